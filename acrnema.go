@@ -0,0 +1,161 @@
+package dicom
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/suyashkumar/dicom/pkg/tag"
+	"github.com/suyashkumar/dicom/pkg/uid"
+)
+
+// ErrorACRNEMAImageLocation indicates that an ACR-NEMA file keeps its pixels
+// in a group other than PixelData (7FE0,0010), as given by its retired Image
+// Location (0028,0200), and can not be converted to DICOM Part 10.
+var ErrorACRNEMAImageLocation = errors.New("ACR-NEMA pixel data is not in the PixelData element")
+
+// acrNemaPeekLength is the number of bytes inspected by detectACRNEMA.
+const acrNemaPeekLength = 512
+
+// acrNemaSOPClasses are the Storage SOP Classes given to converted ACR-NEMA
+// images according to their Modality. Other images become Secondary Capture
+// images.
+var acrNemaSOPClasses = map[string]string{
+	"CR": uid.ComputedRadiographyImageStorage,
+	"CT": uid.CTImageStorage,
+	"MR": uid.MRImageStorage,
+	"US": uid.UltrasoundImageStorage,
+	"NM": uid.NuclearMedicineImageStorage,
+}
+
+// acrNemaOnlyTags are the retired attributes that only describe the ACR-NEMA
+// encoding of a message, and are dropped when converting it to DICOM Part 10.
+var acrNemaOnlyTags = map[tag.Tag]bool{
+	tag.ACR_NEMA_IdentifyingGroupLengthToEnd: true,
+	tag.ACR_NEMA_RecognitionCode:             true,
+	tag.ACR_NEMA_ImageDimensions:             true,
+	tag.ACR_NEMA_ImageFormat:                 true,
+	tag.ACR_NEMA_ManipulatedImage:            true,
+	tag.ACR_NEMA_CompressionCode:             true,
+	tag.ACR_NEMA_ImageLocation:               true,
+}
+
+// detectACRNEMA reports whether data, the first bytes of an input without
+// preamble nor File Meta Information, starts an ACR-NEMA 1.0 or 2.0 message,
+// and in which byte order. ACR-NEMA messages are encoded with implicit VRs and
+// start with the Identifying group (0008), holding its retired Length to End
+// (0008,0001) or Recognition Code (0008,0010).
+func detectACRNEMA(data []byte) (binary.ByteOrder, bool) {
+	for _, bo := range []binary.ByteOrder{binary.LittleEndian, binary.BigEndian} {
+		for offset := 0; offset+8 <= len(data); {
+			t := tag.Tag{Group: bo.Uint16(data[offset:]), Element: bo.Uint16(data[offset+2:])}
+			if t.Group != 0x0008 || isUpperASCII(data[offset+4]) && isUpperASCII(data[offset+5]) {
+				break
+			}
+			if t == tag.ACR_NEMA_IdentifyingGroupLengthToEnd || t == tag.ACR_NEMA_RecognitionCode {
+				return bo, true
+			}
+			length := bo.Uint32(data[offset+4:])
+			if length == tag.VLUndefinedLength || length > uint32(len(data)) {
+				break
+			}
+			offset += 8 + int(length)
+		}
+	}
+	return nil, false
+}
+
+// convertACRNEMA turns ds, read from an ACR-NEMA file, into a DICOM Part 10
+// dataset encoded in Explicit VR Little Endian: group lengths and the
+// attributes in acrNemaOnlyTags are removed, missing SOP Common and Image
+// Pixel attributes are given their usual values (e.g. a new SOPInstanceUID and
+// a MONOCHROME2 PhotometricInterpretation), and File Meta Information is
+// generated.
+func convertACRNEMA(ds *Dataset) error {
+	if loc, err := ds.FindElementByTag(tag.ACR_NEMA_ImageLocation); err == nil {
+		if v, ok := loc.Value.GetValue().([]int); ok && len(v) > 0 && v[0] != int(tag.PixelData.Group) {
+			return fmt.Errorf("%w: Image Location is group %04X", ErrorACRNEMAImageLocation, v[0])
+		}
+	}
+
+	elems := ds.Elements[:0]
+	for _, elem := range ds.Elements {
+		if elem.Tag.Element == 0x0000 || elem.Tag.Group == tag.MetadataGroup || acrNemaOnlyTags[elem.Tag] {
+			continue
+		}
+		elems = append(elems, elem)
+	}
+	ds.Elements = elems
+
+	add := func(t tag.Tag, data interface{}) error {
+		if _, err := ds.FindElementByTag(t); err != ErrorElementNotFound {
+			return err
+		}
+		elem, err := NewElement(t, data)
+		if err != nil {
+			return err
+		}
+		ds.Elements = append(ds.Elements, elem)
+		return nil
+	}
+	newUID := func(t tag.Tag) error {
+		if _, err := findUIDValue(ds, t); err != ErrorElementNotFound {
+			return err
+		}
+		u, err := uid.New()
+		if err != nil {
+			return err
+		}
+		ds.Elements = removeElement(ds.Elements, t)
+		return add(t, []string{u})
+	}
+
+	sopClass := uid.SecondaryCaptureImageStorage
+	if modality, err := ds.FindElementByTag(tag.Modality); err == nil {
+		if v, ok := modality.Value.GetValue().([]string); ok && len(v) > 0 {
+			if c, ok := acrNemaSOPClasses[strings.TrimSpace(v[0])]; ok {
+				sopClass = c
+			}
+		}
+	}
+	if err := add(tag.SOPClassUID, []string{sopClass}); err != nil {
+		return err
+	}
+	for _, t := range []tag.Tag{tag.SOPInstanceUID, tag.StudyInstanceUID, tag.SeriesInstanceUID} {
+		if err := newUID(t); err != nil {
+			return err
+		}
+	}
+	if _, err := ds.FindElementByTag(tag.PixelData); err == nil {
+		if err := add(tag.SamplesPerPixel, []int{1}); err != nil {
+			return err
+		}
+		if err := add(tag.PhotometricInterpretation, []string{"MONOCHROME2"}); err != nil {
+			return err
+		}
+		if err := add(tag.PixelRepresentation, []int{0}); err != nil {
+			return err
+		}
+	}
+
+	metaElems, err := generateFileMeta(ds, []*Element{mustNewElement(tag.TransferSyntaxUID, []string{uid.ExplicitVRLittleEndian})})
+	if err != nil {
+		return err
+	}
+	ds.Elements = append(metaElems, ds.Elements...)
+	sort.SliceStable(ds.Elements, func(i, j int) bool { return ds.Elements[i].Tag.Compare(ds.Elements[j].Tag) < 0 })
+	return nil
+}
+
+// removeElement returns elems without the Elements with tag t.
+func removeElement(elems []*Element, t tag.Tag) []*Element {
+	kept := elems[:0]
+	for _, elem := range elems {
+		if elem.Tag != t {
+			kept = append(kept, elem)
+		}
+	}
+	return kept
+}
@@ -0,0 +1,163 @@
+package dicom
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/suyashkumar/dicom/pkg/dicomio"
+	"github.com/suyashkumar/dicom/pkg/frame"
+	"github.com/suyashkumar/dicom/pkg/tag"
+	"github.com/suyashkumar/dicom/pkg/uid"
+)
+
+// writeACRNEMA encodes elems as an ACR-NEMA file in byte order bo.
+func writeACRNEMA(t *testing.T, bo binary.ByteOrder, elems []*Element) []byte {
+	t.Helper()
+	out := &bytes.Buffer{}
+	w := dicomio.NewWriter(out, bo, true)
+	for _, elem := range elems {
+		if err := writeElement(w, elem, writeOptSet{}); err != nil {
+			t.Fatalf("writeElement(%v) unexpected error: %v", elem.Tag, err)
+		}
+	}
+	return out.Bytes()
+}
+
+func acrNemaElements(imageLocation int) []*Element {
+	return []*Element{
+		mustNewElement(tag.Tag{Group: 0x0008, Element: 0x0000}, []int{42}),
+		mustNewElement(tag.ACR_NEMA_IdentifyingGroupLengthToEnd, []int{1234}),
+		mustNewElement(tag.ACR_NEMA_RecognitionCode, []string{"ACR-NEMA 2.0"}),
+		mustNewElement(tag.Modality, []string{"CT"}),
+		mustNewElement(tag.PatientName, []string{"Doe^John"}),
+		mustNewElement(tag.ACR_NEMA_ImageDimensions, []int{2}),
+		mustNewElement(tag.Rows, []int{2}),
+		mustNewElement(tag.Columns, []int{2}),
+		mustNewElement(tag.ACR_NEMA_ImageFormat, []string{"RECT"}),
+		mustNewElement(tag.BitsAllocated, []int{16}),
+		mustNewElement(tag.BitsStored, []int{12}),
+		mustNewElement(tag.ACR_NEMA_ImageLocation, []int{imageLocation}),
+		mustNewElement(tag.PixelData, PixelDataInfo{Frames: []frame.Frame{{
+			NativeData: frame.NativeFrame{BitsPerSample: 16, Rows: 2, Cols: 2, Data: [][]int{{1}, {2}, {0x0100}, {0x0FFF}}},
+		}}}),
+	}
+}
+
+func TestParse_ACRNEMA(t *testing.T) {
+	for _, bo := range []binary.ByteOrder{binary.LittleEndian, binary.BigEndian} {
+		t.Run(bo.String(), func(t *testing.T) {
+			data := writeACRNEMA(t, bo, acrNemaElements(0x7FE0))
+
+			p, err := NewParser(bytes.NewReader(data), int64(len(data)), nil)
+			if err != nil {
+				t.Fatalf("NewParser() unexpected error: %v", err)
+			}
+			if !p.IsACRNEMA() {
+				t.Errorf("IsACRNEMA() got: false, want: true")
+			}
+
+			ds, err := Parse(bytes.NewReader(data), int64(len(data)), nil)
+			if err != nil {
+				t.Fatalf("Parse() unexpected error: %v", err)
+			}
+			name, err := ds.FindElementByTag(tag.PatientName)
+			if err != nil || !cmp.Equal(MustGetStrings(name.Value), []string{"Doe^John"}) {
+				t.Errorf("Parse() unexpected PatientName: %v, %v", name, err)
+			}
+			pixels, err := ds.FindElementByTag(tag.PixelData)
+			if err != nil {
+				t.Fatalf("Parse() did not read PixelData: %v", err)
+			}
+			if diff := cmp.Diff([][]int{{1}, {2}, {0x0100}, {0x0FFF}}, MustGetPixelDataInfo(pixels.Value).Frames[0].NativeData.Data); diff != "" {
+				t.Errorf("Parse() unexpected PixelData. diff: %v", diff)
+			}
+		})
+	}
+}
+
+func TestParse_ConvertACRNEMAToPart10(t *testing.T) {
+	data := writeACRNEMA(t, binary.BigEndian, acrNemaElements(0x7FE0))
+	ds, err := Parse(bytes.NewReader(data), int64(len(data)), nil, ConvertACRNEMAToPart10())
+	if err != nil {
+		t.Fatalf("Parse(ConvertACRNEMAToPart10()) unexpected error: %v", err)
+	}
+
+	for _, removed := range []tag.Tag{
+		{Group: 0x0008, Element: 0x0000},
+		tag.ACR_NEMA_IdentifyingGroupLengthToEnd,
+		tag.ACR_NEMA_RecognitionCode,
+		tag.ACR_NEMA_ImageDimensions,
+		tag.ACR_NEMA_ImageFormat,
+		tag.ACR_NEMA_ImageLocation,
+	} {
+		if _, err := ds.FindElementByTag(removed); err != ErrorElementNotFound {
+			t.Errorf("Parse(ConvertACRNEMAToPart10()) kept %v", removed)
+		}
+	}
+	for want, value := range map[tag.Tag]interface{}{
+		tag.TransferSyntaxUID:         []string{uid.ExplicitVRLittleEndian},
+		tag.SOPClassUID:               []string{"1.2.840.10008.5.1.4.1.1.2"},
+		tag.MediaStorageSOPClassUID:   []string{"1.2.840.10008.5.1.4.1.1.2"},
+		tag.SamplesPerPixel:           []int{1},
+		tag.PhotometricInterpretation: []string{"MONOCHROME2"},
+		tag.PixelRepresentation:       []int{0},
+	} {
+		elem, err := ds.FindElementByTag(want)
+		if err != nil {
+			t.Errorf("Parse(ConvertACRNEMAToPart10()) did not add %v", want)
+			continue
+		}
+		if diff := cmp.Diff(value, elem.Value.GetValue()); diff != "" {
+			t.Errorf("Parse(ConvertACRNEMAToPart10()) unexpected %v. diff: %v", want, diff)
+		}
+	}
+	for _, generated := range []tag.Tag{tag.SOPInstanceUID, tag.StudyInstanceUID, tag.SeriesInstanceUID} {
+		u, err := findUIDValue(&ds, generated)
+		if err != nil || uid.Validate(u) != nil {
+			t.Errorf("Parse(ConvertACRNEMAToPart10()) unexpected %v: %q, %v", generated, u, err)
+		}
+	}
+
+	out := &bytes.Buffer{}
+	if err := Write(out, ds); err != nil {
+		t.Fatalf("Write() of the converted Dataset unexpected error: %v", err)
+	}
+	written, err := Parse(out, int64(out.Len()), nil)
+	if err != nil {
+		t.Fatalf("Parse() of the converted Dataset unexpected error: %v", err)
+	}
+	pixels, err := written.FindElementByTag(tag.PixelData)
+	if err != nil {
+		t.Fatalf("Parse() of the converted Dataset did not read PixelData: %v", err)
+	}
+	if diff := cmp.Diff([][]int{{1}, {2}, {0x0100}, {0x0FFF}}, MustGetPixelDataInfo(pixels.Value).Frames[0].NativeData.Data); diff != "" {
+		t.Errorf("Parse() of the converted Dataset unexpected PixelData. diff: %v", diff)
+	}
+}
+
+func TestParse_ConvertACRNEMAToPart10_ImageLocation(t *testing.T) {
+	data := writeACRNEMA(t, binary.LittleEndian, acrNemaElements(0x6000))
+	if _, err := Parse(bytes.NewReader(data), int64(len(data)), nil, ConvertACRNEMAToPart10()); !errors.Is(err, ErrorACRNEMAImageLocation) {
+		t.Errorf("Parse(ConvertACRNEMAToPart10()) unexpected error. got: %v, want: %v", err, ErrorACRNEMAImageLocation)
+	}
+}
+
+func TestDetectACRNEMA(t *testing.T) {
+	implicitDICOM := writeACRNEMA(t, binary.LittleEndian, []*Element{
+		mustNewElement(tag.SOPClassUID, []string{"1.2.840.10008.5.1.4.1.1.2"}),
+		mustNewElement(tag.PatientName, []string{"Doe^John"}),
+	})
+	if _, ok := detectACRNEMA(implicitDICOM); ok {
+		t.Errorf("detectACRNEMA() detected an implicit VR dataset without ACR-NEMA attributes")
+	}
+	explicit := &bytes.Buffer{}
+	if err := WriteDataset(explicit, Dataset{Elements: acrNemaElements(0x7FE0)[:5]}, uid.ExplicitVRLittleEndian); err != nil {
+		t.Fatalf("WriteDataset() unexpected error: %v", err)
+	}
+	if _, ok := detectACRNEMA(explicit.Bytes()); ok {
+		t.Errorf("detectACRNEMA() detected an explicit VR dataset")
+	}
+}
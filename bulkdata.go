@@ -0,0 +1,47 @@
+package dicom
+
+import (
+	"github.com/suyashkumar/dicom/pkg/tag"
+)
+
+// BulkDataOption represents an option that controls how binary values are
+// handled when encoding or decoding the DICOM JSON and XML models
+// (MarshalDICOMJSON, UnmarshalDICOMJSON, MarshalDICOMXML and
+// UnmarshalDICOMXML). Later options will override previous options if
+// applicable.
+type BulkDataOption func(*bulkDataOptSet)
+
+// WithBulkDataURIs returns a BulkDataOption for MarshalDICOMJSON and
+// MarshalDICOMXML that encodes binary Elements as bulk data references instead
+// of InlineBinary. For each binary Element, uriFor is called with the Element
+// and the tags of its enclosing Sequences (outermost first); if it returns
+// ok=false, the Element is inlined as usual.
+func WithBulkDataURIs(uriFor func(elem *Element, parents []tag.Tag) (uri string, ok bool)) BulkDataOption {
+	return func(set *bulkDataOptSet) {
+		set.bulkDataURIFor = uriFor
+	}
+}
+
+// WithBulkDataResolver returns a BulkDataOption for UnmarshalDICOMJSON and
+// UnmarshalDICOMXML that fetches the binary value of attributes encoded as bulk
+// data references. Without a resolver, those attributes are skipped.
+func WithBulkDataResolver(resolve func(uri string) ([]byte, error)) BulkDataOption {
+	return func(set *bulkDataOptSet) {
+		set.resolveBulkData = resolve
+	}
+}
+
+// bulkDataOptSet represents the flattened option set after all
+// BulkDataOptions have been applied.
+type bulkDataOptSet struct {
+	bulkDataURIFor  func(elem *Element, parents []tag.Tag) (string, bool)
+	resolveBulkData func(uri string) ([]byte, error)
+}
+
+func toBulkDataOptSet(opts ...BulkDataOption) *bulkDataOptSet {
+	optSet := &bulkDataOptSet{}
+	for _, opt := range opts {
+		opt(optSet)
+	}
+	return optSet
+}
@@ -0,0 +1,187 @@
+package dicom
+
+import (
+	"github.com/suyashkumar/dicom/pkg/frame"
+)
+
+// CloneOption represents an option that can be passed to Dataset.Clone or
+// Element.Clone. Later options will override previous options if applicable.
+type CloneOption func(*cloneOptSet)
+
+// SharePixelData returns a CloneOption indicating that the underlying frame
+// buffers of PixelData values should be shared between the original and the
+// clone instead of being copied. The PixelDataInfo and its Frames slice are
+// still copied, so appending or replacing frames in the clone does not affect
+// the original, but modifying the pixel values of a shared frame will.
+//
+// This is useful when producing many modified variants of a Dataset that only
+// differ in their metadata, where copying large pixel buffers would be
+// wasteful.
+func SharePixelData() CloneOption {
+	return func(set *cloneOptSet) {
+		set.sharePixelData = true
+	}
+}
+
+// cloneOptSet represents the flattened option set after all CloneOptions have
+// been applied.
+type cloneOptSet struct {
+	sharePixelData bool
+}
+
+func toCloneOptSet(opts ...CloneOption) *cloneOptSet {
+	optSet := &cloneOptSet{}
+	for _, opt := range opts {
+		opt(optSet)
+	}
+	return optSet
+}
+
+// Clone returns a deep copy of this Dataset. All Elements and their Values,
+// including Elements nested within Sequences, are copied so that modifying the
+// returned Dataset never modifies the original (and vice versa).
+func (d *Dataset) Clone(opts ...CloneOption) Dataset {
+	optSet := toCloneOptSet(opts...)
+	return Dataset{Elements: cloneElements(d.Elements, *optSet)}
+}
+
+// Clone returns a deep copy of this Element, including a deep copy of its
+// Value (and any nested Sequence Items).
+func (e *Element) Clone(opts ...CloneOption) *Element {
+	optSet := toCloneOptSet(opts...)
+	return cloneElement(e, *optSet)
+}
+
+func cloneElements(elems []*Element, opts cloneOptSet) []*Element {
+	if elems == nil {
+		return nil
+	}
+	cloned := make([]*Element, len(elems))
+	for i, elem := range elems {
+		cloned[i] = cloneElement(elem, opts)
+	}
+	return cloned
+}
+
+func cloneElement(e *Element, opts cloneOptSet) *Element {
+	if e == nil {
+		return nil
+	}
+	return &Element{
+		Tag:                    e.Tag,
+		ValueRepresentation:    e.ValueRepresentation,
+		RawValueRepresentation: e.RawValueRepresentation,
+		ValueLength:            e.ValueLength,
+		Value:                  cloneValue(e.Value, opts),
+	}
+}
+
+func cloneValue(v Value, opts cloneOptSet) Value {
+	switch val := v.(type) {
+	case nil:
+		return nil
+	case *bytesValue:
+		return &bytesValue{value: cloneBytes(val.value)}
+	case *stringsValue:
+		var s []string
+		if val.value != nil {
+			s = make([]string, len(val.value))
+			copy(s, val.value)
+		}
+		return &stringsValue{value: s}
+	case *intsValue:
+		var i []int
+		if val.value != nil {
+			i = make([]int, len(val.value))
+			copy(i, val.value)
+		}
+		return &intsValue{value: i}
+	case *floatsValue:
+		var f []float64
+		if val.value != nil {
+			f = make([]float64, len(val.value))
+			copy(f, val.value)
+		}
+		return &floatsValue{value: f}
+	case *SequenceItemValue:
+		return cloneSequenceItem(val, opts)
+	case *sequencesValue:
+		var items []*SequenceItemValue
+		if val.value != nil {
+			items = make([]*SequenceItemValue, len(val.value))
+			for i, item := range val.value {
+				items[i] = cloneSequenceItem(item, opts)
+			}
+		}
+		return &sequencesValue{value: items}
+	case *pixelDataValue:
+		return &pixelDataValue{PixelDataInfo: clonePixelDataInfo(val.PixelDataInfo, opts)}
+	default:
+		// All Value implementations live in this package, so this should not
+		// happen. Fall back to sharing the Value rather than losing it.
+		return v
+	}
+}
+
+func cloneSequenceItem(item *SequenceItemValue, opts cloneOptSet) *SequenceItemValue {
+	if item == nil {
+		return nil
+	}
+	return &SequenceItemValue{elements: cloneElements(item.elements, opts)}
+}
+
+func clonePixelDataInfo(info PixelDataInfo, opts cloneOptSet) PixelDataInfo {
+	cloned := PixelDataInfo{IsEncapsulated: info.IsEncapsulated}
+	if info.Offsets != nil {
+		cloned.Offsets = make([]uint32, len(info.Offsets))
+		copy(cloned.Offsets, info.Offsets)
+	}
+	if info.Frames != nil {
+		cloned.Frames = make([]frame.Frame, len(info.Frames))
+		for i, f := range info.Frames {
+			cloned.Frames[i] = cloneFrame(f, opts)
+		}
+	}
+	return cloned
+}
+
+func cloneFrame(f frame.Frame, opts cloneOptSet) frame.Frame {
+	// Copying the struct copies all non-buffer fields (Rows, Cols, etc).
+	cloned := f
+	if opts.sharePixelData {
+		return cloned
+	}
+	cloned.EncapsulatedData.Data = cloneBytes(f.EncapsulatedData.Data)
+	cloned.NativeData.Data = cloneNativeData(f.NativeData.Data)
+	return cloned
+}
+
+// cloneNativeData deep copies native pixel data. All samples are copied into a
+// single backing buffer, mirroring how readNativeFrames lays out frames.
+func cloneNativeData(data [][]int) [][]int {
+	if data == nil {
+		return nil
+	}
+	numSamples := 0
+	for _, pixel := range data {
+		numSamples += len(pixel)
+	}
+	buf := make([]int, numSamples)
+	cloned := make([][]int, len(data))
+	offset := 0
+	for i, pixel := range data {
+		n := copy(buf[offset:], pixel)
+		cloned[i] = buf[offset : offset+n : offset+n]
+		offset += n
+	}
+	return cloned
+}
+
+func cloneBytes(b []byte) []byte {
+	if b == nil {
+		return nil
+	}
+	cloned := make([]byte, len(b))
+	copy(cloned, b)
+	return cloned
+}
@@ -0,0 +1,104 @@
+package dicom
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/suyashkumar/dicom/pkg/frame"
+	"github.com/suyashkumar/dicom/pkg/tag"
+)
+
+func makeCloneTestDataset() Dataset {
+	return Dataset{Elements: []*Element{
+		mustNewElement(tag.PatientName, []string{"Bob", "Jones"}),
+		mustNewElement(tag.Rows, []int{2}),
+		mustNewElement(tag.FloatingPointValue, []float64{1.5}),
+		mustNewElement(tag.RedPaletteColorLookupTableData, []byte{0x01, 0x02}),
+		makeSequenceElement(tag.AddOtherSequence, [][]*Element{
+			{
+				mustNewElement(tag.PatientName, []string{"Nested"}),
+				makeSequenceElement(tag.AnatomicRegionSequence, [][]*Element{
+					{mustNewElement(tag.Rows, []int{7})},
+				}),
+			},
+		}),
+		mustNewElement(tag.PixelData, PixelDataInfo{
+			Offsets: []uint32{0},
+			Frames: []frame.Frame{
+				{
+					NativeData: frame.NativeFrame{
+						BitsPerSample: 8,
+						Rows:          1,
+						Cols:          2,
+						Data:          [][]int{{1}, {2}},
+					},
+				},
+			},
+		}),
+	}}
+}
+
+func TestDataset_Clone(t *testing.T) {
+	original := makeCloneTestDataset()
+	want := makeCloneTestDataset()
+
+	cloned := original.Clone()
+	if diff := cmp.Diff(want, cloned, cmp.AllowUnexported(allValues...)); diff != "" {
+		t.Fatalf("Clone() produced unexpected dataset. diff: %v", diff)
+	}
+
+	// Mutate every kind of value in the clone.
+	MustGetStrings(cloned.Elements[0].Value)[0] = "Alice"
+	MustGetInts(cloned.Elements[1].Value)[0] = 100
+	MustGetFloats(cloned.Elements[2].Value)[0] = 2.5
+	MustGetBytes(cloned.Elements[3].Value)[0] = 0xFF
+	items := cloned.Elements[4].Value.GetValue().([]*SequenceItemValue)
+	nested := items[0].GetValue().([]*Element)
+	MustGetStrings(nested[0].Value)[0] = "Changed"
+	nestedItems := nested[1].Value.GetValue().([]*SequenceItemValue)
+	MustGetInts(nestedItems[0].GetValue().([]*Element)[0].Value)[0] = 8
+	items[0].elements = append(items[0].elements, mustNewElement(tag.Columns, []int{1}))
+	pixelData := MustGetPixelDataInfo(cloned.Elements[5].Value)
+	pixelData.Frames[0].NativeData.Data[0][0] = 42
+	pixelData.Offsets[0] = 10
+	cloned.Elements[0].Tag = tag.PatientID
+
+	if diff := cmp.Diff(want, original, cmp.AllowUnexported(allValues...)); diff != "" {
+		t.Errorf("modifying the Clone() result modified the original dataset. diff: %v", diff)
+	}
+}
+
+func TestDataset_Clone_SharePixelData(t *testing.T) {
+	original := makeCloneTestDataset()
+
+	cloned := original.Clone(SharePixelData())
+
+	clonedPixelData := MustGetPixelDataInfo(cloned.Elements[5].Value)
+	clonedPixelData.Frames[0].NativeData.Data[0][0] = 42
+	clonedPixelData.Frames[0].NativeData.Rows = 3
+
+	originalPixelData := MustGetPixelDataInfo(original.Elements[5].Value)
+	if got := originalPixelData.Frames[0].NativeData.Data[0][0]; got != 42 {
+		t.Errorf("Clone(SharePixelData()) expected frame buffers to be shared. got: %v, want: %v", got, 42)
+	}
+	if got := originalPixelData.Frames[0].NativeData.Rows; got != 1 {
+		t.Errorf("Clone(SharePixelData()) unexpectedly shared frame metadata. got: %v, want: %v", got, 1)
+	}
+}
+
+func TestElement_Clone_Encapsulated(t *testing.T) {
+	original := mustNewElement(tag.PixelData, PixelDataInfo{
+		IsEncapsulated: true,
+		Frames: []frame.Frame{
+			{Encapsulated: true, EncapsulatedData: frame.EncapsulatedFrame{Data: []byte{1, 2, 3, 4}}},
+		},
+	})
+
+	cloned := original.Clone()
+	MustGetPixelDataInfo(cloned.Value).Frames[0].EncapsulatedData.Data[0] = 9
+
+	if got := MustGetPixelDataInfo(original.Value).Frames[0].EncapsulatedData.Data[0]; got != 1 {
+		t.Errorf("modifying the Clone() result modified the original encapsulated frame. got: %v, want: %v", got, 1)
+	}
+}
@@ -1,7 +1,6 @@
 package dicom
 
 import (
-	"encoding/binary"
 	"errors"
 	"fmt"
 	"strings"
@@ -36,17 +35,18 @@ func (d *Dataset) FindElementByTag(tag tag.Tag) (*Element, error) {
 	return nil, ErrorElementNotFound
 }
 
-func (d *Dataset) transferSyntax() (binary.ByteOrder, bool, error) {
+// transferSyntax returns the transfer syntax of d, given by its
+// TransferSyntaxUID element. ErrorElementNotFound is returned if d has none.
+func (d *Dataset) transferSyntax() (uid.TransferSyntax, error) {
 	elem, err := d.FindElementByTag(tag.TransferSyntaxUID)
 	if err != nil {
-		return nil, false, err
+		return uid.TransferSyntax{}, err
 	}
 	value, ok := elem.Value.GetValue().([]string)
 	if !ok || len(value) != 1 {
-		return nil, false, fmt.Errorf("failed to retrieve TransferSyntaxUID. Unable to cast elem.Value to []string")
+		return uid.TransferSyntax{}, fmt.Errorf("failed to retrieve TransferSyntaxUID. Unable to cast elem.Value to []string")
 	}
-	transferSyntaxUID := value[0]
-	return uid.ParseTransferSyntaxUID(transferSyntaxUID)
+	return uid.LookupTransferSyntax(value[0])
 }
 
 // FindElementByTagNested searches through the dataset and returns a pointer to the matching element.
@@ -94,7 +94,7 @@ func (d *Dataset) String() string {
 	for elem := range d.flatIteratorWithLevel() {
 		tabs := buildTabs(elem.l)
 		var tagName string
-		if tagInfo, err := tag.Find(elem.e.Tag); err == nil {
+		if tagInfo, err := findTagInfo(elem.parent, elem.e.Tag); err == nil {
 			tagName = tagInfo.Name
 		}
 
@@ -114,6 +114,9 @@ type elementWithLevel struct {
 	e *Element
 	// l represents the nesting level of the Element
 	l uint
+	// parent is the Dataset (or sequence Item) directly containing the
+	// Element, used to resolve private tags.
+	parent *Dataset
 }
 
 func (d *Dataset) flatIteratorWithLevel() <-chan *elementWithLevel {
@@ -126,15 +129,16 @@ func (d *Dataset) flatIteratorWithLevel() <-chan *elementWithLevel {
 }
 
 func flatElementsIteratorWithLevel(elems []*Element, level uint, eWithLevelChan chan<- *elementWithLevel) {
+	parent := &Dataset{Elements: elems}
 	for _, elem := range elems {
 		if elem.Value.ValueType() == Sequences {
-			eWithLevelChan <- &elementWithLevel{elem, level}
+			eWithLevelChan <- &elementWithLevel{elem, level, parent}
 			for _, seqItem := range elem.Value.GetValue().([]*SequenceItemValue) {
 				flatElementsIteratorWithLevel(seqItem.elements, level+1, eWithLevelChan)
 			}
 			continue
 		}
-		eWithLevelChan <- &elementWithLevel{elem, level}
+		eWithLevelChan <- &elementWithLevel{elem, level, parent}
 	}
 }
 
@@ -0,0 +1,469 @@
+package dicom
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/suyashkumar/dicom/pkg/dicomio"
+	"github.com/suyashkumar/dicom/pkg/tag"
+	"github.com/suyashkumar/dicom/pkg/vrraw"
+)
+
+// ErrorInvalidDICOMJSON indicates that the input to UnmarshalDICOMJSON does not
+// follow the DICOM JSON Model.
+var ErrorInvalidDICOMJSON = errors.New("invalid DICOM JSON")
+
+// jsonAttribute is a single attribute of the DICOM JSON Model, see
+// http://dicom.nema.org/medical/dicom/current/output/chtml/part18/sect_F.2.2.html.
+type jsonAttribute struct {
+	VR           string            `json:"vr"`
+	Value        []json.RawMessage `json:"Value,omitempty"`
+	InlineBinary string            `json:"InlineBinary,omitempty"`
+	BulkDataURI  string            `json:"BulkDataURI,omitempty"`
+}
+
+// jsonPersonName is a single PN value of the DICOM JSON Model, see
+// http://dicom.nema.org/medical/dicom/current/output/chtml/part18/sect_F.2.2.html.
+type jsonPersonName struct {
+	Alphabetic  string `json:"Alphabetic,omitempty"`
+	Ideographic string `json:"Ideographic,omitempty"`
+	Phonetic    string `json:"Phonetic,omitempty"`
+}
+
+// MarshalDICOMJSON encodes the Dataset using the standard DICOM JSON Model
+// defined in PS3.18 Annex F, as used by DICOMweb. Unlike json.Marshal on a
+// Dataset (which produces this package's own representation), the output can
+// be consumed by other DICOM tools and read back with UnmarshalDICOMJSON.
+//
+// Binary values (including PixelData) are encoded as InlineBinary, unless
+// WithBulkDataURIs is provided.
+func MarshalDICOMJSON(ds Dataset, opts ...BulkDataOption) ([]byte, error) {
+	optSet := toBulkDataOptSet(opts...)
+	obj, err := elementsToDICOMJSON(ds.Elements, nil, *optSet)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(obj)
+}
+
+// UnmarshalDICOMJSON decodes a single dataset encoded using the standard DICOM
+// JSON Model defined in PS3.18 Annex F. If the input is a JSON array of
+// datasets (as returned by DICOMweb metadata requests), use
+// UnmarshalDICOMJSONArray instead.
+func UnmarshalDICOMJSON(data []byte, opts ...BulkDataOption) (Dataset, error) {
+	optSet := toBulkDataOptSet(opts...)
+	var obj map[string]jsonAttribute
+	if err := json.Unmarshal(data, &obj); err != nil {
+		return Dataset{}, fmt.Errorf("%w: %v", ErrorInvalidDICOMJSON, err)
+	}
+	elems, err := elementsFromDICOMJSON(obj, *optSet)
+	if err != nil {
+		return Dataset{}, err
+	}
+	return Dataset{Elements: elems}, nil
+}
+
+// UnmarshalDICOMJSONArray decodes a JSON array of datasets encoded using the
+// standard DICOM JSON Model, as returned by DICOMweb QIDO-RS and WADO-RS
+// metadata requests.
+func UnmarshalDICOMJSONArray(data []byte, opts ...BulkDataOption) ([]Dataset, error) {
+	var raw []json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrorInvalidDICOMJSON, err)
+	}
+	datasets := make([]Dataset, 0, len(raw))
+	for i, r := range raw {
+		ds, err := UnmarshalDICOMJSON(r, opts...)
+		if err != nil {
+			return nil, fmt.Errorf("dataset %d: %w", i, err)
+		}
+		datasets = append(datasets, ds)
+	}
+	return datasets, nil
+}
+
+// dicomJSONKey returns the "ggggeeee" key used for t in the DICOM JSON Model.
+func dicomJSONKey(t tag.Tag) string {
+	return fmt.Sprintf("%04X%04X", t.Group, t.Element)
+}
+
+func parseDICOMJSONKey(key string) (tag.Tag, error) {
+	if len(key) != 8 {
+		return tag.Tag{}, fmt.Errorf("%w: invalid attribute tag %q", ErrorInvalidDICOMJSON, key)
+	}
+	v, err := strconv.ParseUint(key, 16, 32)
+	if err != nil {
+		return tag.Tag{}, fmt.Errorf("%w: invalid attribute tag %q", ErrorInvalidDICOMJSON, key)
+	}
+	return tag.Tag{Group: uint16(v >> 16), Element: uint16(v)}, nil
+}
+
+// isBinaryVR reports whether vr is encoded as InlineBinary or BulkDataURI in
+// the DICOM JSON Model.
+func isBinaryVR(vr string) bool {
+	switch vr {
+	case vrraw.OtherByte, vrraw.OtherWord, vrraw.OtherDouble, vrraw.OtherFloat,
+		vrraw.OtherLong, vrraw.OtherVeryLong, vrraw.Unknown:
+		return true
+	}
+	return false
+}
+
+func elementsToDICOMJSON(elems []*Element, parents []tag.Tag, opts bulkDataOptSet) (map[string]jsonAttribute, error) {
+	obj := make(map[string]jsonAttribute, len(elems))
+	for _, elem := range elems {
+		attr, err := elementToDICOMJSON(elem, parents, opts)
+		if err != nil {
+			return nil, fmt.Errorf("element %s: %w", tag.DebugString(elem.Tag), err)
+		}
+		obj[dicomJSONKey(elem.Tag)] = attr
+	}
+	return obj, nil
+}
+
+func elementToDICOMJSON(elem *Element, parents []tag.Tag, opts bulkDataOptSet) (jsonAttribute, error) {
+	vr := elementVR(elem)
+	if vr == "" {
+		vr = vrraw.Unknown
+	}
+	attr := jsonAttribute{VR: vr}
+	if elem.Value == nil {
+		return attr, nil
+	}
+
+	if isBinaryVR(vr) || elem.Value.ValueType() == PixelData || elem.Value.ValueType() == Bytes {
+		if opts.bulkDataURIFor != nil {
+			if uri, ok := opts.bulkDataURIFor(elem, parents); ok {
+				attr.BulkDataURI = uri
+				return attr, nil
+			}
+		}
+		data, err := binaryValueBytes(elem, vr)
+		if err != nil {
+			return attr, err
+		}
+		if len(data) > 0 {
+			attr.InlineBinary = base64.StdEncoding.EncodeToString(data)
+		}
+		return attr, nil
+	}
+
+	var values []interface{}
+	switch elem.Value.ValueType() {
+	case Sequences:
+		for _, item := range elem.Value.GetValue().([]*SequenceItemValue) {
+			obj, err := elementsToDICOMJSON(item.elements, append(parents[:len(parents):len(parents)], elem.Tag), opts)
+			if err != nil {
+				return attr, err
+			}
+			values = append(values, obj)
+		}
+	case Ints:
+		ints := MustGetInts(elem.Value)
+		if vr == vrraw.AttributeTag {
+			if len(ints)%2 != 0 {
+				return attr, fmt.Errorf("AT value has an odd number of components: %v", ints)
+			}
+			for i := 0; i < len(ints); i += 2 {
+				values = append(values, fmt.Sprintf("%04X%04X", ints[i], ints[i+1]))
+			}
+			break
+		}
+		for _, v := range ints {
+			values = append(values, v)
+		}
+	case Floats:
+		for _, v := range MustGetFloats(elem.Value) {
+			if math.IsNaN(v) || math.IsInf(v, 0) {
+				return attr, fmt.Errorf("%v cannot be represented in JSON", v)
+			}
+			values = append(values, v)
+		}
+	case Strings:
+		strs := MustGetStrings(elem.Value)
+		if len(strs) == 1 && strs[0] == "" {
+			// A zero-length value.
+			return attr, nil
+		}
+		for _, s := range strs {
+			v, err := stringToDICOMJSON(vr, s)
+			if err != nil {
+				return attr, err
+			}
+			values = append(values, v)
+		}
+	default:
+		return attr, fmt.Errorf("unsupported ValueType %v for VR %s", elem.Value.ValueType(), vr)
+	}
+
+	for _, v := range values {
+		raw, err := json.Marshal(v)
+		if err != nil {
+			return attr, err
+		}
+		attr.Value = append(attr.Value, raw)
+	}
+	return attr, nil
+}
+
+// stringToDICOMJSON converts a single string value to its DICOM JSON Model
+// representation for the provided VR.
+func stringToDICOMJSON(vr, s string) (interface{}, error) {
+	switch vr {
+	case vrraw.PersonName:
+		if s == "" {
+			return nil, nil
+		}
+		groups := strings.SplitN(s, "=", 3)
+		pn := jsonPersonName{Alphabetic: groups[0]}
+		if len(groups) > 1 {
+			pn.Ideographic = groups[1]
+		}
+		if len(groups) > 2 {
+			pn.Phonetic = groups[2]
+		}
+		return pn, nil
+	case vrraw.DecimalString, vrraw.IntegerString:
+		s = strings.TrimSpace(s)
+		if s == "" {
+			return nil, nil
+		}
+		f, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s value %q", vr, s)
+		}
+		if math.IsNaN(f) || math.IsInf(f, 0) {
+			return nil, fmt.Errorf("%s value %q cannot be represented in JSON", vr, s)
+		}
+		// Preserve the original representation when it is already a valid JSON
+		// number, so that values round-trip exactly.
+		if json.Valid([]byte(s)) {
+			return json.Number(s), nil
+		}
+		if vr == vrraw.IntegerString {
+			return json.Number(strconv.FormatInt(int64(f), 10)), nil
+		}
+		return json.Number(strconv.FormatFloat(f, 'g', -1, 64)), nil
+	default:
+		if s == "" {
+			return nil, nil
+		}
+		return s, nil
+	}
+}
+
+// binaryValueBytes returns the little endian byte encoding of a binary
+// Element, as used for InlineBinary.
+func binaryValueBytes(elem *Element, vr string) ([]byte, error) {
+	switch elem.Value.ValueType() {
+	case Bytes:
+		return MustGetBytes(elem.Value), nil
+	case Strings:
+		// VRs like UN, OF and OD are read as raw strings.
+		return []byte(strings.Join(MustGetStrings(elem.Value), "\\")), nil
+	case PixelData:
+		buf := &bytes.Buffer{}
+		w := dicomio.NewWriter(buf, binary.LittleEndian, false)
+		vl := uint32(0)
+		if MustGetPixelDataInfo(elem.Value).IsEncapsulated {
+			vl = tag.VLUndefinedLength
+		}
+		if err := writePixelData(w, elem.Tag, elem.Value, vr, vl); err != nil {
+			return nil, err
+		}
+		return buf.Bytes(), nil
+	}
+	return nil, fmt.Errorf("unsupported ValueType %v for binary VR %s", elem.Value.ValueType(), vr)
+}
+
+func elementsFromDICOMJSON(obj map[string]jsonAttribute, opts bulkDataOptSet) ([]*Element, error) {
+	tags := make([]tag.Tag, 0, len(obj))
+	keys := make(map[tag.Tag]string, len(obj))
+	for key := range obj {
+		t, err := parseDICOMJSONKey(key)
+		if err != nil {
+			return nil, err
+		}
+		tags = append(tags, t)
+		keys[t] = key
+	}
+	// Decode in tag order, so that elements needed to decode native PixelData
+	// (Rows, Columns, etc) are available by the time it is reached.
+	sort.Slice(tags, func(i, j int) bool { return tags[i].Compare(tags[j]) < 0 })
+
+	ds := &Dataset{Elements: make([]*Element, 0, len(tags))}
+	for _, t := range tags {
+		elem, err := elementFromDICOMJSON(t, obj[keys[t]], ds, opts)
+		if err != nil {
+			return nil, fmt.Errorf("element %s: %w", tag.DebugString(t), err)
+		}
+		if elem != nil {
+			ds.Elements = append(ds.Elements, elem)
+		}
+	}
+	return ds.Elements, nil
+}
+
+func elementFromDICOMJSON(t tag.Tag, attr jsonAttribute, ds *Dataset, opts bulkDataOptSet) (*Element, error) {
+	vr := attr.VR
+	if len(vr) != 2 {
+		return nil, fmt.Errorf("%w: missing or invalid vr %q", ErrorInvalidDICOMJSON, vr)
+	}
+	elem := &Element{
+		Tag:                    t,
+		ValueRepresentation:    tag.GetVRKind(t, vr),
+		RawValueRepresentation: vr,
+	}
+
+	if attr.BulkDataURI != "" || attr.InlineBinary != "" {
+		var data []byte
+		var err error
+		if attr.BulkDataURI != "" {
+			if opts.resolveBulkData == nil {
+				return nil, nil
+			}
+			data, err = opts.resolveBulkData(attr.BulkDataURI)
+		} else {
+			data, err = base64.StdEncoding.DecodeString(attr.InlineBinary)
+		}
+		if err != nil {
+			return nil, err
+		}
+		elem.Value, err = binaryValueFromBytes(t, vr, data, ds)
+		return elem, err
+	}
+
+	var err error
+	switch vr {
+	case vrraw.Sequence:
+		var items []*SequenceItemValue
+		for i, raw := range attr.Value {
+			var itemObj map[string]jsonAttribute
+			if err := json.Unmarshal(raw, &itemObj); err != nil {
+				return nil, fmt.Errorf("%w: item %d: %v", ErrorInvalidDICOMJSON, i, err)
+			}
+			itemElems, err := elementsFromDICOMJSON(itemObj, opts)
+			if err != nil {
+				return nil, fmt.Errorf("item %d: %w", i, err)
+			}
+			items = append(items, &SequenceItemValue{elements: itemElems})
+		}
+		elem.Value = &sequencesValue{value: items}
+	case vrraw.UnsignedShort, vrraw.SignedShort, vrraw.UnsignedLong, vrraw.SignedLong:
+		ints := make([]int, 0, len(attr.Value))
+		for _, raw := range attr.Value {
+			var n int
+			if err := json.Unmarshal(raw, &n); err != nil {
+				return nil, fmt.Errorf("%w: invalid %s value %s", ErrorInvalidDICOMJSON, vr, raw)
+			}
+			ints = append(ints, n)
+		}
+		elem.Value = &intsValue{value: ints}
+	case vrraw.AttributeTag:
+		ints := make([]int, 0, 2*len(attr.Value))
+		for _, raw := range attr.Value {
+			var s string
+			if err := json.Unmarshal(raw, &s); err != nil {
+				return nil, fmt.Errorf("%w: invalid AT value %s", ErrorInvalidDICOMJSON, raw)
+			}
+			at, err := parseDICOMJSONKey(s)
+			if err != nil {
+				return nil, err
+			}
+			ints = append(ints, int(at.Group), int(at.Element))
+		}
+		elem.Value = &intsValue{value: ints}
+	case vrraw.FloatingPointSingle, vrraw.FloatingPointDouble:
+		floats := make([]float64, 0, len(attr.Value))
+		for _, raw := range attr.Value {
+			var f float64
+			if err := json.Unmarshal(raw, &f); err != nil {
+				return nil, fmt.Errorf("%w: invalid %s value %s", ErrorInvalidDICOMJSON, vr, raw)
+			}
+			floats = append(floats, f)
+		}
+		elem.Value = &floatsValue{value: floats}
+	default:
+		strs := make([]string, 0, len(attr.Value))
+		for _, raw := range attr.Value {
+			s, err := stringFromDICOMJSON(vr, raw)
+			if err != nil {
+				return nil, err
+			}
+			strs = append(strs, s)
+		}
+		if len(strs) == 0 {
+			// Mirror what the reader produces for zero-length values.
+			strs = []string{""}
+		}
+		elem.Value = &stringsValue{value: strs}
+	}
+	return elem, err
+}
+
+// stringFromDICOMJSON converts a single DICOM JSON Model value to a string.
+func stringFromDICOMJSON(vr string, raw json.RawMessage) (string, error) {
+	if string(raw) == "null" {
+		return "", nil
+	}
+	switch vr {
+	case vrraw.PersonName:
+		var pn jsonPersonName
+		if err := json.Unmarshal(raw, &pn); err != nil {
+			return "", fmt.Errorf("%w: invalid PN value %s", ErrorInvalidDICOMJSON, raw)
+		}
+		groups := []string{pn.Alphabetic, pn.Ideographic, pn.Phonetic}
+		for len(groups) > 1 && groups[len(groups)-1] == "" {
+			groups = groups[:len(groups)-1]
+		}
+		return strings.Join(groups, "="), nil
+	case vrraw.DecimalString, vrraw.IntegerString:
+		// Numbers are kept in their original textual form. Strings are also
+		// accepted, as some implementations encode DS and IS that way.
+		var n json.Number
+		if err := json.Unmarshal(raw, &n); err != nil {
+			return "", fmt.Errorf("%w: invalid %s value %s", ErrorInvalidDICOMJSON, vr, raw)
+		}
+		return n.String(), nil
+	default:
+		var s string
+		if err := json.Unmarshal(raw, &s); err != nil {
+			return "", fmt.Errorf("%w: invalid %s value %s", ErrorInvalidDICOMJSON, vr, raw)
+		}
+		return s, nil
+	}
+}
+
+// binaryValueFromBytes builds the Value of a binary Element from its little
+// endian byte encoding. ds holds the Elements decoded so far at the same
+// nesting level, which are needed to decode native PixelData.
+func binaryValueFromBytes(t tag.Tag, vr string, data []byte, ds *Dataset) (Value, error) {
+	if t == tag.PixelData {
+		r, err := dicomio.NewReader(bufio.NewReader(bytes.NewReader(data)), binary.LittleEndian, int64(len(data)))
+		if err != nil {
+			return nil, err
+		}
+		r.SetTransferSyntax(binary.LittleEndian, false)
+		vl := uint32(len(data))
+		if len(data) >= 4 && binary.LittleEndian.Uint16(data) == tag.Item.Group && binary.LittleEndian.Uint16(data[2:]) == tag.Item.Element {
+			// Encapsulated PixelData starts with the Basic Offset Table Item.
+			vl = tag.VLUndefinedLength
+		}
+		return readPixelData(r, t, vr, vl, ds, nil)
+	}
+	switch vr {
+	case vrraw.OtherByte, vrraw.OtherWord:
+		return &bytesValue{value: data}, nil
+	}
+	// Other binary VRs are represented as raw strings by the reader.
+	return &stringsValue{value: strings.Split(string(data), "\\")}, nil
+}
@@ -0,0 +1,160 @@
+package dicom
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/suyashkumar/dicom/pkg/frame"
+	"github.com/suyashkumar/dicom/pkg/tag"
+)
+
+func TestMarshalDICOMJSON(t *testing.T) {
+	ds := Dataset{Elements: []*Element{
+		mustNewElement(tag.PatientName, []string{"Yamada^Tarou=山田^太郎=やまだ^たろう", "Potter^Harry"}),
+		mustNewElement(tag.PatientID, []string{""}),
+		mustNewElement(tag.ImagePositionPatient, []string{"-1.50", "2", "007"}),
+		mustNewElement(tag.Rows, []int{2}),
+		mustNewElement(tag.FloatingPointValue, []float64{1.5}),
+		mustNewElement(tag.DimensionIndexPointer, []int{0x0020, 0x9157}),
+		mustNewElement(tag.RedPaletteColorLookupTableData, []byte{0x01, 0x02}),
+		makeSequenceElement(tag.ReferencedImageSequence, [][]*Element{
+			{mustNewElement(tag.ReferencedSOPInstanceUID, []string{"1.2.3"})},
+		}),
+	}}
+
+	got, err := MarshalDICOMJSON(ds)
+	if err != nil {
+		t.Fatalf("MarshalDICOMJSON() unexpected error: %v", err)
+	}
+	want := `{` +
+		`"00081140":{"vr":"SQ","Value":[{"00081155":{"vr":"UI","Value":["1.2.3"]}}]},` +
+		`"00100010":{"vr":"PN","Value":[{"Alphabetic":"Yamada^Tarou","Ideographic":"山田^太郎","Phonetic":"やまだ^たろう"},{"Alphabetic":"Potter^Harry"}]},` +
+		`"00100020":{"vr":"LO"},` +
+		`"00200032":{"vr":"DS","Value":[-1.50,2,7]},` +
+		`"00209165":{"vr":"AT","Value":["00209157"]},` +
+		`"00280010":{"vr":"US","Value":[2]},` +
+		`"00281201":{"vr":"OW","InlineBinary":"AQI="},` +
+		`"0040A161":{"vr":"FD","Value":[1.5]}` +
+		`}`
+	if string(got) != want {
+		t.Errorf("MarshalDICOMJSON() unexpected output.\ngot:  %s\nwant: %s", got, want)
+	}
+}
+
+func TestUnmarshalDICOMJSON(t *testing.T) {
+	in := `{
+		"00100010": {"vr": "PN", "Value": [{"Alphabetic": "Potter^Harry", "Phonetic": "pot-er"}, null]},
+		"00200013": {"vr": "IS", "Value": ["5"]},
+		"00080020": {"vr": "DA"},
+		"00280010": {"vr": "US", "Value": [2]},
+		"00280011": {"vr": "US", "Value": [1]},
+		"00280100": {"vr": "US", "Value": [8]},
+		"00280002": {"vr": "US", "Value": [1]},
+		"7FE00010": {"vr": "OW", "InlineBinary": "AQI="},
+		"00091001": {"vr": "UN", "BulkDataURI": "https://example.com/bulk/1"}
+	}`
+	got, err := UnmarshalDICOMJSON([]byte(in))
+	if err != nil {
+		t.Fatalf("UnmarshalDICOMJSON() unexpected error: %v", err)
+	}
+	want := Dataset{Elements: []*Element{
+		mustNewElement(tag.StudyDate, []string{""}),
+		mustNewElement(tag.PatientName, []string{"Potter^Harry==pot-er", ""}),
+		mustNewElement(tag.InstanceNumber, []string{"5"}),
+		mustNewElement(tag.SamplesPerPixel, []int{1}),
+		mustNewElement(tag.Rows, []int{2}),
+		mustNewElement(tag.Columns, []int{1}),
+		mustNewElement(tag.BitsAllocated, []int{8}),
+		mustNewElement(tag.PixelData, PixelDataInfo{Frames: []frame.Frame{
+			{NativeData: frame.NativeFrame{BitsPerSample: 8, Rows: 2, Cols: 1, Data: [][]int{{1}, {2}}}},
+		}}),
+	}}
+	if diff := cmp.Diff(want, got, cmp.AllowUnexported(allValues...)); diff != "" {
+		t.Errorf("UnmarshalDICOMJSON() unexpected dataset. diff: %v", diff)
+	}
+}
+
+func TestUnmarshalDICOMJSON_BulkDataResolver(t *testing.T) {
+	in := `[{"00091001": {"vr": "OB", "BulkDataURI": "bulk/1"}}]`
+	got, err := UnmarshalDICOMJSONArray([]byte(in), WithBulkDataResolver(func(uri string) ([]byte, error) {
+		if uri != "bulk/1" {
+			t.Errorf("WithBulkDataResolver called with unexpected uri: %v", uri)
+		}
+		return []byte{1, 2, 3, 4}, nil
+	}))
+	if err != nil {
+		t.Fatalf("UnmarshalDICOMJSONArray() unexpected error: %v", err)
+	}
+	if len(got) != 1 || len(got[0].Elements) != 1 {
+		t.Fatalf("UnmarshalDICOMJSONArray() unexpected datasets: %v", got)
+	}
+	if diff := cmp.Diff([]byte{1, 2, 3, 4}, MustGetBytes(got[0].Elements[0].Value)); diff != "" {
+		t.Errorf("UnmarshalDICOMJSONArray() unexpected bulk data. diff: %v", diff)
+	}
+}
+
+func TestUnmarshalDICOMJSON_Errors(t *testing.T) {
+	cases := []string{
+		`[]`,
+		`{"0010": {"vr": "PN"}}`,
+		`{"00100010": {}}`,
+		`{"00280010": {"vr": "US", "Value": ["two"]}}`,
+		`{"00081140": {"vr": "SQ", "Value": [{"bad": {"vr": "UI"}}]}}`,
+	}
+	for _, in := range cases {
+		if _, err := UnmarshalDICOMJSON([]byte(in)); !errors.Is(err, ErrorInvalidDICOMJSON) {
+			t.Errorf("UnmarshalDICOMJSON(%s) unexpected error. got: %v, want: %v", in, err, ErrorInvalidDICOMJSON)
+		}
+	}
+}
+
+func TestDICOMJSON_RoundTripTestdata(t *testing.T) {
+	files, err := ioutil.ReadDir("./testdata")
+	if err != nil {
+		t.Fatalf("unable to read testdata/: %v", err)
+	}
+	for _, f := range files {
+		if f.IsDir() || !strings.HasSuffix(f.Name(), ".dcm") {
+			continue
+		}
+		t.Run(f.Name(), func(t *testing.T) {
+			ds, err := ParseFile("./testdata/"+f.Name(), nil)
+			if err != nil {
+				t.Fatalf("ParseFile(%s) unexpected error: %v", f.Name(), err)
+			}
+			data, err := MarshalDICOMJSON(ds)
+			if err != nil {
+				t.Fatalf("MarshalDICOMJSON(%s) unexpected error: %v", f.Name(), err)
+			}
+			if !json.Valid(data) {
+				t.Fatalf("MarshalDICOMJSON(%s) produced invalid JSON", f.Name())
+			}
+			got, err := UnmarshalDICOMJSON(data)
+			if err != nil {
+				t.Fatalf("UnmarshalDICOMJSON(%s) unexpected error: %v", f.Name(), err)
+			}
+			isPixelData := func(e *Element) bool { return e.Tag == tag.PixelData }
+			if diff := cmp.Diff(ds, got,
+				cmp.AllowUnexported(allValues...),
+				cmpopts.IgnoreFields(Element{}, "ValueLength"),
+				cmpopts.IgnoreSliceElements(isPixelData),
+				cmpopts.SortSlices(func(x, y *Element) bool { return x.Tag.Compare(y.Tag) < 0 }),
+			); diff != "" {
+				t.Errorf("DICOM JSON round trip of %s unexpected diff: %v", f.Name(), diff)
+			}
+			// cmp is very slow on large frames, so compare PixelData directly.
+			wantPixelData, _ := ds.FindElementByTag(tag.PixelData)
+			gotPixelData, _ := got.FindElementByTag(tag.PixelData)
+			if !reflect.DeepEqual(wantPixelData.Value.GetValue(), gotPixelData.Value.GetValue()) {
+				t.Errorf("DICOM JSON round trip of %s produced different PixelData", f.Name())
+			}
+		})
+	}
+}
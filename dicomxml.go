@@ -0,0 +1,505 @@
+package dicom
+
+import (
+	"encoding/base64"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/suyashkumar/dicom/pkg/personname"
+	"github.com/suyashkumar/dicom/pkg/tag"
+	"github.com/suyashkumar/dicom/pkg/vrraw"
+)
+
+// ErrorInvalidDICOMXML indicates that the input to UnmarshalDICOMXML does not
+// follow the Native DICOM Model.
+var ErrorInvalidDICOMXML = errors.New("invalid Native DICOM Model XML")
+
+// The following types mirror the Native DICOM Model schema, see
+// http://dicom.nema.org/medical/dicom/current/output/chtml/part19/chapter_A.html.
+
+type xmlNativeDicomModel struct {
+	XMLName    xml.Name       `xml:"NativeDicomModel"`
+	Attributes []xmlAttribute `xml:"DicomAttribute"`
+}
+
+type xmlAttribute struct {
+	Tag            string          `xml:"tag,attr"`
+	VR             string          `xml:"vr,attr,omitempty"`
+	Keyword        string          `xml:"keyword,attr,omitempty"`
+	PrivateCreator string          `xml:"privateCreator,attr,omitempty"`
+	Values         []xmlValue      `xml:"Value"`
+	PersonNames    []xmlPersonName `xml:"PersonName"`
+	Items          []xmlItem       `xml:"Item"`
+	InlineBinary   string          `xml:"InlineBinary,omitempty"`
+	BulkData       *xmlBulkData    `xml:"BulkData"`
+}
+
+type xmlValue struct {
+	Number int    `xml:"number,attr"`
+	Value  string `xml:",chardata"`
+}
+
+type xmlItem struct {
+	Number     int            `xml:"number,attr"`
+	Attributes []xmlAttribute `xml:"DicomAttribute"`
+}
+
+type xmlPersonName struct {
+	Number      int                `xml:"number,attr"`
+	Alphabetic  *xmlNameComponents `xml:"Alphabetic"`
+	Ideographic *xmlNameComponents `xml:"Ideographic"`
+	Phonetic    *xmlNameComponents `xml:"Phonetic"`
+}
+
+type xmlNameComponents struct {
+	FamilyName string `xml:"FamilyName,omitempty"`
+	GivenName  string `xml:"GivenName,omitempty"`
+	MiddleName string `xml:"MiddleName,omitempty"`
+	NamePrefix string `xml:"NamePrefix,omitempty"`
+	NameSuffix string `xml:"NameSuffix,omitempty"`
+}
+
+type xmlBulkData struct {
+	URI  string `xml:"uri,attr,omitempty"`
+	UUID string `xml:"uuid,attr,omitempty"`
+}
+
+// MarshalDICOMXML encodes the Dataset using the Native DICOM Model defined in
+// PS3.19 Annex A, as used by DICOMweb application/dicom+xml responses. The
+// output includes the standard XML header.
+//
+// Binary values (including PixelData) are encoded as InlineBinary, unless
+// WithBulkDataURIs is provided.
+func MarshalDICOMXML(ds Dataset, opts ...BulkDataOption) ([]byte, error) {
+	optSet := toBulkDataOptSet(opts...)
+	model, err := datasetToXMLModel(ds, *optSet)
+	if err != nil {
+		return nil, err
+	}
+	data, err := xml.MarshalIndent(model, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(xml.Header), data...), nil
+}
+
+// UnmarshalDICOMXML decodes a Dataset encoded using the Native DICOM Model
+// defined in PS3.19 Annex A.
+func UnmarshalDICOMXML(data []byte, opts ...BulkDataOption) (Dataset, error) {
+	optSet := toBulkDataOptSet(opts...)
+	var model xmlNativeDicomModel
+	if err := xml.Unmarshal(data, &model); err != nil {
+		return Dataset{}, fmt.Errorf("%w: %v", ErrorInvalidDICOMXML, err)
+	}
+	elems, err := elementsFromXML(model.Attributes, *optSet)
+	if err != nil {
+		return Dataset{}, err
+	}
+	return Dataset{Elements: elems}, nil
+}
+
+// MarshalXML implements xml.Marshaler, encoding this Dataset as a
+// NativeDicomModel element. See MarshalDICOMXML for more options.
+func (d *Dataset) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	model, err := datasetToXMLModel(*d, bulkDataOptSet{})
+	if err != nil {
+		return err
+	}
+	return e.Encode(model)
+}
+
+// UnmarshalXML implements xml.Unmarshaler, decoding a NativeDicomModel element
+// into this Dataset. Attributes encoded as BulkData references are skipped; use
+// UnmarshalDICOMXML with WithBulkDataResolver to fetch them.
+func (d *Dataset) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) error {
+	var model xmlNativeDicomModel
+	if err := dec.DecodeElement(&model, &start); err != nil {
+		return fmt.Errorf("%w: %v", ErrorInvalidDICOMXML, err)
+	}
+	elems, err := elementsFromXML(model.Attributes, bulkDataOptSet{})
+	if err != nil {
+		return err
+	}
+	d.Elements = elems
+	return nil
+}
+
+// attrs returns the attributes of the NativeDicomModel root element.
+func (m *xmlNativeDicomModel) attrs() []xml.Attr {
+	// xml:space="preserve" is fixed by the PS3.19 schema.
+	return []xml.Attr{{Name: xml.Name{Local: "xml:space"}, Value: "preserve"}}
+}
+
+func datasetToXMLModel(ds Dataset, opts bulkDataOptSet) (*xmlNativeDicomModel, error) {
+	attrs, err := elementsToXML(ds.Elements, nil, opts)
+	if err != nil {
+		return nil, err
+	}
+	return &xmlNativeDicomModel{Attributes: attrs}, nil
+}
+
+// MarshalXML encodes the model with the fixed xml:space attribute.
+func (m *xmlNativeDicomModel) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name = xml.Name{Local: "NativeDicomModel"}
+	start.Attr = m.attrs()
+	return e.EncodeElement(struct {
+		Attributes []xmlAttribute `xml:"DicomAttribute"`
+	}{m.Attributes}, start)
+}
+
+func elementsToXML(elems []*Element, parents []tag.Tag, opts bulkDataOptSet) ([]xmlAttribute, error) {
+	sorted := make([]*Element, len(elems))
+	copy(sorted, elems)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].Tag.Compare(sorted[j].Tag) < 0 })
+
+	creators := privateCreators(sorted)
+	attrs := make([]xmlAttribute, 0, len(sorted))
+	for _, elem := range sorted {
+		attr, err := elementToXML(elem, parents, opts)
+		if err != nil {
+			return nil, fmt.Errorf("element %s: %w", tag.DebugString(elem.Tag), err)
+		}
+		if creatorTag, ok := tag.PrivateCreatorFor(elem.Tag); ok {
+			attr.PrivateCreator = creators[creatorTag]
+			if info, err := tag.FindPrivate(attr.PrivateCreator, elem.Tag); err == nil {
+				attr.Keyword = info.Keyword
+			}
+		}
+		attrs = append(attrs, attr)
+	}
+	return attrs, nil
+}
+
+// privateCreators returns the Private Creator values in elems, keyed by the
+// tag of the Private Creator Element (gggg,00xx).
+func privateCreators(elems []*Element) map[tag.Tag]string {
+	creators := make(map[tag.Tag]string)
+	for _, elem := range elems {
+		if !tag.IsPrivateCreator(elem.Tag) {
+			continue
+		}
+		if elem.Value != nil && elem.Value.ValueType() == Strings {
+			if strs := MustGetStrings(elem.Value); len(strs) > 0 {
+				creators[elem.Tag] = strings.TrimSpace(strs[0])
+			}
+		}
+	}
+	return creators
+}
+
+func elementToXML(elem *Element, parents []tag.Tag, opts bulkDataOptSet) (xmlAttribute, error) {
+	vr := elementVR(elem)
+	if vr == "" {
+		vr = vrraw.Unknown
+	}
+	attr := xmlAttribute{Tag: dicomJSONKey(elem.Tag), VR: vr}
+	if info, err := tag.Find(elem.Tag); err == nil {
+		attr.Keyword = info.Keyword
+	}
+	if elem.Value == nil {
+		return attr, nil
+	}
+
+	if isBinaryVR(vr) || elem.Value.ValueType() == PixelData || elem.Value.ValueType() == Bytes {
+		if opts.bulkDataURIFor != nil {
+			if uri, ok := opts.bulkDataURIFor(elem, parents); ok {
+				attr.BulkData = &xmlBulkData{URI: uri}
+				return attr, nil
+			}
+		}
+		data, err := binaryValueBytes(elem, vr)
+		if err != nil {
+			return attr, err
+		}
+		attr.InlineBinary = base64.StdEncoding.EncodeToString(data)
+		return attr, nil
+	}
+
+	switch elem.Value.ValueType() {
+	case Sequences:
+		for i, item := range elem.Value.GetValue().([]*SequenceItemValue) {
+			itemAttrs, err := elementsToXML(item.elements, append(parents[:len(parents):len(parents)], elem.Tag), opts)
+			if err != nil {
+				return attr, err
+			}
+			attr.Items = append(attr.Items, xmlItem{Number: i + 1, Attributes: itemAttrs})
+		}
+	case Ints:
+		ints := MustGetInts(elem.Value)
+		if vr == vrraw.AttributeTag {
+			if len(ints)%2 != 0 {
+				return attr, fmt.Errorf("AT value has an odd number of components: %v", ints)
+			}
+			for i := 0; i < len(ints); i += 2 {
+				attr.Values = append(attr.Values, xmlValue{Number: i/2 + 1, Value: fmt.Sprintf("%04X%04X", ints[i], ints[i+1])})
+			}
+			break
+		}
+		for i, v := range ints {
+			attr.Values = append(attr.Values, xmlValue{Number: i + 1, Value: strconv.Itoa(v)})
+		}
+	case Floats:
+		for i, v := range MustGetFloats(elem.Value) {
+			var s string
+			switch {
+			case math.IsNaN(v):
+				s = "NaN"
+			case math.IsInf(v, 1):
+				s = "INF"
+			case math.IsInf(v, -1):
+				s = "-INF"
+			default:
+				s = strconv.FormatFloat(v, 'g', -1, 64)
+			}
+			attr.Values = append(attr.Values, xmlValue{Number: i + 1, Value: s})
+		}
+	case Strings:
+		for i, s := range MustGetStrings(elem.Value) {
+			if s == "" {
+				// Empty values are omitted; their position is kept by numbering.
+				continue
+			}
+			if vr == vrraw.PersonName {
+				pn, err := personNameToXML(i+1, s)
+				if err != nil {
+					return attr, err
+				}
+				attr.PersonNames = append(attr.PersonNames, pn)
+				continue
+			}
+			attr.Values = append(attr.Values, xmlValue{Number: i + 1, Value: s})
+		}
+	default:
+		return attr, fmt.Errorf("unsupported ValueType %v for VR %s", elem.Value.ValueType(), vr)
+	}
+	return attr, nil
+}
+
+func personNameToXML(number int, s string) (xmlPersonName, error) {
+	info, err := personname.Parse(s)
+	if err != nil {
+		return xmlPersonName{}, err
+	}
+	return xmlPersonName{
+		Number:      number,
+		Alphabetic:  nameComponentsToXML(info.Alphabetic),
+		Ideographic: nameComponentsToXML(info.Ideographic),
+		Phonetic:    nameComponentsToXML(info.Phonetic),
+	}, nil
+}
+
+func nameComponentsToXML(g personname.GroupInfo) *xmlNameComponents {
+	if g.IsEmpty() {
+		return nil
+	}
+	return &xmlNameComponents{
+		FamilyName: g.FamilyName,
+		GivenName:  g.GivenName,
+		MiddleName: g.MiddleName,
+		NamePrefix: g.NamePrefix,
+		NameSuffix: g.NameSuffix,
+	}
+}
+
+func personNameFromXML(pn xmlPersonName) (string, error) {
+	info := personname.Info{
+		Alphabetic:  nameComponentsFromXML(pn.Alphabetic),
+		Ideographic: nameComponentsFromXML(pn.Ideographic),
+		Phonetic:    nameComponentsFromXML(pn.Phonetic),
+	}
+	return info.DCM()
+}
+
+func nameComponentsFromXML(c *xmlNameComponents) personname.GroupInfo {
+	if c == nil {
+		return personname.GroupInfo{}
+	}
+	return personname.GroupInfo{
+		FamilyName: c.FamilyName,
+		GivenName:  c.GivenName,
+		MiddleName: c.MiddleName,
+		NamePrefix: c.NamePrefix,
+		NameSuffix: c.NameSuffix,
+	}
+}
+
+func elementsFromXML(attrs []xmlAttribute, opts bulkDataOptSet) ([]*Element, error) {
+	sorted := make([]xmlAttribute, len(attrs))
+	copy(sorted, attrs)
+	tags := make([]tag.Tag, len(sorted))
+	for i, attr := range sorted {
+		t, err := parseDICOMJSONKey(attr.Tag)
+		if err != nil {
+			return nil, fmt.Errorf("%w: invalid tag %q", ErrorInvalidDICOMXML, attr.Tag)
+		}
+		tags[i] = t
+	}
+	// Decode in tag order, so that elements needed to decode native PixelData
+	// (Rows, Columns, etc) are available by the time it is reached.
+	idx := make([]int, len(sorted))
+	for i := range idx {
+		idx[i] = i
+	}
+	sort.SliceStable(idx, func(i, j int) bool { return tags[idx[i]].Compare(tags[idx[j]]) < 0 })
+
+	ds := &Dataset{Elements: make([]*Element, 0, len(sorted))}
+	for _, i := range idx {
+		elem, err := elementFromXML(tags[i], sorted[i], ds, opts)
+		if err != nil {
+			return nil, fmt.Errorf("element %s: %w", tag.DebugString(tags[i]), err)
+		}
+		if elem != nil {
+			ds.Elements = append(ds.Elements, elem)
+		}
+	}
+	return ds.Elements, nil
+}
+
+func elementFromXML(t tag.Tag, attr xmlAttribute, ds *Dataset, opts bulkDataOptSet) (*Element, error) {
+	vr := attr.VR
+	if vr == "" {
+		if info, err := tag.Find(t); err == nil {
+			vr = info.VR
+		} else {
+			vr = vrraw.Unknown
+		}
+	}
+	if len(vr) != 2 {
+		return nil, fmt.Errorf("%w: invalid vr %q", ErrorInvalidDICOMXML, vr)
+	}
+	elem := &Element{
+		Tag:                    t,
+		ValueRepresentation:    tag.GetVRKind(t, vr),
+		RawValueRepresentation: vr,
+	}
+
+	if attr.BulkData != nil || attr.InlineBinary != "" || (isBinaryVR(vr) && len(attr.Values) == 0) {
+		var data []byte
+		var err error
+		if attr.BulkData != nil {
+			if opts.resolveBulkData == nil {
+				return nil, nil
+			}
+			data, err = opts.resolveBulkData(attr.BulkData.URI)
+		} else {
+			data, err = base64.StdEncoding.DecodeString(strings.TrimSpace(attr.InlineBinary))
+		}
+		if err != nil {
+			return nil, err
+		}
+		elem.Value, err = binaryValueFromBytes(t, vr, data, ds)
+		return elem, err
+	}
+
+	if vr == vrraw.Sequence {
+		items := make([]*SequenceItemValue, len(attr.Items))
+		for _, item := range attr.Items {
+			if item.Number < 1 || item.Number > len(items) || items[item.Number-1] != nil {
+				return nil, fmt.Errorf("%w: invalid Item number %d", ErrorInvalidDICOMXML, item.Number)
+			}
+			itemElems, err := elementsFromXML(item.Attributes, opts)
+			if err != nil {
+				return nil, fmt.Errorf("item %d: %w", item.Number, err)
+			}
+			items[item.Number-1] = &SequenceItemValue{elements: itemElems}
+		}
+		if len(items) == 0 {
+			items = nil
+		}
+		elem.Value = &sequencesValue{value: items}
+		return elem, nil
+	}
+
+	// Gather the (possibly sparse) numbered values in order.
+	raw := make(map[int]string)
+	maxNumber := 0
+	addValue := func(number int, s string) error {
+		if number < 1 {
+			return fmt.Errorf("%w: invalid value number %d", ErrorInvalidDICOMXML, number)
+		}
+		if _, ok := raw[number]; ok {
+			return fmt.Errorf("%w: duplicate value number %d", ErrorInvalidDICOMXML, number)
+		}
+		raw[number] = s
+		if number > maxNumber {
+			maxNumber = number
+		}
+		return nil
+	}
+	for _, v := range attr.Values {
+		if err := addValue(v.Number, v.Value); err != nil {
+			return nil, err
+		}
+	}
+	for _, pn := range attr.PersonNames {
+		s, err := personNameFromXML(pn)
+		if err != nil {
+			return nil, err
+		}
+		if err := addValue(pn.Number, s); err != nil {
+			return nil, err
+		}
+	}
+	strs := make([]string, maxNumber)
+	for number, s := range raw {
+		strs[number-1] = s
+	}
+
+	switch vr {
+	case vrraw.UnsignedShort, vrraw.SignedShort, vrraw.UnsignedLong, vrraw.SignedLong:
+		ints := make([]int, len(strs))
+		for i, s := range strs {
+			n, err := strconv.Atoi(strings.TrimSpace(s))
+			if err != nil {
+				return nil, fmt.Errorf("%w: invalid %s value %q", ErrorInvalidDICOMXML, vr, s)
+			}
+			ints[i] = n
+		}
+		elem.Value = &intsValue{value: ints}
+	case vrraw.AttributeTag:
+		ints := make([]int, 0, 2*len(strs))
+		for _, s := range strs {
+			at, err := parseDICOMJSONKey(strings.TrimSpace(s))
+			if err != nil {
+				return nil, fmt.Errorf("%w: invalid AT value %q", ErrorInvalidDICOMXML, s)
+			}
+			ints = append(ints, int(at.Group), int(at.Element))
+		}
+		elem.Value = &intsValue{value: ints}
+	case vrraw.FloatingPointSingle, vrraw.FloatingPointDouble:
+		floats := make([]float64, len(strs))
+		for i, s := range strs {
+			f, err := parseXMLFloat(strings.TrimSpace(s))
+			if err != nil {
+				return nil, fmt.Errorf("%w: invalid %s value %q", ErrorInvalidDICOMXML, vr, s)
+			}
+			floats[i] = f
+		}
+		elem.Value = &floatsValue{value: floats}
+	default:
+		if len(strs) == 0 {
+			// Mirror what the reader produces for zero-length values.
+			strs = []string{""}
+		}
+		elem.Value = &stringsValue{value: strs}
+	}
+	return elem, nil
+}
+
+// parseXMLFloat parses an xs:float or xs:double value.
+func parseXMLFloat(s string) (float64, error) {
+	switch s {
+	case "INF":
+		return math.Inf(1), nil
+	case "-INF":
+		return math.Inf(-1), nil
+	case "NaN":
+		return math.NaN(), nil
+	}
+	return strconv.ParseFloat(s, 64)
+}
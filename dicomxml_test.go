@@ -0,0 +1,218 @@
+package dicom
+
+import (
+	"encoding/xml"
+	"errors"
+	"io/ioutil"
+	"math"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/suyashkumar/dicom/pkg/tag"
+)
+
+func TestMarshalDICOMXML(t *testing.T) {
+	ds := Dataset{Elements: []*Element{
+		mustNewElement(tag.PatientName, []string{"Yamada^Tarou=山田^太郎", "Potter^Harry"}),
+		mustNewElement(tag.PatientID, []string{""}),
+		mustNewElement(tag.Rows, []int{2}),
+		mustNewElement(tag.DimensionIndexPointer, []int{0x0020, 0x9157}),
+		mustNewElement(tag.RedPaletteColorLookupTableData, []byte{0x01, 0x02}),
+		{Tag: tag.Tag{Group: 0x0009, Element: 0x0010}, RawValueRepresentation: "LO", Value: &stringsValue{value: []string{"ACME"}}},
+		{Tag: tag.Tag{Group: 0x0009, Element: 0x1001}, RawValueRepresentation: "LO", Value: &stringsValue{value: []string{"private"}}},
+		makeSequenceElement(tag.ReferencedImageSequence, [][]*Element{
+			{mustNewElement(tag.ReferencedSOPInstanceUID, []string{"1.2.3"})},
+		}),
+	}}
+
+	got, err := MarshalDICOMXML(ds, WithBulkDataURIs(func(elem *Element, parents []tag.Tag) (string, bool) {
+		return "bulk/1", elem.Tag == tag.RedPaletteColorLookupTableData
+	}))
+	if err != nil {
+		t.Fatalf("MarshalDICOMXML() unexpected error: %v", err)
+	}
+	want := xml.Header + `<NativeDicomModel xml:space="preserve">
+  <DicomAttribute tag="00081140" vr="SQ" keyword="ReferencedImageSequence">
+    <Item number="1">
+      <DicomAttribute tag="00081155" vr="UI" keyword="ReferencedSOPInstanceUID">
+        <Value number="1">1.2.3</Value>
+      </DicomAttribute>
+    </Item>
+  </DicomAttribute>
+  <DicomAttribute tag="00090010" vr="LO">
+    <Value number="1">ACME</Value>
+  </DicomAttribute>
+  <DicomAttribute tag="00091001" vr="LO" privateCreator="ACME">
+    <Value number="1">private</Value>
+  </DicomAttribute>
+  <DicomAttribute tag="00100010" vr="PN" keyword="PatientName">
+    <PersonName number="1">
+      <Alphabetic>
+        <FamilyName>Yamada</FamilyName>
+        <GivenName>Tarou</GivenName>
+      </Alphabetic>
+      <Ideographic>
+        <FamilyName>山田</FamilyName>
+        <GivenName>太郎</GivenName>
+      </Ideographic>
+    </PersonName>
+    <PersonName number="2">
+      <Alphabetic>
+        <FamilyName>Potter</FamilyName>
+        <GivenName>Harry</GivenName>
+      </Alphabetic>
+    </PersonName>
+  </DicomAttribute>
+  <DicomAttribute tag="00100020" vr="LO" keyword="PatientID"></DicomAttribute>
+  <DicomAttribute tag="00209165" vr="AT" keyword="DimensionIndexPointer">
+    <Value number="1">00209157</Value>
+  </DicomAttribute>
+  <DicomAttribute tag="00280010" vr="US" keyword="Rows">
+    <Value number="1">2</Value>
+  </DicomAttribute>
+  <DicomAttribute tag="00281201" vr="OW" keyword="RedPaletteColorLookupTableData">
+    <BulkData uri="bulk/1"></BulkData>
+  </DicomAttribute>
+</NativeDicomModel>`
+	if diff := cmp.Diff(want, string(got)); diff != "" {
+		t.Errorf("MarshalDICOMXML() unexpected output. diff: %v", diff)
+	}
+}
+
+func TestUnmarshalDICOMXML(t *testing.T) {
+	in := `<?xml version="1.0" encoding="UTF-8"?>
+<NativeDicomModel xml:space="preserve">
+  <DicomAttribute tag="00100010" vr="PN" keyword="PatientName">
+    <PersonName number="1">
+      <Alphabetic><FamilyName>Potter</FamilyName><GivenName>Harry</GivenName></Alphabetic>
+      <Phonetic><FamilyName>pot-er</FamilyName></Phonetic>
+    </PersonName>
+  </DicomAttribute>
+  <DicomAttribute tag="00080008" vr="CS" keyword="ImageType">
+    <Value number="1">ORIGINAL</Value>
+    <Value number="3">AXIAL</Value>
+  </DicomAttribute>
+  <DicomAttribute tag="00209165" vr="AT"><Value number="1">00209157</Value></DicomAttribute>
+  <DicomAttribute tag="0040A161" vr="FD"><Value number="1">-INF</Value></DicomAttribute>
+  <DicomAttribute tag="00081140" vr="SQ">
+    <Item number="1">
+      <DicomAttribute tag="00081155" vr="UI"><Value number="1">1.2.3</Value></DicomAttribute>
+    </Item>
+  </DicomAttribute>
+  <DicomAttribute tag="00091001" vr="OB"><BulkData uri="bulk/1"/></DicomAttribute>
+</NativeDicomModel>`
+	got, err := UnmarshalDICOMXML([]byte(in))
+	if err != nil {
+		t.Fatalf("UnmarshalDICOMXML() unexpected error: %v", err)
+	}
+	want := Dataset{Elements: []*Element{
+		mustNewElement(tag.ImageType, []string{"ORIGINAL", "", "AXIAL"}),
+		makeSequenceElement(tag.ReferencedImageSequence, [][]*Element{
+			{mustNewElement(tag.ReferencedSOPInstanceUID, []string{"1.2.3"})},
+		}),
+		mustNewElement(tag.PatientName, []string{"Potter^Harry==pot-er"}),
+		mustNewElement(tag.DimensionIndexPointer, []int{0x0020, 0x9157}),
+		mustNewElement(tag.FloatingPointValue, []float64{math.Inf(-1)}),
+	}}
+	if diff := cmp.Diff(want, got, cmp.AllowUnexported(allValues...)); diff != "" {
+		t.Errorf("UnmarshalDICOMXML() unexpected dataset. diff: %v", diff)
+	}
+}
+
+func TestUnmarshalDICOMXML_BulkDataResolver(t *testing.T) {
+	in := `<NativeDicomModel><DicomAttribute tag="00091001" vr="OB"><BulkData uri="bulk/1"/></DicomAttribute></NativeDicomModel>`
+	got, err := UnmarshalDICOMXML([]byte(in), WithBulkDataResolver(func(uri string) ([]byte, error) {
+		if uri != "bulk/1" {
+			t.Errorf("WithBulkDataResolver called with unexpected uri: %v", uri)
+		}
+		return []byte{1, 2, 3, 4}, nil
+	}))
+	if err != nil {
+		t.Fatalf("UnmarshalDICOMXML() unexpected error: %v", err)
+	}
+	if len(got.Elements) != 1 {
+		t.Fatalf("UnmarshalDICOMXML() unexpected dataset: %v", got)
+	}
+	if diff := cmp.Diff([]byte{1, 2, 3, 4}, MustGetBytes(got.Elements[0].Value)); diff != "" {
+		t.Errorf("UnmarshalDICOMXML() unexpected bulk data. diff: %v", diff)
+	}
+}
+
+func TestUnmarshalDICOMXML_Errors(t *testing.T) {
+	cases := []string{
+		`<NativeDicomModel`,
+		`<NativeDicomModel><DicomAttribute tag="0010" vr="PN"/></NativeDicomModel>`,
+		`<NativeDicomModel><DicomAttribute tag="00280010" vr="US"><Value number="1">two</Value></DicomAttribute></NativeDicomModel>`,
+		`<NativeDicomModel><DicomAttribute tag="00280010" vr="US"><Value number="0">2</Value></DicomAttribute></NativeDicomModel>`,
+		`<NativeDicomModel><DicomAttribute tag="00081140" vr="SQ"><Item number="2"></Item></DicomAttribute></NativeDicomModel>`,
+	}
+	for _, in := range cases {
+		if _, err := UnmarshalDICOMXML([]byte(in)); !errors.Is(err, ErrorInvalidDICOMXML) {
+			t.Errorf("UnmarshalDICOMXML(%s) unexpected error. got: %v, want: %v", in, err, ErrorInvalidDICOMXML)
+		}
+	}
+}
+
+func TestDataset_XMLMarshaler(t *testing.T) {
+	type wrapper struct {
+		XMLName xml.Name `xml:"Response"`
+		Dataset *Dataset `xml:"NativeDicomModel"`
+	}
+	ds := Dataset{Elements: []*Element{mustNewElement(tag.PatientID, []string{"1234"})}}
+	data, err := xml.Marshal(wrapper{Dataset: &ds})
+	if err != nil {
+		t.Fatalf("xml.Marshal() unexpected error: %v", err)
+	}
+	var got wrapper
+	if err := xml.Unmarshal(data, &got); err != nil {
+		t.Fatalf("xml.Unmarshal(%s) unexpected error: %v", data, err)
+	}
+	if diff := cmp.Diff(ds, *got.Dataset, cmp.AllowUnexported(allValues...)); diff != "" {
+		t.Errorf("Dataset XML round trip unexpected diff: %v", diff)
+	}
+}
+
+func TestDICOMXML_RoundTripTestdata(t *testing.T) {
+	files, err := ioutil.ReadDir("./testdata")
+	if err != nil {
+		t.Fatalf("unable to read testdata/: %v", err)
+	}
+	for _, f := range files {
+		if f.IsDir() || !strings.HasSuffix(f.Name(), ".dcm") {
+			continue
+		}
+		t.Run(f.Name(), func(t *testing.T) {
+			ds, err := ParseFile("./testdata/"+f.Name(), nil)
+			if err != nil {
+				t.Fatalf("ParseFile(%s) unexpected error: %v", f.Name(), err)
+			}
+			data, err := MarshalDICOMXML(ds)
+			if err != nil {
+				t.Fatalf("MarshalDICOMXML(%s) unexpected error: %v", f.Name(), err)
+			}
+			got, err := UnmarshalDICOMXML(data)
+			if err != nil {
+				t.Fatalf("UnmarshalDICOMXML(%s) unexpected error: %v", f.Name(), err)
+			}
+			isPixelData := func(e *Element) bool { return e.Tag == tag.PixelData }
+			if diff := cmp.Diff(ds, got,
+				cmp.AllowUnexported(allValues...),
+				cmpopts.IgnoreFields(Element{}, "ValueLength"),
+				cmpopts.IgnoreSliceElements(isPixelData),
+				cmpopts.SortSlices(func(x, y *Element) bool { return x.Tag.Compare(y.Tag) < 0 }),
+			); diff != "" {
+				t.Errorf("DICOM XML round trip of %s unexpected diff: %v", f.Name(), diff)
+			}
+			// cmp is very slow on large frames, so compare PixelData directly.
+			wantPixelData, _ := ds.FindElementByTag(tag.PixelData)
+			gotPixelData, _ := got.FindElementByTag(tag.PixelData)
+			if !reflect.DeepEqual(wantPixelData.Value.GetValue(), gotPixelData.Value.GetValue()) {
+				t.Errorf("DICOM XML round trip of %s produced different PixelData", f.Name())
+			}
+		})
+	}
+}
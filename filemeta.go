@@ -0,0 +1,90 @@
+package dicom
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/suyashkumar/dicom/pkg/tag"
+)
+
+const (
+	// ImplementationClassUID identifies this library in the File Meta
+	// Information written under GenerateFileMeta (PS3.7 D.3.3.2).
+	ImplementationClassUID = "2.25.133540201475839023073689009969917191675"
+	// ImplementationVersionName is written along with ImplementationClassUID
+	// under GenerateFileMeta.
+	ImplementationVersionName = "GO_DICOM_1"
+)
+
+// ErrorInconsistentFileMeta indicates that the File Meta Information of a
+// Dataset contradicts the Dataset it describes, e.g. a
+// MediaStorageSOPInstanceUID that is not its SOPInstanceUID.
+var ErrorInconsistentFileMeta = errors.New("file meta information is inconsistent with the dataset")
+
+// fileMetaVersion is the only FileMetaInformationVersion defined by PS3.10
+// 7.1.
+var fileMetaVersion = []byte{0x00, 0x01}
+
+// generateFileMeta returns the File Meta Information elements of metaElems
+// completed with those derived from ds: FileMetaInformationVersion,
+// MediaStorageSOPClassUID and MediaStorageSOPInstanceUID (from SOPClassUID
+// and SOPInstanceUID), ImplementationClassUID and ImplementationVersionName.
+// Elements already present are kept after checking that they are consistent
+// with ds. The result is sorted by tag.
+func generateFileMeta(ds *Dataset, metaElems []*Element) ([]*Element, error) {
+	elems := append([]*Element(nil), metaElems...)
+	add := func(t tag.Tag, data interface{}) {
+		if _, err := ds.FindElementByTag(t); err == ErrorElementNotFound {
+			elems = append(elems, mustNewElement(t, data))
+		}
+	}
+
+	if version, err := ds.FindElementByTag(tag.FileMetaInformationVersion); err == nil {
+		if b, ok := version.Value.GetValue().([]byte); !ok || len(b) != len(fileMetaVersion) {
+			return nil, fmt.Errorf("%w: FileMetaInformationVersion must be 2 bytes, got: %v", ErrorInconsistentFileMeta, version.Value)
+		}
+	}
+	add(tag.FileMetaInformationVersion, fileMetaVersion)
+
+	for _, uids := range []struct{ meta, source tag.Tag }{
+		{meta: tag.MediaStorageSOPClassUID, source: tag.SOPClassUID},
+		{meta: tag.MediaStorageSOPInstanceUID, source: tag.SOPInstanceUID},
+	} {
+		source, sourceErr := findUIDValue(ds, uids.source)
+		meta, metaErr := findUIDValue(ds, uids.meta)
+		switch {
+		case sourceErr != nil && metaErr != nil:
+			return nil, fmt.Errorf("%w: cannot derive %s without %s", ErrorElementNotFound, tag.MustFind(uids.meta).Name, tag.MustFind(uids.source).Name)
+		case sourceErr == nil && metaErr == nil && meta != source:
+			return nil, fmt.Errorf("%w: %s %q does not match %s %q", ErrorInconsistentFileMeta,
+				tag.MustFind(uids.meta).Name, meta, tag.MustFind(uids.source).Name, source)
+		case metaErr != nil:
+			elems = append(elems, mustNewElement(uids.meta, []string{source}))
+		}
+	}
+
+	add(tag.ImplementationClassUID, []string{ImplementationClassUID})
+	add(tag.ImplementationVersionName, []string{ImplementationVersionName})
+
+	sort.SliceStable(elems, func(i, j int) bool { return elems[i].Tag.Compare(elems[j].Tag) < 0 })
+	return elems, nil
+}
+
+// findUIDValue returns the UID held by the Element with tag t in ds, without
+// its padding. ErrorElementNotFound is returned if it is absent or empty.
+func findUIDValue(ds *Dataset, t tag.Tag) (string, error) {
+	elem, err := ds.FindElementByTag(t)
+	if err != nil {
+		return "", err
+	}
+	v, ok := elem.Value.GetValue().([]string)
+	if !ok || len(v) == 0 {
+		return "", ErrorElementNotFound
+	}
+	if u := strings.TrimRight(v[0], " \x00"); u != "" {
+		return u, nil
+	}
+	return "", ErrorElementNotFound
+}
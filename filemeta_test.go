@@ -0,0 +1,109 @@
+package dicom
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/suyashkumar/dicom/pkg/tag"
+	"github.com/suyashkumar/dicom/pkg/uid"
+)
+
+func TestWrite_GenerateFileMeta(t *testing.T) {
+	ds := Dataset{Elements: []*Element{
+		mustNewElement(tag.TransferSyntaxUID, []string{uid.ExplicitVRLittleEndian}),
+		mustNewElement(tag.SourceApplicationEntityTitle, []string{"SCU"}),
+		mustNewElement(tag.SOPClassUID, []string{"1.2.840.10008.5.1.4.1.1.7"}),
+		mustNewElement(tag.SOPInstanceUID, []string{"1.2.3.4.5"}),
+		mustNewElement(tag.PatientName, []string{"Doe^John"}),
+	}}
+	var out bytes.Buffer
+	if err := Write(&out, ds, GenerateFileMeta()); err != nil {
+		t.Fatalf("Write(GenerateFileMeta()) unexpected error: %v", err)
+	}
+	got, err := Parse(&out, int64(out.Len()), nil)
+	if err != nil {
+		t.Fatalf("Parse() unexpected error: %v", err)
+	}
+
+	var gotTags []tag.Tag
+	for _, elem := range got.Elements {
+		if elem.Tag.Group == tag.MetadataGroup {
+			gotTags = append(gotTags, elem.Tag)
+		}
+	}
+	wantTags := []tag.Tag{
+		tag.FileMetaInformationGroupLength,
+		tag.FileMetaInformationVersion,
+		tag.MediaStorageSOPClassUID,
+		tag.MediaStorageSOPInstanceUID,
+		tag.TransferSyntaxUID,
+		tag.ImplementationClassUID,
+		tag.ImplementationVersionName,
+		tag.SourceApplicationEntityTitle,
+	}
+	if diff := cmp.Diff(wantTags, gotTags); diff != "" {
+		t.Errorf("Write(GenerateFileMeta()) unexpected File Meta Information elements. diff: %v", diff)
+	}
+	for metaTag, want := range map[tag.Tag]string{
+		tag.MediaStorageSOPClassUID:    "1.2.840.10008.5.1.4.1.1.7",
+		tag.MediaStorageSOPInstanceUID: "1.2.3.4.5",
+		tag.ImplementationClassUID:     ImplementationClassUID,
+		tag.ImplementationVersionName:  ImplementationVersionName,
+	} {
+		if u, err := findUIDValue(&got, metaTag); err != nil || u != want {
+			t.Errorf("Write(GenerateFileMeta()) unexpected %v. got: %q, %v, want: %q", metaTag, u, err, want)
+		}
+	}
+	if err := uid.Validate(ImplementationClassUID); err != nil {
+		t.Errorf("ImplementationClassUID is invalid: %v", err)
+	}
+}
+
+func TestWrite_GenerateFileMeta_Errors(t *testing.T) {
+	cases := []struct {
+		name    string
+		elems   []*Element
+		wantErr error
+	}{
+		{
+			name: "mismatched MediaStorageSOPInstanceUID",
+			elems: []*Element{
+				mustNewElement(tag.MediaStorageSOPInstanceUID, []string{"1.2.3.4.6"}),
+				mustNewElement(tag.SOPClassUID, []string{"1.2.840.10008.5.1.4.1.1.7"}),
+				mustNewElement(tag.SOPInstanceUID, []string{"1.2.3.4.5"}),
+			},
+			wantErr: ErrorInconsistentFileMeta,
+		},
+		{
+			name: "invalid FileMetaInformationVersion",
+			elems: []*Element{
+				mustNewElement(tag.FileMetaInformationVersion, []byte{1}),
+				mustNewElement(tag.SOPClassUID, []string{"1.2.840.10008.5.1.4.1.1.7"}),
+				mustNewElement(tag.SOPInstanceUID, []string{"1.2.3.4.5"}),
+			},
+			wantErr: ErrorInconsistentFileMeta,
+		},
+		{
+			name: "no SOPInstanceUID",
+			elems: []*Element{
+				mustNewElement(tag.SOPClassUID, []string{"1.2.840.10008.5.1.4.1.1.7"}),
+				mustNewElement(tag.SOPInstanceUID, []string{""}),
+			},
+			wantErr: ErrorElementNotFound,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			elems := append([]*Element{mustNewElement(tag.TransferSyntaxUID, []string{uid.ImplicitVRLittleEndian})}, tc.elems...)
+			var out bytes.Buffer
+			if err := Write(&out, Dataset{Elements: elems}, GenerateFileMeta()); !errors.Is(err, tc.wantErr) {
+				t.Errorf("Write(GenerateFileMeta()) unexpected error. got: %v, want: %v", err, tc.wantErr)
+			}
+			if out.Len() != 0 {
+				t.Errorf("Write(GenerateFileMeta()) wrote %d bytes despite the error", out.Len())
+			}
+		})
+	}
+}
@@ -0,0 +1,162 @@
+package main
+
+import (
+	"bytes"
+	"flag"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/suyashkumar/dicom/pkg/tag"
+)
+
+var update = flag.Bool("update", false, "update the golden files in testdata")
+
+func TestGenerate(t *testing.T) {
+	part6, err := readTables(filepath.Join("testdata", "part06.xml"))
+	if err != nil {
+		t.Fatalf("readTables(part06.xml) unexpected error: %v", err)
+	}
+	part7, err := readTables(filepath.Join("testdata", "part07.xml"))
+	if err != nil {
+		t.Fatalf("readTables(part07.xml) unexpected error: %v", err)
+	}
+
+	cases := []struct {
+		golden   string
+		generate func(*bytes.Buffer) error
+	}{
+		{
+			golden: "tag_definitions.golden",
+			generate: func(b *bytes.Buffer) error {
+				entries, err := tagEntries(part6, part7)
+				if err != nil {
+					return err
+				}
+				return generateTags(b, entries)
+			},
+		},
+		{
+			golden: "uid_definitions.golden",
+			generate: func(b *bytes.Buffer) error {
+				entries, err := uidEntries(part6)
+				if err != nil {
+					return err
+				}
+				return generateUIDs(b, entries)
+			},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.golden, func(t *testing.T) {
+			var b bytes.Buffer
+			if err := tc.generate(&b); err != nil {
+				t.Fatalf("generate unexpected error: %v", err)
+			}
+			path := filepath.Join("testdata", tc.golden)
+			if *update {
+				if err := ioutil.WriteFile(path, b.Bytes(), 0644); err != nil {
+					t.Fatalf("unable to update %s: %v", path, err)
+				}
+			}
+			want, err := ioutil.ReadFile(path)
+			if err != nil {
+				t.Fatalf("unable to read %s: %v", path, err)
+			}
+			if diff := cmp.Diff(string(want), b.String()); diff != "" {
+				t.Errorf("generated source differs from %s (run go test -update to update it). diff: %v", tc.golden, diff)
+			}
+		})
+	}
+}
+
+func TestParseTables(t *testing.T) {
+	doc := `<book xmlns="http://docbook.org/ns/docbook" xml:id="PS3.6">
+  <table xml:id="table_1">
+    <thead><tr><th><para><emphasis role="bold">Tag</emphasis></para></th><th><para/></th></tr></thead>
+    <tbody>
+      <tr><td><para>(0010,0010)</para></td><td><para>Patient&#8203;Name</para><para>See  Note</para></td></tr>
+    </tbody>
+  </table>
+  <table><tbody><tr><td><para>untitled</para></td></tr></tbody></table>
+</book>`
+	got, err := parseTables(strings.NewReader(doc))
+	if err != nil {
+		t.Fatalf("parseTables() unexpected error: %v", err)
+	}
+	want := map[string]table{
+		"table_1": {
+			ID:     "table_1",
+			Header: []string{"Tag", ""},
+			Rows:   [][]string{{"(0010,0010)", "PatientName See Note"}},
+		},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("parseTables() unexpected tables. diff: %v", diff)
+	}
+}
+
+func TestParseTagPattern(t *testing.T) {
+	cases := []struct {
+		in        string
+		wantValue tag.Tag
+		wantMask  tag.Tag
+		wantErr   bool
+	}{
+		{in: "(0010,0010)", wantValue: tag.Tag{Group: 0x0010, Element: 0x0010}, wantMask: tag.Tag{Group: 0xFFFF, Element: 0xFFFF}},
+		{in: "(60xx,3000)", wantValue: tag.Tag{Group: 0x6000, Element: 0x3000}, wantMask: tag.Tag{Group: 0xFF01, Element: 0xFFFF}},
+		{in: "(0028,04x0)", wantValue: tag.Tag{Group: 0x0028, Element: 0x0400}, wantMask: tag.Tag{Group: 0xFFFF, Element: 0xFF0F}},
+		{in: "(1010,xxxx)", wantValue: tag.Tag{Group: 0x1010, Element: 0x0000}, wantMask: tag.Tag{Group: 0xFFFF, Element: 0x0000}},
+		{in: "(gggg,0000)", wantErr: true},
+		{in: "0010,0010", wantErr: true},
+	}
+	for _, tc := range cases {
+		t.Run(tc.in, func(t *testing.T) {
+			value, mask, err := parseTagPattern(tc.in)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("parseTagPattern(%q) unexpected error: %v", tc.in, err)
+			}
+			if value != tc.wantValue || mask != tc.wantMask {
+				t.Errorf("parseTagPattern(%q) got: %s %s, want: %s %s", tc.in, value, mask, tc.wantValue, tc.wantMask)
+			}
+		})
+	}
+}
+
+func TestKeywordFromName(t *testing.T) {
+	for name, want := range map[string]string{
+		"Command Length to End":       "CommandLengthToEnd",
+		"Affected SOP Instance UID":   "AffectedSOPInstanceUID",
+		"Number of Remaining Sub-ops": "NumberOfRemainingSubOps",
+		"":                            "",
+	} {
+		if got := keywordFromName(name); got != want {
+			t.Errorf("keywordFromName(%q) got: %q, want: %q", name, got, want)
+		}
+	}
+}
+
+func TestTagEntries_DuplicateKeyword(t *testing.T) {
+	header := []string{"Tag", "Name", "Keyword", "VR", "VM", ""}
+	part6 := map[string]table{
+		"table_6-1": {ID: "table_6-1", Header: header, Rows: [][]string{
+			{"(0010,0010)", "Patient's Name", "PatientName", "PN", "1", ""},
+			{"(0010,0011)", "Patient's Name", "PatientName", "PN", "1", ""},
+		}},
+		"table_7-1": {ID: "table_7-1", Header: header},
+		"table_8-1": {ID: "table_8-1", Header: header},
+	}
+	part7 := map[string]table{
+		"table_E.1-1": {ID: "table_E.1-1", Header: header},
+		"table_E.2-1": {ID: "table_E.2-1", Header: header},
+	}
+	if _, err := tagEntries(part6, part7); err == nil {
+		t.Errorf("tagEntries() with a duplicate keyword expected an error")
+	}
+	delete(part7, "table_E.2-1")
+	if _, err := tagEntries(part6, part7); err == nil {
+		t.Errorf("tagEntries() with a missing table expected an error")
+	}
+}
@@ -0,0 +1,152 @@
+package main
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// xmlNamespace is the namespace of the xml:id attribute identifying DocBook
+// tables, e.g. xml:id="table_6-1".
+const xmlNamespace = "http://www.w3.org/XML/1998/namespace"
+
+// table is a DocBook table, with the text content of each cell.
+type table struct {
+	ID     string
+	Header []string
+	Rows   [][]string
+}
+
+// docbookTable is either an HTML table, as used by the standard, or a CALS
+// table, whose rows are within a tgroup.
+type docbookTable struct {
+	Head     []docbookRow `xml:"thead>tr"`
+	Body     []docbookRow `xml:"tbody>tr"`
+	CALSHead []docbookRow `xml:"tgroup>thead>row"`
+	CALSBody []docbookRow `xml:"tgroup>tbody>row"`
+}
+
+type docbookRow struct {
+	Cells []cellText `xml:",any"`
+}
+
+// cellText is the text content of a th, td or entry element, with whitespace
+// collapsed and the zero width spaces the standard uses as line break hints
+// removed.
+type cellText string
+
+func (c *cellText) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var b strings.Builder
+	for depth := 0; ; {
+		tok, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := tok.(type) {
+		case xml.CharData:
+			b.Write(t)
+		case xml.StartElement:
+			depth++
+			if t.Name.Local == "para" {
+				b.WriteByte(' ')
+			}
+		case xml.EndElement:
+			if depth == 0 {
+				*c = cellText(normalizeText(b.String()))
+				return nil
+			}
+			depth--
+		}
+	}
+}
+
+func normalizeText(s string) string {
+	s = strings.NewReplacer("\u200b", "", "\u00a0", " ").Replace(s)
+	return strings.Join(strings.Fields(s), " ")
+}
+
+// parseTables returns the tables of the DocBook document read from r, by
+// xml:id.
+func parseTables(r io.Reader) (map[string]table, error) {
+	tables := make(map[string]table)
+	d := xml.NewDecoder(r)
+	for {
+		tok, err := d.Token()
+		if err == io.EOF {
+			return tables, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		start, ok := tok.(xml.StartElement)
+		if !ok || start.Name.Local != "table" {
+			continue
+		}
+		var id string
+		for _, attr := range start.Attr {
+			if attr.Name.Space == xmlNamespace && attr.Name.Local == "id" {
+				id = attr.Value
+			}
+		}
+		var dt docbookTable
+		if err := d.DecodeElement(&dt, &start); err != nil {
+			return nil, fmt.Errorf("table %q: %w", id, err)
+		}
+		if id == "" {
+			continue
+		}
+		t := table{ID: id}
+		dt.Head = append(dt.Head, dt.CALSHead...)
+		dt.Body = append(dt.Body, dt.CALSBody...)
+		if len(dt.Head) > 0 {
+			t.Header = cellStrings(dt.Head[0].Cells)
+		}
+		for _, row := range dt.Body {
+			t.Rows = append(t.Rows, cellStrings(row.Cells))
+		}
+		tables[id] = t
+	}
+}
+
+func cellStrings(cells []cellText) []string {
+	s := make([]string, len(cells))
+	for i, c := range cells {
+		s[i] = string(c)
+	}
+	return s
+}
+
+// column returns the index of the column whose header is one of names,
+// ignoring case, or -1 if there is none.
+func (t table) column(names ...string) int {
+	for i, h := range t.Header {
+		for _, name := range names {
+			if strings.EqualFold(h, name) {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+// columns returns the indexes of the columns named names, or an error if the
+// table lacks one of them.
+func (t table) columns(names ...string) ([]int, error) {
+	idx := make([]int, len(names))
+	for i, name := range names {
+		if idx[i] = t.column(name); idx[i] < 0 {
+			return nil, fmt.Errorf("table %s: no %q column in %q", t.ID, name, t.Header)
+		}
+	}
+	return idx, nil
+}
+
+// cell returns the cell of row at column i, or "" if the row is short or i is
+// negative.
+func cell(row []string, i int) string {
+	if i < 0 || i >= len(row) {
+		return ""
+	}
+	return row[i]
+}
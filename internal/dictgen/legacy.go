@@ -0,0 +1,148 @@
+package main
+
+import "github.com/suyashkumar/dicom/pkg/tag"
+
+// legacyTagNames are the names under which pkg/tag exported retired ACR-NEMA
+// tags before it was generated by dictgen, e.g. ACR_NEMA_ImageDimensions for
+// ImageDimensions (0028,0005). They are generated as aliases of the keyword
+// vars so that regenerating the dictionary does not remove them.
+var legacyTagNames = map[tag.Tag]string{
+	{Group: 0x0000, Element: 0x0001}: "ACR_NEMA_CommandGroupLengthToEnd",
+	{Group: 0x0000, Element: 0x0010}: "ACR_NEMA_CommandRecognitionCode",
+	{Group: 0x0000, Element: 0x0200}: "ACR_NEMA_Initiator",
+	{Group: 0x0000, Element: 0x0300}: "ACR_NEMA_Receiver",
+	{Group: 0x0000, Element: 0x0400}: "ACR_NEMA_FindLocation",
+	{Group: 0x0000, Element: 0x0850}: "ACR_NEMA_NumberOfMatches",
+	{Group: 0x0000, Element: 0x0860}: "ACR_NEMA_ResponseSequenceNumber",
+	{Group: 0x0000, Element: 0x4000}: "ACR_NEMA_DialogReceiver",
+	{Group: 0x0000, Element: 0x4010}: "ACR_NEMA_TerminalType",
+	{Group: 0x0000, Element: 0x5010}: "ACR_NEMA_MessageSetID",
+	{Group: 0x0000, Element: 0x5020}: "ACR_NEMA_EndMessageSet",
+	{Group: 0x0000, Element: 0x5110}: "ACR_NEMA_DisplayFormat",
+	{Group: 0x0000, Element: 0x5120}: "ACR_NEMA_PagePositionID",
+	{Group: 0x0000, Element: 0x5130}: "ACR_NEMA_TextFormatID",
+	{Group: 0x0000, Element: 0x5140}: "ACR_NEMA_NormalReverse",
+	{Group: 0x0000, Element: 0x5150}: "ACR_NEMA_AddGrayScale",
+	{Group: 0x0000, Element: 0x5160}: "ACR_NEMA_Borders",
+	{Group: 0x0000, Element: 0x5170}: "ACR_NEMA_Copies",
+	{Group: 0x0000, Element: 0x5180}: "ACR_NEMA_MagnificationType",
+	{Group: 0x0000, Element: 0x5190}: "ACR_NEMA_Erase",
+	{Group: 0x0000, Element: 0x51A0}: "ACR_NEMA_Print",
+	{Group: 0x0000, Element: 0x51B0}: "ACR_NEMA_Overlays",
+	{Group: 0x0008, Element: 0x0001}: "ACR_NEMA_IdentifyingGroupLengthToEnd",
+	{Group: 0x0008, Element: 0x0010}: "ACR_NEMA_RecognitionCode",
+	{Group: 0x0008, Element: 0x0040}: "ACR_NEMA_OldDataSetType",
+	{Group: 0x0008, Element: 0x0041}: "ACR_NEMA_DataSetSubtype",
+	{Group: 0x0008, Element: 0x1000}: "ACR_NEMA_NetworkID",
+	{Group: 0x0008, Element: 0x4000}: "ACR_NEMA_IdentifyingComments",
+	{Group: 0x0010, Element: 0x1050}: "ACR_NEMA_InsurancePlanIdentification",
+	{Group: 0x0018, Element: 0x1240}: "ACR_NEMA_UpperLowerPixelValues",
+	{Group: 0x0018, Element: 0x4000}: "ACR_NEMA_AcquisitionComments",
+	{Group: 0x0018, Element: 0x5030}: "ACR_NEMA_DynamicRange",
+	{Group: 0x0018, Element: 0x5040}: "ACR_NEMA_TotalGain",
+	{Group: 0x0020, Element: 0x0030}: "ACR_NEMA_ImagePosition",
+	{Group: 0x0020, Element: 0x0035}: "ACR_NEMA_ImageOrientation",
+	{Group: 0x0020, Element: 0x0050}: "ACR_NEMA_Location",
+	{Group: 0x0020, Element: 0x0070}: "ACR_NEMA_ImageGeometryType",
+	{Group: 0x0020, Element: 0x0080}: "ACR_NEMA_MaskingImage",
+	{Group: 0x0020, Element: 0x1001}: "ACR_NEMA_AcquisitionsInSeries",
+	{Group: 0x0020, Element: 0x1003}: "ACR_NEMA_ImagesInSeries",
+	{Group: 0x0020, Element: 0x1005}: "ACR_NEMA_ImagesInStudy",
+	{Group: 0x0020, Element: 0x1020}: "ACR_NEMA_Reference",
+	{Group: 0x0020, Element: 0x3100}: "ACR_NEMA_SourceImageID",
+	{Group: 0x0020, Element: 0x3401}: "ACR_NEMA_ModifyingDeviceID",
+	{Group: 0x0020, Element: 0x3402}: "ACR_NEMA_ModifiedImageID",
+	{Group: 0x0020, Element: 0x3403}: "ACR_NEMA_ModifiedImageDate",
+	{Group: 0x0020, Element: 0x3404}: "ACR_NEMA_ModifyingDeviceManufacturer",
+	{Group: 0x0020, Element: 0x3405}: "ACR_NEMA_ModifiedImageTime",
+	{Group: 0x0020, Element: 0x3406}: "ACR_NEMA_ModifiedImageDescription",
+	{Group: 0x0020, Element: 0x5000}: "ACR_NEMA_OriginalImageIdentification",
+	{Group: 0x0020, Element: 0x5002}: "ACR_NEMA_OriginalImageIdentificationNomenclature",
+	{Group: 0x0028, Element: 0x0005}: "ACR_NEMA_ImageDimensions",
+	{Group: 0x0028, Element: 0x0040}: "ACR_NEMA_ImageFormat",
+	{Group: 0x0028, Element: 0x0050}: "ACR_NEMA_ManipulatedImage",
+	{Group: 0x0028, Element: 0x005F}: "ACR_NEMA_2C_CompressionRecognitionCode",
+	{Group: 0x0028, Element: 0x0060}: "ACR_NEMA_CompressionCode",
+	{Group: 0x0028, Element: 0x0061}: "ACR_NEMA_2C_CompressionOriginator",
+	{Group: 0x0028, Element: 0x0062}: "ACR_NEMA_2C_CompressionLabel",
+	{Group: 0x0028, Element: 0x0063}: "ACR_NEMA_2C_CompressionDescription",
+	{Group: 0x0028, Element: 0x0065}: "ACR_NEMA_2C_CompressionSequence",
+	{Group: 0x0028, Element: 0x0066}: "ACR_NEMA_2C_CompressionStepPointers",
+	{Group: 0x0028, Element: 0x0068}: "ACR_NEMA_2C_RepeatInterval",
+	{Group: 0x0028, Element: 0x0069}: "ACR_NEMA_2C_BitsGrouped",
+	{Group: 0x0028, Element: 0x0070}: "ACR_NEMA_2C_PerimeterTable",
+	{Group: 0x0028, Element: 0x0071}: "ACR_NEMA_2C_PerimeterValue",
+	{Group: 0x0028, Element: 0x0080}: "ACR_NEMA_2C_PredictorRows",
+	{Group: 0x0028, Element: 0x0081}: "ACR_NEMA_2C_PredictorColumns",
+	{Group: 0x0028, Element: 0x0082}: "ACR_NEMA_2C_PredictorConstants",
+	{Group: 0x0028, Element: 0x0090}: "ACR_NEMA_2C_BlockedPixels",
+	{Group: 0x0028, Element: 0x0091}: "ACR_NEMA_2C_BlockRows",
+	{Group: 0x0028, Element: 0x0092}: "ACR_NEMA_2C_BlockColumns",
+	{Group: 0x0028, Element: 0x0093}: "ACR_NEMA_2C_RowOverlap",
+	{Group: 0x0028, Element: 0x0094}: "ACR_NEMA_2C_ColumnOverlap",
+	{Group: 0x0028, Element: 0x0104}: "ACR_NEMA_SmallestValidPixelValue",
+	{Group: 0x0028, Element: 0x0105}: "ACR_NEMA_LargestValidPixelValue",
+	{Group: 0x0028, Element: 0x0200}: "ACR_NEMA_ImageLocation",
+	{Group: 0x0028, Element: 0x0400}: "ACR_NEMA_2C_TransformLabel",
+	{Group: 0x0028, Element: 0x0401}: "ACR_NEMA_2C_TransformVersionNumber",
+	{Group: 0x0028, Element: 0x0402}: "ACR_NEMA_2C_NumberOfTransformSteps",
+	{Group: 0x0028, Element: 0x0403}: "ACR_NEMA_2C_SequenceOfCompressedData",
+	{Group: 0x0028, Element: 0x0404}: "ACR_NEMA_2C_DetailsOfCoefficients",
+	{Group: 0x0028, Element: 0x0410}: "ACR_NEMA_2C_RowsForNthOrderCoefficients",
+	{Group: 0x0028, Element: 0x0411}: "ACR_NEMA_2C_ColumnsForNthOrderCoefficients",
+	{Group: 0x0028, Element: 0x0412}: "ACR_NEMA_2C_CoefficientCoding",
+	{Group: 0x0028, Element: 0x0413}: "ACR_NEMA_2C_CoefficientCodingPointers",
+	{Group: 0x0028, Element: 0x0700}: "ACR_NEMA_2C_DCTLabel",
+	{Group: 0x0028, Element: 0x0701}: "ACR_NEMA_2C_DataBlockDescription",
+	{Group: 0x0028, Element: 0x0702}: "ACR_NEMA_2C_DataBlock",
+	{Group: 0x0028, Element: 0x0710}: "ACR_NEMA_2C_NormalizationFactorFormat",
+	{Group: 0x0028, Element: 0x0720}: "ACR_NEMA_2C_ZonalMapNumberFormat",
+	{Group: 0x0028, Element: 0x0721}: "ACR_NEMA_2C_ZonalMapLocation",
+	{Group: 0x0028, Element: 0x0722}: "ACR_NEMA_2C_ZonalMapFormat",
+	{Group: 0x0028, Element: 0x0730}: "ACR_NEMA_2C_AdaptiveMapFormat",
+	{Group: 0x0028, Element: 0x0740}: "ACR_NEMA_2C_CodeNumberFormat",
+	{Group: 0x0028, Element: 0x0800}: "ACR_NEMA_2C_CodeLabel",
+	{Group: 0x0028, Element: 0x0802}: "ACR_NEMA_2C_NumberOfTables",
+	{Group: 0x0028, Element: 0x0803}: "ACR_NEMA_2C_CodeTableLocation",
+	{Group: 0x0028, Element: 0x0804}: "ACR_NEMA_2C_BitsForCodeWord",
+	{Group: 0x0028, Element: 0x0808}: "ACR_NEMA_2C_ImageDataLocation",
+	{Group: 0x0028, Element: 0x1080}: "ACR_NEMA_GrayScale",
+	{Group: 0x0028, Element: 0x1100}: "ACR_NEMA_GrayLookupTableDescriptor",
+	{Group: 0x0028, Element: 0x1200}: "ACR_NEMA_GrayLookupTableData",
+	{Group: 0x0028, Element: 0x4000}: "ACR_NEMA_ImagePresentationComments",
+	{Group: 0x1000, Element: 0x0000}: "ACR_NEMA_2C_CodeTableGroupLength",
+	{Group: 0x1000, Element: 0x0010}: "ACR_NEMA_2C_EscapeTriplet",
+	{Group: 0x1000, Element: 0x0011}: "ACR_NEMA_2C_RunLengthTriplet",
+	{Group: 0x1000, Element: 0x0012}: "ACR_NEMA_2C_HuffmanTableSize",
+	{Group: 0x1000, Element: 0x0013}: "ACR_NEMA_2C_HuffmanTableTriplet",
+	{Group: 0x1000, Element: 0x0014}: "ACR_NEMA_2C_ShiftTableSize",
+	{Group: 0x1000, Element: 0x0015}: "ACR_NEMA_2C_ShiftTableTriplet",
+	{Group: 0x1010, Element: 0x0000}: "ACR_NEMA_2C_ZonalMapGroupLength",
+	{Group: 0x1010, Element: 0x0004}: "ACR_NEMA_2C_ZonalMap",
+	{Group: 0x4000, Element: 0x0000}: "ACR_NEMA_TextGroupLength",
+	{Group: 0x4000, Element: 0x0010}: "ACR_NEMA_TextArbitrary",
+	{Group: 0x4000, Element: 0x4000}: "ACR_NEMA_TextComments",
+	{Group: 0x6000, Element: 0x0060}: "ACR_NEMA_2C_OverlayCompressionCode",
+	{Group: 0x6000, Element: 0x0061}: "ACR_NEMA_2C_OverlayCompressionOriginator",
+	{Group: 0x6000, Element: 0x0062}: "ACR_NEMA_2C_OverlayCompressionLabel",
+	{Group: 0x6000, Element: 0x0063}: "ACR_NEMA_2C_OverlayCompressionDescription",
+	{Group: 0x6000, Element: 0x0066}: "ACR_NEMA_2C_OverlayCompressionStepPointers",
+	{Group: 0x6000, Element: 0x0068}: "ACR_NEMA_2C_OverlayRepeatInterval",
+	{Group: 0x6000, Element: 0x0069}: "ACR_NEMA_2C_OverlayBitsGrouped",
+	{Group: 0x6000, Element: 0x0110}: "ACR_NEMA_OverlayFormat",
+	{Group: 0x6000, Element: 0x0200}: "ACR_NEMA_OverlayLocation",
+	{Group: 0x6000, Element: 0x0800}: "ACR_NEMA_2C_OverlayCodeLabel",
+	{Group: 0x6000, Element: 0x0802}: "ACR_NEMA_2C_OverlayNumberOfTables",
+	{Group: 0x6000, Element: 0x0803}: "ACR_NEMA_2C_OverlayCodeTableLocation",
+	{Group: 0x6000, Element: 0x0804}: "ACR_NEMA_2C_OverlayBitsForCodeWord",
+	{Group: 0x6000, Element: 0x4000}: "ACR_NEMA_OverlayComments",
+	{Group: 0x7F00, Element: 0x0000}: "ACR_NEMA_2C_VariablePixelDataGroupLength",
+	{Group: 0x7F00, Element: 0x0010}: "ACR_NEMA_2C_VariablePixelData",
+	{Group: 0x7F00, Element: 0x0011}: "ACR_NEMA_2C_VariableNextDataGroup",
+	{Group: 0x7F00, Element: 0x0020}: "ACR_NEMA_2C_VariableCoefficientsSDVN",
+	{Group: 0x7F00, Element: 0x0030}: "ACR_NEMA_2C_VariableCoefficientsSDHN",
+	{Group: 0x7F00, Element: 0x0040}: "ACR_NEMA_2C_VariableCoefficientsSDDN",
+	{Group: 0x7FE0, Element: 0x0020}: "ACR_NEMA_2C_CoefficientsSDVN",
+	{Group: 0x7FE0, Element: 0x0030}: "ACR_NEMA_2C_CoefficientsSDHN",
+	{Group: 0x7FE0, Element: 0x0040}: "ACR_NEMA_2C_CoefficientsSDDN",
+}
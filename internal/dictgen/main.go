@@ -0,0 +1,96 @@
+// Command dictgen generates the tag and UID dictionaries of this module from
+// the DocBook XML edition of the DICOM standard, which can be downloaded from
+// https://dicom.nema.org/medical/dicom/current/source/docbook/.
+//
+// The tag dictionary (pkg/tag/tag_definitions.go) is built from the registry
+// of data elements, file meta elements and directory structuring elements in
+// PS3.6 (tables 6-1, 7-1 and 8-1) and from the command fields in PS3.7 (tables
+// E.1-1 and E.2-1). The UID dictionary (pkg/uid/uid_definitions.go) is built
+// from the UID registry and the well-known frames of reference in PS3.6
+// (tables A-1 and A-2).
+//
+// It is run from the root of the module with the paths of the XML files:
+//
+//	go run ./internal/dictgen -part6 part06.xml -part7 part07.xml -tags pkg/tag/tag_definitions.go -uids pkg/uid/uid_definitions.go
+//
+// The checked-in dictionaries are still those of pkg/tag/generate_tag_definitions.py
+// and pynetdicom until they are regenerated this way, which also replaces the
+// go:generate directive of pkg/tag.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+)
+
+var (
+	part6   = flag.String("part6", "", "path to the PS3.6 DocBook XML file (part06.xml)")
+	part7   = flag.String("part7", "", "path to the PS3.7 DocBook XML file (part07.xml)")
+	tagsOut = flag.String("tags", "", "if set, write the tag dictionary to this file")
+	uidsOut = flag.String("uids", "", "if set, write the UID dictionary to this file")
+)
+
+func main() {
+	flag.Parse()
+	if *tagsOut == "" && *uidsOut == "" {
+		log.Fatal("at least one of -tags and -uids is required")
+	}
+	if *part6 == "" {
+		log.Fatal("-part6 is required")
+	}
+
+	p6, err := readTables(*part6)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if *tagsOut != "" {
+		if *part7 == "" {
+			log.Fatal("-part7 is required with -tags")
+		}
+		p7, err := readTables(*part7)
+		if err != nil {
+			log.Fatal(err)
+		}
+		entries, err := tagEntries(p6, p7)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if err := writeFile(*tagsOut, func(b *bytes.Buffer) error { return generateTags(b, entries) }); err != nil {
+			log.Fatal(err)
+		}
+	}
+	if *uidsOut != "" {
+		entries, err := uidEntries(p6)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if err := writeFile(*uidsOut, func(b *bytes.Buffer) error { return generateUIDs(b, entries) }); err != nil {
+			log.Fatal(err)
+		}
+	}
+}
+
+func readTables(path string) (map[string]table, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	tables, err := parseTables(f)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return tables, nil
+}
+
+func writeFile(path string, generate func(*bytes.Buffer) error) error {
+	var b bytes.Buffer
+	if err := generate(&b); err != nil {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+	return ioutil.WriteFile(path, b.Bytes(), 0644)
+}
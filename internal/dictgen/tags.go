@@ -0,0 +1,272 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"log"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/suyashkumar/dicom/pkg/tag"
+)
+
+// Tables of the tag dictionary, by the part of the standard they are in.
+var (
+	part6TagTables = []string{
+		"table_6-1", // Registry of DICOM Data Elements
+		"table_7-1", // Registry of DICOM File Meta Elements
+		"table_8-1", // Registry of DICOM Directory Structuring Elements
+	}
+	part7TagTables = []string{
+		"table_E.1-1", // Command Fields
+		"table_E.2-1", // Retired Command Fields
+	}
+)
+
+// retiredTables are the tables whose elements are all retired.
+var retiredTables = map[string]bool{"table_E.2-1": true}
+
+var (
+	tagPattern  = regexp.MustCompile(`^\(([0-9A-Fa-fx]{4}),([0-9A-Fa-fx]{4})\)$`)
+	vrPattern   = regexp.MustCompile(`^[A-Z]{2}( or [A-Z]{2})*$`)
+	nonKeywordR = regexp.MustCompile(`[^A-Za-z0-9]+`)
+)
+
+// tagEntry is an entry of the generated tag dictionary. Repeating entries,
+// e.g. (60xx,3000), match every tag t where t&mask == value.
+type tagEntry struct {
+	value   tag.Tag
+	mask    tag.Tag
+	vrs     string
+	keyword string
+	vm      string
+	retired bool
+}
+
+func (e tagEntry) repeating() bool {
+	return e.mask != tag.Tag{Group: 0xFFFF, Element: 0xFFFF}
+}
+
+// name is the tag.Info Name of the entry, prefixed by "RETIRED_" for retired
+// elements like in the rest of pkg/tag.
+func (e tagEntry) name() string {
+	if e.retired {
+		return "RETIRED_" + e.keyword
+	}
+	return e.keyword
+}
+
+// tagEntries returns the tag dictionary entries of the PS3.6 and PS3.7
+// tables, in tag order.
+func tagEntries(part6, part7 map[string]table) ([]tagEntry, error) {
+	var entries []tagEntry
+	for _, src := range []struct {
+		tables map[string]table
+		ids    []string
+	}{{part6, part6TagTables}, {part7, part7TagTables}} {
+		for _, id := range src.ids {
+			t, ok := src.tables[id]
+			if !ok {
+				return nil, fmt.Errorf("missing table %s", id)
+			}
+			e, err := tableTagEntries(t)
+			if err != nil {
+				return nil, err
+			}
+			entries = append(entries, e...)
+		}
+	}
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].value.Compare(entries[j].value) < 0
+	})
+	var deduped []tagEntry
+	seen := make(map[[2]tag.Tag]bool)
+	keywords := make(map[string]tag.Tag)
+	for _, e := range entries {
+		key := [2]tag.Tag{e.value, e.mask}
+		if seen[key] {
+			log.Printf("skipping duplicate entry %s %s", e.value, e.keyword)
+			continue
+		}
+		seen[key] = true
+		if t, ok := keywords[e.keyword]; ok {
+			return nil, fmt.Errorf("keyword %s is used by both %s and %s", e.keyword, t, e.value)
+		}
+		keywords[e.keyword] = e.value
+		deduped = append(deduped, e)
+	}
+	return deduped, nil
+}
+
+func tableTagEntries(t table) ([]tagEntry, error) {
+	cols, err := t.columns("Tag", "VR", "VM")
+	if err != nil {
+		return nil, err
+	}
+	tagCol, vrCol, vmCol := cols[0], cols[1], cols[2]
+	nameCol := t.column("Name", "Message Field")
+	keywordCol := t.column("Keyword")
+	// The retired status of PS3.6 elements is in the last column, which has
+	// no header.
+	statusCol := -1
+	if len(t.Header) > vmCol+1 && t.Header[vmCol+1] == "" {
+		statusCol = vmCol + 1
+	}
+
+	var entries []tagEntry
+	for _, row := range t.Rows {
+		e := tagEntry{
+			keyword: cell(row, keywordCol),
+			retired: retiredTables[t.ID] || strings.HasPrefix(cell(row, statusCol), "RET"),
+		}
+		if e.keyword == "" {
+			e.keyword = keywordFromName(cell(row, nameCol))
+		}
+		if e.keyword == "" {
+			log.Printf("%s: skipping %s without keyword", t.ID, cell(row, tagCol))
+			continue
+		}
+		if e.value, e.mask, err = parseTagPattern(cell(row, tagCol)); err != nil {
+			return nil, fmt.Errorf("%s: %s: %w", t.ID, e.keyword, err)
+		}
+		if e.vrs, err = normalizeVR(cell(row, vrCol)); err != nil {
+			return nil, fmt.Errorf("%s: %s: %w", t.ID, e.keyword, err)
+		}
+		if e.vm, err = normalizeVM(cell(row, vmCol)); err != nil {
+			return nil, fmt.Errorf("%s: %s: %w", t.ID, e.keyword, err)
+		}
+		entries = append(entries, e)
+	}
+	return entries, nil
+}
+
+// keywordFromName derives a keyword from the name of an element, for tables
+// without a keyword column, e.g. "Message ID" becomes "MessageID".
+func keywordFromName(name string) string {
+	var b strings.Builder
+	for _, word := range nonKeywordR.Split(name, -1) {
+		if word != "" {
+			b.WriteString(strings.ToUpper(word[:1]) + word[1:])
+		}
+	}
+	return b.String()
+}
+
+// parseTagPattern parses a tag in the PS3.6 notation, where each x stands for
+// any hex digit, e.g. "(0010,0010)" or "(60xx,3000)". As in PS3.6, repeating
+// groups only include even groups.
+func parseTagPattern(s string) (value, mask tag.Tag, err error) {
+	m := tagPattern.FindStringSubmatch(s)
+	if m == nil {
+		return tag.Tag{}, tag.Tag{}, fmt.Errorf("invalid tag %q", s)
+	}
+	var parts [2]uint16
+	var masks [2]uint16
+	for i, p := range m[1:] {
+		v, err := strconv.ParseUint(strings.Replace(p, "x", "0", -1), 16, 16)
+		if err != nil {
+			return tag.Tag{}, tag.Tag{}, fmt.Errorf("invalid tag %q: %v", s, err)
+		}
+		parts[i] = uint16(v)
+		for _, c := range p {
+			masks[i] <<= 4
+			if c != 'x' {
+				masks[i] |= 0xF
+			}
+		}
+	}
+	if masks[0] != 0xFFFF {
+		masks[0] |= 1
+	}
+	return tag.Tag{Group: parts[0], Element: parts[1]}, tag.Tag{Group: masks[0], Element: masks[1]}, nil
+}
+
+// normalizeVR returns the VRs listed in a VR cell, separated by " or ", with
+// the default VR first. The Item and delimitation elements, which have no VR,
+// get the NA pseudo VR.
+func normalizeVR(s string) (string, error) {
+	if s == "" || strings.HasPrefix(s, "See Note") {
+		return "NA", nil
+	}
+	if !vrPattern.MatchString(s) {
+		return "", fmt.Errorf("invalid VR %q", s)
+	}
+	if s == "OB or OW" {
+		// OW is the only VR allowed for these elements, e.g. PixelData, in
+		// implicit VR transfer syntaxes.
+		return "OW or OB", nil
+	}
+	return s, nil
+}
+
+// normalizeVM returns the VM of a VM cell. When several VMs are listed, e.g.
+// "1-n or 1", the first one is used.
+func normalizeVM(s string) (string, error) {
+	if s == "" {
+		return "1", nil
+	}
+	vm := strings.Split(s, " or ")[0]
+	if _, err := tag.ParseVM(vm); err != nil {
+		return "", err
+	}
+	return vm, nil
+}
+
+// generateTags writes the source of pkg/tag/tag_definitions.go.
+func generateTags(b *bytes.Buffer, entries []tagEntry) error {
+	fmt.Fprintln(b, "package tag")
+	fmt.Fprintln(b)
+	fmt.Fprintln(b, "// Code generated by dictgen from PS3.6 and PS3.7. DO NOT EDIT.")
+	fmt.Fprintln(b)
+	for _, e := range entries {
+		fmt.Fprintf(b, "var %s = %s\n", e.keyword, tagLiteral(e.value))
+	}
+	fmt.Fprintln(b)
+	fmt.Fprintln(b, "// Former names of retired ACR-NEMA tags, kept for compatibility.")
+	for _, e := range entries {
+		if name, ok := legacyTagNames[e.value]; ok {
+			fmt.Fprintf(b, "var %s = %s\n", name, e.keyword)
+		}
+	}
+	fmt.Fprintln(b)
+	fmt.Fprintln(b, "var tagDict map[Tag]Info")
+	fmt.Fprintln(b)
+	fmt.Fprintln(b, "func init() {")
+	fmt.Fprintln(b, "	maybeInitTagDict()")
+	fmt.Fprintln(b, "}")
+	fmt.Fprintln(b)
+	fmt.Fprintln(b, "func maybeInitTagDict() {")
+	fmt.Fprintln(b, "	if len(tagDict) > 0 {")
+	fmt.Fprintln(b, "		return")
+	fmt.Fprintln(b, "	}")
+	fmt.Fprintln(b, "	tagDict = make(map[Tag]Info)")
+	for _, e := range entries {
+		info := fmt.Sprintf("newInfo(%s, %q, %q, %q, %t)", tagLiteral(e.value), e.vrs, e.name(), e.vm, e.retired)
+		if e.repeating() {
+			fmt.Fprintf(b, "	addRepeating(%s, %s, %s)\n", tagLiteral(e.value), tagLiteral(e.mask), info)
+		} else {
+			fmt.Fprintf(b, "	tagDict[%s] = %s\n", tagLiteral(e.value), info)
+		}
+	}
+	fmt.Fprintln(b, "}")
+	return formatSource(b)
+}
+
+func tagLiteral(t tag.Tag) string {
+	return fmt.Sprintf("Tag{0x%04X, 0x%04X}", t.Group, t.Element)
+}
+
+// formatSource gofmts the source in b.
+func formatSource(b *bytes.Buffer) error {
+	src, err := format.Source(b.Bytes())
+	if err != nil {
+		return err
+	}
+	b.Reset()
+	b.Write(src)
+	return nil
+}
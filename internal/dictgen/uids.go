@@ -0,0 +1,110 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+const (
+	uidRegistryTable       = "table_A-1" // UID Values
+	frameOfReferenceTable  = "table_A-2" // Well-known Frames of Reference
+	frameOfReferenceType   = "Well-known frame of reference"
+	retiredUIDSuffix       = "(Retired)"
+	defaultTransferSyntaxR = ": Default Transfer Syntax"
+)
+
+// uidTypeConstants are the pkg/uid Type constants, by the UID Type in PS3.6.
+var uidTypeConstants = map[string]string{
+	"SOP Class":                     "TypeSOPClass",
+	"Transfer Syntax":               "TypeTransferSyntax",
+	"Well-known frame of reference": "TypeWellKnownFrameOfReference",
+	"Well-known SOP Instance":       "TypeWellKnownSOPInstance",
+	"Coding Scheme":                 "TypeCodingScheme",
+}
+
+// uidEntry is an entry of the generated UID dictionary.
+type uidEntry struct {
+	uid     string
+	name    string
+	keyword string
+	typ     string
+	part    string
+	retired bool
+}
+
+// uidEntries returns the UID dictionary entries of the PS3.6 tables, in table
+// order.
+func uidEntries(part6 map[string]table) ([]uidEntry, error) {
+	var entries []uidEntry
+	seen := make(map[string]bool)
+	for _, id := range []string{uidRegistryTable, frameOfReferenceTable} {
+		t, ok := part6[id]
+		if !ok {
+			return nil, fmt.Errorf("missing table %s", id)
+		}
+		cols, err := t.columns("UID Value", "UID Name", "UID Keyword")
+		if err != nil {
+			return nil, err
+		}
+		typeCol, partCol := t.column("UID Type"), t.column("Part")
+		for _, row := range t.Rows {
+			e := uidEntry{
+				uid:     cell(row, cols[0]),
+				name:    cell(row, cols[1]),
+				keyword: cell(row, cols[2]),
+				typ:     cell(row, typeCol),
+				part:    cell(row, partCol),
+			}
+			if id == frameOfReferenceTable {
+				e.typ = frameOfReferenceType
+			}
+			if e.uid == "" || e.name == "" {
+				return nil, fmt.Errorf("%s: incomplete UID entry %q", id, row)
+			}
+			if seen[e.uid] {
+				continue
+			}
+			seen[e.uid] = true
+			if strings.HasSuffix(e.name, retiredUIDSuffix) {
+				e.retired = true
+				e.name = strings.TrimSpace(strings.TrimSuffix(e.name, retiredUIDSuffix))
+			}
+			// e.g. "Implicit VR Little Endian: Default Transfer Syntax for DICOM".
+			if i := strings.Index(e.name, defaultTransferSyntaxR); i >= 0 {
+				e.name = e.name[:i]
+			}
+			entries = append(entries, e)
+		}
+	}
+	return entries, nil
+}
+
+// generateUIDs writes the source of pkg/uid/uid_definitions.go.
+func generateUIDs(b *bytes.Buffer, entries []uidEntry) error {
+	fmt.Fprintln(b, "package uid")
+	fmt.Fprintln(b)
+	fmt.Fprintln(b, "// Code generated by dictgen from PS3.6. DO NOT EDIT.")
+	fmt.Fprintln(b)
+	fmt.Fprintln(b, "var uidMap = map[string]Info{")
+	for _, e := range entries {
+		fields := []string{fmt.Sprintf("UID: %q", e.uid), fmt.Sprintf("Name: %q", e.name)}
+		if e.keyword != "" {
+			fields = append(fields, fmt.Sprintf("Keyword: %q", e.keyword))
+		}
+		if c, ok := uidTypeConstants[e.typ]; ok {
+			fields = append(fields, "Type: "+c)
+		} else {
+			fields = append(fields, fmt.Sprintf("Type: %q", e.typ))
+		}
+		if e.part != "" {
+			fields = append(fields, fmt.Sprintf("Part: %q", e.part))
+		}
+		if e.retired {
+			fields = append(fields, `Status: "Retired"`)
+		}
+		fmt.Fprintf(b, "	%q: {%s},\n", e.uid, strings.Join(fields, ", "))
+	}
+	fmt.Fprintln(b, "}")
+	return formatSource(b)
+}
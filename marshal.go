@@ -0,0 +1,435 @@
+package dicom
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/suyashkumar/dicom/pkg/personname"
+	"github.com/suyashkumar/dicom/pkg/tag"
+	"github.com/suyashkumar/dicom/pkg/vrraw"
+)
+
+var (
+	// ErrorInvalidMarshalSource indicates that the value passed to Marshal was
+	// not a struct or a non-nil pointer to a struct.
+	ErrorInvalidMarshalSource = errors.New("dicom: Marshal source must be a struct or a non-nil pointer to a struct")
+	// ErrorValueNotRepresentable indicates that a Go value cannot be encoded
+	// under the VR of its Element (for example, 1.5 as an IS).
+	ErrorValueNotRepresentable = errors.New("value is not representable in this VR")
+)
+
+// maxDecimalStringLength is the maximum length of a single DS value.
+const maxDecimalStringLength = 16
+
+// MarshalError describes a failure to marshal a Go struct field into a DICOM
+// Element.
+type MarshalError struct {
+	// Tag is the tag of the Element that failed to marshal.
+	Tag tag.Tag
+	// Path is the location of the Element within the Dataset being built,
+	// including the enclosing Sequences and Item indices.
+	Path string
+	// Field is the name of the Go struct field being marshaled.
+	Field string
+	// Type is the Go type of the struct field being marshaled.
+	Type reflect.Type
+	// Err is the underlying reason for the failure.
+	Err error
+}
+
+func (e *MarshalError) Error() string {
+	return fmt.Sprintf("dicom: cannot marshal Go struct field %s of type %v into %s %s: %v",
+		e.Field, e.Type, e.Path, e.Tag, e.Err)
+}
+
+// Unwrap returns the underlying error.
+func (e *MarshalError) Unwrap() error { return e.Err }
+
+// Marshal builds a Dataset from the provided tagged struct (or pointer to a
+// struct). It is the inverse of Unmarshal, and uses the same `dicom:"..."`
+// struct tags.
+//
+// The VR of each Element is looked up in the tag dictionary, and the Go value
+// is encoded accordingly: numbers are formatted as DS or IS strings (or stored
+// as binary values for US, SS, UL, SL, FL and FD), time.Time values are
+// formatted as DA, TM or DT, and personname.Info values are formatted as PN.
+// Nested structs become Sequences with a single Item, and slices of structs
+// become Sequences with one Item per slice entry.
+//
+// Nil pointers are skipped. Other zero values are encoded as empty Elements
+// unless the field is tagged with ",omitempty". The returned Elements are
+// sorted by tag at every nesting level.
+func Marshal(v interface{}) (Dataset, error) {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() == reflect.Ptr {
+		if rv.IsNil() {
+			return Dataset{}, ErrorInvalidMarshalSource
+		}
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		return Dataset{}, ErrorInvalidMarshalSource
+	}
+	elems, err := marshalElements(rv, "")
+	if err != nil {
+		return Dataset{}, err
+	}
+	return Dataset{Elements: elems}, nil
+}
+
+func marshalElements(rv reflect.Value, path string) ([]*Element, error) {
+	fields, err := structFields(rv.Type())
+	if err != nil {
+		return nil, err
+	}
+	elems := make([]*Element, 0, len(fields))
+	for _, f := range fields {
+		fv, ok := fieldByIndexNoAlloc(rv, f.index)
+		if !ok {
+			// Field lives in a nil embedded struct pointer.
+			continue
+		}
+		for fv.Kind() == reflect.Ptr {
+			if fv.IsNil() {
+				break
+			}
+			fv = fv.Elem()
+		}
+		if fv.Kind() == reflect.Ptr || (f.omitEmpty && isEmptyFieldValue(fv)) {
+			continue
+		}
+		fieldPath := elementPath(path, f)
+		elem, err := marshalElement(f.tag, fv, fieldPath)
+		if err != nil {
+			var mErr *MarshalError
+			if errors.As(err, &mErr) {
+				return nil, err
+			}
+			return nil, &MarshalError{Tag: f.tag, Path: fieldPath, Field: f.name, Type: fv.Type(), Err: err}
+		}
+		elems = append(elems, elem)
+	}
+	sort.SliceStable(elems, func(i, j int) bool {
+		return elems[i].Tag.Compare(elems[j].Tag) < 0
+	})
+	return elems, nil
+}
+
+// fieldByIndexNoAlloc is like reflect.Value.FieldByIndex, but reports false
+// instead of panicking when it encounters a nil embedded struct pointer.
+func fieldByIndexNoAlloc(v reflect.Value, index []int) (reflect.Value, bool) {
+	for i, x := range index {
+		if i > 0 && v.Kind() == reflect.Ptr {
+			if v.IsNil() {
+				return reflect.Value{}, false
+			}
+			v = v.Elem()
+		}
+		v = v.Field(x)
+	}
+	return v, true
+}
+
+func isEmptyFieldValue(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Slice, reflect.Map, reflect.String:
+		return v.Len() == 0
+	case reflect.Struct:
+		switch v.Type() {
+		case timeType:
+			return v.Interface().(time.Time).IsZero()
+		case personNameType:
+			return v.Interface().(personname.Info).IsEmpty()
+		}
+		return false
+	}
+	return v.IsZero()
+}
+
+func marshalElement(t tag.Tag, fv reflect.Value, path string) (*Element, error) {
+	info, err := tag.Find(t)
+	if err != nil {
+		return nil, fmt.Errorf("unable to determine VR: %w", err)
+	}
+	vr := info.VR
+
+	if vr == vrraw.Sequence {
+		items, err := marshalSequenceItems(fv, path)
+		if err != nil {
+			return nil, err
+		}
+		return &Element{
+			Tag:                    t,
+			ValueRepresentation:    tag.VRSequence,
+			RawValueRepresentation: vr,
+			Value:                  &sequencesValue{value: items},
+		}, nil
+	}
+
+	value, err := marshalValue(t, vr, fv)
+	if err != nil {
+		return nil, err
+	}
+	return &Element{
+		Tag:                    t,
+		ValueRepresentation:    tag.GetVRKind(t, vr),
+		RawValueRepresentation: vr,
+		Value:                  value,
+	}, nil
+}
+
+func marshalSequenceItems(fv reflect.Value, path string) ([]*SequenceItemValue, error) {
+	switch {
+	case fv.Kind() == reflect.Struct:
+		elems, err := marshalElements(fv, path+"[0]")
+		if err != nil {
+			return nil, err
+		}
+		return []*SequenceItemValue{{elements: elems}}, nil
+	case fv.Kind() == reflect.Slice:
+		items := make([]*SequenceItemValue, 0, fv.Len())
+		for i := 0; i < fv.Len(); i++ {
+			item := fv.Index(i)
+			if item.Kind() == reflect.Ptr {
+				if item.IsNil() {
+					continue
+				}
+				item = item.Elem()
+			}
+			if item.Kind() != reflect.Struct {
+				return nil, fmt.Errorf("%w: sequences require a struct or slice of structs", ErrorUnsupportedFieldType)
+			}
+			elems, err := marshalElements(item, fmt.Sprintf("%s[%d]", path, i))
+			if err != nil {
+				return nil, err
+			}
+			items = append(items, &SequenceItemValue{elements: elems})
+		}
+		return items, nil
+	}
+	return nil, fmt.Errorf("%w: sequences require a struct or slice of structs", ErrorUnsupportedFieldType)
+}
+
+func marshalValue(t tag.Tag, vr string, fv reflect.Value) (Value, error) {
+	switch fv.Type() {
+	case pixelDataType:
+		if t != tag.PixelData {
+			return nil, fmt.Errorf("%w: PixelDataInfo can only be marshaled into PixelData", ErrorUnsupportedFieldType)
+		}
+		return &pixelDataValue{PixelDataInfo: fv.Interface().(PixelDataInfo)}, nil
+	}
+
+	if fv.Kind() == reflect.Slice && fv.Type().Elem().Kind() == reflect.Uint8 {
+		switch vr {
+		case vrraw.OtherByte, vrraw.OtherWord:
+			return &bytesValue{value: fv.Bytes()}, nil
+		}
+		return nil, fmt.Errorf("%w: []byte requires an OB or OW VR, got %s", ErrorUnsupportedFieldType, vr)
+	}
+
+	// Normalize scalars and slices into a list of reflect.Values.
+	var values []reflect.Value
+	if fv.Kind() == reflect.Slice {
+		values = make([]reflect.Value, fv.Len())
+		for i := range values {
+			values[i] = fv.Index(i)
+		}
+	} else if fv.Kind() == reflect.String {
+		// Mirror Unmarshal, which joins multiple values with a backslash.
+		for _, s := range strings.Split(fv.String(), "\\") {
+			values = append(values, reflect.ValueOf(s))
+		}
+	} else {
+		values = []reflect.Value{fv}
+	}
+
+	switch vr {
+	case vrraw.UnsignedShort, vrraw.SignedShort, vrraw.UnsignedLong, vrraw.SignedLong, vrraw.AttributeTag:
+		ints := make([]int, len(values))
+		for i, v := range values {
+			n, err := marshalInt(v)
+			if err == nil {
+				err = checkIntRange(vr, n)
+			}
+			if err != nil {
+				return nil, fmt.Errorf("value %d: %w", i, err)
+			}
+			ints[i] = int(n)
+		}
+		return &intsValue{value: ints}, nil
+	case vrraw.FloatingPointSingle, vrraw.FloatingPointDouble:
+		floats := make([]float64, len(values))
+		for i, v := range values {
+			f, err := marshalFloat(v)
+			if err != nil {
+				return nil, fmt.Errorf("value %d: %w", i, err)
+			}
+			floats[i] = f
+		}
+		return &floatsValue{value: floats}, nil
+	case vrraw.OtherByte, vrraw.OtherWord:
+		return nil, fmt.Errorf("%w: %s requires a []byte field", ErrorUnsupportedFieldType, vr)
+	}
+
+	strs := make([]string, len(values))
+	for i, v := range values {
+		s, err := marshalString(vr, v)
+		if err != nil {
+			return nil, fmt.Errorf("value %d: %w", i, err)
+		}
+		strs[i] = s
+	}
+	return &stringsValue{value: strs}, nil
+}
+
+func marshalInt(v reflect.Value) (int64, error) {
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return v.Int(), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return int64(v.Uint()), nil
+	case reflect.String:
+		n, err := strconv.ParseInt(strings.TrimSpace(v.String()), 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid integer string %q", v.String())
+		}
+		return n, nil
+	}
+	return 0, fmt.Errorf("%w: %v", ErrorUnsupportedFieldType, v.Type())
+}
+
+func marshalFloat(v reflect.Value) (float64, error) {
+	switch v.Kind() {
+	case reflect.Float32, reflect.Float64:
+		return v.Float(), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := marshalInt(v)
+		return float64(n), err
+	case reflect.String:
+		f, err := strconv.ParseFloat(strings.TrimSpace(v.String()), 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid decimal string %q", v.String())
+		}
+		return f, nil
+	}
+	return 0, fmt.Errorf("%w: %v", ErrorUnsupportedFieldType, v.Type())
+}
+
+// checkIntRange reports an error if n cannot be stored in a binary integer of
+// the provided VR.
+func checkIntRange(vr string, n int64) error {
+	var min, max int64
+	switch vr {
+	case vrraw.UnsignedShort:
+		min, max = 0, math.MaxUint16
+	case vrraw.SignedShort:
+		min, max = math.MinInt16, math.MaxInt16
+	case vrraw.UnsignedLong, vrraw.AttributeTag:
+		min, max = 0, math.MaxUint32
+	case vrraw.SignedLong:
+		min, max = math.MinInt32, math.MaxInt32
+	default:
+		return nil
+	}
+	if n < min || n > max {
+		return fmt.Errorf("%w: %d is out of the %s range", ErrorValueNotRepresentable, n, vr)
+	}
+	return nil
+}
+
+// marshalString formats a single Go value as a string under the provided VR.
+func marshalString(vr string, v reflect.Value) (string, error) {
+	switch v.Type() {
+	case timeType:
+		return formatDICOMTime(vr, v.Interface().(time.Time))
+	case personNameType:
+		return v.Interface().(personname.Info).DCM()
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		return v.String(), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := marshalInt(v)
+		if err != nil {
+			return "", err
+		}
+		if vr == vrraw.DecimalString {
+			return formatDecimalString(float64(n))
+		}
+		return formatIntegerString(n)
+	case reflect.Float32, reflect.Float64:
+		f := v.Float()
+		if vr == vrraw.IntegerString {
+			if f != math.Trunc(f) {
+				return "", fmt.Errorf("%w: %v is not an integer", ErrorValueNotRepresentable, f)
+			}
+			return formatIntegerString(int64(f))
+		}
+		return formatDecimalString(f)
+	}
+	return "", fmt.Errorf("%w: %v", ErrorUnsupportedFieldType, v.Type())
+}
+
+// formatIntegerString formats n as an IS value.
+func formatIntegerString(n int64) (string, error) {
+	if n < math.MinInt32 || n > math.MaxInt32 {
+		return "", fmt.Errorf("%w: %d is out of the IS range", ErrorValueNotRepresentable, n)
+	}
+	return strconv.FormatInt(n, 10), nil
+}
+
+// formatDecimalString formats f as a DS value, reducing precision as needed to
+// fit within the 16 byte DS limit.
+func formatDecimalString(f float64) (string, error) {
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return "", fmt.Errorf("%w: %v", ErrorValueNotRepresentable, f)
+	}
+	s := strconv.FormatFloat(f, 'g', -1, 64)
+	for prec := maxDecimalStringLength; len(s) > maxDecimalStringLength && prec > 0; prec-- {
+		s = strconv.FormatFloat(f, 'g', prec, 64)
+	}
+	if len(s) > maxDecimalStringLength {
+		return "", fmt.Errorf("%w: %v does not fit in a DS", ErrorValueNotRepresentable, f)
+	}
+	return s, nil
+}
+
+// formatDICOMTime formats t as a DA, TM or DT value. Zero times are formatted
+// as empty values.
+func formatDICOMTime(vr string, t time.Time) (string, error) {
+	if t.IsZero() {
+		return "", nil
+	}
+	switch vr {
+	case vrraw.Date:
+		return t.Format("20060102"), nil
+	case vrraw.Time:
+		return formatTime(t), nil
+	case vrraw.DateTime:
+		s := t.Format("20060102") + formatTime(t)
+		if t.Location() != time.UTC {
+			s += t.Format("-0700")
+		}
+		return s, nil
+	}
+	return "", fmt.Errorf("%w: time.Time requires a DA, TM or DT VR, got %s", ErrorUnsupportedFieldType, vr)
+}
+
+// formatTime formats the time of day of t as HHMMSS, followed by fractional
+// seconds (with trailing zeros removed) if present.
+func formatTime(t time.Time) string {
+	s := t.Format("150405")
+	if ns := t.Nanosecond(); ns >= 1000 {
+		s += strings.TrimRight(fmt.Sprintf(".%06d", ns/1000), "0")
+	}
+	return s
+}
@@ -0,0 +1,182 @@
+package dicom
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/suyashkumar/dicom/pkg/personname"
+	"github.com/suyashkumar/dicom/pkg/tag"
+	"github.com/suyashkumar/dicom/pkg/uid"
+)
+
+type marshalTestReference struct {
+	SOPClassUID    string `dicom:"ReferencedSOPClassUID"`
+	SOPInstanceUID string `dicom:"ReferencedSOPInstanceUID"`
+}
+
+type marshalTestReport struct {
+	TransferSyntax string                 `dicom:"TransferSyntaxUID"`
+	SOPInstanceUID string                 `dicom:"SOPInstanceUID"`
+	PatientName    personname.Info        `dicom:"PatientName"`
+	StudyDate      time.Time              `dicom:"StudyDate"`
+	StudyTime      time.Time              `dicom:"StudyTime"`
+	Position       []float64              `dicom:"ImagePositionPatient"`
+	Rows           int                    `dicom:"Rows"`
+	NumberOfFrames int                    `dicom:"NumberOfFrames"`
+	Thickness      float64                `dicom:"SliceThickness"`
+	References     []marshalTestReference `dicom:"ReferencedImageSequence"`
+	Description    string                 `dicom:"StudyDescription,omitempty"`
+	AccessionNo    string                 `dicom:"AccessionNumber"`
+	Comments       *string                `dicom:"ImageComments"`
+}
+
+func TestMarshal(t *testing.T) {
+	report := marshalTestReport{
+		TransferSyntax: uid.ExplicitVRLittleEndian,
+		SOPInstanceUID: "1.2.3.4",
+		PatientName: personname.Info{
+			Alphabetic: personname.GroupInfo{FamilyName: "Potter", GivenName: "Harry"},
+		},
+		StudyDate:      time.Date(2020, time.March, 4, 0, 0, 0, 0, time.UTC),
+		StudyTime:      time.Date(0, time.January, 1, 10, 15, 30, 250000000, time.UTC),
+		Position:       []float64{-1.5, 2, 1.0 / 3},
+		Rows:           128,
+		NumberOfFrames: 3,
+		Thickness:      0.625,
+		References: []marshalTestReference{
+			{SOPClassUID: "1.2.3", SOPInstanceUID: "1.2.3.4"},
+			{SOPClassUID: "1.2.3", SOPInstanceUID: "1.2.3.5"},
+		},
+	}
+
+	ds, err := Marshal(&report)
+	if err != nil {
+		t.Fatalf("Marshal() unexpected error: %v", err)
+	}
+
+	want := Dataset{Elements: []*Element{
+		mustNewElement(tag.TransferSyntaxUID, []string{uid.ExplicitVRLittleEndian}),
+		mustNewElement(tag.SOPInstanceUID, []string{"1.2.3.4"}),
+		mustNewElement(tag.StudyDate, []string{"20200304"}),
+		mustNewElement(tag.StudyTime, []string{"101530.25"}),
+		mustNewElement(tag.AccessionNumber, []string{""}),
+		makeSequenceElement(tag.ReferencedImageSequence, [][]*Element{
+			{
+				mustNewElement(tag.ReferencedSOPClassUID, []string{"1.2.3"}),
+				mustNewElement(tag.ReferencedSOPInstanceUID, []string{"1.2.3.4"}),
+			},
+			{
+				mustNewElement(tag.ReferencedSOPClassUID, []string{"1.2.3"}),
+				mustNewElement(tag.ReferencedSOPInstanceUID, []string{"1.2.3.5"}),
+			},
+		}),
+		mustNewElement(tag.PatientName, []string{"Potter^Harry"}),
+		mustNewElement(tag.SliceThickness, []string{"0.625"}),
+		mustNewElement(tag.ImagePositionPatient, []string{"-1.5", "2", "0.33333333333333"}),
+		mustNewElement(tag.NumberOfFrames, []string{"3"}),
+		mustNewElement(tag.Rows, []int{128}),
+	}}
+	if diff := cmp.Diff(want, ds, cmp.AllowUnexported(allValues...)); diff != "" {
+		t.Fatalf("Marshal() unexpected dataset. diff: %v", diff)
+	}
+
+	// The marshaled Dataset should be writable, and read back identically.
+	var buf bytes.Buffer
+	if err := Write(&buf, ds); err != nil {
+		t.Fatalf("Write(Marshal()) unexpected error: %v", err)
+	}
+	parsed, err := Parse(&buf, int64(buf.Len()), nil)
+	if err != nil {
+		t.Fatalf("Parse(Write(Marshal())) unexpected error: %v", err)
+	}
+	var got marshalTestReport
+	if err := Unmarshal(parsed, &got); err != nil {
+		t.Fatalf("Unmarshal(Parse(Write(Marshal()))) unexpected error: %v", err)
+	}
+	report.Position[2] = 0.33333333333333
+	if diff := cmp.Diff(report, got); diff != "" {
+		t.Errorf("Marshal/Unmarshal round trip unexpected result. diff: %v", diff)
+	}
+}
+
+func TestMarshal_Errors(t *testing.T) {
+	cases := []struct {
+		name      string
+		source    interface{}
+		wantPath  string
+		wantError error
+	}{
+		{
+			name: "fractional IS",
+			source: struct {
+				N float64 `dicom:"NumberOfFrames"`
+			}{N: 1.5},
+			wantPath:  "NumberOfFrames",
+			wantError: ErrorValueNotRepresentable,
+		},
+		{
+			name: "US out of range",
+			source: struct {
+				R int `dicom:"Rows"`
+			}{R: 70000},
+			wantPath:  "Rows",
+			wantError: ErrorValueNotRepresentable,
+		},
+		{
+			name: "nested time with non-time VR",
+			source: struct {
+				Refs []struct {
+					T time.Time `dicom:"ReferencedSOPInstanceUID"`
+				} `dicom:"ReferencedImageSequence"`
+			}{Refs: []struct {
+				T time.Time `dicom:"ReferencedSOPInstanceUID"`
+			}{{}, {T: time.Now()}}},
+			wantPath:  "ReferencedImageSequence[1].ReferencedSOPInstanceUID",
+			wantError: ErrorUnsupportedFieldType,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := Marshal(tc.source)
+			var mErr *MarshalError
+			if !errors.As(err, &mErr) {
+				t.Fatalf("Marshal() expected a *MarshalError, got: %v", err)
+			}
+			if mErr.Path != tc.wantPath {
+				t.Errorf("Marshal() error reported wrong element. got: %s, want: %s", mErr.Path, tc.wantPath)
+			}
+			if !errors.Is(err, tc.wantError) {
+				t.Errorf("Marshal() unexpected underlying error. got: %v, want: %v", err, tc.wantError)
+			}
+		})
+	}
+}
+
+func TestFormatDecimalString(t *testing.T) {
+	cases := []struct {
+		in   float64
+		want string
+	}{
+		{in: 0, want: "0"},
+		{in: -0.5, want: "-0.5"},
+		{in: 1e20, want: "1e+20"},
+		{in: 123456789.123456789, want: "123456789.123457"},
+		{in: -1.0 / 3, want: "-0.3333333333333"},
+	}
+	for _, tc := range cases {
+		got, err := formatDecimalString(tc.in)
+		if err != nil {
+			t.Errorf("formatDecimalString(%v) unexpected error: %v", tc.in, err)
+		}
+		if got != tc.want {
+			t.Errorf("formatDecimalString(%v) got: %q, want: %q", tc.in, got, tc.want)
+		}
+		if len(got) > maxDecimalStringLength {
+			t.Errorf("formatDecimalString(%v) = %q exceeds the DS length limit", tc.in, got)
+		}
+	}
+}
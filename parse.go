@@ -24,6 +24,7 @@ import (
 	"bufio"
 	"encoding/binary"
 	"errors"
+	"fmt"
 	"io"
 	"log"
 	"os"
@@ -54,8 +55,8 @@ var (
 
 // Parse parses the entire DICOM at the input io.Reader into a Dataset of DICOM Elements. Use this if you are
 // looking to parse the DICOM all at once, instead of element-by-element.
-func Parse(in io.Reader, bytesToRead int64, frameChan chan *frame.Frame) (Dataset, error) {
-	p, err := NewParser(in, bytesToRead, frameChan)
+func Parse(in io.Reader, bytesToRead int64, frameChan chan *frame.Frame, opts ...ParseOption) (Dataset, error) {
+	p, err := NewParser(in, bytesToRead, frameChan, opts...)
 	if err != nil {
 		return Dataset{}, err
 	}
@@ -66,6 +67,18 @@ func Parse(in io.Reader, bytesToRead int64, frameChan chan *frame.Frame) (Datase
 			return p.dataset, err
 		}
 	}
+	if p.acrNema && p.opts.convertACRNEMA {
+		if err := convertACRNEMA(&p.dataset); err != nil {
+			return p.dataset, err
+		}
+		// Keep the metadata in step with the generated File Meta Information.
+		p.metadata = Dataset{}
+		for _, elem := range p.dataset.Elements {
+			if elem.Tag.Group == tag.MetadataGroup {
+				p.metadata.Elements = append(p.metadata.Elements, elem)
+			}
+		}
+	}
 
 	// Close the frameChannel if needed
 	if p.frameChannel != nil {
@@ -76,7 +89,7 @@ func Parse(in io.Reader, bytesToRead int64, frameChan chan *frame.Frame) (Datase
 
 // ParseFile parses the entire DICOM at the given filepath. See dicom.Parse as
 // well for a more generic io.Reader based API.
-func ParseFile(filepath string, frameChan chan *frame.Frame) (Dataset, error) {
+func ParseFile(filepath string, frameChan chan *frame.Frame, opts ...ParseOption) (Dataset, error) {
 	f, err := os.Open(filepath)
 	if err != nil {
 		return Dataset{}, err
@@ -88,7 +101,60 @@ func ParseFile(filepath string, frameChan chan *frame.Frame) (Dataset, error) {
 		return Dataset{}, err
 	}
 
-	return Parse(f, info.Size(), frameChan)
+	return Parse(f, info.Size(), frameChan, opts...)
+}
+
+// ParseOption represents an option that can be passed to Parse, ParseFile and NewParser. Later options will override
+// previous options if applicable.
+type ParseOption func(*parseOptSet)
+
+// RawDatasetTransferSyntax returns a ParseOption that reads input without a transfer syntax in its File Meta
+// Information, e.g. a bare dataset without preamble and File Meta Information such as a DIMSE P-DATA payload, with the
+// given transfer syntax instead of Implicit VR Little Endian. It has no effect on input declaring its transfer syntax.
+// Deflated transfer syntaxes are not supported yet, and make parsing fail with ErrorUnimplemented.
+func RawDatasetTransferSyntax(transferSyntaxUID string) ParseOption {
+	return func(set *parseOptSet) {
+		set.rawTransferSyntax = transferSyntaxUID
+		set.autoDetectTransferSyntax = false
+	}
+}
+
+// AutoDetectTransferSyntax returns a ParseOption that guesses the transfer syntax of input without a transfer syntax
+// in its File Meta Information from its first element: explicit VR when a VR follows the tag, and the byte order in
+// which the group number of the tag is the smallest. Only Implicit VR Little Endian, Explicit VR Little Endian and
+// Explicit VR Big Endian can be detected.
+func AutoDetectTransferSyntax() ParseOption {
+	return func(set *parseOptSet) {
+		set.rawTransferSyntax = ""
+		set.autoDetectTransferSyntax = true
+	}
+}
+
+// ConvertACRNEMAToPart10 returns a ParseOption that converts input detected as a legacy ACR-NEMA 1.0 or 2.0 file (see
+// Parser.IsACRNEMA) to a DICOM Part 10 Dataset once it is parsed: retired group lengths and ACR-NEMA encoding
+// attributes (e.g. Image Dimensions (0028,0005)) are removed, missing SOP Common and Image Pixel attributes are added
+// (e.g. a new SOPInstanceUID, and a SOPClassUID derived from the Modality), and File Meta Information for Explicit VR
+// Little Endian is generated, so that the Dataset can be written with Write. It only applies to Parse and ParseFile,
+// which read the whole Dataset.
+func ConvertACRNEMAToPart10() ParseOption {
+	return func(set *parseOptSet) {
+		set.convertACRNEMA = true
+	}
+}
+
+// parseOptSet represents the flattened option set after all ParseOptions have been applied.
+type parseOptSet struct {
+	rawTransferSyntax        string
+	autoDetectTransferSyntax bool
+	convertACRNEMA           bool
+}
+
+func toParseOptSet(opts ...ParseOption) parseOptSet {
+	optSet := parseOptSet{}
+	for _, opt := range opts {
+		opt(&optSet)
+	}
+	return optSet
 }
 
 // Parser is a struct that allows a user to parse Elements from a DICOM element-by-element using Next(), which may be
@@ -101,6 +167,9 @@ type Parser struct {
 	// file is optional, might be populated if reading from an underlying file
 	file         *os.File
 	frameChannel chan *frame.Frame
+	opts         parseOptSet
+	// acrNema is set if the input is a legacy ACR-NEMA file, see IsACRNEMA.
+	acrNema bool
 }
 
 // NewParser returns a new Parser that points to the provided io.Reader, with bytesToRead bytes left to read. NewParser
@@ -108,7 +177,12 @@ type Parser struct {
 //
 // frameChannel is an optional channel (can be nil) upon which DICOM image frames will be sent as they are parsed (if
 // provided).
-func NewParser(in io.Reader, bytesToRead int64, frameChannel chan *frame.Frame) (*Parser, error) {
+//
+// Input without a transfer syntax in its File Meta Information is read as Implicit VR Little Endian, unless another
+// transfer syntax is given with RawDatasetTransferSyntax or AutoDetectTransferSyntax. Legacy ACR-NEMA 1.0 and 2.0 files,
+// which have neither preamble nor File Meta Information, are detected unless RawDatasetTransferSyntax is given, and
+// read with implicit VRs in their byte order.
+func NewParser(in io.Reader, bytesToRead int64, frameChannel chan *frame.Frame, opts ...ParseOption) (*Parser, error) {
 	reader, err := dicomio.NewReader(bufio.NewReader(in), binary.LittleEndian, bytesToRead)
 	if err != nil {
 		return nil, err
@@ -117,6 +191,7 @@ func NewParser(in io.Reader, bytesToRead int64, frameChannel chan *frame.Frame)
 	p := Parser{
 		reader:       reader,
 		frameChannel: frameChannel,
+		opts:         toParseOptSet(opts...),
 	}
 
 	elems, err := p.readHeader()
@@ -134,9 +209,33 @@ func NewParser(in io.Reader, bytesToRead int64, frameChannel chan *frame.Frame)
 	implicit := true
 
 	ts, err := p.dataset.FindElementByTag(tag.TransferSyntaxUID)
-	if err != nil {
+	if err != nil && len(elems) == 0 && p.opts.rawTransferSyntax == "" {
+		data, _ := p.reader.Peek(acrNemaPeekLength)
+		if acrNemaOrder, ok := detectACRNEMA(data); ok {
+			bo, p.acrNema = acrNemaOrder, true
+		}
+	}
+	switch {
+	case p.acrNema:
+		// ACR-NEMA is always encoded with implicit VRs, in the byte order found by detectACRNEMA.
+	case err != nil && p.opts.rawTransferSyntax != "":
+		rawTS, err := uid.LookupTransferSyntax(p.opts.rawTransferSyntax)
+		if err != nil {
+			return nil, err
+		}
+		if rawTS.Deflated {
+			return nil, fmt.Errorf("%w: reading deflated transfer syntax %s", ErrorUnimplemented, uid.UIDString(rawTS.UID))
+		}
+		bo, implicit = rawTS.ByteOrder, rawTS.Implicit
+	case err != nil && p.opts.autoDetectTransferSyntax:
+		data, err := p.reader.Peek(8)
+		if err != nil && len(data) == 0 {
+			return nil, err
+		}
+		bo, implicit, _ = uid.ParseTransferSyntaxUID(detectTransferSyntax(data))
+	case err != nil:
 		log.Println("WARN: could not find transfer syntax uid in metadata, proceeding with little endian implicit")
-	} else {
+	default:
 		bo, implicit, err = uid.ParseTransferSyntaxUID(MustGetStrings(ts.Value)[0])
 		if err != nil {
 			// TODO(suyashkumar): should we attempt to parse with LittleEndian
@@ -182,6 +281,12 @@ func (p *Parser) Next() (*Element, error) {
 
 }
 
+// IsACRNEMA indicates whether the input was detected as a legacy ACR-NEMA 1.0 or 2.0 file, i.e. a message without
+// preamble nor File Meta Information starting with the retired ACR-NEMA attributes of the Identifying group (0008).
+func (p *Parser) IsACRNEMA() bool {
+	return p.acrNema
+}
+
 // GetMetadata returns just the set of metadata elements that have been parsed
 // so far.
 func (p *Parser) GetMetadata() Dataset {
@@ -195,6 +300,10 @@ func (p *Parser) readHeader() ([]*Element, error) {
 	// compatibility mode, where we rewind to position 0 and blindly attempt to
 	// parse a Dataset (and do not parse metadata in the usual way).
 	data, err := p.reader.Peek(128 + 4)
+	if err == io.EOF && len(data) > 0 {
+		// Input shorter than a preamble, e.g. a small bare dataset.
+		return nil, nil
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -239,3 +348,24 @@ func (p *Parser) readHeader() ([]*Element, error) {
 	}
 	return metaElems, nil
 }
+
+// detectTransferSyntax guesses the transfer syntax of a dataset from the first bytes of its first element, see
+// AutoDetectTransferSyntax.
+func detectTransferSyntax(data []byte) string {
+	if len(data) < 4 {
+		return uid.ImplicitVRLittleEndian
+	}
+	explicit := len(data) >= 6 && isUpperASCII(data[4]) && isUpperASCII(data[5])
+	if !explicit {
+		// Implicit VR Big Endian is not defined by the standard.
+		return uid.ImplicitVRLittleEndian
+	}
+	if binary.BigEndian.Uint16(data) < binary.LittleEndian.Uint16(data) {
+		return uid.ExplicitVRBigEndian
+	}
+	return uid.ExplicitVRLittleEndian
+}
+
+func isUpperASCII(b byte) bool {
+	return b >= 'A' && b <= 'Z'
+}
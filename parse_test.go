@@ -3,6 +3,7 @@ package dicom_test
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"image/jpeg"
 	"io/ioutil"
@@ -11,6 +12,7 @@ import (
 	"testing"
 
 	"github.com/suyashkumar/dicom/pkg/tag"
+	"github.com/suyashkumar/dicom/pkg/uid"
 
 	"github.com/suyashkumar/dicom/pkg/frame"
 
@@ -45,6 +47,57 @@ func TestParse(t *testing.T) {
 	}
 }
 
+func TestParse_RawDataset(t *testing.T) {
+	patientName, err := dicom.NewElement(tag.PatientName, []string{"Bob", "Jones"})
+	if err != nil {
+		t.Fatalf("NewElement() unexpected error: %v", err)
+	}
+	rows, err := dicom.NewElement(tag.Rows, []int{128})
+	if err != nil {
+		t.Fatalf("NewElement() unexpected error: %v", err)
+	}
+	ds := dicom.Dataset{Elements: []*dicom.Element{patientName, rows}}
+
+	for _, ts := range []string{uid.ImplicitVRLittleEndian, uid.ExplicitVRLittleEndian, uid.ExplicitVRBigEndian} {
+		raw := &bytes.Buffer{}
+		if err := dicom.WriteDataset(raw, ds, ts); err != nil {
+			t.Fatalf("WriteDataset(%s) unexpected error: %v", ts, err)
+		}
+		for name, opt := range map[string]dicom.ParseOption{
+			"RawDatasetTransferSyntax": dicom.RawDatasetTransferSyntax(ts),
+			"AutoDetectTransferSyntax": dicom.AutoDetectTransferSyntax(),
+		} {
+			t.Run(fmt.Sprintf("%s/%s", name, uid.MustLookup(ts).Name), func(t *testing.T) {
+				got, err := dicom.Parse(bytes.NewReader(raw.Bytes()), int64(raw.Len()), nil, opt)
+				if err != nil {
+					t.Fatalf("Parse() unexpected error: %v", err)
+				}
+				gotName, err := got.FindElementByTag(tag.PatientName)
+				if err != nil {
+					t.Fatalf("Parse() did not read PatientName: %v", err)
+				}
+				if n := dicom.MustGetStrings(gotName.Value); len(n) != 2 || n[0] != "Bob" || n[1] != "Jones" {
+					t.Errorf("Parse() unexpected PatientName. got: %v, want: [Bob Jones]", n)
+				}
+				gotRows, err := got.FindElementByTag(tag.Rows)
+				if err != nil {
+					t.Fatalf("Parse() did not read Rows: %v", err)
+				}
+				if r := dicom.MustGetInts(gotRows.Value); len(r) != 1 || r[0] != 128 {
+					t.Errorf("Parse() unexpected Rows. got: %v, want: [128]", r)
+				}
+			})
+		}
+	}
+
+	if _, err := dicom.Parse(bytes.NewReader([]byte{8, 0}), 2, nil, dicom.RawDatasetTransferSyntax("1.2.3")); !errors.Is(err, uid.ErrorUnknownTransferSyntax) {
+		t.Errorf("Parse(RawDatasetTransferSyntax(1.2.3)) unexpected error. got: %v, want: %v", err, uid.ErrorUnknownTransferSyntax)
+	}
+	if _, err := dicom.Parse(bytes.NewReader([]byte{8, 0}), 2, nil, dicom.RawDatasetTransferSyntax(uid.DeflatedExplicitVRLittleEndian)); !errors.Is(err, dicom.ErrorUnimplemented) {
+		t.Errorf("Parse(RawDatasetTransferSyntax(%s)) unexpected error. got: %v, want: %v", uid.DeflatedExplicitVRLittleEndian, err, dicom.ErrorUnimplemented)
+	}
+}
+
 // BenchmarkParse runs sanity benchmarks over the sample files in testdata.
 func BenchmarkParse(b *testing.B) {
 	files, err := ioutil.ReadDir("./testdata")
@@ -3,6 +3,7 @@ package dicomio
 import (
 	"encoding/binary"
 	"io"
+	"math"
 )
 
 // Writer is a lower level encoder that manages writing out entities to an
@@ -11,6 +12,9 @@ type Writer struct {
 	out      io.Writer
 	bo       binary.ByteOrder
 	implicit bool
+	// scratch holds the encoding of a single number, which avoids the
+	// reflection and allocations of binary.Write.
+	scratch [8]byte
 }
 
 // NewWriter initializes and returns a Writer.
@@ -42,13 +46,15 @@ func (w *Writer) WriteZeros(len int) error {
 
 // WriteString writes the provided string to the Writer.
 func (w *Writer) WriteString(v string) error {
-	_, err := w.out.Write([]byte(v))
+	_, err := io.WriteString(w.out, v)
 	return err
 }
 
 // WriteByte writes the provided byte to the Writer.
 func (w *Writer) WriteByte(v byte) error {
-	return binary.Write(w.out, w.bo, &v)
+	w.scratch[0] = v
+	_, err := w.out.Write(w.scratch[:1])
+	return err
 }
 
 // WriteBytes writes the provided byte slice to the Writer.
@@ -59,20 +65,26 @@ func (w *Writer) WriteBytes(v []byte) error {
 
 // WriteUInt16 writes the provided uint16 to the Writer.
 func (w *Writer) WriteUInt16(v uint16) error {
-	return binary.Write(w.out, w.bo, &v)
+	w.bo.PutUint16(w.scratch[:2], v)
+	_, err := w.out.Write(w.scratch[:2])
+	return err
 }
 
 // WriteUInt32 writes the provided uint32 to the Writer.
 func (w *Writer) WriteUInt32(v uint32) error {
-	return binary.Write(w.out, w.bo, &v)
+	w.bo.PutUint32(w.scratch[:4], v)
+	_, err := w.out.Write(w.scratch[:4])
+	return err
 }
 
 // WriteFloat32 writes the provided float32 to the Writer.
 func (w *Writer) WriteFloat32(v float32) error {
-	return binary.Write(w.out, w.bo, &v)
+	return w.WriteUInt32(math.Float32bits(v))
 }
 
 // WriteFloat64 writes the provided float64 to the Writer.
 func (w *Writer) WriteFloat64(v float64) error {
-	return binary.Write(w.out, w.bo, &v)
+	w.bo.PutUint64(w.scratch[:8], math.Float64bits(v))
+	_, err := w.out.Write(w.scratch[:8])
+	return err
 }
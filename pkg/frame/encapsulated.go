@@ -2,14 +2,26 @@ package frame
 
 import (
 	"bytes"
+	"errors"
+	"fmt"
 	"image"
 	"image/jpeg"
+
+	"github.com/suyashkumar/dicom/pkg/uid"
 )
 
+// ErrorUnsupportedCodec is returned by EncapsulatedFrame.GetImage when the
+// frame is compressed with a codec that can not be decoded.
+var ErrorUnsupportedCodec = errors.New("unsupported codec for decoding the encapsulated frame")
+
 // EncapsulatedFrame represents an encapsulated image frame
 type EncapsulatedFrame struct {
-	// Data is a collection of bytes representing a JPEG encoded image frame
+	// Data is a collection of bytes representing a compressed image frame,
+	// e.g. JPEG encoded.
 	Data []byte
+	// TransferSyntaxUID is the transfer syntax of the Dataset the frame was
+	// read from, which tells how Data is compressed, or "" if unknown.
+	TransferSyntaxUID string
 }
 
 // IsEncapsulated indicates if the frame is encapsulated or not.
@@ -26,8 +38,14 @@ func (e *EncapsulatedFrame) GetNativeFrame() (*NativeFrame, error) {
 	return nil, ErrorFrameTypeNotPresent
 }
 
-// GetImage returns a Go image.Image from the underlying frame.
+// GetImage returns a Go image.Image from the underlying frame. Only JPEG
+// encoded frames can be decoded: ErrorUnsupportedCodec is returned if the
+// transfer syntax of the frame uses another codec. Frames of an unknown or
+// unregistered transfer syntax are assumed to be JPEG encoded.
 func (e *EncapsulatedFrame) GetImage() (image.Image, error) {
+	if ts, err := uid.LookupTransferSyntax(e.TransferSyntaxUID); err == nil && ts.Codec != uid.CodecJPEG {
+		return nil, fmt.Errorf("%w: %s (%s)", ErrorUnsupportedCodec, ts.Codec, ts.Name)
+	}
 	// Decoding the data to only re-encode it as a JPEG *without* modifications
 	// is very inefficient. If all you want to do is write the JPEG to disk,
 	// you should fetch the EncapsulatedFrame and grab the []byte Data from
@@ -0,0 +1,42 @@
+package frame_test
+
+import (
+	"bytes"
+	"errors"
+	"image"
+	"image/jpeg"
+	"testing"
+
+	"github.com/suyashkumar/dicom/pkg/frame"
+)
+
+func TestEncapsulatedFrame_GetImage(t *testing.T) {
+	var jpegData bytes.Buffer
+	if err := jpeg.Encode(&jpegData, image.NewGray(image.Rect(0, 0, 2, 2)), nil); err != nil {
+		t.Fatalf("unable to encode test JPEG: %v", err)
+	}
+
+	cases := []struct {
+		name              string
+		transferSyntaxUID string
+		wantErr           error
+	}{
+		{name: "unknown transfer syntax", transferSyntaxUID: ""},
+		{name: "JPEG Baseline", transferSyntaxUID: "1.2.840.10008.1.2.4.50"},
+		{name: "RLE Lossless", transferSyntaxUID: "1.2.840.10008.1.2.5", wantErr: frame.ErrorUnsupportedCodec},
+		{name: "JPEG 2000", transferSyntaxUID: "1.2.840.10008.1.2.4.90", wantErr: frame.ErrorUnsupportedCodec},
+		{name: "unregistered transfer syntax", transferSyntaxUID: "1.2.3.4"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			f := frame.EncapsulatedFrame{Data: jpegData.Bytes(), TransferSyntaxUID: tc.transferSyntaxUID}
+			img, err := f.GetImage()
+			if !errors.Is(err, tc.wantErr) {
+				t.Fatalf("GetImage() unexpected error. got: %v, want: %v", err, tc.wantErr)
+			}
+			if tc.wantErr == nil && img.Bounds() != image.Rect(0, 0, 2, 2) {
+				t.Errorf("GetImage() unexpected bounds: %v", img.Bounds())
+			}
+		})
+	}
+}
@@ -0,0 +1,83 @@
+package iod
+
+import (
+	"strconv"
+
+	"github.com/suyashkumar/dicom"
+	"github.com/suyashkumar/dicom/pkg/tag"
+)
+
+// Condition is the rule of a conditional attribute or module. It is evaluated
+// on the Dataset, or sequence item, containing the attribute.
+type Condition func(ds *dicom.Dataset) bool
+
+// Present returns a Condition met when the attribute t is present, even if
+// empty.
+func Present(t tag.Tag) Condition {
+	return func(ds *dicom.Dataset) bool {
+		_, err := ds.FindElementByTag(t)
+		return err == nil
+	}
+}
+
+// Equals returns a Condition met when the first value of the attribute t is
+// one of values.
+func Equals(t tag.Tag, values ...string) Condition {
+	return func(ds *dicom.Dataset) bool {
+		v, err := stringValues(ds, t)
+		return err == nil && len(v) > 0 && contains(values, v[0])
+	}
+}
+
+// Contains returns a Condition met when any value of the attribute t is
+// value, e.g. when Scanning Sequence contains "IR".
+func Contains(t tag.Tag, value string) Condition {
+	return func(ds *dicom.Dataset) bool {
+		v, err := stringValues(ds, t)
+		return err == nil && contains(v, value)
+	}
+}
+
+// GreaterThan returns a Condition met when the first value of the numeric
+// attribute t is greater than n.
+func GreaterThan(t tag.Tag, n float64) Condition {
+	return func(ds *dicom.Dataset) bool {
+		v, err := stringValues(ds, t)
+		if err != nil || len(v) == 0 {
+			return false
+		}
+		f, err := strconv.ParseFloat(v[0], 64)
+		return err == nil && f > n
+	}
+}
+
+// Not returns a Condition met when c is not.
+func Not(c Condition) Condition {
+	return func(ds *dicom.Dataset) bool {
+		return !c(ds)
+	}
+}
+
+// And returns a Condition met when all of conds are.
+func And(conds ...Condition) Condition {
+	return func(ds *dicom.Dataset) bool {
+		for _, c := range conds {
+			if !c(ds) {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// Or returns a Condition met when any of conds is.
+func Or(conds ...Condition) Condition {
+	return func(ds *dicom.Dataset) bool {
+		for _, c := range conds {
+			if c(ds) {
+				return true
+			}
+		}
+		return false
+	}
+}
@@ -0,0 +1,41 @@
+package iod
+
+import (
+	"testing"
+
+	"github.com/suyashkumar/dicom"
+	"github.com/suyashkumar/dicom/pkg/tag"
+)
+
+func TestConditions(t *testing.T) {
+	ds := &dicom.Dataset{Elements: []*dicom.Element{
+		mustNewElement(tag.ScanningSequence, []string{"SE", "IR"}),
+		mustNewElement(tag.SamplesPerPixel, []int{3}),
+		mustNewElement(tag.PatientName, []string{""}),
+	}}
+	cases := []struct {
+		name string
+		cond Condition
+		want bool
+	}{
+		{name: "Present", cond: Present(tag.PatientName), want: true},
+		{name: "Present absent", cond: Present(tag.PatientID), want: false},
+		{name: "Equals", cond: Equals(tag.ScanningSequence, "GR", "SE"), want: true},
+		{name: "Equals only first value", cond: Equals(tag.ScanningSequence, "IR"), want: false},
+		{name: "Equals int", cond: Equals(tag.SamplesPerPixel, "3"), want: true},
+		{name: "Contains", cond: Contains(tag.ScanningSequence, "IR"), want: true},
+		{name: "Contains absent", cond: Contains(tag.SequenceVariant, "SK"), want: false},
+		{name: "GreaterThan", cond: GreaterThan(tag.SamplesPerPixel, 1), want: true},
+		{name: "GreaterThan not numeric", cond: GreaterThan(tag.ScanningSequence, 1), want: false},
+		{name: "Not", cond: Not(Present(tag.PatientID)), want: true},
+		{name: "And", cond: And(Present(tag.PatientName), Present(tag.PatientID)), want: false},
+		{name: "Or", cond: Or(Present(tag.PatientName), Present(tag.PatientID)), want: true},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.cond(ds); got != tc.want {
+				t.Errorf("condition got: %v, want: %v", got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,410 @@
+// Package iod validates DICOM Datasets against the Information Object
+// Definitions (IODs) of PS3.3, i.e. checks that they have the attributes the
+// modules of their IOD require, with valid values.
+//
+//	dataset, _ := dicom.ParseFile("ct.dcm", nil)
+//	findings, err := iod.Validate(&dataset)
+//	for _, f := range findings {
+//		fmt.Println(f)
+//	}
+package iod
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/suyashkumar/dicom"
+	"github.com/suyashkumar/dicom/pkg/tag"
+	"github.com/suyashkumar/dicom/pkg/uid"
+)
+
+var (
+	// ErrorMissingSOPClass indicates that a Dataset has no SOP Class UID,
+	// which identifies its IOD.
+	ErrorMissingSOPClass = errors.New("dataset has no SOPClassUID")
+	// ErrorUnknownIOD indicates that there is no IOD definition for the SOP
+	// Class of a Dataset.
+	ErrorUnknownIOD = errors.New("no IOD definition for the SOP Class")
+)
+
+// Type is the requirement type of an attribute in a module (PS3.5 7.4).
+type Type int
+
+const (
+	// Type1 attributes must be present with a value.
+	Type1 Type = iota + 1
+	// Type1C attributes must be present with a value if their Condition is
+	// met.
+	Type1C
+	// Type2 attributes must be present, but may be empty.
+	Type2
+	// Type2C attributes must be present, but may be empty, if their Condition
+	// is met.
+	Type2C
+	// Type3 attributes are optional.
+	Type3
+)
+
+// String returns the PS3.3 notation of t, e.g. "1C".
+func (t Type) String() string {
+	switch t {
+	case Type1:
+		return "1"
+	case Type1C:
+		return "1C"
+	case Type2:
+		return "2"
+	case Type2C:
+		return "2C"
+	case Type3:
+		return "3"
+	default:
+		return fmt.Sprintf("Type(%d)", int(t))
+	}
+}
+
+// Attribute is the definition of an attribute within a module, or within the
+// items of a sequence attribute.
+type Attribute struct {
+	Tag  tag.Tag
+	Type Type
+	// Condition tells if a Type1C or Type2C attribute is required. It is
+	// evaluated on the Dataset or sequence item containing the attribute.
+	Condition Condition
+	// Enum lists the enumerated values allowed for every value of the
+	// attribute, if not empty.
+	Enum []string
+	// ValueEnums lists the enumerated values allowed for the first values of
+	// a multi-valued attribute, e.g. for Value 1 and Value 2 of Image Type. An
+	// empty entry allows any value.
+	ValueEnums [][]string
+	// Items lists the attributes of each item of a sequence attribute.
+	Items []Attribute
+}
+
+// Module is the definition of an IOD module (PS3.3 Section C).
+type Module struct {
+	Name       string
+	Attributes []Attribute
+}
+
+// Usage is the usage of a module in an IOD.
+type Usage int
+
+const (
+	// Mandatory modules must be present.
+	Mandatory Usage = iota + 1
+	// Conditional modules must be present if their Condition is met.
+	Conditional
+	// UserOption modules are optional.
+	UserOption
+)
+
+// ModuleUsage is a module of an IOD, with its usage.
+type ModuleUsage struct {
+	Module *Module
+	Usage  Usage
+	// Condition tells if a Conditional module is required. Conditional and
+	// UserOption modules are also validated when any of their attributes that
+	// no other module of the IOD defines is present.
+	Condition Condition
+}
+
+// IOD is an Information Object Definition, i.e. the modules the instances of
+// some SOP Classes are made of.
+type IOD struct {
+	Name string
+	// SOPClasses lists the UIDs of the SOP Classes whose instances follow this
+	// IOD.
+	SOPClasses []string
+	Modules    []ModuleUsage
+}
+
+// FindingKind is the kind of problem reported by a Finding.
+type FindingKind int
+
+const (
+	// MissingAttribute indicates that a required attribute is absent.
+	MissingAttribute FindingKind = iota + 1
+	// EmptyAttribute indicates that an attribute that requires a value is
+	// empty.
+	EmptyAttribute
+	// InvalidValue indicates that a value is not one of the enumerated
+	// values of an attribute.
+	InvalidValue
+)
+
+// String returns a description of k.
+func (k FindingKind) String() string {
+	switch k {
+	case MissingAttribute:
+		return "missing attribute"
+	case EmptyAttribute:
+		return "empty attribute"
+	case InvalidValue:
+		return "invalid value"
+	default:
+		return fmt.Sprintf("FindingKind(%d)", int(k))
+	}
+}
+
+// PathElement is a step of a Path: an attribute and, if the Path continues
+// within the items of a sequence attribute, the index of the item.
+type PathElement struct {
+	Tag  tag.Tag
+	Item int
+}
+
+// Path locates an attribute within nested sequences, from the top-level
+// Dataset.
+type Path []PathElement
+
+// String returns p with the keywords of its attributes, e.g.
+// "ReferencedSeriesSequence[0].SeriesInstanceUID".
+func (p Path) String() string {
+	parts := make([]string, len(p))
+	for i, e := range p {
+		name := e.Tag.String()
+		if info, err := tag.Find(e.Tag); err == nil {
+			name = info.Keyword
+		}
+		if i < len(p)-1 {
+			name += "[" + strconv.Itoa(e.Item) + "]"
+		}
+		parts[i] = name
+	}
+	return strings.Join(parts, ".")
+}
+
+// Finding is a conformance problem found by Validate.
+type Finding struct {
+	Kind   FindingKind
+	Path   Path
+	Module string // The name of the module defining the attribute.
+	Type   Type   // The requirement type of the attribute.
+	// Value is the invalid value of InvalidValue findings.
+	Value string
+}
+
+// String describes f, e.g.
+// "missing attribute PatientName (Type 2, Patient module)".
+func (f Finding) String() string {
+	desc := fmt.Sprintf("%s %s", f.Kind, f.Path)
+	if f.Kind == InvalidValue {
+		desc += fmt.Sprintf(" %q", f.Value)
+	}
+	return fmt.Sprintf("%s (Type %s, %s module)", desc, f.Type, f.Module)
+}
+
+// ForSOPClass returns the IOD of instances of the SOP Class sopClassUID. An
+// error wrapping ErrorUnknownIOD is returned if it is not defined in this
+// package.
+func ForSOPClass(sopClassUID string) (*IOD, error) {
+	d, ok := iodsBySOPClass[sopClassUID]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrorUnknownIOD, uid.UIDString(sopClassUID))
+	}
+	return d, nil
+}
+
+// Validate validates ds against the IOD of its SOP Class UID, see
+// IOD.Validate. An error is returned if ds has no SOP Class UID, or if its IOD
+// is not defined in this package.
+func Validate(ds *dicom.Dataset) ([]Finding, error) {
+	values, err := stringValues(ds, tag.SOPClassUID)
+	if err != nil || len(values) == 0 || values[0] == "" {
+		return nil, ErrorMissingSOPClass
+	}
+	d, err := ForSOPClass(values[0])
+	if err != nil {
+		return nil, err
+	}
+	return d.Validate(ds), nil
+}
+
+// Validate checks that ds follows the IOD d, and returns the problems found:
+// missing or empty attributes, according to their type in each module, and
+// values that are not enumerated values. It returns no findings if ds is
+// valid.
+func (d *IOD) Validate(ds *dicom.Dataset) []Finding {
+	var findings []Finding
+	for _, mu := range d.Modules {
+		if !mu.required(ds, d.Modules) {
+			continue
+		}
+		findings = append(findings, validateAttributes(ds, mu.Module.Attributes, mu.Module.Name, nil)...)
+	}
+	return findings
+}
+
+// required indicates if the module must be validated in ds, an instance of
+// the IOD made of modules. Attributes that other modules also define, e.g.
+// Manufacturer, do not tell that the module is used, so only the presence of
+// the attributes specific to the module is considered.
+func (mu ModuleUsage) required(ds *dicom.Dataset, modules []ModuleUsage) bool {
+	switch {
+	case mu.Usage == Mandatory:
+		return true
+	case mu.Usage == Conditional && mu.Condition != nil && mu.Condition(ds):
+		return true
+	}
+	for _, a := range mu.Module.Attributes {
+		if definedByOther(a.Tag, mu.Module, modules) {
+			continue
+		}
+		if _, err := ds.FindElementByTag(a.Tag); err == nil {
+			return true
+		}
+	}
+	return false
+}
+
+// definedByOther indicates if a module of modules other than m defines the
+// attribute t.
+func definedByOther(t tag.Tag, m *Module, modules []ModuleUsage) bool {
+	for _, mu := range modules {
+		if mu.Module == m {
+			continue
+		}
+		for _, a := range mu.Module.Attributes {
+			if a.Tag == t {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func validateAttributes(ds *dicom.Dataset, attrs []Attribute, module string, parent Path) []Finding {
+	var findings []Finding
+	for _, a := range attrs {
+		path := append(append(Path{}, parent...), PathElement{Tag: a.Tag})
+		newFinding := func(kind FindingKind, value string) Finding {
+			return Finding{Kind: kind, Path: path, Module: module, Type: a.Type, Value: value}
+		}
+
+		elem, err := ds.FindElementByTag(a.Tag)
+		if err != nil {
+			if a.required(ds) {
+				findings = append(findings, newFinding(MissingAttribute, ""))
+			}
+			continue
+		}
+		if isEmpty(elem) {
+			if a.requiresValue(ds) {
+				findings = append(findings, newFinding(EmptyAttribute, ""))
+			}
+			continue
+		}
+
+		if elem.Value.ValueType() == dicom.Sequences {
+			for i, item := range elem.Value.GetValue().([]*dicom.SequenceItemValue) {
+				itemDS := &dicom.Dataset{Elements: item.GetValue().([]*dicom.Element)}
+				itemPath := append(append(Path{}, parent...), PathElement{Tag: a.Tag, Item: i})
+				findings = append(findings, validateAttributes(itemDS, a.Items, module, itemPath)...)
+			}
+			continue
+		}
+		for i, v := range valueStrings(elem) {
+			if !a.allows(i, v) {
+				findings = append(findings, newFinding(InvalidValue, v))
+			}
+		}
+	}
+	return findings
+}
+
+// required indicates if a must be present in ds.
+func (a Attribute) required(ds *dicom.Dataset) bool {
+	switch a.Type {
+	case Type1, Type2:
+		return true
+	case Type1C, Type2C:
+		return a.Condition != nil && a.Condition(ds)
+	}
+	return false
+}
+
+// requiresValue indicates if a must have a value in ds.
+func (a Attribute) requiresValue(ds *dicom.Dataset) bool {
+	return (a.Type == Type1 || a.Type == Type1C) && a.required(ds)
+}
+
+// allows indicates if v is a valid value at index i of a.
+func (a Attribute) allows(i int, v string) bool {
+	if v == "" {
+		return true
+	}
+	if len(a.Enum) > 0 && !contains(a.Enum, v) {
+		return false
+	}
+	if i < len(a.ValueEnums) && len(a.ValueEnums[i]) > 0 && !contains(a.ValueEnums[i], v) {
+		return false
+	}
+	return true
+}
+
+func contains(values []string, v string) bool {
+	for _, e := range values {
+		if e == v {
+			return true
+		}
+	}
+	return false
+}
+
+// isEmpty indicates if elem has no value, i.e. a zero length.
+func isEmpty(elem *dicom.Element) bool {
+	if elem.Value == nil {
+		return true
+	}
+	switch v := elem.Value.GetValue().(type) {
+	case []*dicom.SequenceItemValue:
+		return len(v) == 0
+	case []byte:
+		return len(v) == 0
+	case dicom.PixelDataInfo:
+		return len(v.Frames) == 0
+	}
+	for _, s := range valueStrings(elem) {
+		if s != "" {
+			return false
+		}
+	}
+	return true
+}
+
+// valueStrings returns the values of elem as strings, with any padding
+// removed. Values that are not strings, ints or floats are not returned.
+func valueStrings(elem *dicom.Element) []string {
+	if elem.Value == nil {
+		return nil
+	}
+	var values []string
+	switch v := elem.Value.GetValue().(type) {
+	case []string:
+		for _, s := range v {
+			values = append(values, strings.Trim(s, " \x00"))
+		}
+	case []int:
+		for _, n := range v {
+			values = append(values, strconv.Itoa(n))
+		}
+	case []float64:
+		for _, f := range v {
+			values = append(values, strconv.FormatFloat(f, 'g', -1, 64))
+		}
+	}
+	return values
+}
+
+// stringValues returns the values of the attribute t of ds as strings.
+func stringValues(ds *dicom.Dataset, t tag.Tag) ([]string, error) {
+	elem, err := ds.FindElementByTag(t)
+	if err != nil {
+		return nil, err
+	}
+	return valueStrings(elem), nil
+}
@@ -0,0 +1,311 @@
+package iod
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/suyashkumar/dicom"
+	"github.com/suyashkumar/dicom/pkg/frame"
+	"github.com/suyashkumar/dicom/pkg/tag"
+	"github.com/suyashkumar/dicom/pkg/uid"
+)
+
+func mustNewElement(t tag.Tag, data interface{}) *dicom.Element {
+	elem, err := dicom.NewElement(t, data)
+	if err != nil {
+		panic(err)
+	}
+	return elem
+}
+
+// ctDataset returns a valid CT Image, with elems added or replacing those with
+// the same tag.
+func ctDataset(elems ...*dicom.Element) *dicom.Dataset {
+	ds := &dicom.Dataset{Elements: []*dicom.Element{
+		mustNewElement(tag.SOPClassUID, []string{uid.CTImageStorage}),
+		mustNewElement(tag.SOPInstanceUID, []string{"1.2.3.4.5"}),
+		mustNewElement(tag.PatientName, []string{"Doe^John"}),
+		mustNewElement(tag.PatientID, []string{"12345"}),
+		mustNewElement(tag.PatientBirthDate, []string{""}),
+		mustNewElement(tag.PatientSex, []string{"O"}),
+		mustNewElement(tag.StudyInstanceUID, []string{"1.2.3"}),
+		mustNewElement(tag.StudyDate, []string{"20200101"}),
+		mustNewElement(tag.StudyTime, []string{"120000"}),
+		mustNewElement(tag.ReferringPhysicianName, []string{""}),
+		mustNewElement(tag.StudyID, []string{"1"}),
+		mustNewElement(tag.AccessionNumber, []string{""}),
+		mustNewElement(tag.Modality, []string{"CT"}),
+		mustNewElement(tag.SeriesInstanceUID, []string{"1.2.3.4"}),
+		mustNewElement(tag.SeriesNumber, []string{"1"}),
+		mustNewElement(tag.PatientPosition, []string{"HFS"}),
+		mustNewElement(tag.FrameOfReferenceUID, []string{"1.2.3.6"}),
+		mustNewElement(tag.PositionReferenceIndicator, []string{""}),
+		mustNewElement(tag.Manufacturer, []string{"ACME"}),
+		mustNewElement(tag.InstanceNumber, []string{"1"}),
+		mustNewElement(tag.PixelSpacing, []string{"0.5", "0.5"}),
+		mustNewElement(tag.ImageOrientationPatient, []string{"1", "0", "0", "0", "1", "0"}),
+		mustNewElement(tag.ImagePositionPatient, []string{"0", "0", "0"}),
+		mustNewElement(tag.SliceThickness, []string{"1"}),
+		mustNewElement(tag.ImageType, []string{"ORIGINAL", "PRIMARY", "AXIAL"}),
+		mustNewElement(tag.SamplesPerPixel, []int{1}),
+		mustNewElement(tag.PhotometricInterpretation, []string{"MONOCHROME2"}),
+		mustNewElement(tag.Rows, []int{1}),
+		mustNewElement(tag.Columns, []int{1}),
+		mustNewElement(tag.BitsAllocated, []int{16}),
+		mustNewElement(tag.BitsStored, []int{12}),
+		mustNewElement(tag.HighBit, []int{11}),
+		mustNewElement(tag.PixelRepresentation, []int{0}),
+		mustNewElement(tag.RescaleIntercept, []string{"-1024"}),
+		mustNewElement(tag.RescaleSlope, []string{"1"}),
+		mustNewElement(tag.KVP, []string{"120"}),
+		mustNewElement(tag.AcquisitionNumber, []string{"1"}),
+		mustNewElement(tag.PixelData, dicom.PixelDataInfo{Frames: []frame.Frame{
+			{NativeData: frame.NativeFrame{BitsPerSample: 16, Rows: 1, Cols: 1, Data: [][]int{{0}}}},
+		}}),
+	}}
+	return withElements(ds, elems...)
+}
+
+func withElements(ds *dicom.Dataset, elems ...*dicom.Element) *dicom.Dataset {
+	for _, elem := range elems {
+		replaced := false
+		for i, e := range ds.Elements {
+			if e.Tag == elem.Tag {
+				ds.Elements[i] = elem
+				replaced = true
+			}
+		}
+		if !replaced {
+			ds.Elements = append(ds.Elements, elem)
+		}
+	}
+	return ds
+}
+
+func without(ds *dicom.Dataset, tags ...tag.Tag) *dicom.Dataset {
+	var elems []*dicom.Element
+	for _, e := range ds.Elements {
+		keep := true
+		for _, t := range tags {
+			if e.Tag == t {
+				keep = false
+			}
+		}
+		if keep {
+			elems = append(elems, e)
+		}
+	}
+	return &dicom.Dataset{Elements: elems}
+}
+
+func TestValidate_CTImage(t *testing.T) {
+	cases := []struct {
+		name string
+		ds   *dicom.Dataset
+		want []Finding
+	}{
+		{
+			name: "valid",
+			ds:   ctDataset(),
+		},
+		{
+			name: "missing Type 1 and Type 2",
+			ds:   without(ctDataset(), tag.StudyInstanceUID, tag.PatientName),
+			want: []Finding{
+				{Kind: MissingAttribute, Path: Path{{Tag: tag.PatientName}}, Module: "Patient", Type: Type2},
+				{Kind: MissingAttribute, Path: Path{{Tag: tag.StudyInstanceUID}}, Module: "General Study", Type: Type1},
+			},
+		},
+		{
+			name: "empty Type 1",
+			ds:   ctDataset(mustNewElement(tag.SeriesInstanceUID, []string{""})),
+			want: []Finding{
+				{Kind: EmptyAttribute, Path: Path{{Tag: tag.SeriesInstanceUID}}, Module: "General Series", Type: Type1},
+			},
+		},
+		{
+			name: "invalid enumerated values",
+			ds: ctDataset(
+				mustNewElement(tag.PatientSex, []string{"X"}),
+				mustNewElement(tag.ImageType, []string{"ORIGINAL", "OTHER"}),
+				mustNewElement(tag.BitsAllocated, []int{8}),
+			),
+			want: []Finding{
+				{Kind: InvalidValue, Path: Path{{Tag: tag.PatientSex}}, Module: "Patient", Type: Type2, Value: "X"},
+				{Kind: InvalidValue, Path: Path{{Tag: tag.ImageType}}, Module: "CT Image", Type: Type1, Value: "OTHER"},
+				{Kind: InvalidValue, Path: Path{{Tag: tag.BitsAllocated}}, Module: "CT Image", Type: Type1, Value: "8"},
+			},
+		},
+		{
+			name: "conditional attribute",
+			ds:   ctDataset(mustNewElement(tag.SamplesPerPixel, []int{3})),
+			want: []Finding{
+				{Kind: MissingAttribute, Path: Path{{Tag: tag.PlanarConfiguration}}, Module: "Image Pixel", Type: Type1C},
+				{Kind: InvalidValue, Path: Path{{Tag: tag.SamplesPerPixel}}, Module: "CT Image", Type: Type1, Value: "3"},
+			},
+		},
+		{
+			name: "user option module present",
+			ds:   ctDataset(mustNewElement(tag.WindowCenter, []string{"40"})),
+			want: []Finding{
+				{Kind: MissingAttribute, Path: Path{{Tag: tag.WindowWidth}}, Module: "VOI LUT", Type: Type1C},
+			},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := Validate(tc.ds)
+			if err != nil {
+				t.Fatalf("Validate() unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("Validate() unexpected findings. diff: %v", diff)
+			}
+		})
+	}
+}
+
+func TestValidate_Segmentation(t *testing.T) {
+	code := func(meaning string) []*dicom.Element {
+		return []*dicom.Element{
+			mustNewElement(tag.CodeValue, []string{"T-D0050"}),
+			mustNewElement(tag.CodingSchemeDesignator, []string{"SRT"}),
+			mustNewElement(tag.CodeMeaning, []string{meaning}),
+		}
+	}
+	segments := mustNewElement(tag.SegmentSequence, [][]*dicom.Element{
+		{
+			mustNewElement(tag.SegmentNumber, []int{1}),
+			mustNewElement(tag.SegmentLabel, []string{"Tissue"}),
+			mustNewElement(tag.SegmentAlgorithmType, []string{"MANUAL"}),
+			mustNewElement(tag.SegmentedPropertyCategoryCodeSequence, [][]*dicom.Element{code("Tissue")}),
+			mustNewElement(tag.SegmentedPropertyTypeCodeSequence, [][]*dicom.Element{code("Tissue")}),
+		},
+		{
+			mustNewElement(tag.SegmentNumber, []int{2}),
+			mustNewElement(tag.SegmentLabel, []string{"Tumor"}),
+			mustNewElement(tag.SegmentAlgorithmType, []string{"AUTOMATIC"}),
+			mustNewElement(tag.SegmentedPropertyCategoryCodeSequence, [][]*dicom.Element{code("")}),
+		},
+	})
+	ds := &dicom.Dataset{Elements: []*dicom.Element{
+		mustNewElement(tag.SOPClassUID, []string{uid.SegmentationStorage}),
+		mustNewElement(tag.SegmentationType, []string{"FRACTIONAL"}),
+		segments,
+	}}
+
+	findings := Segmentation.Validate(ds)
+	got := make(map[string]Finding)
+	for _, f := range findings {
+		got[f.Path.String()] = f
+	}
+	for path, want := range map[string]Finding{
+		"SegmentationFractionalType": {Kind: MissingAttribute, Module: "Segmentation Image", Type: Type1C},
+		"SegmentSequence[1].SegmentAlgorithmName": {
+			Kind: MissingAttribute, Module: "Segmentation Image", Type: Type1C,
+		},
+		"SegmentSequence[1].SegmentedPropertyTypeCodeSequence": {
+			Kind: MissingAttribute, Module: "Segmentation Image", Type: Type1,
+		},
+		"SegmentSequence[1].SegmentedPropertyCategoryCodeSequence[0].CodeMeaning": {
+			Kind: EmptyAttribute, Module: "Segmentation Image", Type: Type1,
+		},
+	} {
+		f, ok := got[path]
+		if !ok {
+			t.Errorf("Validate() missing finding for %s. got: %v", path, findings)
+			continue
+		}
+		f.Path = nil
+		if diff := cmp.Diff(want, f); diff != "" {
+			t.Errorf("Validate() unexpected finding for %s. diff: %v", path, diff)
+		}
+	}
+	for path := range got {
+		if len(path) > len("SegmentSequence[0]") && path[:len("SegmentSequence[0]")] == "SegmentSequence[0]" {
+			t.Errorf("Validate() unexpected finding in the valid item: %s", path)
+		}
+	}
+}
+
+func TestIOD_Validate_SharedAttributes(t *testing.T) {
+	d := &IOD{
+		Name: "Test",
+		Modules: []ModuleUsage{
+			{Module: GeneralEquipmentModule, Usage: Mandatory},
+			{Module: EnhancedGeneralEquipmentModule, Usage: UserOption},
+		},
+	}
+	manufacturer := mustNewElement(tag.Manufacturer, []string{"ACME"})
+	modelName := mustNewElement(tag.ManufacturerModelName, []string{"Scanner"})
+	cases := []struct {
+		name string
+		ds   *dicom.Dataset
+		want []Finding
+	}{
+		{
+			name: "only shared attributes",
+			ds:   &dicom.Dataset{Elements: []*dicom.Element{manufacturer, modelName}},
+		},
+		{
+			name: "module specific attribute",
+			ds: &dicom.Dataset{Elements: []*dicom.Element{
+				manufacturer,
+				modelName,
+				mustNewElement(tag.DeviceSerialNumber, []string{"1234"}),
+			}},
+			want: []Finding{{
+				Kind:   MissingAttribute,
+				Path:   Path{{Tag: tag.SoftwareVersions}},
+				Module: "Enhanced General Equipment",
+				Type:   Type1,
+			}},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, d.Validate(tc.ds)); diff != "" {
+				t.Errorf("Validate() unexpected findings. diff: %v", diff)
+			}
+		})
+	}
+}
+
+func TestValidate_Errors(t *testing.T) {
+	if _, err := Validate(&dicom.Dataset{}); !errors.Is(err, ErrorMissingSOPClass) {
+		t.Errorf("Validate(no SOPClassUID) unexpected error. got: %v, want: %v", err, ErrorMissingSOPClass)
+	}
+	ds := &dicom.Dataset{Elements: []*dicom.Element{mustNewElement(tag.SOPClassUID, []string{"1.2.3.4"})}}
+	if _, err := Validate(ds); !errors.Is(err, ErrorUnknownIOD) {
+		t.Errorf("Validate(unknown SOP Class) unexpected error. got: %v, want: %v", err, ErrorUnknownIOD)
+	}
+}
+
+func TestForSOPClass(t *testing.T) {
+	for sopClass, want := range map[string]*IOD{
+		uid.CTImageStorage:         CTImage,
+		uid.MRImageStorage:         MRImage,
+		uid.EnhancedCTImageStorage: EnhancedCTImage,
+		uid.SegmentationStorage:    Segmentation,
+		uid.ComprehensiveSRStorage: ComprehensiveSR,
+	} {
+		if got, err := ForSOPClass(sopClass); err != nil || got != want {
+			t.Errorf("ForSOPClass(%s) got: %v, %v, want: %s", sopClass, got, err, want.Name)
+		}
+	}
+}
+
+func TestFinding_String(t *testing.T) {
+	f := Finding{
+		Kind:   InvalidValue,
+		Path:   Path{{Tag: tag.ConceptNameCodeSequence, Item: 0}, {Tag: tag.CodeMeaning}},
+		Module: "SR Document Content",
+		Type:   Type1,
+		Value:  "x",
+	}
+	want := `invalid value ConceptNameCodeSequence[0].CodeMeaning "x" (Type 1, SR Document Content module)`
+	if got := f.String(); got != want {
+		t.Errorf("Finding.String() got: %s, want: %s", got, want)
+	}
+}
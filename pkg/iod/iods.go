@@ -0,0 +1,145 @@
+package iod
+
+import "github.com/suyashkumar/dicom/pkg/uid"
+
+// CTImage is the CT Image IOD (PS3.3 A.3).
+var CTImage = &IOD{
+	Name:       "CT Image",
+	SOPClasses: []string{uid.CTImageStorage},
+	Modules: []ModuleUsage{
+		{Module: PatientModule, Usage: Mandatory},
+		{Module: GeneralStudyModule, Usage: Mandatory},
+		{Module: PatientStudyModule, Usage: UserOption},
+		{Module: GeneralSeriesModule, Usage: Mandatory},
+		{Module: FrameOfReferenceModule, Usage: Mandatory},
+		{Module: GeneralEquipmentModule, Usage: Mandatory},
+		{Module: GeneralImageModule, Usage: Mandatory},
+		{Module: ImagePlaneModule, Usage: Mandatory},
+		{Module: ImagePixelModule, Usage: Mandatory},
+		// Required if contrast media was used, which is only known from its
+		// attributes.
+		{Module: ContrastBolusModule, Usage: Conditional},
+		{Module: CTImageModule, Usage: Mandatory},
+		{Module: VOILUTModule, Usage: UserOption},
+		{Module: SOPCommonModule, Usage: Mandatory},
+	},
+}
+
+// MRImage is the MR Image IOD (PS3.3 A.4).
+var MRImage = &IOD{
+	Name:       "MR Image",
+	SOPClasses: []string{uid.MRImageStorage},
+	Modules: []ModuleUsage{
+		{Module: PatientModule, Usage: Mandatory},
+		{Module: GeneralStudyModule, Usage: Mandatory},
+		{Module: PatientStudyModule, Usage: UserOption},
+		{Module: GeneralSeriesModule, Usage: Mandatory},
+		{Module: FrameOfReferenceModule, Usage: Mandatory},
+		{Module: GeneralEquipmentModule, Usage: Mandatory},
+		{Module: GeneralImageModule, Usage: Mandatory},
+		{Module: ImagePlaneModule, Usage: Mandatory},
+		{Module: ImagePixelModule, Usage: Mandatory},
+		{Module: ContrastBolusModule, Usage: Conditional},
+		{Module: MRImageModule, Usage: Mandatory},
+		{Module: VOILUTModule, Usage: UserOption},
+		{Module: SOPCommonModule, Usage: Mandatory},
+	},
+}
+
+// SecondaryCaptureImage is the Secondary Capture Image IOD (PS3.3 A.8.1).
+var SecondaryCaptureImage = &IOD{
+	Name:       "Secondary Capture Image",
+	SOPClasses: []string{uid.SecondaryCaptureImageStorage},
+	Modules: []ModuleUsage{
+		{Module: PatientModule, Usage: Mandatory},
+		{Module: GeneralStudyModule, Usage: Mandatory},
+		{Module: PatientStudyModule, Usage: UserOption},
+		{Module: GeneralSeriesModule, Usage: Mandatory},
+		{Module: GeneralEquipmentModule, Usage: UserOption},
+		{Module: SCEquipmentModule, Usage: Mandatory},
+		{Module: GeneralImageModule, Usage: Mandatory},
+		{Module: ImagePixelModule, Usage: Mandatory},
+		{Module: SCImageModule, Usage: Mandatory},
+		{Module: VOILUTModule, Usage: UserOption},
+		{Module: SOPCommonModule, Usage: Mandatory},
+	},
+}
+
+// EnhancedCTImage is the Enhanced CT Image IOD (PS3.3 A.38.1).
+var EnhancedCTImage = &IOD{
+	Name:       "Enhanced CT Image",
+	SOPClasses: []string{uid.EnhancedCTImageStorage},
+	Modules: []ModuleUsage{
+		{Module: PatientModule, Usage: Mandatory},
+		{Module: GeneralStudyModule, Usage: Mandatory},
+		{Module: PatientStudyModule, Usage: UserOption},
+		{Module: GeneralSeriesModule, Usage: Mandatory},
+		{Module: CTSeriesModule, Usage: Mandatory},
+		{Module: FrameOfReferenceModule, Usage: Mandatory},
+		{Module: GeneralEquipmentModule, Usage: Mandatory},
+		{Module: EnhancedGeneralEquipmentModule, Usage: Mandatory},
+		{Module: ImagePixelModule, Usage: Mandatory},
+		{Module: MultiFrameFunctionalGroupsModule, Usage: Mandatory},
+		{Module: MultiFrameDimensionModule, Usage: Mandatory},
+		{Module: AcquisitionContextModule, Usage: Mandatory},
+		{Module: EnhancedCTImageModule, Usage: Mandatory},
+		{Module: SOPCommonModule, Usage: Mandatory},
+	},
+}
+
+// Segmentation is the Segmentation IOD (PS3.3 A.51).
+var Segmentation = &IOD{
+	Name:       "Segmentation",
+	SOPClasses: []string{uid.SegmentationStorage},
+	Modules: []ModuleUsage{
+		{Module: PatientModule, Usage: Mandatory},
+		{Module: GeneralStudyModule, Usage: Mandatory},
+		{Module: PatientStudyModule, Usage: UserOption},
+		{Module: GeneralSeriesModule, Usage: Mandatory},
+		{Module: SegmentationSeriesModule, Usage: Mandatory},
+		// Required if the segmentation is defined in a Frame of Reference.
+		{Module: FrameOfReferenceModule, Usage: Conditional},
+		{Module: GeneralEquipmentModule, Usage: Mandatory},
+		{Module: EnhancedGeneralEquipmentModule, Usage: Mandatory},
+		{Module: GeneralImageModule, Usage: Mandatory},
+		{Module: ImagePixelModule, Usage: Mandatory},
+		{Module: SegmentationImageModule, Usage: Mandatory},
+		{Module: MultiFrameFunctionalGroupsModule, Usage: Mandatory},
+		{Module: MultiFrameDimensionModule, Usage: Mandatory},
+		{Module: SOPCommonModule, Usage: Mandatory},
+	},
+}
+
+// srModules are the modules of the Basic Text, Enhanced and Comprehensive SR
+// IODs (PS3.3 A.35.1-3), which differ in the content items they allow rather
+// than in their modules.
+var srModules = []ModuleUsage{
+	{Module: PatientModule, Usage: Mandatory},
+	{Module: GeneralStudyModule, Usage: Mandatory},
+	{Module: PatientStudyModule, Usage: UserOption},
+	{Module: SRDocumentSeriesModule, Usage: Mandatory},
+	{Module: GeneralEquipmentModule, Usage: Mandatory},
+	{Module: SRDocumentGeneralModule, Usage: Mandatory},
+	{Module: SRDocumentContentModule, Usage: Mandatory},
+	{Module: SOPCommonModule, Usage: Mandatory},
+}
+
+// BasicTextSR is the Basic Text SR IOD (PS3.3 A.35.1).
+var BasicTextSR = &IOD{Name: "Basic Text SR", SOPClasses: []string{uid.BasicTextSRStorage}, Modules: srModules}
+
+// EnhancedSR is the Enhanced SR IOD (PS3.3 A.35.2).
+var EnhancedSR = &IOD{Name: "Enhanced SR", SOPClasses: []string{uid.EnhancedSRStorage}, Modules: srModules}
+
+// ComprehensiveSR is the Comprehensive SR IOD (PS3.3 A.35.3).
+var ComprehensiveSR = &IOD{Name: "Comprehensive SR", SOPClasses: []string{uid.ComprehensiveSRStorage}, Modules: srModules}
+
+// iodsBySOPClass maps SOP Class UIDs to the IOD of their instances.
+var iodsBySOPClass = make(map[string]*IOD)
+
+func init() {
+	for _, d := range []*IOD{CTImage, MRImage, SecondaryCaptureImage, EnhancedCTImage, Segmentation, BasicTextSR, EnhancedSR, ComprehensiveSR} {
+		for _, c := range d.SOPClasses {
+			iodsBySOPClass[c] = d
+		}
+	}
+}
@@ -0,0 +1,483 @@
+package iod
+
+import (
+	"github.com/suyashkumar/dicom/pkg/tag"
+	"github.com/suyashkumar/dicom/pkg/uid"
+)
+
+// The modules below define the attributes of the modules of PS3.3 that the
+// IODs of this package are made of. They are not exhaustive: most Type 3
+// attributes, and conditional attributes whose condition can not be decided
+// from the Dataset alone, are left out.
+
+// codeSequenceItem holds the attributes of the Code Sequence Macro (PS3.3
+// Table 8.8-1), the items of code sequences.
+var codeSequenceItem = []Attribute{
+	{Tag: tag.CodeValue, Type: Type1},
+	{Tag: tag.CodingSchemeDesignator, Type: Type1},
+	{Tag: tag.CodeMeaning, Type: Type1},
+}
+
+// PatientModule is the Patient Module (PS3.3 C.7.1.1).
+var PatientModule = &Module{
+	Name: "Patient",
+	Attributes: []Attribute{
+		{Tag: tag.PatientName, Type: Type2},
+		{Tag: tag.PatientID, Type: Type2},
+		{Tag: tag.IssuerOfPatientID, Type: Type3},
+		{Tag: tag.PatientBirthDate, Type: Type2},
+		{Tag: tag.PatientSex, Type: Type2, Enum: []string{"M", "F", "O"}},
+	},
+}
+
+// GeneralStudyModule is the General Study Module (PS3.3 C.7.2.1).
+var GeneralStudyModule = &Module{
+	Name: "General Study",
+	Attributes: []Attribute{
+		{Tag: tag.StudyInstanceUID, Type: Type1},
+		{Tag: tag.StudyDate, Type: Type2},
+		{Tag: tag.StudyTime, Type: Type2},
+		{Tag: tag.ReferringPhysicianName, Type: Type2},
+		{Tag: tag.StudyID, Type: Type2},
+		{Tag: tag.AccessionNumber, Type: Type2},
+		{Tag: tag.StudyDescription, Type: Type3},
+	},
+}
+
+// PatientStudyModule is the Patient Study Module (PS3.3 C.7.2.2).
+var PatientStudyModule = &Module{
+	Name: "Patient Study",
+	Attributes: []Attribute{
+		{Tag: tag.PatientAge, Type: Type3},
+		{Tag: tag.PatientSize, Type: Type3},
+		{Tag: tag.PatientWeight, Type: Type3},
+	},
+}
+
+// GeneralSeriesModule is the General Series Module (PS3.3 C.7.3.1).
+var GeneralSeriesModule = &Module{
+	Name: "General Series",
+	Attributes: []Attribute{
+		{Tag: tag.Modality, Type: Type1},
+		{Tag: tag.SeriesInstanceUID, Type: Type1},
+		{Tag: tag.SeriesNumber, Type: Type2},
+		{Tag: tag.SeriesDate, Type: Type3},
+		{Tag: tag.SeriesTime, Type: Type3},
+		{Tag: tag.SeriesDescription, Type: Type3},
+		{
+			Tag:       tag.PatientPosition,
+			Type:      Type2C,
+			Condition: Equals(tag.SOPClassUID, uid.CTImageStorage, uid.MRImageStorage),
+		},
+	},
+}
+
+// FrameOfReferenceModule is the Frame of Reference Module (PS3.3 C.7.4.1).
+var FrameOfReferenceModule = &Module{
+	Name: "Frame of Reference",
+	Attributes: []Attribute{
+		{Tag: tag.FrameOfReferenceUID, Type: Type1},
+		{Tag: tag.PositionReferenceIndicator, Type: Type2},
+	},
+}
+
+// GeneralEquipmentModule is the General Equipment Module (PS3.3 C.7.5.1).
+var GeneralEquipmentModule = &Module{
+	Name: "General Equipment",
+	Attributes: []Attribute{
+		{Tag: tag.Manufacturer, Type: Type2},
+		{Tag: tag.InstitutionName, Type: Type3},
+		{Tag: tag.StationName, Type: Type3},
+		{Tag: tag.ManufacturerModelName, Type: Type3},
+		{Tag: tag.SoftwareVersions, Type: Type3},
+	},
+}
+
+// EnhancedGeneralEquipmentModule is the Enhanced General Equipment Module
+// (PS3.3 C.7.5.2).
+var EnhancedGeneralEquipmentModule = &Module{
+	Name: "Enhanced General Equipment",
+	Attributes: []Attribute{
+		{Tag: tag.Manufacturer, Type: Type1},
+		{Tag: tag.ManufacturerModelName, Type: Type1},
+		{Tag: tag.DeviceSerialNumber, Type: Type1},
+		{Tag: tag.SoftwareVersions, Type: Type1},
+	},
+}
+
+// GeneralImageModule is the General Image Module (PS3.3 C.7.6.1).
+var GeneralImageModule = &Module{
+	Name: "General Image",
+	Attributes: []Attribute{
+		{Tag: tag.InstanceNumber, Type: Type2},
+		{
+			// Images without Image Orientation (Patient), including multi-frame
+			// images where it is in functional groups, need Patient
+			// Orientation.
+			Tag:       tag.PatientOrientation,
+			Type:      Type2C,
+			Condition: Not(Or(Present(tag.ImageOrientationPatient), Present(tag.PerFrameFunctionalGroupsSequence))),
+		},
+		{Tag: tag.ImageType, Type: Type3},
+		{Tag: tag.AcquisitionNumber, Type: Type3},
+		{Tag: tag.BurnedInAnnotation, Type: Type3, Enum: []string{"YES", "NO"}},
+		{Tag: tag.LossyImageCompression, Type: Type3, Enum: []string{"00", "01"}},
+		{Tag: tag.PresentationLUTShape, Type: Type3, Enum: []string{"IDENTITY", "INVERSE"}},
+	},
+}
+
+// ImagePlaneModule is the Image Plane Module (PS3.3 C.7.6.2).
+var ImagePlaneModule = &Module{
+	Name: "Image Plane",
+	Attributes: []Attribute{
+		{Tag: tag.PixelSpacing, Type: Type1},
+		{Tag: tag.ImageOrientationPatient, Type: Type1},
+		{Tag: tag.ImagePositionPatient, Type: Type1},
+		{Tag: tag.SliceThickness, Type: Type2},
+		{Tag: tag.SpacingBetweenSlices, Type: Type3},
+		{Tag: tag.SliceLocation, Type: Type3},
+	},
+}
+
+// ImagePixelModule is the Image Pixel Module (PS3.3 C.7.6.3).
+var ImagePixelModule = &Module{
+	Name: "Image Pixel",
+	Attributes: []Attribute{
+		{Tag: tag.SamplesPerPixel, Type: Type1},
+		{Tag: tag.PhotometricInterpretation, Type: Type1},
+		{Tag: tag.Rows, Type: Type1},
+		{Tag: tag.Columns, Type: Type1},
+		{Tag: tag.BitsAllocated, Type: Type1},
+		{Tag: tag.BitsStored, Type: Type1},
+		{Tag: tag.HighBit, Type: Type1},
+		{Tag: tag.PixelRepresentation, Type: Type1, Enum: []string{"0", "1"}},
+		{Tag: tag.PixelData, Type: Type1C, Condition: Not(Present(tag.PixelDataProviderURL))},
+		{
+			Tag:       tag.PlanarConfiguration,
+			Type:      Type1C,
+			Condition: GreaterThan(tag.SamplesPerPixel, 1),
+			Enum:      []string{"0", "1"},
+		},
+	},
+}
+
+// ContrastBolusModule is the Contrast/Bolus Module (PS3.3 C.7.6.4).
+var ContrastBolusModule = &Module{
+	Name: "Contrast/Bolus",
+	Attributes: []Attribute{
+		{Tag: tag.ContrastBolusAgent, Type: Type2},
+		{Tag: tag.ContrastBolusRoute, Type: Type3},
+	},
+}
+
+// MultiFrameFunctionalGroupsModule is the Multi-frame Functional Groups
+// Module (PS3.3 C.7.6.16).
+var MultiFrameFunctionalGroupsModule = &Module{
+	Name: "Multi-frame Functional Groups",
+	Attributes: []Attribute{
+		{Tag: tag.SharedFunctionalGroupsSequence, Type: Type2},
+		{Tag: tag.PerFrameFunctionalGroupsSequence, Type: Type1},
+		{Tag: tag.InstanceNumber, Type: Type1},
+		{Tag: tag.ContentDate, Type: Type1},
+		{Tag: tag.ContentTime, Type: Type1},
+		{Tag: tag.NumberOfFrames, Type: Type1},
+		{Tag: tag.ConcatenationUID, Type: Type1C, Condition: Present(tag.SOPInstanceUIDOfConcatenationSource)},
+		{Tag: tag.ConcatenationFrameOffsetNumber, Type: Type1C, Condition: Present(tag.ConcatenationUID)},
+		{Tag: tag.InConcatenationNumber, Type: Type1C, Condition: Present(tag.ConcatenationUID)},
+	},
+}
+
+// MultiFrameDimensionModule is the Multi-frame Dimension Module (PS3.3
+// C.7.6.17).
+var MultiFrameDimensionModule = &Module{
+	Name: "Multi-frame Dimension",
+	Attributes: []Attribute{
+		{
+			Tag:  tag.DimensionOrganizationSequence,
+			Type: Type1,
+			Items: []Attribute{
+				{Tag: tag.DimensionOrganizationUID, Type: Type1},
+			},
+		},
+		{
+			Tag:  tag.DimensionOrganizationType,
+			Type: Type3,
+			Enum: []string{"3D", "3D_TEMPORAL", "TILED_FULL", "TILED_SPARSE"},
+		},
+		{
+			Tag:  tag.DimensionIndexSequence,
+			Type: Type1,
+			Items: []Attribute{
+				{Tag: tag.DimensionIndexPointer, Type: Type1},
+				{Tag: tag.FunctionalGroupPointer, Type: Type3},
+				{Tag: tag.DimensionOrganizationUID, Type: Type3},
+			},
+		},
+	},
+}
+
+// AcquisitionContextModule is the Acquisition Context Module (PS3.3
+// C.7.6.14).
+var AcquisitionContextModule = &Module{
+	Name: "Acquisition Context",
+	Attributes: []Attribute{
+		{Tag: tag.AcquisitionContextSequence, Type: Type2},
+	},
+}
+
+// CTImageModule is the CT Image Module (PS3.3 C.8.2.1).
+var CTImageModule = &Module{
+	Name: "CT Image",
+	Attributes: []Attribute{
+		{
+			Tag:        tag.ImageType,
+			Type:       Type1,
+			ValueEnums: [][]string{{"ORIGINAL", "DERIVED"}, {"PRIMARY", "SECONDARY"}},
+		},
+		{Tag: tag.SamplesPerPixel, Type: Type1, Enum: []string{"1"}},
+		{Tag: tag.PhotometricInterpretation, Type: Type1, Enum: []string{"MONOCHROME1", "MONOCHROME2"}},
+		{Tag: tag.BitsAllocated, Type: Type1, Enum: []string{"16"}},
+		{Tag: tag.BitsStored, Type: Type1, Enum: []string{"12", "13", "14", "15", "16"}},
+		{Tag: tag.HighBit, Type: Type1, Enum: []string{"11", "12", "13", "14", "15"}},
+		{Tag: tag.RescaleIntercept, Type: Type1},
+		{Tag: tag.RescaleSlope, Type: Type1},
+		{Tag: tag.KVP, Type: Type2},
+		{Tag: tag.AcquisitionNumber, Type: Type2},
+		{Tag: tag.DataCollectionDiameter, Type: Type3},
+		{Tag: tag.ReconstructionDiameter, Type: Type3},
+		{Tag: tag.ConvolutionKernel, Type: Type3},
+	},
+}
+
+// MRImageModule is the MR Image Module (PS3.3 C.8.3.1).
+var MRImageModule = &Module{
+	Name: "MR Image",
+	Attributes: []Attribute{
+		{
+			Tag:        tag.ImageType,
+			Type:       Type1,
+			ValueEnums: [][]string{{"ORIGINAL", "DERIVED"}, {"PRIMARY", "SECONDARY"}},
+		},
+		{Tag: tag.SamplesPerPixel, Type: Type1, Enum: []string{"1"}},
+		{Tag: tag.PhotometricInterpretation, Type: Type1, Enum: []string{"MONOCHROME1", "MONOCHROME2"}},
+		{Tag: tag.BitsAllocated, Type: Type1, Enum: []string{"16"}},
+		{Tag: tag.ScanningSequence, Type: Type1, Enum: []string{"SE", "IR", "GR", "EP", "RM"}},
+		{
+			Tag:  tag.SequenceVariant,
+			Type: Type1,
+			Enum: []string{"SK", "MTC", "SS", "TRSS", "SP", "MP", "OSP", "NONE"},
+		},
+		{Tag: tag.ScanOptions, Type: Type2},
+		{Tag: tag.MRAcquisitionType, Type: Type2, Enum: []string{"2D", "3D"}},
+		{
+			// Required except for echo planar sequences without segmented
+			// k-space.
+			Tag:       tag.RepetitionTime,
+			Type:      Type2C,
+			Condition: Not(And(Contains(tag.ScanningSequence, "EP"), Not(Contains(tag.SequenceVariant, "SK")))),
+		},
+		{Tag: tag.EchoTime, Type: Type2},
+		{Tag: tag.EchoTrainLength, Type: Type2},
+		{Tag: tag.InversionTime, Type: Type2C, Condition: Contains(tag.ScanningSequence, "IR")},
+		{Tag: tag.SequenceName, Type: Type3},
+		{Tag: tag.AngioFlag, Type: Type3, Enum: []string{"Y", "N"}},
+		{Tag: tag.ImagedNucleus, Type: Type3},
+		{Tag: tag.MagneticFieldStrength, Type: Type3},
+	},
+}
+
+// SCEquipmentModule is the SC Equipment Module (PS3.3 C.8.6.1).
+var SCEquipmentModule = &Module{
+	Name: "SC Equipment",
+	Attributes: []Attribute{
+		{
+			Tag:  tag.ConversionType,
+			Type: Type1,
+			Enum: []string{"DV", "DI", "DF", "WSD", "SD", "SI", "DRW", "SYN"},
+		},
+		{Tag: tag.Modality, Type: Type3},
+		{Tag: tag.SecondaryCaptureDeviceManufacturer, Type: Type3},
+	},
+}
+
+// SCImageModule is the SC Image Module (PS3.3 C.8.6.2).
+var SCImageModule = &Module{
+	Name: "SC Image",
+	Attributes: []Attribute{
+		{Tag: tag.DateOfSecondaryCapture, Type: Type3},
+		{Tag: tag.TimeOfSecondaryCapture, Type: Type3},
+		{Tag: tag.NominalScannedPixelSpacing, Type: Type3},
+	},
+}
+
+// CTSeriesModule is the CT Series Module (PS3.3 C.8.15.1).
+var CTSeriesModule = &Module{
+	Name: "CT Series",
+	Attributes: []Attribute{
+		{Tag: tag.Modality, Type: Type1, Enum: []string{"CT"}},
+	},
+}
+
+// EnhancedCTImageModule is the Enhanced CT Image Module (PS3.3 C.8.15.2).
+var EnhancedCTImageModule = &Module{
+	Name: "Enhanced CT Image",
+	Attributes: []Attribute{
+		{
+			Tag:        tag.ImageType,
+			Type:       Type1,
+			ValueEnums: [][]string{{"ORIGINAL", "DERIVED", "MIXED"}, {"PRIMARY"}},
+		},
+		{Tag: tag.SamplesPerPixel, Type: Type1, Enum: []string{"1"}},
+		{Tag: tag.PhotometricInterpretation, Type: Type1, Enum: []string{"MONOCHROME2"}},
+		{Tag: tag.BitsAllocated, Type: Type1, Enum: []string{"16"}},
+		{Tag: tag.BitsStored, Type: Type1, Enum: []string{"12", "16"}},
+		{Tag: tag.HighBit, Type: Type1, Enum: []string{"11", "15"}},
+		{Tag: tag.ContentQualification, Type: Type1, Enum: []string{"PRODUCT", "RESEARCH", "SERVICE"}},
+		{Tag: tag.ImageComments, Type: Type3},
+		{Tag: tag.PixelPresentation, Type: Type1, Enum: []string{"MONOCHROME"}},
+		{Tag: tag.VolumetricProperties, Type: Type1, Enum: []string{"VOLUME", "SAMPLED", "DISTORTED", "MIXED"}},
+		{Tag: tag.VolumeBasedCalculationTechnique, Type: Type1},
+	},
+}
+
+// SRDocumentSeriesModule is the SR Document Series Module (PS3.3 C.17.1).
+var SRDocumentSeriesModule = &Module{
+	Name: "SR Document Series",
+	Attributes: []Attribute{
+		{Tag: tag.Modality, Type: Type1, Enum: []string{"SR"}},
+		{Tag: tag.SeriesInstanceUID, Type: Type1},
+		{Tag: tag.SeriesNumber, Type: Type1},
+		{Tag: tag.SeriesDate, Type: Type3},
+		{Tag: tag.SeriesTime, Type: Type3},
+		{
+			Tag:  tag.ReferencedPerformedProcedureStepSequence,
+			Type: Type2,
+			Items: []Attribute{
+				{Tag: tag.ReferencedSOPClassUID, Type: Type1},
+				{Tag: tag.ReferencedSOPInstanceUID, Type: Type1},
+			},
+		},
+	},
+}
+
+// SRDocumentGeneralModule is the SR Document General Module (PS3.3 C.17.2).
+var SRDocumentGeneralModule = &Module{
+	Name: "SR Document General",
+	Attributes: []Attribute{
+		{Tag: tag.InstanceNumber, Type: Type1},
+		{Tag: tag.PreliminaryFlag, Type: Type3, Enum: []string{"PRELIMINARY", "FINAL"}},
+		{Tag: tag.CompletionFlag, Type: Type1, Enum: []string{"PARTIAL", "COMPLETE"}},
+		{Tag: tag.CompletionFlagDescription, Type: Type3},
+		{Tag: tag.VerificationFlag, Type: Type1, Enum: []string{"UNVERIFIED", "VERIFIED"}},
+		{Tag: tag.ContentDate, Type: Type1},
+		{Tag: tag.ContentTime, Type: Type1},
+		{
+			Tag:       tag.VerifyingObserverSequence,
+			Type:      Type1C,
+			Condition: Equals(tag.VerificationFlag, "VERIFIED"),
+			Items: []Attribute{
+				{Tag: tag.VerifyingObserverName, Type: Type1},
+				{Tag: tag.VerifyingObserverIdentificationCodeSequence, Type: Type2, Items: codeSequenceItem},
+				{Tag: tag.VerifyingOrganization, Type: Type2},
+				{Tag: tag.VerificationDateTime, Type: Type1},
+			},
+		},
+		{Tag: tag.PerformedProcedureCodeSequence, Type: Type2, Items: codeSequenceItem},
+	},
+}
+
+// SRDocumentContentModule is the SR Document Content Module (PS3.3 C.17.3),
+// for the root content item of the document.
+var SRDocumentContentModule = &Module{
+	Name: "SR Document Content",
+	Attributes: []Attribute{
+		{Tag: tag.ValueType, Type: Type1, Enum: []string{"CONTAINER"}},
+		{Tag: tag.ConceptNameCodeSequence, Type: Type1, Items: codeSequenceItem},
+		{Tag: tag.ContinuityOfContent, Type: Type1, Enum: []string{"SEPARATE", "CONTINUOUS"}},
+	},
+}
+
+// SegmentationSeriesModule is the Segmentation Series Module (PS3.3
+// C.8.20.1).
+var SegmentationSeriesModule = &Module{
+	Name: "Segmentation Series",
+	Attributes: []Attribute{
+		{Tag: tag.Modality, Type: Type1, Enum: []string{"SEG"}},
+		{Tag: tag.SeriesNumber, Type: Type1},
+	},
+}
+
+// SegmentationImageModule is the Segmentation Image Module (PS3.3 C.8.20.2).
+var SegmentationImageModule = &Module{
+	Name: "Segmentation Image",
+	Attributes: []Attribute{
+		{Tag: tag.ImageType, Type: Type1, ValueEnums: [][]string{{"DERIVED"}, {"PRIMARY"}}},
+		{Tag: tag.InstanceNumber, Type: Type1},
+		{Tag: tag.ContentLabel, Type: Type1},
+		{Tag: tag.ContentDescription, Type: Type2},
+		{Tag: tag.ContentCreatorName, Type: Type2},
+		{Tag: tag.SamplesPerPixel, Type: Type1, Enum: []string{"1"}},
+		{Tag: tag.PhotometricInterpretation, Type: Type1, Enum: []string{"MONOCHROME2"}},
+		{Tag: tag.PixelRepresentation, Type: Type1, Enum: []string{"0"}},
+		{Tag: tag.BitsAllocated, Type: Type1, Enum: []string{"1", "8", "16"}},
+		{Tag: tag.BitsStored, Type: Type1, Enum: []string{"1", "8", "16"}},
+		{Tag: tag.HighBit, Type: Type1, Enum: []string{"0", "7", "15"}},
+		{Tag: tag.LossyImageCompression, Type: Type1, Enum: []string{"00"}},
+		{Tag: tag.SegmentationType, Type: Type1, Enum: []string{"BINARY", "FRACTIONAL", "LABELMAP"}},
+		{
+			Tag:       tag.SegmentationFractionalType,
+			Type:      Type1C,
+			Condition: Equals(tag.SegmentationType, "FRACTIONAL"),
+			Enum:      []string{"PROBABILITY", "OCCUPANCY"},
+		},
+		{
+			Tag:       tag.MaximumFractionalValue,
+			Type:      Type1C,
+			Condition: Equals(tag.SegmentationType, "FRACTIONAL"),
+		},
+		{
+			Tag:  tag.SegmentSequence,
+			Type: Type1,
+			Items: []Attribute{
+				{Tag: tag.SegmentNumber, Type: Type1},
+				{Tag: tag.SegmentLabel, Type: Type1},
+				{Tag: tag.SegmentDescription, Type: Type3},
+				{
+					Tag:  tag.SegmentAlgorithmType,
+					Type: Type1,
+					Enum: []string{"AUTOMATIC", "SEMIAUTOMATIC", "MANUAL"},
+				},
+				{
+					Tag:       tag.SegmentAlgorithmName,
+					Type:      Type1C,
+					Condition: Not(Equals(tag.SegmentAlgorithmType, "MANUAL")),
+				},
+				{Tag: tag.SegmentedPropertyCategoryCodeSequence, Type: Type1, Items: codeSequenceItem},
+				{Tag: tag.SegmentedPropertyTypeCodeSequence, Type: Type1, Items: codeSequenceItem},
+			},
+		},
+	},
+}
+
+// VOILUTModule is the VOI LUT Module (PS3.3 C.11.2).
+var VOILUTModule = &Module{
+	Name: "VOI LUT",
+	Attributes: []Attribute{
+		{Tag: tag.VOILUTSequence, Type: Type1C, Condition: Not(Present(tag.WindowCenter))},
+		{Tag: tag.WindowCenter, Type: Type1C, Condition: Not(Present(tag.VOILUTSequence))},
+		{Tag: tag.WindowWidth, Type: Type1C, Condition: Present(tag.WindowCenter)},
+		{Tag: tag.VOILUTFunction, Type: Type3, Enum: []string{"LINEAR", "LINEAR_EXACT", "SIGMOID"}},
+	},
+}
+
+// SOPCommonModule is the SOP Common Module (PS3.3 C.12.1).
+var SOPCommonModule = &Module{
+	Name: "SOP Common",
+	Attributes: []Attribute{
+		{Tag: tag.SOPClassUID, Type: Type1},
+		{Tag: tag.SOPInstanceUID, Type: Type1},
+		{Tag: tag.SpecificCharacterSet, Type: Type3},
+		{Tag: tag.InstanceCreationDate, Type: Type3},
+		{Tag: tag.InstanceCreationTime, Type: Type3},
+	},
+}
@@ -0,0 +1,248 @@
+package tag
+
+import (
+	"bufio"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// ErrorInvalidDictionaryEntry indicates that an entry passed to Register or
+// LoadDictionary is malformed.
+var ErrorInvalidDictionaryEntry = errors.New("invalid tag dictionary entry")
+
+// tagDictMu guards tagDict, which Register and LoadDictionary may modify while
+// other goroutines look tags up.
+var tagDictMu sync.RWMutex
+
+var (
+	vrRegexp = regexp.MustCompile(`^[A-Z]{2}$`)
+	// dcmdumpLine matches entries of the dcmtk dicom.dic format read by dcmdump,
+	// e.g. "(0010,0010)	PN	PatientName	1	DICOM". The version column is
+	// optional.
+	dcmdumpLine = regexp.MustCompile(`^\(([^)]+)\)\s+(\S+)\s+(\S+)\s+(\S+)`)
+	// privateTagKey matches the private tag format of dicom.dic, e.g.
+	// (0019,"SIEMENS MR HEADER",08). The group and element offset may be
+	// masked, as in (60xx,"creator",xx), and the group may be a range.
+	privateTagKey = regexp.MustCompile(`^([0-9A-Fa-fx]{4}(?:-[0-9A-Fa-f]{4})?),"([^"]*)",([0-9A-Fa-fx]{2})$`)
+)
+
+// Register adds info to the tag dictionary, replacing any existing entry for
+// info.Tag. Registered tags are then returned by Find and FindByName, and used
+// wherever the dictionary is consulted, e.g. to determine the VR of elements
+// read with an implicit VR transfer syntax, or in dicom.NewElement. It is safe
+// to call Register concurrently with lookups.
+//
+// Use RegisterPrivate for private data elements, whose tag depends on the
+// Private Creator block.
+func Register(info Info) error {
+	info, err := validateInfo(info)
+	if err != nil {
+		return err
+	}
+	tagDictMu.Lock()
+	defer tagDictMu.Unlock()
+	maybeInitTagDict()
+	tagDict[info.Tag] = info
+	return nil
+}
+
+// LoadDictionary registers every entry of the dictionary read from r. Blank
+// lines and lines starting with '#' are ignored. Each entry is either in the
+// dcmtk dicom.dic format used by dcmdump (tab or space separated tag, VR,
+// keyword, VM and an optional version):
+//
+//	(0009,1001)	LO	AcmeModel	1	ACME
+//	(0019,"SIEMENS MR HEADER",0c)	IS	BValue	1	private
+//
+// or CSV with tag, VR, keyword and VM columns, where the tag may be written as
+// "(gggg,eeee)", "gggg,eeee" or ggggeeee:
+//
+//	00091001,LO,AcmeModel,1
+//
+// A CSV header line starting with "tag" is skipped. Entries of the form
+// (gggg,"creator",ee) are registered in the private dictionary, see
+// RegisterPrivate; their group and element offset may be masked, e.g.
+// (0019,"creator",xx), and every private tag they match is registered. Repeating entries such as (60xx,3000) or
+// (6000-60FF,3000) are registered with RegisterRepeating. If any entry is malformed, an error wrapping
+// ErrorInvalidDictionaryEntry is returned and no entry is registered.
+func LoadDictionary(r io.Reader) error {
+	type entry struct {
+		creator string
+		pattern string
+		info    Info
+	}
+	var entries []entry
+	scanner := bufio.NewScanner(r)
+	for lineNum := 1; scanner.Scan(); lineNum++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		var fields []string
+		if m := dcmdumpLine.FindStringSubmatch(line); m != nil {
+			fields = m[1:]
+		} else {
+			record, err := csv.NewReader(strings.NewReader(line)).Read()
+			if err != nil || len(record) < 4 {
+				return fmt.Errorf("%w: line %d: %q", ErrorInvalidDictionaryEntry, lineNum, line)
+			}
+			if strings.EqualFold(strings.TrimSpace(record[0]), "tag") {
+				continue
+			}
+			fields = record[:4]
+		}
+		for i := range fields {
+			fields[i] = strings.TrimSpace(fields[i])
+		}
+
+		info := Info{VR: normalizeDictionaryVR(fields[1]), Name: fields[2], VM: fields[3]}
+		key := strings.Trim(fields[0], "()")
+		if m := privateTagKey.FindStringSubmatch(key); m != nil {
+			// The creator may contain 'x' or '-', so private entries are
+			// matched before repeating ones.
+			tags, err := expandPrivateKey(m[1], m[3])
+			if err != nil {
+				return fmt.Errorf("%w: line %d: %v", ErrorInvalidDictionaryEntry, lineNum, err)
+			}
+			for _, t := range tags {
+				info.Tag = t
+				if _, err := validateInfo(info); err != nil {
+					return fmt.Errorf("line %d: %w", lineNum, err)
+				}
+				entries = append(entries, entry{creator: m[2], info: info})
+			}
+			continue
+		}
+		if strings.ContainsAny(key, "x-") {
+			if _, _, err := parseTagPattern(key); err != nil {
+				return fmt.Errorf("line %d: %w", lineNum, err)
+			}
+			if _, err := validateInfo(info); err != nil {
+				return fmt.Errorf("line %d: %w", lineNum, err)
+			}
+			entries = append(entries, entry{pattern: key, info: info})
+			continue
+		}
+		t, err := parseDictionaryTag(key)
+		if err != nil {
+			return fmt.Errorf("%w: line %d: %v", ErrorInvalidDictionaryEntry, lineNum, err)
+		}
+		info.Tag = t
+		if _, err := validateInfo(info); err != nil {
+			return fmt.Errorf("line %d: %w", lineNum, err)
+		}
+		entries = append(entries, entry{info: info})
+	}
+	if err := scanner.Err(); err != nil {
+		return err
+	}
+
+	for _, e := range entries {
+		var err error
+		if e.pattern != "" {
+			err = RegisterRepeating(e.pattern, e.info)
+		} else if e.creator != "" {
+			err = RegisterPrivate(e.creator, e.info)
+		} else {
+			err = Register(e.info)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// parseDictionaryTag parses "gggg,eeee" or "ggggeeee".
+func parseDictionaryTag(s string) (Tag, error) {
+	if !strings.Contains(s, ",") && len(s) == 8 {
+		s = s[:4] + "," + s[4:]
+	}
+	if strings.Count(s, ",") != 1 {
+		return Tag{}, fmt.Errorf("invalid tag %q", s)
+	}
+	t, err := parseTag(s)
+	if err != nil {
+		return Tag{}, fmt.Errorf("invalid tag %q: %v", s, err)
+	}
+	return t, nil
+}
+
+// expandPrivateKey returns the private tags matched by the group and element
+// offset of a private dicom.dic entry, either of which may be masked with 'x',
+// and the group may be a range such as 6001-60FF. The element of each tag is
+// the offset within the private block, as expected by RegisterPrivate.
+func expandPrivateKey(group, offset string) ([]Tag, error) {
+	var matchGroup func(g uint16) bool
+	if m := tagRange.FindStringSubmatch(group); m != nil {
+		lo, _ := strconv.ParseUint(m[1], 16, 16)
+		hi, _ := strconv.ParseUint(m[2], 16, 16)
+		matchGroup = func(g uint16) bool { return uint64(g) >= lo && uint64(g) <= hi }
+	} else {
+		value, mask, err := parsePatternPart(group, false)
+		if err != nil {
+			return nil, err
+		}
+		matchGroup = func(g uint16) bool { return g&mask == value }
+	}
+	offsetValue, offsetMask, err := parsePatternPart("00"+offset, false)
+	if err != nil {
+		return nil, err
+	}
+
+	var tags []Tag
+	for g := 0; g <= 0xFFFF; g++ {
+		if !isPrivateGroup(uint16(g)) || !matchGroup(uint16(g)) {
+			continue
+		}
+		for o := 0; o <= 0xFF; o++ {
+			if uint16(o)&offsetMask == offsetValue {
+				tags = append(tags, Tag{Group: uint16(g), Element: uint16(o)})
+			}
+		}
+	}
+	if len(tags) == 0 {
+		return nil, fmt.Errorf("group %s is not private", group)
+	}
+	return tags, nil
+}
+
+// normalizeDictionaryVR maps the lowercase pseudo VRs used by dicom.dic for
+// tags that allow several VRs to the list of VRs, like
+// generate_tag_definitions.py does.
+func normalizeDictionaryVR(vr string) string {
+	switch vr {
+	case "xs":
+		return "US or SS"
+	case "ox":
+		return "OW or OB"
+	case "lt":
+		return "OW or US or SS"
+	case "up":
+		return "UL"
+	}
+	return vr
+}
+
+// validateInfo checks info and returns it with its derived fields filled in.
+func validateInfo(info Info) (Info, error) {
+	info, err := completeInfo(info)
+	if err != nil {
+		return Info{}, fmt.Errorf("%w: %v", ErrorInvalidDictionaryEntry, err)
+	}
+	for _, vr := range info.VRs {
+		if !vrRegexp.MatchString(vr) {
+			return Info{}, fmt.Errorf("%w: invalid VR %q for %s", ErrorInvalidDictionaryEntry, vr, info.Tag)
+		}
+	}
+	if info.Name == "" {
+		return Info{}, fmt.Errorf("%w: missing name for %s", ErrorInvalidDictionaryEntry, info.Tag)
+	}
+	return info, nil
+}
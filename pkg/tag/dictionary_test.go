@@ -0,0 +1,154 @@
+package tag
+
+import (
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestRegister(t *testing.T) {
+	if err := Register(Info{Tag: Tag{0x0009, 0x1001}, VR: "LO", Name: "RegisterTestModel", VM: "1-2"}); err != nil {
+		t.Fatalf("Register() unexpected error: %v", err)
+	}
+	want := Info{
+		Tag:          Tag{0x0009, 0x1001},
+		VR:           "LO",
+		Name:         "RegisterTestModel",
+		VM:           "1-2",
+		Keyword:      "RegisterTestModel",
+		VRs:          []string{"LO"},
+		Multiplicity: Multiplicity{Min: 1, Max: 2, Step: 1},
+	}
+	got, err := Find(want.Tag)
+	if err != nil {
+		t.Fatalf("Find() after Register() unexpected error: %v", err)
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("Find() after Register() unexpected info. diff: %v", diff)
+	}
+	got, err = FindByName("RegisterTestModel")
+	if err != nil {
+		t.Fatalf("FindByName() after Register() unexpected error: %v", err)
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("FindByName() after Register() unexpected info. diff: %v", diff)
+	}
+
+	if err := Register(Info{Tag: Tag{0x0009, 0x1002}, VR: "L", Name: "Bad"}); !errors.Is(err, ErrorInvalidDictionaryEntry) {
+		t.Errorf("Register(bad VR) unexpected error. got: %v, want: %v", err, ErrorInvalidDictionaryEntry)
+	}
+}
+
+func TestLoadDictionary(t *testing.T) {
+	dict := `# In-house attributes
+(0009,1010)	LO	LoadTestDcmdump	1	ACME
+(0009,1011)	xs	LoadTestDcmdumpXS	1-n
+
+(0019,"LOAD TEST",0c)	IS	LoadTestPrivate	1	private
+tag,vr,keyword,vm
+00091012,FD,LoadTestCSV,3
+"(0009,1013)",UI,LoadTestCSVParens,1
+"0009,1014",SQ,LoadTestCSVComma,1
+00091015,OB or OW,LoadTestCSVMultiVR,1
+`
+	if err := LoadDictionary(strings.NewReader(dict)); err != nil {
+		t.Fatalf("LoadDictionary() unexpected error: %v", err)
+	}
+	for _, want := range []Info{
+		newInfo(Tag{0x0009, 0x1010}, "LO", "LoadTestDcmdump", "1", false),
+		newInfo(Tag{0x0009, 0x1011}, "US or SS", "LoadTestDcmdumpXS", "1-n", false),
+		newInfo(Tag{0x0009, 0x1012}, "FD", "LoadTestCSV", "3", false),
+		newInfo(Tag{0x0009, 0x1013}, "UI", "LoadTestCSVParens", "1", false),
+		newInfo(Tag{0x0009, 0x1014}, "SQ", "LoadTestCSVComma", "1", false),
+		newInfo(Tag{0x0009, 0x1015}, "OB or OW", "LoadTestCSVMultiVR", "1", false),
+	} {
+		got, err := Find(want.Tag)
+		if err != nil {
+			t.Errorf("Find(%s) after LoadDictionary() unexpected error: %v", want.Tag, err)
+			continue
+		}
+		if diff := cmp.Diff(want, got); diff != "" {
+			t.Errorf("Find(%s) after LoadDictionary() unexpected info. diff: %v", want.Tag, diff)
+		}
+	}
+	got, err := FindPrivate("LOAD TEST", Tag{0x0019, 0x120C})
+	if err != nil || got.Name != "LoadTestPrivate" || got.VR != "IS" {
+		t.Errorf("FindPrivate() after LoadDictionary() got: %v, %v", got, err)
+	}
+}
+
+func TestLoadDictionary_PrivateCreators(t *testing.T) {
+	// Entries from dcmtk private.dic, whose creators contain characters of
+	// repeating tag patterns, and whose group and element may be masked.
+	dict := `(0009,"SPI-P-Private_ICS Release 1",10)	LO	LoadTestSPI	1	PrivateTag
+(0019,"Siemens: Thorax/Multix FD Lab Settings",xx)	US	LoadTestSiemens	1	PrivateTag
+(60xx,"LOAD TEST OVERLAY",0x)	OW	LoadTestOverlay	1	PrivateTag
+(7001-7003,"LOAD TEST RANGE",01)	LO	LoadTestRange	1	PrivateTag
+`
+	if err := LoadDictionary(strings.NewReader(dict)); err != nil {
+		t.Fatalf("LoadDictionary() unexpected error: %v", err)
+	}
+	cases := []struct {
+		creator  string
+		tag      Tag
+		wantName string
+	}{
+		{creator: "SPI-P-Private_ICS Release 1", tag: Tag{0x0009, 0x1010}, wantName: "LoadTestSPI"},
+		{creator: "Siemens: Thorax/Multix FD Lab Settings", tag: Tag{0x0019, 0x1000}, wantName: "LoadTestSiemens"},
+		{creator: "Siemens: Thorax/Multix FD Lab Settings", tag: Tag{0x0019, 0x10FF}, wantName: "LoadTestSiemens"},
+		{creator: "LOAD TEST OVERLAY", tag: Tag{0x6003, 0x1105}, wantName: "LoadTestOverlay"},
+		{creator: "LOAD TEST OVERLAY", tag: Tag{0x60FF, 0x100F}, wantName: "LoadTestOverlay"},
+		{creator: "LOAD TEST RANGE", tag: Tag{0x7003, 0x1001}, wantName: "LoadTestRange"},
+	}
+	for _, tc := range cases {
+		if got, err := FindPrivate(tc.creator, tc.tag); err != nil || got.Name != tc.wantName {
+			t.Errorf("FindPrivate(%q, %s) after LoadDictionary() got: %v, %v, want: %s", tc.creator, tc.tag, got.Name, err, tc.wantName)
+		}
+	}
+	for _, missing := range []Tag{{0x6003, 0x1110}, {0x7005, 0x1001}} {
+		if _, err := FindPrivate("LOAD TEST OVERLAY", missing); err == nil {
+			t.Errorf("FindPrivate(%s) after LoadDictionary() got no error, want one outside the masked entry", missing)
+		}
+	}
+}
+
+func TestLoadDictionary_Errors(t *testing.T) {
+	cases := []string{
+		"(0009,1020)\tLO\tLoadTestNotRegistered\t1\n(0009,10)\tLO\n",
+		"00091021,LO,NoVM\n",
+		"0009102,LO,ShortTag,1\n",
+		"00091022,LOO,BadVR,1\n",
+		"00091023,LO,BadVM,1-m\n",
+		"(0018,\"NOT PRIVATE\",01)\tLO\tEvenGroup\t1\n",
+	}
+	for _, in := range cases {
+		if err := LoadDictionary(strings.NewReader(in)); !errors.Is(err, ErrorInvalidDictionaryEntry) {
+			t.Errorf("LoadDictionary(%q) unexpected error. got: %v, want: %v", in, err, ErrorInvalidDictionaryEntry)
+		}
+	}
+	if _, err := Find(Tag{0x0009, 0x1020}); err == nil {
+		t.Errorf("LoadDictionary() registered entries despite an error")
+	}
+}
+
+func TestRegister_Concurrent(t *testing.T) {
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(2)
+		go func(i int) {
+			defer wg.Done()
+			_ = Register(Info{Tag: Tag{0x0011, uint16(0x1000 + i)}, VR: "LO", Name: "ConcurrentTest", VM: "1"})
+		}(i)
+		go func() {
+			defer wg.Done()
+			if _, err := Find(PixelData); err != nil {
+				t.Errorf("Find() unexpected error: %v", err)
+			}
+			_, _ = FindByName("PatientName")
+		}()
+	}
+	wg.Wait()
+}
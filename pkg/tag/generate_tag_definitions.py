@@ -11,41 +11,70 @@ Tag = NamedTuple('Tag', [
     ('elem', int),
     ('vr', str),
     ('name', str),
-    ('vm', str)])
+    ('vm', str),
+    ('retired', bool)])
+
+# dcmtk uses lowercase pseudo VRs for attributes that allow several VRs. The
+# first VR listed is the default.
+PSEUDO_VRS = {
+    "XS": "US or SS",
+    # OW is the only VR allowed for PixelData in implicit VR transfer syntaxes.
+    "OX": "OW or OB",
+    "LT": "OW or US or SS",
+    "UP": "UL",
+}
+
+def pattern_part(s: str, is_group: bool):
+    """Returns the (value, mask) matching the group or element s, which is
+    either a hex number, a dcmtk range of even values ("6000-60FF") or a PS3.6
+    mask ("60xx", "xxx0"). Masked groups only match even groups."""
+    if re.match('^[0-9A-Fa-f]{4}$', s):
+        return int(s, 16), 0xFFFF
+    m = re.match('^([0-9A-Fa-f]{4})-([0-9A-Fa-f]{4})$', s)
+    if m:
+        lo, hi = int(m.group(1), 16), int(m.group(2), 16)
+        size = hi - lo + 1
+        if size & (size - 1) or lo & (size - 1):
+            return None
+        return lo, (~(size - 1) | 1) & 0xFFFF
+    if re.match('^[0-9A-Fa-fx]{4}$', s):
+        value = int(s.replace('x', '0'), 16)
+        mask = int(''.join('0' if c == 'x' else 'F' for c in s), 16)
+        if is_group:
+            mask |= 1
+        return value, mask
+    return None
 
 def list_tags() -> List[Tag]:
     global DATA
 
     ok = True
     tags: List[Tag] = []
-    for line in DATA.split("\n"):
+    for line in (DATA + EXTRA_DATA).split("\n"):
         if re.match(r'\s*#', line) or re.match(r'^\s*$', line):
             continue
-        m = re.match(r'\(([^,]+),([^,]+)\)\s+(\w\w)\s+([^\t]+)\s+([^\t]+)', line)
+        m = re.match(r'\(([^,]+),([^,]+)\)\s+(\w\w)\s+([^\t]+)\s+([^\t]+)\s+([^\t]+)', line)
         if not m:
             logging.error("Invalid line: %s", line)
             ok = False
             continue
 
-        vr=m.group(3).upper()
-        if vr == "XS":
-            # Its generally safe to treat XS as unsigned.  See
-            # https://github.com/dgobbi/vtk-dicom/issues/38 for
-	    # some discussions.
-            vr = "US"
-        elif vr == "OX":
-	    # TODO(saito) I'm less sure about the OX rule. Where is
-	    # this crap defined in the standard??
-            vr = "OW"
+        vr = m.group(3)
+        if vr.islower():
+            vr = PSEUDO_VRS.get(vr.upper(), vr.upper())
+        version = m.group(6)
 
         tag = Tag(group=m.group(1),
                   elem=m.group(2),
                   vr=vr,
                   name=m.group(4),
-                  vm=m.group(5))
+                  vm=m.group(5),
+                  retired=version.endswith("retired") or version.startswith("ACR/NEMA"))
 
 
-        if not re.match('^[0-9A-Fa-f]+$', tag.group) or not re.match('^[0-9A-Fa-f]+$', tag.elem):
+        if pattern_part(tag.group, True) is None or pattern_part(tag.elem, False) is None:
+            # The -o- and -u- ranges (private creators, group lengths) are
+            # handled in code.
             continue
         tags.append(tag)
     if not ok:
@@ -56,15 +85,17 @@ def list_tags() -> List[Tag]:
 def generate(out: IO[str]):
     tags = list_tags()
 
-    print("package dicomtag", file=out)
+    print("package tag", file=out)
     print("", file=out)
     print("// Code generated from generate_tag_definitions.py. DO NOT EDIT.", file=out)
     for t in tags:
         if t.name.find("RETIRED") >= 0:
             continue
-        print(f'var {t.name} = Tag{{0x{t.group}, 0x{t.elem}}}', file=out)
+        group, _ = pattern_part(t.group, True)
+        elem, _ = pattern_part(t.elem, False)
+        print(f'var {t.name} = Tag{{0x{group:04X}, 0x{elem:04X}}}', file=out)
 
-    print("var tagDict map[Tag]TagInfo", file=out)
+    print("var tagDict map[Tag]Info", file=out)
     print("", file=out)
     print("func init() {", file=out)
     print("	maybeInitTagDict()", file=out)
@@ -73,9 +104,17 @@ def generate(out: IO[str]):
     print("	if len(tagDict) > 0 {", file=out)
     print("		return", file=out)
     print("	}", file=out)
-    print("	tagDict = make(map[Tag]TagInfo)", file=out)
+    print("	tagDict = make(map[Tag]Info)", file=out)
     for t in tags:
-        print(f'	tagDict[Tag{{0x{t.group}, 0x{t.elem}}}] = TagInfo{{Tag{{0x{t.group}, 0x{t.elem}}}, "{t.vr}", "{t.name}", "{t.vm}"}}', file=out)
+        retired = "true" if t.retired else "false"
+        group, group_mask = pattern_part(t.group, True)
+        elem, elem_mask = pattern_part(t.elem, False)
+        tag = f'Tag{{0x{group:04X}, 0x{elem:04X}}}'
+        info = f'newInfo({tag}, "{t.vr}", "{t.name}", "{t.vm}", {retired})'
+        if group_mask == 0xFFFF and elem_mask == 0xFFFF:
+            print(f'	tagDict[{tag}] = {info}', file=out)
+        else:
+            print(f'	addRepeating({tag}, Tag{{0x{group_mask:04X}, 0x{elem_mask:04X}}}, {info})', file=out)
     print("}", file=out)
 
 
@@ -4035,6 +4074,27 @@ DATA = """#
 #
 """
 
+# Repeating elements defined by PS3.6 that the dcmtk dictionary above lists as
+# single tags.
+EXTRA_DATA = """
+(0028,04x0)	US	RETIRED_RowsForNthOrderCoefficients	1	DICOM/retired
+(0028,04x1)	US	RETIRED_ColumnsForNthOrderCoefficients	1	DICOM/retired
+(0028,04x2)	LO	RETIRED_CoefficientCoding	1-n	DICOM/retired
+(0028,04x3)	AT	RETIRED_CoefficientCodingPointers	1-n	DICOM/retired
+(0028,08x0)	LO	RETIRED_CodeLabel	1-n	DICOM/retired
+(0028,08x2)	US	RETIRED_NumberOfTables	1	DICOM/retired
+(0028,08x3)	AT	RETIRED_CodeTableLocation	1-n	DICOM/retired
+(0028,08x4)	US	RETIRED_BitsForCodeWord	1	DICOM/retired
+(0028,08x8)	AT	RETIRED_ImageDataLocation	1-n	DICOM/retired
+(1000,xxx0)	US	RETIRED_EscapeTriplet	3	DICOM/retired
+(1000,xxx1)	US	RETIRED_RunLengthTriplet	3	DICOM/retired
+(1000,xxx2)	US	RETIRED_HuffmanTableSize	1	DICOM/retired
+(1000,xxx3)	US	RETIRED_HuffmanTableTriplet	3	DICOM/retired
+(1000,xxx4)	US	RETIRED_ShiftTableSize	1	DICOM/retired
+(1000,xxx5)	US	RETIRED_ShiftTableTriplet	3	DICOM/retired
+(1010,xxxx)	US	RETIRED_ZonalMap	1-n	DICOM/retired
+"""
+
 def main():
     with open("tag_definitions.go", "w") as out:
         generate(out)
@@ -0,0 +1,124 @@
+package tag
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ErrorInvalidVM indicates that a value multiplicity string could not be
+// parsed.
+var ErrorInvalidVM = errors.New("invalid value multiplicity")
+
+// VMUnbounded is the Multiplicity.Max of value multiplicities without an upper
+// bound, such as "1-n".
+const VMUnbounded = -1
+
+// retiredPrefix is the prefix of the names of retired tags.
+const retiredPrefix = "RETIRED_"
+
+// Multiplicity is a parsed value multiplicity (VM), see PS3.5 6.4. For
+// example, "1" is {1, 1, 1}, "1-3" is {1, 3, 1}, "1-n" is {1, VMUnbounded, 1}
+// and "2-2n" is {2, VMUnbounded, 2}.
+type Multiplicity struct {
+	// Min is the minimum number of values.
+	Min int
+	// Max is the maximum number of values, or VMUnbounded.
+	Max int
+	// Step is the number the count of values must be a multiple of, e.g. 2
+	// for "2-2n". It is 1 for all other multiplicities.
+	Step int
+}
+
+// ParseVM parses a value multiplicity string, such as "1", "1-3", "1-n" or
+// "2-2n".
+func ParseVM(vm string) (Multiplicity, error) {
+	parts := strings.Split(strings.TrimSpace(vm), "-")
+	if len(parts) > 2 {
+		return Multiplicity{}, fmt.Errorf("%w: %q", ErrorInvalidVM, vm)
+	}
+	min, err := strconv.Atoi(parts[0])
+	if err != nil || min < 0 {
+		return Multiplicity{}, fmt.Errorf("%w: %q", ErrorInvalidVM, vm)
+	}
+	if len(parts) == 1 {
+		return Multiplicity{Min: min, Max: min, Step: 1}, nil
+	}
+	if strings.HasSuffix(parts[1], "n") {
+		step := 1
+		if s := strings.TrimSuffix(parts[1], "n"); s != "" {
+			if step, err = strconv.Atoi(s); err != nil || step < 1 {
+				return Multiplicity{}, fmt.Errorf("%w: %q", ErrorInvalidVM, vm)
+			}
+		}
+		return Multiplicity{Min: min, Max: VMUnbounded, Step: step}, nil
+	}
+	max, err := strconv.Atoi(parts[1])
+	if err != nil || max < min {
+		return Multiplicity{}, fmt.Errorf("%w: %q", ErrorInvalidVM, vm)
+	}
+	return Multiplicity{Min: min, Max: max, Step: 1}, nil
+}
+
+// Allows indicates if n values satisfy this multiplicity.
+func (m Multiplicity) Allows(n int) bool {
+	if n < m.Min || (m.Max != VMUnbounded && n > m.Max) {
+		return false
+	}
+	return m.Step <= 1 || n%m.Step == 0
+}
+
+// AllowsVR indicates if vr is one of the VRs allowed for this tag.
+func (i Info) AllowsVR(vr string) bool {
+	for _, v := range i.VRs {
+		if v == vr {
+			return true
+		}
+	}
+	return false
+}
+
+// newInfo builds an Info, where vrs lists the allowed VRs separated by " or "
+// (the default VR first) as in PS3.6, e.g. "US or SS". It panics if vm is
+// invalid, so it must only be used for the built-in dictionaries.
+func newInfo(t Tag, vrs, name, vm string, retired bool) Info {
+	info, err := completeInfo(Info{Tag: t, VR: vrs, Name: name, VM: vm, Retired: retired})
+	if err != nil {
+		panic(err)
+	}
+	return info
+}
+
+// completeInfo fills in the fields of info that can be derived from the
+// others: VR and VRs from each other (VR may list several VRs separated by
+// " or "), Keyword from Name, Retired from the "RETIRED_" prefix of Name and
+// Multiplicity from VM.
+func completeInfo(info Info) (Info, error) {
+	if strings.Contains(info.VR, " or ") {
+		info.VRs = strings.Split(info.VR, " or ")
+		info.VR = info.VRs[0]
+	}
+	if len(info.VRs) == 0 {
+		info.VRs = []string{info.VR}
+	}
+	if info.VR == "" {
+		info.VR = info.VRs[0]
+	} else if !info.AllowsVR(info.VR) {
+		info.VRs = append([]string{info.VR}, info.VRs...)
+	}
+	if info.Keyword == "" {
+		info.Keyword = strings.TrimPrefix(info.Name, retiredPrefix)
+	}
+	if strings.HasPrefix(info.Name, retiredPrefix) {
+		info.Retired = true
+	}
+	if info.VM != "" && info.Multiplicity == (Multiplicity{}) {
+		m, err := ParseVM(info.VM)
+		if err != nil {
+			return Info{}, fmt.Errorf("%s: %w", info.Tag, err)
+		}
+		info.Multiplicity = m
+	}
+	return info, nil
+}
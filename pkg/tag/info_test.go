@@ -0,0 +1,109 @@
+package tag
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParseVM(t *testing.T) {
+	cases := []struct {
+		vm   string
+		want Multiplicity
+	}{
+		{"1", Multiplicity{1, 1, 1}},
+		{"16", Multiplicity{16, 16, 1}},
+		{"1-3", Multiplicity{1, 3, 1}},
+		{"1-n", Multiplicity{1, VMUnbounded, 1}},
+		{"2-2n", Multiplicity{2, VMUnbounded, 2}},
+		{"3-3n", Multiplicity{3, VMUnbounded, 3}},
+	}
+	for _, tc := range cases {
+		got, err := ParseVM(tc.vm)
+		if err != nil {
+			t.Errorf("ParseVM(%q) unexpected error: %v", tc.vm, err)
+			continue
+		}
+		if got != tc.want {
+			t.Errorf("ParseVM(%q) got: %v, want: %v", tc.vm, got, tc.want)
+		}
+	}
+	for _, vm := range []string{"", "n", "3-1", "1-0n", "1-2-3", "a"} {
+		if _, err := ParseVM(vm); !errors.Is(err, ErrorInvalidVM) {
+			t.Errorf("ParseVM(%q) unexpected error. got: %v, want: %v", vm, err, ErrorInvalidVM)
+		}
+	}
+}
+
+func TestMultiplicity_Allows(t *testing.T) {
+	cases := []struct {
+		vm   string
+		n    int
+		want bool
+	}{
+		{"1", 1, true},
+		{"1", 2, false},
+		{"1-3", 0, false},
+		{"1-3", 3, true},
+		{"1-n", 100, true},
+		{"2-2n", 4, true},
+		{"2-2n", 5, false},
+		{"3-3n", 3, true},
+	}
+	for _, tc := range cases {
+		m, err := ParseVM(tc.vm)
+		if err != nil {
+			t.Fatalf("ParseVM(%q) unexpected error: %v", tc.vm, err)
+		}
+		if got := m.Allows(tc.n); got != tc.want {
+			t.Errorf("ParseVM(%q).Allows(%d) got: %v, want: %v", tc.vm, tc.n, got, tc.want)
+		}
+	}
+}
+
+func TestFind_Metadata(t *testing.T) {
+	cases := []struct {
+		tag  Tag
+		want Info
+	}{
+		{
+			tag: SmallestImagePixelValue,
+			want: Info{
+				Tag: SmallestImagePixelValue, VR: "US", Name: "SmallestImagePixelValue", VM: "1",
+				Keyword: "SmallestImagePixelValue", VRs: []string{"US", "SS"}, Multiplicity: Multiplicity{1, 1, 1},
+			},
+		},
+		{
+			tag: PixelData,
+			want: Info{
+				Tag: PixelData, VR: "OW", Name: "PixelData", VM: "1",
+				Keyword: "PixelData", VRs: []string{"OW", "OB"}, Multiplicity: Multiplicity{1, 1, 1},
+			},
+		},
+		{
+			tag: Tag{0x0008, 0x0001},
+			want: Info{
+				Tag: Tag{0x0008, 0x0001}, VR: "UL", Name: "RETIRED_LengthToEnd", VM: "1",
+				Keyword: "LengthToEnd", Retired: true, VRs: []string{"UL"}, Multiplicity: Multiplicity{1, 1, 1},
+			},
+		},
+		{
+			tag: DimensionIndexPointer,
+			want: Info{
+				Tag: DimensionIndexPointer, VR: "AT", Name: "DimensionIndexPointer", VM: "1",
+				Keyword: "DimensionIndexPointer", VRs: []string{"AT"}, Multiplicity: Multiplicity{1, 1, 1},
+			},
+		},
+	}
+	for _, tc := range cases {
+		got, err := Find(tc.tag)
+		if err != nil {
+			t.Errorf("Find(%s) unexpected error: %v", tc.tag, err)
+			continue
+		}
+		if diff := cmp.Diff(tc.want, got); diff != "" {
+			t.Errorf("Find(%s) unexpected info. diff: %v", tc.tag, diff)
+		}
+	}
+}
@@ -0,0 +1,107 @@
+package tag
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// ErrorInvalidPrivateTag indicates that a tag or offset passed to the private
+// dictionary does not identify a private data element.
+var ErrorInvalidPrivateTag = errors.New("not a private data element tag")
+
+// privateKey identifies an attribute in the private dictionary: the Private
+// Creator that reserved the block, the (odd) group and the element offset
+// within the block.
+type privateKey struct {
+	creator string
+	group   uint16
+	offset  uint8
+}
+
+var (
+	privateDictMu sync.RWMutex
+	privateDict   map[privateKey]Info
+)
+
+func init() {
+	privateDict = make(map[privateKey]Info, len(privateDefinitions))
+	for _, d := range privateDefinitions {
+		privateDict[privateKey{d.creator, d.info.Tag.Group, uint8(d.info.Tag.Element)}] = d.info
+	}
+}
+
+// IsPrivateCreator indicates if t is a Private Creator Data Element, i.e.
+// (gggg,0010-00FF) for an odd group gggg. See PS3.5 7.8.1.
+func IsPrivateCreator(t Tag) bool {
+	return isPrivateGroup(t.Group) && t.Element >= 0x0010 && t.Element <= 0x00FF
+}
+
+// PrivateCreatorInfo returns information about the Private Creator Data Element
+// t, which is always LO (PS3.5 7.8.1).
+func PrivateCreatorInfo(t Tag) Info {
+	return newInfo(t, "LO", "PrivateCreator", "1", false)
+}
+
+// PrivateCreatorFor returns the tag of the Private Creator Data Element that
+// reserves the block containing the private data element t. For example, the
+// Private Creator of (0019,1008) is (0019,0010). It returns false if t is not
+// a private data element.
+func PrivateCreatorFor(t Tag) (Tag, bool) {
+	if !isPrivateGroup(t.Group) || t.Element < 0x1000 {
+		return Tag{}, false
+	}
+	return Tag{Group: t.Group, Element: t.Element >> 8}, true
+}
+
+// RegisterPrivate adds (or replaces) an attribute in the private dictionary.
+// info.Tag.Group is the private group and the low byte of info.Tag.Element is
+// the offset of the attribute within its block (the "xx" in (0019,xx08)); the
+// high byte is ignored, since the block is only known once the Private Creator
+// is resolved in a given Dataset.
+//
+//	Example: RegisterPrivate("ACME 1.0", Info{Tag: Tag{0x0029, 0x0001}, VR: "LO", Name: "AcmeModel", VM: "1"})
+func RegisterPrivate(creator string, info Info) error {
+	if !isPrivateGroup(info.Tag.Group) {
+		return fmt.Errorf("%w: group %04x", ErrorInvalidPrivateTag, info.Tag.Group)
+	}
+	info, err := validateInfo(info)
+	if err != nil {
+		return err
+	}
+	info.Tag.Element &= 0x00FF
+	privateDictMu.Lock()
+	defer privateDictMu.Unlock()
+	privateDict[privateKey{normalizeCreator(creator), info.Tag.Group, uint8(info.Tag.Element)}] = info
+	return nil
+}
+
+// FindPrivate finds information about the private data element t, whose block
+// is reserved by the Private Creator creator (the value of the element at
+// PrivateCreatorFor(t)). The returned Info.Tag is t.
+func FindPrivate(creator string, t Tag) (Info, error) {
+	if _, ok := PrivateCreatorFor(t); !ok {
+		return Info{}, fmt.Errorf("%w: %s", ErrorInvalidPrivateTag, t)
+	}
+	privateDictMu.RLock()
+	info, ok := privateDict[privateKey{normalizeCreator(creator), t.Group, uint8(t.Element)}]
+	privateDictMu.RUnlock()
+	if !ok {
+		return Info{}, fmt.Errorf("Could not find private tag %s for creator %q in dictionary", t, creator)
+	}
+	info.Tag = t
+	return info, nil
+}
+
+// normalizeCreator strips the padding that may surround Private Creator
+// values.
+func normalizeCreator(creator string) string {
+	return strings.Trim(creator, " \x00")
+}
+
+// isPrivateGroup is like IsPrivate, but excludes the groups that PS3.5 7.8
+// reserves and that may not be used for private data elements.
+func isPrivateGroup(group uint16) bool {
+	return IsPrivate(group) && group > 0x0008 && group != 0xFFFF
+}
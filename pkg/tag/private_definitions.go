@@ -0,0 +1,58 @@
+package tag
+
+// privateDefinition is an entry of the built-in private dictionary. The element
+// number of info.Tag is the offset within the private block.
+type privateDefinition struct {
+	creator string
+	info    Info
+}
+
+// privateDefinitions holds well-known vendor private attributes, as documented
+// in vendor conformance statements. Use RegisterPrivate to add more.
+var privateDefinitions = []privateDefinition{
+	// GE Healthcare
+	{"GEMS_IDEN_01", newInfo(Tag{0x0009, 0x0001}, "LO", "FullFidelity", "1", false)},
+	{"GEMS_IDEN_01", newInfo(Tag{0x0009, 0x0002}, "SH", "SuiteID", "1", false)},
+	{"GEMS_ACQU_01", newInfo(Tag{0x0019, 0x009C}, "LO", "PulseSequenceName", "1", false)},
+	{"GEMS_ACQU_01", newInfo(Tag{0x0019, 0x009E}, "LO", "InternalPulseSequenceName", "1", false)},
+	{"GEMS_SERS_01", newInfo(Tag{0x0025, 0x0007}, "SL", "ImagesInSeries", "1", false)},
+	{"GEMS_PARM_01", newInfo(Tag{0x0043, 0x0039}, "IS", "SlopInteger6To9", "4", false)},
+
+	// Siemens Healthineers
+	{"SIEMENS CSA HEADER", newInfo(Tag{0x0029, 0x0008}, "CS", "CSAImageHeaderType", "1", false)},
+	{"SIEMENS CSA HEADER", newInfo(Tag{0x0029, 0x0009}, "LO", "CSAImageHeaderVersion", "1", false)},
+	{"SIEMENS CSA HEADER", newInfo(Tag{0x0029, 0x0010}, "OB", "CSAImageHeaderInfo", "1", false)},
+	{"SIEMENS CSA HEADER", newInfo(Tag{0x0029, 0x0018}, "CS", "CSASeriesHeaderType", "1", false)},
+	{"SIEMENS CSA HEADER", newInfo(Tag{0x0029, 0x0019}, "LO", "CSASeriesHeaderVersion", "1", false)},
+	{"SIEMENS CSA HEADER", newInfo(Tag{0x0029, 0x0020}, "OB", "CSASeriesHeaderInfo", "1", false)},
+	{"SIEMENS MR HEADER", newInfo(Tag{0x0019, 0x0008}, "CS", "CSAImageHeaderType", "1", false)},
+	{"SIEMENS MR HEADER", newInfo(Tag{0x0019, 0x0009}, "LO", "CSAImageHeaderVersion", "1", false)},
+	{"SIEMENS MR HEADER", newInfo(Tag{0x0019, 0x000A}, "US", "NumberOfImagesInMosaic", "1", false)},
+	{"SIEMENS MR HEADER", newInfo(Tag{0x0019, 0x000B}, "DS", "SliceMeasurementDuration", "1", false)},
+	{"SIEMENS MR HEADER", newInfo(Tag{0x0019, 0x000C}, "IS", "BValue", "1", false)},
+	{"SIEMENS MR HEADER", newInfo(Tag{0x0019, 0x000D}, "CS", "DiffusionDirectionality", "1", false)},
+	{"SIEMENS MR HEADER", newInfo(Tag{0x0019, 0x000E}, "FD", "DiffusionGradientDirection", "3", false)},
+	{"SIEMENS MR HEADER", newInfo(Tag{0x0019, 0x000F}, "SH", "GradientMode", "1", false)},
+	{"SIEMENS MR HEADER", newInfo(Tag{0x0019, 0x0011}, "SH", "FlowCompensation", "1", false)},
+	{"SIEMENS MR HEADER", newInfo(Tag{0x0019, 0x0012}, "SL", "TablePositionOrigin", "3", false)},
+	{"SIEMENS MR HEADER", newInfo(Tag{0x0019, 0x0013}, "SL", "ImaAbsTablePosition", "3", false)},
+	{"SIEMENS MR HEADER", newInfo(Tag{0x0019, 0x0014}, "IS", "ImaRelTablePosition", "3", false)},
+	{"SIEMENS MR HEADER", newInfo(Tag{0x0019, 0x0015}, "FD", "SlicePositionPCS", "3", false)},
+	{"SIEMENS MR HEADER", newInfo(Tag{0x0019, 0x0016}, "DS", "TimeAfterStart", "1", false)},
+	{"SIEMENS MR HEADER", newInfo(Tag{0x0019, 0x0017}, "DS", "SliceResolution", "1", false)},
+	{"SIEMENS MR HEADER", newInfo(Tag{0x0019, 0x0018}, "IS", "RealDwellTime", "1", false)},
+	{"SIEMENS MR HEADER", newInfo(Tag{0x0019, 0x0027}, "FD", "BMatrix", "6", false)},
+	{"SIEMENS MR HEADER", newInfo(Tag{0x0019, 0x0028}, "FD", "BandwidthPerPixelPhaseEncode", "1", false)},
+	{"SIEMENS MR HEADER", newInfo(Tag{0x0019, 0x0029}, "FD", "MosaicRefAcqTimes", "1-n", false)},
+
+	// Philips Healthcare
+	{"Philips Imaging DD 001", newInfo(Tag{0x2001, 0x0003}, "FL", "DiffusionBFactor", "1", false)},
+	{"Philips Imaging DD 001", newInfo(Tag{0x2001, 0x0004}, "CS", "DiffusionDirection", "1", false)},
+	{"Philips Imaging DD 001", newInfo(Tag{0x2001, 0x0008}, "IS", "PhaseNumber", "1", false)},
+	{"Philips Imaging DD 001", newInfo(Tag{0x2001, 0x000A}, "IS", "SliceNumberMR", "1", false)},
+	{"Philips Imaging DD 001", newInfo(Tag{0x2001, 0x000B}, "CS", "SliceOrientation", "1", false)},
+	{"Philips Imaging DD 001", newInfo(Tag{0x2001, 0x0017}, "SL", "NumberOfPhasesMR", "1", false)},
+	{"Philips Imaging DD 001", newInfo(Tag{0x2001, 0x0018}, "SL", "NumberOfSlicesMR", "1", false)},
+	{"Philips MR Imaging DD 001", newInfo(Tag{0x2005, 0x000D}, "FL", "ScaleIntercept", "1", false)},
+	{"Philips MR Imaging DD 001", newInfo(Tag{0x2005, 0x000E}, "FL", "ScaleSlope", "1", false)},
+}
@@ -0,0 +1,76 @@
+package tag
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestPrivateCreatorFor(t *testing.T) {
+	cases := []struct {
+		tag    Tag
+		want   Tag
+		wantOK bool
+	}{
+		{tag: Tag{0x0019, 0x100C}, want: Tag{0x0019, 0x0010}, wantOK: true},
+		{tag: Tag{0x2001, 0xFF03}, want: Tag{0x2001, 0x00FF}, wantOK: true},
+		{tag: Tag{0x0019, 0x0010}},
+		{tag: Tag{0x0018, 0x100C}},
+		{tag: Tag{0x0007, 0x100C}},
+	}
+	for _, tc := range cases {
+		got, ok := PrivateCreatorFor(tc.tag)
+		if got != tc.want || ok != tc.wantOK {
+			t.Errorf("PrivateCreatorFor(%s) got: %s, %v, want: %s, %v", tc.tag, got, ok, tc.want, tc.wantOK)
+		}
+	}
+}
+
+func TestPrivateCreatorInfo(t *testing.T) {
+	got := PrivateCreatorInfo(Tag{0x0019, 0x0010})
+	want := Info{
+		Tag:          Tag{0x0019, 0x0010},
+		VR:           "LO",
+		Name:         "PrivateCreator",
+		VM:           "1",
+		Keyword:      "PrivateCreator",
+		VRs:          []string{"LO"},
+		Multiplicity: Multiplicity{Min: 1, Max: 1, Step: 1},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("PrivateCreatorInfo() unexpected info. diff: %v", diff)
+	}
+}
+
+func TestFindPrivate(t *testing.T) {
+	info, err := FindPrivate("SIEMENS CSA HEADER ", Tag{0x0029, 0x1110})
+	if err != nil {
+		t.Fatalf("FindPrivate() unexpected error: %v", err)
+	}
+	if info.Name != "CSAImageHeaderInfo" || info.VR != "OB" || info.Tag != (Tag{0x0029, 0x1110}) {
+		t.Errorf("FindPrivate() unexpected info: %v", info)
+	}
+	if _, err := FindPrivate("SIEMENS CSA HEADER", Tag{0x0029, 0x0010}); !errors.Is(err, ErrorInvalidPrivateTag) {
+		t.Errorf("FindPrivate(creator element) unexpected error. got: %v, want: %v", err, ErrorInvalidPrivateTag)
+	}
+	if _, err := FindPrivate("SOMEONE ELSE", Tag{0x0029, 0x1110}); err == nil {
+		t.Errorf("FindPrivate(unknown creator) expected an error")
+	}
+}
+
+func TestRegisterPrivate(t *testing.T) {
+	if err := RegisterPrivate("ACME 1.0", Info{Tag: Tag{0x0041, 0x1001}, VR: "LO", Name: "AcmeModel", VM: "1"}); err != nil {
+		t.Fatalf("RegisterPrivate() unexpected error: %v", err)
+	}
+	info, err := FindPrivate("ACME 1.0", Tag{0x0041, 0x2001})
+	if err != nil {
+		t.Fatalf("FindPrivate() unexpected error: %v", err)
+	}
+	if info.Name != "AcmeModel" || info.VR != "LO" {
+		t.Errorf("FindPrivate() unexpected info: %v", info)
+	}
+	if err := RegisterPrivate("ACME 1.0", Info{Tag: Tag{0x0040, 0x1001}}); !errors.Is(err, ErrorInvalidPrivateTag) {
+		t.Errorf("RegisterPrivate(even group) unexpected error. got: %v, want: %v", err, ErrorInvalidPrivateTag)
+	}
+}
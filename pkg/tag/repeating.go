@@ -0,0 +1,137 @@
+package tag
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// repeatingEntry is a dictionary entry for a repeating group or element, such
+// as Overlay Data (60xx,3000). A tag t matches the entry if
+// t.Group&mask.Group == value.Group and t.Element&mask.Element ==
+// value.Element.
+type repeatingEntry struct {
+	value Tag
+	mask  Tag
+	info  Info
+}
+
+// repeatingDict holds the repeating tag definitions, guarded by tagDictMu like
+// tagDict. Later entries take precedence over earlier ones.
+var repeatingDict []repeatingEntry
+
+var (
+	// tagRange matches dcmtk ranges of even values, e.g. "6000-60FF".
+	tagRange = regexp.MustCompile(`^([0-9A-Fa-f]{4})-([0-9A-Fa-f]{4})$`)
+	// tagMask matches PS3.6 masks, e.g. "60xx" or "xxx0".
+	tagMask = regexp.MustCompile(`^[0-9A-Fa-fx]{4}$`)
+)
+
+// RegisterRepeating adds info to the tag dictionary for every tag matching
+// pattern, replacing any existing definition for the same pattern. The pattern
+// uses the PS3.6 notation, where each x stands for any hex digit, e.g.
+// "(60xx,3000)" or "(1000,xxx0)". As in PS3.6, repeating groups only include
+// even groups. The dcmtk notation for ranges of even values, e.g.
+// "(6000-60FF,3000)", is also accepted. info.Tag is ignored.
+//
+// Exact definitions (see Register) take precedence over repeating ones.
+func RegisterRepeating(pattern string, info Info) error {
+	value, mask, err := parseTagPattern(pattern)
+	if err != nil {
+		return err
+	}
+	info.Tag = value
+	info, err = validateInfo(info)
+	if err != nil {
+		return err
+	}
+	tagDictMu.Lock()
+	defer tagDictMu.Unlock()
+	maybeInitTagDict()
+	addRepeating(value, mask, info)
+	return nil
+}
+
+// addRepeating adds an entry to repeatingDict. The caller must hold tagDictMu,
+// or be initializing the dictionary.
+func addRepeating(value, mask Tag, info Info) {
+	for i, e := range repeatingDict {
+		if e.value == value && e.mask == mask {
+			repeatingDict[i].info = info
+			return
+		}
+	}
+	repeatingDict = append(repeatingDict, repeatingEntry{value: value, mask: mask, info: info})
+}
+
+// findRepeating returns the repeating definition matching t. The caller must
+// hold tagDictMu.
+func findRepeating(t Tag) (Info, bool) {
+	for i := len(repeatingDict) - 1; i >= 0; i-- {
+		e := repeatingDict[i]
+		if t.Group&e.mask.Group == e.value.Group && t.Element&e.mask.Element == e.value.Element {
+			info := e.info
+			info.Tag = t
+			return info, true
+		}
+	}
+	return Info{}, false
+}
+
+// parseTagPattern parses a repeating tag pattern (see RegisterRepeating) into
+// the value and mask of the tags it matches.
+func parseTagPattern(pattern string) (value Tag, mask Tag, err error) {
+	parts := strings.Split(strings.Trim(strings.TrimSpace(pattern), "()"), ",")
+	if len(parts) != 2 {
+		return Tag{}, Tag{}, fmt.Errorf("%w: invalid tag pattern %q", ErrorInvalidDictionaryEntry, pattern)
+	}
+	group, groupMask, err := parsePatternPart(parts[0], true)
+	if err != nil {
+		return Tag{}, Tag{}, fmt.Errorf("%w: invalid tag pattern %q", ErrorInvalidDictionaryEntry, pattern)
+	}
+	elem, elemMask, err := parsePatternPart(parts[1], false)
+	if err != nil {
+		return Tag{}, Tag{}, fmt.Errorf("%w: invalid tag pattern %q", ErrorInvalidDictionaryEntry, pattern)
+	}
+	if group%2 == 1 {
+		return Tag{}, Tag{}, fmt.Errorf("%w: tag pattern %q is in a private group, use RegisterPrivate", ErrorInvalidDictionaryEntry, pattern)
+	}
+	if groupMask == 0xFFFF && elemMask == 0xFFFF {
+		return Tag{}, Tag{}, fmt.Errorf("%w: tag pattern %q does not repeat", ErrorInvalidDictionaryEntry, pattern)
+	}
+	return Tag{group, elem}, Tag{groupMask, elemMask}, nil
+}
+
+// parsePatternPart parses the group or element of a tag pattern. Masked groups
+// only match even groups.
+func parsePatternPart(s string, isGroup bool) (value, mask uint16, err error) {
+	s = strings.TrimSpace(s)
+	if m := tagRange.FindStringSubmatch(s); m != nil {
+		lo, _ := strconv.ParseUint(m[1], 16, 16)
+		hi, _ := strconv.ParseUint(m[2], 16, 16)
+		size := hi - lo + 1
+		// Only aligned, power of two sized ranges can be expressed as masks.
+		if hi < lo || size&(size-1) != 0 || lo&(size-1) != 0 {
+			return 0, 0, fmt.Errorf("unsupported range %q", s)
+		}
+		return uint16(lo), ^uint16(size-1) | 1, nil
+	}
+	if !tagMask.MatchString(s) {
+		return 0, 0, fmt.Errorf("invalid pattern %q", s)
+	}
+	for _, c := range s {
+		value <<= 4
+		mask <<= 4
+		if c == 'x' {
+			continue
+		}
+		d, _ := strconv.ParseUint(string(c), 16, 8)
+		value |= uint16(d)
+		mask |= 0xF
+	}
+	if isGroup && mask != 0xFFFF {
+		mask |= 1
+	}
+	return value, mask, nil
+}
@@ -0,0 +1,99 @@
+package tag
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestFind_Repeating(t *testing.T) {
+	cases := []struct {
+		tag      Tag
+		wantName string
+		wantVRs  []string
+	}{
+		{tag: Tag{0x6000, 0x3000}, wantName: "OverlayData", wantVRs: []string{"OW", "OB"}},
+		{tag: Tag{0x6002, 0x3000}, wantName: "OverlayData", wantVRs: []string{"OW", "OB"}},
+		{tag: Tag{0x601E, 0x0010}, wantName: "OverlayRows", wantVRs: []string{"US"}},
+		{tag: Tag{0x5004, 0x3000}, wantName: "RETIRED_CurveData", wantVRs: []string{"OW", "OB"}},
+		{tag: Tag{0x1000, 0x0023}, wantName: "RETIRED_HuffmanTableTriplet", wantVRs: []string{"US"}},
+		{tag: Tag{0x0028, 0x0812}, wantName: "RETIRED_NumberOfTables", wantVRs: []string{"US"}},
+	}
+	for _, tc := range cases {
+		t.Run(tc.tag.String(), func(t *testing.T) {
+			got, err := Find(tc.tag)
+			if err != nil {
+				t.Fatalf("Find(%s) unexpected error: %v", tc.tag, err)
+			}
+			if got.Tag != tc.tag {
+				t.Errorf("Find(%s) unexpected tag. got: %s, want: %s", tc.tag, got.Tag, tc.tag)
+			}
+			if got.Name != tc.wantName {
+				t.Errorf("Find(%s) unexpected name. got: %s, want: %s", tc.tag, got.Name, tc.wantName)
+			}
+			if diff := cmp.Diff(tc.wantVRs, got.VRs); diff != "" {
+				t.Errorf("Find(%s) unexpected VRs. diff: %v", tc.tag, diff)
+			}
+		})
+	}
+
+	// Repeating groups only include even groups; odd groups are private.
+	if info, err := Find(Tag{0x6001, 0x3000}); err == nil {
+		t.Errorf("Find(6001,3000) expected error, got: %v", info)
+	}
+	if OverlayData != (Tag{0x6000, 0x3000}) {
+		t.Errorf("unexpected OverlayData tag: %s", OverlayData)
+	}
+}
+
+func TestRegisterRepeating(t *testing.T) {
+	if err := RegisterRepeating("(0009,10xx)", Info{VR: "LO", Name: "RepeatingTestLabel", VM: "1"}); err == nil {
+		t.Errorf("RegisterRepeating() on a private group expected an error")
+	}
+	if err := RegisterRepeating("(70xx,0010)", Info{VR: "FD", Name: "RepeatingTestValue", VM: "1"}); err != nil {
+		t.Fatalf("RegisterRepeating() unexpected error: %v", err)
+	}
+	got, err := Find(Tag{0x7012, 0x0010})
+	if err != nil {
+		t.Fatalf("Find() after RegisterRepeating() unexpected error: %v", err)
+	}
+	if got.Name != "RepeatingTestValue" || got.VR != "FD" || got.Tag != (Tag{0x7012, 0x0010}) {
+		t.Errorf("Find() after RegisterRepeating() unexpected info: %v", got)
+	}
+	got, err = FindByName("RepeatingTestValue")
+	if err != nil {
+		t.Fatalf("FindByName() after RegisterRepeating() unexpected error: %v", err)
+	}
+	if got.Tag != (Tag{0x7000, 0x0010}) {
+		t.Errorf("FindByName() after RegisterRepeating() unexpected tag: %s", got.Tag)
+	}
+
+	for _, pattern := range []string{"(6000,3000)", "(60x,3000)", "(6000-60FE,3000)", "(6001-6003,3000)", "60xx"} {
+		if err := RegisterRepeating(pattern, Info{VR: "OW", Name: "Bad", VM: "1"}); !errors.Is(err, ErrorInvalidDictionaryEntry) {
+			t.Errorf("RegisterRepeating(%q) unexpected error. got: %v, want: %v", pattern, err, ErrorInvalidDictionaryEntry)
+		}
+	}
+}
+
+func TestLoadDictionary_Repeating(t *testing.T) {
+	dict := `(72xx,0020)	LO	RepeatingLoadTestMask	1	ACME
+(7400-74FF,0020)	SH	RepeatingLoadTestRange	1	ACME
+`
+	if err := LoadDictionary(strings.NewReader(dict)); err != nil {
+		t.Fatalf("LoadDictionary() unexpected error: %v", err)
+	}
+	for tg, want := range map[Tag]string{
+		{0x7204, 0x0020}: "RepeatingLoadTestMask",
+		{0x74FE, 0x0020}: "RepeatingLoadTestRange",
+	} {
+		got, err := Find(tg)
+		if err != nil {
+			t.Fatalf("Find(%s) unexpected error: %v", tg, err)
+		}
+		if got.Name != want {
+			t.Errorf("Find(%s) unexpected name. got: %s, want: %s", tg, got.Name, want)
+		}
+	}
+}
@@ -70,12 +70,24 @@ func (t Tag) String() string {
 // standard.
 type Info struct {
 	Tag Tag
-	// Data encoding "UL", "CS", etc.
+	// Data encoding "UL", "CS", etc. For tags that allow several VRs, this is
+	// the default one, i.e. VRs[0].
 	VR string
-	// Human-readable name of the tag, e.g., "CommandDataSetType"
+	// Human-readable name of the tag, e.g., "CommandDataSetType". Names of
+	// retired tags defined by the current standard start with "RETIRED_".
 	Name string
 	// Cardinality (# of values expected in the element)
 	VM string
+	// Keyword is the DICOM keyword of the tag, i.e. Name without the
+	// "RETIRED_" prefix.
+	Keyword string
+	// Retired indicates that the tag has been retired from the standard.
+	Retired bool
+	// VRs lists every VR allowed for the tag, e.g. ["US", "SS"] for tags
+	// defined as "US or SS". It starts with VR.
+	VRs []string
+	// Multiplicity is VM, parsed.
+	Multiplicity Multiplicity
 }
 
 // MetadataGroup is the value of Tag.Group for metadata tags.
@@ -128,7 +140,7 @@ func GetVRKind(tag Tag, vr string) VRKind {
 		return VRDate
 	case "AT":
 		return VRTagList
-	case "OW", "OB":
+	case "OW", "OB", "OV":
 		return VRBytes
 	case "LT", "UT":
 		return VRString
@@ -152,14 +164,20 @@ func GetVRKind(tag Tag, vr string) VRKind {
 }
 
 // Find finds information about the given tag. If the tag is not part of
-// the DICOM standard, or is retired from the standard, it returns an error.
+// the DICOM standard (or was not added with Register or LoadDictionary), it
+// returns an error.
 func Find(tag Tag) (Info, error) {
+	tagDictMu.RLock()
 	maybeInitTagDict()
 	entry, ok := tagDict[tag]
+	if !ok {
+		entry, ok = findRepeating(tag)
+	}
+	tagDictMu.RUnlock()
 	if !ok {
 		// (0000-u-ffff,0000)	UL	GenericGroupLength	1	GENERIC
 		if tag.Group%2 == 0 && tag.Element == 0x0000 {
-			entry = Info{tag, "UL", "GenericGroupLength", "1"}
+			entry = newInfo(tag, "UL", "GenericGroupLength", "1", false)
 		} else {
 			return Info{}, fmt.Errorf("Could not find tag (0x%x, 0x%x) in dictionary", tag.Group, tag.Element)
 		}
@@ -176,18 +194,27 @@ func MustFind(tag Tag) Info {
 	return e
 }
 
-// FindByName finds information about the tag with the given name. If the tag is
-// not part of the DICOM standard, or is retired from the standard, it returns
-// an error.
+// FindByName finds information about the tag with the given name or keyword
+// (e.g. "GeneralPurposeScheduledProcedureStepStatus" for the retired
+// "RETIRED_GeneralPurposeScheduledProcedureStepStatus"). If the tag is not part
+// of the DICOM standard (or was not added with Register or LoadDictionary), it
+// returns an error.
 //
 //   Example: FindTagByName("TransferSyntaxUID")
 func FindByName(name string) (Info, error) {
+	tagDictMu.RLock()
+	defer tagDictMu.RUnlock()
 	maybeInitTagDict()
 	for _, ent := range tagDict {
-		if ent.Name == name {
+		if ent.Name == name || ent.Keyword == name {
 			return ent, nil
 		}
 	}
+	for _, ent := range repeatingDict {
+		if ent.info.Name == name || ent.info.Keyword == name {
+			return ent.info, nil
+		}
+	}
 	return Info{}, fmt.Errorf("Could not find tag with name %s", name)
 }
 
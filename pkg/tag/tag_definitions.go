@@ -3199,6 +3199,22 @@ var WaveformPaddingValue = Tag{0x5400, 0x100A}
 var WaveformData = Tag{0x5400, 0x1010}
 var FirstOrderPhaseCorrectionAngle = Tag{0x5600, 0x0010}
 var SpectroscopyData = Tag{0x5600, 0x0020}
+var OverlayRows = Tag{0x6000, 0x0010}
+var OverlayColumns = Tag{0x6000, 0x0011}
+var NumberOfFramesInOverlay = Tag{0x6000, 0x0015}
+var OverlayDescription = Tag{0x6000, 0x0022}
+var OverlayType = Tag{0x6000, 0x0040}
+var OverlaySubtype = Tag{0x6000, 0x0045}
+var OverlayOrigin = Tag{0x6000, 0x0050}
+var ImageFrameOrigin = Tag{0x6000, 0x0051}
+var OverlayBitsAllocated = Tag{0x6000, 0x0100}
+var OverlayBitPosition = Tag{0x6000, 0x0102}
+var OverlayActivationLayer = Tag{0x6000, 0x1001}
+var ROIArea = Tag{0x6000, 0x1301}
+var ROIMean = Tag{0x6000, 0x1302}
+var ROIStandardDeviation = Tag{0x6000, 0x1303}
+var OverlayLabel = Tag{0x6000, 0x1500}
+var OverlayData = Tag{0x6000, 0x3000}
 var PixelData = Tag{0x7FE0, 0x0010}
 var DigitalSignaturesSequence = Tag{0xFFFA, 0xFFFA}
 var DataSetTrailingPadding = Tag{0xFFFC, 0xFFFC}
@@ -3281,6 +3297,7 @@ var ACR_NEMA_AcquisitionsInSeries = Tag{0x0020, 0x1001}
 var ACR_NEMA_ImagesInSeries = Tag{0x0020, 0x1003}
 var ACR_NEMA_ImagesInStudy = Tag{0x0020, 0x1005}
 var ACR_NEMA_Reference = Tag{0x0020, 0x1020}
+var ACR_NEMA_SourceImageID = Tag{0x0020, 0x3100}
 var ACR_NEMA_ModifyingDeviceID = Tag{0x0020, 0x3401}
 var ACR_NEMA_ModifiedImageID = Tag{0x0020, 0x3402}
 var ACR_NEMA_ModifiedImageDate = Tag{0x0020, 0x3403}
@@ -3303,6 +3320,9 @@ var ACR_NEMA_ImagePresentationComments = Tag{0x0028, 0x4000}
 var ACR_NEMA_TextGroupLength = Tag{0x4000, 0x0000}
 var ACR_NEMA_TextArbitrary = Tag{0x4000, 0x0010}
 var ACR_NEMA_TextComments = Tag{0x4000, 0x4000}
+var ACR_NEMA_OverlayFormat = Tag{0x6000, 0x0110}
+var ACR_NEMA_OverlayLocation = Tag{0x6000, 0x0200}
+var ACR_NEMA_OverlayComments = Tag{0x6000, 0x4000}
 var ACR_NEMA_2C_CompressionRecognitionCode = Tag{0x0028, 0x005F}
 var ACR_NEMA_2C_CompressionOriginator = Tag{0x0028, 0x0061}
 var ACR_NEMA_2C_CompressionLabel = Tag{0x0028, 0x0062}
@@ -3353,6 +3373,23 @@ var ACR_NEMA_2C_ShiftTableSize = Tag{0x1000, 0x0014}
 var ACR_NEMA_2C_ShiftTableTriplet = Tag{0x1000, 0x0015}
 var ACR_NEMA_2C_ZonalMapGroupLength = Tag{0x1010, 0x0000}
 var ACR_NEMA_2C_ZonalMap = Tag{0x1010, 0x0004}
+var ACR_NEMA_2C_OverlayCompressionCode = Tag{0x6000, 0x0060}
+var ACR_NEMA_2C_OverlayCompressionOriginator = Tag{0x6000, 0x0061}
+var ACR_NEMA_2C_OverlayCompressionLabel = Tag{0x6000, 0x0062}
+var ACR_NEMA_2C_OverlayCompressionDescription = Tag{0x6000, 0x0063}
+var ACR_NEMA_2C_OverlayCompressionStepPointers = Tag{0x6000, 0x0066}
+var ACR_NEMA_2C_OverlayRepeatInterval = Tag{0x6000, 0x0068}
+var ACR_NEMA_2C_OverlayBitsGrouped = Tag{0x6000, 0x0069}
+var ACR_NEMA_2C_OverlayCodeLabel = Tag{0x6000, 0x0800}
+var ACR_NEMA_2C_OverlayNumberOfTables = Tag{0x6000, 0x0802}
+var ACR_NEMA_2C_OverlayCodeTableLocation = Tag{0x6000, 0x0803}
+var ACR_NEMA_2C_OverlayBitsForCodeWord = Tag{0x6000, 0x0804}
+var ACR_NEMA_2C_VariablePixelDataGroupLength = Tag{0x7F00, 0x0000}
+var ACR_NEMA_2C_VariablePixelData = Tag{0x7F00, 0x0010}
+var ACR_NEMA_2C_VariableNextDataGroup = Tag{0x7F00, 0x0011}
+var ACR_NEMA_2C_VariableCoefficientsSDVN = Tag{0x7F00, 0x0020}
+var ACR_NEMA_2C_VariableCoefficientsSDHN = Tag{0x7F00, 0x0030}
+var ACR_NEMA_2C_VariableCoefficientsSDDN = Tag{0x7F00, 0x0040}
 var ACR_NEMA_2C_CoefficientsSDVN = Tag{0x7FE0, 0x0020}
 var ACR_NEMA_2C_CoefficientsSDHN = Tag{0x7FE0, 0x0030}
 var ACR_NEMA_2C_CoefficientsSDDN = Tag{0x7FE0, 0x0040}
@@ -3366,3734 +3403,3843 @@ func maybeInitTagDict() {
 		return
 	}
 	tagDict = make(map[Tag]Info)
-	tagDict[Tag{0x0000, 0x0000}] = Info{Tag{0x0000, 0x0000}, "UL", "CommandGroupLength", "1"}
-	tagDict[Tag{0x0000, 0x0002}] = Info{Tag{0x0000, 0x0002}, "UI", "AffectedSOPClassUID", "1"}
-	tagDict[Tag{0x0000, 0x0003}] = Info{Tag{0x0000, 0x0003}, "UI", "RequestedSOPClassUID", "1"}
-	tagDict[Tag{0x0000, 0x0100}] = Info{Tag{0x0000, 0x0100}, "US", "CommandField", "1"}
-	tagDict[Tag{0x0000, 0x0110}] = Info{Tag{0x0000, 0x0110}, "US", "MessageID", "1"}
-	tagDict[Tag{0x0000, 0x0120}] = Info{Tag{0x0000, 0x0120}, "US", "MessageIDBeingRespondedTo", "1"}
-	tagDict[Tag{0x0000, 0x0600}] = Info{Tag{0x0000, 0x0600}, "AE", "MoveDestination", "1"}
-	tagDict[Tag{0x0000, 0x0700}] = Info{Tag{0x0000, 0x0700}, "US", "Priority", "1"}
-	tagDict[Tag{0x0000, 0x0800}] = Info{Tag{0x0000, 0x0800}, "US", "CommandDataSetType", "1"}
-	tagDict[Tag{0x0000, 0x0900}] = Info{Tag{0x0000, 0x0900}, "US", "Status", "1"}
-	tagDict[Tag{0x0000, 0x0901}] = Info{Tag{0x0000, 0x0901}, "AT", "OffendingElement", "1-n"}
-	tagDict[Tag{0x0000, 0x0902}] = Info{Tag{0x0000, 0x0902}, "LO", "ErrorComment", "1"}
-	tagDict[Tag{0x0000, 0x0903}] = Info{Tag{0x0000, 0x0903}, "US", "ErrorID", "1"}
-	tagDict[Tag{0x0000, 0x1000}] = Info{Tag{0x0000, 0x1000}, "UI", "AffectedSOPInstanceUID", "1"}
-	tagDict[Tag{0x0000, 0x1001}] = Info{Tag{0x0000, 0x1001}, "UI", "RequestedSOPInstanceUID", "1"}
-	tagDict[Tag{0x0000, 0x1002}] = Info{Tag{0x0000, 0x1002}, "US", "EventTypeID", "1"}
-	tagDict[Tag{0x0000, 0x1005}] = Info{Tag{0x0000, 0x1005}, "AT", "AttributeIdentifierList", "1-n"}
-	tagDict[Tag{0x0000, 0x1008}] = Info{Tag{0x0000, 0x1008}, "US", "ActionTypeID", "1"}
-	tagDict[Tag{0x0000, 0x1020}] = Info{Tag{0x0000, 0x1020}, "US", "NumberOfRemainingSuboperations", "1"}
-	tagDict[Tag{0x0000, 0x1021}] = Info{Tag{0x0000, 0x1021}, "US", "NumberOfCompletedSuboperations", "1"}
-	tagDict[Tag{0x0000, 0x1022}] = Info{Tag{0x0000, 0x1022}, "US", "NumberOfFailedSuboperations", "1"}
-	tagDict[Tag{0x0000, 0x1023}] = Info{Tag{0x0000, 0x1023}, "US", "NumberOfWarningSuboperations", "1"}
-	tagDict[Tag{0x0000, 0x1030}] = Info{Tag{0x0000, 0x1030}, "AE", "MoveOriginatorApplicationEntityTitle", "1"}
-	tagDict[Tag{0x0000, 0x1031}] = Info{Tag{0x0000, 0x1031}, "US", "MoveOriginatorMessageID", "1"}
-	tagDict[Tag{0x0002, 0x0000}] = Info{Tag{0x0002, 0x0000}, "UL", "FileMetaInformationGroupLength", "1"}
-	tagDict[Tag{0x0002, 0x0001}] = Info{Tag{0x0002, 0x0001}, "OB", "FileMetaInformationVersion", "1"}
-	tagDict[Tag{0x0002, 0x0002}] = Info{Tag{0x0002, 0x0002}, "UI", "MediaStorageSOPClassUID", "1"}
-	tagDict[Tag{0x0002, 0x0003}] = Info{Tag{0x0002, 0x0003}, "UI", "MediaStorageSOPInstanceUID", "1"}
-	tagDict[Tag{0x0002, 0x0010}] = Info{Tag{0x0002, 0x0010}, "UI", "TransferSyntaxUID", "1"}
-	tagDict[Tag{0x0002, 0x0012}] = Info{Tag{0x0002, 0x0012}, "UI", "ImplementationClassUID", "1"}
-	tagDict[Tag{0x0002, 0x0013}] = Info{Tag{0x0002, 0x0013}, "SH", "ImplementationVersionName", "1"}
-	tagDict[Tag{0x0002, 0x0016}] = Info{Tag{0x0002, 0x0016}, "AE", "SourceApplicationEntityTitle", "1"}
-	tagDict[Tag{0x0002, 0x0100}] = Info{Tag{0x0002, 0x0100}, "UI", "PrivateInformationCreatorUID", "1"}
-	tagDict[Tag{0x0002, 0x0102}] = Info{Tag{0x0002, 0x0102}, "OB", "PrivateInformation", "1"}
-	tagDict[Tag{0x0004, 0x1130}] = Info{Tag{0x0004, 0x1130}, "CS", "FileSetID", "1"}
-	tagDict[Tag{0x0004, 0x1141}] = Info{Tag{0x0004, 0x1141}, "CS", "FileSetDescriptorFileID", "1-8"}
-	tagDict[Tag{0x0004, 0x1142}] = Info{Tag{0x0004, 0x1142}, "CS", "SpecificCharacterSetOfFileSetDescriptorFile", "1"}
-	tagDict[Tag{0x0004, 0x1200}] = Info{Tag{0x0004, 0x1200}, "UP", "OffsetOfTheFirstDirectoryRecordOfTheRootDirectoryEntity", "1"}
-	tagDict[Tag{0x0004, 0x1202}] = Info{Tag{0x0004, 0x1202}, "UP", "OffsetOfTheLastDirectoryRecordOfTheRootDirectoryEntity", "1"}
-	tagDict[Tag{0x0004, 0x1212}] = Info{Tag{0x0004, 0x1212}, "US", "FileSetConsistencyFlag", "1"}
-	tagDict[Tag{0x0004, 0x1220}] = Info{Tag{0x0004, 0x1220}, "SQ", "DirectoryRecordSequence", "1"}
-	tagDict[Tag{0x0004, 0x1400}] = Info{Tag{0x0004, 0x1400}, "UP", "OffsetOfTheNextDirectoryRecord", "1"}
-	tagDict[Tag{0x0004, 0x1410}] = Info{Tag{0x0004, 0x1410}, "US", "RecordInUseFlag", "1"}
-	tagDict[Tag{0x0004, 0x1420}] = Info{Tag{0x0004, 0x1420}, "UP", "OffsetOfReferencedLowerLevelDirectoryEntity", "1"}
-	tagDict[Tag{0x0004, 0x1430}] = Info{Tag{0x0004, 0x1430}, "CS", "DirectoryRecordType", "1"}
-	tagDict[Tag{0x0004, 0x1432}] = Info{Tag{0x0004, 0x1432}, "UI", "PrivateRecordUID", "1"}
-	tagDict[Tag{0x0004, 0x1500}] = Info{Tag{0x0004, 0x1500}, "CS", "ReferencedFileID", "1-8"}
-	tagDict[Tag{0x0004, 0x1510}] = Info{Tag{0x0004, 0x1510}, "UI", "ReferencedSOPClassUIDInFile", "1"}
-	tagDict[Tag{0x0004, 0x1511}] = Info{Tag{0x0004, 0x1511}, "UI", "ReferencedSOPInstanceUIDInFile", "1"}
-	tagDict[Tag{0x0004, 0x1512}] = Info{Tag{0x0004, 0x1512}, "UI", "ReferencedTransferSyntaxUIDInFile", "1"}
-	tagDict[Tag{0x0004, 0x151A}] = Info{Tag{0x0004, 0x151A}, "UI", "ReferencedRelatedGeneralSOPClassUIDInFile", "1-n"}
-	tagDict[Tag{0x0008, 0x0005}] = Info{Tag{0x0008, 0x0005}, "CS", "SpecificCharacterSet", "1-n"}
-	tagDict[Tag{0x0008, 0x0006}] = Info{Tag{0x0008, 0x0006}, "SQ", "LanguageCodeSequence", "1"}
-	tagDict[Tag{0x0008, 0x0008}] = Info{Tag{0x0008, 0x0008}, "CS", "ImageType", "2-n"}
-	tagDict[Tag{0x0008, 0x0012}] = Info{Tag{0x0008, 0x0012}, "DA", "InstanceCreationDate", "1"}
-	tagDict[Tag{0x0008, 0x0013}] = Info{Tag{0x0008, 0x0013}, "TM", "InstanceCreationTime", "1"}
-	tagDict[Tag{0x0008, 0x0014}] = Info{Tag{0x0008, 0x0014}, "UI", "InstanceCreatorUID", "1"}
-	tagDict[Tag{0x0008, 0x0016}] = Info{Tag{0x0008, 0x0016}, "UI", "SOPClassUID", "1"}
-	tagDict[Tag{0x0008, 0x0018}] = Info{Tag{0x0008, 0x0018}, "UI", "SOPInstanceUID", "1"}
-	tagDict[Tag{0x0008, 0x001A}] = Info{Tag{0x0008, 0x001A}, "UI", "RelatedGeneralSOPClassUID", "1-n"}
-	tagDict[Tag{0x0008, 0x001B}] = Info{Tag{0x0008, 0x001B}, "UI", "OriginalSpecializedSOPClassUID", "1"}
-	tagDict[Tag{0x0008, 0x0020}] = Info{Tag{0x0008, 0x0020}, "DA", "StudyDate", "1"}
-	tagDict[Tag{0x0008, 0x0021}] = Info{Tag{0x0008, 0x0021}, "DA", "SeriesDate", "1"}
-	tagDict[Tag{0x0008, 0x0022}] = Info{Tag{0x0008, 0x0022}, "DA", "AcquisitionDate", "1"}
-	tagDict[Tag{0x0008, 0x0023}] = Info{Tag{0x0008, 0x0023}, "DA", "ContentDate", "1"}
-	tagDict[Tag{0x0008, 0x002A}] = Info{Tag{0x0008, 0x002A}, "DT", "AcquisitionDateTime", "1"}
-	tagDict[Tag{0x0008, 0x0030}] = Info{Tag{0x0008, 0x0030}, "TM", "StudyTime", "1"}
-	tagDict[Tag{0x0008, 0x0031}] = Info{Tag{0x0008, 0x0031}, "TM", "SeriesTime", "1"}
-	tagDict[Tag{0x0008, 0x0032}] = Info{Tag{0x0008, 0x0032}, "TM", "AcquisitionTime", "1"}
-	tagDict[Tag{0x0008, 0x0033}] = Info{Tag{0x0008, 0x0033}, "TM", "ContentTime", "1"}
-	tagDict[Tag{0x0008, 0x0050}] = Info{Tag{0x0008, 0x0050}, "SH", "AccessionNumber", "1"}
-	tagDict[Tag{0x0008, 0x0051}] = Info{Tag{0x0008, 0x0051}, "SQ", "IssuerOfAccessionNumberSequence", "1"}
-	tagDict[Tag{0x0008, 0x0052}] = Info{Tag{0x0008, 0x0052}, "CS", "QueryRetrieveLevel", "1"}
-	tagDict[Tag{0x0008, 0x0054}] = Info{Tag{0x0008, 0x0054}, "AE", "RetrieveAETitle", "1-n"}
-	tagDict[Tag{0x0008, 0x0056}] = Info{Tag{0x0008, 0x0056}, "CS", "InstanceAvailability", "1"}
-	tagDict[Tag{0x0008, 0x0058}] = Info{Tag{0x0008, 0x0058}, "UI", "FailedSOPInstanceUIDList", "1-n"}
-	tagDict[Tag{0x0008, 0x0060}] = Info{Tag{0x0008, 0x0060}, "CS", "Modality", "1"}
-	tagDict[Tag{0x0008, 0x0061}] = Info{Tag{0x0008, 0x0061}, "CS", "ModalitiesInStudy", "1-n"}
-	tagDict[Tag{0x0008, 0x0062}] = Info{Tag{0x0008, 0x0062}, "UI", "SOPClassesInStudy", "1-n"}
-	tagDict[Tag{0x0008, 0x0064}] = Info{Tag{0x0008, 0x0064}, "CS", "ConversionType", "1"}
-	tagDict[Tag{0x0008, 0x0068}] = Info{Tag{0x0008, 0x0068}, "CS", "PresentationIntentType", "1"}
-	tagDict[Tag{0x0008, 0x0070}] = Info{Tag{0x0008, 0x0070}, "LO", "Manufacturer", "1"}
-	tagDict[Tag{0x0008, 0x0080}] = Info{Tag{0x0008, 0x0080}, "LO", "InstitutionName", "1"}
-	tagDict[Tag{0x0008, 0x0081}] = Info{Tag{0x0008, 0x0081}, "ST", "InstitutionAddress", "1"}
-	tagDict[Tag{0x0008, 0x0082}] = Info{Tag{0x0008, 0x0082}, "SQ", "InstitutionCodeSequence", "1"}
-	tagDict[Tag{0x0008, 0x0090}] = Info{Tag{0x0008, 0x0090}, "PN", "ReferringPhysicianName", "1"}
-	tagDict[Tag{0x0008, 0x0092}] = Info{Tag{0x0008, 0x0092}, "ST", "ReferringPhysicianAddress", "1"}
-	tagDict[Tag{0x0008, 0x0094}] = Info{Tag{0x0008, 0x0094}, "SH", "ReferringPhysicianTelephoneNumbers", "1-n"}
-	tagDict[Tag{0x0008, 0x0096}] = Info{Tag{0x0008, 0x0096}, "SQ", "ReferringPhysicianIdentificationSequence", "1"}
-	tagDict[Tag{0x0008, 0x0100}] = Info{Tag{0x0008, 0x0100}, "SH", "CodeValue", "1"}
-	tagDict[Tag{0x0008, 0x0102}] = Info{Tag{0x0008, 0x0102}, "SH", "CodingSchemeDesignator", "1"}
-	tagDict[Tag{0x0008, 0x0103}] = Info{Tag{0x0008, 0x0103}, "SH", "CodingSchemeVersion", "1"}
-	tagDict[Tag{0x0008, 0x0104}] = Info{Tag{0x0008, 0x0104}, "LO", "CodeMeaning", "1"}
-	tagDict[Tag{0x0008, 0x0105}] = Info{Tag{0x0008, 0x0105}, "CS", "MappingResource", "1"}
-	tagDict[Tag{0x0008, 0x0106}] = Info{Tag{0x0008, 0x0106}, "DT", "ContextGroupVersion", "1"}
-	tagDict[Tag{0x0008, 0x0107}] = Info{Tag{0x0008, 0x0107}, "DT", "ContextGroupLocalVersion", "1"}
-	tagDict[Tag{0x0008, 0x010B}] = Info{Tag{0x0008, 0x010B}, "CS", "ContextGroupExtensionFlag", "1"}
-	tagDict[Tag{0x0008, 0x010C}] = Info{Tag{0x0008, 0x010C}, "UI", "CodingSchemeUID", "1"}
-	tagDict[Tag{0x0008, 0x010D}] = Info{Tag{0x0008, 0x010D}, "UI", "ContextGroupExtensionCreatorUID", "1"}
-	tagDict[Tag{0x0008, 0x010F}] = Info{Tag{0x0008, 0x010F}, "CS", "ContextIdentifier", "1"}
-	tagDict[Tag{0x0008, 0x0110}] = Info{Tag{0x0008, 0x0110}, "SQ", "CodingSchemeIdentificationSequence", "1"}
-	tagDict[Tag{0x0008, 0x0112}] = Info{Tag{0x0008, 0x0112}, "LO", "CodingSchemeRegistry", "1"}
-	tagDict[Tag{0x0008, 0x0114}] = Info{Tag{0x0008, 0x0114}, "ST", "CodingSchemeExternalID", "1"}
-	tagDict[Tag{0x0008, 0x0115}] = Info{Tag{0x0008, 0x0115}, "ST", "CodingSchemeName", "1"}
-	tagDict[Tag{0x0008, 0x0116}] = Info{Tag{0x0008, 0x0116}, "ST", "CodingSchemeResponsibleOrganization", "1"}
-	tagDict[Tag{0x0008, 0x0117}] = Info{Tag{0x0008, 0x0117}, "UI", "ContextUID", "1"}
-	tagDict[Tag{0x0008, 0x0201}] = Info{Tag{0x0008, 0x0201}, "SH", "TimezoneOffsetFromUTC", "1"}
-	tagDict[Tag{0x0008, 0x1010}] = Info{Tag{0x0008, 0x1010}, "SH", "StationName", "1"}
-	tagDict[Tag{0x0008, 0x1030}] = Info{Tag{0x0008, 0x1030}, "LO", "StudyDescription", "1"}
-	tagDict[Tag{0x0008, 0x1032}] = Info{Tag{0x0008, 0x1032}, "SQ", "ProcedureCodeSequence", "1"}
-	tagDict[Tag{0x0008, 0x103E}] = Info{Tag{0x0008, 0x103E}, "LO", "SeriesDescription", "1"}
-	tagDict[Tag{0x0008, 0x103F}] = Info{Tag{0x0008, 0x103F}, "SQ", "SeriesDescriptionCodeSequence", "1"}
-	tagDict[Tag{0x0008, 0x1040}] = Info{Tag{0x0008, 0x1040}, "LO", "InstitutionalDepartmentName", "1"}
-	tagDict[Tag{0x0008, 0x1048}] = Info{Tag{0x0008, 0x1048}, "PN", "PhysiciansOfRecord", "1-n"}
-	tagDict[Tag{0x0008, 0x1049}] = Info{Tag{0x0008, 0x1049}, "SQ", "PhysiciansOfRecordIdentificationSequence", "1"}
-	tagDict[Tag{0x0008, 0x1050}] = Info{Tag{0x0008, 0x1050}, "PN", "PerformingPhysicianName", "1-n"}
-	tagDict[Tag{0x0008, 0x1052}] = Info{Tag{0x0008, 0x1052}, "SQ", "PerformingPhysicianIdentificationSequence", "1"}
-	tagDict[Tag{0x0008, 0x1060}] = Info{Tag{0x0008, 0x1060}, "PN", "NameOfPhysiciansReadingStudy", "1-n"}
-	tagDict[Tag{0x0008, 0x1062}] = Info{Tag{0x0008, 0x1062}, "SQ", "PhysiciansReadingStudyIdentificationSequence", "1"}
-	tagDict[Tag{0x0008, 0x1070}] = Info{Tag{0x0008, 0x1070}, "PN", "OperatorsName", "1-n"}
-	tagDict[Tag{0x0008, 0x1072}] = Info{Tag{0x0008, 0x1072}, "SQ", "OperatorIdentificationSequence", "1"}
-	tagDict[Tag{0x0008, 0x1080}] = Info{Tag{0x0008, 0x1080}, "LO", "AdmittingDiagnosesDescription", "1-n"}
-	tagDict[Tag{0x0008, 0x1084}] = Info{Tag{0x0008, 0x1084}, "SQ", "AdmittingDiagnosesCodeSequence", "1"}
-	tagDict[Tag{0x0008, 0x1090}] = Info{Tag{0x0008, 0x1090}, "LO", "ManufacturerModelName", "1"}
-	tagDict[Tag{0x0008, 0x1110}] = Info{Tag{0x0008, 0x1110}, "SQ", "ReferencedStudySequence", "1"}
-	tagDict[Tag{0x0008, 0x1111}] = Info{Tag{0x0008, 0x1111}, "SQ", "ReferencedPerformedProcedureStepSequence", "1"}
-	tagDict[Tag{0x0008, 0x1115}] = Info{Tag{0x0008, 0x1115}, "SQ", "ReferencedSeriesSequence", "1"}
-	tagDict[Tag{0x0008, 0x1120}] = Info{Tag{0x0008, 0x1120}, "SQ", "ReferencedPatientSequence", "1"}
-	tagDict[Tag{0x0008, 0x1125}] = Info{Tag{0x0008, 0x1125}, "SQ", "ReferencedVisitSequence", "1"}
-	tagDict[Tag{0x0008, 0x1134}] = Info{Tag{0x0008, 0x1134}, "SQ", "ReferencedStereometricInstanceSequence", "1"}
-	tagDict[Tag{0x0008, 0x113A}] = Info{Tag{0x0008, 0x113A}, "SQ", "ReferencedWaveformSequence", "1"}
-	tagDict[Tag{0x0008, 0x1140}] = Info{Tag{0x0008, 0x1140}, "SQ", "ReferencedImageSequence", "1"}
-	tagDict[Tag{0x0008, 0x114A}] = Info{Tag{0x0008, 0x114A}, "SQ", "ReferencedInstanceSequence", "1"}
-	tagDict[Tag{0x0008, 0x114B}] = Info{Tag{0x0008, 0x114B}, "SQ", "ReferencedRealWorldValueMappingInstanceSequence", "1"}
-	tagDict[Tag{0x0008, 0x1150}] = Info{Tag{0x0008, 0x1150}, "UI", "ReferencedSOPClassUID", "1"}
-	tagDict[Tag{0x0008, 0x1155}] = Info{Tag{0x0008, 0x1155}, "UI", "ReferencedSOPInstanceUID", "1"}
-	tagDict[Tag{0x0008, 0x115A}] = Info{Tag{0x0008, 0x115A}, "UI", "SOPClassesSupported", "1-n"}
-	tagDict[Tag{0x0008, 0x1160}] = Info{Tag{0x0008, 0x1160}, "IS", "ReferencedFrameNumber", "1-n"}
-	tagDict[Tag{0x0008, 0x1161}] = Info{Tag{0x0008, 0x1161}, "UL", "SimpleFrameList", "1-n"}
-	tagDict[Tag{0x0008, 0x1162}] = Info{Tag{0x0008, 0x1162}, "UL", "CalculatedFrameList", "3-3n"}
-	tagDict[Tag{0x0008, 0x1163}] = Info{Tag{0x0008, 0x1163}, "FD", "TimeRange", "2"}
-	tagDict[Tag{0x0008, 0x1164}] = Info{Tag{0x0008, 0x1164}, "SQ", "FrameExtractionSequence", "1"}
-	tagDict[Tag{0x0008, 0x1167}] = Info{Tag{0x0008, 0x1167}, "UI", "MultiFrameSourceSOPInstanceUID", "1"}
-	tagDict[Tag{0x0008, 0x1195}] = Info{Tag{0x0008, 0x1195}, "UI", "TransactionUID", "1"}
-	tagDict[Tag{0x0008, 0x1197}] = Info{Tag{0x0008, 0x1197}, "US", "FailureReason", "1"}
-	tagDict[Tag{0x0008, 0x1198}] = Info{Tag{0x0008, 0x1198}, "SQ", "FailedSOPSequence", "1"}
-	tagDict[Tag{0x0008, 0x1199}] = Info{Tag{0x0008, 0x1199}, "SQ", "ReferencedSOPSequence", "1"}
-	tagDict[Tag{0x0008, 0x1200}] = Info{Tag{0x0008, 0x1200}, "SQ", "StudiesContainingOtherReferencedInstancesSequence", "1"}
-	tagDict[Tag{0x0008, 0x1250}] = Info{Tag{0x0008, 0x1250}, "SQ", "RelatedSeriesSequence", "1"}
-	tagDict[Tag{0x0008, 0x2111}] = Info{Tag{0x0008, 0x2111}, "ST", "DerivationDescription", "1"}
-	tagDict[Tag{0x0008, 0x2112}] = Info{Tag{0x0008, 0x2112}, "SQ", "SourceImageSequence", "1"}
-	tagDict[Tag{0x0008, 0x2120}] = Info{Tag{0x0008, 0x2120}, "SH", "StageName", "1"}
-	tagDict[Tag{0x0008, 0x2122}] = Info{Tag{0x0008, 0x2122}, "IS", "StageNumber", "1"}
-	tagDict[Tag{0x0008, 0x2124}] = Info{Tag{0x0008, 0x2124}, "IS", "NumberOfStages", "1"}
-	tagDict[Tag{0x0008, 0x2127}] = Info{Tag{0x0008, 0x2127}, "SH", "ViewName", "1"}
-	tagDict[Tag{0x0008, 0x2128}] = Info{Tag{0x0008, 0x2128}, "IS", "ViewNumber", "1"}
-	tagDict[Tag{0x0008, 0x2129}] = Info{Tag{0x0008, 0x2129}, "IS", "NumberOfEventTimers", "1"}
-	tagDict[Tag{0x0008, 0x212A}] = Info{Tag{0x0008, 0x212A}, "IS", "NumberOfViewsInStage", "1"}
-	tagDict[Tag{0x0008, 0x2130}] = Info{Tag{0x0008, 0x2130}, "DS", "EventElapsedTimes", "1-n"}
-	tagDict[Tag{0x0008, 0x2132}] = Info{Tag{0x0008, 0x2132}, "LO", "EventTimerNames", "1-n"}
-	tagDict[Tag{0x0008, 0x2133}] = Info{Tag{0x0008, 0x2133}, "SQ", "EventTimerSequence", "1"}
-	tagDict[Tag{0x0008, 0x2134}] = Info{Tag{0x0008, 0x2134}, "FD", "EventTimeOffset", "1"}
-	tagDict[Tag{0x0008, 0x2135}] = Info{Tag{0x0008, 0x2135}, "SQ", "EventCodeSequence", "1"}
-	tagDict[Tag{0x0008, 0x2142}] = Info{Tag{0x0008, 0x2142}, "IS", "StartTrim", "1"}
-	tagDict[Tag{0x0008, 0x2143}] = Info{Tag{0x0008, 0x2143}, "IS", "StopTrim", "1"}
-	tagDict[Tag{0x0008, 0x2144}] = Info{Tag{0x0008, 0x2144}, "IS", "RecommendedDisplayFrameRate", "1"}
-	tagDict[Tag{0x0008, 0x2218}] = Info{Tag{0x0008, 0x2218}, "SQ", "AnatomicRegionSequence", "1"}
-	tagDict[Tag{0x0008, 0x2220}] = Info{Tag{0x0008, 0x2220}, "SQ", "AnatomicRegionModifierSequence", "1"}
-	tagDict[Tag{0x0008, 0x2228}] = Info{Tag{0x0008, 0x2228}, "SQ", "PrimaryAnatomicStructureSequence", "1"}
-	tagDict[Tag{0x0008, 0x2229}] = Info{Tag{0x0008, 0x2229}, "SQ", "AnatomicStructureSpaceOrRegionSequence", "1"}
-	tagDict[Tag{0x0008, 0x2230}] = Info{Tag{0x0008, 0x2230}, "SQ", "PrimaryAnatomicStructureModifierSequence", "1"}
-	tagDict[Tag{0x0008, 0x3001}] = Info{Tag{0x0008, 0x3001}, "SQ", "AlternateRepresentationSequence", "1"}
-	tagDict[Tag{0x0008, 0x3010}] = Info{Tag{0x0008, 0x3010}, "UI", "IrradiationEventUID", "1"}
-	tagDict[Tag{0x0008, 0x9007}] = Info{Tag{0x0008, 0x9007}, "CS", "FrameType", "4"}
-	tagDict[Tag{0x0008, 0x9092}] = Info{Tag{0x0008, 0x9092}, "SQ", "ReferencedImageEvidenceSequence", "1"}
-	tagDict[Tag{0x0008, 0x9121}] = Info{Tag{0x0008, 0x9121}, "SQ", "ReferencedRawDataSequence", "1"}
-	tagDict[Tag{0x0008, 0x9123}] = Info{Tag{0x0008, 0x9123}, "UI", "CreatorVersionUID", "1"}
-	tagDict[Tag{0x0008, 0x9124}] = Info{Tag{0x0008, 0x9124}, "SQ", "DerivationImageSequence", "1"}
-	tagDict[Tag{0x0008, 0x9154}] = Info{Tag{0x0008, 0x9154}, "SQ", "SourceImageEvidenceSequence", "1"}
-	tagDict[Tag{0x0008, 0x9205}] = Info{Tag{0x0008, 0x9205}, "CS", "PixelPresentation", "1"}
-	tagDict[Tag{0x0008, 0x9206}] = Info{Tag{0x0008, 0x9206}, "CS", "VolumetricProperties", "1"}
-	tagDict[Tag{0x0008, 0x9207}] = Info{Tag{0x0008, 0x9207}, "CS", "VolumeBasedCalculationTechnique", "1"}
-	tagDict[Tag{0x0008, 0x9208}] = Info{Tag{0x0008, 0x9208}, "CS", "ComplexImageComponent", "1"}
-	tagDict[Tag{0x0008, 0x9209}] = Info{Tag{0x0008, 0x9209}, "CS", "AcquisitionContrast", "1"}
-	tagDict[Tag{0x0008, 0x9215}] = Info{Tag{0x0008, 0x9215}, "SQ", "DerivationCodeSequence", "1"}
-	tagDict[Tag{0x0008, 0x9237}] = Info{Tag{0x0008, 0x9237}, "SQ", "ReferencedPresentationStateSequence", "1"}
-	tagDict[Tag{0x0008, 0x9410}] = Info{Tag{0x0008, 0x9410}, "SQ", "ReferencedOtherPlaneSequence", "1"}
-	tagDict[Tag{0x0008, 0x9458}] = Info{Tag{0x0008, 0x9458}, "SQ", "FrameDisplaySequence", "1"}
-	tagDict[Tag{0x0008, 0x9459}] = Info{Tag{0x0008, 0x9459}, "FL", "RecommendedDisplayFrameRateInFloat", "1"}
-	tagDict[Tag{0x0008, 0x9460}] = Info{Tag{0x0008, 0x9460}, "CS", "SkipFrameRangeFlag", "1"}
-	tagDict[Tag{0x0010, 0x0010}] = Info{Tag{0x0010, 0x0010}, "PN", "PatientName", "1"}
-	tagDict[Tag{0x0010, 0x0020}] = Info{Tag{0x0010, 0x0020}, "LO", "PatientID", "1"}
-	tagDict[Tag{0x0010, 0x0021}] = Info{Tag{0x0010, 0x0021}, "LO", "IssuerOfPatientID", "1"}
-	tagDict[Tag{0x0010, 0x0022}] = Info{Tag{0x0010, 0x0022}, "CS", "TypeOfPatientID", "1"}
-	tagDict[Tag{0x0010, 0x0024}] = Info{Tag{0x0010, 0x0024}, "SQ", "IssuerOfPatientIDQualifiersSequence", "1"}
-	tagDict[Tag{0x0010, 0x0030}] = Info{Tag{0x0010, 0x0030}, "DA", "PatientBirthDate", "1"}
-	tagDict[Tag{0x0010, 0x0032}] = Info{Tag{0x0010, 0x0032}, "TM", "PatientBirthTime", "1"}
-	tagDict[Tag{0x0010, 0x0040}] = Info{Tag{0x0010, 0x0040}, "CS", "PatientSex", "1"}
-	tagDict[Tag{0x0010, 0x0050}] = Info{Tag{0x0010, 0x0050}, "SQ", "PatientInsurancePlanCodeSequence", "1"}
-	tagDict[Tag{0x0010, 0x0101}] = Info{Tag{0x0010, 0x0101}, "SQ", "PatientPrimaryLanguageCodeSequence", "1"}
-	tagDict[Tag{0x0010, 0x0102}] = Info{Tag{0x0010, 0x0102}, "SQ", "PatientPrimaryLanguageModifierCodeSequence", "1"}
-	tagDict[Tag{0x0010, 0x1000}] = Info{Tag{0x0010, 0x1000}, "LO", "OtherPatientIDs", "1-n"}
-	tagDict[Tag{0x0010, 0x1001}] = Info{Tag{0x0010, 0x1001}, "PN", "OtherPatientNames", "1-n"}
-	tagDict[Tag{0x0010, 0x1002}] = Info{Tag{0x0010, 0x1002}, "SQ", "OtherPatientIDsSequence", "1"}
-	tagDict[Tag{0x0010, 0x1005}] = Info{Tag{0x0010, 0x1005}, "PN", "PatientBirthName", "1"}
-	tagDict[Tag{0x0010, 0x1010}] = Info{Tag{0x0010, 0x1010}, "AS", "PatientAge", "1"}
-	tagDict[Tag{0x0010, 0x1020}] = Info{Tag{0x0010, 0x1020}, "DS", "PatientSize", "1"}
-	tagDict[Tag{0x0010, 0x1021}] = Info{Tag{0x0010, 0x1021}, "SQ", "PatientSizeCodeSequence", "1"}
-	tagDict[Tag{0x0010, 0x1030}] = Info{Tag{0x0010, 0x1030}, "DS", "PatientWeight", "1"}
-	tagDict[Tag{0x0010, 0x1040}] = Info{Tag{0x0010, 0x1040}, "LO", "PatientAddress", "1"}
-	tagDict[Tag{0x0010, 0x1060}] = Info{Tag{0x0010, 0x1060}, "PN", "PatientMotherBirthName", "1"}
-	tagDict[Tag{0x0010, 0x1080}] = Info{Tag{0x0010, 0x1080}, "LO", "MilitaryRank", "1"}
-	tagDict[Tag{0x0010, 0x1081}] = Info{Tag{0x0010, 0x1081}, "LO", "BranchOfService", "1"}
-	tagDict[Tag{0x0010, 0x1090}] = Info{Tag{0x0010, 0x1090}, "LO", "MedicalRecordLocator", "1"}
-	tagDict[Tag{0x0010, 0x2000}] = Info{Tag{0x0010, 0x2000}, "LO", "MedicalAlerts", "1-n"}
-	tagDict[Tag{0x0010, 0x2110}] = Info{Tag{0x0010, 0x2110}, "LO", "Allergies", "1-n"}
-	tagDict[Tag{0x0010, 0x2150}] = Info{Tag{0x0010, 0x2150}, "LO", "CountryOfResidence", "1"}
-	tagDict[Tag{0x0010, 0x2152}] = Info{Tag{0x0010, 0x2152}, "LO", "RegionOfResidence", "1"}
-	tagDict[Tag{0x0010, 0x2154}] = Info{Tag{0x0010, 0x2154}, "SH", "PatientTelephoneNumbers", "1-n"}
-	tagDict[Tag{0x0010, 0x2160}] = Info{Tag{0x0010, 0x2160}, "SH", "EthnicGroup", "1"}
-	tagDict[Tag{0x0010, 0x2180}] = Info{Tag{0x0010, 0x2180}, "SH", "Occupation", "1"}
-	tagDict[Tag{0x0010, 0x21A0}] = Info{Tag{0x0010, 0x21A0}, "CS", "SmokingStatus", "1"}
-	tagDict[Tag{0x0010, 0x21B0}] = Info{Tag{0x0010, 0x21B0}, "LT", "AdditionalPatientHistory", "1"}
-	tagDict[Tag{0x0010, 0x21C0}] = Info{Tag{0x0010, 0x21C0}, "US", "PregnancyStatus", "1"}
-	tagDict[Tag{0x0010, 0x21D0}] = Info{Tag{0x0010, 0x21D0}, "DA", "LastMenstrualDate", "1"}
-	tagDict[Tag{0x0010, 0x21F0}] = Info{Tag{0x0010, 0x21F0}, "LO", "PatientReligiousPreference", "1"}
-	tagDict[Tag{0x0010, 0x2201}] = Info{Tag{0x0010, 0x2201}, "LO", "PatientSpeciesDescription", "1"}
-	tagDict[Tag{0x0010, 0x2202}] = Info{Tag{0x0010, 0x2202}, "SQ", "PatientSpeciesCodeSequence", "1"}
-	tagDict[Tag{0x0010, 0x2203}] = Info{Tag{0x0010, 0x2203}, "CS", "PatientSexNeutered", "1"}
-	tagDict[Tag{0x0010, 0x2210}] = Info{Tag{0x0010, 0x2210}, "CS", "AnatomicalOrientationType", "1"}
-	tagDict[Tag{0x0010, 0x2292}] = Info{Tag{0x0010, 0x2292}, "LO", "PatientBreedDescription", "1"}
-	tagDict[Tag{0x0010, 0x2293}] = Info{Tag{0x0010, 0x2293}, "SQ", "PatientBreedCodeSequence", "1"}
-	tagDict[Tag{0x0010, 0x2294}] = Info{Tag{0x0010, 0x2294}, "SQ", "BreedRegistrationSequence", "1"}
-	tagDict[Tag{0x0010, 0x2295}] = Info{Tag{0x0010, 0x2295}, "LO", "BreedRegistrationNumber", "1"}
-	tagDict[Tag{0x0010, 0x2296}] = Info{Tag{0x0010, 0x2296}, "SQ", "BreedRegistryCodeSequence", "1"}
-	tagDict[Tag{0x0010, 0x2297}] = Info{Tag{0x0010, 0x2297}, "PN", "ResponsiblePerson", "1"}
-	tagDict[Tag{0x0010, 0x2298}] = Info{Tag{0x0010, 0x2298}, "CS", "ResponsiblePersonRole", "1"}
-	tagDict[Tag{0x0010, 0x2299}] = Info{Tag{0x0010, 0x2299}, "LO", "ResponsibleOrganization", "1"}
-	tagDict[Tag{0x0010, 0x4000}] = Info{Tag{0x0010, 0x4000}, "LT", "PatientComments", "1"}
-	tagDict[Tag{0x0010, 0x9431}] = Info{Tag{0x0010, 0x9431}, "FL", "ExaminedBodyThickness", "1"}
-	tagDict[Tag{0x0012, 0x0010}] = Info{Tag{0x0012, 0x0010}, "LO", "ClinicalTrialSponsorName", "1"}
-	tagDict[Tag{0x0012, 0x0020}] = Info{Tag{0x0012, 0x0020}, "LO", "ClinicalTrialProtocolID", "1"}
-	tagDict[Tag{0x0012, 0x0021}] = Info{Tag{0x0012, 0x0021}, "LO", "ClinicalTrialProtocolName", "1"}
-	tagDict[Tag{0x0012, 0x0030}] = Info{Tag{0x0012, 0x0030}, "LO", "ClinicalTrialSiteID", "1"}
-	tagDict[Tag{0x0012, 0x0031}] = Info{Tag{0x0012, 0x0031}, "LO", "ClinicalTrialSiteName", "1"}
-	tagDict[Tag{0x0012, 0x0040}] = Info{Tag{0x0012, 0x0040}, "LO", "ClinicalTrialSubjectID", "1"}
-	tagDict[Tag{0x0012, 0x0042}] = Info{Tag{0x0012, 0x0042}, "LO", "ClinicalTrialSubjectReadingID", "1"}
-	tagDict[Tag{0x0012, 0x0050}] = Info{Tag{0x0012, 0x0050}, "LO", "ClinicalTrialTimePointID", "1"}
-	tagDict[Tag{0x0012, 0x0051}] = Info{Tag{0x0012, 0x0051}, "ST", "ClinicalTrialTimePointDescription", "1"}
-	tagDict[Tag{0x0012, 0x0060}] = Info{Tag{0x0012, 0x0060}, "LO", "ClinicalTrialCoordinatingCenterName", "1"}
-	tagDict[Tag{0x0012, 0x0062}] = Info{Tag{0x0012, 0x0062}, "CS", "PatientIdentityRemoved", "1"}
-	tagDict[Tag{0x0012, 0x0063}] = Info{Tag{0x0012, 0x0063}, "LO", "DeidentificationMethod", "1-n"}
-	tagDict[Tag{0x0012, 0x0064}] = Info{Tag{0x0012, 0x0064}, "SQ", "DeidentificationMethodCodeSequence", "1"}
-	tagDict[Tag{0x0012, 0x0071}] = Info{Tag{0x0012, 0x0071}, "LO", "ClinicalTrialSeriesID", "1"}
-	tagDict[Tag{0x0012, 0x0072}] = Info{Tag{0x0012, 0x0072}, "LO", "ClinicalTrialSeriesDescription", "1"}
-	tagDict[Tag{0x0012, 0x0081}] = Info{Tag{0x0012, 0x0081}, "LO", "ClinicalTrialProtocolEthicsCommitteeName", "1"}
-	tagDict[Tag{0x0012, 0x0082}] = Info{Tag{0x0012, 0x0082}, "LO", "ClinicalTrialProtocolEthicsCommitteeApprovalNumber", "1"}
-	tagDict[Tag{0x0012, 0x0083}] = Info{Tag{0x0012, 0x0083}, "SQ", "ConsentForClinicalTrialUseSequence", "1"}
-	tagDict[Tag{0x0012, 0x0084}] = Info{Tag{0x0012, 0x0084}, "CS", "DistributionType", "1"}
-	tagDict[Tag{0x0012, 0x0085}] = Info{Tag{0x0012, 0x0085}, "CS", "ConsentForDistributionFlag", "1"}
-	tagDict[Tag{0x0014, 0x0023}] = Info{Tag{0x0014, 0x0023}, "ST", "CADFileFormat", "1-n"}
-	tagDict[Tag{0x0014, 0x0024}] = Info{Tag{0x0014, 0x0024}, "ST", "ComponentReferenceSystem", "1-n"}
-	tagDict[Tag{0x0014, 0x0025}] = Info{Tag{0x0014, 0x0025}, "ST", "ComponentManufacturingProcedure", "1-n"}
-	tagDict[Tag{0x0014, 0x0028}] = Info{Tag{0x0014, 0x0028}, "ST", "ComponentManufacturer", "1-n"}
-	tagDict[Tag{0x0014, 0x0030}] = Info{Tag{0x0014, 0x0030}, "DS", "MaterialThickness", "1-n"}
-	tagDict[Tag{0x0014, 0x0032}] = Info{Tag{0x0014, 0x0032}, "DS", "MaterialPipeDiameter", "1-n"}
-	tagDict[Tag{0x0014, 0x0034}] = Info{Tag{0x0014, 0x0034}, "DS", "MaterialIsolationDiameter", "1-n"}
-	tagDict[Tag{0x0014, 0x0042}] = Info{Tag{0x0014, 0x0042}, "ST", "MaterialGrade", "1-n"}
-	tagDict[Tag{0x0014, 0x0044}] = Info{Tag{0x0014, 0x0044}, "ST", "MaterialPropertiesFileID", "1-n"}
-	tagDict[Tag{0x0014, 0x0045}] = Info{Tag{0x0014, 0x0045}, "ST", "MaterialPropertiesFileFormat", "1-n"}
-	tagDict[Tag{0x0014, 0x0046}] = Info{Tag{0x0014, 0x0046}, "LT", "MaterialNotes", "1"}
-	tagDict[Tag{0x0014, 0x0050}] = Info{Tag{0x0014, 0x0050}, "CS", "ComponentShape", "1"}
-	tagDict[Tag{0x0014, 0x0052}] = Info{Tag{0x0014, 0x0052}, "CS", "CurvatureType", "1"}
-	tagDict[Tag{0x0014, 0x0054}] = Info{Tag{0x0014, 0x0054}, "DS", "OuterDiameter", "1"}
-	tagDict[Tag{0x0014, 0x0056}] = Info{Tag{0x0014, 0x0056}, "DS", "InnerDiameter", "1"}
-	tagDict[Tag{0x0014, 0x1010}] = Info{Tag{0x0014, 0x1010}, "ST", "ActualEnvironmentalConditions", "1"}
-	tagDict[Tag{0x0014, 0x1020}] = Info{Tag{0x0014, 0x1020}, "DA", "ExpiryDate", "1"}
-	tagDict[Tag{0x0014, 0x1040}] = Info{Tag{0x0014, 0x1040}, "ST", "EnvironmentalConditions", "1"}
-	tagDict[Tag{0x0014, 0x2002}] = Info{Tag{0x0014, 0x2002}, "SQ", "EvaluatorSequence", "1"}
-	tagDict[Tag{0x0014, 0x2004}] = Info{Tag{0x0014, 0x2004}, "IS", "EvaluatorNumber", "1"}
-	tagDict[Tag{0x0014, 0x2006}] = Info{Tag{0x0014, 0x2006}, "PN", "EvaluatorName", "1"}
-	tagDict[Tag{0x0014, 0x2008}] = Info{Tag{0x0014, 0x2008}, "IS", "EvaluationAttempt", "1"}
-	tagDict[Tag{0x0014, 0x2012}] = Info{Tag{0x0014, 0x2012}, "SQ", "IndicationSequence", "1"}
-	tagDict[Tag{0x0014, 0x2014}] = Info{Tag{0x0014, 0x2014}, "IS", "IndicationNumber", "1"}
-	tagDict[Tag{0x0014, 0x2016}] = Info{Tag{0x0014, 0x2016}, "SH", "IndicationLabel", "1"}
-	tagDict[Tag{0x0014, 0x2018}] = Info{Tag{0x0014, 0x2018}, "ST", "IndicationDescription", "1"}
-	tagDict[Tag{0x0014, 0x201A}] = Info{Tag{0x0014, 0x201A}, "CS", "IndicationType", "1-n"}
-	tagDict[Tag{0x0014, 0x201C}] = Info{Tag{0x0014, 0x201C}, "CS", "IndicationDisposition", "1"}
-	tagDict[Tag{0x0014, 0x201E}] = Info{Tag{0x0014, 0x201E}, "SQ", "IndicationROISequence", "1"}
-	tagDict[Tag{0x0014, 0x2030}] = Info{Tag{0x0014, 0x2030}, "SQ", "IndicationPhysicalPropertySequence", "1"}
-	tagDict[Tag{0x0014, 0x2032}] = Info{Tag{0x0014, 0x2032}, "SH", "PropertyLabel", "1"}
-	tagDict[Tag{0x0014, 0x2202}] = Info{Tag{0x0014, 0x2202}, "IS", "CoordinateSystemNumberOfAxes", "1"}
-	tagDict[Tag{0x0014, 0x2204}] = Info{Tag{0x0014, 0x2204}, "SQ", "CoordinateSystemAxesSequence", "1"}
-	tagDict[Tag{0x0014, 0x2206}] = Info{Tag{0x0014, 0x2206}, "ST", "CoordinateSystemAxisDescription", "1"}
-	tagDict[Tag{0x0014, 0x2208}] = Info{Tag{0x0014, 0x2208}, "CS", "CoordinateSystemDataSetMapping", "1"}
-	tagDict[Tag{0x0014, 0x220A}] = Info{Tag{0x0014, 0x220A}, "IS", "CoordinateSystemAxisNumber", "1"}
-	tagDict[Tag{0x0014, 0x220C}] = Info{Tag{0x0014, 0x220C}, "CS", "CoordinateSystemAxisType", "1"}
-	tagDict[Tag{0x0014, 0x220E}] = Info{Tag{0x0014, 0x220E}, "CS", "CoordinateSystemAxisUnits", "1"}
-	tagDict[Tag{0x0014, 0x2210}] = Info{Tag{0x0014, 0x2210}, "OB", "CoordinateSystemAxisValues", "1"}
-	tagDict[Tag{0x0014, 0x2220}] = Info{Tag{0x0014, 0x2220}, "SQ", "CoordinateSystemTransformSequence", "1"}
-	tagDict[Tag{0x0014, 0x2222}] = Info{Tag{0x0014, 0x2222}, "ST", "TransformDescription", "1"}
-	tagDict[Tag{0x0014, 0x2224}] = Info{Tag{0x0014, 0x2224}, "IS", "TransformNumberOfAxes", "1"}
-	tagDict[Tag{0x0014, 0x2226}] = Info{Tag{0x0014, 0x2226}, "IS", "TransformOrderOfAxes", "1-n"}
-	tagDict[Tag{0x0014, 0x2228}] = Info{Tag{0x0014, 0x2228}, "CS", "TransformedAxisUnits", "1"}
-	tagDict[Tag{0x0014, 0x222A}] = Info{Tag{0x0014, 0x222A}, "DS", "CoordinateSystemTransformRotationAndScaleMatrix", "1-n"}
-	tagDict[Tag{0x0014, 0x222C}] = Info{Tag{0x0014, 0x222C}, "DS", "CoordinateSystemTransformTranslationMatrix", "1-n"}
-	tagDict[Tag{0x0014, 0x3011}] = Info{Tag{0x0014, 0x3011}, "DS", "InternalDetectorFrameTime", "1"}
-	tagDict[Tag{0x0014, 0x3012}] = Info{Tag{0x0014, 0x3012}, "DS", "NumberOfFramesIntegrated", "1"}
-	tagDict[Tag{0x0014, 0x3020}] = Info{Tag{0x0014, 0x3020}, "SQ", "DetectorTemperatureSequence", "1"}
-	tagDict[Tag{0x0014, 0x3022}] = Info{Tag{0x0014, 0x3022}, "ST", "SensorName", "1"}
-	tagDict[Tag{0x0014, 0x3024}] = Info{Tag{0x0014, 0x3024}, "DS", "HorizontalOffsetOfSensor", "1"}
-	tagDict[Tag{0x0014, 0x3026}] = Info{Tag{0x0014, 0x3026}, "DS", "VerticalOffsetOfSensor", "1"}
-	tagDict[Tag{0x0014, 0x3028}] = Info{Tag{0x0014, 0x3028}, "DS", "SensorTemperature", "1"}
-	tagDict[Tag{0x0014, 0x3040}] = Info{Tag{0x0014, 0x3040}, "SQ", "DarkCurrentSequence", "1"}
-	tagDict[Tag{0x0014, 0x3050}] = Info{Tag{0x0014, 0x3050}, "OW", "DarkCurrentCounts", "1"}
-	tagDict[Tag{0x0014, 0x3060}] = Info{Tag{0x0014, 0x3060}, "SQ", "GainCorrectionReferenceSequence", "1"}
-	tagDict[Tag{0x0014, 0x3070}] = Info{Tag{0x0014, 0x3070}, "OW", "AirCounts", "1"}
-	tagDict[Tag{0x0014, 0x3071}] = Info{Tag{0x0014, 0x3071}, "DS", "KVUsedInGainCalibration", "1"}
-	tagDict[Tag{0x0014, 0x3072}] = Info{Tag{0x0014, 0x3072}, "DS", "MAUsedInGainCalibration", "1"}
-	tagDict[Tag{0x0014, 0x3073}] = Info{Tag{0x0014, 0x3073}, "DS", "NumberOfFramesUsedForIntegration", "1"}
-	tagDict[Tag{0x0014, 0x3074}] = Info{Tag{0x0014, 0x3074}, "LO", "FilterMaterialUsedInGainCalibration", "1"}
-	tagDict[Tag{0x0014, 0x3075}] = Info{Tag{0x0014, 0x3075}, "DS", "FilterThicknessUsedInGainCalibration", "1"}
-	tagDict[Tag{0x0014, 0x3076}] = Info{Tag{0x0014, 0x3076}, "DA", "DateOfGainCalibration", "1"}
-	tagDict[Tag{0x0014, 0x3077}] = Info{Tag{0x0014, 0x3077}, "TM", "TimeOfGainCalibration", "1"}
-	tagDict[Tag{0x0014, 0x3080}] = Info{Tag{0x0014, 0x3080}, "OB", "BadPixelImage", "1"}
-	tagDict[Tag{0x0014, 0x3099}] = Info{Tag{0x0014, 0x3099}, "LT", "CalibrationNotes", "1"}
-	tagDict[Tag{0x0014, 0x4002}] = Info{Tag{0x0014, 0x4002}, "SQ", "PulserEquipmentSequence", "1"}
-	tagDict[Tag{0x0014, 0x4004}] = Info{Tag{0x0014, 0x4004}, "CS", "PulserType", "1"}
-	tagDict[Tag{0x0014, 0x4006}] = Info{Tag{0x0014, 0x4006}, "LT", "PulserNotes", "1"}
-	tagDict[Tag{0x0014, 0x4008}] = Info{Tag{0x0014, 0x4008}, "SQ", "ReceiverEquipmentSequence", "1"}
-	tagDict[Tag{0x0014, 0x400A}] = Info{Tag{0x0014, 0x400A}, "CS", "AmplifierType", "1"}
-	tagDict[Tag{0x0014, 0x400C}] = Info{Tag{0x0014, 0x400C}, "LT", "ReceiverNotes", "1"}
-	tagDict[Tag{0x0014, 0x400E}] = Info{Tag{0x0014, 0x400E}, "SQ", "PreAmplifierEquipmentSequence", "1"}
-	tagDict[Tag{0x0014, 0x400F}] = Info{Tag{0x0014, 0x400F}, "LT", "PreAmplifierNotes", "1"}
-	tagDict[Tag{0x0014, 0x4010}] = Info{Tag{0x0014, 0x4010}, "SQ", "TransmitTransducerSequence", "1"}
-	tagDict[Tag{0x0014, 0x4011}] = Info{Tag{0x0014, 0x4011}, "SQ", "ReceiveTransducerSequence", "1"}
-	tagDict[Tag{0x0014, 0x4012}] = Info{Tag{0x0014, 0x4012}, "US", "NumberOfElements", "1"}
-	tagDict[Tag{0x0014, 0x4013}] = Info{Tag{0x0014, 0x4013}, "CS", "ElementShape", "1"}
-	tagDict[Tag{0x0014, 0x4014}] = Info{Tag{0x0014, 0x4014}, "DS", "ElementDimensionA", "1"}
-	tagDict[Tag{0x0014, 0x4015}] = Info{Tag{0x0014, 0x4015}, "DS", "ElementDimensionB", "1"}
-	tagDict[Tag{0x0014, 0x4016}] = Info{Tag{0x0014, 0x4016}, "DS", "ElementPitch", "1"}
-	tagDict[Tag{0x0014, 0x4017}] = Info{Tag{0x0014, 0x4017}, "DS", "MeasuredBeamDimensionA", "1"}
-	tagDict[Tag{0x0014, 0x4018}] = Info{Tag{0x0014, 0x4018}, "DS", "MeasuredBeamDimensionB", "1"}
-	tagDict[Tag{0x0014, 0x4019}] = Info{Tag{0x0014, 0x4019}, "DS", "LocationOfMeasuredBeamDiameter", "1"}
-	tagDict[Tag{0x0014, 0x401A}] = Info{Tag{0x0014, 0x401A}, "DS", "NominalFrequency", "1"}
-	tagDict[Tag{0x0014, 0x401B}] = Info{Tag{0x0014, 0x401B}, "DS", "MeasuredCenterFrequency", "1"}
-	tagDict[Tag{0x0014, 0x401C}] = Info{Tag{0x0014, 0x401C}, "DS", "MeasuredBandwidth", "1"}
-	tagDict[Tag{0x0014, 0x4020}] = Info{Tag{0x0014, 0x4020}, "SQ", "PulserSettingsSequence", "1"}
-	tagDict[Tag{0x0014, 0x4022}] = Info{Tag{0x0014, 0x4022}, "DS", "PulseWidth", "1"}
-	tagDict[Tag{0x0014, 0x4024}] = Info{Tag{0x0014, 0x4024}, "DS", "ExcitationFrequency", "1"}
-	tagDict[Tag{0x0014, 0x4026}] = Info{Tag{0x0014, 0x4026}, "CS", "ModulationType", "1"}
-	tagDict[Tag{0x0014, 0x4028}] = Info{Tag{0x0014, 0x4028}, "DS", "Damping", "1"}
-	tagDict[Tag{0x0014, 0x4030}] = Info{Tag{0x0014, 0x4030}, "SQ", "ReceiverSettingsSequence", "1"}
-	tagDict[Tag{0x0014, 0x4031}] = Info{Tag{0x0014, 0x4031}, "DS", "AcquiredSoundpathLength", "1"}
-	tagDict[Tag{0x0014, 0x4032}] = Info{Tag{0x0014, 0x4032}, "CS", "AcquisitionCompressionType", "1"}
-	tagDict[Tag{0x0014, 0x4033}] = Info{Tag{0x0014, 0x4033}, "IS", "AcquisitionSampleSize", "1"}
-	tagDict[Tag{0x0014, 0x4034}] = Info{Tag{0x0014, 0x4034}, "DS", "RectifierSmoothing", "1"}
-	tagDict[Tag{0x0014, 0x4035}] = Info{Tag{0x0014, 0x4035}, "SQ", "DACSequence", "1"}
-	tagDict[Tag{0x0014, 0x4036}] = Info{Tag{0x0014, 0x4036}, "CS", "DACType", "1"}
-	tagDict[Tag{0x0014, 0x4038}] = Info{Tag{0x0014, 0x4038}, "DS", "DACGainPoints", "1-n"}
-	tagDict[Tag{0x0014, 0x403A}] = Info{Tag{0x0014, 0x403A}, "DS", "DACTimePoints", "1-n"}
-	tagDict[Tag{0x0014, 0x403C}] = Info{Tag{0x0014, 0x403C}, "DS", "DACAmplitude", "1-n"}
-	tagDict[Tag{0x0014, 0x4040}] = Info{Tag{0x0014, 0x4040}, "SQ", "PreAmplifierSettingsSequence", "1"}
-	tagDict[Tag{0x0014, 0x4050}] = Info{Tag{0x0014, 0x4050}, "SQ", "TransmitTransducerSettingsSequence", "1"}
-	tagDict[Tag{0x0014, 0x4051}] = Info{Tag{0x0014, 0x4051}, "SQ", "ReceiveTransducerSettingsSequence", "1"}
-	tagDict[Tag{0x0014, 0x4052}] = Info{Tag{0x0014, 0x4052}, "DS", "IncidentAngle", "1"}
-	tagDict[Tag{0x0014, 0x4054}] = Info{Tag{0x0014, 0x4054}, "ST", "CouplingTechnique", "1"}
-	tagDict[Tag{0x0014, 0x4056}] = Info{Tag{0x0014, 0x4056}, "ST", "CouplingMedium", "1"}
-	tagDict[Tag{0x0014, 0x4057}] = Info{Tag{0x0014, 0x4057}, "DS", "CouplingVelocity", "1"}
-	tagDict[Tag{0x0014, 0x4058}] = Info{Tag{0x0014, 0x4058}, "DS", "CrystalCenterLocationX", "1"}
-	tagDict[Tag{0x0014, 0x4059}] = Info{Tag{0x0014, 0x4059}, "DS", "CrystalCenterLocationZ", "1"}
-	tagDict[Tag{0x0014, 0x405A}] = Info{Tag{0x0014, 0x405A}, "DS", "SoundPathLength", "1"}
-	tagDict[Tag{0x0014, 0x405C}] = Info{Tag{0x0014, 0x405C}, "ST", "DelayLawIdentifier", "1"}
-	tagDict[Tag{0x0014, 0x4060}] = Info{Tag{0x0014, 0x4060}, "SQ", "GateSettingsSequence", "1"}
-	tagDict[Tag{0x0014, 0x4062}] = Info{Tag{0x0014, 0x4062}, "DS", "GateThreshold", "1"}
-	tagDict[Tag{0x0014, 0x4064}] = Info{Tag{0x0014, 0x4064}, "DS", "VelocityOfSound", "1"}
-	tagDict[Tag{0x0014, 0x4070}] = Info{Tag{0x0014, 0x4070}, "SQ", "CalibrationSettingsSequence", "1"}
-	tagDict[Tag{0x0014, 0x4072}] = Info{Tag{0x0014, 0x4072}, "ST", "CalibrationProcedure", "1"}
-	tagDict[Tag{0x0014, 0x4074}] = Info{Tag{0x0014, 0x4074}, "SH", "ProcedureVersion", "1"}
-	tagDict[Tag{0x0014, 0x4076}] = Info{Tag{0x0014, 0x4076}, "DA", "ProcedureCreationDate", "1"}
-	tagDict[Tag{0x0014, 0x4078}] = Info{Tag{0x0014, 0x4078}, "DA", "ProcedureExpirationDate", "1"}
-	tagDict[Tag{0x0014, 0x407A}] = Info{Tag{0x0014, 0x407A}, "DA", "ProcedureLastModifiedDate", "1"}
-	tagDict[Tag{0x0014, 0x407C}] = Info{Tag{0x0014, 0x407C}, "TM", "CalibrationTime", "1-n"}
-	tagDict[Tag{0x0014, 0x407E}] = Info{Tag{0x0014, 0x407E}, "DA", "CalibrationDate", "1-n"}
-	tagDict[Tag{0x0014, 0x5002}] = Info{Tag{0x0014, 0x5002}, "IS", "LINACEnergy", "1"}
-	tagDict[Tag{0x0014, 0x5004}] = Info{Tag{0x0014, 0x5004}, "IS", "LINACOutput", "1"}
-	tagDict[Tag{0x0018, 0x0010}] = Info{Tag{0x0018, 0x0010}, "LO", "ContrastBolusAgent", "1"}
-	tagDict[Tag{0x0018, 0x0012}] = Info{Tag{0x0018, 0x0012}, "SQ", "ContrastBolusAgentSequence", "1"}
-	tagDict[Tag{0x0018, 0x0014}] = Info{Tag{0x0018, 0x0014}, "SQ", "ContrastBolusAdministrationRouteSequence", "1"}
-	tagDict[Tag{0x0018, 0x0015}] = Info{Tag{0x0018, 0x0015}, "CS", "BodyPartExamined", "1"}
-	tagDict[Tag{0x0018, 0x0020}] = Info{Tag{0x0018, 0x0020}, "CS", "ScanningSequence", "1-n"}
-	tagDict[Tag{0x0018, 0x0021}] = Info{Tag{0x0018, 0x0021}, "CS", "SequenceVariant", "1-n"}
-	tagDict[Tag{0x0018, 0x0022}] = Info{Tag{0x0018, 0x0022}, "CS", "ScanOptions", "1-n"}
-	tagDict[Tag{0x0018, 0x0023}] = Info{Tag{0x0018, 0x0023}, "CS", "MRAcquisitionType", "1"}
-	tagDict[Tag{0x0018, 0x0024}] = Info{Tag{0x0018, 0x0024}, "SH", "SequenceName", "1"}
-	tagDict[Tag{0x0018, 0x0025}] = Info{Tag{0x0018, 0x0025}, "CS", "AngioFlag", "1"}
-	tagDict[Tag{0x0018, 0x0026}] = Info{Tag{0x0018, 0x0026}, "SQ", "InterventionDrugInformationSequence", "1"}
-	tagDict[Tag{0x0018, 0x0027}] = Info{Tag{0x0018, 0x0027}, "TM", "InterventionDrugStopTime", "1"}
-	tagDict[Tag{0x0018, 0x0028}] = Info{Tag{0x0018, 0x0028}, "DS", "InterventionDrugDose", "1"}
-	tagDict[Tag{0x0018, 0x0029}] = Info{Tag{0x0018, 0x0029}, "SQ", "InterventionDrugCodeSequence", "1"}
-	tagDict[Tag{0x0018, 0x002A}] = Info{Tag{0x0018, 0x002A}, "SQ", "AdditionalDrugSequence", "1"}
-	tagDict[Tag{0x0018, 0x0031}] = Info{Tag{0x0018, 0x0031}, "LO", "Radiopharmaceutical", "1"}
-	tagDict[Tag{0x0018, 0x0034}] = Info{Tag{0x0018, 0x0034}, "LO", "InterventionDrugName", "1"}
-	tagDict[Tag{0x0018, 0x0035}] = Info{Tag{0x0018, 0x0035}, "TM", "InterventionDrugStartTime", "1"}
-	tagDict[Tag{0x0018, 0x0036}] = Info{Tag{0x0018, 0x0036}, "SQ", "InterventionSequence", "1"}
-	tagDict[Tag{0x0018, 0x0038}] = Info{Tag{0x0018, 0x0038}, "CS", "InterventionStatus", "1"}
-	tagDict[Tag{0x0018, 0x003A}] = Info{Tag{0x0018, 0x003A}, "ST", "InterventionDescription", "1"}
-	tagDict[Tag{0x0018, 0x0040}] = Info{Tag{0x0018, 0x0040}, "IS", "CineRate", "1"}
-	tagDict[Tag{0x0018, 0x0042}] = Info{Tag{0x0018, 0x0042}, "CS", "InitialCineRunState", "1"}
-	tagDict[Tag{0x0018, 0x0050}] = Info{Tag{0x0018, 0x0050}, "DS", "SliceThickness", "1"}
-	tagDict[Tag{0x0018, 0x0060}] = Info{Tag{0x0018, 0x0060}, "DS", "KVP", "1"}
-	tagDict[Tag{0x0018, 0x0070}] = Info{Tag{0x0018, 0x0070}, "IS", "CountsAccumulated", "1"}
-	tagDict[Tag{0x0018, 0x0071}] = Info{Tag{0x0018, 0x0071}, "CS", "AcquisitionTerminationCondition", "1"}
-	tagDict[Tag{0x0018, 0x0072}] = Info{Tag{0x0018, 0x0072}, "DS", "EffectiveDuration", "1"}
-	tagDict[Tag{0x0018, 0x0073}] = Info{Tag{0x0018, 0x0073}, "CS", "AcquisitionStartCondition", "1"}
-	tagDict[Tag{0x0018, 0x0074}] = Info{Tag{0x0018, 0x0074}, "IS", "AcquisitionStartConditionData", "1"}
-	tagDict[Tag{0x0018, 0x0075}] = Info{Tag{0x0018, 0x0075}, "IS", "AcquisitionTerminationConditionData", "1"}
-	tagDict[Tag{0x0018, 0x0080}] = Info{Tag{0x0018, 0x0080}, "DS", "RepetitionTime", "1"}
-	tagDict[Tag{0x0018, 0x0081}] = Info{Tag{0x0018, 0x0081}, "DS", "EchoTime", "1"}
-	tagDict[Tag{0x0018, 0x0082}] = Info{Tag{0x0018, 0x0082}, "DS", "InversionTime", "1"}
-	tagDict[Tag{0x0018, 0x0083}] = Info{Tag{0x0018, 0x0083}, "DS", "NumberOfAverages", "1"}
-	tagDict[Tag{0x0018, 0x0084}] = Info{Tag{0x0018, 0x0084}, "DS", "ImagingFrequency", "1"}
-	tagDict[Tag{0x0018, 0x0085}] = Info{Tag{0x0018, 0x0085}, "SH", "ImagedNucleus", "1"}
-	tagDict[Tag{0x0018, 0x0086}] = Info{Tag{0x0018, 0x0086}, "IS", "EchoNumbers", "1-n"}
-	tagDict[Tag{0x0018, 0x0087}] = Info{Tag{0x0018, 0x0087}, "DS", "MagneticFieldStrength", "1"}
-	tagDict[Tag{0x0018, 0x0088}] = Info{Tag{0x0018, 0x0088}, "DS", "SpacingBetweenSlices", "1"}
-	tagDict[Tag{0x0018, 0x0089}] = Info{Tag{0x0018, 0x0089}, "IS", "NumberOfPhaseEncodingSteps", "1"}
-	tagDict[Tag{0x0018, 0x0090}] = Info{Tag{0x0018, 0x0090}, "DS", "DataCollectionDiameter", "1"}
-	tagDict[Tag{0x0018, 0x0091}] = Info{Tag{0x0018, 0x0091}, "IS", "EchoTrainLength", "1"}
-	tagDict[Tag{0x0018, 0x0093}] = Info{Tag{0x0018, 0x0093}, "DS", "PercentSampling", "1"}
-	tagDict[Tag{0x0018, 0x0094}] = Info{Tag{0x0018, 0x0094}, "DS", "PercentPhaseFieldOfView", "1"}
-	tagDict[Tag{0x0018, 0x0095}] = Info{Tag{0x0018, 0x0095}, "DS", "PixelBandwidth", "1"}
-	tagDict[Tag{0x0018, 0x1000}] = Info{Tag{0x0018, 0x1000}, "LO", "DeviceSerialNumber", "1"}
-	tagDict[Tag{0x0018, 0x1002}] = Info{Tag{0x0018, 0x1002}, "UI", "DeviceUID", "1"}
-	tagDict[Tag{0x0018, 0x1003}] = Info{Tag{0x0018, 0x1003}, "LO", "DeviceID", "1"}
-	tagDict[Tag{0x0018, 0x1004}] = Info{Tag{0x0018, 0x1004}, "LO", "PlateID", "1"}
-	tagDict[Tag{0x0018, 0x1005}] = Info{Tag{0x0018, 0x1005}, "LO", "GeneratorID", "1"}
-	tagDict[Tag{0x0018, 0x1006}] = Info{Tag{0x0018, 0x1006}, "LO", "GridID", "1"}
-	tagDict[Tag{0x0018, 0x1007}] = Info{Tag{0x0018, 0x1007}, "LO", "CassetteID", "1"}
-	tagDict[Tag{0x0018, 0x1008}] = Info{Tag{0x0018, 0x1008}, "LO", "GantryID", "1"}
-	tagDict[Tag{0x0018, 0x1010}] = Info{Tag{0x0018, 0x1010}, "LO", "SecondaryCaptureDeviceID", "1"}
-	tagDict[Tag{0x0018, 0x1012}] = Info{Tag{0x0018, 0x1012}, "DA", "DateOfSecondaryCapture", "1"}
-	tagDict[Tag{0x0018, 0x1014}] = Info{Tag{0x0018, 0x1014}, "TM", "TimeOfSecondaryCapture", "1"}
-	tagDict[Tag{0x0018, 0x1016}] = Info{Tag{0x0018, 0x1016}, "LO", "SecondaryCaptureDeviceManufacturer", "1"}
-	tagDict[Tag{0x0018, 0x1018}] = Info{Tag{0x0018, 0x1018}, "LO", "SecondaryCaptureDeviceManufacturerModelName", "1"}
-	tagDict[Tag{0x0018, 0x1019}] = Info{Tag{0x0018, 0x1019}, "LO", "SecondaryCaptureDeviceSoftwareVersions", "1-n"}
-	tagDict[Tag{0x0018, 0x1020}] = Info{Tag{0x0018, 0x1020}, "LO", "SoftwareVersions", "1-n"}
-	tagDict[Tag{0x0018, 0x1022}] = Info{Tag{0x0018, 0x1022}, "SH", "VideoImageFormatAcquired", "1"}
-	tagDict[Tag{0x0018, 0x1023}] = Info{Tag{0x0018, 0x1023}, "LO", "DigitalImageFormatAcquired", "1"}
-	tagDict[Tag{0x0018, 0x1030}] = Info{Tag{0x0018, 0x1030}, "LO", "ProtocolName", "1"}
-	tagDict[Tag{0x0018, 0x1040}] = Info{Tag{0x0018, 0x1040}, "LO", "ContrastBolusRoute", "1"}
-	tagDict[Tag{0x0018, 0x1041}] = Info{Tag{0x0018, 0x1041}, "DS", "ContrastBolusVolume", "1"}
-	tagDict[Tag{0x0018, 0x1042}] = Info{Tag{0x0018, 0x1042}, "TM", "ContrastBolusStartTime", "1"}
-	tagDict[Tag{0x0018, 0x1043}] = Info{Tag{0x0018, 0x1043}, "TM", "ContrastBolusStopTime", "1"}
-	tagDict[Tag{0x0018, 0x1044}] = Info{Tag{0x0018, 0x1044}, "DS", "ContrastBolusTotalDose", "1"}
-	tagDict[Tag{0x0018, 0x1045}] = Info{Tag{0x0018, 0x1045}, "IS", "SyringeCounts", "1"}
-	tagDict[Tag{0x0018, 0x1046}] = Info{Tag{0x0018, 0x1046}, "DS", "ContrastFlowRate", "1-n"}
-	tagDict[Tag{0x0018, 0x1047}] = Info{Tag{0x0018, 0x1047}, "DS", "ContrastFlowDuration", "1-n"}
-	tagDict[Tag{0x0018, 0x1048}] = Info{Tag{0x0018, 0x1048}, "CS", "ContrastBolusIngredient", "1"}
-	tagDict[Tag{0x0018, 0x1049}] = Info{Tag{0x0018, 0x1049}, "DS", "ContrastBolusIngredientConcentration", "1"}
-	tagDict[Tag{0x0018, 0x1050}] = Info{Tag{0x0018, 0x1050}, "DS", "SpatialResolution", "1"}
-	tagDict[Tag{0x0018, 0x1060}] = Info{Tag{0x0018, 0x1060}, "DS", "TriggerTime", "1"}
-	tagDict[Tag{0x0018, 0x1061}] = Info{Tag{0x0018, 0x1061}, "LO", "TriggerSourceOrType", "1"}
-	tagDict[Tag{0x0018, 0x1062}] = Info{Tag{0x0018, 0x1062}, "IS", "NominalInterval", "1"}
-	tagDict[Tag{0x0018, 0x1063}] = Info{Tag{0x0018, 0x1063}, "DS", "FrameTime", "1"}
-	tagDict[Tag{0x0018, 0x1064}] = Info{Tag{0x0018, 0x1064}, "LO", "CardiacFramingType", "1"}
-	tagDict[Tag{0x0018, 0x1065}] = Info{Tag{0x0018, 0x1065}, "DS", "FrameTimeVector", "1-n"}
-	tagDict[Tag{0x0018, 0x1066}] = Info{Tag{0x0018, 0x1066}, "DS", "FrameDelay", "1"}
-	tagDict[Tag{0x0018, 0x1067}] = Info{Tag{0x0018, 0x1067}, "DS", "ImageTriggerDelay", "1"}
-	tagDict[Tag{0x0018, 0x1068}] = Info{Tag{0x0018, 0x1068}, "DS", "MultiplexGroupTimeOffset", "1"}
-	tagDict[Tag{0x0018, 0x1069}] = Info{Tag{0x0018, 0x1069}, "DS", "TriggerTimeOffset", "1"}
-	tagDict[Tag{0x0018, 0x106A}] = Info{Tag{0x0018, 0x106A}, "CS", "SynchronizationTrigger", "1"}
-	tagDict[Tag{0x0018, 0x106C}] = Info{Tag{0x0018, 0x106C}, "US", "SynchronizationChannel", "2"}
-	tagDict[Tag{0x0018, 0x106E}] = Info{Tag{0x0018, 0x106E}, "UL", "TriggerSamplePosition", "1"}
-	tagDict[Tag{0x0018, 0x1070}] = Info{Tag{0x0018, 0x1070}, "LO", "RadiopharmaceuticalRoute", "1"}
-	tagDict[Tag{0x0018, 0x1071}] = Info{Tag{0x0018, 0x1071}, "DS", "RadiopharmaceuticalVolume", "1"}
-	tagDict[Tag{0x0018, 0x1072}] = Info{Tag{0x0018, 0x1072}, "TM", "RadiopharmaceuticalStartTime", "1"}
-	tagDict[Tag{0x0018, 0x1073}] = Info{Tag{0x0018, 0x1073}, "TM", "RadiopharmaceuticalStopTime", "1"}
-	tagDict[Tag{0x0018, 0x1074}] = Info{Tag{0x0018, 0x1074}, "DS", "RadionuclideTotalDose", "1"}
-	tagDict[Tag{0x0018, 0x1075}] = Info{Tag{0x0018, 0x1075}, "DS", "RadionuclideHalfLife", "1"}
-	tagDict[Tag{0x0018, 0x1076}] = Info{Tag{0x0018, 0x1076}, "DS", "RadionuclidePositronFraction", "1"}
-	tagDict[Tag{0x0018, 0x1077}] = Info{Tag{0x0018, 0x1077}, "DS", "RadiopharmaceuticalSpecificActivity", "1"}
-	tagDict[Tag{0x0018, 0x1078}] = Info{Tag{0x0018, 0x1078}, "DT", "RadiopharmaceuticalStartDateTime", "1"}
-	tagDict[Tag{0x0018, 0x1079}] = Info{Tag{0x0018, 0x1079}, "DT", "RadiopharmaceuticalStopDateTime", "1"}
-	tagDict[Tag{0x0018, 0x1080}] = Info{Tag{0x0018, 0x1080}, "CS", "BeatRejectionFlag", "1"}
-	tagDict[Tag{0x0018, 0x1081}] = Info{Tag{0x0018, 0x1081}, "IS", "LowRRValue", "1"}
-	tagDict[Tag{0x0018, 0x1082}] = Info{Tag{0x0018, 0x1082}, "IS", "HighRRValue", "1"}
-	tagDict[Tag{0x0018, 0x1083}] = Info{Tag{0x0018, 0x1083}, "IS", "IntervalsAcquired", "1"}
-	tagDict[Tag{0x0018, 0x1084}] = Info{Tag{0x0018, 0x1084}, "IS", "IntervalsRejected", "1"}
-	tagDict[Tag{0x0018, 0x1085}] = Info{Tag{0x0018, 0x1085}, "LO", "PVCRejection", "1"}
-	tagDict[Tag{0x0018, 0x1086}] = Info{Tag{0x0018, 0x1086}, "IS", "SkipBeats", "1"}
-	tagDict[Tag{0x0018, 0x1088}] = Info{Tag{0x0018, 0x1088}, "IS", "HeartRate", "1"}
-	tagDict[Tag{0x0018, 0x1090}] = Info{Tag{0x0018, 0x1090}, "IS", "CardiacNumberOfImages", "1"}
-	tagDict[Tag{0x0018, 0x1094}] = Info{Tag{0x0018, 0x1094}, "IS", "TriggerWindow", "1"}
-	tagDict[Tag{0x0018, 0x1100}] = Info{Tag{0x0018, 0x1100}, "DS", "ReconstructionDiameter", "1"}
-	tagDict[Tag{0x0018, 0x1110}] = Info{Tag{0x0018, 0x1110}, "DS", "DistanceSourceToDetector", "1"}
-	tagDict[Tag{0x0018, 0x1111}] = Info{Tag{0x0018, 0x1111}, "DS", "DistanceSourceToPatient", "1"}
-	tagDict[Tag{0x0018, 0x1114}] = Info{Tag{0x0018, 0x1114}, "DS", "EstimatedRadiographicMagnificationFactor", "1"}
-	tagDict[Tag{0x0018, 0x1120}] = Info{Tag{0x0018, 0x1120}, "DS", "GantryDetectorTilt", "1"}
-	tagDict[Tag{0x0018, 0x1121}] = Info{Tag{0x0018, 0x1121}, "DS", "GantryDetectorSlew", "1"}
-	tagDict[Tag{0x0018, 0x1130}] = Info{Tag{0x0018, 0x1130}, "DS", "TableHeight", "1"}
-	tagDict[Tag{0x0018, 0x1131}] = Info{Tag{0x0018, 0x1131}, "DS", "TableTraverse", "1"}
-	tagDict[Tag{0x0018, 0x1134}] = Info{Tag{0x0018, 0x1134}, "CS", "TableMotion", "1"}
-	tagDict[Tag{0x0018, 0x1135}] = Info{Tag{0x0018, 0x1135}, "DS", "TableVerticalIncrement", "1-n"}
-	tagDict[Tag{0x0018, 0x1136}] = Info{Tag{0x0018, 0x1136}, "DS", "TableLateralIncrement", "1-n"}
-	tagDict[Tag{0x0018, 0x1137}] = Info{Tag{0x0018, 0x1137}, "DS", "TableLongitudinalIncrement", "1-n"}
-	tagDict[Tag{0x0018, 0x1138}] = Info{Tag{0x0018, 0x1138}, "DS", "TableAngle", "1"}
-	tagDict[Tag{0x0018, 0x113A}] = Info{Tag{0x0018, 0x113A}, "CS", "TableType", "1"}
-	tagDict[Tag{0x0018, 0x1140}] = Info{Tag{0x0018, 0x1140}, "CS", "RotationDirection", "1"}
-	tagDict[Tag{0x0018, 0x1142}] = Info{Tag{0x0018, 0x1142}, "DS", "RadialPosition", "1-n"}
-	tagDict[Tag{0x0018, 0x1143}] = Info{Tag{0x0018, 0x1143}, "DS", "ScanArc", "1"}
-	tagDict[Tag{0x0018, 0x1144}] = Info{Tag{0x0018, 0x1144}, "DS", "AngularStep", "1"}
-	tagDict[Tag{0x0018, 0x1145}] = Info{Tag{0x0018, 0x1145}, "DS", "CenterOfRotationOffset", "1"}
-	tagDict[Tag{0x0018, 0x1147}] = Info{Tag{0x0018, 0x1147}, "CS", "FieldOfViewShape", "1"}
-	tagDict[Tag{0x0018, 0x1149}] = Info{Tag{0x0018, 0x1149}, "IS", "FieldOfViewDimensions", "1-2"}
-	tagDict[Tag{0x0018, 0x1150}] = Info{Tag{0x0018, 0x1150}, "IS", "ExposureTime", "1"}
-	tagDict[Tag{0x0018, 0x1151}] = Info{Tag{0x0018, 0x1151}, "IS", "XRayTubeCurrent", "1"}
-	tagDict[Tag{0x0018, 0x1152}] = Info{Tag{0x0018, 0x1152}, "IS", "Exposure", "1"}
-	tagDict[Tag{0x0018, 0x1153}] = Info{Tag{0x0018, 0x1153}, "IS", "ExposureInuAs", "1"}
-	tagDict[Tag{0x0018, 0x1154}] = Info{Tag{0x0018, 0x1154}, "DS", "AveragePulseWidth", "1"}
-	tagDict[Tag{0x0018, 0x1155}] = Info{Tag{0x0018, 0x1155}, "CS", "RadiationSetting", "1"}
-	tagDict[Tag{0x0018, 0x1156}] = Info{Tag{0x0018, 0x1156}, "CS", "RectificationType", "1"}
-	tagDict[Tag{0x0018, 0x115A}] = Info{Tag{0x0018, 0x115A}, "CS", "RadiationMode", "1"}
-	tagDict[Tag{0x0018, 0x115E}] = Info{Tag{0x0018, 0x115E}, "DS", "ImageAndFluoroscopyAreaDoseProduct", "1"}
-	tagDict[Tag{0x0018, 0x1160}] = Info{Tag{0x0018, 0x1160}, "SH", "FilterType", "1"}
-	tagDict[Tag{0x0018, 0x1161}] = Info{Tag{0x0018, 0x1161}, "LO", "TypeOfFilters", "1-n"}
-	tagDict[Tag{0x0018, 0x1162}] = Info{Tag{0x0018, 0x1162}, "DS", "IntensifierSize", "1"}
-	tagDict[Tag{0x0018, 0x1164}] = Info{Tag{0x0018, 0x1164}, "DS", "ImagerPixelSpacing", "2"}
-	tagDict[Tag{0x0018, 0x1166}] = Info{Tag{0x0018, 0x1166}, "CS", "Grid", "1-n"}
-	tagDict[Tag{0x0018, 0x1170}] = Info{Tag{0x0018, 0x1170}, "IS", "GeneratorPower", "1"}
-	tagDict[Tag{0x0018, 0x1180}] = Info{Tag{0x0018, 0x1180}, "SH", "CollimatorGridName", "1"}
-	tagDict[Tag{0x0018, 0x1181}] = Info{Tag{0x0018, 0x1181}, "CS", "CollimatorType", "1"}
-	tagDict[Tag{0x0018, 0x1182}] = Info{Tag{0x0018, 0x1182}, "IS", "FocalDistance", "1-2"}
-	tagDict[Tag{0x0018, 0x1183}] = Info{Tag{0x0018, 0x1183}, "DS", "XFocusCenter", "1-2"}
-	tagDict[Tag{0x0018, 0x1184}] = Info{Tag{0x0018, 0x1184}, "DS", "YFocusCenter", "1-2"}
-	tagDict[Tag{0x0018, 0x1190}] = Info{Tag{0x0018, 0x1190}, "DS", "FocalSpots", "1-n"}
-	tagDict[Tag{0x0018, 0x1191}] = Info{Tag{0x0018, 0x1191}, "CS", "AnodeTargetMaterial", "1"}
-	tagDict[Tag{0x0018, 0x11A0}] = Info{Tag{0x0018, 0x11A0}, "DS", "BodyPartThickness", "1"}
-	tagDict[Tag{0x0018, 0x11A2}] = Info{Tag{0x0018, 0x11A2}, "DS", "CompressionForce", "1"}
-	tagDict[Tag{0x0018, 0x1200}] = Info{Tag{0x0018, 0x1200}, "DA", "DateOfLastCalibration", "1-n"}
-	tagDict[Tag{0x0018, 0x1201}] = Info{Tag{0x0018, 0x1201}, "TM", "TimeOfLastCalibration", "1-n"}
-	tagDict[Tag{0x0018, 0x1210}] = Info{Tag{0x0018, 0x1210}, "SH", "ConvolutionKernel", "1-n"}
-	tagDict[Tag{0x0018, 0x1242}] = Info{Tag{0x0018, 0x1242}, "IS", "ActualFrameDuration", "1"}
-	tagDict[Tag{0x0018, 0x1243}] = Info{Tag{0x0018, 0x1243}, "IS", "CountRate", "1"}
-	tagDict[Tag{0x0018, 0x1244}] = Info{Tag{0x0018, 0x1244}, "US", "PreferredPlaybackSequencing", "1"}
-	tagDict[Tag{0x0018, 0x1250}] = Info{Tag{0x0018, 0x1250}, "SH", "ReceiveCoilName", "1"}
-	tagDict[Tag{0x0018, 0x1251}] = Info{Tag{0x0018, 0x1251}, "SH", "TransmitCoilName", "1"}
-	tagDict[Tag{0x0018, 0x1260}] = Info{Tag{0x0018, 0x1260}, "SH", "PlateType", "1"}
-	tagDict[Tag{0x0018, 0x1261}] = Info{Tag{0x0018, 0x1261}, "LO", "PhosphorType", "1"}
-	tagDict[Tag{0x0018, 0x1300}] = Info{Tag{0x0018, 0x1300}, "DS", "ScanVelocity", "1"}
-	tagDict[Tag{0x0018, 0x1301}] = Info{Tag{0x0018, 0x1301}, "CS", "WholeBodyTechnique", "1-n"}
-	tagDict[Tag{0x0018, 0x1302}] = Info{Tag{0x0018, 0x1302}, "IS", "ScanLength", "1"}
-	tagDict[Tag{0x0018, 0x1310}] = Info{Tag{0x0018, 0x1310}, "US", "AcquisitionMatrix", "4"}
-	tagDict[Tag{0x0018, 0x1312}] = Info{Tag{0x0018, 0x1312}, "CS", "InPlanePhaseEncodingDirection", "1"}
-	tagDict[Tag{0x0018, 0x1314}] = Info{Tag{0x0018, 0x1314}, "DS", "FlipAngle", "1"}
-	tagDict[Tag{0x0018, 0x1315}] = Info{Tag{0x0018, 0x1315}, "CS", "VariableFlipAngleFlag", "1"}
-	tagDict[Tag{0x0018, 0x1316}] = Info{Tag{0x0018, 0x1316}, "DS", "SAR", "1"}
-	tagDict[Tag{0x0018, 0x1318}] = Info{Tag{0x0018, 0x1318}, "DS", "dBdt", "1"}
-	tagDict[Tag{0x0018, 0x1400}] = Info{Tag{0x0018, 0x1400}, "LO", "AcquisitionDeviceProcessingDescription", "1"}
-	tagDict[Tag{0x0018, 0x1401}] = Info{Tag{0x0018, 0x1401}, "LO", "AcquisitionDeviceProcessingCode", "1"}
-	tagDict[Tag{0x0018, 0x1402}] = Info{Tag{0x0018, 0x1402}, "CS", "CassetteOrientation", "1"}
-	tagDict[Tag{0x0018, 0x1403}] = Info{Tag{0x0018, 0x1403}, "CS", "CassetteSize", "1"}
-	tagDict[Tag{0x0018, 0x1404}] = Info{Tag{0x0018, 0x1404}, "US", "ExposuresOnPlate", "1"}
-	tagDict[Tag{0x0018, 0x1405}] = Info{Tag{0x0018, 0x1405}, "IS", "RelativeXRayExposure", "1"}
-	tagDict[Tag{0x0018, 0x1411}] = Info{Tag{0x0018, 0x1411}, "DS", "ExposureIndex", "1"}
-	tagDict[Tag{0x0018, 0x1412}] = Info{Tag{0x0018, 0x1412}, "DS", "TargetExposureIndex", "1"}
-	tagDict[Tag{0x0018, 0x1413}] = Info{Tag{0x0018, 0x1413}, "DS", "DeviationIndex", "1"}
-	tagDict[Tag{0x0018, 0x1450}] = Info{Tag{0x0018, 0x1450}, "DS", "ColumnAngulation", "1"}
-	tagDict[Tag{0x0018, 0x1460}] = Info{Tag{0x0018, 0x1460}, "DS", "TomoLayerHeight", "1"}
-	tagDict[Tag{0x0018, 0x1470}] = Info{Tag{0x0018, 0x1470}, "DS", "TomoAngle", "1"}
-	tagDict[Tag{0x0018, 0x1480}] = Info{Tag{0x0018, 0x1480}, "DS", "TomoTime", "1"}
-	tagDict[Tag{0x0018, 0x1490}] = Info{Tag{0x0018, 0x1490}, "CS", "TomoType", "1"}
-	tagDict[Tag{0x0018, 0x1491}] = Info{Tag{0x0018, 0x1491}, "CS", "TomoClass", "1"}
-	tagDict[Tag{0x0018, 0x1495}] = Info{Tag{0x0018, 0x1495}, "IS", "NumberOfTomosynthesisSourceImages", "1"}
-	tagDict[Tag{0x0018, 0x1500}] = Info{Tag{0x0018, 0x1500}, "CS", "PositionerMotion", "1"}
-	tagDict[Tag{0x0018, 0x1508}] = Info{Tag{0x0018, 0x1508}, "CS", "PositionerType", "1"}
-	tagDict[Tag{0x0018, 0x1510}] = Info{Tag{0x0018, 0x1510}, "DS", "PositionerPrimaryAngle", "1"}
-	tagDict[Tag{0x0018, 0x1511}] = Info{Tag{0x0018, 0x1511}, "DS", "PositionerSecondaryAngle", "1"}
-	tagDict[Tag{0x0018, 0x1520}] = Info{Tag{0x0018, 0x1520}, "DS", "PositionerPrimaryAngleIncrement", "1-n"}
-	tagDict[Tag{0x0018, 0x1521}] = Info{Tag{0x0018, 0x1521}, "DS", "PositionerSecondaryAngleIncrement", "1-n"}
-	tagDict[Tag{0x0018, 0x1530}] = Info{Tag{0x0018, 0x1530}, "DS", "DetectorPrimaryAngle", "1"}
-	tagDict[Tag{0x0018, 0x1531}] = Info{Tag{0x0018, 0x1531}, "DS", "DetectorSecondaryAngle", "1"}
-	tagDict[Tag{0x0018, 0x1600}] = Info{Tag{0x0018, 0x1600}, "CS", "ShutterShape", "1-3"}
-	tagDict[Tag{0x0018, 0x1602}] = Info{Tag{0x0018, 0x1602}, "IS", "ShutterLeftVerticalEdge", "1"}
-	tagDict[Tag{0x0018, 0x1604}] = Info{Tag{0x0018, 0x1604}, "IS", "ShutterRightVerticalEdge", "1"}
-	tagDict[Tag{0x0018, 0x1606}] = Info{Tag{0x0018, 0x1606}, "IS", "ShutterUpperHorizontalEdge", "1"}
-	tagDict[Tag{0x0018, 0x1608}] = Info{Tag{0x0018, 0x1608}, "IS", "ShutterLowerHorizontalEdge", "1"}
-	tagDict[Tag{0x0018, 0x1610}] = Info{Tag{0x0018, 0x1610}, "IS", "CenterOfCircularShutter", "2"}
-	tagDict[Tag{0x0018, 0x1612}] = Info{Tag{0x0018, 0x1612}, "IS", "RadiusOfCircularShutter", "1"}
-	tagDict[Tag{0x0018, 0x1620}] = Info{Tag{0x0018, 0x1620}, "IS", "VerticesOfThePolygonalShutter", "2-2n"}
-	tagDict[Tag{0x0018, 0x1622}] = Info{Tag{0x0018, 0x1622}, "US", "ShutterPresentationValue", "1"}
-	tagDict[Tag{0x0018, 0x1623}] = Info{Tag{0x0018, 0x1623}, "US", "ShutterOverlayGroup", "1"}
-	tagDict[Tag{0x0018, 0x1624}] = Info{Tag{0x0018, 0x1624}, "US", "ShutterPresentationColorCIELabValue", "3"}
-	tagDict[Tag{0x0018, 0x1700}] = Info{Tag{0x0018, 0x1700}, "CS", "CollimatorShape", "1-3"}
-	tagDict[Tag{0x0018, 0x1702}] = Info{Tag{0x0018, 0x1702}, "IS", "CollimatorLeftVerticalEdge", "1"}
-	tagDict[Tag{0x0018, 0x1704}] = Info{Tag{0x0018, 0x1704}, "IS", "CollimatorRightVerticalEdge", "1"}
-	tagDict[Tag{0x0018, 0x1706}] = Info{Tag{0x0018, 0x1706}, "IS", "CollimatorUpperHorizontalEdge", "1"}
-	tagDict[Tag{0x0018, 0x1708}] = Info{Tag{0x0018, 0x1708}, "IS", "CollimatorLowerHorizontalEdge", "1"}
-	tagDict[Tag{0x0018, 0x1710}] = Info{Tag{0x0018, 0x1710}, "IS", "CenterOfCircularCollimator", "2"}
-	tagDict[Tag{0x0018, 0x1712}] = Info{Tag{0x0018, 0x1712}, "IS", "RadiusOfCircularCollimator", "1"}
-	tagDict[Tag{0x0018, 0x1720}] = Info{Tag{0x0018, 0x1720}, "IS", "VerticesOfThePolygonalCollimator", "2-2n"}
-	tagDict[Tag{0x0018, 0x1800}] = Info{Tag{0x0018, 0x1800}, "CS", "AcquisitionTimeSynchronized", "1"}
-	tagDict[Tag{0x0018, 0x1801}] = Info{Tag{0x0018, 0x1801}, "SH", "TimeSource", "1"}
-	tagDict[Tag{0x0018, 0x1802}] = Info{Tag{0x0018, 0x1802}, "CS", "TimeDistributionProtocol", "1"}
-	tagDict[Tag{0x0018, 0x1803}] = Info{Tag{0x0018, 0x1803}, "LO", "NTPSourceAddress", "1"}
-	tagDict[Tag{0x0018, 0x2001}] = Info{Tag{0x0018, 0x2001}, "IS", "PageNumberVector", "1-n"}
-	tagDict[Tag{0x0018, 0x2002}] = Info{Tag{0x0018, 0x2002}, "SH", "FrameLabelVector", "1-n"}
-	tagDict[Tag{0x0018, 0x2003}] = Info{Tag{0x0018, 0x2003}, "DS", "FramePrimaryAngleVector", "1-n"}
-	tagDict[Tag{0x0018, 0x2004}] = Info{Tag{0x0018, 0x2004}, "DS", "FrameSecondaryAngleVector", "1-n"}
-	tagDict[Tag{0x0018, 0x2005}] = Info{Tag{0x0018, 0x2005}, "DS", "SliceLocationVector", "1-n"}
-	tagDict[Tag{0x0018, 0x2006}] = Info{Tag{0x0018, 0x2006}, "SH", "DisplayWindowLabelVector", "1-n"}
-	tagDict[Tag{0x0018, 0x2010}] = Info{Tag{0x0018, 0x2010}, "DS", "NominalScannedPixelSpacing", "2"}
-	tagDict[Tag{0x0018, 0x2020}] = Info{Tag{0x0018, 0x2020}, "CS", "DigitizingDeviceTransportDirection", "1"}
-	tagDict[Tag{0x0018, 0x2030}] = Info{Tag{0x0018, 0x2030}, "DS", "RotationOfScannedFilm", "1"}
-	tagDict[Tag{0x0018, 0x3100}] = Info{Tag{0x0018, 0x3100}, "CS", "IVUSAcquisition", "1"}
-	tagDict[Tag{0x0018, 0x3101}] = Info{Tag{0x0018, 0x3101}, "DS", "IVUSPullbackRate", "1"}
-	tagDict[Tag{0x0018, 0x3102}] = Info{Tag{0x0018, 0x3102}, "DS", "IVUSGatedRate", "1"}
-	tagDict[Tag{0x0018, 0x3103}] = Info{Tag{0x0018, 0x3103}, "IS", "IVUSPullbackStartFrameNumber", "1"}
-	tagDict[Tag{0x0018, 0x3104}] = Info{Tag{0x0018, 0x3104}, "IS", "IVUSPullbackStopFrameNumber", "1"}
-	tagDict[Tag{0x0018, 0x3105}] = Info{Tag{0x0018, 0x3105}, "IS", "LesionNumber", "1-n"}
-	tagDict[Tag{0x0018, 0x5000}] = Info{Tag{0x0018, 0x5000}, "SH", "OutputPower", "1-n"}
-	tagDict[Tag{0x0018, 0x5010}] = Info{Tag{0x0018, 0x5010}, "LO", "TransducerData", "1-n"}
-	tagDict[Tag{0x0018, 0x5012}] = Info{Tag{0x0018, 0x5012}, "DS", "FocusDepth", "1"}
-	tagDict[Tag{0x0018, 0x5020}] = Info{Tag{0x0018, 0x5020}, "LO", "ProcessingFunction", "1"}
-	tagDict[Tag{0x0018, 0x5022}] = Info{Tag{0x0018, 0x5022}, "DS", "MechanicalIndex", "1"}
-	tagDict[Tag{0x0018, 0x5024}] = Info{Tag{0x0018, 0x5024}, "DS", "BoneThermalIndex", "1"}
-	tagDict[Tag{0x0018, 0x5026}] = Info{Tag{0x0018, 0x5026}, "DS", "CranialThermalIndex", "1"}
-	tagDict[Tag{0x0018, 0x5027}] = Info{Tag{0x0018, 0x5027}, "DS", "SoftTissueThermalIndex", "1"}
-	tagDict[Tag{0x0018, 0x5028}] = Info{Tag{0x0018, 0x5028}, "DS", "SoftTissueFocusThermalIndex", "1"}
-	tagDict[Tag{0x0018, 0x5029}] = Info{Tag{0x0018, 0x5029}, "DS", "SoftTissueSurfaceThermalIndex", "1"}
-	tagDict[Tag{0x0018, 0x5050}] = Info{Tag{0x0018, 0x5050}, "IS", "DepthOfScanField", "1"}
-	tagDict[Tag{0x0018, 0x5100}] = Info{Tag{0x0018, 0x5100}, "CS", "PatientPosition", "1"}
-	tagDict[Tag{0x0018, 0x5101}] = Info{Tag{0x0018, 0x5101}, "CS", "ViewPosition", "1"}
-	tagDict[Tag{0x0018, 0x5104}] = Info{Tag{0x0018, 0x5104}, "SQ", "ProjectionEponymousNameCodeSequence", "1"}
-	tagDict[Tag{0x0018, 0x6000}] = Info{Tag{0x0018, 0x6000}, "DS", "Sensitivity", "1"}
-	tagDict[Tag{0x0018, 0x6011}] = Info{Tag{0x0018, 0x6011}, "SQ", "SequenceOfUltrasoundRegions", "1"}
-	tagDict[Tag{0x0018, 0x6012}] = Info{Tag{0x0018, 0x6012}, "US", "RegionSpatialFormat", "1"}
-	tagDict[Tag{0x0018, 0x6014}] = Info{Tag{0x0018, 0x6014}, "US", "RegionDataType", "1"}
-	tagDict[Tag{0x0018, 0x6016}] = Info{Tag{0x0018, 0x6016}, "UL", "RegionFlags", "1"}
-	tagDict[Tag{0x0018, 0x6018}] = Info{Tag{0x0018, 0x6018}, "UL", "RegionLocationMinX0", "1"}
-	tagDict[Tag{0x0018, 0x601A}] = Info{Tag{0x0018, 0x601A}, "UL", "RegionLocationMinY0", "1"}
-	tagDict[Tag{0x0018, 0x601C}] = Info{Tag{0x0018, 0x601C}, "UL", "RegionLocationMaxX1", "1"}
-	tagDict[Tag{0x0018, 0x601E}] = Info{Tag{0x0018, 0x601E}, "UL", "RegionLocationMaxY1", "1"}
-	tagDict[Tag{0x0018, 0x6020}] = Info{Tag{0x0018, 0x6020}, "SL", "ReferencePixelX0", "1"}
-	tagDict[Tag{0x0018, 0x6022}] = Info{Tag{0x0018, 0x6022}, "SL", "ReferencePixelY0", "1"}
-	tagDict[Tag{0x0018, 0x6024}] = Info{Tag{0x0018, 0x6024}, "US", "PhysicalUnitsXDirection", "1"}
-	tagDict[Tag{0x0018, 0x6026}] = Info{Tag{0x0018, 0x6026}, "US", "PhysicalUnitsYDirection", "1"}
-	tagDict[Tag{0x0018, 0x6028}] = Info{Tag{0x0018, 0x6028}, "FD", "ReferencePixelPhysicalValueX", "1"}
-	tagDict[Tag{0x0018, 0x602A}] = Info{Tag{0x0018, 0x602A}, "FD", "ReferencePixelPhysicalValueY", "1"}
-	tagDict[Tag{0x0018, 0x602C}] = Info{Tag{0x0018, 0x602C}, "FD", "PhysicalDeltaX", "1"}
-	tagDict[Tag{0x0018, 0x602E}] = Info{Tag{0x0018, 0x602E}, "FD", "PhysicalDeltaY", "1"}
-	tagDict[Tag{0x0018, 0x6030}] = Info{Tag{0x0018, 0x6030}, "UL", "TransducerFrequency", "1"}
-	tagDict[Tag{0x0018, 0x6031}] = Info{Tag{0x0018, 0x6031}, "CS", "TransducerType", "1"}
-	tagDict[Tag{0x0018, 0x6032}] = Info{Tag{0x0018, 0x6032}, "UL", "PulseRepetitionFrequency", "1"}
-	tagDict[Tag{0x0018, 0x6034}] = Info{Tag{0x0018, 0x6034}, "FD", "DopplerCorrectionAngle", "1"}
-	tagDict[Tag{0x0018, 0x6036}] = Info{Tag{0x0018, 0x6036}, "FD", "SteeringAngle", "1"}
-	tagDict[Tag{0x0018, 0x6039}] = Info{Tag{0x0018, 0x6039}, "SL", "DopplerSampleVolumeXPosition", "1"}
-	tagDict[Tag{0x0018, 0x603B}] = Info{Tag{0x0018, 0x603B}, "SL", "DopplerSampleVolumeYPosition", "1"}
-	tagDict[Tag{0x0018, 0x603D}] = Info{Tag{0x0018, 0x603D}, "SL", "TMLinePositionX0", "1"}
-	tagDict[Tag{0x0018, 0x603F}] = Info{Tag{0x0018, 0x603F}, "SL", "TMLinePositionY0", "1"}
-	tagDict[Tag{0x0018, 0x6041}] = Info{Tag{0x0018, 0x6041}, "SL", "TMLinePositionX1", "1"}
-	tagDict[Tag{0x0018, 0x6043}] = Info{Tag{0x0018, 0x6043}, "SL", "TMLinePositionY1", "1"}
-	tagDict[Tag{0x0018, 0x6044}] = Info{Tag{0x0018, 0x6044}, "US", "PixelComponentOrganization", "1"}
-	tagDict[Tag{0x0018, 0x6046}] = Info{Tag{0x0018, 0x6046}, "UL", "PixelComponentMask", "1"}
-	tagDict[Tag{0x0018, 0x6048}] = Info{Tag{0x0018, 0x6048}, "UL", "PixelComponentRangeStart", "1"}
-	tagDict[Tag{0x0018, 0x604A}] = Info{Tag{0x0018, 0x604A}, "UL", "PixelComponentRangeStop", "1"}
-	tagDict[Tag{0x0018, 0x604C}] = Info{Tag{0x0018, 0x604C}, "US", "PixelComponentPhysicalUnits", "1"}
-	tagDict[Tag{0x0018, 0x604E}] = Info{Tag{0x0018, 0x604E}, "US", "PixelComponentDataType", "1"}
-	tagDict[Tag{0x0018, 0x6050}] = Info{Tag{0x0018, 0x6050}, "UL", "NumberOfTableBreakPoints", "1"}
-	tagDict[Tag{0x0018, 0x6052}] = Info{Tag{0x0018, 0x6052}, "UL", "TableOfXBreakPoints", "1-n"}
-	tagDict[Tag{0x0018, 0x6054}] = Info{Tag{0x0018, 0x6054}, "FD", "TableOfYBreakPoints", "1-n"}
-	tagDict[Tag{0x0018, 0x6056}] = Info{Tag{0x0018, 0x6056}, "UL", "NumberOfTableEntries", "1"}
-	tagDict[Tag{0x0018, 0x6058}] = Info{Tag{0x0018, 0x6058}, "UL", "TableOfPixelValues", "1-n"}
-	tagDict[Tag{0x0018, 0x605A}] = Info{Tag{0x0018, 0x605A}, "FL", "TableOfParameterValues", "1-n"}
-	tagDict[Tag{0x0018, 0x6060}] = Info{Tag{0x0018, 0x6060}, "FL", "RWaveTimeVector", "1-n"}
-	tagDict[Tag{0x0018, 0x7000}] = Info{Tag{0x0018, 0x7000}, "CS", "DetectorConditionsNominalFlag", "1"}
-	tagDict[Tag{0x0018, 0x7001}] = Info{Tag{0x0018, 0x7001}, "DS", "DetectorTemperature", "1"}
-	tagDict[Tag{0x0018, 0x7004}] = Info{Tag{0x0018, 0x7004}, "CS", "DetectorType", "1"}
-	tagDict[Tag{0x0018, 0x7005}] = Info{Tag{0x0018, 0x7005}, "CS", "DetectorConfiguration", "1"}
-	tagDict[Tag{0x0018, 0x7006}] = Info{Tag{0x0018, 0x7006}, "LT", "DetectorDescription", "1"}
-	tagDict[Tag{0x0018, 0x7008}] = Info{Tag{0x0018, 0x7008}, "LT", "DetectorMode", "1"}
-	tagDict[Tag{0x0018, 0x700A}] = Info{Tag{0x0018, 0x700A}, "SH", "DetectorID", "1"}
-	tagDict[Tag{0x0018, 0x700C}] = Info{Tag{0x0018, 0x700C}, "DA", "DateOfLastDetectorCalibration", "1"}
-	tagDict[Tag{0x0018, 0x700E}] = Info{Tag{0x0018, 0x700E}, "TM", "TimeOfLastDetectorCalibration", "1"}
-	tagDict[Tag{0x0018, 0x7010}] = Info{Tag{0x0018, 0x7010}, "IS", "ExposuresOnDetectorSinceLastCalibration", "1"}
-	tagDict[Tag{0x0018, 0x7011}] = Info{Tag{0x0018, 0x7011}, "IS", "ExposuresOnDetectorSinceManufactured", "1"}
-	tagDict[Tag{0x0018, 0x7012}] = Info{Tag{0x0018, 0x7012}, "DS", "DetectorTimeSinceLastExposure", "1"}
-	tagDict[Tag{0x0018, 0x7014}] = Info{Tag{0x0018, 0x7014}, "DS", "DetectorActiveTime", "1"}
-	tagDict[Tag{0x0018, 0x7016}] = Info{Tag{0x0018, 0x7016}, "DS", "DetectorActivationOffsetFromExposure", "1"}
-	tagDict[Tag{0x0018, 0x701A}] = Info{Tag{0x0018, 0x701A}, "DS", "DetectorBinning", "2"}
-	tagDict[Tag{0x0018, 0x7020}] = Info{Tag{0x0018, 0x7020}, "DS", "DetectorElementPhysicalSize", "2"}
-	tagDict[Tag{0x0018, 0x7022}] = Info{Tag{0x0018, 0x7022}, "DS", "DetectorElementSpacing", "2"}
-	tagDict[Tag{0x0018, 0x7024}] = Info{Tag{0x0018, 0x7024}, "CS", "DetectorActiveShape", "1"}
-	tagDict[Tag{0x0018, 0x7026}] = Info{Tag{0x0018, 0x7026}, "DS", "DetectorActiveDimensions", "1-2"}
-	tagDict[Tag{0x0018, 0x7028}] = Info{Tag{0x0018, 0x7028}, "DS", "DetectorActiveOrigin", "2"}
-	tagDict[Tag{0x0018, 0x702A}] = Info{Tag{0x0018, 0x702A}, "LO", "DetectorManufacturerName", "1"}
-	tagDict[Tag{0x0018, 0x702B}] = Info{Tag{0x0018, 0x702B}, "LO", "DetectorManufacturerModelName", "1"}
-	tagDict[Tag{0x0018, 0x7030}] = Info{Tag{0x0018, 0x7030}, "DS", "FieldOfViewOrigin", "2"}
-	tagDict[Tag{0x0018, 0x7032}] = Info{Tag{0x0018, 0x7032}, "DS", "FieldOfViewRotation", "1"}
-	tagDict[Tag{0x0018, 0x7034}] = Info{Tag{0x0018, 0x7034}, "CS", "FieldOfViewHorizontalFlip", "1"}
-	tagDict[Tag{0x0018, 0x7036}] = Info{Tag{0x0018, 0x7036}, "FL", "PixelDataAreaOriginRelativeToFOV", "2"}
-	tagDict[Tag{0x0018, 0x7038}] = Info{Tag{0x0018, 0x7038}, "FL", "PixelDataAreaRotationAngleRelativeToFOV", "1"}
-	tagDict[Tag{0x0018, 0x7040}] = Info{Tag{0x0018, 0x7040}, "LT", "GridAbsorbingMaterial", "1"}
-	tagDict[Tag{0x0018, 0x7041}] = Info{Tag{0x0018, 0x7041}, "LT", "GridSpacingMaterial", "1"}
-	tagDict[Tag{0x0018, 0x7042}] = Info{Tag{0x0018, 0x7042}, "DS", "GridThickness", "1"}
-	tagDict[Tag{0x0018, 0x7044}] = Info{Tag{0x0018, 0x7044}, "DS", "GridPitch", "1"}
-	tagDict[Tag{0x0018, 0x7046}] = Info{Tag{0x0018, 0x7046}, "IS", "GridAspectRatio", "2"}
-	tagDict[Tag{0x0018, 0x7048}] = Info{Tag{0x0018, 0x7048}, "DS", "GridPeriod", "1"}
-	tagDict[Tag{0x0018, 0x704C}] = Info{Tag{0x0018, 0x704C}, "DS", "GridFocalDistance", "1"}
-	tagDict[Tag{0x0018, 0x7050}] = Info{Tag{0x0018, 0x7050}, "CS", "FilterMaterial", "1-n"}
-	tagDict[Tag{0x0018, 0x7052}] = Info{Tag{0x0018, 0x7052}, "DS", "FilterThicknessMinimum", "1-n"}
-	tagDict[Tag{0x0018, 0x7054}] = Info{Tag{0x0018, 0x7054}, "DS", "FilterThicknessMaximum", "1-n"}
-	tagDict[Tag{0x0018, 0x7056}] = Info{Tag{0x0018, 0x7056}, "FL", "FilterBeamPathLengthMinimum", "1-n"}
-	tagDict[Tag{0x0018, 0x7058}] = Info{Tag{0x0018, 0x7058}, "FL", "FilterBeamPathLengthMaximum", "1-n"}
-	tagDict[Tag{0x0018, 0x7060}] = Info{Tag{0x0018, 0x7060}, "CS", "ExposureControlMode", "1"}
-	tagDict[Tag{0x0018, 0x7062}] = Info{Tag{0x0018, 0x7062}, "LT", "ExposureControlModeDescription", "1"}
-	tagDict[Tag{0x0018, 0x7064}] = Info{Tag{0x0018, 0x7064}, "CS", "ExposureStatus", "1"}
-	tagDict[Tag{0x0018, 0x7065}] = Info{Tag{0x0018, 0x7065}, "DS", "PhototimerSetting", "1"}
-	tagDict[Tag{0x0018, 0x8150}] = Info{Tag{0x0018, 0x8150}, "DS", "ExposureTimeInuS", "1"}
-	tagDict[Tag{0x0018, 0x8151}] = Info{Tag{0x0018, 0x8151}, "DS", "XRayTubeCurrentInuA", "1"}
-	tagDict[Tag{0x0018, 0x9004}] = Info{Tag{0x0018, 0x9004}, "CS", "ContentQualification", "1"}
-	tagDict[Tag{0x0018, 0x9005}] = Info{Tag{0x0018, 0x9005}, "SH", "PulseSequenceName", "1"}
-	tagDict[Tag{0x0018, 0x9006}] = Info{Tag{0x0018, 0x9006}, "SQ", "MRImagingModifierSequence", "1"}
-	tagDict[Tag{0x0018, 0x9008}] = Info{Tag{0x0018, 0x9008}, "CS", "EchoPulseSequence", "1"}
-	tagDict[Tag{0x0018, 0x9009}] = Info{Tag{0x0018, 0x9009}, "CS", "InversionRecovery", "1"}
-	tagDict[Tag{0x0018, 0x9010}] = Info{Tag{0x0018, 0x9010}, "CS", "FlowCompensation", "1"}
-	tagDict[Tag{0x0018, 0x9011}] = Info{Tag{0x0018, 0x9011}, "CS", "MultipleSpinEcho", "1"}
-	tagDict[Tag{0x0018, 0x9012}] = Info{Tag{0x0018, 0x9012}, "CS", "MultiPlanarExcitation", "1"}
-	tagDict[Tag{0x0018, 0x9014}] = Info{Tag{0x0018, 0x9014}, "CS", "PhaseContrast", "1"}
-	tagDict[Tag{0x0018, 0x9015}] = Info{Tag{0x0018, 0x9015}, "CS", "TimeOfFlightContrast", "1"}
-	tagDict[Tag{0x0018, 0x9016}] = Info{Tag{0x0018, 0x9016}, "CS", "Spoiling", "1"}
-	tagDict[Tag{0x0018, 0x9017}] = Info{Tag{0x0018, 0x9017}, "CS", "SteadyStatePulseSequence", "1"}
-	tagDict[Tag{0x0018, 0x9018}] = Info{Tag{0x0018, 0x9018}, "CS", "EchoPlanarPulseSequence", "1"}
-	tagDict[Tag{0x0018, 0x9019}] = Info{Tag{0x0018, 0x9019}, "FD", "TagAngleFirstAxis", "1"}
-	tagDict[Tag{0x0018, 0x9020}] = Info{Tag{0x0018, 0x9020}, "CS", "MagnetizationTransfer", "1"}
-	tagDict[Tag{0x0018, 0x9021}] = Info{Tag{0x0018, 0x9021}, "CS", "T2Preparation", "1"}
-	tagDict[Tag{0x0018, 0x9022}] = Info{Tag{0x0018, 0x9022}, "CS", "BloodSignalNulling", "1"}
-	tagDict[Tag{0x0018, 0x9024}] = Info{Tag{0x0018, 0x9024}, "CS", "SaturationRecovery", "1"}
-	tagDict[Tag{0x0018, 0x9025}] = Info{Tag{0x0018, 0x9025}, "CS", "SpectrallySelectedSuppression", "1"}
-	tagDict[Tag{0x0018, 0x9026}] = Info{Tag{0x0018, 0x9026}, "CS", "SpectrallySelectedExcitation", "1"}
-	tagDict[Tag{0x0018, 0x9027}] = Info{Tag{0x0018, 0x9027}, "CS", "SpatialPresaturation", "1"}
-	tagDict[Tag{0x0018, 0x9028}] = Info{Tag{0x0018, 0x9028}, "CS", "Tagging", "1"}
-	tagDict[Tag{0x0018, 0x9029}] = Info{Tag{0x0018, 0x9029}, "CS", "OversamplingPhase", "1"}
-	tagDict[Tag{0x0018, 0x9030}] = Info{Tag{0x0018, 0x9030}, "FD", "TagSpacingFirstDimension", "1"}
-	tagDict[Tag{0x0018, 0x9032}] = Info{Tag{0x0018, 0x9032}, "CS", "GeometryOfKSpaceTraversal", "1"}
-	tagDict[Tag{0x0018, 0x9033}] = Info{Tag{0x0018, 0x9033}, "CS", "SegmentedKSpaceTraversal", "1"}
-	tagDict[Tag{0x0018, 0x9034}] = Info{Tag{0x0018, 0x9034}, "CS", "RectilinearPhaseEncodeReordering", "1"}
-	tagDict[Tag{0x0018, 0x9035}] = Info{Tag{0x0018, 0x9035}, "FD", "TagThickness", "1"}
-	tagDict[Tag{0x0018, 0x9036}] = Info{Tag{0x0018, 0x9036}, "CS", "PartialFourierDirection", "1"}
-	tagDict[Tag{0x0018, 0x9037}] = Info{Tag{0x0018, 0x9037}, "CS", "CardiacSynchronizationTechnique", "1"}
-	tagDict[Tag{0x0018, 0x9041}] = Info{Tag{0x0018, 0x9041}, "LO", "ReceiveCoilManufacturerName", "1"}
-	tagDict[Tag{0x0018, 0x9042}] = Info{Tag{0x0018, 0x9042}, "SQ", "MRReceiveCoilSequence", "1"}
-	tagDict[Tag{0x0018, 0x9043}] = Info{Tag{0x0018, 0x9043}, "CS", "ReceiveCoilType", "1"}
-	tagDict[Tag{0x0018, 0x9044}] = Info{Tag{0x0018, 0x9044}, "CS", "QuadratureReceiveCoil", "1"}
-	tagDict[Tag{0x0018, 0x9045}] = Info{Tag{0x0018, 0x9045}, "SQ", "MultiCoilDefinitionSequence", "1"}
-	tagDict[Tag{0x0018, 0x9046}] = Info{Tag{0x0018, 0x9046}, "LO", "MultiCoilConfiguration", "1"}
-	tagDict[Tag{0x0018, 0x9047}] = Info{Tag{0x0018, 0x9047}, "SH", "MultiCoilElementName", "1"}
-	tagDict[Tag{0x0018, 0x9048}] = Info{Tag{0x0018, 0x9048}, "CS", "MultiCoilElementUsed", "1"}
-	tagDict[Tag{0x0018, 0x9049}] = Info{Tag{0x0018, 0x9049}, "SQ", "MRTransmitCoilSequence", "1"}
-	tagDict[Tag{0x0018, 0x9050}] = Info{Tag{0x0018, 0x9050}, "LO", "TransmitCoilManufacturerName", "1"}
-	tagDict[Tag{0x0018, 0x9051}] = Info{Tag{0x0018, 0x9051}, "CS", "TransmitCoilType", "1"}
-	tagDict[Tag{0x0018, 0x9052}] = Info{Tag{0x0018, 0x9052}, "FD", "SpectralWidth", "1-2"}
-	tagDict[Tag{0x0018, 0x9053}] = Info{Tag{0x0018, 0x9053}, "FD", "ChemicalShiftReference", "1-2"}
-	tagDict[Tag{0x0018, 0x9054}] = Info{Tag{0x0018, 0x9054}, "CS", "VolumeLocalizationTechnique", "1"}
-	tagDict[Tag{0x0018, 0x9058}] = Info{Tag{0x0018, 0x9058}, "US", "MRAcquisitionFrequencyEncodingSteps", "1"}
-	tagDict[Tag{0x0018, 0x9059}] = Info{Tag{0x0018, 0x9059}, "CS", "Decoupling", "1"}
-	tagDict[Tag{0x0018, 0x9060}] = Info{Tag{0x0018, 0x9060}, "CS", "DecoupledNucleus", "1-2"}
-	tagDict[Tag{0x0018, 0x9061}] = Info{Tag{0x0018, 0x9061}, "FD", "DecouplingFrequency", "1-2"}
-	tagDict[Tag{0x0018, 0x9062}] = Info{Tag{0x0018, 0x9062}, "CS", "DecouplingMethod", "1"}
-	tagDict[Tag{0x0018, 0x9063}] = Info{Tag{0x0018, 0x9063}, "FD", "DecouplingChemicalShiftReference", "1-2"}
-	tagDict[Tag{0x0018, 0x9064}] = Info{Tag{0x0018, 0x9064}, "CS", "KSpaceFiltering", "1"}
-	tagDict[Tag{0x0018, 0x9065}] = Info{Tag{0x0018, 0x9065}, "CS", "TimeDomainFiltering", "1-2"}
-	tagDict[Tag{0x0018, 0x9066}] = Info{Tag{0x0018, 0x9066}, "US", "NumberOfZeroFills", "1-2"}
-	tagDict[Tag{0x0018, 0x9067}] = Info{Tag{0x0018, 0x9067}, "CS", "BaselineCorrection", "1"}
-	tagDict[Tag{0x0018, 0x9069}] = Info{Tag{0x0018, 0x9069}, "FD", "ParallelReductionFactorInPlane", "1"}
-	tagDict[Tag{0x0018, 0x9070}] = Info{Tag{0x0018, 0x9070}, "FD", "CardiacRRIntervalSpecified", "1"}
-	tagDict[Tag{0x0018, 0x9073}] = Info{Tag{0x0018, 0x9073}, "FD", "AcquisitionDuration", "1"}
-	tagDict[Tag{0x0018, 0x9074}] = Info{Tag{0x0018, 0x9074}, "DT", "FrameAcquisitionDateTime", "1"}
-	tagDict[Tag{0x0018, 0x9075}] = Info{Tag{0x0018, 0x9075}, "CS", "DiffusionDirectionality", "1"}
-	tagDict[Tag{0x0018, 0x9076}] = Info{Tag{0x0018, 0x9076}, "SQ", "DiffusionGradientDirectionSequence", "1"}
-	tagDict[Tag{0x0018, 0x9077}] = Info{Tag{0x0018, 0x9077}, "CS", "ParallelAcquisition", "1"}
-	tagDict[Tag{0x0018, 0x9078}] = Info{Tag{0x0018, 0x9078}, "CS", "ParallelAcquisitionTechnique", "1"}
-	tagDict[Tag{0x0018, 0x9079}] = Info{Tag{0x0018, 0x9079}, "FD", "InversionTimes", "1-n"}
-	tagDict[Tag{0x0018, 0x9080}] = Info{Tag{0x0018, 0x9080}, "ST", "MetaboliteMapDescription", "1"}
-	tagDict[Tag{0x0018, 0x9081}] = Info{Tag{0x0018, 0x9081}, "CS", "PartialFourier", "1"}
-	tagDict[Tag{0x0018, 0x9082}] = Info{Tag{0x0018, 0x9082}, "FD", "EffectiveEchoTime", "1"}
-	tagDict[Tag{0x0018, 0x9083}] = Info{Tag{0x0018, 0x9083}, "SQ", "MetaboliteMapCodeSequence", "1"}
-	tagDict[Tag{0x0018, 0x9084}] = Info{Tag{0x0018, 0x9084}, "SQ", "ChemicalShiftSequence", "1"}
-	tagDict[Tag{0x0018, 0x9085}] = Info{Tag{0x0018, 0x9085}, "CS", "CardiacSignalSource", "1"}
-	tagDict[Tag{0x0018, 0x9087}] = Info{Tag{0x0018, 0x9087}, "FD", "DiffusionBValue", "1"}
-	tagDict[Tag{0x0018, 0x9089}] = Info{Tag{0x0018, 0x9089}, "FD", "DiffusionGradientOrientation", "3"}
-	tagDict[Tag{0x0018, 0x9090}] = Info{Tag{0x0018, 0x9090}, "FD", "VelocityEncodingDirection", "3"}
-	tagDict[Tag{0x0018, 0x9091}] = Info{Tag{0x0018, 0x9091}, "FD", "VelocityEncodingMinimumValue", "1"}
-	tagDict[Tag{0x0018, 0x9092}] = Info{Tag{0x0018, 0x9092}, "SQ", "VelocityEncodingAcquisitionSequence", "1"}
-	tagDict[Tag{0x0018, 0x9093}] = Info{Tag{0x0018, 0x9093}, "US", "NumberOfKSpaceTrajectories", "1"}
-	tagDict[Tag{0x0018, 0x9094}] = Info{Tag{0x0018, 0x9094}, "CS", "CoverageOfKSpace", "1"}
-	tagDict[Tag{0x0018, 0x9095}] = Info{Tag{0x0018, 0x9095}, "UL", "SpectroscopyAcquisitionPhaseRows", "1"}
-	tagDict[Tag{0x0018, 0x9098}] = Info{Tag{0x0018, 0x9098}, "FD", "TransmitterFrequency", "1-2"}
-	tagDict[Tag{0x0018, 0x9100}] = Info{Tag{0x0018, 0x9100}, "CS", "ResonantNucleus", "1-2"}
-	tagDict[Tag{0x0018, 0x9101}] = Info{Tag{0x0018, 0x9101}, "CS", "FrequencyCorrection", "1"}
-	tagDict[Tag{0x0018, 0x9103}] = Info{Tag{0x0018, 0x9103}, "SQ", "MRSpectroscopyFOVGeometrySequence", "1"}
-	tagDict[Tag{0x0018, 0x9104}] = Info{Tag{0x0018, 0x9104}, "FD", "SlabThickness", "1"}
-	tagDict[Tag{0x0018, 0x9105}] = Info{Tag{0x0018, 0x9105}, "FD", "SlabOrientation", "3"}
-	tagDict[Tag{0x0018, 0x9106}] = Info{Tag{0x0018, 0x9106}, "FD", "MidSlabPosition", "3"}
-	tagDict[Tag{0x0018, 0x9107}] = Info{Tag{0x0018, 0x9107}, "SQ", "MRSpatialSaturationSequence", "1"}
-	tagDict[Tag{0x0018, 0x9112}] = Info{Tag{0x0018, 0x9112}, "SQ", "MRTimingAndRelatedParametersSequence", "1"}
-	tagDict[Tag{0x0018, 0x9114}] = Info{Tag{0x0018, 0x9114}, "SQ", "MREchoSequence", "1"}
-	tagDict[Tag{0x0018, 0x9115}] = Info{Tag{0x0018, 0x9115}, "SQ", "MRModifierSequence", "1"}
-	tagDict[Tag{0x0018, 0x9117}] = Info{Tag{0x0018, 0x9117}, "SQ", "MRDiffusionSequence", "1"}
-	tagDict[Tag{0x0018, 0x9118}] = Info{Tag{0x0018, 0x9118}, "SQ", "CardiacSynchronizationSequence", "1"}
-	tagDict[Tag{0x0018, 0x9119}] = Info{Tag{0x0018, 0x9119}, "SQ", "MRAveragesSequence", "1"}
-	tagDict[Tag{0x0018, 0x9125}] = Info{Tag{0x0018, 0x9125}, "SQ", "MRFOVGeometrySequence", "1"}
-	tagDict[Tag{0x0018, 0x9126}] = Info{Tag{0x0018, 0x9126}, "SQ", "VolumeLocalizationSequence", "1"}
-	tagDict[Tag{0x0018, 0x9127}] = Info{Tag{0x0018, 0x9127}, "UL", "SpectroscopyAcquisitionDataColumns", "1"}
-	tagDict[Tag{0x0018, 0x9147}] = Info{Tag{0x0018, 0x9147}, "CS", "DiffusionAnisotropyType", "1"}
-	tagDict[Tag{0x0018, 0x9151}] = Info{Tag{0x0018, 0x9151}, "DT", "FrameReferenceDateTime", "1"}
-	tagDict[Tag{0x0018, 0x9152}] = Info{Tag{0x0018, 0x9152}, "SQ", "MRMetaboliteMapSequence", "1"}
-	tagDict[Tag{0x0018, 0x9155}] = Info{Tag{0x0018, 0x9155}, "FD", "ParallelReductionFactorOutOfPlane", "1"}
-	tagDict[Tag{0x0018, 0x9159}] = Info{Tag{0x0018, 0x9159}, "UL", "SpectroscopyAcquisitionOutOfPlanePhaseSteps", "1"}
-	tagDict[Tag{0x0018, 0x9168}] = Info{Tag{0x0018, 0x9168}, "FD", "ParallelReductionFactorSecondInPlane", "1"}
-	tagDict[Tag{0x0018, 0x9169}] = Info{Tag{0x0018, 0x9169}, "CS", "CardiacBeatRejectionTechnique", "1"}
-	tagDict[Tag{0x0018, 0x9170}] = Info{Tag{0x0018, 0x9170}, "CS", "RespiratoryMotionCompensationTechnique", "1"}
-	tagDict[Tag{0x0018, 0x9171}] = Info{Tag{0x0018, 0x9171}, "CS", "RespiratorySignalSource", "1"}
-	tagDict[Tag{0x0018, 0x9172}] = Info{Tag{0x0018, 0x9172}, "CS", "BulkMotionCompensationTechnique", "1"}
-	tagDict[Tag{0x0018, 0x9173}] = Info{Tag{0x0018, 0x9173}, "CS", "BulkMotionSignalSource", "1"}
-	tagDict[Tag{0x0018, 0x9174}] = Info{Tag{0x0018, 0x9174}, "CS", "ApplicableSafetyStandardAgency", "1"}
-	tagDict[Tag{0x0018, 0x9175}] = Info{Tag{0x0018, 0x9175}, "LO", "ApplicableSafetyStandardDescription", "1"}
-	tagDict[Tag{0x0018, 0x9176}] = Info{Tag{0x0018, 0x9176}, "SQ", "OperatingModeSequence", "1"}
-	tagDict[Tag{0x0018, 0x9177}] = Info{Tag{0x0018, 0x9177}, "CS", "OperatingModeType", "1"}
-	tagDict[Tag{0x0018, 0x9178}] = Info{Tag{0x0018, 0x9178}, "CS", "OperatingMode", "1"}
-	tagDict[Tag{0x0018, 0x9179}] = Info{Tag{0x0018, 0x9179}, "CS", "SpecificAbsorptionRateDefinition", "1"}
-	tagDict[Tag{0x0018, 0x9180}] = Info{Tag{0x0018, 0x9180}, "CS", "GradientOutputType", "1"}
-	tagDict[Tag{0x0018, 0x9181}] = Info{Tag{0x0018, 0x9181}, "FD", "SpecificAbsorptionRateValue", "1"}
-	tagDict[Tag{0x0018, 0x9182}] = Info{Tag{0x0018, 0x9182}, "FD", "GradientOutput", "1"}
-	tagDict[Tag{0x0018, 0x9183}] = Info{Tag{0x0018, 0x9183}, "CS", "FlowCompensationDirection", "1"}
-	tagDict[Tag{0x0018, 0x9184}] = Info{Tag{0x0018, 0x9184}, "FD", "TaggingDelay", "1"}
-	tagDict[Tag{0x0018, 0x9185}] = Info{Tag{0x0018, 0x9185}, "ST", "RespiratoryMotionCompensationTechniqueDescription", "1"}
-	tagDict[Tag{0x0018, 0x9186}] = Info{Tag{0x0018, 0x9186}, "SH", "RespiratorySignalSourceID", "1"}
-	tagDict[Tag{0x0018, 0x9197}] = Info{Tag{0x0018, 0x9197}, "SQ", "MRVelocityEncodingSequence", "1"}
-	tagDict[Tag{0x0018, 0x9198}] = Info{Tag{0x0018, 0x9198}, "CS", "FirstOrderPhaseCorrection", "1"}
-	tagDict[Tag{0x0018, 0x9199}] = Info{Tag{0x0018, 0x9199}, "CS", "WaterReferencedPhaseCorrection", "1"}
-	tagDict[Tag{0x0018, 0x9200}] = Info{Tag{0x0018, 0x9200}, "CS", "MRSpectroscopyAcquisitionType", "1"}
-	tagDict[Tag{0x0018, 0x9214}] = Info{Tag{0x0018, 0x9214}, "CS", "RespiratoryCyclePosition", "1"}
-	tagDict[Tag{0x0018, 0x9217}] = Info{Tag{0x0018, 0x9217}, "FD", "VelocityEncodingMaximumValue", "1"}
-	tagDict[Tag{0x0018, 0x9218}] = Info{Tag{0x0018, 0x9218}, "FD", "TagSpacingSecondDimension", "1"}
-	tagDict[Tag{0x0018, 0x9219}] = Info{Tag{0x0018, 0x9219}, "SS", "TagAngleSecondAxis", "1"}
-	tagDict[Tag{0x0018, 0x9220}] = Info{Tag{0x0018, 0x9220}, "FD", "FrameAcquisitionDuration", "1"}
-	tagDict[Tag{0x0018, 0x9226}] = Info{Tag{0x0018, 0x9226}, "SQ", "MRImageFrameTypeSequence", "1"}
-	tagDict[Tag{0x0018, 0x9227}] = Info{Tag{0x0018, 0x9227}, "SQ", "MRSpectroscopyFrameTypeSequence", "1"}
-	tagDict[Tag{0x0018, 0x9231}] = Info{Tag{0x0018, 0x9231}, "US", "MRAcquisitionPhaseEncodingStepsInPlane", "1"}
-	tagDict[Tag{0x0018, 0x9232}] = Info{Tag{0x0018, 0x9232}, "US", "MRAcquisitionPhaseEncodingStepsOutOfPlane", "1"}
-	tagDict[Tag{0x0018, 0x9234}] = Info{Tag{0x0018, 0x9234}, "UL", "SpectroscopyAcquisitionPhaseColumns", "1"}
-	tagDict[Tag{0x0018, 0x9236}] = Info{Tag{0x0018, 0x9236}, "CS", "CardiacCyclePosition", "1"}
-	tagDict[Tag{0x0018, 0x9239}] = Info{Tag{0x0018, 0x9239}, "SQ", "SpecificAbsorptionRateSequence", "1"}
-	tagDict[Tag{0x0018, 0x9240}] = Info{Tag{0x0018, 0x9240}, "US", "RFEchoTrainLength", "1"}
-	tagDict[Tag{0x0018, 0x9241}] = Info{Tag{0x0018, 0x9241}, "US", "GradientEchoTrainLength", "1"}
-	tagDict[Tag{0x0018, 0x9250}] = Info{Tag{0x0018, 0x9250}, "CS", "ArterialSpinLabelingContrast", "1"}
-	tagDict[Tag{0x0018, 0x9251}] = Info{Tag{0x0018, 0x9251}, "SQ", "MRArterialSpinLabelingSequence", "1"}
-	tagDict[Tag{0x0018, 0x9252}] = Info{Tag{0x0018, 0x9252}, "LO", "ASLTechniqueDescription", "1"}
-	tagDict[Tag{0x0018, 0x9253}] = Info{Tag{0x0018, 0x9253}, "US", "ASLSlabNumber", "1"}
-	tagDict[Tag{0x0018, 0x9254}] = Info{Tag{0x0018, 0x9254}, "FD", "ASLSlabThickness", "1"}
-	tagDict[Tag{0x0018, 0x9255}] = Info{Tag{0x0018, 0x9255}, "FD", "ASLSlabOrientation", "3"}
-	tagDict[Tag{0x0018, 0x9256}] = Info{Tag{0x0018, 0x9256}, "FD", "ASLMidSlabPosition", "3"}
-	tagDict[Tag{0x0018, 0x9257}] = Info{Tag{0x0018, 0x9257}, "CS", "ASLContext", "1"}
-	tagDict[Tag{0x0018, 0x9258}] = Info{Tag{0x0018, 0x9258}, "UL", "ASLPulseTrainDuration", "1"}
-	tagDict[Tag{0x0018, 0x9259}] = Info{Tag{0x0018, 0x9259}, "CS", "ASLCrusherFlag", "1"}
-	tagDict[Tag{0x0018, 0x925A}] = Info{Tag{0x0018, 0x925A}, "FD", "ASLCrusherFlow", "1"}
-	tagDict[Tag{0x0018, 0x925B}] = Info{Tag{0x0018, 0x925B}, "LO", "ASLCrusherDescription", "1"}
-	tagDict[Tag{0x0018, 0x925C}] = Info{Tag{0x0018, 0x925C}, "CS", "ASLBolusCutoffFlag", "1"}
-	tagDict[Tag{0x0018, 0x925D}] = Info{Tag{0x0018, 0x925D}, "SQ", "ASLBolusCutoffTimingSequence", "1"}
-	tagDict[Tag{0x0018, 0x925E}] = Info{Tag{0x0018, 0x925E}, "LO", "ASLBolusCutoffTechnique", "1"}
-	tagDict[Tag{0x0018, 0x925F}] = Info{Tag{0x0018, 0x925F}, "UL", "ASLBolusCutoffDelayTime", "1"}
-	tagDict[Tag{0x0018, 0x9260}] = Info{Tag{0x0018, 0x9260}, "SQ", "ASLSlabSequence", "1"}
-	tagDict[Tag{0x0018, 0x9295}] = Info{Tag{0x0018, 0x9295}, "FD", "ChemicalShiftMinimumIntegrationLimitInppm", "1"}
-	tagDict[Tag{0x0018, 0x9296}] = Info{Tag{0x0018, 0x9296}, "FD", "ChemicalShiftMaximumIntegrationLimitInppm", "1"}
-	tagDict[Tag{0x0018, 0x9301}] = Info{Tag{0x0018, 0x9301}, "SQ", "CTAcquisitionTypeSequence", "1"}
-	tagDict[Tag{0x0018, 0x9302}] = Info{Tag{0x0018, 0x9302}, "CS", "AcquisitionType", "1"}
-	tagDict[Tag{0x0018, 0x9303}] = Info{Tag{0x0018, 0x9303}, "FD", "TubeAngle", "1"}
-	tagDict[Tag{0x0018, 0x9304}] = Info{Tag{0x0018, 0x9304}, "SQ", "CTAcquisitionDetailsSequence", "1"}
-	tagDict[Tag{0x0018, 0x9305}] = Info{Tag{0x0018, 0x9305}, "FD", "RevolutionTime", "1"}
-	tagDict[Tag{0x0018, 0x9306}] = Info{Tag{0x0018, 0x9306}, "FD", "SingleCollimationWidth", "1"}
-	tagDict[Tag{0x0018, 0x9307}] = Info{Tag{0x0018, 0x9307}, "FD", "TotalCollimationWidth", "1"}
-	tagDict[Tag{0x0018, 0x9308}] = Info{Tag{0x0018, 0x9308}, "SQ", "CTTableDynamicsSequence", "1"}
-	tagDict[Tag{0x0018, 0x9309}] = Info{Tag{0x0018, 0x9309}, "FD", "TableSpeed", "1"}
-	tagDict[Tag{0x0018, 0x9310}] = Info{Tag{0x0018, 0x9310}, "FD", "TableFeedPerRotation", "1"}
-	tagDict[Tag{0x0018, 0x9311}] = Info{Tag{0x0018, 0x9311}, "FD", "SpiralPitchFactor", "1"}
-	tagDict[Tag{0x0018, 0x9312}] = Info{Tag{0x0018, 0x9312}, "SQ", "CTGeometrySequence", "1"}
-	tagDict[Tag{0x0018, 0x9313}] = Info{Tag{0x0018, 0x9313}, "FD", "DataCollectionCenterPatient", "3"}
-	tagDict[Tag{0x0018, 0x9314}] = Info{Tag{0x0018, 0x9314}, "SQ", "CTReconstructionSequence", "1"}
-	tagDict[Tag{0x0018, 0x9315}] = Info{Tag{0x0018, 0x9315}, "CS", "ReconstructionAlgorithm", "1"}
-	tagDict[Tag{0x0018, 0x9316}] = Info{Tag{0x0018, 0x9316}, "CS", "ConvolutionKernelGroup", "1"}
-	tagDict[Tag{0x0018, 0x9317}] = Info{Tag{0x0018, 0x9317}, "FD", "ReconstructionFieldOfView", "2"}
-	tagDict[Tag{0x0018, 0x9318}] = Info{Tag{0x0018, 0x9318}, "FD", "ReconstructionTargetCenterPatient", "3"}
-	tagDict[Tag{0x0018, 0x9319}] = Info{Tag{0x0018, 0x9319}, "FD", "ReconstructionAngle", "1"}
-	tagDict[Tag{0x0018, 0x9320}] = Info{Tag{0x0018, 0x9320}, "SH", "ImageFilter", "1"}
-	tagDict[Tag{0x0018, 0x9321}] = Info{Tag{0x0018, 0x9321}, "SQ", "CTExposureSequence", "1"}
-	tagDict[Tag{0x0018, 0x9322}] = Info{Tag{0x0018, 0x9322}, "FD", "ReconstructionPixelSpacing", "2"}
-	tagDict[Tag{0x0018, 0x9323}] = Info{Tag{0x0018, 0x9323}, "CS", "ExposureModulationType", "1"}
-	tagDict[Tag{0x0018, 0x9324}] = Info{Tag{0x0018, 0x9324}, "FD", "EstimatedDoseSaving", "1"}
-	tagDict[Tag{0x0018, 0x9325}] = Info{Tag{0x0018, 0x9325}, "SQ", "CTXRayDetailsSequence", "1"}
-	tagDict[Tag{0x0018, 0x9326}] = Info{Tag{0x0018, 0x9326}, "SQ", "CTPositionSequence", "1"}
-	tagDict[Tag{0x0018, 0x9327}] = Info{Tag{0x0018, 0x9327}, "FD", "TablePosition", "1"}
-	tagDict[Tag{0x0018, 0x9328}] = Info{Tag{0x0018, 0x9328}, "FD", "ExposureTimeInms", "1"}
-	tagDict[Tag{0x0018, 0x9329}] = Info{Tag{0x0018, 0x9329}, "SQ", "CTImageFrameTypeSequence", "1"}
-	tagDict[Tag{0x0018, 0x9330}] = Info{Tag{0x0018, 0x9330}, "FD", "XRayTubeCurrentInmA", "1"}
-	tagDict[Tag{0x0018, 0x9332}] = Info{Tag{0x0018, 0x9332}, "FD", "ExposureInmAs", "1"}
-	tagDict[Tag{0x0018, 0x9333}] = Info{Tag{0x0018, 0x9333}, "CS", "ConstantVolumeFlag", "1"}
-	tagDict[Tag{0x0018, 0x9334}] = Info{Tag{0x0018, 0x9334}, "CS", "FluoroscopyFlag", "1"}
-	tagDict[Tag{0x0018, 0x9335}] = Info{Tag{0x0018, 0x9335}, "FD", "DistanceSourceToDataCollectionCenter", "1"}
-	tagDict[Tag{0x0018, 0x9337}] = Info{Tag{0x0018, 0x9337}, "US", "ContrastBolusAgentNumber", "1"}
-	tagDict[Tag{0x0018, 0x9338}] = Info{Tag{0x0018, 0x9338}, "SQ", "ContrastBolusIngredientCodeSequence", "1"}
-	tagDict[Tag{0x0018, 0x9340}] = Info{Tag{0x0018, 0x9340}, "SQ", "ContrastAdministrationProfileSequence", "1"}
-	tagDict[Tag{0x0018, 0x9341}] = Info{Tag{0x0018, 0x9341}, "SQ", "ContrastBolusUsageSequence", "1"}
-	tagDict[Tag{0x0018, 0x9342}] = Info{Tag{0x0018, 0x9342}, "CS", "ContrastBolusAgentAdministered", "1"}
-	tagDict[Tag{0x0018, 0x9343}] = Info{Tag{0x0018, 0x9343}, "CS", "ContrastBolusAgentDetected", "1"}
-	tagDict[Tag{0x0018, 0x9344}] = Info{Tag{0x0018, 0x9344}, "CS", "ContrastBolusAgentPhase", "1"}
-	tagDict[Tag{0x0018, 0x9345}] = Info{Tag{0x0018, 0x9345}, "FD", "CTDIvol", "1"}
-	tagDict[Tag{0x0018, 0x9346}] = Info{Tag{0x0018, 0x9346}, "SQ", "CTDIPhantomTypeCodeSequence", "1"}
-	tagDict[Tag{0x0018, 0x9351}] = Info{Tag{0x0018, 0x9351}, "FL", "CalciumScoringMassFactorPatient", "1"}
-	tagDict[Tag{0x0018, 0x9352}] = Info{Tag{0x0018, 0x9352}, "FL", "CalciumScoringMassFactorDevice", "3"}
-	tagDict[Tag{0x0018, 0x9353}] = Info{Tag{0x0018, 0x9353}, "FL", "EnergyWeightingFactor", "1"}
-	tagDict[Tag{0x0018, 0x9360}] = Info{Tag{0x0018, 0x9360}, "SQ", "CTAdditionalXRaySourceSequence", "1"}
-	tagDict[Tag{0x0018, 0x9401}] = Info{Tag{0x0018, 0x9401}, "SQ", "ProjectionPixelCalibrationSequence", "1"}
-	tagDict[Tag{0x0018, 0x9402}] = Info{Tag{0x0018, 0x9402}, "FL", "DistanceSourceToIsocenter", "1"}
-	tagDict[Tag{0x0018, 0x9403}] = Info{Tag{0x0018, 0x9403}, "FL", "DistanceObjectToTableTop", "1"}
-	tagDict[Tag{0x0018, 0x9404}] = Info{Tag{0x0018, 0x9404}, "FL", "ObjectPixelSpacingInCenterOfBeam", "2"}
-	tagDict[Tag{0x0018, 0x9405}] = Info{Tag{0x0018, 0x9405}, "SQ", "PositionerPositionSequence", "1"}
-	tagDict[Tag{0x0018, 0x9406}] = Info{Tag{0x0018, 0x9406}, "SQ", "TablePositionSequence", "1"}
-	tagDict[Tag{0x0018, 0x9407}] = Info{Tag{0x0018, 0x9407}, "SQ", "CollimatorShapeSequence", "1"}
-	tagDict[Tag{0x0018, 0x9410}] = Info{Tag{0x0018, 0x9410}, "CS", "PlanesInAcquisition", "1"}
-	tagDict[Tag{0x0018, 0x9412}] = Info{Tag{0x0018, 0x9412}, "SQ", "XAXRFFrameCharacteristicsSequence", "1"}
-	tagDict[Tag{0x0018, 0x9417}] = Info{Tag{0x0018, 0x9417}, "SQ", "FrameAcquisitionSequence", "1"}
-	tagDict[Tag{0x0018, 0x9420}] = Info{Tag{0x0018, 0x9420}, "CS", "XRayReceptorType", "1"}
-	tagDict[Tag{0x0018, 0x9423}] = Info{Tag{0x0018, 0x9423}, "LO", "AcquisitionProtocolName", "1"}
-	tagDict[Tag{0x0018, 0x9424}] = Info{Tag{0x0018, 0x9424}, "LT", "AcquisitionProtocolDescription", "1"}
-	tagDict[Tag{0x0018, 0x9425}] = Info{Tag{0x0018, 0x9425}, "CS", "ContrastBolusIngredientOpaque", "1"}
-	tagDict[Tag{0x0018, 0x9426}] = Info{Tag{0x0018, 0x9426}, "FL", "DistanceReceptorPlaneToDetectorHousing", "1"}
-	tagDict[Tag{0x0018, 0x9427}] = Info{Tag{0x0018, 0x9427}, "CS", "IntensifierActiveShape", "1"}
-	tagDict[Tag{0x0018, 0x9428}] = Info{Tag{0x0018, 0x9428}, "FL", "IntensifierActiveDimensions", "1-2"}
-	tagDict[Tag{0x0018, 0x9429}] = Info{Tag{0x0018, 0x9429}, "FL", "PhysicalDetectorSize", "2"}
-	tagDict[Tag{0x0018, 0x9430}] = Info{Tag{0x0018, 0x9430}, "FL", "PositionOfIsocenterProjection", "2"}
-	tagDict[Tag{0x0018, 0x9432}] = Info{Tag{0x0018, 0x9432}, "SQ", "FieldOfViewSequence", "1"}
-	tagDict[Tag{0x0018, 0x9433}] = Info{Tag{0x0018, 0x9433}, "LO", "FieldOfViewDescription", "1"}
-	tagDict[Tag{0x0018, 0x9434}] = Info{Tag{0x0018, 0x9434}, "SQ", "ExposureControlSensingRegionsSequence", "1"}
-	tagDict[Tag{0x0018, 0x9435}] = Info{Tag{0x0018, 0x9435}, "CS", "ExposureControlSensingRegionShape", "1"}
-	tagDict[Tag{0x0018, 0x9436}] = Info{Tag{0x0018, 0x9436}, "SS", "ExposureControlSensingRegionLeftVerticalEdge", "1"}
-	tagDict[Tag{0x0018, 0x9437}] = Info{Tag{0x0018, 0x9437}, "SS", "ExposureControlSensingRegionRightVerticalEdge", "1"}
-	tagDict[Tag{0x0018, 0x9438}] = Info{Tag{0x0018, 0x9438}, "SS", "ExposureControlSensingRegionUpperHorizontalEdge", "1"}
-	tagDict[Tag{0x0018, 0x9439}] = Info{Tag{0x0018, 0x9439}, "SS", "ExposureControlSensingRegionLowerHorizontalEdge", "1"}
-	tagDict[Tag{0x0018, 0x9440}] = Info{Tag{0x0018, 0x9440}, "SS", "CenterOfCircularExposureControlSensingRegion", "2"}
-	tagDict[Tag{0x0018, 0x9441}] = Info{Tag{0x0018, 0x9441}, "US", "RadiusOfCircularExposureControlSensingRegion", "1"}
-	tagDict[Tag{0x0018, 0x9442}] = Info{Tag{0x0018, 0x9442}, "SS", "VerticesOfThePolygonalExposureControlSensingRegion", "2-n"}
-	tagDict[Tag{0x0018, 0x9447}] = Info{Tag{0x0018, 0x9447}, "FL", "ColumnAngulationPatient", "1"}
-	tagDict[Tag{0x0018, 0x9449}] = Info{Tag{0x0018, 0x9449}, "FL", "BeamAngle", "1"}
-	tagDict[Tag{0x0018, 0x9451}] = Info{Tag{0x0018, 0x9451}, "SQ", "FrameDetectorParametersSequence", "1"}
-	tagDict[Tag{0x0018, 0x9452}] = Info{Tag{0x0018, 0x9452}, "FL", "CalculatedAnatomyThickness", "1"}
-	tagDict[Tag{0x0018, 0x9455}] = Info{Tag{0x0018, 0x9455}, "SQ", "CalibrationSequence", "1"}
-	tagDict[Tag{0x0018, 0x9456}] = Info{Tag{0x0018, 0x9456}, "SQ", "ObjectThicknessSequence", "1"}
-	tagDict[Tag{0x0018, 0x9457}] = Info{Tag{0x0018, 0x9457}, "CS", "PlaneIdentification", "1"}
-	tagDict[Tag{0x0018, 0x9461}] = Info{Tag{0x0018, 0x9461}, "FL", "FieldOfViewDimensionsInFloat", "1-2"}
-	tagDict[Tag{0x0018, 0x9462}] = Info{Tag{0x0018, 0x9462}, "SQ", "IsocenterReferenceSystemSequence", "1"}
-	tagDict[Tag{0x0018, 0x9463}] = Info{Tag{0x0018, 0x9463}, "FL", "PositionerIsocenterPrimaryAngle", "1"}
-	tagDict[Tag{0x0018, 0x9464}] = Info{Tag{0x0018, 0x9464}, "FL", "PositionerIsocenterSecondaryAngle", "1"}
-	tagDict[Tag{0x0018, 0x9465}] = Info{Tag{0x0018, 0x9465}, "FL", "PositionerIsocenterDetectorRotationAngle", "1"}
-	tagDict[Tag{0x0018, 0x9466}] = Info{Tag{0x0018, 0x9466}, "FL", "TableXPositionToIsocenter", "1"}
-	tagDict[Tag{0x0018, 0x9467}] = Info{Tag{0x0018, 0x9467}, "FL", "TableYPositionToIsocenter", "1"}
-	tagDict[Tag{0x0018, 0x9468}] = Info{Tag{0x0018, 0x9468}, "FL", "TableZPositionToIsocenter", "1"}
-	tagDict[Tag{0x0018, 0x9469}] = Info{Tag{0x0018, 0x9469}, "FL", "TableHorizontalRotationAngle", "1"}
-	tagDict[Tag{0x0018, 0x9470}] = Info{Tag{0x0018, 0x9470}, "FL", "TableHeadTiltAngle", "1"}
-	tagDict[Tag{0x0018, 0x9471}] = Info{Tag{0x0018, 0x9471}, "FL", "TableCradleTiltAngle", "1"}
-	tagDict[Tag{0x0018, 0x9472}] = Info{Tag{0x0018, 0x9472}, "SQ", "FrameDisplayShutterSequence", "1"}
-	tagDict[Tag{0x0018, 0x9473}] = Info{Tag{0x0018, 0x9473}, "FL", "AcquiredImageAreaDoseProduct", "1"}
-	tagDict[Tag{0x0018, 0x9474}] = Info{Tag{0x0018, 0x9474}, "CS", "CArmPositionerTabletopRelationship", "1"}
-	tagDict[Tag{0x0018, 0x9476}] = Info{Tag{0x0018, 0x9476}, "SQ", "XRayGeometrySequence", "1"}
-	tagDict[Tag{0x0018, 0x9477}] = Info{Tag{0x0018, 0x9477}, "SQ", "IrradiationEventIdentificationSequence", "1"}
-	tagDict[Tag{0x0018, 0x9504}] = Info{Tag{0x0018, 0x9504}, "SQ", "XRay3DFrameTypeSequence", "1"}
-	tagDict[Tag{0x0018, 0x9506}] = Info{Tag{0x0018, 0x9506}, "SQ", "ContributingSourcesSequence", "1"}
-	tagDict[Tag{0x0018, 0x9507}] = Info{Tag{0x0018, 0x9507}, "SQ", "XRay3DAcquisitionSequence", "1"}
-	tagDict[Tag{0x0018, 0x9508}] = Info{Tag{0x0018, 0x9508}, "FL", "PrimaryPositionerScanArc", "1"}
-	tagDict[Tag{0x0018, 0x9509}] = Info{Tag{0x0018, 0x9509}, "FL", "SecondaryPositionerScanArc", "1"}
-	tagDict[Tag{0x0018, 0x9510}] = Info{Tag{0x0018, 0x9510}, "FL", "PrimaryPositionerScanStartAngle", "1"}
-	tagDict[Tag{0x0018, 0x9511}] = Info{Tag{0x0018, 0x9511}, "FL", "SecondaryPositionerScanStartAngle", "1"}
-	tagDict[Tag{0x0018, 0x9514}] = Info{Tag{0x0018, 0x9514}, "FL", "PrimaryPositionerIncrement", "1"}
-	tagDict[Tag{0x0018, 0x9515}] = Info{Tag{0x0018, 0x9515}, "FL", "SecondaryPositionerIncrement", "1"}
-	tagDict[Tag{0x0018, 0x9516}] = Info{Tag{0x0018, 0x9516}, "DT", "StartAcquisitionDateTime", "1"}
-	tagDict[Tag{0x0018, 0x9517}] = Info{Tag{0x0018, 0x9517}, "DT", "EndAcquisitionDateTime", "1"}
-	tagDict[Tag{0x0018, 0x9524}] = Info{Tag{0x0018, 0x9524}, "LO", "ApplicationName", "1"}
-	tagDict[Tag{0x0018, 0x9525}] = Info{Tag{0x0018, 0x9525}, "LO", "ApplicationVersion", "1"}
-	tagDict[Tag{0x0018, 0x9526}] = Info{Tag{0x0018, 0x9526}, "LO", "ApplicationManufacturer", "1"}
-	tagDict[Tag{0x0018, 0x9527}] = Info{Tag{0x0018, 0x9527}, "CS", "AlgorithmType", "1"}
-	tagDict[Tag{0x0018, 0x9528}] = Info{Tag{0x0018, 0x9528}, "LO", "AlgorithmDescription", "1"}
-	tagDict[Tag{0x0018, 0x9530}] = Info{Tag{0x0018, 0x9530}, "SQ", "XRay3DReconstructionSequence", "1"}
-	tagDict[Tag{0x0018, 0x9531}] = Info{Tag{0x0018, 0x9531}, "LO", "ReconstructionDescription", "1"}
-	tagDict[Tag{0x0018, 0x9538}] = Info{Tag{0x0018, 0x9538}, "SQ", "PerProjectionAcquisitionSequence", "1"}
-	tagDict[Tag{0x0018, 0x9601}] = Info{Tag{0x0018, 0x9601}, "SQ", "DiffusionBMatrixSequence", "1"}
-	tagDict[Tag{0x0018, 0x9602}] = Info{Tag{0x0018, 0x9602}, "FD", "DiffusionBValueXX", "1"}
-	tagDict[Tag{0x0018, 0x9603}] = Info{Tag{0x0018, 0x9603}, "FD", "DiffusionBValueXY", "1"}
-	tagDict[Tag{0x0018, 0x9604}] = Info{Tag{0x0018, 0x9604}, "FD", "DiffusionBValueXZ", "1"}
-	tagDict[Tag{0x0018, 0x9605}] = Info{Tag{0x0018, 0x9605}, "FD", "DiffusionBValueYY", "1"}
-	tagDict[Tag{0x0018, 0x9606}] = Info{Tag{0x0018, 0x9606}, "FD", "DiffusionBValueYZ", "1"}
-	tagDict[Tag{0x0018, 0x9607}] = Info{Tag{0x0018, 0x9607}, "FD", "DiffusionBValueZZ", "1"}
-	tagDict[Tag{0x0018, 0x9701}] = Info{Tag{0x0018, 0x9701}, "DT", "DecayCorrectionDateTime", "1"}
-	tagDict[Tag{0x0018, 0x9715}] = Info{Tag{0x0018, 0x9715}, "FD", "StartDensityThreshold", "1"}
-	tagDict[Tag{0x0018, 0x9716}] = Info{Tag{0x0018, 0x9716}, "FD", "StartRelativeDensityDifferenceThreshold", "1"}
-	tagDict[Tag{0x0018, 0x9717}] = Info{Tag{0x0018, 0x9717}, "FD", "StartCardiacTriggerCountThreshold", "1"}
-	tagDict[Tag{0x0018, 0x9718}] = Info{Tag{0x0018, 0x9718}, "FD", "StartRespiratoryTriggerCountThreshold", "1"}
-	tagDict[Tag{0x0018, 0x9719}] = Info{Tag{0x0018, 0x9719}, "FD", "TerminationCountsThreshold", "1"}
-	tagDict[Tag{0x0018, 0x9720}] = Info{Tag{0x0018, 0x9720}, "FD", "TerminationDensityThreshold", "1"}
-	tagDict[Tag{0x0018, 0x9721}] = Info{Tag{0x0018, 0x9721}, "FD", "TerminationRelativeDensityThreshold", "1"}
-	tagDict[Tag{0x0018, 0x9722}] = Info{Tag{0x0018, 0x9722}, "FD", "TerminationTimeThreshold", "1"}
-	tagDict[Tag{0x0018, 0x9723}] = Info{Tag{0x0018, 0x9723}, "FD", "TerminationCardiacTriggerCountThreshold", "1"}
-	tagDict[Tag{0x0018, 0x9724}] = Info{Tag{0x0018, 0x9724}, "FD", "TerminationRespiratoryTriggerCountThreshold", "1"}
-	tagDict[Tag{0x0018, 0x9725}] = Info{Tag{0x0018, 0x9725}, "CS", "DetectorGeometry", "1"}
-	tagDict[Tag{0x0018, 0x9726}] = Info{Tag{0x0018, 0x9726}, "FD", "TransverseDetectorSeparation", "1"}
-	tagDict[Tag{0x0018, 0x9727}] = Info{Tag{0x0018, 0x9727}, "FD", "AxialDetectorDimension", "1"}
-	tagDict[Tag{0x0018, 0x9729}] = Info{Tag{0x0018, 0x9729}, "US", "RadiopharmaceuticalAgentNumber", "1"}
-	tagDict[Tag{0x0018, 0x9732}] = Info{Tag{0x0018, 0x9732}, "SQ", "PETFrameAcquisitionSequence", "1"}
-	tagDict[Tag{0x0018, 0x9733}] = Info{Tag{0x0018, 0x9733}, "SQ", "PETDetectorMotionDetailsSequence", "1"}
-	tagDict[Tag{0x0018, 0x9734}] = Info{Tag{0x0018, 0x9734}, "SQ", "PETTableDynamicsSequence", "1"}
-	tagDict[Tag{0x0018, 0x9735}] = Info{Tag{0x0018, 0x9735}, "SQ", "PETPositionSequence", "1"}
-	tagDict[Tag{0x0018, 0x9736}] = Info{Tag{0x0018, 0x9736}, "SQ", "PETFrameCorrectionFactorsSequence", "1"}
-	tagDict[Tag{0x0018, 0x9737}] = Info{Tag{0x0018, 0x9737}, "SQ", "RadiopharmaceuticalUsageSequence", "1"}
-	tagDict[Tag{0x0018, 0x9738}] = Info{Tag{0x0018, 0x9738}, "CS", "AttenuationCorrectionSource", "1"}
-	tagDict[Tag{0x0018, 0x9739}] = Info{Tag{0x0018, 0x9739}, "US", "NumberOfIterations", "1"}
-	tagDict[Tag{0x0018, 0x9740}] = Info{Tag{0x0018, 0x9740}, "US", "NumberOfSubsets", "1"}
-	tagDict[Tag{0x0018, 0x9749}] = Info{Tag{0x0018, 0x9749}, "SQ", "PETReconstructionSequence", "1"}
-	tagDict[Tag{0x0018, 0x9751}] = Info{Tag{0x0018, 0x9751}, "SQ", "PETFrameTypeSequence", "1"}
-	tagDict[Tag{0x0018, 0x9755}] = Info{Tag{0x0018, 0x9755}, "CS", "TimeOfFlightInformationUsed", "1"}
-	tagDict[Tag{0x0018, 0x9756}] = Info{Tag{0x0018, 0x9756}, "CS", "ReconstructionType", "1"}
-	tagDict[Tag{0x0018, 0x9758}] = Info{Tag{0x0018, 0x9758}, "CS", "DecayCorrected", "1"}
-	tagDict[Tag{0x0018, 0x9759}] = Info{Tag{0x0018, 0x9759}, "CS", "AttenuationCorrected", "1"}
-	tagDict[Tag{0x0018, 0x9760}] = Info{Tag{0x0018, 0x9760}, "CS", "ScatterCorrected", "1"}
-	tagDict[Tag{0x0018, 0x9761}] = Info{Tag{0x0018, 0x9761}, "CS", "DeadTimeCorrected", "1"}
-	tagDict[Tag{0x0018, 0x9762}] = Info{Tag{0x0018, 0x9762}, "CS", "GantryMotionCorrected", "1"}
-	tagDict[Tag{0x0018, 0x9763}] = Info{Tag{0x0018, 0x9763}, "CS", "PatientMotionCorrected", "1"}
-	tagDict[Tag{0x0018, 0x9764}] = Info{Tag{0x0018, 0x9764}, "CS", "CountLossNormalizationCorrected", "1"}
-	tagDict[Tag{0x0018, 0x9765}] = Info{Tag{0x0018, 0x9765}, "CS", "RandomsCorrected", "1"}
-	tagDict[Tag{0x0018, 0x9766}] = Info{Tag{0x0018, 0x9766}, "CS", "NonUniformRadialSamplingCorrected", "1"}
-	tagDict[Tag{0x0018, 0x9767}] = Info{Tag{0x0018, 0x9767}, "CS", "SensitivityCalibrated", "1"}
-	tagDict[Tag{0x0018, 0x9768}] = Info{Tag{0x0018, 0x9768}, "CS", "DetectorNormalizationCorrection", "1"}
-	tagDict[Tag{0x0018, 0x9769}] = Info{Tag{0x0018, 0x9769}, "CS", "IterativeReconstructionMethod", "1"}
-	tagDict[Tag{0x0018, 0x9770}] = Info{Tag{0x0018, 0x9770}, "CS", "AttenuationCorrectionTemporalRelationship", "1"}
-	tagDict[Tag{0x0018, 0x9771}] = Info{Tag{0x0018, 0x9771}, "SQ", "PatientPhysiologicalStateSequence", "1"}
-	tagDict[Tag{0x0018, 0x9772}] = Info{Tag{0x0018, 0x9772}, "SQ", "PatientPhysiologicalStateCodeSequence", "1"}
-	tagDict[Tag{0x0018, 0x9801}] = Info{Tag{0x0018, 0x9801}, "FD", "DepthsOfFocus", "1-n"}
-	tagDict[Tag{0x0018, 0x9803}] = Info{Tag{0x0018, 0x9803}, "SQ", "ExcludedIntervalsSequence", "1"}
-	tagDict[Tag{0x0018, 0x9804}] = Info{Tag{0x0018, 0x9804}, "DT", "ExclusionStartDatetime", "1"}
-	tagDict[Tag{0x0018, 0x9805}] = Info{Tag{0x0018, 0x9805}, "FD", "ExclusionDuration", "1"}
-	tagDict[Tag{0x0018, 0x9806}] = Info{Tag{0x0018, 0x9806}, "SQ", "USImageDescriptionSequence", "1"}
-	tagDict[Tag{0x0018, 0x9807}] = Info{Tag{0x0018, 0x9807}, "SQ", "ImageDataTypeSequence", "1"}
-	tagDict[Tag{0x0018, 0x9808}] = Info{Tag{0x0018, 0x9808}, "CS", "DataType", "1"}
-	tagDict[Tag{0x0018, 0x9809}] = Info{Tag{0x0018, 0x9809}, "SQ", "TransducerScanPatternCodeSequence", "1"}
-	tagDict[Tag{0x0018, 0x980B}] = Info{Tag{0x0018, 0x980B}, "CS", "AliasedDataType", "1"}
-	tagDict[Tag{0x0018, 0x980C}] = Info{Tag{0x0018, 0x980C}, "CS", "PositionMeasuringDeviceUsed", "1"}
-	tagDict[Tag{0x0018, 0x980D}] = Info{Tag{0x0018, 0x980D}, "SQ", "TransducerGeometryCodeSequence", "1"}
-	tagDict[Tag{0x0018, 0x980E}] = Info{Tag{0x0018, 0x980E}, "SQ", "TransducerBeamSteeringCodeSequence", "1"}
-	tagDict[Tag{0x0018, 0x980F}] = Info{Tag{0x0018, 0x980F}, "SQ", "TransducerApplicationCodeSequence", "1"}
-	tagDict[Tag{0x0018, 0xA001}] = Info{Tag{0x0018, 0xA001}, "SQ", "ContributingEquipmentSequence", "1"}
-	tagDict[Tag{0x0018, 0xA002}] = Info{Tag{0x0018, 0xA002}, "DT", "ContributionDateTime", "1"}
-	tagDict[Tag{0x0018, 0xA003}] = Info{Tag{0x0018, 0xA003}, "ST", "ContributionDescription", "1"}
-	tagDict[Tag{0x0020, 0x000D}] = Info{Tag{0x0020, 0x000D}, "UI", "StudyInstanceUID", "1"}
-	tagDict[Tag{0x0020, 0x000E}] = Info{Tag{0x0020, 0x000E}, "UI", "SeriesInstanceUID", "1"}
-	tagDict[Tag{0x0020, 0x0010}] = Info{Tag{0x0020, 0x0010}, "SH", "StudyID", "1"}
-	tagDict[Tag{0x0020, 0x0011}] = Info{Tag{0x0020, 0x0011}, "IS", "SeriesNumber", "1"}
-	tagDict[Tag{0x0020, 0x0012}] = Info{Tag{0x0020, 0x0012}, "IS", "AcquisitionNumber", "1"}
-	tagDict[Tag{0x0020, 0x0013}] = Info{Tag{0x0020, 0x0013}, "IS", "InstanceNumber", "1"}
-	tagDict[Tag{0x0020, 0x0019}] = Info{Tag{0x0020, 0x0019}, "IS", "ItemNumber", "1"}
-	tagDict[Tag{0x0020, 0x0020}] = Info{Tag{0x0020, 0x0020}, "CS", "PatientOrientation", "2"}
-	tagDict[Tag{0x0020, 0x0032}] = Info{Tag{0x0020, 0x0032}, "DS", "ImagePositionPatient", "3"}
-	tagDict[Tag{0x0020, 0x0037}] = Info{Tag{0x0020, 0x0037}, "DS", "ImageOrientationPatient", "6"}
-	tagDict[Tag{0x0020, 0x0052}] = Info{Tag{0x0020, 0x0052}, "UI", "FrameOfReferenceUID", "1"}
-	tagDict[Tag{0x0020, 0x0060}] = Info{Tag{0x0020, 0x0060}, "CS", "Laterality", "1"}
-	tagDict[Tag{0x0020, 0x0062}] = Info{Tag{0x0020, 0x0062}, "CS", "ImageLaterality", "1"}
-	tagDict[Tag{0x0020, 0x0100}] = Info{Tag{0x0020, 0x0100}, "IS", "TemporalPositionIdentifier", "1"}
-	tagDict[Tag{0x0020, 0x0105}] = Info{Tag{0x0020, 0x0105}, "IS", "NumberOfTemporalPositions", "1"}
-	tagDict[Tag{0x0020, 0x0110}] = Info{Tag{0x0020, 0x0110}, "DS", "TemporalResolution", "1"}
-	tagDict[Tag{0x0020, 0x0200}] = Info{Tag{0x0020, 0x0200}, "UI", "SynchronizationFrameOfReferenceUID", "1"}
-	tagDict[Tag{0x0020, 0x0242}] = Info{Tag{0x0020, 0x0242}, "UI", "SOPInstanceUIDOfConcatenationSource", "1"}
-	tagDict[Tag{0x0020, 0x1002}] = Info{Tag{0x0020, 0x1002}, "IS", "ImagesInAcquisition", "1"}
-	tagDict[Tag{0x0020, 0x1040}] = Info{Tag{0x0020, 0x1040}, "LO", "PositionReferenceIndicator", "1"}
-	tagDict[Tag{0x0020, 0x1041}] = Info{Tag{0x0020, 0x1041}, "DS", "SliceLocation", "1"}
-	tagDict[Tag{0x0020, 0x1200}] = Info{Tag{0x0020, 0x1200}, "IS", "NumberOfPatientRelatedStudies", "1"}
-	tagDict[Tag{0x0020, 0x1202}] = Info{Tag{0x0020, 0x1202}, "IS", "NumberOfPatientRelatedSeries", "1"}
-	tagDict[Tag{0x0020, 0x1204}] = Info{Tag{0x0020, 0x1204}, "IS", "NumberOfPatientRelatedInstances", "1"}
-	tagDict[Tag{0x0020, 0x1206}] = Info{Tag{0x0020, 0x1206}, "IS", "NumberOfStudyRelatedSeries", "1"}
-	tagDict[Tag{0x0020, 0x1208}] = Info{Tag{0x0020, 0x1208}, "IS", "NumberOfStudyRelatedInstances", "1"}
-	tagDict[Tag{0x0020, 0x1209}] = Info{Tag{0x0020, 0x1209}, "IS", "NumberOfSeriesRelatedInstances", "1"}
-	tagDict[Tag{0x0020, 0x4000}] = Info{Tag{0x0020, 0x4000}, "LT", "ImageComments", "1"}
-	tagDict[Tag{0x0020, 0x9056}] = Info{Tag{0x0020, 0x9056}, "SH", "StackID", "1"}
-	tagDict[Tag{0x0020, 0x9057}] = Info{Tag{0x0020, 0x9057}, "UL", "InStackPositionNumber", "1"}
-	tagDict[Tag{0x0020, 0x9071}] = Info{Tag{0x0020, 0x9071}, "SQ", "FrameAnatomySequence", "1"}
-	tagDict[Tag{0x0020, 0x9072}] = Info{Tag{0x0020, 0x9072}, "CS", "FrameLaterality", "1"}
-	tagDict[Tag{0x0020, 0x9111}] = Info{Tag{0x0020, 0x9111}, "SQ", "FrameContentSequence", "1"}
-	tagDict[Tag{0x0020, 0x9113}] = Info{Tag{0x0020, 0x9113}, "SQ", "PlanePositionSequence", "1"}
-	tagDict[Tag{0x0020, 0x9116}] = Info{Tag{0x0020, 0x9116}, "SQ", "PlaneOrientationSequence", "1"}
-	tagDict[Tag{0x0020, 0x9128}] = Info{Tag{0x0020, 0x9128}, "UL", "TemporalPositionIndex", "1"}
-	tagDict[Tag{0x0020, 0x9153}] = Info{Tag{0x0020, 0x9153}, "FD", "NominalCardiacTriggerDelayTime", "1"}
-	tagDict[Tag{0x0020, 0x9154}] = Info{Tag{0x0020, 0x9154}, "FL", "NominalCardiacTriggerTimePriorToRPeak", "1"}
-	tagDict[Tag{0x0020, 0x9155}] = Info{Tag{0x0020, 0x9155}, "FL", "ActualCardiacTriggerTimePriorToRPeak", "1"}
-	tagDict[Tag{0x0020, 0x9156}] = Info{Tag{0x0020, 0x9156}, "US", "FrameAcquisitionNumber", "1"}
-	tagDict[Tag{0x0020, 0x9157}] = Info{Tag{0x0020, 0x9157}, "UL", "DimensionIndexValues", "1-n"}
-	tagDict[Tag{0x0020, 0x9158}] = Info{Tag{0x0020, 0x9158}, "LT", "FrameComments", "1"}
-	tagDict[Tag{0x0020, 0x9161}] = Info{Tag{0x0020, 0x9161}, "UI", "ConcatenationUID", "1"}
-	tagDict[Tag{0x0020, 0x9162}] = Info{Tag{0x0020, 0x9162}, "US", "InConcatenationNumber", "1"}
-	tagDict[Tag{0x0020, 0x9163}] = Info{Tag{0x0020, 0x9163}, "US", "InConcatenationTotalNumber", "1"}
-	tagDict[Tag{0x0020, 0x9164}] = Info{Tag{0x0020, 0x9164}, "UI", "DimensionOrganizationUID", "1"}
-	tagDict[Tag{0x0020, 0x9165}] = Info{Tag{0x0020, 0x9165}, "AT", "DimensionIndexPointer", "1"}
-	tagDict[Tag{0x0020, 0x9167}] = Info{Tag{0x0020, 0x9167}, "AT", "FunctionalGroupPointer", "1"}
-	tagDict[Tag{0x0020, 0x9213}] = Info{Tag{0x0020, 0x9213}, "LO", "DimensionIndexPrivateCreator", "1"}
-	tagDict[Tag{0x0020, 0x9221}] = Info{Tag{0x0020, 0x9221}, "SQ", "DimensionOrganizationSequence", "1"}
-	tagDict[Tag{0x0020, 0x9222}] = Info{Tag{0x0020, 0x9222}, "SQ", "DimensionIndexSequence", "1"}
-	tagDict[Tag{0x0020, 0x9228}] = Info{Tag{0x0020, 0x9228}, "UL", "ConcatenationFrameOffsetNumber", "1"}
-	tagDict[Tag{0x0020, 0x9238}] = Info{Tag{0x0020, 0x9238}, "LO", "FunctionalGroupPrivateCreator", "1"}
-	tagDict[Tag{0x0020, 0x9241}] = Info{Tag{0x0020, 0x9241}, "FL", "NominalPercentageOfCardiacPhase", "1"}
-	tagDict[Tag{0x0020, 0x9245}] = Info{Tag{0x0020, 0x9245}, "FL", "NominalPercentageOfRespiratoryPhase", "1"}
-	tagDict[Tag{0x0020, 0x9246}] = Info{Tag{0x0020, 0x9246}, "FL", "StartingRespiratoryAmplitude", "1"}
-	tagDict[Tag{0x0020, 0x9247}] = Info{Tag{0x0020, 0x9247}, "CS", "StartingRespiratoryPhase", "1"}
-	tagDict[Tag{0x0020, 0x9248}] = Info{Tag{0x0020, 0x9248}, "FL", "EndingRespiratoryAmplitude", "1"}
-	tagDict[Tag{0x0020, 0x9249}] = Info{Tag{0x0020, 0x9249}, "CS", "EndingRespiratoryPhase", "1"}
-	tagDict[Tag{0x0020, 0x9250}] = Info{Tag{0x0020, 0x9250}, "CS", "RespiratoryTriggerType", "1"}
-	tagDict[Tag{0x0020, 0x9251}] = Info{Tag{0x0020, 0x9251}, "FD", "RRIntervalTimeNominal", "1"}
-	tagDict[Tag{0x0020, 0x9252}] = Info{Tag{0x0020, 0x9252}, "FD", "ActualCardiacTriggerDelayTime", "1"}
-	tagDict[Tag{0x0020, 0x9253}] = Info{Tag{0x0020, 0x9253}, "SQ", "RespiratorySynchronizationSequence", "1"}
-	tagDict[Tag{0x0020, 0x9254}] = Info{Tag{0x0020, 0x9254}, "FD", "RespiratoryIntervalTime", "1"}
-	tagDict[Tag{0x0020, 0x9255}] = Info{Tag{0x0020, 0x9255}, "FD", "NominalRespiratoryTriggerDelayTime", "1"}
-	tagDict[Tag{0x0020, 0x9256}] = Info{Tag{0x0020, 0x9256}, "FD", "RespiratoryTriggerDelayThreshold", "1"}
-	tagDict[Tag{0x0020, 0x9257}] = Info{Tag{0x0020, 0x9257}, "FD", "ActualRespiratoryTriggerDelayTime", "1"}
-	tagDict[Tag{0x0020, 0x9301}] = Info{Tag{0x0020, 0x9301}, "FD", "ImagePositionVolume", "3"}
-	tagDict[Tag{0x0020, 0x9302}] = Info{Tag{0x0020, 0x9302}, "FD", "ImageOrientationVolume", "6"}
-	tagDict[Tag{0x0020, 0x9307}] = Info{Tag{0x0020, 0x9307}, "CS", "UltrasoundAcquisitionGeometry", "1"}
-	tagDict[Tag{0x0020, 0x9308}] = Info{Tag{0x0020, 0x9308}, "FD", "ApexPosition", "3"}
-	tagDict[Tag{0x0020, 0x9309}] = Info{Tag{0x0020, 0x9309}, "FD", "VolumeToTransducerMappingMatrix", "16"}
-	tagDict[Tag{0x0020, 0x930A}] = Info{Tag{0x0020, 0x930A}, "FD", "VolumeToTableMappingMatrix", "16"}
-	tagDict[Tag{0x0020, 0x930C}] = Info{Tag{0x0020, 0x930C}, "CS", "PatientFrameOfReferenceSource", "1"}
-	tagDict[Tag{0x0020, 0x930D}] = Info{Tag{0x0020, 0x930D}, "FD", "TemporalPositionTimeOffset", "1"}
-	tagDict[Tag{0x0020, 0x930E}] = Info{Tag{0x0020, 0x930E}, "SQ", "PlanePositionVolumeSequence", "1"}
-	tagDict[Tag{0x0020, 0x930F}] = Info{Tag{0x0020, 0x930F}, "SQ", "PlaneOrientationVolumeSequence", "1"}
-	tagDict[Tag{0x0020, 0x9310}] = Info{Tag{0x0020, 0x9310}, "SQ", "TemporalPositionSequence", "1"}
-	tagDict[Tag{0x0020, 0x9311}] = Info{Tag{0x0020, 0x9311}, "CS", "DimensionOrganizationType", "1"}
-	tagDict[Tag{0x0020, 0x9312}] = Info{Tag{0x0020, 0x9312}, "UI", "VolumeFrameOfReferenceUID", "1"}
-	tagDict[Tag{0x0020, 0x9313}] = Info{Tag{0x0020, 0x9313}, "UI", "TableFrameOfReferenceUID", "1"}
-	tagDict[Tag{0x0020, 0x9421}] = Info{Tag{0x0020, 0x9421}, "LO", "DimensionDescriptionLabel", "1"}
-	tagDict[Tag{0x0020, 0x9450}] = Info{Tag{0x0020, 0x9450}, "SQ", "PatientOrientationInFrameSequence", "1"}
-	tagDict[Tag{0x0020, 0x9453}] = Info{Tag{0x0020, 0x9453}, "LO", "FrameLabel", "1"}
-	tagDict[Tag{0x0020, 0x9518}] = Info{Tag{0x0020, 0x9518}, "US", "AcquisitionIndex", "1-n"}
-	tagDict[Tag{0x0020, 0x9529}] = Info{Tag{0x0020, 0x9529}, "SQ", "ContributingSOPInstancesReferenceSequence", "1"}
-	tagDict[Tag{0x0020, 0x9536}] = Info{Tag{0x0020, 0x9536}, "US", "ReconstructionIndex", "1"}
-	tagDict[Tag{0x0022, 0x0001}] = Info{Tag{0x0022, 0x0001}, "US", "LightPathFilterPassThroughWavelength", "1"}
-	tagDict[Tag{0x0022, 0x0002}] = Info{Tag{0x0022, 0x0002}, "US", "LightPathFilterPassBand", "2"}
-	tagDict[Tag{0x0022, 0x0003}] = Info{Tag{0x0022, 0x0003}, "US", "ImagePathFilterPassThroughWavelength", "1"}
-	tagDict[Tag{0x0022, 0x0004}] = Info{Tag{0x0022, 0x0004}, "US", "ImagePathFilterPassBand", "2"}
-	tagDict[Tag{0x0022, 0x0005}] = Info{Tag{0x0022, 0x0005}, "CS", "PatientEyeMovementCommanded", "1"}
-	tagDict[Tag{0x0022, 0x0006}] = Info{Tag{0x0022, 0x0006}, "SQ", "PatientEyeMovementCommandCodeSequence", "1"}
-	tagDict[Tag{0x0022, 0x0007}] = Info{Tag{0x0022, 0x0007}, "FL", "SphericalLensPower", "1"}
-	tagDict[Tag{0x0022, 0x0008}] = Info{Tag{0x0022, 0x0008}, "FL", "CylinderLensPower", "1"}
-	tagDict[Tag{0x0022, 0x0009}] = Info{Tag{0x0022, 0x0009}, "FL", "CylinderAxis", "1"}
-	tagDict[Tag{0x0022, 0x000A}] = Info{Tag{0x0022, 0x000A}, "FL", "EmmetropicMagnification", "1"}
-	tagDict[Tag{0x0022, 0x000B}] = Info{Tag{0x0022, 0x000B}, "FL", "IntraOcularPressure", "1"}
-	tagDict[Tag{0x0022, 0x000C}] = Info{Tag{0x0022, 0x000C}, "FL", "HorizontalFieldOfView", "1"}
-	tagDict[Tag{0x0022, 0x000D}] = Info{Tag{0x0022, 0x000D}, "CS", "PupilDilated", "1"}
-	tagDict[Tag{0x0022, 0x000E}] = Info{Tag{0x0022, 0x000E}, "FL", "DegreeOfDilation", "1"}
-	tagDict[Tag{0x0022, 0x0010}] = Info{Tag{0x0022, 0x0010}, "FL", "StereoBaselineAngle", "1"}
-	tagDict[Tag{0x0022, 0x0011}] = Info{Tag{0x0022, 0x0011}, "FL", "StereoBaselineDisplacement", "1"}
-	tagDict[Tag{0x0022, 0x0012}] = Info{Tag{0x0022, 0x0012}, "FL", "StereoHorizontalPixelOffset", "1"}
-	tagDict[Tag{0x0022, 0x0013}] = Info{Tag{0x0022, 0x0013}, "FL", "StereoVerticalPixelOffset", "1"}
-	tagDict[Tag{0x0022, 0x0014}] = Info{Tag{0x0022, 0x0014}, "FL", "StereoRotation", "1"}
-	tagDict[Tag{0x0022, 0x0015}] = Info{Tag{0x0022, 0x0015}, "SQ", "AcquisitionDeviceTypeCodeSequence", "1"}
-	tagDict[Tag{0x0022, 0x0016}] = Info{Tag{0x0022, 0x0016}, "SQ", "IlluminationTypeCodeSequence", "1"}
-	tagDict[Tag{0x0022, 0x0017}] = Info{Tag{0x0022, 0x0017}, "SQ", "LightPathFilterTypeStackCodeSequence", "1"}
-	tagDict[Tag{0x0022, 0x0018}] = Info{Tag{0x0022, 0x0018}, "SQ", "ImagePathFilterTypeStackCodeSequence", "1"}
-	tagDict[Tag{0x0022, 0x0019}] = Info{Tag{0x0022, 0x0019}, "SQ", "LensesCodeSequence", "1"}
-	tagDict[Tag{0x0022, 0x001A}] = Info{Tag{0x0022, 0x001A}, "SQ", "ChannelDescriptionCodeSequence", "1"}
-	tagDict[Tag{0x0022, 0x001B}] = Info{Tag{0x0022, 0x001B}, "SQ", "RefractiveStateSequence", "1"}
-	tagDict[Tag{0x0022, 0x001C}] = Info{Tag{0x0022, 0x001C}, "SQ", "MydriaticAgentCodeSequence", "1"}
-	tagDict[Tag{0x0022, 0x001D}] = Info{Tag{0x0022, 0x001D}, "SQ", "RelativeImagePositionCodeSequence", "1"}
-	tagDict[Tag{0x0022, 0x001E}] = Info{Tag{0x0022, 0x001E}, "FL", "CameraAngleOfView", "1"}
-	tagDict[Tag{0x0022, 0x0020}] = Info{Tag{0x0022, 0x0020}, "SQ", "StereoPairsSequence", "1"}
-	tagDict[Tag{0x0022, 0x0021}] = Info{Tag{0x0022, 0x0021}, "SQ", "LeftImageSequence", "1"}
-	tagDict[Tag{0x0022, 0x0022}] = Info{Tag{0x0022, 0x0022}, "SQ", "RightImageSequence", "1"}
-	tagDict[Tag{0x0022, 0x0030}] = Info{Tag{0x0022, 0x0030}, "FL", "AxialLengthOfTheEye", "1"}
-	tagDict[Tag{0x0022, 0x0031}] = Info{Tag{0x0022, 0x0031}, "SQ", "OphthalmicFrameLocationSequence", "1"}
-	tagDict[Tag{0x0022, 0x0032}] = Info{Tag{0x0022, 0x0032}, "FL", "ReferenceCoordinates", "2-2n"}
-	tagDict[Tag{0x0022, 0x0035}] = Info{Tag{0x0022, 0x0035}, "FL", "DepthSpatialResolution", "1"}
-	tagDict[Tag{0x0022, 0x0036}] = Info{Tag{0x0022, 0x0036}, "FL", "MaximumDepthDistortion", "1"}
-	tagDict[Tag{0x0022, 0x0037}] = Info{Tag{0x0022, 0x0037}, "FL", "AlongScanSpatialResolution", "1"}
-	tagDict[Tag{0x0022, 0x0038}] = Info{Tag{0x0022, 0x0038}, "FL", "MaximumAlongScanDistortion", "1"}
-	tagDict[Tag{0x0022, 0x0039}] = Info{Tag{0x0022, 0x0039}, "CS", "OphthalmicImageOrientation", "1"}
-	tagDict[Tag{0x0022, 0x0041}] = Info{Tag{0x0022, 0x0041}, "FL", "DepthOfTransverseImage", "1"}
-	tagDict[Tag{0x0022, 0x0042}] = Info{Tag{0x0022, 0x0042}, "SQ", "MydriaticAgentConcentrationUnitsSequence", "1"}
-	tagDict[Tag{0x0022, 0x0048}] = Info{Tag{0x0022, 0x0048}, "FL", "AcrossScanSpatialResolution", "1"}
-	tagDict[Tag{0x0022, 0x0049}] = Info{Tag{0x0022, 0x0049}, "FL", "MaximumAcrossScanDistortion", "1"}
-	tagDict[Tag{0x0022, 0x004E}] = Info{Tag{0x0022, 0x004E}, "DS", "MydriaticAgentConcentration", "1"}
-	tagDict[Tag{0x0022, 0x0055}] = Info{Tag{0x0022, 0x0055}, "FL", "IlluminationWaveLength", "1"}
-	tagDict[Tag{0x0022, 0x0056}] = Info{Tag{0x0022, 0x0056}, "FL", "IlluminationPower", "1"}
-	tagDict[Tag{0x0022, 0x0057}] = Info{Tag{0x0022, 0x0057}, "FL", "IlluminationBandwidth", "1"}
-	tagDict[Tag{0x0022, 0x0058}] = Info{Tag{0x0022, 0x0058}, "SQ", "MydriaticAgentSequence", "1"}
-	tagDict[Tag{0x0022, 0x1007}] = Info{Tag{0x0022, 0x1007}, "SQ", "OphthalmicAxialMeasurementsRightEyeSequence", "1"}
-	tagDict[Tag{0x0022, 0x1008}] = Info{Tag{0x0022, 0x1008}, "SQ", "OphthalmicAxialMeasurementsLeftEyeSequence", "1"}
-	tagDict[Tag{0x0022, 0x1009}] = Info{Tag{0x0022, 0x1009}, "CS", "OphthalmicAxialMeasurementsDeviceType", "1"}
-	tagDict[Tag{0x0022, 0x1010}] = Info{Tag{0x0022, 0x1010}, "CS", "OphthalmicAxialLengthMeasurementsType", "1"}
-	tagDict[Tag{0x0022, 0x1012}] = Info{Tag{0x0022, 0x1012}, "SQ", "OphthalmicAxialLengthSequence", "1"}
-	tagDict[Tag{0x0022, 0x1019}] = Info{Tag{0x0022, 0x1019}, "FL", "OphthalmicAxialLength", "1"}
-	tagDict[Tag{0x0022, 0x1024}] = Info{Tag{0x0022, 0x1024}, "SQ", "LensStatusCodeSequence", "1"}
-	tagDict[Tag{0x0022, 0x1025}] = Info{Tag{0x0022, 0x1025}, "SQ", "VitreousStatusCodeSequence", "1"}
-	tagDict[Tag{0x0022, 0x1028}] = Info{Tag{0x0022, 0x1028}, "SQ", "IOLFormulaCodeSequence", "1"}
-	tagDict[Tag{0x0022, 0x1029}] = Info{Tag{0x0022, 0x1029}, "LO", "IOLFormulaDetail", "1"}
-	tagDict[Tag{0x0022, 0x1033}] = Info{Tag{0x0022, 0x1033}, "FL", "KeratometerIndex", "1"}
-	tagDict[Tag{0x0022, 0x1035}] = Info{Tag{0x0022, 0x1035}, "SQ", "SourceOfOphthalmicAxialLengthCodeSequence", "1"}
-	tagDict[Tag{0x0022, 0x1037}] = Info{Tag{0x0022, 0x1037}, "FL", "TargetRefraction", "1"}
-	tagDict[Tag{0x0022, 0x1039}] = Info{Tag{0x0022, 0x1039}, "CS", "RefractiveProcedureOccurred", "1"}
-	tagDict[Tag{0x0022, 0x1040}] = Info{Tag{0x0022, 0x1040}, "SQ", "RefractiveSurgeryTypeCodeSequence", "1"}
-	tagDict[Tag{0x0022, 0x1044}] = Info{Tag{0x0022, 0x1044}, "SQ", "OphthalmicUltrasoundMethodCodeSequence", "1"}
-	tagDict[Tag{0x0022, 0x1050}] = Info{Tag{0x0022, 0x1050}, "SQ", "OphthalmicAxialLengthMeasurementsSequence", "1"}
-	tagDict[Tag{0x0022, 0x1053}] = Info{Tag{0x0022, 0x1053}, "FL", "IOLPower", "1"}
-	tagDict[Tag{0x0022, 0x1054}] = Info{Tag{0x0022, 0x1054}, "FL", "PredictedRefractiveError", "1"}
-	tagDict[Tag{0x0022, 0x1059}] = Info{Tag{0x0022, 0x1059}, "FL", "OphthalmicAxialLengthVelocity", "1"}
-	tagDict[Tag{0x0022, 0x1065}] = Info{Tag{0x0022, 0x1065}, "LO", "LensStatusDescription", "1"}
-	tagDict[Tag{0x0022, 0x1066}] = Info{Tag{0x0022, 0x1066}, "LO", "VitreousStatusDescription", "1"}
-	tagDict[Tag{0x0022, 0x1090}] = Info{Tag{0x0022, 0x1090}, "SQ", "IOLPowerSequence", "1"}
-	tagDict[Tag{0x0022, 0x1092}] = Info{Tag{0x0022, 0x1092}, "SQ", "LensConstantSequence", "1"}
-	tagDict[Tag{0x0022, 0x1093}] = Info{Tag{0x0022, 0x1093}, "LO", "IOLManufacturer", "1"}
-	tagDict[Tag{0x0022, 0x1094}] = Info{Tag{0x0022, 0x1094}, "LO", "LensConstantDescription", "1"}
-	tagDict[Tag{0x0022, 0x1095}] = Info{Tag{0x0022, 0x1095}, "LO", "ImplantName", "1"}
-	tagDict[Tag{0x0022, 0x1096}] = Info{Tag{0x0022, 0x1096}, "SQ", "KeratometryMeasurementTypeCodeSequence", "1"}
-	tagDict[Tag{0x0022, 0x1097}] = Info{Tag{0x0022, 0x1097}, "LO", "ImplantPartNumber", "1"}
-	tagDict[Tag{0x0022, 0x1100}] = Info{Tag{0x0022, 0x1100}, "SQ", "ReferencedOphthalmicAxialMeasurementsSequence", "1"}
-	tagDict[Tag{0x0022, 0x1101}] = Info{Tag{0x0022, 0x1101}, "SQ", "OphthalmicAxialLengthMeasurementsSegmentNameCodeSequence", "1"}
-	tagDict[Tag{0x0022, 0x1103}] = Info{Tag{0x0022, 0x1103}, "SQ", "RefractiveErrorBeforeRefractiveSurgeryCodeSequence", "1"}
-	tagDict[Tag{0x0022, 0x1121}] = Info{Tag{0x0022, 0x1121}, "FL", "IOLPowerForExactEmmetropia", "1"}
-	tagDict[Tag{0x0022, 0x1122}] = Info{Tag{0x0022, 0x1122}, "FL", "IOLPowerForExactTargetRefraction", "1"}
-	tagDict[Tag{0x0022, 0x1125}] = Info{Tag{0x0022, 0x1125}, "SQ", "AnteriorChamberDepthDefinitionCodeSequence", "1"}
-	tagDict[Tag{0x0022, 0x1127}] = Info{Tag{0x0022, 0x1127}, "SQ", "LensThicknessSequence", "1"}
-	tagDict[Tag{0x0022, 0x1128}] = Info{Tag{0x0022, 0x1128}, "SQ", "AnteriorChamberDepthSequence", "1"}
-	tagDict[Tag{0x0022, 0x1130}] = Info{Tag{0x0022, 0x1130}, "FL", "LensThickness", "1"}
-	tagDict[Tag{0x0022, 0x1131}] = Info{Tag{0x0022, 0x1131}, "FL", "AnteriorChamberDepth", "1"}
-	tagDict[Tag{0x0022, 0x1132}] = Info{Tag{0x0022, 0x1132}, "SQ", "SourceOfLensThicknessDataCodeSequence", "1"}
-	tagDict[Tag{0x0022, 0x1133}] = Info{Tag{0x0022, 0x1133}, "SQ", "SourceOfAnteriorChamberDepthDataCodeSequence", "1"}
-	tagDict[Tag{0x0022, 0x1134}] = Info{Tag{0x0022, 0x1134}, "SQ", "SourceOfRefractiveMeasurementsSequence", "1"}
-	tagDict[Tag{0x0022, 0x1135}] = Info{Tag{0x0022, 0x1135}, "SQ", "SourceOfRefractiveMeasurementsCodeSequence", "1"}
-	tagDict[Tag{0x0022, 0x1140}] = Info{Tag{0x0022, 0x1140}, "CS", "OphthalmicAxialLengthMeasurementModified", "1"}
-	tagDict[Tag{0x0022, 0x1150}] = Info{Tag{0x0022, 0x1150}, "SQ", "OphthalmicAxialLengthDataSourceCodeSequence", "1"}
-	tagDict[Tag{0x0022, 0x1153}] = Info{Tag{0x0022, 0x1153}, "SQ", "OphthalmicAxialLengthAcquisitionMethodCodeSequence", "1"}
-	tagDict[Tag{0x0022, 0x1155}] = Info{Tag{0x0022, 0x1155}, "FL", "SignalToNoiseRatio", "1"}
-	tagDict[Tag{0x0022, 0x1159}] = Info{Tag{0x0022, 0x1159}, "LO", "OphthalmicAxialLengthDataSourceDescription", "1"}
-	tagDict[Tag{0x0022, 0x1210}] = Info{Tag{0x0022, 0x1210}, "SQ", "OphthalmicAxialLengthMeasurementsTotalLengthSequence", "1"}
-	tagDict[Tag{0x0022, 0x1211}] = Info{Tag{0x0022, 0x1211}, "SQ", "OphthalmicAxialLengthMeasurementsSegmentalLengthSequence", "1"}
-	tagDict[Tag{0x0022, 0x1212}] = Info{Tag{0x0022, 0x1212}, "SQ", "OphthalmicAxialLengthMeasurementsLengthSummationSequence", "1"}
-	tagDict[Tag{0x0022, 0x1220}] = Info{Tag{0x0022, 0x1220}, "SQ", "UltrasoundOphthalmicAxialLengthMeasurementsSequence", "1"}
-	tagDict[Tag{0x0022, 0x1225}] = Info{Tag{0x0022, 0x1225}, "SQ", "OpticalOphthalmicAxialLengthMeasurementsSequence", "1"}
-	tagDict[Tag{0x0022, 0x1230}] = Info{Tag{0x0022, 0x1230}, "SQ", "UltrasoundSelectedOphthalmicAxialLengthSequence", "1"}
-	tagDict[Tag{0x0022, 0x1250}] = Info{Tag{0x0022, 0x1250}, "SQ", "OphthalmicAxialLengthSelectionMethodCodeSequence", "1"}
-	tagDict[Tag{0x0022, 0x1255}] = Info{Tag{0x0022, 0x1255}, "SQ", "OpticalSelectedOphthalmicAxialLengthSequence", "1"}
-	tagDict[Tag{0x0022, 0x1257}] = Info{Tag{0x0022, 0x1257}, "SQ", "SelectedSegmentalOphthalmicAxialLengthSequence", "1"}
-	tagDict[Tag{0x0022, 0x1260}] = Info{Tag{0x0022, 0x1260}, "SQ", "SelectedTotalOphthalmicAxialLengthSequence", "1"}
-	tagDict[Tag{0x0022, 0x1262}] = Info{Tag{0x0022, 0x1262}, "SQ", "OphthalmicAxialLengthQualityMetricSequence", "1"}
-	tagDict[Tag{0x0022, 0x1265}] = Info{Tag{0x0022, 0x1265}, "SQ", "OphthalmicAxialLengthQualityMetricTypeCodeSequence", "1"}
-	tagDict[Tag{0x0022, 0x1273}] = Info{Tag{0x0022, 0x1273}, "LO", "OphthalmicAxialLengthQualityMetricTypeDescription", "1"}
-	tagDict[Tag{0x0022, 0x1300}] = Info{Tag{0x0022, 0x1300}, "SQ", "IntraocularLensCalculationsRightEyeSequence", "1"}
-	tagDict[Tag{0x0022, 0x1310}] = Info{Tag{0x0022, 0x1310}, "SQ", "IntraocularLensCalculationsLeftEyeSequence", "1"}
-	tagDict[Tag{0x0022, 0x1330}] = Info{Tag{0x0022, 0x1330}, "SQ", "ReferencedOphthalmicAxialLengthMeasurementQCImageSequence", "1"}
-	tagDict[Tag{0x0024, 0x0010}] = Info{Tag{0x0024, 0x0010}, "FL", "VisualFieldHorizontalExtent", "1"}
-	tagDict[Tag{0x0024, 0x0011}] = Info{Tag{0x0024, 0x0011}, "FL", "VisualFieldVerticalExtent", "1"}
-	tagDict[Tag{0x0024, 0x0012}] = Info{Tag{0x0024, 0x0012}, "CS", "VisualFieldShape", "1"}
-	tagDict[Tag{0x0024, 0x0016}] = Info{Tag{0x0024, 0x0016}, "SQ", "ScreeningTestModeCodeSequence", "1"}
-	tagDict[Tag{0x0024, 0x0018}] = Info{Tag{0x0024, 0x0018}, "FL", "MaximumStimulusLuminance", "1"}
-	tagDict[Tag{0x0024, 0x0020}] = Info{Tag{0x0024, 0x0020}, "FL", "BackgroundLuminance", "1"}
-	tagDict[Tag{0x0024, 0x0021}] = Info{Tag{0x0024, 0x0021}, "SQ", "StimulusColorCodeSequence", "1"}
-	tagDict[Tag{0x0024, 0x0024}] = Info{Tag{0x0024, 0x0024}, "SQ", "BackgroundIlluminationColorCodeSequence", "1"}
-	tagDict[Tag{0x0024, 0x0025}] = Info{Tag{0x0024, 0x0025}, "FL", "StimulusArea", "1"}
-	tagDict[Tag{0x0024, 0x0028}] = Info{Tag{0x0024, 0x0028}, "FL", "StimulusPresentationTime", "1"}
-	tagDict[Tag{0x0024, 0x0032}] = Info{Tag{0x0024, 0x0032}, "SQ", "FixationSequence", "1"}
-	tagDict[Tag{0x0024, 0x0033}] = Info{Tag{0x0024, 0x0033}, "SQ", "FixationMonitoringCodeSequence", "1"}
-	tagDict[Tag{0x0024, 0x0034}] = Info{Tag{0x0024, 0x0034}, "SQ", "VisualFieldCatchTrialSequence", "1"}
-	tagDict[Tag{0x0024, 0x0035}] = Info{Tag{0x0024, 0x0035}, "US", "FixationCheckedQuantity", "1"}
-	tagDict[Tag{0x0024, 0x0036}] = Info{Tag{0x0024, 0x0036}, "US", "PatientNotProperlyFixatedQuantity", "1"}
-	tagDict[Tag{0x0024, 0x0037}] = Info{Tag{0x0024, 0x0037}, "CS", "PresentedVisualStimuliDataFlag", "1"}
-	tagDict[Tag{0x0024, 0x0038}] = Info{Tag{0x0024, 0x0038}, "US", "NumberOfVisualStimuli", "1"}
-	tagDict[Tag{0x0024, 0x0039}] = Info{Tag{0x0024, 0x0039}, "CS", "ExcessiveFixationLossesDataFlag", "1"}
-	tagDict[Tag{0x0024, 0x0040}] = Info{Tag{0x0024, 0x0040}, "CS", "ExcessiveFixationLosses", "1"}
-	tagDict[Tag{0x0024, 0x0042}] = Info{Tag{0x0024, 0x0042}, "US", "StimuliRetestingQuantity", "1"}
-	tagDict[Tag{0x0024, 0x0044}] = Info{Tag{0x0024, 0x0044}, "LT", "CommentsOnPatientPerformanceOfVisualField", "1"}
-	tagDict[Tag{0x0024, 0x0045}] = Info{Tag{0x0024, 0x0045}, "CS", "FalseNegativesEstimateFlag", "1"}
-	tagDict[Tag{0x0024, 0x0046}] = Info{Tag{0x0024, 0x0046}, "FL", "FalseNegativesEstimate", "1"}
-	tagDict[Tag{0x0024, 0x0048}] = Info{Tag{0x0024, 0x0048}, "US", "NegativeCatchTrialsQuantity", "1"}
-	tagDict[Tag{0x0024, 0x0050}] = Info{Tag{0x0024, 0x0050}, "US", "FalseNegativesQuantity", "1"}
-	tagDict[Tag{0x0024, 0x0051}] = Info{Tag{0x0024, 0x0051}, "CS", "ExcessiveFalseNegativesDataFlag", "1"}
-	tagDict[Tag{0x0024, 0x0052}] = Info{Tag{0x0024, 0x0052}, "CS", "ExcessiveFalseNegatives", "1"}
-	tagDict[Tag{0x0024, 0x0053}] = Info{Tag{0x0024, 0x0053}, "CS", "FalsePositivesEstimateFlag", "1"}
-	tagDict[Tag{0x0024, 0x0054}] = Info{Tag{0x0024, 0x0054}, "FL", "FalsePositivesEstimate", "1"}
-	tagDict[Tag{0x0024, 0x0055}] = Info{Tag{0x0024, 0x0055}, "CS", "CatchTrialsDataFlag", "1"}
-	tagDict[Tag{0x0024, 0x0056}] = Info{Tag{0x0024, 0x0056}, "US", "PositiveCatchTrialsQuantity", "1"}
-	tagDict[Tag{0x0024, 0x0057}] = Info{Tag{0x0024, 0x0057}, "CS", "TestPointNormalsDataFlag", "1"}
-	tagDict[Tag{0x0024, 0x0058}] = Info{Tag{0x0024, 0x0058}, "SQ", "TestPointNormalsSequence", "1"}
-	tagDict[Tag{0x0024, 0x0059}] = Info{Tag{0x0024, 0x0059}, "CS", "GlobalDeviationProbabilityNormalsFlag", "1"}
-	tagDict[Tag{0x0024, 0x0060}] = Info{Tag{0x0024, 0x0060}, "US", "FalsePositivesQuantity", "1"}
-	tagDict[Tag{0x0024, 0x0061}] = Info{Tag{0x0024, 0x0061}, "CS", "ExcessiveFalsePositivesDataFlag", "1"}
-	tagDict[Tag{0x0024, 0x0062}] = Info{Tag{0x0024, 0x0062}, "CS", "ExcessiveFalsePositives", "1"}
-	tagDict[Tag{0x0024, 0x0063}] = Info{Tag{0x0024, 0x0063}, "CS", "VisualFieldTestNormalsFlag", "1"}
-	tagDict[Tag{0x0024, 0x0064}] = Info{Tag{0x0024, 0x0064}, "SQ", "ResultsNormalsSequence", "1"}
-	tagDict[Tag{0x0024, 0x0065}] = Info{Tag{0x0024, 0x0065}, "SQ", "AgeCorrectedSensitivityDeviationAlgorithmSequence", "1"}
-	tagDict[Tag{0x0024, 0x0066}] = Info{Tag{0x0024, 0x0066}, "FL", "GlobalDeviationFromNormal", "1"}
-	tagDict[Tag{0x0024, 0x0067}] = Info{Tag{0x0024, 0x0067}, "SQ", "GeneralizedDefectSensitivityDeviationAlgorithmSequence", "1"}
-	tagDict[Tag{0x0024, 0x0068}] = Info{Tag{0x0024, 0x0068}, "FL", "LocalizedDeviationfromNormal", "1"}
-	tagDict[Tag{0x0024, 0x0069}] = Info{Tag{0x0024, 0x0069}, "LO", "PatientReliabilityIndicator", "1"}
-	tagDict[Tag{0x0024, 0x0070}] = Info{Tag{0x0024, 0x0070}, "FL", "VisualFieldMeanSensitivity", "1"}
-	tagDict[Tag{0x0024, 0x0071}] = Info{Tag{0x0024, 0x0071}, "FL", "GlobalDeviationProbability", "1"}
-	tagDict[Tag{0x0024, 0x0072}] = Info{Tag{0x0024, 0x0072}, "CS", "LocalDeviationProbabilityNormalsFlag", "1"}
-	tagDict[Tag{0x0024, 0x0073}] = Info{Tag{0x0024, 0x0073}, "FL", "LocalizedDeviationProbability", "1"}
-	tagDict[Tag{0x0024, 0x0074}] = Info{Tag{0x0024, 0x0074}, "CS", "ShortTermFluctuationCalculated", "1"}
-	tagDict[Tag{0x0024, 0x0075}] = Info{Tag{0x0024, 0x0075}, "FL", "ShortTermFluctuation", "1"}
-	tagDict[Tag{0x0024, 0x0076}] = Info{Tag{0x0024, 0x0076}, "CS", "ShortTermFluctuationProbabilityCalculated", "1"}
-	tagDict[Tag{0x0024, 0x0077}] = Info{Tag{0x0024, 0x0077}, "FL", "ShortTermFluctuationProbability", "1"}
-	tagDict[Tag{0x0024, 0x0078}] = Info{Tag{0x0024, 0x0078}, "CS", "CorrectedLocalizedDeviationFromNormalCalculated", "1"}
-	tagDict[Tag{0x0024, 0x0079}] = Info{Tag{0x0024, 0x0079}, "FL", "CorrectedLocalizedDeviationFromNormal", "1"}
-	tagDict[Tag{0x0024, 0x0080}] = Info{Tag{0x0024, 0x0080}, "CS", "CorrectedLocalizedDeviationFromNormalProbabilityCalculated", "1"}
-	tagDict[Tag{0x0024, 0x0081}] = Info{Tag{0x0024, 0x0081}, "FL", "CorrectedLocalizedDeviationFromNormalProbability", "1"}
-	tagDict[Tag{0x0024, 0x0083}] = Info{Tag{0x0024, 0x0083}, "SQ", "GlobalDeviationProbabilitySequence", "1"}
-	tagDict[Tag{0x0024, 0x0085}] = Info{Tag{0x0024, 0x0085}, "SQ", "LocalizedDeviationProbabilitySequence", "1"}
-	tagDict[Tag{0x0024, 0x0086}] = Info{Tag{0x0024, 0x0086}, "CS", "FovealSensitivityMeasured", "1"}
-	tagDict[Tag{0x0024, 0x0087}] = Info{Tag{0x0024, 0x0087}, "FL", "FovealSensitivity", "1"}
-	tagDict[Tag{0x0024, 0x0088}] = Info{Tag{0x0024, 0x0088}, "FL", "VisualFieldTestDuration", "1"}
-	tagDict[Tag{0x0024, 0x0089}] = Info{Tag{0x0024, 0x0089}, "SQ", "VisualFieldTestPointSequence", "1"}
-	tagDict[Tag{0x0024, 0x0090}] = Info{Tag{0x0024, 0x0090}, "FL", "VisualFieldTestPointXCoordinate", "1"}
-	tagDict[Tag{0x0024, 0x0091}] = Info{Tag{0x0024, 0x0091}, "FL", "VisualFieldTestPointYCoordinate", "1"}
-	tagDict[Tag{0x0024, 0x0092}] = Info{Tag{0x0024, 0x0092}, "FL", "AgeCorrectedSensitivityDeviationValue", "1"}
-	tagDict[Tag{0x0024, 0x0093}] = Info{Tag{0x0024, 0x0093}, "CS", "StimulusResults", "1"}
-	tagDict[Tag{0x0024, 0x0094}] = Info{Tag{0x0024, 0x0094}, "FL", "SensitivityValue", "1"}
-	tagDict[Tag{0x0024, 0x0095}] = Info{Tag{0x0024, 0x0095}, "CS", "RetestStimulusSeen", "1"}
-	tagDict[Tag{0x0024, 0x0096}] = Info{Tag{0x0024, 0x0096}, "FL", "RetestSensitivityValue", "1"}
-	tagDict[Tag{0x0024, 0x0097}] = Info{Tag{0x0024, 0x0097}, "SQ", "VisualFieldTestPointNormalsSequence", "1"}
-	tagDict[Tag{0x0024, 0x0098}] = Info{Tag{0x0024, 0x0098}, "FL", "QuantifiedDefect", "1"}
-	tagDict[Tag{0x0024, 0x0100}] = Info{Tag{0x0024, 0x0100}, "FL", "AgeCorrectedSensitivityDeviationProbabilityValue", "1"}
-	tagDict[Tag{0x0024, 0x0102}] = Info{Tag{0x0024, 0x0102}, "CS", "GeneralizedDefectCorrectedSensitivityDeviationFlag", "1"}
-	tagDict[Tag{0x0024, 0x0103}] = Info{Tag{0x0024, 0x0103}, "FL", "GeneralizedDefectCorrectedSensitivityDeviationValue", "1"}
-	tagDict[Tag{0x0024, 0x0104}] = Info{Tag{0x0024, 0x0104}, "FL", "GeneralizedDefectCorrectedSensitivityDeviationProbabilityValue", "1"}
-	tagDict[Tag{0x0024, 0x0105}] = Info{Tag{0x0024, 0x0105}, "FL", "MinimumSensitivityValue", "1"}
-	tagDict[Tag{0x0024, 0x0106}] = Info{Tag{0x0024, 0x0106}, "CS", "BlindSpotLocalized", "1"}
-	tagDict[Tag{0x0024, 0x0107}] = Info{Tag{0x0024, 0x0107}, "FL", "BlindSpotXCoordinate", "1"}
-	tagDict[Tag{0x0024, 0x0108}] = Info{Tag{0x0024, 0x0108}, "FL", "BlindSpotYCoordinate", "1"}
-	tagDict[Tag{0x0024, 0x0110}] = Info{Tag{0x0024, 0x0110}, "SQ", "VisualAcuityMeasurementSequence", "1"}
-	tagDict[Tag{0x0024, 0x0112}] = Info{Tag{0x0024, 0x0112}, "SQ", "RefractiveParametersUsedOnPatientSequence", "1"}
-	tagDict[Tag{0x0024, 0x0113}] = Info{Tag{0x0024, 0x0113}, "CS", "MeasurementLaterality", "1"}
-	tagDict[Tag{0x0024, 0x0114}] = Info{Tag{0x0024, 0x0114}, "SQ", "OphthalmicPatientClinicalInformationLeftEyeSequence", "1"}
-	tagDict[Tag{0x0024, 0x0115}] = Info{Tag{0x0024, 0x0115}, "SQ", "OphthalmicPatientClinicalInformationRightEyeSequence", "1"}
-	tagDict[Tag{0x0024, 0x0117}] = Info{Tag{0x0024, 0x0117}, "CS", "FovealPointNormativeDataFlag", "1"}
-	tagDict[Tag{0x0024, 0x0118}] = Info{Tag{0x0024, 0x0118}, "FL", "FovealPointProbabilityValue", "1"}
-	tagDict[Tag{0x0024, 0x0120}] = Info{Tag{0x0024, 0x0120}, "CS", "ScreeningBaselineMeasured", "1"}
-	tagDict[Tag{0x0024, 0x0122}] = Info{Tag{0x0024, 0x0122}, "SQ", "ScreeningBaselineMeasuredSequence", "1"}
-	tagDict[Tag{0x0024, 0x0124}] = Info{Tag{0x0024, 0x0124}, "CS", "ScreeningBaselineType", "1"}
-	tagDict[Tag{0x0024, 0x0126}] = Info{Tag{0x0024, 0x0126}, "FL", "ScreeningBaselineValue", "1"}
-	tagDict[Tag{0x0024, 0x0202}] = Info{Tag{0x0024, 0x0202}, "LO", "AlgorithmSource", "1"}
-	tagDict[Tag{0x0024, 0x0306}] = Info{Tag{0x0024, 0x0306}, "LO", "DataSetName", "1"}
-	tagDict[Tag{0x0024, 0x0307}] = Info{Tag{0x0024, 0x0307}, "LO", "DataSetVersion", "1"}
-	tagDict[Tag{0x0024, 0x0308}] = Info{Tag{0x0024, 0x0308}, "LO", "DataSetSource", "1"}
-	tagDict[Tag{0x0024, 0x0309}] = Info{Tag{0x0024, 0x0309}, "LO", "DataSetDescription", "1"}
-	tagDict[Tag{0x0024, 0x0317}] = Info{Tag{0x0024, 0x0317}, "SQ", "VisualFieldTestReliabilityGlobalIndexSequence", "1"}
-	tagDict[Tag{0x0024, 0x0320}] = Info{Tag{0x0024, 0x0320}, "SQ", "VisualFieldGlobalResultsIndexSequence", "1"}
-	tagDict[Tag{0x0024, 0x0325}] = Info{Tag{0x0024, 0x0325}, "SQ", "DataObservationSequence", "1"}
-	tagDict[Tag{0x0024, 0x0338}] = Info{Tag{0x0024, 0x0338}, "CS", "IndexNormalsFlag", "1"}
-	tagDict[Tag{0x0024, 0x0341}] = Info{Tag{0x0024, 0x0341}, "FL", "IndexProbability", "1"}
-	tagDict[Tag{0x0024, 0x0344}] = Info{Tag{0x0024, 0x0344}, "SQ", "IndexProbabilitySequence", "1"}
-	tagDict[Tag{0x0028, 0x0002}] = Info{Tag{0x0028, 0x0002}, "US", "SamplesPerPixel", "1"}
-	tagDict[Tag{0x0028, 0x0003}] = Info{Tag{0x0028, 0x0003}, "US", "SamplesPerPixelUsed", "1"}
-	tagDict[Tag{0x0028, 0x0004}] = Info{Tag{0x0028, 0x0004}, "CS", "PhotometricInterpretation", "1"}
-	tagDict[Tag{0x0028, 0x0006}] = Info{Tag{0x0028, 0x0006}, "US", "PlanarConfiguration", "1"}
-	tagDict[Tag{0x0028, 0x0008}] = Info{Tag{0x0028, 0x0008}, "IS", "NumberOfFrames", "1"}
-	tagDict[Tag{0x0028, 0x0009}] = Info{Tag{0x0028, 0x0009}, "AT", "FrameIncrementPointer", "1-n"}
-	tagDict[Tag{0x0028, 0x000A}] = Info{Tag{0x0028, 0x000A}, "AT", "FrameDimensionPointer", "1-n"}
-	tagDict[Tag{0x0028, 0x0010}] = Info{Tag{0x0028, 0x0010}, "US", "Rows", "1"}
-	tagDict[Tag{0x0028, 0x0011}] = Info{Tag{0x0028, 0x0011}, "US", "Columns", "1"}
-	tagDict[Tag{0x0028, 0x0014}] = Info{Tag{0x0028, 0x0014}, "US", "UltrasoundColorDataPresent", "1"}
-	tagDict[Tag{0x0028, 0x0030}] = Info{Tag{0x0028, 0x0030}, "DS", "PixelSpacing", "2"}
-	tagDict[Tag{0x0028, 0x0031}] = Info{Tag{0x0028, 0x0031}, "DS", "ZoomFactor", "2"}
-	tagDict[Tag{0x0028, 0x0032}] = Info{Tag{0x0028, 0x0032}, "DS", "ZoomCenter", "2"}
-	tagDict[Tag{0x0028, 0x0034}] = Info{Tag{0x0028, 0x0034}, "IS", "PixelAspectRatio", "2"}
-	tagDict[Tag{0x0028, 0x0051}] = Info{Tag{0x0028, 0x0051}, "CS", "CorrectedImage", "1-n"}
-	tagDict[Tag{0x0028, 0x0100}] = Info{Tag{0x0028, 0x0100}, "US", "BitsAllocated", "1"}
-	tagDict[Tag{0x0028, 0x0101}] = Info{Tag{0x0028, 0x0101}, "US", "BitsStored", "1"}
-	tagDict[Tag{0x0028, 0x0102}] = Info{Tag{0x0028, 0x0102}, "US", "HighBit", "1"}
-	tagDict[Tag{0x0028, 0x0103}] = Info{Tag{0x0028, 0x0103}, "US", "PixelRepresentation", "1"}
-	tagDict[Tag{0x0028, 0x0106}] = Info{Tag{0x0028, 0x0106}, "US", "SmallestImagePixelValue", "1"}
-	tagDict[Tag{0x0028, 0x0107}] = Info{Tag{0x0028, 0x0107}, "US", "LargestImagePixelValue", "1"}
-	tagDict[Tag{0x0028, 0x0108}] = Info{Tag{0x0028, 0x0108}, "US", "SmallestPixelValueInSeries", "1"}
-	tagDict[Tag{0x0028, 0x0109}] = Info{Tag{0x0028, 0x0109}, "US", "LargestPixelValueInSeries", "1"}
-	tagDict[Tag{0x0028, 0x0120}] = Info{Tag{0x0028, 0x0120}, "US", "PixelPaddingValue", "1"}
-	tagDict[Tag{0x0028, 0x0121}] = Info{Tag{0x0028, 0x0121}, "US", "PixelPaddingRangeLimit", "1"}
-	tagDict[Tag{0x0028, 0x0300}] = Info{Tag{0x0028, 0x0300}, "CS", "QualityControlImage", "1"}
-	tagDict[Tag{0x0028, 0x0301}] = Info{Tag{0x0028, 0x0301}, "CS", "BurnedInAnnotation", "1"}
-	tagDict[Tag{0x0028, 0x0302}] = Info{Tag{0x0028, 0x0302}, "CS", "RecognizableVisualFeatures", "1"}
-	tagDict[Tag{0x0028, 0x0303}] = Info{Tag{0x0028, 0x0303}, "CS", "LongitudinalTemporalInformationModified", "1"}
-	tagDict[Tag{0x0028, 0x0304}] = Info{Tag{0x0028, 0x0304}, "UI", "ReferencedColorPaletteInstanceUID", "1"}
-	tagDict[Tag{0x0028, 0x0A02}] = Info{Tag{0x0028, 0x0A02}, "CS", "PixelSpacingCalibrationType", "1"}
-	tagDict[Tag{0x0028, 0x0A04}] = Info{Tag{0x0028, 0x0A04}, "LO", "PixelSpacingCalibrationDescription", "1"}
-	tagDict[Tag{0x0028, 0x1040}] = Info{Tag{0x0028, 0x1040}, "CS", "PixelIntensityRelationship", "1"}
-	tagDict[Tag{0x0028, 0x1041}] = Info{Tag{0x0028, 0x1041}, "SS", "PixelIntensityRelationshipSign", "1"}
-	tagDict[Tag{0x0028, 0x1050}] = Info{Tag{0x0028, 0x1050}, "DS", "WindowCenter", "1-n"}
-	tagDict[Tag{0x0028, 0x1051}] = Info{Tag{0x0028, 0x1051}, "DS", "WindowWidth", "1-n"}
-	tagDict[Tag{0x0028, 0x1052}] = Info{Tag{0x0028, 0x1052}, "DS", "RescaleIntercept", "1"}
-	tagDict[Tag{0x0028, 0x1053}] = Info{Tag{0x0028, 0x1053}, "DS", "RescaleSlope", "1"}
-	tagDict[Tag{0x0028, 0x1054}] = Info{Tag{0x0028, 0x1054}, "LO", "RescaleType", "1"}
-	tagDict[Tag{0x0028, 0x1055}] = Info{Tag{0x0028, 0x1055}, "LO", "WindowCenterWidthExplanation", "1-n"}
-	tagDict[Tag{0x0028, 0x1056}] = Info{Tag{0x0028, 0x1056}, "CS", "VOILUTFunction", "1"}
-	tagDict[Tag{0x0028, 0x1090}] = Info{Tag{0x0028, 0x1090}, "CS", "RecommendedViewingMode", "1"}
-	tagDict[Tag{0x0028, 0x1101}] = Info{Tag{0x0028, 0x1101}, "US", "RedPaletteColorLookupTableDescriptor", "3"}
-	tagDict[Tag{0x0028, 0x1102}] = Info{Tag{0x0028, 0x1102}, "US", "GreenPaletteColorLookupTableDescriptor", "3"}
-	tagDict[Tag{0x0028, 0x1103}] = Info{Tag{0x0028, 0x1103}, "US", "BluePaletteColorLookupTableDescriptor", "3"}
-	tagDict[Tag{0x0028, 0x1104}] = Info{Tag{0x0028, 0x1104}, "US", "AlphaPaletteColorLookupTableDescriptor", "3"}
-	tagDict[Tag{0x0028, 0x1199}] = Info{Tag{0x0028, 0x1199}, "UI", "PaletteColorLookupTableUID", "1"}
-	tagDict[Tag{0x0028, 0x1201}] = Info{Tag{0x0028, 0x1201}, "OW", "RedPaletteColorLookupTableData", "1"}
-	tagDict[Tag{0x0028, 0x1202}] = Info{Tag{0x0028, 0x1202}, "OW", "GreenPaletteColorLookupTableData", "1"}
-	tagDict[Tag{0x0028, 0x1203}] = Info{Tag{0x0028, 0x1203}, "OW", "BluePaletteColorLookupTableData", "1"}
-	tagDict[Tag{0x0028, 0x1204}] = Info{Tag{0x0028, 0x1204}, "OW", "AlphaPaletteColorLookupTableData", "1"}
-	tagDict[Tag{0x0028, 0x1221}] = Info{Tag{0x0028, 0x1221}, "OW", "SegmentedRedPaletteColorLookupTableData", "1"}
-	tagDict[Tag{0x0028, 0x1222}] = Info{Tag{0x0028, 0x1222}, "OW", "SegmentedGreenPaletteColorLookupTableData", "1"}
-	tagDict[Tag{0x0028, 0x1223}] = Info{Tag{0x0028, 0x1223}, "OW", "SegmentedBluePaletteColorLookupTableData", "1"}
-	tagDict[Tag{0x0028, 0x1300}] = Info{Tag{0x0028, 0x1300}, "CS", "BreastImplantPresent", "1"}
-	tagDict[Tag{0x0028, 0x1350}] = Info{Tag{0x0028, 0x1350}, "CS", "PartialView", "1"}
-	tagDict[Tag{0x0028, 0x1351}] = Info{Tag{0x0028, 0x1351}, "ST", "PartialViewDescription", "1"}
-	tagDict[Tag{0x0028, 0x1352}] = Info{Tag{0x0028, 0x1352}, "SQ", "PartialViewCodeSequence", "1"}
-	tagDict[Tag{0x0028, 0x135A}] = Info{Tag{0x0028, 0x135A}, "CS", "SpatialLocationsPreserved", "1"}
-	tagDict[Tag{0x0028, 0x1401}] = Info{Tag{0x0028, 0x1401}, "SQ", "DataFrameAssignmentSequence", "1"}
-	tagDict[Tag{0x0028, 0x1402}] = Info{Tag{0x0028, 0x1402}, "CS", "DataPathAssignment", "1"}
-	tagDict[Tag{0x0028, 0x1403}] = Info{Tag{0x0028, 0x1403}, "US", "BitsMappedToColorLookupTable", "1"}
-	tagDict[Tag{0x0028, 0x1404}] = Info{Tag{0x0028, 0x1404}, "SQ", "BlendingLUT1Sequence", "1"}
-	tagDict[Tag{0x0028, 0x1405}] = Info{Tag{0x0028, 0x1405}, "CS", "BlendingLUT1TransferFunction", "1"}
-	tagDict[Tag{0x0028, 0x1406}] = Info{Tag{0x0028, 0x1406}, "FD", "BlendingWeightConstant", "1"}
-	tagDict[Tag{0x0028, 0x1407}] = Info{Tag{0x0028, 0x1407}, "US", "BlendingLookupTableDescriptor", "3"}
-	tagDict[Tag{0x0028, 0x1408}] = Info{Tag{0x0028, 0x1408}, "OW", "BlendingLookupTableData", "1"}
-	tagDict[Tag{0x0028, 0x140B}] = Info{Tag{0x0028, 0x140B}, "SQ", "EnhancedPaletteColorLookupTableSequence", "1"}
-	tagDict[Tag{0x0028, 0x140C}] = Info{Tag{0x0028, 0x140C}, "SQ", "BlendingLUT2Sequence", "1"}
-	tagDict[Tag{0x0028, 0x140D}] = Info{Tag{0x0028, 0x140D}, "CS", "BlendingLUT2TransferFunction", "1"}
-	tagDict[Tag{0x0028, 0x140E}] = Info{Tag{0x0028, 0x140E}, "CS", "DataPathID", "1"}
-	tagDict[Tag{0x0028, 0x140F}] = Info{Tag{0x0028, 0x140F}, "CS", "RGBLUTTransferFunction", "1"}
-	tagDict[Tag{0x0028, 0x1410}] = Info{Tag{0x0028, 0x1410}, "CS", "AlphaLUTTransferFunction", "1"}
-	tagDict[Tag{0x0028, 0x2000}] = Info{Tag{0x0028, 0x2000}, "OB", "ICCProfile", "1"}
-	tagDict[Tag{0x0028, 0x2110}] = Info{Tag{0x0028, 0x2110}, "CS", "LossyImageCompression", "1"}
-	tagDict[Tag{0x0028, 0x2112}] = Info{Tag{0x0028, 0x2112}, "DS", "LossyImageCompressionRatio", "1-n"}
-	tagDict[Tag{0x0028, 0x2114}] = Info{Tag{0x0028, 0x2114}, "CS", "LossyImageCompressionMethod", "1-n"}
-	tagDict[Tag{0x0028, 0x3000}] = Info{Tag{0x0028, 0x3000}, "SQ", "ModalityLUTSequence", "1"}
-	tagDict[Tag{0x0028, 0x3002}] = Info{Tag{0x0028, 0x3002}, "US", "LUTDescriptor", "3"}
-	tagDict[Tag{0x0028, 0x3003}] = Info{Tag{0x0028, 0x3003}, "LO", "LUTExplanation", "1"}
-	tagDict[Tag{0x0028, 0x3004}] = Info{Tag{0x0028, 0x3004}, "LO", "ModalityLUTType", "1"}
-	tagDict[Tag{0x0028, 0x3006}] = Info{Tag{0x0028, 0x3006}, "LT", "LUTData", "1-n"}
-	tagDict[Tag{0x0028, 0x3010}] = Info{Tag{0x0028, 0x3010}, "SQ", "VOILUTSequence", "1"}
-	tagDict[Tag{0x0028, 0x3110}] = Info{Tag{0x0028, 0x3110}, "SQ", "SoftcopyVOILUTSequence", "1"}
-	tagDict[Tag{0x0028, 0x6010}] = Info{Tag{0x0028, 0x6010}, "US", "RepresentativeFrameNumber", "1"}
-	tagDict[Tag{0x0028, 0x6020}] = Info{Tag{0x0028, 0x6020}, "US", "FrameNumbersOfInterest", "1-n"}
-	tagDict[Tag{0x0028, 0x6022}] = Info{Tag{0x0028, 0x6022}, "LO", "FrameOfInterestDescription", "1-n"}
-	tagDict[Tag{0x0028, 0x6023}] = Info{Tag{0x0028, 0x6023}, "CS", "FrameOfInterestType", "1-n"}
-	tagDict[Tag{0x0028, 0x6040}] = Info{Tag{0x0028, 0x6040}, "US", "RWavePointer", "1-n"}
-	tagDict[Tag{0x0028, 0x6100}] = Info{Tag{0x0028, 0x6100}, "SQ", "MaskSubtractionSequence", "1"}
-	tagDict[Tag{0x0028, 0x6101}] = Info{Tag{0x0028, 0x6101}, "CS", "MaskOperation", "1"}
-	tagDict[Tag{0x0028, 0x6102}] = Info{Tag{0x0028, 0x6102}, "US", "ApplicableFrameRange", "2-2n"}
-	tagDict[Tag{0x0028, 0x6110}] = Info{Tag{0x0028, 0x6110}, "US", "MaskFrameNumbers", "1-n"}
-	tagDict[Tag{0x0028, 0x6112}] = Info{Tag{0x0028, 0x6112}, "US", "ContrastFrameAveraging", "1"}
-	tagDict[Tag{0x0028, 0x6114}] = Info{Tag{0x0028, 0x6114}, "FL", "MaskSubPixelShift", "2"}
-	tagDict[Tag{0x0028, 0x6120}] = Info{Tag{0x0028, 0x6120}, "SS", "TIDOffset", "1"}
-	tagDict[Tag{0x0028, 0x6190}] = Info{Tag{0x0028, 0x6190}, "ST", "MaskOperationExplanation", "1"}
-	tagDict[Tag{0x0028, 0x7FE0}] = Info{Tag{0x0028, 0x7FE0}, "UT", "PixelDataProviderURL", "1"}
-	tagDict[Tag{0x0028, 0x9001}] = Info{Tag{0x0028, 0x9001}, "UL", "DataPointRows", "1"}
-	tagDict[Tag{0x0028, 0x9002}] = Info{Tag{0x0028, 0x9002}, "UL", "DataPointColumns", "1"}
-	tagDict[Tag{0x0028, 0x9003}] = Info{Tag{0x0028, 0x9003}, "CS", "SignalDomainColumns", "1"}
-	tagDict[Tag{0x0028, 0x9108}] = Info{Tag{0x0028, 0x9108}, "CS", "DataRepresentation", "1"}
-	tagDict[Tag{0x0028, 0x9110}] = Info{Tag{0x0028, 0x9110}, "SQ", "PixelMeasuresSequence", "1"}
-	tagDict[Tag{0x0028, 0x9132}] = Info{Tag{0x0028, 0x9132}, "SQ", "FrameVOILUTSequence", "1"}
-	tagDict[Tag{0x0028, 0x9145}] = Info{Tag{0x0028, 0x9145}, "SQ", "PixelValueTransformationSequence", "1"}
-	tagDict[Tag{0x0028, 0x9235}] = Info{Tag{0x0028, 0x9235}, "CS", "SignalDomainRows", "1"}
-	tagDict[Tag{0x0028, 0x9411}] = Info{Tag{0x0028, 0x9411}, "FL", "DisplayFilterPercentage", "1"}
-	tagDict[Tag{0x0028, 0x9415}] = Info{Tag{0x0028, 0x9415}, "SQ", "FramePixelShiftSequence", "1"}
-	tagDict[Tag{0x0028, 0x9416}] = Info{Tag{0x0028, 0x9416}, "US", "SubtractionItemID", "1"}
-	tagDict[Tag{0x0028, 0x9422}] = Info{Tag{0x0028, 0x9422}, "SQ", "PixelIntensityRelationshipLUTSequence", "1"}
-	tagDict[Tag{0x0028, 0x9443}] = Info{Tag{0x0028, 0x9443}, "SQ", "FramePixelDataPropertiesSequence", "1"}
-	tagDict[Tag{0x0028, 0x9444}] = Info{Tag{0x0028, 0x9444}, "CS", "GeometricalProperties", "1"}
-	tagDict[Tag{0x0028, 0x9445}] = Info{Tag{0x0028, 0x9445}, "FL", "GeometricMaximumDistortion", "1"}
-	tagDict[Tag{0x0028, 0x9446}] = Info{Tag{0x0028, 0x9446}, "CS", "ImageProcessingApplied", "1-n"}
-	tagDict[Tag{0x0028, 0x9454}] = Info{Tag{0x0028, 0x9454}, "CS", "MaskSelectionMode", "1"}
-	tagDict[Tag{0x0028, 0x9474}] = Info{Tag{0x0028, 0x9474}, "CS", "LUTFunction", "1"}
-	tagDict[Tag{0x0028, 0x9478}] = Info{Tag{0x0028, 0x9478}, "FL", "MaskVisibilityPercentage", "1"}
-	tagDict[Tag{0x0028, 0x9501}] = Info{Tag{0x0028, 0x9501}, "SQ", "PixelShiftSequence", "1"}
-	tagDict[Tag{0x0028, 0x9502}] = Info{Tag{0x0028, 0x9502}, "SQ", "RegionPixelShiftSequence", "1"}
-	tagDict[Tag{0x0028, 0x9503}] = Info{Tag{0x0028, 0x9503}, "SS", "VerticesOfTheRegion", "2-2n"}
-	tagDict[Tag{0x0028, 0x9505}] = Info{Tag{0x0028, 0x9505}, "SQ", "MultiFramePresentationSequence", "1"}
-	tagDict[Tag{0x0028, 0x9506}] = Info{Tag{0x0028, 0x9506}, "US", "PixelShiftFrameRange", "2-2n"}
-	tagDict[Tag{0x0028, 0x9507}] = Info{Tag{0x0028, 0x9507}, "US", "LUTFrameRange", "2-2n"}
-	tagDict[Tag{0x0028, 0x9520}] = Info{Tag{0x0028, 0x9520}, "DS", "ImageToEquipmentMappingMatrix", "16"}
-	tagDict[Tag{0x0028, 0x9537}] = Info{Tag{0x0028, 0x9537}, "CS", "EquipmentCoordinateSystemIdentification", "1"}
-	tagDict[Tag{0x0032, 0x1031}] = Info{Tag{0x0032, 0x1031}, "SQ", "RequestingPhysicianIdentificationSequence", "1"}
-	tagDict[Tag{0x0032, 0x1032}] = Info{Tag{0x0032, 0x1032}, "PN", "RequestingPhysician", "1"}
-	tagDict[Tag{0x0032, 0x1033}] = Info{Tag{0x0032, 0x1033}, "LO", "RequestingService", "1"}
-	tagDict[Tag{0x0032, 0x1034}] = Info{Tag{0x0032, 0x1034}, "SQ", "RequestingServiceCodeSequence", "1"}
-	tagDict[Tag{0x0032, 0x1060}] = Info{Tag{0x0032, 0x1060}, "LO", "RequestedProcedureDescription", "1"}
-	tagDict[Tag{0x0032, 0x1064}] = Info{Tag{0x0032, 0x1064}, "SQ", "RequestedProcedureCodeSequence", "1"}
-	tagDict[Tag{0x0032, 0x1070}] = Info{Tag{0x0032, 0x1070}, "LO", "RequestedContrastAgent", "1"}
-	tagDict[Tag{0x0038, 0x0004}] = Info{Tag{0x0038, 0x0004}, "SQ", "ReferencedPatientAliasSequence", "1"}
-	tagDict[Tag{0x0038, 0x0008}] = Info{Tag{0x0038, 0x0008}, "CS", "VisitStatusID", "1"}
-	tagDict[Tag{0x0038, 0x0010}] = Info{Tag{0x0038, 0x0010}, "LO", "AdmissionID", "1"}
-	tagDict[Tag{0x0038, 0x0014}] = Info{Tag{0x0038, 0x0014}, "SQ", "IssuerOfAdmissionIDSequence", "1"}
-	tagDict[Tag{0x0038, 0x0016}] = Info{Tag{0x0038, 0x0016}, "LO", "RouteOfAdmissions", "1"}
-	tagDict[Tag{0x0038, 0x0020}] = Info{Tag{0x0038, 0x0020}, "DA", "AdmittingDate", "1"}
-	tagDict[Tag{0x0038, 0x0021}] = Info{Tag{0x0038, 0x0021}, "TM", "AdmittingTime", "1"}
-	tagDict[Tag{0x0038, 0x0050}] = Info{Tag{0x0038, 0x0050}, "LO", "SpecialNeeds", "1"}
-	tagDict[Tag{0x0038, 0x0060}] = Info{Tag{0x0038, 0x0060}, "LO", "ServiceEpisodeID", "1"}
-	tagDict[Tag{0x0038, 0x0062}] = Info{Tag{0x0038, 0x0062}, "LO", "ServiceEpisodeDescription", "1"}
-	tagDict[Tag{0x0038, 0x0064}] = Info{Tag{0x0038, 0x0064}, "SQ", "IssuerOfServiceEpisodeIDSequence", "1"}
-	tagDict[Tag{0x0038, 0x0100}] = Info{Tag{0x0038, 0x0100}, "SQ", "PertinentDocumentsSequence", "1"}
-	tagDict[Tag{0x0038, 0x0300}] = Info{Tag{0x0038, 0x0300}, "LO", "CurrentPatientLocation", "1"}
-	tagDict[Tag{0x0038, 0x0400}] = Info{Tag{0x0038, 0x0400}, "LO", "PatientInstitutionResidence", "1"}
-	tagDict[Tag{0x0038, 0x0500}] = Info{Tag{0x0038, 0x0500}, "LO", "PatientState", "1"}
-	tagDict[Tag{0x0038, 0x0502}] = Info{Tag{0x0038, 0x0502}, "SQ", "PatientClinicalTrialParticipationSequence", "1"}
-	tagDict[Tag{0x0038, 0x4000}] = Info{Tag{0x0038, 0x4000}, "LT", "VisitComments", "1"}
-	tagDict[Tag{0x003A, 0x0004}] = Info{Tag{0x003A, 0x0004}, "CS", "WaveformOriginality", "1"}
-	tagDict[Tag{0x003A, 0x0005}] = Info{Tag{0x003A, 0x0005}, "US", "NumberOfWaveformChannels", "1"}
-	tagDict[Tag{0x003A, 0x0010}] = Info{Tag{0x003A, 0x0010}, "UL", "NumberOfWaveformSamples", "1"}
-	tagDict[Tag{0x003A, 0x001A}] = Info{Tag{0x003A, 0x001A}, "DS", "SamplingFrequency", "1"}
-	tagDict[Tag{0x003A, 0x0020}] = Info{Tag{0x003A, 0x0020}, "SH", "MultiplexGroupLabel", "1"}
-	tagDict[Tag{0x003A, 0x0200}] = Info{Tag{0x003A, 0x0200}, "SQ", "ChannelDefinitionSequence", "1"}
-	tagDict[Tag{0x003A, 0x0202}] = Info{Tag{0x003A, 0x0202}, "IS", "WaveformChannelNumber", "1"}
-	tagDict[Tag{0x003A, 0x0203}] = Info{Tag{0x003A, 0x0203}, "SH", "ChannelLabel", "1"}
-	tagDict[Tag{0x003A, 0x0205}] = Info{Tag{0x003A, 0x0205}, "CS", "ChannelStatus", "1-n"}
-	tagDict[Tag{0x003A, 0x0208}] = Info{Tag{0x003A, 0x0208}, "SQ", "ChannelSourceSequence", "1"}
-	tagDict[Tag{0x003A, 0x0209}] = Info{Tag{0x003A, 0x0209}, "SQ", "ChannelSourceModifiersSequence", "1"}
-	tagDict[Tag{0x003A, 0x020A}] = Info{Tag{0x003A, 0x020A}, "SQ", "SourceWaveformSequence", "1"}
-	tagDict[Tag{0x003A, 0x020C}] = Info{Tag{0x003A, 0x020C}, "LO", "ChannelDerivationDescription", "1"}
-	tagDict[Tag{0x003A, 0x0210}] = Info{Tag{0x003A, 0x0210}, "DS", "ChannelSensitivity", "1"}
-	tagDict[Tag{0x003A, 0x0211}] = Info{Tag{0x003A, 0x0211}, "SQ", "ChannelSensitivityUnitsSequence", "1"}
-	tagDict[Tag{0x003A, 0x0212}] = Info{Tag{0x003A, 0x0212}, "DS", "ChannelSensitivityCorrectionFactor", "1"}
-	tagDict[Tag{0x003A, 0x0213}] = Info{Tag{0x003A, 0x0213}, "DS", "ChannelBaseline", "1"}
-	tagDict[Tag{0x003A, 0x0214}] = Info{Tag{0x003A, 0x0214}, "DS", "ChannelTimeSkew", "1"}
-	tagDict[Tag{0x003A, 0x0215}] = Info{Tag{0x003A, 0x0215}, "DS", "ChannelSampleSkew", "1"}
-	tagDict[Tag{0x003A, 0x0218}] = Info{Tag{0x003A, 0x0218}, "DS", "ChannelOffset", "1"}
-	tagDict[Tag{0x003A, 0x021A}] = Info{Tag{0x003A, 0x021A}, "US", "WaveformBitsStored", "1"}
-	tagDict[Tag{0x003A, 0x0220}] = Info{Tag{0x003A, 0x0220}, "DS", "FilterLowFrequency", "1"}
-	tagDict[Tag{0x003A, 0x0221}] = Info{Tag{0x003A, 0x0221}, "DS", "FilterHighFrequency", "1"}
-	tagDict[Tag{0x003A, 0x0222}] = Info{Tag{0x003A, 0x0222}, "DS", "NotchFilterFrequency", "1"}
-	tagDict[Tag{0x003A, 0x0223}] = Info{Tag{0x003A, 0x0223}, "DS", "NotchFilterBandwidth", "1"}
-	tagDict[Tag{0x003A, 0x0230}] = Info{Tag{0x003A, 0x0230}, "FL", "WaveformDataDisplayScale", "1"}
-	tagDict[Tag{0x003A, 0x0231}] = Info{Tag{0x003A, 0x0231}, "US", "WaveformDisplayBackgroundCIELabValue", "3"}
-	tagDict[Tag{0x003A, 0x0240}] = Info{Tag{0x003A, 0x0240}, "SQ", "WaveformPresentationGroupSequence", "1"}
-	tagDict[Tag{0x003A, 0x0241}] = Info{Tag{0x003A, 0x0241}, "US", "PresentationGroupNumber", "1"}
-	tagDict[Tag{0x003A, 0x0242}] = Info{Tag{0x003A, 0x0242}, "SQ", "ChannelDisplaySequence", "1"}
-	tagDict[Tag{0x003A, 0x0244}] = Info{Tag{0x003A, 0x0244}, "US", "ChannelRecommendedDisplayCIELabValue", "3"}
-	tagDict[Tag{0x003A, 0x0245}] = Info{Tag{0x003A, 0x0245}, "FL", "ChannelPosition", "1"}
-	tagDict[Tag{0x003A, 0x0246}] = Info{Tag{0x003A, 0x0246}, "CS", "DisplayShadingFlag", "1"}
-	tagDict[Tag{0x003A, 0x0247}] = Info{Tag{0x003A, 0x0247}, "FL", "FractionalChannelDisplayScale", "1"}
-	tagDict[Tag{0x003A, 0x0248}] = Info{Tag{0x003A, 0x0248}, "FL", "AbsoluteChannelDisplayScale", "1"}
-	tagDict[Tag{0x003A, 0x0300}] = Info{Tag{0x003A, 0x0300}, "SQ", "MultiplexedAudioChannelsDescriptionCodeSequence", "1"}
-	tagDict[Tag{0x003A, 0x0301}] = Info{Tag{0x003A, 0x0301}, "IS", "ChannelIdentificationCode", "1"}
-	tagDict[Tag{0x003A, 0x0302}] = Info{Tag{0x003A, 0x0302}, "CS", "ChannelMode", "1"}
-	tagDict[Tag{0x0040, 0x0001}] = Info{Tag{0x0040, 0x0001}, "AE", "ScheduledStationAETitle", "1-n"}
-	tagDict[Tag{0x0040, 0x0002}] = Info{Tag{0x0040, 0x0002}, "DA", "ScheduledProcedureStepStartDate", "1"}
-	tagDict[Tag{0x0040, 0x0003}] = Info{Tag{0x0040, 0x0003}, "TM", "ScheduledProcedureStepStartTime", "1"}
-	tagDict[Tag{0x0040, 0x0004}] = Info{Tag{0x0040, 0x0004}, "DA", "ScheduledProcedureStepEndDate", "1"}
-	tagDict[Tag{0x0040, 0x0005}] = Info{Tag{0x0040, 0x0005}, "TM", "ScheduledProcedureStepEndTime", "1"}
-	tagDict[Tag{0x0040, 0x0006}] = Info{Tag{0x0040, 0x0006}, "PN", "ScheduledPerformingPhysicianName", "1"}
-	tagDict[Tag{0x0040, 0x0007}] = Info{Tag{0x0040, 0x0007}, "LO", "ScheduledProcedureStepDescription", "1"}
-	tagDict[Tag{0x0040, 0x0008}] = Info{Tag{0x0040, 0x0008}, "SQ", "ScheduledProtocolCodeSequence", "1"}
-	tagDict[Tag{0x0040, 0x0009}] = Info{Tag{0x0040, 0x0009}, "SH", "ScheduledProcedureStepID", "1"}
-	tagDict[Tag{0x0040, 0x000A}] = Info{Tag{0x0040, 0x000A}, "SQ", "StageCodeSequence", "1"}
-	tagDict[Tag{0x0040, 0x000B}] = Info{Tag{0x0040, 0x000B}, "SQ", "ScheduledPerformingPhysicianIdentificationSequence", "1"}
-	tagDict[Tag{0x0040, 0x0010}] = Info{Tag{0x0040, 0x0010}, "SH", "ScheduledStationName", "1-n"}
-	tagDict[Tag{0x0040, 0x0011}] = Info{Tag{0x0040, 0x0011}, "SH", "ScheduledProcedureStepLocation", "1"}
-	tagDict[Tag{0x0040, 0x0012}] = Info{Tag{0x0040, 0x0012}, "LO", "PreMedication", "1"}
-	tagDict[Tag{0x0040, 0x0020}] = Info{Tag{0x0040, 0x0020}, "CS", "ScheduledProcedureStepStatus", "1"}
-	tagDict[Tag{0x0040, 0x0026}] = Info{Tag{0x0040, 0x0026}, "SQ", "OrderPlacerIdentifierSequence", "1"}
-	tagDict[Tag{0x0040, 0x0027}] = Info{Tag{0x0040, 0x0027}, "SQ", "OrderFillerIdentifierSequence", "1"}
-	tagDict[Tag{0x0040, 0x0031}] = Info{Tag{0x0040, 0x0031}, "UT", "LocalNamespaceEntityID", "1"}
-	tagDict[Tag{0x0040, 0x0032}] = Info{Tag{0x0040, 0x0032}, "UT", "UniversalEntityID", "1"}
-	tagDict[Tag{0x0040, 0x0033}] = Info{Tag{0x0040, 0x0033}, "CS", "UniversalEntityIDType", "1"}
-	tagDict[Tag{0x0040, 0x0035}] = Info{Tag{0x0040, 0x0035}, "CS", "IdentifierTypeCode", "1"}
-	tagDict[Tag{0x0040, 0x0036}] = Info{Tag{0x0040, 0x0036}, "SQ", "AssigningFacilitySequence", "1"}
-	tagDict[Tag{0x0040, 0x0039}] = Info{Tag{0x0040, 0x0039}, "SQ", "AssigningJurisdictionCodeSequence", "1"}
-	tagDict[Tag{0x0040, 0x003A}] = Info{Tag{0x0040, 0x003A}, "SQ", "AssigningAgencyOrDepartmentCodeSequence", "1"}
-	tagDict[Tag{0x0040, 0x0100}] = Info{Tag{0x0040, 0x0100}, "SQ", "ScheduledProcedureStepSequence", "1"}
-	tagDict[Tag{0x0040, 0x0220}] = Info{Tag{0x0040, 0x0220}, "SQ", "ReferencedNonImageCompositeSOPInstanceSequence", "1"}
-	tagDict[Tag{0x0040, 0x0241}] = Info{Tag{0x0040, 0x0241}, "AE", "PerformedStationAETitle", "1"}
-	tagDict[Tag{0x0040, 0x0242}] = Info{Tag{0x0040, 0x0242}, "SH", "PerformedStationName", "1"}
-	tagDict[Tag{0x0040, 0x0243}] = Info{Tag{0x0040, 0x0243}, "SH", "PerformedLocation", "1"}
-	tagDict[Tag{0x0040, 0x0244}] = Info{Tag{0x0040, 0x0244}, "DA", "PerformedProcedureStepStartDate", "1"}
-	tagDict[Tag{0x0040, 0x0245}] = Info{Tag{0x0040, 0x0245}, "TM", "PerformedProcedureStepStartTime", "1"}
-	tagDict[Tag{0x0040, 0x0250}] = Info{Tag{0x0040, 0x0250}, "DA", "PerformedProcedureStepEndDate", "1"}
-	tagDict[Tag{0x0040, 0x0251}] = Info{Tag{0x0040, 0x0251}, "TM", "PerformedProcedureStepEndTime", "1"}
-	tagDict[Tag{0x0040, 0x0252}] = Info{Tag{0x0040, 0x0252}, "CS", "PerformedProcedureStepStatus", "1"}
-	tagDict[Tag{0x0040, 0x0253}] = Info{Tag{0x0040, 0x0253}, "SH", "PerformedProcedureStepID", "1"}
-	tagDict[Tag{0x0040, 0x0254}] = Info{Tag{0x0040, 0x0254}, "LO", "PerformedProcedureStepDescription", "1"}
-	tagDict[Tag{0x0040, 0x0255}] = Info{Tag{0x0040, 0x0255}, "LO", "PerformedProcedureTypeDescription", "1"}
-	tagDict[Tag{0x0040, 0x0260}] = Info{Tag{0x0040, 0x0260}, "SQ", "PerformedProtocolCodeSequence", "1"}
-	tagDict[Tag{0x0040, 0x0261}] = Info{Tag{0x0040, 0x0261}, "CS", "PerformedProtocolType", "1"}
-	tagDict[Tag{0x0040, 0x0270}] = Info{Tag{0x0040, 0x0270}, "SQ", "ScheduledStepAttributesSequence", "1"}
-	tagDict[Tag{0x0040, 0x0275}] = Info{Tag{0x0040, 0x0275}, "SQ", "RequestAttributesSequence", "1"}
-	tagDict[Tag{0x0040, 0x0280}] = Info{Tag{0x0040, 0x0280}, "ST", "CommentsOnThePerformedProcedureStep", "1"}
-	tagDict[Tag{0x0040, 0x0281}] = Info{Tag{0x0040, 0x0281}, "SQ", "PerformedProcedureStepDiscontinuationReasonCodeSequence", "1"}
-	tagDict[Tag{0x0040, 0x0293}] = Info{Tag{0x0040, 0x0293}, "SQ", "QuantitySequence", "1"}
-	tagDict[Tag{0x0040, 0x0294}] = Info{Tag{0x0040, 0x0294}, "DS", "Quantity", "1"}
-	tagDict[Tag{0x0040, 0x0295}] = Info{Tag{0x0040, 0x0295}, "SQ", "MeasuringUnitsSequence", "1"}
-	tagDict[Tag{0x0040, 0x0296}] = Info{Tag{0x0040, 0x0296}, "SQ", "BillingItemSequence", "1"}
-	tagDict[Tag{0x0040, 0x0300}] = Info{Tag{0x0040, 0x0300}, "US", "TotalTimeOfFluoroscopy", "1"}
-	tagDict[Tag{0x0040, 0x0301}] = Info{Tag{0x0040, 0x0301}, "US", "TotalNumberOfExposures", "1"}
-	tagDict[Tag{0x0040, 0x0302}] = Info{Tag{0x0040, 0x0302}, "US", "EntranceDose", "1"}
-	tagDict[Tag{0x0040, 0x0303}] = Info{Tag{0x0040, 0x0303}, "US", "ExposedArea", "1-2"}
-	tagDict[Tag{0x0040, 0x0306}] = Info{Tag{0x0040, 0x0306}, "DS", "DistanceSourceToEntrance", "1"}
-	tagDict[Tag{0x0040, 0x030E}] = Info{Tag{0x0040, 0x030E}, "SQ", "ExposureDoseSequence", "1"}
-	tagDict[Tag{0x0040, 0x0310}] = Info{Tag{0x0040, 0x0310}, "ST", "CommentsOnRadiationDose", "1"}
-	tagDict[Tag{0x0040, 0x0312}] = Info{Tag{0x0040, 0x0312}, "DS", "XRayOutput", "1"}
-	tagDict[Tag{0x0040, 0x0314}] = Info{Tag{0x0040, 0x0314}, "DS", "HalfValueLayer", "1"}
-	tagDict[Tag{0x0040, 0x0316}] = Info{Tag{0x0040, 0x0316}, "DS", "OrganDose", "1"}
-	tagDict[Tag{0x0040, 0x0318}] = Info{Tag{0x0040, 0x0318}, "CS", "OrganExposed", "1"}
-	tagDict[Tag{0x0040, 0x0320}] = Info{Tag{0x0040, 0x0320}, "SQ", "BillingProcedureStepSequence", "1"}
-	tagDict[Tag{0x0040, 0x0321}] = Info{Tag{0x0040, 0x0321}, "SQ", "FilmConsumptionSequence", "1"}
-	tagDict[Tag{0x0040, 0x0324}] = Info{Tag{0x0040, 0x0324}, "SQ", "BillingSuppliesAndDevicesSequence", "1"}
-	tagDict[Tag{0x0040, 0x0340}] = Info{Tag{0x0040, 0x0340}, "SQ", "PerformedSeriesSequence", "1"}
-	tagDict[Tag{0x0040, 0x0400}] = Info{Tag{0x0040, 0x0400}, "LT", "CommentsOnTheScheduledProcedureStep", "1"}
-	tagDict[Tag{0x0040, 0x0440}] = Info{Tag{0x0040, 0x0440}, "SQ", "ProtocolContextSequence", "1"}
-	tagDict[Tag{0x0040, 0x0441}] = Info{Tag{0x0040, 0x0441}, "SQ", "ContentItemModifierSequence", "1"}
-	tagDict[Tag{0x0040, 0x0500}] = Info{Tag{0x0040, 0x0500}, "SQ", "ScheduledSpecimenSequence", "1"}
-	tagDict[Tag{0x0040, 0x0512}] = Info{Tag{0x0040, 0x0512}, "LO", "ContainerIdentifier", "1"}
-	tagDict[Tag{0x0040, 0x0513}] = Info{Tag{0x0040, 0x0513}, "SQ", "IssuerOfTheContainerIdentifierSequence", "1"}
-	tagDict[Tag{0x0040, 0x0515}] = Info{Tag{0x0040, 0x0515}, "SQ", "AlternateContainerIdentifierSequence", "1"}
-	tagDict[Tag{0x0040, 0x0518}] = Info{Tag{0x0040, 0x0518}, "SQ", "ContainerTypeCodeSequence", "1"}
-	tagDict[Tag{0x0040, 0x051A}] = Info{Tag{0x0040, 0x051A}, "LO", "ContainerDescription", "1"}
-	tagDict[Tag{0x0040, 0x0520}] = Info{Tag{0x0040, 0x0520}, "SQ", "ContainerComponentSequence", "1"}
-	tagDict[Tag{0x0040, 0x0551}] = Info{Tag{0x0040, 0x0551}, "LO", "SpecimenIdentifier", "1"}
-	tagDict[Tag{0x0040, 0x0554}] = Info{Tag{0x0040, 0x0554}, "UI", "SpecimenUID", "1"}
-	tagDict[Tag{0x0040, 0x0555}] = Info{Tag{0x0040, 0x0555}, "SQ", "AcquisitionContextSequence", "1"}
-	tagDict[Tag{0x0040, 0x0556}] = Info{Tag{0x0040, 0x0556}, "ST", "AcquisitionContextDescription", "1"}
-	tagDict[Tag{0x0040, 0x0560}] = Info{Tag{0x0040, 0x0560}, "SQ", "SpecimenDescriptionSequence", "1"}
-	tagDict[Tag{0x0040, 0x0562}] = Info{Tag{0x0040, 0x0562}, "SQ", "IssuerOfTheSpecimenIdentifierSequence", "1"}
-	tagDict[Tag{0x0040, 0x059A}] = Info{Tag{0x0040, 0x059A}, "SQ", "SpecimenTypeCodeSequence", "1"}
-	tagDict[Tag{0x0040, 0x0600}] = Info{Tag{0x0040, 0x0600}, "LO", "SpecimenShortDescription", "1"}
-	tagDict[Tag{0x0040, 0x0602}] = Info{Tag{0x0040, 0x0602}, "UT", "SpecimenDetailedDescription", "1"}
-	tagDict[Tag{0x0040, 0x0610}] = Info{Tag{0x0040, 0x0610}, "SQ", "SpecimenPreparationSequence", "1"}
-	tagDict[Tag{0x0040, 0x0612}] = Info{Tag{0x0040, 0x0612}, "SQ", "SpecimenPreparationStepContentItemSequence", "1"}
-	tagDict[Tag{0x0040, 0x0620}] = Info{Tag{0x0040, 0x0620}, "SQ", "SpecimenLocalizationContentItemSequence", "1"}
-	tagDict[Tag{0x0040, 0x071A}] = Info{Tag{0x0040, 0x071A}, "SQ", "ImageCenterPointCoordinatesSequence", "1"}
-	tagDict[Tag{0x0040, 0x072A}] = Info{Tag{0x0040, 0x072A}, "DS", "XOffsetInSlideCoordinateSystem", "1"}
-	tagDict[Tag{0x0040, 0x073A}] = Info{Tag{0x0040, 0x073A}, "DS", "YOffsetInSlideCoordinateSystem", "1"}
-	tagDict[Tag{0x0040, 0x074A}] = Info{Tag{0x0040, 0x074A}, "DS", "ZOffsetInSlideCoordinateSystem", "1"}
-	tagDict[Tag{0x0040, 0x08EA}] = Info{Tag{0x0040, 0x08EA}, "SQ", "MeasurementUnitsCodeSequence", "1"}
-	tagDict[Tag{0x0040, 0x1001}] = Info{Tag{0x0040, 0x1001}, "SH", "RequestedProcedureID", "1"}
-	tagDict[Tag{0x0040, 0x1002}] = Info{Tag{0x0040, 0x1002}, "LO", "ReasonForTheRequestedProcedure", "1"}
-	tagDict[Tag{0x0040, 0x1003}] = Info{Tag{0x0040, 0x1003}, "SH", "RequestedProcedurePriority", "1"}
-	tagDict[Tag{0x0040, 0x1004}] = Info{Tag{0x0040, 0x1004}, "LO", "PatientTransportArrangements", "1"}
-	tagDict[Tag{0x0040, 0x1005}] = Info{Tag{0x0040, 0x1005}, "LO", "RequestedProcedureLocation", "1"}
-	tagDict[Tag{0x0040, 0x1008}] = Info{Tag{0x0040, 0x1008}, "LO", "ConfidentialityCode", "1"}
-	tagDict[Tag{0x0040, 0x1009}] = Info{Tag{0x0040, 0x1009}, "SH", "ReportingPriority", "1"}
-	tagDict[Tag{0x0040, 0x100A}] = Info{Tag{0x0040, 0x100A}, "SQ", "ReasonForRequestedProcedureCodeSequence", "1"}
-	tagDict[Tag{0x0040, 0x1010}] = Info{Tag{0x0040, 0x1010}, "PN", "NamesOfIntendedRecipientsOfResults", "1-n"}
-	tagDict[Tag{0x0040, 0x1011}] = Info{Tag{0x0040, 0x1011}, "SQ", "IntendedRecipientsOfResultsIdentificationSequence", "1"}
-	tagDict[Tag{0x0040, 0x1012}] = Info{Tag{0x0040, 0x1012}, "SQ", "ReasonForPerformedProcedureCodeSequence", "1"}
-	tagDict[Tag{0x0040, 0x1101}] = Info{Tag{0x0040, 0x1101}, "SQ", "PersonIdentificationCodeSequence", "1"}
-	tagDict[Tag{0x0040, 0x1102}] = Info{Tag{0x0040, 0x1102}, "ST", "PersonAddress", "1"}
-	tagDict[Tag{0x0040, 0x1103}] = Info{Tag{0x0040, 0x1103}, "LO", "PersonTelephoneNumbers", "1-n"}
-	tagDict[Tag{0x0040, 0x1400}] = Info{Tag{0x0040, 0x1400}, "LT", "RequestedProcedureComments", "1"}
-	tagDict[Tag{0x0040, 0x2004}] = Info{Tag{0x0040, 0x2004}, "DA", "IssueDateOfImagingServiceRequest", "1"}
-	tagDict[Tag{0x0040, 0x2005}] = Info{Tag{0x0040, 0x2005}, "TM", "IssueTimeOfImagingServiceRequest", "1"}
-	tagDict[Tag{0x0040, 0x2008}] = Info{Tag{0x0040, 0x2008}, "PN", "OrderEnteredBy", "1"}
-	tagDict[Tag{0x0040, 0x2009}] = Info{Tag{0x0040, 0x2009}, "SH", "OrderEntererLocation", "1"}
-	tagDict[Tag{0x0040, 0x2010}] = Info{Tag{0x0040, 0x2010}, "SH", "OrderCallbackPhoneNumber", "1"}
-	tagDict[Tag{0x0040, 0x2016}] = Info{Tag{0x0040, 0x2016}, "LO", "PlacerOrderNumberImagingServiceRequest", "1"}
-	tagDict[Tag{0x0040, 0x2017}] = Info{Tag{0x0040, 0x2017}, "LO", "FillerOrderNumberImagingServiceRequest", "1"}
-	tagDict[Tag{0x0040, 0x2400}] = Info{Tag{0x0040, 0x2400}, "LT", "ImagingServiceRequestComments", "1"}
-	tagDict[Tag{0x0040, 0x3001}] = Info{Tag{0x0040, 0x3001}, "LO", "ConfidentialityConstraintOnPatientDataDescription", "1"}
-	tagDict[Tag{0x0040, 0x4001}] = Info{Tag{0x0040, 0x4001}, "CS", "GeneralPurposeScheduledProcedureStepStatus", "1"}
-	tagDict[Tag{0x0040, 0x4002}] = Info{Tag{0x0040, 0x4002}, "CS", "GeneralPurposePerformedProcedureStepStatus", "1"}
-	tagDict[Tag{0x0040, 0x4003}] = Info{Tag{0x0040, 0x4003}, "CS", "GeneralPurposeScheduledProcedureStepPriority", "1"}
-	tagDict[Tag{0x0040, 0x4004}] = Info{Tag{0x0040, 0x4004}, "SQ", "ScheduledProcessingApplicationsCodeSequence", "1"}
-	tagDict[Tag{0x0040, 0x4005}] = Info{Tag{0x0040, 0x4005}, "DT", "ScheduledProcedureStepStartDateTime", "1"}
-	tagDict[Tag{0x0040, 0x4006}] = Info{Tag{0x0040, 0x4006}, "CS", "MultipleCopiesFlag", "1"}
-	tagDict[Tag{0x0040, 0x4007}] = Info{Tag{0x0040, 0x4007}, "SQ", "PerformedProcessingApplicationsCodeSequence", "1"}
-	tagDict[Tag{0x0040, 0x4009}] = Info{Tag{0x0040, 0x4009}, "SQ", "HumanPerformerCodeSequence", "1"}
-	tagDict[Tag{0x0040, 0x4010}] = Info{Tag{0x0040, 0x4010}, "DT", "ScheduledProcedureStepModificationDateTime", "1"}
-	tagDict[Tag{0x0040, 0x4011}] = Info{Tag{0x0040, 0x4011}, "DT", "ExpectedCompletionDateTime", "1"}
-	tagDict[Tag{0x0040, 0x4015}] = Info{Tag{0x0040, 0x4015}, "SQ", "ResultingGeneralPurposePerformedProcedureStepsSequence", "1"}
-	tagDict[Tag{0x0040, 0x4016}] = Info{Tag{0x0040, 0x4016}, "SQ", "ReferencedGeneralPurposeScheduledProcedureStepSequence", "1"}
-	tagDict[Tag{0x0040, 0x4018}] = Info{Tag{0x0040, 0x4018}, "SQ", "ScheduledWorkitemCodeSequence", "1"}
-	tagDict[Tag{0x0040, 0x4019}] = Info{Tag{0x0040, 0x4019}, "SQ", "PerformedWorkitemCodeSequence", "1"}
-	tagDict[Tag{0x0040, 0x4020}] = Info{Tag{0x0040, 0x4020}, "CS", "InputAvailabilityFlag", "1"}
-	tagDict[Tag{0x0040, 0x4021}] = Info{Tag{0x0040, 0x4021}, "SQ", "InputInformationSequence", "1"}
-	tagDict[Tag{0x0040, 0x4022}] = Info{Tag{0x0040, 0x4022}, "SQ", "RelevantInformationSequence", "1"}
-	tagDict[Tag{0x0040, 0x4023}] = Info{Tag{0x0040, 0x4023}, "UI", "ReferencedGeneralPurposeScheduledProcedureStepTransactionUID", "1"}
-	tagDict[Tag{0x0040, 0x4025}] = Info{Tag{0x0040, 0x4025}, "SQ", "ScheduledStationNameCodeSequence", "1"}
-	tagDict[Tag{0x0040, 0x4026}] = Info{Tag{0x0040, 0x4026}, "SQ", "ScheduledStationClassCodeSequence", "1"}
-	tagDict[Tag{0x0040, 0x4027}] = Info{Tag{0x0040, 0x4027}, "SQ", "ScheduledStationGeographicLocationCodeSequence", "1"}
-	tagDict[Tag{0x0040, 0x4028}] = Info{Tag{0x0040, 0x4028}, "SQ", "PerformedStationNameCodeSequence", "1"}
-	tagDict[Tag{0x0040, 0x4029}] = Info{Tag{0x0040, 0x4029}, "SQ", "PerformedStationClassCodeSequence", "1"}
-	tagDict[Tag{0x0040, 0x4030}] = Info{Tag{0x0040, 0x4030}, "SQ", "PerformedStationGeographicLocationCodeSequence", "1"}
-	tagDict[Tag{0x0040, 0x4031}] = Info{Tag{0x0040, 0x4031}, "SQ", "RequestedSubsequentWorkitemCodeSequence", "1"}
-	tagDict[Tag{0x0040, 0x4032}] = Info{Tag{0x0040, 0x4032}, "SQ", "NonDICOMOutputCodeSequence", "1"}
-	tagDict[Tag{0x0040, 0x4033}] = Info{Tag{0x0040, 0x4033}, "SQ", "OutputInformationSequence", "1"}
-	tagDict[Tag{0x0040, 0x4034}] = Info{Tag{0x0040, 0x4034}, "SQ", "ScheduledHumanPerformersSequence", "1"}
-	tagDict[Tag{0x0040, 0x4035}] = Info{Tag{0x0040, 0x4035}, "SQ", "ActualHumanPerformersSequence", "1"}
-	tagDict[Tag{0x0040, 0x4036}] = Info{Tag{0x0040, 0x4036}, "LO", "HumanPerformerOrganization", "1"}
-	tagDict[Tag{0x0040, 0x4037}] = Info{Tag{0x0040, 0x4037}, "PN", "HumanPerformerName", "1"}
-	tagDict[Tag{0x0040, 0x4040}] = Info{Tag{0x0040, 0x4040}, "CS", "RawDataHandling", "1"}
-	tagDict[Tag{0x0040, 0x4041}] = Info{Tag{0x0040, 0x4041}, "CS", "InputReadinessState", "1"}
-	tagDict[Tag{0x0040, 0x4050}] = Info{Tag{0x0040, 0x4050}, "DT", "PerformedProcedureStepStartDateTime", "1"}
-	tagDict[Tag{0x0040, 0x4051}] = Info{Tag{0x0040, 0x4051}, "DT", "PerformedProcedureStepEndDateTime", "1"}
-	tagDict[Tag{0x0040, 0x4052}] = Info{Tag{0x0040, 0x4052}, "DT", "ProcedureStepCancellationDateTime", "1"}
-	tagDict[Tag{0x0040, 0x8302}] = Info{Tag{0x0040, 0x8302}, "DS", "EntranceDoseInmGy", "1"}
-	tagDict[Tag{0x0040, 0x9094}] = Info{Tag{0x0040, 0x9094}, "SQ", "ReferencedImageRealWorldValueMappingSequence", "1"}
-	tagDict[Tag{0x0040, 0x9096}] = Info{Tag{0x0040, 0x9096}, "SQ", "RealWorldValueMappingSequence", "1"}
-	tagDict[Tag{0x0040, 0x9098}] = Info{Tag{0x0040, 0x9098}, "SQ", "PixelValueMappingCodeSequence", "1"}
-	tagDict[Tag{0x0040, 0x9210}] = Info{Tag{0x0040, 0x9210}, "SH", "LUTLabel", "1"}
-	tagDict[Tag{0x0040, 0x9211}] = Info{Tag{0x0040, 0x9211}, "US", "RealWorldValueLastValueMapped", "1"}
-	tagDict[Tag{0x0040, 0x9212}] = Info{Tag{0x0040, 0x9212}, "FD", "RealWorldValueLUTData", "1-n"}
-	tagDict[Tag{0x0040, 0x9216}] = Info{Tag{0x0040, 0x9216}, "US", "RealWorldValueFirstValueMapped", "1"}
-	tagDict[Tag{0x0040, 0x9224}] = Info{Tag{0x0040, 0x9224}, "FD", "RealWorldValueIntercept", "1"}
-	tagDict[Tag{0x0040, 0x9225}] = Info{Tag{0x0040, 0x9225}, "FD", "RealWorldValueSlope", "1"}
-	tagDict[Tag{0x0040, 0xA010}] = Info{Tag{0x0040, 0xA010}, "CS", "RelationshipType", "1"}
-	tagDict[Tag{0x0040, 0xA027}] = Info{Tag{0x0040, 0xA027}, "LO", "VerifyingOrganization", "1"}
-	tagDict[Tag{0x0040, 0xA030}] = Info{Tag{0x0040, 0xA030}, "DT", "VerificationDateTime", "1"}
-	tagDict[Tag{0x0040, 0xA032}] = Info{Tag{0x0040, 0xA032}, "DT", "ObservationDateTime", "1"}
-	tagDict[Tag{0x0040, 0xA040}] = Info{Tag{0x0040, 0xA040}, "CS", "ValueType", "1"}
-	tagDict[Tag{0x0040, 0xA043}] = Info{Tag{0x0040, 0xA043}, "SQ", "ConceptNameCodeSequence", "1"}
-	tagDict[Tag{0x0040, 0xA050}] = Info{Tag{0x0040, 0xA050}, "CS", "ContinuityOfContent", "1"}
-	tagDict[Tag{0x0040, 0xA073}] = Info{Tag{0x0040, 0xA073}, "SQ", "VerifyingObserverSequence", "1"}
-	tagDict[Tag{0x0040, 0xA075}] = Info{Tag{0x0040, 0xA075}, "PN", "VerifyingObserverName", "1"}
-	tagDict[Tag{0x0040, 0xA078}] = Info{Tag{0x0040, 0xA078}, "SQ", "AuthorObserverSequence", "1"}
-	tagDict[Tag{0x0040, 0xA07A}] = Info{Tag{0x0040, 0xA07A}, "SQ", "ParticipantSequence", "1"}
-	tagDict[Tag{0x0040, 0xA07C}] = Info{Tag{0x0040, 0xA07C}, "SQ", "CustodialOrganizationSequence", "1"}
-	tagDict[Tag{0x0040, 0xA080}] = Info{Tag{0x0040, 0xA080}, "CS", "ParticipationType", "1"}
-	tagDict[Tag{0x0040, 0xA082}] = Info{Tag{0x0040, 0xA082}, "DT", "ParticipationDateTime", "1"}
-	tagDict[Tag{0x0040, 0xA084}] = Info{Tag{0x0040, 0xA084}, "CS", "ObserverType", "1"}
-	tagDict[Tag{0x0040, 0xA088}] = Info{Tag{0x0040, 0xA088}, "SQ", "VerifyingObserverIdentificationCodeSequence", "1"}
-	tagDict[Tag{0x0040, 0xA0B0}] = Info{Tag{0x0040, 0xA0B0}, "US", "ReferencedWaveformChannels", "2-2n"}
-	tagDict[Tag{0x0040, 0xA120}] = Info{Tag{0x0040, 0xA120}, "DT", "DateTime", "1"}
-	tagDict[Tag{0x0040, 0xA121}] = Info{Tag{0x0040, 0xA121}, "DA", "Date", "1"}
-	tagDict[Tag{0x0040, 0xA122}] = Info{Tag{0x0040, 0xA122}, "TM", "Time", "1"}
-	tagDict[Tag{0x0040, 0xA123}] = Info{Tag{0x0040, 0xA123}, "PN", "PersonName", "1"}
-	tagDict[Tag{0x0040, 0xA124}] = Info{Tag{0x0040, 0xA124}, "UI", "UID", "1"}
-	tagDict[Tag{0x0040, 0xA130}] = Info{Tag{0x0040, 0xA130}, "CS", "TemporalRangeType", "1"}
-	tagDict[Tag{0x0040, 0xA132}] = Info{Tag{0x0040, 0xA132}, "UL", "ReferencedSamplePositions", "1-n"}
-	tagDict[Tag{0x0040, 0xA136}] = Info{Tag{0x0040, 0xA136}, "US", "ReferencedFrameNumbers", "1-n"}
-	tagDict[Tag{0x0040, 0xA138}] = Info{Tag{0x0040, 0xA138}, "DS", "ReferencedTimeOffsets", "1-n"}
-	tagDict[Tag{0x0040, 0xA13A}] = Info{Tag{0x0040, 0xA13A}, "DT", "ReferencedDateTime", "1-n"}
-	tagDict[Tag{0x0040, 0xA160}] = Info{Tag{0x0040, 0xA160}, "UT", "TextValue", "1"}
-	tagDict[Tag{0x0040, 0xA168}] = Info{Tag{0x0040, 0xA168}, "SQ", "ConceptCodeSequence", "1"}
-	tagDict[Tag{0x0040, 0xA170}] = Info{Tag{0x0040, 0xA170}, "SQ", "PurposeOfReferenceCodeSequence", "1"}
-	tagDict[Tag{0x0040, 0xA180}] = Info{Tag{0x0040, 0xA180}, "US", "AnnotationGroupNumber", "1"}
-	tagDict[Tag{0x0040, 0xA195}] = Info{Tag{0x0040, 0xA195}, "SQ", "ModifierCodeSequence", "1"}
-	tagDict[Tag{0x0040, 0xA300}] = Info{Tag{0x0040, 0xA300}, "SQ", "MeasuredValueSequence", "1"}
-	tagDict[Tag{0x0040, 0xA301}] = Info{Tag{0x0040, 0xA301}, "SQ", "NumericValueQualifierCodeSequence", "1"}
-	tagDict[Tag{0x0040, 0xA30A}] = Info{Tag{0x0040, 0xA30A}, "DS", "NumericValue", "1-n"}
-	tagDict[Tag{0x0040, 0xA360}] = Info{Tag{0x0040, 0xA360}, "SQ", "PredecessorDocumentsSequence", "1"}
-	tagDict[Tag{0x0040, 0xA370}] = Info{Tag{0x0040, 0xA370}, "SQ", "ReferencedRequestSequence", "1"}
-	tagDict[Tag{0x0040, 0xA372}] = Info{Tag{0x0040, 0xA372}, "SQ", "PerformedProcedureCodeSequence", "1"}
-	tagDict[Tag{0x0040, 0xA375}] = Info{Tag{0x0040, 0xA375}, "SQ", "CurrentRequestedProcedureEvidenceSequence", "1"}
-	tagDict[Tag{0x0040, 0xA385}] = Info{Tag{0x0040, 0xA385}, "SQ", "PertinentOtherEvidenceSequence", "1"}
-	tagDict[Tag{0x0040, 0xA390}] = Info{Tag{0x0040, 0xA390}, "SQ", "HL7StructuredDocumentReferenceSequence", "1"}
-	tagDict[Tag{0x0040, 0xA491}] = Info{Tag{0x0040, 0xA491}, "CS", "CompletionFlag", "1"}
-	tagDict[Tag{0x0040, 0xA492}] = Info{Tag{0x0040, 0xA492}, "LO", "CompletionFlagDescription", "1"}
-	tagDict[Tag{0x0040, 0xA493}] = Info{Tag{0x0040, 0xA493}, "CS", "VerificationFlag", "1"}
-	tagDict[Tag{0x0040, 0xA494}] = Info{Tag{0x0040, 0xA494}, "CS", "ArchiveRequested", "1"}
-	tagDict[Tag{0x0040, 0xA496}] = Info{Tag{0x0040, 0xA496}, "CS", "PreliminaryFlag", "1"}
-	tagDict[Tag{0x0040, 0xA504}] = Info{Tag{0x0040, 0xA504}, "SQ", "ContentTemplateSequence", "1"}
-	tagDict[Tag{0x0040, 0xA525}] = Info{Tag{0x0040, 0xA525}, "SQ", "IdenticalDocumentsSequence", "1"}
-	tagDict[Tag{0x0040, 0xA730}] = Info{Tag{0x0040, 0xA730}, "SQ", "ContentSequence", "1"}
-	tagDict[Tag{0x0040, 0xB020}] = Info{Tag{0x0040, 0xB020}, "SQ", "WaveformAnnotationSequence", "1"}
-	tagDict[Tag{0x0040, 0xDB00}] = Info{Tag{0x0040, 0xDB00}, "CS", "TemplateIdentifier", "1"}
-	tagDict[Tag{0x0040, 0xDB73}] = Info{Tag{0x0040, 0xDB73}, "UL", "ReferencedContentItemIdentifier", "1-n"}
-	tagDict[Tag{0x0040, 0xE001}] = Info{Tag{0x0040, 0xE001}, "ST", "HL7InstanceIdentifier", "1"}
-	tagDict[Tag{0x0040, 0xE004}] = Info{Tag{0x0040, 0xE004}, "DT", "HL7DocumentEffectiveTime", "1"}
-	tagDict[Tag{0x0040, 0xE006}] = Info{Tag{0x0040, 0xE006}, "SQ", "HL7DocumentTypeCodeSequence", "1"}
-	tagDict[Tag{0x0040, 0xE008}] = Info{Tag{0x0040, 0xE008}, "SQ", "DocumentClassCodeSequence", "1"}
-	tagDict[Tag{0x0040, 0xE010}] = Info{Tag{0x0040, 0xE010}, "UT", "RetrieveURI", "1"}
-	tagDict[Tag{0x0040, 0xE011}] = Info{Tag{0x0040, 0xE011}, "UI", "RetrieveLocationUID", "1"}
-	tagDict[Tag{0x0040, 0xE020}] = Info{Tag{0x0040, 0xE020}, "CS", "TypeOfInstances", "1"}
-	tagDict[Tag{0x0040, 0xE021}] = Info{Tag{0x0040, 0xE021}, "SQ", "DICOMRetrievalSequence", "1"}
-	tagDict[Tag{0x0040, 0xE022}] = Info{Tag{0x0040, 0xE022}, "SQ", "DICOMMediaRetrievalSequence", "1"}
-	tagDict[Tag{0x0040, 0xE023}] = Info{Tag{0x0040, 0xE023}, "SQ", "WADORetrievalSequence", "1"}
-	tagDict[Tag{0x0040, 0xE024}] = Info{Tag{0x0040, 0xE024}, "SQ", "XDSRetrievalSequence", "1"}
-	tagDict[Tag{0x0040, 0xE030}] = Info{Tag{0x0040, 0xE030}, "UI", "RepositoryUniqueID", "1"}
-	tagDict[Tag{0x0040, 0xE031}] = Info{Tag{0x0040, 0xE031}, "UI", "HomeCommunityID", "1"}
-	tagDict[Tag{0x0042, 0x0010}] = Info{Tag{0x0042, 0x0010}, "ST", "DocumentTitle", "1"}
-	tagDict[Tag{0x0042, 0x0011}] = Info{Tag{0x0042, 0x0011}, "OB", "EncapsulatedDocument", "1"}
-	tagDict[Tag{0x0042, 0x0012}] = Info{Tag{0x0042, 0x0012}, "LO", "MIMETypeOfEncapsulatedDocument", "1"}
-	tagDict[Tag{0x0042, 0x0013}] = Info{Tag{0x0042, 0x0013}, "SQ", "SourceInstanceSequence", "1"}
-	tagDict[Tag{0x0042, 0x0014}] = Info{Tag{0x0042, 0x0014}, "LO", "ListOfMIMETypes", "1-n"}
-	tagDict[Tag{0x0044, 0x0001}] = Info{Tag{0x0044, 0x0001}, "ST", "ProductPackageIdentifier", "1"}
-	tagDict[Tag{0x0044, 0x0002}] = Info{Tag{0x0044, 0x0002}, "CS", "SubstanceAdministrationApproval", "1"}
-	tagDict[Tag{0x0044, 0x0003}] = Info{Tag{0x0044, 0x0003}, "LT", "ApprovalStatusFurtherDescription", "1"}
-	tagDict[Tag{0x0044, 0x0004}] = Info{Tag{0x0044, 0x0004}, "DT", "ApprovalStatusDateTime", "1"}
-	tagDict[Tag{0x0044, 0x0007}] = Info{Tag{0x0044, 0x0007}, "SQ", "ProductTypeCodeSequence", "1"}
-	tagDict[Tag{0x0044, 0x0008}] = Info{Tag{0x0044, 0x0008}, "LO", "ProductName", "1-n"}
-	tagDict[Tag{0x0044, 0x0009}] = Info{Tag{0x0044, 0x0009}, "LT", "ProductDescription", "1"}
-	tagDict[Tag{0x0044, 0x000A}] = Info{Tag{0x0044, 0x000A}, "LO", "ProductLotIdentifier", "1"}
-	tagDict[Tag{0x0044, 0x000B}] = Info{Tag{0x0044, 0x000B}, "DT", "ProductExpirationDateTime", "1"}
-	tagDict[Tag{0x0044, 0x0010}] = Info{Tag{0x0044, 0x0010}, "DT", "SubstanceAdministrationDateTime", "1"}
-	tagDict[Tag{0x0044, 0x0011}] = Info{Tag{0x0044, 0x0011}, "LO", "SubstanceAdministrationNotes", "1"}
-	tagDict[Tag{0x0044, 0x0012}] = Info{Tag{0x0044, 0x0012}, "LO", "SubstanceAdministrationDeviceID", "1"}
-	tagDict[Tag{0x0044, 0x0013}] = Info{Tag{0x0044, 0x0013}, "SQ", "ProductParameterSequence", "1"}
-	tagDict[Tag{0x0044, 0x0019}] = Info{Tag{0x0044, 0x0019}, "SQ", "SubstanceAdministrationParameterSequence", "1"}
-	tagDict[Tag{0x0046, 0x0012}] = Info{Tag{0x0046, 0x0012}, "LO", "LensDescription", "1"}
-	tagDict[Tag{0x0046, 0x0014}] = Info{Tag{0x0046, 0x0014}, "SQ", "RightLensSequence", "1"}
-	tagDict[Tag{0x0046, 0x0015}] = Info{Tag{0x0046, 0x0015}, "SQ", "LeftLensSequence", "1"}
-	tagDict[Tag{0x0046, 0x0016}] = Info{Tag{0x0046, 0x0016}, "SQ", "UnspecifiedLateralityLensSequence", "1"}
-	tagDict[Tag{0x0046, 0x0018}] = Info{Tag{0x0046, 0x0018}, "SQ", "CylinderSequence", "1"}
-	tagDict[Tag{0x0046, 0x0028}] = Info{Tag{0x0046, 0x0028}, "SQ", "PrismSequence", "1"}
-	tagDict[Tag{0x0046, 0x0030}] = Info{Tag{0x0046, 0x0030}, "FD", "HorizontalPrismPower", "1"}
-	tagDict[Tag{0x0046, 0x0032}] = Info{Tag{0x0046, 0x0032}, "CS", "HorizontalPrismBase", "1"}
-	tagDict[Tag{0x0046, 0x0034}] = Info{Tag{0x0046, 0x0034}, "FD", "VerticalPrismPower", "1"}
-	tagDict[Tag{0x0046, 0x0036}] = Info{Tag{0x0046, 0x0036}, "CS", "VerticalPrismBase", "1"}
-	tagDict[Tag{0x0046, 0x0038}] = Info{Tag{0x0046, 0x0038}, "CS", "LensSegmentType", "1"}
-	tagDict[Tag{0x0046, 0x0040}] = Info{Tag{0x0046, 0x0040}, "FD", "OpticalTransmittance", "1"}
-	tagDict[Tag{0x0046, 0x0042}] = Info{Tag{0x0046, 0x0042}, "FD", "ChannelWidth", "1"}
-	tagDict[Tag{0x0046, 0x0044}] = Info{Tag{0x0046, 0x0044}, "FD", "PupilSize", "1"}
-	tagDict[Tag{0x0046, 0x0046}] = Info{Tag{0x0046, 0x0046}, "FD", "CornealSize", "1"}
-	tagDict[Tag{0x0046, 0x0050}] = Info{Tag{0x0046, 0x0050}, "SQ", "AutorefractionRightEyeSequence", "1"}
-	tagDict[Tag{0x0046, 0x0052}] = Info{Tag{0x0046, 0x0052}, "SQ", "AutorefractionLeftEyeSequence", "1"}
-	tagDict[Tag{0x0046, 0x0060}] = Info{Tag{0x0046, 0x0060}, "FD", "DistancePupillaryDistance", "1"}
-	tagDict[Tag{0x0046, 0x0062}] = Info{Tag{0x0046, 0x0062}, "FD", "NearPupillaryDistance", "1"}
-	tagDict[Tag{0x0046, 0x0063}] = Info{Tag{0x0046, 0x0063}, "FD", "IntermediatePupillaryDistance", "1"}
-	tagDict[Tag{0x0046, 0x0064}] = Info{Tag{0x0046, 0x0064}, "FD", "OtherPupillaryDistance", "1"}
-	tagDict[Tag{0x0046, 0x0070}] = Info{Tag{0x0046, 0x0070}, "SQ", "KeratometryRightEyeSequence", "1"}
-	tagDict[Tag{0x0046, 0x0071}] = Info{Tag{0x0046, 0x0071}, "SQ", "KeratometryLeftEyeSequence", "1"}
-	tagDict[Tag{0x0046, 0x0074}] = Info{Tag{0x0046, 0x0074}, "SQ", "SteepKeratometricAxisSequence", "1"}
-	tagDict[Tag{0x0046, 0x0075}] = Info{Tag{0x0046, 0x0075}, "FD", "RadiusOfCurvature", "1"}
-	tagDict[Tag{0x0046, 0x0076}] = Info{Tag{0x0046, 0x0076}, "FD", "KeratometricPower", "1"}
-	tagDict[Tag{0x0046, 0x0077}] = Info{Tag{0x0046, 0x0077}, "FD", "KeratometricAxis", "1"}
-	tagDict[Tag{0x0046, 0x0080}] = Info{Tag{0x0046, 0x0080}, "SQ", "FlatKeratometricAxisSequence", "1"}
-	tagDict[Tag{0x0046, 0x0092}] = Info{Tag{0x0046, 0x0092}, "CS", "BackgroundColor", "1"}
-	tagDict[Tag{0x0046, 0x0094}] = Info{Tag{0x0046, 0x0094}, "CS", "Optotype", "1"}
-	tagDict[Tag{0x0046, 0x0095}] = Info{Tag{0x0046, 0x0095}, "CS", "OptotypePresentation", "1"}
-	tagDict[Tag{0x0046, 0x0097}] = Info{Tag{0x0046, 0x0097}, "SQ", "SubjectiveRefractionRightEyeSequence", "1"}
-	tagDict[Tag{0x0046, 0x0098}] = Info{Tag{0x0046, 0x0098}, "SQ", "SubjectiveRefractionLeftEyeSequence", "1"}
-	tagDict[Tag{0x0046, 0x0100}] = Info{Tag{0x0046, 0x0100}, "SQ", "AddNearSequence", "1"}
-	tagDict[Tag{0x0046, 0x0101}] = Info{Tag{0x0046, 0x0101}, "SQ", "AddIntermediateSequence", "1"}
-	tagDict[Tag{0x0046, 0x0102}] = Info{Tag{0x0046, 0x0102}, "SQ", "AddOtherSequence", "1"}
-	tagDict[Tag{0x0046, 0x0104}] = Info{Tag{0x0046, 0x0104}, "FD", "AddPower", "1"}
-	tagDict[Tag{0x0046, 0x0106}] = Info{Tag{0x0046, 0x0106}, "FD", "ViewingDistance", "1"}
-	tagDict[Tag{0x0046, 0x0121}] = Info{Tag{0x0046, 0x0121}, "SQ", "VisualAcuityTypeCodeSequence", "1"}
-	tagDict[Tag{0x0046, 0x0122}] = Info{Tag{0x0046, 0x0122}, "SQ", "VisualAcuityRightEyeSequence", "1"}
-	tagDict[Tag{0x0046, 0x0123}] = Info{Tag{0x0046, 0x0123}, "SQ", "VisualAcuityLeftEyeSequence", "1"}
-	tagDict[Tag{0x0046, 0x0124}] = Info{Tag{0x0046, 0x0124}, "SQ", "VisualAcuityBothEyesOpenSequence", "1"}
-	tagDict[Tag{0x0046, 0x0125}] = Info{Tag{0x0046, 0x0125}, "CS", "ViewingDistanceType", "1"}
-	tagDict[Tag{0x0046, 0x0135}] = Info{Tag{0x0046, 0x0135}, "SS", "VisualAcuityModifiers", "2"}
-	tagDict[Tag{0x0046, 0x0137}] = Info{Tag{0x0046, 0x0137}, "FD", "DecimalVisualAcuity", "1"}
-	tagDict[Tag{0x0046, 0x0139}] = Info{Tag{0x0046, 0x0139}, "LO", "OptotypeDetailedDefinition", "1"}
-	tagDict[Tag{0x0046, 0x0145}] = Info{Tag{0x0046, 0x0145}, "SQ", "ReferencedRefractiveMeasurementsSequence", "1"}
-	tagDict[Tag{0x0046, 0x0146}] = Info{Tag{0x0046, 0x0146}, "FD", "SpherePower", "1"}
-	tagDict[Tag{0x0046, 0x0147}] = Info{Tag{0x0046, 0x0147}, "FD", "CylinderPower", "1"}
-	tagDict[Tag{0x0048, 0x0001}] = Info{Tag{0x0048, 0x0001}, "FL", "ImagedVolumeWidth", "1"}
-	tagDict[Tag{0x0048, 0x0002}] = Info{Tag{0x0048, 0x0002}, "FL", "ImagedVolumeHeight", "1"}
-	tagDict[Tag{0x0048, 0x0003}] = Info{Tag{0x0048, 0x0003}, "FL", "ImagedVolumeDepth", "1"}
-	tagDict[Tag{0x0048, 0x0006}] = Info{Tag{0x0048, 0x0006}, "UL", "TotalPixelMatrixColumns", "1"}
-	tagDict[Tag{0x0048, 0x0007}] = Info{Tag{0x0048, 0x0007}, "UL", "TotalPixelMatrixRows", "1"}
-	tagDict[Tag{0x0048, 0x0008}] = Info{Tag{0x0048, 0x0008}, "SQ", "TotalPixelMatrixOriginSequence", "1"}
-	tagDict[Tag{0x0048, 0x0010}] = Info{Tag{0x0048, 0x0010}, "CS", "SpecimenLabelInImage", "1"}
-	tagDict[Tag{0x0048, 0x0011}] = Info{Tag{0x0048, 0x0011}, "CS", "FocusMethod", "1"}
-	tagDict[Tag{0x0048, 0x0012}] = Info{Tag{0x0048, 0x0012}, "CS", "ExtendedDepthOfField", "1"}
-	tagDict[Tag{0x0048, 0x0013}] = Info{Tag{0x0048, 0x0013}, "US", "NumberOfFocalPlanes", "1"}
-	tagDict[Tag{0x0048, 0x0014}] = Info{Tag{0x0048, 0x0014}, "FL", "DistanceBetweenFocalPlanes", "1"}
-	tagDict[Tag{0x0048, 0x0015}] = Info{Tag{0x0048, 0x0015}, "US", "RecommendedAbsentPixelCIELabValue", "3"}
-	tagDict[Tag{0x0048, 0x0100}] = Info{Tag{0x0048, 0x0100}, "SQ", "IlluminatorTypeCodeSequence", "1"}
-	tagDict[Tag{0x0048, 0x0102}] = Info{Tag{0x0048, 0x0102}, "DS", "ImageOrientationSlide", "6"}
-	tagDict[Tag{0x0048, 0x0105}] = Info{Tag{0x0048, 0x0105}, "SQ", "OpticalPathSequence", "1"}
-	tagDict[Tag{0x0048, 0x0106}] = Info{Tag{0x0048, 0x0106}, "SH", "OpticalPathIdentifier", "1"}
-	tagDict[Tag{0x0048, 0x0107}] = Info{Tag{0x0048, 0x0107}, "ST", "OpticalPathDescription", "1"}
-	tagDict[Tag{0x0048, 0x0108}] = Info{Tag{0x0048, 0x0108}, "SQ", "IlluminationColorCodeSequence", "1"}
-	tagDict[Tag{0x0048, 0x0110}] = Info{Tag{0x0048, 0x0110}, "SQ", "SpecimenReferenceSequence", "1"}
-	tagDict[Tag{0x0048, 0x0111}] = Info{Tag{0x0048, 0x0111}, "DS", "CondenserLensPower", "1"}
-	tagDict[Tag{0x0048, 0x0112}] = Info{Tag{0x0048, 0x0112}, "DS", "ObjectiveLensPower", "1"}
-	tagDict[Tag{0x0048, 0x0113}] = Info{Tag{0x0048, 0x0113}, "DS", "ObjectiveLensNumericalAperture", "1"}
-	tagDict[Tag{0x0048, 0x0120}] = Info{Tag{0x0048, 0x0120}, "SQ", "PaletteColorLookupTableSequence", "1"}
-	tagDict[Tag{0x0048, 0x0200}] = Info{Tag{0x0048, 0x0200}, "SQ", "ReferencedImageNavigationSequence", "1"}
-	tagDict[Tag{0x0048, 0x0201}] = Info{Tag{0x0048, 0x0201}, "US", "TopLeftHandCornerOfLocalizerArea", "2"}
-	tagDict[Tag{0x0048, 0x0202}] = Info{Tag{0x0048, 0x0202}, "US", "BottomRightHandCornerOfLocalizerArea", "2"}
-	tagDict[Tag{0x0048, 0x0207}] = Info{Tag{0x0048, 0x0207}, "SQ", "OpticalPathIdentificationSequence", "1"}
-	tagDict[Tag{0x0048, 0x021A}] = Info{Tag{0x0048, 0x021A}, "SQ", "PlanePositionSlideSequence", "1"}
-	tagDict[Tag{0x0048, 0x021E}] = Info{Tag{0x0048, 0x021E}, "SL", "ColumnPositionInTotalImagePixelMatrix", "1"}
-	tagDict[Tag{0x0048, 0x021F}] = Info{Tag{0x0048, 0x021F}, "SL", "RowPositionInTotalImagePixelMatrix", "1"}
-	tagDict[Tag{0x0048, 0x0301}] = Info{Tag{0x0048, 0x0301}, "CS", "PixelOriginInterpretation", "1"}
-	tagDict[Tag{0x0050, 0x0004}] = Info{Tag{0x0050, 0x0004}, "CS", "CalibrationImage", "1"}
-	tagDict[Tag{0x0050, 0x0010}] = Info{Tag{0x0050, 0x0010}, "SQ", "DeviceSequence", "1"}
-	tagDict[Tag{0x0050, 0x0012}] = Info{Tag{0x0050, 0x0012}, "SQ", "ContainerComponentTypeCodeSequence", "1"}
-	tagDict[Tag{0x0050, 0x0013}] = Info{Tag{0x0050, 0x0013}, "FD", "ContainerComponentThickness", "1"}
-	tagDict[Tag{0x0050, 0x0014}] = Info{Tag{0x0050, 0x0014}, "DS", "DeviceLength", "1"}
-	tagDict[Tag{0x0050, 0x0015}] = Info{Tag{0x0050, 0x0015}, "FD", "ContainerComponentWidth", "1"}
-	tagDict[Tag{0x0050, 0x0016}] = Info{Tag{0x0050, 0x0016}, "DS", "DeviceDiameter", "1"}
-	tagDict[Tag{0x0050, 0x0017}] = Info{Tag{0x0050, 0x0017}, "CS", "DeviceDiameterUnits", "1"}
-	tagDict[Tag{0x0050, 0x0018}] = Info{Tag{0x0050, 0x0018}, "DS", "DeviceVolume", "1"}
-	tagDict[Tag{0x0050, 0x0019}] = Info{Tag{0x0050, 0x0019}, "DS", "InterMarkerDistance", "1"}
-	tagDict[Tag{0x0050, 0x001A}] = Info{Tag{0x0050, 0x001A}, "CS", "ContainerComponentMaterial", "1"}
-	tagDict[Tag{0x0050, 0x001B}] = Info{Tag{0x0050, 0x001B}, "LO", "ContainerComponentID", "1"}
-	tagDict[Tag{0x0050, 0x001C}] = Info{Tag{0x0050, 0x001C}, "FD", "ContainerComponentLength", "1"}
-	tagDict[Tag{0x0050, 0x001D}] = Info{Tag{0x0050, 0x001D}, "FD", "ContainerComponentDiameter", "1"}
-	tagDict[Tag{0x0050, 0x001E}] = Info{Tag{0x0050, 0x001E}, "LO", "ContainerComponentDescription", "1"}
-	tagDict[Tag{0x0050, 0x0020}] = Info{Tag{0x0050, 0x0020}, "LO", "DeviceDescription", "1"}
-	tagDict[Tag{0x0052, 0x0001}] = Info{Tag{0x0052, 0x0001}, "FL", "ContrastBolusIngredientPercentByVolume", "1"}
-	tagDict[Tag{0x0052, 0x0002}] = Info{Tag{0x0052, 0x0002}, "FD", "OCTFocalDistance", "1"}
-	tagDict[Tag{0x0052, 0x0003}] = Info{Tag{0x0052, 0x0003}, "FD", "BeamSpotSize", "1"}
-	tagDict[Tag{0x0052, 0x0004}] = Info{Tag{0x0052, 0x0004}, "FD", "EffectiveRefractiveIndex", "1"}
-	tagDict[Tag{0x0052, 0x0006}] = Info{Tag{0x0052, 0x0006}, "CS", "OCTAcquisitionDomain", "1"}
-	tagDict[Tag{0x0052, 0x0007}] = Info{Tag{0x0052, 0x0007}, "FD", "OCTOpticalCenterWavelength", "1"}
-	tagDict[Tag{0x0052, 0x0008}] = Info{Tag{0x0052, 0x0008}, "FD", "AxialResolution", "1"}
-	tagDict[Tag{0x0052, 0x0009}] = Info{Tag{0x0052, 0x0009}, "FD", "RangingDepth", "1"}
-	tagDict[Tag{0x0052, 0x0011}] = Info{Tag{0x0052, 0x0011}, "FD", "ALineRate", "1"}
-	tagDict[Tag{0x0052, 0x0012}] = Info{Tag{0x0052, 0x0012}, "US", "ALinesPerFrame", "1"}
-	tagDict[Tag{0x0052, 0x0013}] = Info{Tag{0x0052, 0x0013}, "FD", "CatheterRotationalRate", "1"}
-	tagDict[Tag{0x0052, 0x0014}] = Info{Tag{0x0052, 0x0014}, "FD", "ALinePixelSpacing", "1"}
-	tagDict[Tag{0x0052, 0x0016}] = Info{Tag{0x0052, 0x0016}, "SQ", "ModeOfPercutaneousAccessSequence", "1"}
-	tagDict[Tag{0x0052, 0x0025}] = Info{Tag{0x0052, 0x0025}, "SQ", "IntravascularOCTFrameTypeSequence", "1"}
-	tagDict[Tag{0x0052, 0x0026}] = Info{Tag{0x0052, 0x0026}, "CS", "OCTZOffsetApplied", "1"}
-	tagDict[Tag{0x0052, 0x0027}] = Info{Tag{0x0052, 0x0027}, "SQ", "IntravascularFrameContentSequence", "1"}
-	tagDict[Tag{0x0052, 0x0028}] = Info{Tag{0x0052, 0x0028}, "FD", "IntravascularLongitudinalDistance", "1"}
-	tagDict[Tag{0x0052, 0x0029}] = Info{Tag{0x0052, 0x0029}, "SQ", "IntravascularOCTFrameContentSequence", "1"}
-	tagDict[Tag{0x0052, 0x0030}] = Info{Tag{0x0052, 0x0030}, "SS", "OCTZOffsetCorrection", "1"}
-	tagDict[Tag{0x0052, 0x0031}] = Info{Tag{0x0052, 0x0031}, "CS", "CatheterDirectionOfRotation", "1"}
-	tagDict[Tag{0x0052, 0x0033}] = Info{Tag{0x0052, 0x0033}, "FD", "SeamLineLocation", "1"}
-	tagDict[Tag{0x0052, 0x0034}] = Info{Tag{0x0052, 0x0034}, "FD", "FirstALineLocation", "1"}
-	tagDict[Tag{0x0052, 0x0036}] = Info{Tag{0x0052, 0x0036}, "US", "SeamLineIndex", "1"}
-	tagDict[Tag{0x0052, 0x0038}] = Info{Tag{0x0052, 0x0038}, "US", "NumberOfPaddedAlines", "1"}
-	tagDict[Tag{0x0052, 0x0039}] = Info{Tag{0x0052, 0x0039}, "CS", "InterpolationType", "1"}
-	tagDict[Tag{0x0052, 0x003A}] = Info{Tag{0x0052, 0x003A}, "CS", "RefractiveIndexApplied", "1"}
-	tagDict[Tag{0x0054, 0x0010}] = Info{Tag{0x0054, 0x0010}, "US", "EnergyWindowVector", "1-n"}
-	tagDict[Tag{0x0054, 0x0011}] = Info{Tag{0x0054, 0x0011}, "US", "NumberOfEnergyWindows", "1"}
-	tagDict[Tag{0x0054, 0x0012}] = Info{Tag{0x0054, 0x0012}, "SQ", "EnergyWindowInformationSequence", "1"}
-	tagDict[Tag{0x0054, 0x0013}] = Info{Tag{0x0054, 0x0013}, "SQ", "EnergyWindowRangeSequence", "1"}
-	tagDict[Tag{0x0054, 0x0014}] = Info{Tag{0x0054, 0x0014}, "DS", "EnergyWindowLowerLimit", "1"}
-	tagDict[Tag{0x0054, 0x0015}] = Info{Tag{0x0054, 0x0015}, "DS", "EnergyWindowUpperLimit", "1"}
-	tagDict[Tag{0x0054, 0x0016}] = Info{Tag{0x0054, 0x0016}, "SQ", "RadiopharmaceuticalInformationSequence", "1"}
-	tagDict[Tag{0x0054, 0x0017}] = Info{Tag{0x0054, 0x0017}, "IS", "ResidualSyringeCounts", "1"}
-	tagDict[Tag{0x0054, 0x0018}] = Info{Tag{0x0054, 0x0018}, "SH", "EnergyWindowName", "1"}
-	tagDict[Tag{0x0054, 0x0020}] = Info{Tag{0x0054, 0x0020}, "US", "DetectorVector", "1-n"}
-	tagDict[Tag{0x0054, 0x0021}] = Info{Tag{0x0054, 0x0021}, "US", "NumberOfDetectors", "1"}
-	tagDict[Tag{0x0054, 0x0022}] = Info{Tag{0x0054, 0x0022}, "SQ", "DetectorInformationSequence", "1"}
-	tagDict[Tag{0x0054, 0x0030}] = Info{Tag{0x0054, 0x0030}, "US", "PhaseVector", "1-n"}
-	tagDict[Tag{0x0054, 0x0031}] = Info{Tag{0x0054, 0x0031}, "US", "NumberOfPhases", "1"}
-	tagDict[Tag{0x0054, 0x0032}] = Info{Tag{0x0054, 0x0032}, "SQ", "PhaseInformationSequence", "1"}
-	tagDict[Tag{0x0054, 0x0033}] = Info{Tag{0x0054, 0x0033}, "US", "NumberOfFramesInPhase", "1"}
-	tagDict[Tag{0x0054, 0x0036}] = Info{Tag{0x0054, 0x0036}, "IS", "PhaseDelay", "1"}
-	tagDict[Tag{0x0054, 0x0038}] = Info{Tag{0x0054, 0x0038}, "IS", "PauseBetweenFrames", "1"}
-	tagDict[Tag{0x0054, 0x0039}] = Info{Tag{0x0054, 0x0039}, "CS", "PhaseDescription", "1"}
-	tagDict[Tag{0x0054, 0x0050}] = Info{Tag{0x0054, 0x0050}, "US", "RotationVector", "1-n"}
-	tagDict[Tag{0x0054, 0x0051}] = Info{Tag{0x0054, 0x0051}, "US", "NumberOfRotations", "1"}
-	tagDict[Tag{0x0054, 0x0052}] = Info{Tag{0x0054, 0x0052}, "SQ", "RotationInformationSequence", "1"}
-	tagDict[Tag{0x0054, 0x0053}] = Info{Tag{0x0054, 0x0053}, "US", "NumberOfFramesInRotation", "1"}
-	tagDict[Tag{0x0054, 0x0060}] = Info{Tag{0x0054, 0x0060}, "US", "RRIntervalVector", "1-n"}
-	tagDict[Tag{0x0054, 0x0061}] = Info{Tag{0x0054, 0x0061}, "US", "NumberOfRRIntervals", "1"}
-	tagDict[Tag{0x0054, 0x0062}] = Info{Tag{0x0054, 0x0062}, "SQ", "GatedInformationSequence", "1"}
-	tagDict[Tag{0x0054, 0x0063}] = Info{Tag{0x0054, 0x0063}, "SQ", "DataInformationSequence", "1"}
-	tagDict[Tag{0x0054, 0x0070}] = Info{Tag{0x0054, 0x0070}, "US", "TimeSlotVector", "1-n"}
-	tagDict[Tag{0x0054, 0x0071}] = Info{Tag{0x0054, 0x0071}, "US", "NumberOfTimeSlots", "1"}
-	tagDict[Tag{0x0054, 0x0072}] = Info{Tag{0x0054, 0x0072}, "SQ", "TimeSlotInformationSequence", "1"}
-	tagDict[Tag{0x0054, 0x0073}] = Info{Tag{0x0054, 0x0073}, "DS", "TimeSlotTime", "1"}
-	tagDict[Tag{0x0054, 0x0080}] = Info{Tag{0x0054, 0x0080}, "US", "SliceVector", "1-n"}
-	tagDict[Tag{0x0054, 0x0081}] = Info{Tag{0x0054, 0x0081}, "US", "NumberOfSlices", "1"}
-	tagDict[Tag{0x0054, 0x0090}] = Info{Tag{0x0054, 0x0090}, "US", "AngularViewVector", "1-n"}
-	tagDict[Tag{0x0054, 0x0100}] = Info{Tag{0x0054, 0x0100}, "US", "TimeSliceVector", "1-n"}
-	tagDict[Tag{0x0054, 0x0101}] = Info{Tag{0x0054, 0x0101}, "US", "NumberOfTimeSlices", "1"}
-	tagDict[Tag{0x0054, 0x0200}] = Info{Tag{0x0054, 0x0200}, "DS", "StartAngle", "1"}
-	tagDict[Tag{0x0054, 0x0202}] = Info{Tag{0x0054, 0x0202}, "CS", "TypeOfDetectorMotion", "1"}
-	tagDict[Tag{0x0054, 0x0210}] = Info{Tag{0x0054, 0x0210}, "IS", "TriggerVector", "1-n"}
-	tagDict[Tag{0x0054, 0x0211}] = Info{Tag{0x0054, 0x0211}, "US", "NumberOfTriggersInPhase", "1"}
-	tagDict[Tag{0x0054, 0x0220}] = Info{Tag{0x0054, 0x0220}, "SQ", "ViewCodeSequence", "1"}
-	tagDict[Tag{0x0054, 0x0222}] = Info{Tag{0x0054, 0x0222}, "SQ", "ViewModifierCodeSequence", "1"}
-	tagDict[Tag{0x0054, 0x0300}] = Info{Tag{0x0054, 0x0300}, "SQ", "RadionuclideCodeSequence", "1"}
-	tagDict[Tag{0x0054, 0x0302}] = Info{Tag{0x0054, 0x0302}, "SQ", "AdministrationRouteCodeSequence", "1"}
-	tagDict[Tag{0x0054, 0x0304}] = Info{Tag{0x0054, 0x0304}, "SQ", "RadiopharmaceuticalCodeSequence", "1"}
-	tagDict[Tag{0x0054, 0x0306}] = Info{Tag{0x0054, 0x0306}, "SQ", "CalibrationDataSequence", "1"}
-	tagDict[Tag{0x0054, 0x0308}] = Info{Tag{0x0054, 0x0308}, "US", "EnergyWindowNumber", "1"}
-	tagDict[Tag{0x0054, 0x0400}] = Info{Tag{0x0054, 0x0400}, "SH", "ImageID", "1"}
-	tagDict[Tag{0x0054, 0x0410}] = Info{Tag{0x0054, 0x0410}, "SQ", "PatientOrientationCodeSequence", "1"}
-	tagDict[Tag{0x0054, 0x0412}] = Info{Tag{0x0054, 0x0412}, "SQ", "PatientOrientationModifierCodeSequence", "1"}
-	tagDict[Tag{0x0054, 0x0414}] = Info{Tag{0x0054, 0x0414}, "SQ", "PatientGantryRelationshipCodeSequence", "1"}
-	tagDict[Tag{0x0054, 0x0500}] = Info{Tag{0x0054, 0x0500}, "CS", "SliceProgressionDirection", "1"}
-	tagDict[Tag{0x0054, 0x1000}] = Info{Tag{0x0054, 0x1000}, "CS", "SeriesType", "2"}
-	tagDict[Tag{0x0054, 0x1001}] = Info{Tag{0x0054, 0x1001}, "CS", "Units", "1"}
-	tagDict[Tag{0x0054, 0x1002}] = Info{Tag{0x0054, 0x1002}, "CS", "CountsSource", "1"}
-	tagDict[Tag{0x0054, 0x1004}] = Info{Tag{0x0054, 0x1004}, "CS", "ReprojectionMethod", "1"}
-	tagDict[Tag{0x0054, 0x1006}] = Info{Tag{0x0054, 0x1006}, "CS", "SUVType", "1"}
-	tagDict[Tag{0x0054, 0x1100}] = Info{Tag{0x0054, 0x1100}, "CS", "RandomsCorrectionMethod", "1"}
-	tagDict[Tag{0x0054, 0x1101}] = Info{Tag{0x0054, 0x1101}, "LO", "AttenuationCorrectionMethod", "1"}
-	tagDict[Tag{0x0054, 0x1102}] = Info{Tag{0x0054, 0x1102}, "CS", "DecayCorrection", "1"}
-	tagDict[Tag{0x0054, 0x1103}] = Info{Tag{0x0054, 0x1103}, "LO", "ReconstructionMethod", "1"}
-	tagDict[Tag{0x0054, 0x1104}] = Info{Tag{0x0054, 0x1104}, "LO", "DetectorLinesOfResponseUsed", "1"}
-	tagDict[Tag{0x0054, 0x1105}] = Info{Tag{0x0054, 0x1105}, "LO", "ScatterCorrectionMethod", "1"}
-	tagDict[Tag{0x0054, 0x1200}] = Info{Tag{0x0054, 0x1200}, "DS", "AxialAcceptance", "1"}
-	tagDict[Tag{0x0054, 0x1201}] = Info{Tag{0x0054, 0x1201}, "IS", "AxialMash", "2"}
-	tagDict[Tag{0x0054, 0x1202}] = Info{Tag{0x0054, 0x1202}, "IS", "TransverseMash", "1"}
-	tagDict[Tag{0x0054, 0x1203}] = Info{Tag{0x0054, 0x1203}, "DS", "DetectorElementSize", "2"}
-	tagDict[Tag{0x0054, 0x1210}] = Info{Tag{0x0054, 0x1210}, "DS", "CoincidenceWindowWidth", "1"}
-	tagDict[Tag{0x0054, 0x1220}] = Info{Tag{0x0054, 0x1220}, "CS", "SecondaryCountsType", "1-n"}
-	tagDict[Tag{0x0054, 0x1300}] = Info{Tag{0x0054, 0x1300}, "DS", "FrameReferenceTime", "1"}
-	tagDict[Tag{0x0054, 0x1310}] = Info{Tag{0x0054, 0x1310}, "IS", "PrimaryPromptsCountsAccumulated", "1"}
-	tagDict[Tag{0x0054, 0x1311}] = Info{Tag{0x0054, 0x1311}, "IS", "SecondaryCountsAccumulated", "1-n"}
-	tagDict[Tag{0x0054, 0x1320}] = Info{Tag{0x0054, 0x1320}, "DS", "SliceSensitivityFactor", "1"}
-	tagDict[Tag{0x0054, 0x1321}] = Info{Tag{0x0054, 0x1321}, "DS", "DecayFactor", "1"}
-	tagDict[Tag{0x0054, 0x1322}] = Info{Tag{0x0054, 0x1322}, "DS", "DoseCalibrationFactor", "1"}
-	tagDict[Tag{0x0054, 0x1323}] = Info{Tag{0x0054, 0x1323}, "DS", "ScatterFractionFactor", "1"}
-	tagDict[Tag{0x0054, 0x1324}] = Info{Tag{0x0054, 0x1324}, "DS", "DeadTimeFactor", "1"}
-	tagDict[Tag{0x0054, 0x1330}] = Info{Tag{0x0054, 0x1330}, "US", "ImageIndex", "1"}
-	tagDict[Tag{0x0060, 0x3000}] = Info{Tag{0x0060, 0x3000}, "SQ", "HistogramSequence", "1"}
-	tagDict[Tag{0x0060, 0x3002}] = Info{Tag{0x0060, 0x3002}, "US", "HistogramNumberOfBins", "1"}
-	tagDict[Tag{0x0060, 0x3004}] = Info{Tag{0x0060, 0x3004}, "US", "HistogramFirstBinValue", "1"}
-	tagDict[Tag{0x0060, 0x3006}] = Info{Tag{0x0060, 0x3006}, "US", "HistogramLastBinValue", "1"}
-	tagDict[Tag{0x0060, 0x3008}] = Info{Tag{0x0060, 0x3008}, "US", "HistogramBinWidth", "1"}
-	tagDict[Tag{0x0060, 0x3010}] = Info{Tag{0x0060, 0x3010}, "LO", "HistogramExplanation", "1"}
-	tagDict[Tag{0x0060, 0x3020}] = Info{Tag{0x0060, 0x3020}, "UL", "HistogramData", "1-n"}
-	tagDict[Tag{0x0062, 0x0001}] = Info{Tag{0x0062, 0x0001}, "CS", "SegmentationType", "1"}
-	tagDict[Tag{0x0062, 0x0002}] = Info{Tag{0x0062, 0x0002}, "SQ", "SegmentSequence", "1"}
-	tagDict[Tag{0x0062, 0x0003}] = Info{Tag{0x0062, 0x0003}, "SQ", "SegmentedPropertyCategoryCodeSequence", "1"}
-	tagDict[Tag{0x0062, 0x0004}] = Info{Tag{0x0062, 0x0004}, "US", "SegmentNumber", "1"}
-	tagDict[Tag{0x0062, 0x0005}] = Info{Tag{0x0062, 0x0005}, "LO", "SegmentLabel", "1"}
-	tagDict[Tag{0x0062, 0x0006}] = Info{Tag{0x0062, 0x0006}, "ST", "SegmentDescription", "1"}
-	tagDict[Tag{0x0062, 0x0008}] = Info{Tag{0x0062, 0x0008}, "CS", "SegmentAlgorithmType", "1"}
-	tagDict[Tag{0x0062, 0x0009}] = Info{Tag{0x0062, 0x0009}, "LO", "SegmentAlgorithmName", "1"}
-	tagDict[Tag{0x0062, 0x000A}] = Info{Tag{0x0062, 0x000A}, "SQ", "SegmentIdentificationSequence", "1"}
-	tagDict[Tag{0x0062, 0x000B}] = Info{Tag{0x0062, 0x000B}, "US", "ReferencedSegmentNumber", "1-n"}
-	tagDict[Tag{0x0062, 0x000C}] = Info{Tag{0x0062, 0x000C}, "US", "RecommendedDisplayGrayscaleValue", "1"}
-	tagDict[Tag{0x0062, 0x000D}] = Info{Tag{0x0062, 0x000D}, "US", "RecommendedDisplayCIELabValue", "3"}
-	tagDict[Tag{0x0062, 0x000E}] = Info{Tag{0x0062, 0x000E}, "US", "MaximumFractionalValue", "1"}
-	tagDict[Tag{0x0062, 0x000F}] = Info{Tag{0x0062, 0x000F}, "SQ", "SegmentedPropertyTypeCodeSequence", "1"}
-	tagDict[Tag{0x0062, 0x0010}] = Info{Tag{0x0062, 0x0010}, "CS", "SegmentationFractionalType", "1"}
-	tagDict[Tag{0x0064, 0x0002}] = Info{Tag{0x0064, 0x0002}, "SQ", "DeformableRegistrationSequence", "1"}
-	tagDict[Tag{0x0064, 0x0003}] = Info{Tag{0x0064, 0x0003}, "UI", "SourceFrameOfReferenceUID", "1"}
-	tagDict[Tag{0x0064, 0x0005}] = Info{Tag{0x0064, 0x0005}, "SQ", "DeformableRegistrationGridSequence", "1"}
-	tagDict[Tag{0x0064, 0x0007}] = Info{Tag{0x0064, 0x0007}, "UL", "GridDimensions", "3"}
-	tagDict[Tag{0x0064, 0x0008}] = Info{Tag{0x0064, 0x0008}, "FD", "GridResolution", "3"}
-	tagDict[Tag{0x0064, 0x0009}] = Info{Tag{0x0064, 0x0009}, "OF", "VectorGridData", "1"}
-	tagDict[Tag{0x0064, 0x000F}] = Info{Tag{0x0064, 0x000F}, "SQ", "PreDeformationMatrixRegistrationSequence", "1"}
-	tagDict[Tag{0x0064, 0x0010}] = Info{Tag{0x0064, 0x0010}, "SQ", "PostDeformationMatrixRegistrationSequence", "1"}
-	tagDict[Tag{0x0066, 0x0001}] = Info{Tag{0x0066, 0x0001}, "UL", "NumberOfSurfaces", "1"}
-	tagDict[Tag{0x0066, 0x0002}] = Info{Tag{0x0066, 0x0002}, "SQ", "SurfaceSequence", "1"}
-	tagDict[Tag{0x0066, 0x0003}] = Info{Tag{0x0066, 0x0003}, "UL", "SurfaceNumber", "1"}
-	tagDict[Tag{0x0066, 0x0004}] = Info{Tag{0x0066, 0x0004}, "LT", "SurfaceComments", "1"}
-	tagDict[Tag{0x0066, 0x0009}] = Info{Tag{0x0066, 0x0009}, "CS", "SurfaceProcessing", "1"}
-	tagDict[Tag{0x0066, 0x000A}] = Info{Tag{0x0066, 0x000A}, "FL", "SurfaceProcessingRatio", "1"}
-	tagDict[Tag{0x0066, 0x000B}] = Info{Tag{0x0066, 0x000B}, "LO", "SurfaceProcessingDescription", "1"}
-	tagDict[Tag{0x0066, 0x000C}] = Info{Tag{0x0066, 0x000C}, "FL", "RecommendedPresentationOpacity", "1"}
-	tagDict[Tag{0x0066, 0x000D}] = Info{Tag{0x0066, 0x000D}, "CS", "RecommendedPresentationType", "1"}
-	tagDict[Tag{0x0066, 0x000E}] = Info{Tag{0x0066, 0x000E}, "CS", "FiniteVolume", "1"}
-	tagDict[Tag{0x0066, 0x0010}] = Info{Tag{0x0066, 0x0010}, "CS", "Manifold", "1"}
-	tagDict[Tag{0x0066, 0x0011}] = Info{Tag{0x0066, 0x0011}, "SQ", "SurfacePointsSequence", "1"}
-	tagDict[Tag{0x0066, 0x0012}] = Info{Tag{0x0066, 0x0012}, "SQ", "SurfacePointsNormalsSequence", "1"}
-	tagDict[Tag{0x0066, 0x0013}] = Info{Tag{0x0066, 0x0013}, "SQ", "SurfaceMeshPrimitivesSequence", "1"}
-	tagDict[Tag{0x0066, 0x0015}] = Info{Tag{0x0066, 0x0015}, "UL", "NumberOfSurfacePoints", "1"}
-	tagDict[Tag{0x0066, 0x0016}] = Info{Tag{0x0066, 0x0016}, "OF", "PointCoordinatesData", "1"}
-	tagDict[Tag{0x0066, 0x0017}] = Info{Tag{0x0066, 0x0017}, "FL", "PointPositionAccuracy", "3"}
-	tagDict[Tag{0x0066, 0x0018}] = Info{Tag{0x0066, 0x0018}, "FL", "MeanPointDistance", "1"}
-	tagDict[Tag{0x0066, 0x0019}] = Info{Tag{0x0066, 0x0019}, "FL", "MaximumPointDistance", "1"}
-	tagDict[Tag{0x0066, 0x001A}] = Info{Tag{0x0066, 0x001A}, "FL", "PointsBoundingBoxCoordinates", "6"}
-	tagDict[Tag{0x0066, 0x001B}] = Info{Tag{0x0066, 0x001B}, "FL", "AxisOfRotation", "3"}
-	tagDict[Tag{0x0066, 0x001C}] = Info{Tag{0x0066, 0x001C}, "FL", "CenterOfRotation", "3"}
-	tagDict[Tag{0x0066, 0x001E}] = Info{Tag{0x0066, 0x001E}, "UL", "NumberOfVectors", "1"}
-	tagDict[Tag{0x0066, 0x001F}] = Info{Tag{0x0066, 0x001F}, "US", "VectorDimensionality", "1"}
-	tagDict[Tag{0x0066, 0x0020}] = Info{Tag{0x0066, 0x0020}, "FL", "VectorAccuracy", "1-n"}
-	tagDict[Tag{0x0066, 0x0021}] = Info{Tag{0x0066, 0x0021}, "OF", "VectorCoordinateData", "1"}
-	tagDict[Tag{0x0066, 0x0023}] = Info{Tag{0x0066, 0x0023}, "OW", "TrianglePointIndexList", "1"}
-	tagDict[Tag{0x0066, 0x0024}] = Info{Tag{0x0066, 0x0024}, "OW", "EdgePointIndexList", "1"}
-	tagDict[Tag{0x0066, 0x0025}] = Info{Tag{0x0066, 0x0025}, "OW", "VertexPointIndexList", "1"}
-	tagDict[Tag{0x0066, 0x0026}] = Info{Tag{0x0066, 0x0026}, "SQ", "TriangleStripSequence", "1"}
-	tagDict[Tag{0x0066, 0x0027}] = Info{Tag{0x0066, 0x0027}, "SQ", "TriangleFanSequence", "1"}
-	tagDict[Tag{0x0066, 0x0028}] = Info{Tag{0x0066, 0x0028}, "SQ", "LineSequence", "1"}
-	tagDict[Tag{0x0066, 0x0029}] = Info{Tag{0x0066, 0x0029}, "OW", "PrimitivePointIndexList", "1"}
-	tagDict[Tag{0x0066, 0x002A}] = Info{Tag{0x0066, 0x002A}, "UL", "SurfaceCount", "1"}
-	tagDict[Tag{0x0066, 0x002B}] = Info{Tag{0x0066, 0x002B}, "SQ", "ReferencedSurfaceSequence", "1"}
-	tagDict[Tag{0x0066, 0x002C}] = Info{Tag{0x0066, 0x002C}, "UL", "ReferencedSurfaceNumber", "1"}
-	tagDict[Tag{0x0066, 0x002D}] = Info{Tag{0x0066, 0x002D}, "SQ", "SegmentSurfaceGenerationAlgorithmIdentificationSequence", "1"}
-	tagDict[Tag{0x0066, 0x002E}] = Info{Tag{0x0066, 0x002E}, "SQ", "SegmentSurfaceSourceInstanceSequence", "1"}
-	tagDict[Tag{0x0066, 0x002F}] = Info{Tag{0x0066, 0x002F}, "SQ", "AlgorithmFamilyCodeSequence", "1"}
-	tagDict[Tag{0x0066, 0x0030}] = Info{Tag{0x0066, 0x0030}, "SQ", "AlgorithmNameCodeSequence", "1"}
-	tagDict[Tag{0x0066, 0x0031}] = Info{Tag{0x0066, 0x0031}, "LO", "AlgorithmVersion", "1"}
-	tagDict[Tag{0x0066, 0x0032}] = Info{Tag{0x0066, 0x0032}, "LT", "AlgorithmParameters", "1"}
-	tagDict[Tag{0x0066, 0x0034}] = Info{Tag{0x0066, 0x0034}, "SQ", "FacetSequence", "1"}
-	tagDict[Tag{0x0066, 0x0035}] = Info{Tag{0x0066, 0x0035}, "SQ", "SurfaceProcessingAlgorithmIdentificationSequence", "1"}
-	tagDict[Tag{0x0066, 0x0036}] = Info{Tag{0x0066, 0x0036}, "LO", "AlgorithmName", "1"}
-	tagDict[Tag{0x0068, 0x6210}] = Info{Tag{0x0068, 0x6210}, "LO", "ImplantSize", "1"}
-	tagDict[Tag{0x0068, 0x6221}] = Info{Tag{0x0068, 0x6221}, "LO", "ImplantTemplateVersion", "1"}
-	tagDict[Tag{0x0068, 0x6222}] = Info{Tag{0x0068, 0x6222}, "SQ", "ReplacedImplantTemplateSequence", "1"}
-	tagDict[Tag{0x0068, 0x6223}] = Info{Tag{0x0068, 0x6223}, "CS", "ImplantType", "1"}
-	tagDict[Tag{0x0068, 0x6224}] = Info{Tag{0x0068, 0x6224}, "SQ", "DerivationImplantTemplateSequence", "1"}
-	tagDict[Tag{0x0068, 0x6225}] = Info{Tag{0x0068, 0x6225}, "SQ", "OriginalImplantTemplateSequence", "1"}
-	tagDict[Tag{0x0068, 0x6226}] = Info{Tag{0x0068, 0x6226}, "DT", "EffectiveDateTime", "1"}
-	tagDict[Tag{0x0068, 0x6230}] = Info{Tag{0x0068, 0x6230}, "SQ", "ImplantTargetAnatomySequence", "1"}
-	tagDict[Tag{0x0068, 0x6260}] = Info{Tag{0x0068, 0x6260}, "SQ", "InformationFromManufacturerSequence", "1"}
-	tagDict[Tag{0x0068, 0x6265}] = Info{Tag{0x0068, 0x6265}, "SQ", "NotificationFromManufacturerSequence", "1"}
-	tagDict[Tag{0x0068, 0x6270}] = Info{Tag{0x0068, 0x6270}, "DT", "InformationIssueDateTime", "1"}
-	tagDict[Tag{0x0068, 0x6280}] = Info{Tag{0x0068, 0x6280}, "ST", "InformationSummary", "1"}
-	tagDict[Tag{0x0068, 0x62A0}] = Info{Tag{0x0068, 0x62A0}, "SQ", "ImplantRegulatoryDisapprovalCodeSequence", "1"}
-	tagDict[Tag{0x0068, 0x62A5}] = Info{Tag{0x0068, 0x62A5}, "FD", "OverallTemplateSpatialTolerance", "1"}
-	tagDict[Tag{0x0068, 0x62C0}] = Info{Tag{0x0068, 0x62C0}, "SQ", "HPGLDocumentSequence", "1"}
-	tagDict[Tag{0x0068, 0x62D0}] = Info{Tag{0x0068, 0x62D0}, "US", "HPGLDocumentID", "1"}
-	tagDict[Tag{0x0068, 0x62D5}] = Info{Tag{0x0068, 0x62D5}, "LO", "HPGLDocumentLabel", "1"}
-	tagDict[Tag{0x0068, 0x62E0}] = Info{Tag{0x0068, 0x62E0}, "SQ", "ViewOrientationCodeSequence", "1"}
-	tagDict[Tag{0x0068, 0x62F0}] = Info{Tag{0x0068, 0x62F0}, "FD", "ViewOrientationModifier", "9"}
-	tagDict[Tag{0x0068, 0x62F2}] = Info{Tag{0x0068, 0x62F2}, "FD", "HPGLDocumentScaling", "1"}
-	tagDict[Tag{0x0068, 0x6300}] = Info{Tag{0x0068, 0x6300}, "OB", "HPGLDocument", "1"}
-	tagDict[Tag{0x0068, 0x6310}] = Info{Tag{0x0068, 0x6310}, "US", "HPGLContourPenNumber", "1"}
-	tagDict[Tag{0x0068, 0x6320}] = Info{Tag{0x0068, 0x6320}, "SQ", "HPGLPenSequence", "1"}
-	tagDict[Tag{0x0068, 0x6330}] = Info{Tag{0x0068, 0x6330}, "US", "HPGLPenNumber", "1"}
-	tagDict[Tag{0x0068, 0x6340}] = Info{Tag{0x0068, 0x6340}, "LO", "HPGLPenLabel", "1"}
-	tagDict[Tag{0x0068, 0x6345}] = Info{Tag{0x0068, 0x6345}, "ST", "HPGLPenDescription", "1"}
-	tagDict[Tag{0x0068, 0x6346}] = Info{Tag{0x0068, 0x6346}, "FD", "RecommendedRotationPoint", "2"}
-	tagDict[Tag{0x0068, 0x6347}] = Info{Tag{0x0068, 0x6347}, "FD", "BoundingRectangle", "4"}
-	tagDict[Tag{0x0068, 0x6350}] = Info{Tag{0x0068, 0x6350}, "US", "ImplantTemplate3DModelSurfaceNumber", "1-n"}
-	tagDict[Tag{0x0068, 0x6360}] = Info{Tag{0x0068, 0x6360}, "SQ", "SurfaceModelDescriptionSequence", "1"}
-	tagDict[Tag{0x0068, 0x6380}] = Info{Tag{0x0068, 0x6380}, "LO", "SurfaceModelLabel", "1"}
-	tagDict[Tag{0x0068, 0x6390}] = Info{Tag{0x0068, 0x6390}, "FD", "SurfaceModelScalingFactor", "1"}
-	tagDict[Tag{0x0068, 0x63A0}] = Info{Tag{0x0068, 0x63A0}, "SQ", "MaterialsCodeSequence", "1"}
-	tagDict[Tag{0x0068, 0x63A4}] = Info{Tag{0x0068, 0x63A4}, "SQ", "CoatingMaterialsCodeSequence", "1"}
-	tagDict[Tag{0x0068, 0x63A8}] = Info{Tag{0x0068, 0x63A8}, "SQ", "ImplantTypeCodeSequence", "1"}
-	tagDict[Tag{0x0068, 0x63AC}] = Info{Tag{0x0068, 0x63AC}, "SQ", "FixationMethodCodeSequence", "1"}
-	tagDict[Tag{0x0068, 0x63B0}] = Info{Tag{0x0068, 0x63B0}, "SQ", "MatingFeatureSetsSequence", "1"}
-	tagDict[Tag{0x0068, 0x63C0}] = Info{Tag{0x0068, 0x63C0}, "US", "MatingFeatureSetID", "1"}
-	tagDict[Tag{0x0068, 0x63D0}] = Info{Tag{0x0068, 0x63D0}, "LO", "MatingFeatureSetLabel", "1"}
-	tagDict[Tag{0x0068, 0x63E0}] = Info{Tag{0x0068, 0x63E0}, "SQ", "MatingFeatureSequence", "1"}
-	tagDict[Tag{0x0068, 0x63F0}] = Info{Tag{0x0068, 0x63F0}, "US", "MatingFeatureID", "1"}
-	tagDict[Tag{0x0068, 0x6400}] = Info{Tag{0x0068, 0x6400}, "SQ", "MatingFeatureDegreeOfFreedomSequence", "1"}
-	tagDict[Tag{0x0068, 0x6410}] = Info{Tag{0x0068, 0x6410}, "US", "DegreeOfFreedomID", "1"}
-	tagDict[Tag{0x0068, 0x6420}] = Info{Tag{0x0068, 0x6420}, "CS", "DegreeOfFreedomType", "1"}
-	tagDict[Tag{0x0068, 0x6430}] = Info{Tag{0x0068, 0x6430}, "SQ", "TwoDMatingFeatureCoordinatesSequence", "1"}
-	tagDict[Tag{0x0068, 0x6440}] = Info{Tag{0x0068, 0x6440}, "US", "ReferencedHPGLDocumentID", "1"}
-	tagDict[Tag{0x0068, 0x6450}] = Info{Tag{0x0068, 0x6450}, "FD", "TwoDMatingPoint", "2"}
-	tagDict[Tag{0x0068, 0x6460}] = Info{Tag{0x0068, 0x6460}, "FD", "TwoDMatingAxes", "4"}
-	tagDict[Tag{0x0068, 0x6470}] = Info{Tag{0x0068, 0x6470}, "SQ", "TwoDDegreeOfFreedomSequence", "1"}
-	tagDict[Tag{0x0068, 0x6490}] = Info{Tag{0x0068, 0x6490}, "FD", "ThreeDDegreeOfFreedomAxis", "3"}
-	tagDict[Tag{0x0068, 0x64A0}] = Info{Tag{0x0068, 0x64A0}, "FD", "RangeOfFreedom", "2"}
-	tagDict[Tag{0x0068, 0x64C0}] = Info{Tag{0x0068, 0x64C0}, "FD", "ThreeDMatingPoint", "3"}
-	tagDict[Tag{0x0068, 0x64D0}] = Info{Tag{0x0068, 0x64D0}, "FD", "ThreeDMatingAxes", "9"}
-	tagDict[Tag{0x0068, 0x64F0}] = Info{Tag{0x0068, 0x64F0}, "FD", "TwoDDegreeOfFreedomAxis", "3"}
-	tagDict[Tag{0x0068, 0x6500}] = Info{Tag{0x0068, 0x6500}, "SQ", "PlanningLandmarkPointSequence", "1"}
-	tagDict[Tag{0x0068, 0x6510}] = Info{Tag{0x0068, 0x6510}, "SQ", "PlanningLandmarkLineSequence", "1"}
-	tagDict[Tag{0x0068, 0x6520}] = Info{Tag{0x0068, 0x6520}, "SQ", "PlanningLandmarkPlaneSequence", "1"}
-	tagDict[Tag{0x0068, 0x6530}] = Info{Tag{0x0068, 0x6530}, "US", "PlanningLandmarkID", "1"}
-	tagDict[Tag{0x0068, 0x6540}] = Info{Tag{0x0068, 0x6540}, "LO", "PlanningLandmarkDescription", "1"}
-	tagDict[Tag{0x0068, 0x6545}] = Info{Tag{0x0068, 0x6545}, "SQ", "PlanningLandmarkIdentificationCodeSequence", "1"}
-	tagDict[Tag{0x0068, 0x6550}] = Info{Tag{0x0068, 0x6550}, "SQ", "TwoDPointCoordinatesSequence", "1"}
-	tagDict[Tag{0x0068, 0x6560}] = Info{Tag{0x0068, 0x6560}, "FD", "TwoDPointCoordinates", "2"}
-	tagDict[Tag{0x0068, 0x6590}] = Info{Tag{0x0068, 0x6590}, "FD", "ThreeDPointCoordinates", "3"}
-	tagDict[Tag{0x0068, 0x65A0}] = Info{Tag{0x0068, 0x65A0}, "SQ", "TwoDLineCoordinatesSequence", "1"}
-	tagDict[Tag{0x0068, 0x65B0}] = Info{Tag{0x0068, 0x65B0}, "FD", "TwoDLineCoordinates", "4"}
-	tagDict[Tag{0x0068, 0x65D0}] = Info{Tag{0x0068, 0x65D0}, "FD", "ThreeDLineCoordinates", "6"}
-	tagDict[Tag{0x0068, 0x65E0}] = Info{Tag{0x0068, 0x65E0}, "SQ", "TwoDPlaneCoordinatesSequence", "1"}
-	tagDict[Tag{0x0068, 0x65F0}] = Info{Tag{0x0068, 0x65F0}, "FD", "TwoDPlaneIntersection", "4"}
-	tagDict[Tag{0x0068, 0x6610}] = Info{Tag{0x0068, 0x6610}, "FD", "ThreeDPlaneOrigin", "3"}
-	tagDict[Tag{0x0068, 0x6620}] = Info{Tag{0x0068, 0x6620}, "FD", "ThreeDPlaneNormal", "3"}
-	tagDict[Tag{0x0070, 0x0001}] = Info{Tag{0x0070, 0x0001}, "SQ", "GraphicAnnotationSequence", "1"}
-	tagDict[Tag{0x0070, 0x0002}] = Info{Tag{0x0070, 0x0002}, "CS", "GraphicLayer", "1"}
-	tagDict[Tag{0x0070, 0x0003}] = Info{Tag{0x0070, 0x0003}, "CS", "BoundingBoxAnnotationUnits", "1"}
-	tagDict[Tag{0x0070, 0x0004}] = Info{Tag{0x0070, 0x0004}, "CS", "AnchorPointAnnotationUnits", "1"}
-	tagDict[Tag{0x0070, 0x0005}] = Info{Tag{0x0070, 0x0005}, "CS", "GraphicAnnotationUnits", "1"}
-	tagDict[Tag{0x0070, 0x0006}] = Info{Tag{0x0070, 0x0006}, "ST", "UnformattedTextValue", "1"}
-	tagDict[Tag{0x0070, 0x0008}] = Info{Tag{0x0070, 0x0008}, "SQ", "TextObjectSequence", "1"}
-	tagDict[Tag{0x0070, 0x0009}] = Info{Tag{0x0070, 0x0009}, "SQ", "GraphicObjectSequence", "1"}
-	tagDict[Tag{0x0070, 0x0010}] = Info{Tag{0x0070, 0x0010}, "FL", "BoundingBoxTopLeftHandCorner", "2"}
-	tagDict[Tag{0x0070, 0x0011}] = Info{Tag{0x0070, 0x0011}, "FL", "BoundingBoxBottomRightHandCorner", "2"}
-	tagDict[Tag{0x0070, 0x0012}] = Info{Tag{0x0070, 0x0012}, "CS", "BoundingBoxTextHorizontalJustification", "1"}
-	tagDict[Tag{0x0070, 0x0014}] = Info{Tag{0x0070, 0x0014}, "FL", "AnchorPoint", "2"}
-	tagDict[Tag{0x0070, 0x0015}] = Info{Tag{0x0070, 0x0015}, "CS", "AnchorPointVisibility", "1"}
-	tagDict[Tag{0x0070, 0x0020}] = Info{Tag{0x0070, 0x0020}, "US", "GraphicDimensions", "1"}
-	tagDict[Tag{0x0070, 0x0021}] = Info{Tag{0x0070, 0x0021}, "US", "NumberOfGraphicPoints", "1"}
-	tagDict[Tag{0x0070, 0x0022}] = Info{Tag{0x0070, 0x0022}, "FL", "GraphicData", "2-n"}
-	tagDict[Tag{0x0070, 0x0023}] = Info{Tag{0x0070, 0x0023}, "CS", "GraphicType", "1"}
-	tagDict[Tag{0x0070, 0x0024}] = Info{Tag{0x0070, 0x0024}, "CS", "GraphicFilled", "1"}
-	tagDict[Tag{0x0070, 0x0041}] = Info{Tag{0x0070, 0x0041}, "CS", "ImageHorizontalFlip", "1"}
-	tagDict[Tag{0x0070, 0x0042}] = Info{Tag{0x0070, 0x0042}, "US", "ImageRotation", "1"}
-	tagDict[Tag{0x0070, 0x0052}] = Info{Tag{0x0070, 0x0052}, "SL", "DisplayedAreaTopLeftHandCorner", "2"}
-	tagDict[Tag{0x0070, 0x0053}] = Info{Tag{0x0070, 0x0053}, "SL", "DisplayedAreaBottomRightHandCorner", "2"}
-	tagDict[Tag{0x0070, 0x005A}] = Info{Tag{0x0070, 0x005A}, "SQ", "DisplayedAreaSelectionSequence", "1"}
-	tagDict[Tag{0x0070, 0x0060}] = Info{Tag{0x0070, 0x0060}, "SQ", "GraphicLayerSequence", "1"}
-	tagDict[Tag{0x0070, 0x0062}] = Info{Tag{0x0070, 0x0062}, "IS", "GraphicLayerOrder", "1"}
-	tagDict[Tag{0x0070, 0x0066}] = Info{Tag{0x0070, 0x0066}, "US", "GraphicLayerRecommendedDisplayGrayscaleValue", "1"}
-	tagDict[Tag{0x0070, 0x0068}] = Info{Tag{0x0070, 0x0068}, "LO", "GraphicLayerDescription", "1"}
-	tagDict[Tag{0x0070, 0x0080}] = Info{Tag{0x0070, 0x0080}, "CS", "ContentLabel", "1"}
-	tagDict[Tag{0x0070, 0x0081}] = Info{Tag{0x0070, 0x0081}, "LO", "ContentDescription", "1"}
-	tagDict[Tag{0x0070, 0x0082}] = Info{Tag{0x0070, 0x0082}, "DA", "PresentationCreationDate", "1"}
-	tagDict[Tag{0x0070, 0x0083}] = Info{Tag{0x0070, 0x0083}, "TM", "PresentationCreationTime", "1"}
-	tagDict[Tag{0x0070, 0x0084}] = Info{Tag{0x0070, 0x0084}, "PN", "ContentCreatorName", "1"}
-	tagDict[Tag{0x0070, 0x0086}] = Info{Tag{0x0070, 0x0086}, "SQ", "ContentCreatorIdentificationCodeSequence", "1"}
-	tagDict[Tag{0x0070, 0x0087}] = Info{Tag{0x0070, 0x0087}, "SQ", "AlternateContentDescriptionSequence", "1"}
-	tagDict[Tag{0x0070, 0x0100}] = Info{Tag{0x0070, 0x0100}, "CS", "PresentationSizeMode", "1"}
-	tagDict[Tag{0x0070, 0x0101}] = Info{Tag{0x0070, 0x0101}, "DS", "PresentationPixelSpacing", "2"}
-	tagDict[Tag{0x0070, 0x0102}] = Info{Tag{0x0070, 0x0102}, "IS", "PresentationPixelAspectRatio", "2"}
-	tagDict[Tag{0x0070, 0x0103}] = Info{Tag{0x0070, 0x0103}, "FL", "PresentationPixelMagnificationRatio", "1"}
-	tagDict[Tag{0x0070, 0x0207}] = Info{Tag{0x0070, 0x0207}, "LO", "GraphicGroupLabel", "1"}
-	tagDict[Tag{0x0070, 0x0208}] = Info{Tag{0x0070, 0x0208}, "ST", "GraphicGroupDescription", "1"}
-	tagDict[Tag{0x0070, 0x0209}] = Info{Tag{0x0070, 0x0209}, "SQ", "CompoundGraphicSequence", "1"}
-	tagDict[Tag{0x0070, 0x0226}] = Info{Tag{0x0070, 0x0226}, "UL", "CompoundGraphicInstanceID", "1"}
-	tagDict[Tag{0x0070, 0x0227}] = Info{Tag{0x0070, 0x0227}, "LO", "FontName", "1"}
-	tagDict[Tag{0x0070, 0x0228}] = Info{Tag{0x0070, 0x0228}, "CS", "FontNameType", "1"}
-	tagDict[Tag{0x0070, 0x0229}] = Info{Tag{0x0070, 0x0229}, "LO", "CSSFontName", "1"}
-	tagDict[Tag{0x0070, 0x0230}] = Info{Tag{0x0070, 0x0230}, "FD", "RotationAngle", "1"}
-	tagDict[Tag{0x0070, 0x0231}] = Info{Tag{0x0070, 0x0231}, "SQ", "TextStyleSequence", "1"}
-	tagDict[Tag{0x0070, 0x0232}] = Info{Tag{0x0070, 0x0232}, "SQ", "LineStyleSequence", "1"}
-	tagDict[Tag{0x0070, 0x0233}] = Info{Tag{0x0070, 0x0233}, "SQ", "FillStyleSequence", "1"}
-	tagDict[Tag{0x0070, 0x0234}] = Info{Tag{0x0070, 0x0234}, "SQ", "GraphicGroupSequence", "1"}
-	tagDict[Tag{0x0070, 0x0241}] = Info{Tag{0x0070, 0x0241}, "US", "TextColorCIELabValue", "3"}
-	tagDict[Tag{0x0070, 0x0242}] = Info{Tag{0x0070, 0x0242}, "CS", "HorizontalAlignment", "1"}
-	tagDict[Tag{0x0070, 0x0243}] = Info{Tag{0x0070, 0x0243}, "CS", "VerticalAlignment", "1"}
-	tagDict[Tag{0x0070, 0x0244}] = Info{Tag{0x0070, 0x0244}, "CS", "ShadowStyle", "1"}
-	tagDict[Tag{0x0070, 0x0245}] = Info{Tag{0x0070, 0x0245}, "FL", "ShadowOffsetX", "1"}
-	tagDict[Tag{0x0070, 0x0246}] = Info{Tag{0x0070, 0x0246}, "FL", "ShadowOffsetY", "1"}
-	tagDict[Tag{0x0070, 0x0247}] = Info{Tag{0x0070, 0x0247}, "US", "ShadowColorCIELabValue", "3"}
-	tagDict[Tag{0x0070, 0x0248}] = Info{Tag{0x0070, 0x0248}, "CS", "Underlined", "1"}
-	tagDict[Tag{0x0070, 0x0249}] = Info{Tag{0x0070, 0x0249}, "CS", "Bold", "1"}
-	tagDict[Tag{0x0070, 0x0250}] = Info{Tag{0x0070, 0x0250}, "CS", "Italic", "1"}
-	tagDict[Tag{0x0070, 0x0251}] = Info{Tag{0x0070, 0x0251}, "US", "PatternOnColorCIELabValue", "3"}
-	tagDict[Tag{0x0070, 0x0252}] = Info{Tag{0x0070, 0x0252}, "US", "PatternOffColorCIELabValue", "3"}
-	tagDict[Tag{0x0070, 0x0253}] = Info{Tag{0x0070, 0x0253}, "FL", "LineThickness", "1"}
-	tagDict[Tag{0x0070, 0x0254}] = Info{Tag{0x0070, 0x0254}, "CS", "LineDashingStyle", "1"}
-	tagDict[Tag{0x0070, 0x0255}] = Info{Tag{0x0070, 0x0255}, "UL", "LinePattern", "1"}
-	tagDict[Tag{0x0070, 0x0256}] = Info{Tag{0x0070, 0x0256}, "OB", "FillPattern", "1"}
-	tagDict[Tag{0x0070, 0x0257}] = Info{Tag{0x0070, 0x0257}, "CS", "FillMode", "1"}
-	tagDict[Tag{0x0070, 0x0258}] = Info{Tag{0x0070, 0x0258}, "FL", "ShadowOpacity", "1"}
-	tagDict[Tag{0x0070, 0x0261}] = Info{Tag{0x0070, 0x0261}, "FL", "GapLength", "1"}
-	tagDict[Tag{0x0070, 0x0262}] = Info{Tag{0x0070, 0x0262}, "FL", "DiameterOfVisibility", "1"}
-	tagDict[Tag{0x0070, 0x0273}] = Info{Tag{0x0070, 0x0273}, "FL", "RotationPoint", "2"}
-	tagDict[Tag{0x0070, 0x0274}] = Info{Tag{0x0070, 0x0274}, "CS", "TickAlignment", "1"}
-	tagDict[Tag{0x0070, 0x0278}] = Info{Tag{0x0070, 0x0278}, "CS", "ShowTickLabel", "1"}
-	tagDict[Tag{0x0070, 0x0279}] = Info{Tag{0x0070, 0x0279}, "CS", "TickLabelAlignment", "1"}
-	tagDict[Tag{0x0070, 0x0282}] = Info{Tag{0x0070, 0x0282}, "CS", "CompoundGraphicUnits", "1"}
-	tagDict[Tag{0x0070, 0x0284}] = Info{Tag{0x0070, 0x0284}, "FL", "PatternOnOpacity", "1"}
-	tagDict[Tag{0x0070, 0x0285}] = Info{Tag{0x0070, 0x0285}, "FL", "PatternOffOpacity", "1"}
-	tagDict[Tag{0x0070, 0x0287}] = Info{Tag{0x0070, 0x0287}, "SQ", "MajorTicksSequence", "1"}
-	tagDict[Tag{0x0070, 0x0288}] = Info{Tag{0x0070, 0x0288}, "FL", "TickPosition", "1"}
-	tagDict[Tag{0x0070, 0x0289}] = Info{Tag{0x0070, 0x0289}, "SH", "TickLabel", "1"}
-	tagDict[Tag{0x0070, 0x0294}] = Info{Tag{0x0070, 0x0294}, "CS", "CompoundGraphicType", "1"}
-	tagDict[Tag{0x0070, 0x0295}] = Info{Tag{0x0070, 0x0295}, "UL", "GraphicGroupID", "1"}
-	tagDict[Tag{0x0070, 0x0306}] = Info{Tag{0x0070, 0x0306}, "CS", "ShapeType", "1"}
-	tagDict[Tag{0x0070, 0x0308}] = Info{Tag{0x0070, 0x0308}, "SQ", "RegistrationSequence", "1"}
-	tagDict[Tag{0x0070, 0x0309}] = Info{Tag{0x0070, 0x0309}, "SQ", "MatrixRegistrationSequence", "1"}
-	tagDict[Tag{0x0070, 0x030A}] = Info{Tag{0x0070, 0x030A}, "SQ", "MatrixSequence", "1"}
-	tagDict[Tag{0x0070, 0x030C}] = Info{Tag{0x0070, 0x030C}, "CS", "FrameOfReferenceTransformationMatrixType", "1"}
-	tagDict[Tag{0x0070, 0x030D}] = Info{Tag{0x0070, 0x030D}, "SQ", "RegistrationTypeCodeSequence", "1"}
-	tagDict[Tag{0x0070, 0x030F}] = Info{Tag{0x0070, 0x030F}, "ST", "FiducialDescription", "1"}
-	tagDict[Tag{0x0070, 0x0310}] = Info{Tag{0x0070, 0x0310}, "SH", "FiducialIdentifier", "1"}
-	tagDict[Tag{0x0070, 0x0311}] = Info{Tag{0x0070, 0x0311}, "SQ", "FiducialIdentifierCodeSequence", "1"}
-	tagDict[Tag{0x0070, 0x0312}] = Info{Tag{0x0070, 0x0312}, "FD", "ContourUncertaintyRadius", "1"}
-	tagDict[Tag{0x0070, 0x0314}] = Info{Tag{0x0070, 0x0314}, "SQ", "UsedFiducialsSequence", "1"}
-	tagDict[Tag{0x0070, 0x0318}] = Info{Tag{0x0070, 0x0318}, "SQ", "GraphicCoordinatesDataSequence", "1"}
-	tagDict[Tag{0x0070, 0x031A}] = Info{Tag{0x0070, 0x031A}, "UI", "FiducialUID", "1"}
-	tagDict[Tag{0x0070, 0x031C}] = Info{Tag{0x0070, 0x031C}, "SQ", "FiducialSetSequence", "1"}
-	tagDict[Tag{0x0070, 0x031E}] = Info{Tag{0x0070, 0x031E}, "SQ", "FiducialSequence", "1"}
-	tagDict[Tag{0x0070, 0x0401}] = Info{Tag{0x0070, 0x0401}, "US", "GraphicLayerRecommendedDisplayCIELabValue", "3"}
-	tagDict[Tag{0x0070, 0x0402}] = Info{Tag{0x0070, 0x0402}, "SQ", "BlendingSequence", "1"}
-	tagDict[Tag{0x0070, 0x0403}] = Info{Tag{0x0070, 0x0403}, "FL", "RelativeOpacity", "1"}
-	tagDict[Tag{0x0070, 0x0404}] = Info{Tag{0x0070, 0x0404}, "SQ", "ReferencedSpatialRegistrationSequence", "1"}
-	tagDict[Tag{0x0070, 0x0405}] = Info{Tag{0x0070, 0x0405}, "CS", "BlendingPosition", "1"}
-	tagDict[Tag{0x0072, 0x0002}] = Info{Tag{0x0072, 0x0002}, "SH", "HangingProtocolName", "1"}
-	tagDict[Tag{0x0072, 0x0004}] = Info{Tag{0x0072, 0x0004}, "LO", "HangingProtocolDescription", "1"}
-	tagDict[Tag{0x0072, 0x0006}] = Info{Tag{0x0072, 0x0006}, "CS", "HangingProtocolLevel", "1"}
-	tagDict[Tag{0x0072, 0x0008}] = Info{Tag{0x0072, 0x0008}, "LO", "HangingProtocolCreator", "1"}
-	tagDict[Tag{0x0072, 0x000A}] = Info{Tag{0x0072, 0x000A}, "DT", "HangingProtocolCreationDateTime", "1"}
-	tagDict[Tag{0x0072, 0x000C}] = Info{Tag{0x0072, 0x000C}, "SQ", "HangingProtocolDefinitionSequence", "1"}
-	tagDict[Tag{0x0072, 0x000E}] = Info{Tag{0x0072, 0x000E}, "SQ", "HangingProtocolUserIdentificationCodeSequence", "1"}
-	tagDict[Tag{0x0072, 0x0010}] = Info{Tag{0x0072, 0x0010}, "LO", "HangingProtocolUserGroupName", "1"}
-	tagDict[Tag{0x0072, 0x0012}] = Info{Tag{0x0072, 0x0012}, "SQ", "SourceHangingProtocolSequence", "1"}
-	tagDict[Tag{0x0072, 0x0014}] = Info{Tag{0x0072, 0x0014}, "US", "NumberOfPriorsReferenced", "1"}
-	tagDict[Tag{0x0072, 0x0020}] = Info{Tag{0x0072, 0x0020}, "SQ", "ImageSetsSequence", "1"}
-	tagDict[Tag{0x0072, 0x0022}] = Info{Tag{0x0072, 0x0022}, "SQ", "ImageSetSelectorSequence", "1"}
-	tagDict[Tag{0x0072, 0x0024}] = Info{Tag{0x0072, 0x0024}, "CS", "ImageSetSelectorUsageFlag", "1"}
-	tagDict[Tag{0x0072, 0x0026}] = Info{Tag{0x0072, 0x0026}, "AT", "SelectorAttribute", "1"}
-	tagDict[Tag{0x0072, 0x0028}] = Info{Tag{0x0072, 0x0028}, "US", "SelectorValueNumber", "1"}
-	tagDict[Tag{0x0072, 0x0030}] = Info{Tag{0x0072, 0x0030}, "SQ", "TimeBasedImageSetsSequence", "1"}
-	tagDict[Tag{0x0072, 0x0032}] = Info{Tag{0x0072, 0x0032}, "US", "ImageSetNumber", "1"}
-	tagDict[Tag{0x0072, 0x0034}] = Info{Tag{0x0072, 0x0034}, "CS", "ImageSetSelectorCategory", "1"}
-	tagDict[Tag{0x0072, 0x0038}] = Info{Tag{0x0072, 0x0038}, "US", "RelativeTime", "2"}
-	tagDict[Tag{0x0072, 0x003A}] = Info{Tag{0x0072, 0x003A}, "CS", "RelativeTimeUnits", "1"}
-	tagDict[Tag{0x0072, 0x003C}] = Info{Tag{0x0072, 0x003C}, "SS", "AbstractPriorValue", "2"}
-	tagDict[Tag{0x0072, 0x003E}] = Info{Tag{0x0072, 0x003E}, "SQ", "AbstractPriorCodeSequence", "1"}
-	tagDict[Tag{0x0072, 0x0040}] = Info{Tag{0x0072, 0x0040}, "LO", "ImageSetLabel", "1"}
-	tagDict[Tag{0x0072, 0x0050}] = Info{Tag{0x0072, 0x0050}, "CS", "SelectorAttributeVR", "1"}
-	tagDict[Tag{0x0072, 0x0052}] = Info{Tag{0x0072, 0x0052}, "AT", "SelectorSequencePointer", "1-n"}
-	tagDict[Tag{0x0072, 0x0054}] = Info{Tag{0x0072, 0x0054}, "LO", "SelectorSequencePointerPrivateCreator", "1-n"}
-	tagDict[Tag{0x0072, 0x0056}] = Info{Tag{0x0072, 0x0056}, "LO", "SelectorAttributePrivateCreator", "1"}
-	tagDict[Tag{0x0072, 0x0060}] = Info{Tag{0x0072, 0x0060}, "AT", "SelectorATValue", "1-n"}
-	tagDict[Tag{0x0072, 0x0062}] = Info{Tag{0x0072, 0x0062}, "CS", "SelectorCSValue", "1-n"}
-	tagDict[Tag{0x0072, 0x0064}] = Info{Tag{0x0072, 0x0064}, "IS", "SelectorISValue", "1-n"}
-	tagDict[Tag{0x0072, 0x0066}] = Info{Tag{0x0072, 0x0066}, "LO", "SelectorLOValue", "1-n"}
-	tagDict[Tag{0x0072, 0x0068}] = Info{Tag{0x0072, 0x0068}, "LT", "SelectorLTValue", "1"}
-	tagDict[Tag{0x0072, 0x006A}] = Info{Tag{0x0072, 0x006A}, "PN", "SelectorPNValue", "1-n"}
-	tagDict[Tag{0x0072, 0x006C}] = Info{Tag{0x0072, 0x006C}, "SH", "SelectorSHValue", "1-n"}
-	tagDict[Tag{0x0072, 0x006E}] = Info{Tag{0x0072, 0x006E}, "ST", "SelectorSTValue", "1"}
-	tagDict[Tag{0x0072, 0x0070}] = Info{Tag{0x0072, 0x0070}, "UT", "SelectorUTValue", "1"}
-	tagDict[Tag{0x0072, 0x0072}] = Info{Tag{0x0072, 0x0072}, "DS", "SelectorDSValue", "1-n"}
-	tagDict[Tag{0x0072, 0x0074}] = Info{Tag{0x0072, 0x0074}, "FD", "SelectorFDValue", "1-n"}
-	tagDict[Tag{0x0072, 0x0076}] = Info{Tag{0x0072, 0x0076}, "FL", "SelectorFLValue", "1-n"}
-	tagDict[Tag{0x0072, 0x0078}] = Info{Tag{0x0072, 0x0078}, "UL", "SelectorULValue", "1-n"}
-	tagDict[Tag{0x0072, 0x007A}] = Info{Tag{0x0072, 0x007A}, "US", "SelectorUSValue", "1-n"}
-	tagDict[Tag{0x0072, 0x007C}] = Info{Tag{0x0072, 0x007C}, "SL", "SelectorSLValue", "1-n"}
-	tagDict[Tag{0x0072, 0x007E}] = Info{Tag{0x0072, 0x007E}, "SS", "SelectorSSValue", "1-n"}
-	tagDict[Tag{0x0072, 0x0080}] = Info{Tag{0x0072, 0x0080}, "SQ", "SelectorCodeSequenceValue", "1"}
-	tagDict[Tag{0x0072, 0x0100}] = Info{Tag{0x0072, 0x0100}, "US", "NumberOfScreens", "1"}
-	tagDict[Tag{0x0072, 0x0102}] = Info{Tag{0x0072, 0x0102}, "SQ", "NominalScreenDefinitionSequence", "1"}
-	tagDict[Tag{0x0072, 0x0104}] = Info{Tag{0x0072, 0x0104}, "US", "NumberOfVerticalPixels", "1"}
-	tagDict[Tag{0x0072, 0x0106}] = Info{Tag{0x0072, 0x0106}, "US", "NumberOfHorizontalPixels", "1"}
-	tagDict[Tag{0x0072, 0x0108}] = Info{Tag{0x0072, 0x0108}, "FD", "DisplayEnvironmentSpatialPosition", "4"}
-	tagDict[Tag{0x0072, 0x010A}] = Info{Tag{0x0072, 0x010A}, "US", "ScreenMinimumGrayscaleBitDepth", "1"}
-	tagDict[Tag{0x0072, 0x010C}] = Info{Tag{0x0072, 0x010C}, "US", "ScreenMinimumColorBitDepth", "1"}
-	tagDict[Tag{0x0072, 0x010E}] = Info{Tag{0x0072, 0x010E}, "US", "ApplicationMaximumRepaintTime", "1"}
-	tagDict[Tag{0x0072, 0x0200}] = Info{Tag{0x0072, 0x0200}, "SQ", "DisplaySetsSequence", "1"}
-	tagDict[Tag{0x0072, 0x0202}] = Info{Tag{0x0072, 0x0202}, "US", "DisplaySetNumber", "1"}
-	tagDict[Tag{0x0072, 0x0203}] = Info{Tag{0x0072, 0x0203}, "LO", "DisplaySetLabel", "1"}
-	tagDict[Tag{0x0072, 0x0204}] = Info{Tag{0x0072, 0x0204}, "US", "DisplaySetPresentationGroup", "1"}
-	tagDict[Tag{0x0072, 0x0206}] = Info{Tag{0x0072, 0x0206}, "LO", "DisplaySetPresentationGroupDescription", "1"}
-	tagDict[Tag{0x0072, 0x0208}] = Info{Tag{0x0072, 0x0208}, "CS", "PartialDataDisplayHandling", "1"}
-	tagDict[Tag{0x0072, 0x0210}] = Info{Tag{0x0072, 0x0210}, "SQ", "SynchronizedScrollingSequence", "1"}
-	tagDict[Tag{0x0072, 0x0212}] = Info{Tag{0x0072, 0x0212}, "US", "DisplaySetScrollingGroup", "2-n"}
-	tagDict[Tag{0x0072, 0x0214}] = Info{Tag{0x0072, 0x0214}, "SQ", "NavigationIndicatorSequence", "1"}
-	tagDict[Tag{0x0072, 0x0216}] = Info{Tag{0x0072, 0x0216}, "US", "NavigationDisplaySet", "1"}
-	tagDict[Tag{0x0072, 0x0218}] = Info{Tag{0x0072, 0x0218}, "US", "ReferenceDisplaySets", "1-n"}
-	tagDict[Tag{0x0072, 0x0300}] = Info{Tag{0x0072, 0x0300}, "SQ", "ImageBoxesSequence", "1"}
-	tagDict[Tag{0x0072, 0x0302}] = Info{Tag{0x0072, 0x0302}, "US", "ImageBoxNumber", "1"}
-	tagDict[Tag{0x0072, 0x0304}] = Info{Tag{0x0072, 0x0304}, "CS", "ImageBoxLayoutType", "1"}
-	tagDict[Tag{0x0072, 0x0306}] = Info{Tag{0x0072, 0x0306}, "US", "ImageBoxTileHorizontalDimension", "1"}
-	tagDict[Tag{0x0072, 0x0308}] = Info{Tag{0x0072, 0x0308}, "US", "ImageBoxTileVerticalDimension", "1"}
-	tagDict[Tag{0x0072, 0x0310}] = Info{Tag{0x0072, 0x0310}, "CS", "ImageBoxScrollDirection", "1"}
-	tagDict[Tag{0x0072, 0x0312}] = Info{Tag{0x0072, 0x0312}, "CS", "ImageBoxSmallScrollType", "1"}
-	tagDict[Tag{0x0072, 0x0314}] = Info{Tag{0x0072, 0x0314}, "US", "ImageBoxSmallScrollAmount", "1"}
-	tagDict[Tag{0x0072, 0x0316}] = Info{Tag{0x0072, 0x0316}, "CS", "ImageBoxLargeScrollType", "1"}
-	tagDict[Tag{0x0072, 0x0318}] = Info{Tag{0x0072, 0x0318}, "US", "ImageBoxLargeScrollAmount", "1"}
-	tagDict[Tag{0x0072, 0x0320}] = Info{Tag{0x0072, 0x0320}, "US", "ImageBoxOverlapPriority", "1"}
-	tagDict[Tag{0x0072, 0x0330}] = Info{Tag{0x0072, 0x0330}, "FD", "CineRelativeToRealTime", "1"}
-	tagDict[Tag{0x0072, 0x0400}] = Info{Tag{0x0072, 0x0400}, "SQ", "FilterOperationsSequence", "1"}
-	tagDict[Tag{0x0072, 0x0402}] = Info{Tag{0x0072, 0x0402}, "CS", "FilterByCategory", "1"}
-	tagDict[Tag{0x0072, 0x0404}] = Info{Tag{0x0072, 0x0404}, "CS", "FilterByAttributePresence", "1"}
-	tagDict[Tag{0x0072, 0x0406}] = Info{Tag{0x0072, 0x0406}, "CS", "FilterByOperator", "1"}
-	tagDict[Tag{0x0072, 0x0420}] = Info{Tag{0x0072, 0x0420}, "US", "StructuredDisplayBackgroundCIELabValue", "3"}
-	tagDict[Tag{0x0072, 0x0421}] = Info{Tag{0x0072, 0x0421}, "US", "EmptyImageBoxCIELabValue", "3"}
-	tagDict[Tag{0x0072, 0x0422}] = Info{Tag{0x0072, 0x0422}, "SQ", "StructuredDisplayImageBoxSequence", "1"}
-	tagDict[Tag{0x0072, 0x0424}] = Info{Tag{0x0072, 0x0424}, "SQ", "StructuredDisplayTextBoxSequence", "1"}
-	tagDict[Tag{0x0072, 0x0427}] = Info{Tag{0x0072, 0x0427}, "SQ", "ReferencedFirstFrameSequence", "1"}
-	tagDict[Tag{0x0072, 0x0430}] = Info{Tag{0x0072, 0x0430}, "SQ", "ImageBoxSynchronizationSequence", "1"}
-	tagDict[Tag{0x0072, 0x0432}] = Info{Tag{0x0072, 0x0432}, "US", "SynchronizedImageBoxList", "2-n"}
-	tagDict[Tag{0x0072, 0x0434}] = Info{Tag{0x0072, 0x0434}, "CS", "TypeOfSynchronization", "1"}
-	tagDict[Tag{0x0072, 0x0500}] = Info{Tag{0x0072, 0x0500}, "CS", "BlendingOperationType", "1"}
-	tagDict[Tag{0x0072, 0x0510}] = Info{Tag{0x0072, 0x0510}, "CS", "ReformattingOperationType", "1"}
-	tagDict[Tag{0x0072, 0x0512}] = Info{Tag{0x0072, 0x0512}, "FD", "ReformattingThickness", "1"}
-	tagDict[Tag{0x0072, 0x0514}] = Info{Tag{0x0072, 0x0514}, "FD", "ReformattingInterval", "1"}
-	tagDict[Tag{0x0072, 0x0516}] = Info{Tag{0x0072, 0x0516}, "CS", "ReformattingOperationInitialViewDirection", "1"}
-	tagDict[Tag{0x0072, 0x0520}] = Info{Tag{0x0072, 0x0520}, "CS", "ThreeDRenderingType", "1-n"}
-	tagDict[Tag{0x0072, 0x0600}] = Info{Tag{0x0072, 0x0600}, "SQ", "SortingOperationsSequence", "1"}
-	tagDict[Tag{0x0072, 0x0602}] = Info{Tag{0x0072, 0x0602}, "CS", "SortByCategory", "1"}
-	tagDict[Tag{0x0072, 0x0604}] = Info{Tag{0x0072, 0x0604}, "CS", "SortingDirection", "1"}
-	tagDict[Tag{0x0072, 0x0700}] = Info{Tag{0x0072, 0x0700}, "CS", "DisplaySetPatientOrientation", "2"}
-	tagDict[Tag{0x0072, 0x0702}] = Info{Tag{0x0072, 0x0702}, "CS", "VOIType", "1"}
-	tagDict[Tag{0x0072, 0x0704}] = Info{Tag{0x0072, 0x0704}, "CS", "PseudoColorType", "1"}
-	tagDict[Tag{0x0072, 0x0705}] = Info{Tag{0x0072, 0x0705}, "SQ", "PseudoColorPaletteInstanceReferenceSequence", "1"}
-	tagDict[Tag{0x0072, 0x0706}] = Info{Tag{0x0072, 0x0706}, "CS", "ShowGrayscaleInverted", "1"}
-	tagDict[Tag{0x0072, 0x0710}] = Info{Tag{0x0072, 0x0710}, "CS", "ShowImageTrueSizeFlag", "1"}
-	tagDict[Tag{0x0072, 0x0712}] = Info{Tag{0x0072, 0x0712}, "CS", "ShowGraphicAnnotationFlag", "1"}
-	tagDict[Tag{0x0072, 0x0714}] = Info{Tag{0x0072, 0x0714}, "CS", "ShowPatientDemographicsFlag", "1"}
-	tagDict[Tag{0x0072, 0x0716}] = Info{Tag{0x0072, 0x0716}, "CS", "ShowAcquisitionTechniquesFlag", "1"}
-	tagDict[Tag{0x0072, 0x0717}] = Info{Tag{0x0072, 0x0717}, "CS", "DisplaySetHorizontalJustification", "1"}
-	tagDict[Tag{0x0072, 0x0718}] = Info{Tag{0x0072, 0x0718}, "CS", "DisplaySetVerticalJustification", "1"}
-	tagDict[Tag{0x0074, 0x0120}] = Info{Tag{0x0074, 0x0120}, "FD", "ContinuationStartMeterset", "1"}
-	tagDict[Tag{0x0074, 0x0121}] = Info{Tag{0x0074, 0x0121}, "FD", "ContinuationEndMeterset", "1"}
-	tagDict[Tag{0x0074, 0x1000}] = Info{Tag{0x0074, 0x1000}, "CS", "ProcedureStepState", "1"}
-	tagDict[Tag{0x0074, 0x1002}] = Info{Tag{0x0074, 0x1002}, "SQ", "ProcedureStepProgressInformationSequence", "1"}
-	tagDict[Tag{0x0074, 0x1004}] = Info{Tag{0x0074, 0x1004}, "DS", "ProcedureStepProgress", "1"}
-	tagDict[Tag{0x0074, 0x1006}] = Info{Tag{0x0074, 0x1006}, "ST", "ProcedureStepProgressDescription", "1"}
-	tagDict[Tag{0x0074, 0x1008}] = Info{Tag{0x0074, 0x1008}, "SQ", "ProcedureStepCommunicationsURISequence", "1"}
-	tagDict[Tag{0x0074, 0x100A}] = Info{Tag{0x0074, 0x100A}, "ST", "ContactURI", "1"}
-	tagDict[Tag{0x0074, 0x100C}] = Info{Tag{0x0074, 0x100C}, "LO", "ContactDisplayName", "1"}
-	tagDict[Tag{0x0074, 0x100E}] = Info{Tag{0x0074, 0x100E}, "SQ", "ProcedureStepDiscontinuationReasonCodeSequence", "1"}
-	tagDict[Tag{0x0074, 0x1020}] = Info{Tag{0x0074, 0x1020}, "SQ", "BeamTaskSequence", "1"}
-	tagDict[Tag{0x0074, 0x1022}] = Info{Tag{0x0074, 0x1022}, "CS", "BeamTaskType", "1"}
-	tagDict[Tag{0x0074, 0x1026}] = Info{Tag{0x0074, 0x1026}, "FD", "TableTopVerticalAdjustedPosition", "1"}
-	tagDict[Tag{0x0074, 0x1027}] = Info{Tag{0x0074, 0x1027}, "FD", "TableTopLongitudinalAdjustedPosition", "1"}
-	tagDict[Tag{0x0074, 0x1028}] = Info{Tag{0x0074, 0x1028}, "FD", "TableTopLateralAdjustedPosition", "1"}
-	tagDict[Tag{0x0074, 0x102A}] = Info{Tag{0x0074, 0x102A}, "FD", "PatientSupportAdjustedAngle", "1"}
-	tagDict[Tag{0x0074, 0x102B}] = Info{Tag{0x0074, 0x102B}, "FD", "TableTopEccentricAdjustedAngle", "1"}
-	tagDict[Tag{0x0074, 0x102C}] = Info{Tag{0x0074, 0x102C}, "FD", "TableTopPitchAdjustedAngle", "1"}
-	tagDict[Tag{0x0074, 0x102D}] = Info{Tag{0x0074, 0x102D}, "FD", "TableTopRollAdjustedAngle", "1"}
-	tagDict[Tag{0x0074, 0x1030}] = Info{Tag{0x0074, 0x1030}, "SQ", "DeliveryVerificationImageSequence", "1"}
-	tagDict[Tag{0x0074, 0x1032}] = Info{Tag{0x0074, 0x1032}, "CS", "VerificationImageTiming", "1"}
-	tagDict[Tag{0x0074, 0x1034}] = Info{Tag{0x0074, 0x1034}, "CS", "DoubleExposureFlag", "1"}
-	tagDict[Tag{0x0074, 0x1036}] = Info{Tag{0x0074, 0x1036}, "CS", "DoubleExposureOrdering", "1"}
-	tagDict[Tag{0x0074, 0x1040}] = Info{Tag{0x0074, 0x1040}, "SQ", "RelatedReferenceRTImageSequence", "1"}
-	tagDict[Tag{0x0074, 0x1042}] = Info{Tag{0x0074, 0x1042}, "SQ", "GeneralMachineVerificationSequence", "1"}
-	tagDict[Tag{0x0074, 0x1044}] = Info{Tag{0x0074, 0x1044}, "SQ", "ConventionalMachineVerificationSequence", "1"}
-	tagDict[Tag{0x0074, 0x1046}] = Info{Tag{0x0074, 0x1046}, "SQ", "IonMachineVerificationSequence", "1"}
-	tagDict[Tag{0x0074, 0x1048}] = Info{Tag{0x0074, 0x1048}, "SQ", "FailedAttributesSequence", "1"}
-	tagDict[Tag{0x0074, 0x104A}] = Info{Tag{0x0074, 0x104A}, "SQ", "OverriddenAttributesSequence", "1"}
-	tagDict[Tag{0x0074, 0x104C}] = Info{Tag{0x0074, 0x104C}, "SQ", "ConventionalControlPointVerificationSequence", "1"}
-	tagDict[Tag{0x0074, 0x104E}] = Info{Tag{0x0074, 0x104E}, "SQ", "IonControlPointVerificationSequence", "1"}
-	tagDict[Tag{0x0074, 0x1050}] = Info{Tag{0x0074, 0x1050}, "SQ", "AttributeOccurrenceSequence", "1"}
-	tagDict[Tag{0x0074, 0x1052}] = Info{Tag{0x0074, 0x1052}, "AT", "AttributeOccurrencePointer", "1"}
-	tagDict[Tag{0x0074, 0x1054}] = Info{Tag{0x0074, 0x1054}, "UL", "AttributeItemSelector", "1"}
-	tagDict[Tag{0x0074, 0x1056}] = Info{Tag{0x0074, 0x1056}, "LO", "AttributeOccurrencePrivateCreator", "1"}
-	tagDict[Tag{0x0074, 0x1057}] = Info{Tag{0x0074, 0x1057}, "IS", "SelectorSequencePointerItems", "1-n"}
-	tagDict[Tag{0x0074, 0x1200}] = Info{Tag{0x0074, 0x1200}, "CS", "ScheduledProcedureStepPriority", "1"}
-	tagDict[Tag{0x0074, 0x1202}] = Info{Tag{0x0074, 0x1202}, "LO", "WorklistLabel", "1"}
-	tagDict[Tag{0x0074, 0x1204}] = Info{Tag{0x0074, 0x1204}, "LO", "ProcedureStepLabel", "1"}
-	tagDict[Tag{0x0074, 0x1210}] = Info{Tag{0x0074, 0x1210}, "SQ", "ScheduledProcessingParametersSequence", "1"}
-	tagDict[Tag{0x0074, 0x1212}] = Info{Tag{0x0074, 0x1212}, "SQ", "PerformedProcessingParametersSequence", "1"}
-	tagDict[Tag{0x0074, 0x1216}] = Info{Tag{0x0074, 0x1216}, "SQ", "UnifiedProcedureStepPerformedProcedureSequence", "1"}
-	tagDict[Tag{0x0074, 0x1224}] = Info{Tag{0x0074, 0x1224}, "SQ", "ReplacedProcedureStepSequence", "1"}
-	tagDict[Tag{0x0074, 0x1230}] = Info{Tag{0x0074, 0x1230}, "LO", "DeletionLock", "1"}
-	tagDict[Tag{0x0074, 0x1234}] = Info{Tag{0x0074, 0x1234}, "AE", "ReceivingAE", "1"}
-	tagDict[Tag{0x0074, 0x1236}] = Info{Tag{0x0074, 0x1236}, "AE", "RequestingAE", "1"}
-	tagDict[Tag{0x0074, 0x1238}] = Info{Tag{0x0074, 0x1238}, "LT", "ReasonForCancellation", "1"}
-	tagDict[Tag{0x0074, 0x1242}] = Info{Tag{0x0074, 0x1242}, "CS", "SCPStatus", "1"}
-	tagDict[Tag{0x0074, 0x1244}] = Info{Tag{0x0074, 0x1244}, "CS", "SubscriptionListStatus", "1"}
-	tagDict[Tag{0x0074, 0x1246}] = Info{Tag{0x0074, 0x1246}, "CS", "UnifiedProcedureStepListStatus", "1"}
-	tagDict[Tag{0x0074, 0x1324}] = Info{Tag{0x0074, 0x1324}, "UL", "BeamOrderIndex", "1"}
-	tagDict[Tag{0x0074, 0x1338}] = Info{Tag{0x0074, 0x1338}, "FD", "DoubleExposureMeterset", "1"}
-	tagDict[Tag{0x0074, 0x133A}] = Info{Tag{0x0074, 0x133A}, "FD", "DoubleExposureFieldDelta", "4"}
-	tagDict[Tag{0x0076, 0x0001}] = Info{Tag{0x0076, 0x0001}, "LO", "ImplantAssemblyTemplateName", "1"}
-	tagDict[Tag{0x0076, 0x0003}] = Info{Tag{0x0076, 0x0003}, "LO", "ImplantAssemblyTemplateIssuer", "1"}
-	tagDict[Tag{0x0076, 0x0006}] = Info{Tag{0x0076, 0x0006}, "LO", "ImplantAssemblyTemplateVersion", "1"}
-	tagDict[Tag{0x0076, 0x0008}] = Info{Tag{0x0076, 0x0008}, "SQ", "ReplacedImplantAssemblyTemplateSequence", "1"}
-	tagDict[Tag{0x0076, 0x000A}] = Info{Tag{0x0076, 0x000A}, "CS", "ImplantAssemblyTemplateType", "1"}
-	tagDict[Tag{0x0076, 0x000C}] = Info{Tag{0x0076, 0x000C}, "SQ", "OriginalImplantAssemblyTemplateSequence", "1"}
-	tagDict[Tag{0x0076, 0x000E}] = Info{Tag{0x0076, 0x000E}, "SQ", "DerivationImplantAssemblyTemplateSequence", "1"}
-	tagDict[Tag{0x0076, 0x0010}] = Info{Tag{0x0076, 0x0010}, "SQ", "ImplantAssemblyTemplateTargetAnatomySequence", "1"}
-	tagDict[Tag{0x0076, 0x0020}] = Info{Tag{0x0076, 0x0020}, "SQ", "ProcedureTypeCodeSequence", "1"}
-	tagDict[Tag{0x0076, 0x0030}] = Info{Tag{0x0076, 0x0030}, "LO", "SurgicalTechnique", "1"}
-	tagDict[Tag{0x0076, 0x0032}] = Info{Tag{0x0076, 0x0032}, "SQ", "ComponentTypesSequence", "1"}
-	tagDict[Tag{0x0076, 0x0034}] = Info{Tag{0x0076, 0x0034}, "CS", "ComponentTypeCodeSequence", "1"}
-	tagDict[Tag{0x0076, 0x0036}] = Info{Tag{0x0076, 0x0036}, "CS", "ExclusiveComponentType", "1"}
-	tagDict[Tag{0x0076, 0x0038}] = Info{Tag{0x0076, 0x0038}, "CS", "MandatoryComponentType", "1"}
-	tagDict[Tag{0x0076, 0x0040}] = Info{Tag{0x0076, 0x0040}, "SQ", "ComponentSequence", "1"}
-	tagDict[Tag{0x0076, 0x0055}] = Info{Tag{0x0076, 0x0055}, "US", "ComponentID", "1"}
-	tagDict[Tag{0x0076, 0x0060}] = Info{Tag{0x0076, 0x0060}, "SQ", "ComponentAssemblySequence", "1"}
-	tagDict[Tag{0x0076, 0x0070}] = Info{Tag{0x0076, 0x0070}, "US", "Component1ReferencedID", "1"}
-	tagDict[Tag{0x0076, 0x0080}] = Info{Tag{0x0076, 0x0080}, "US", "Component1ReferencedMatingFeatureSetID", "1"}
-	tagDict[Tag{0x0076, 0x0090}] = Info{Tag{0x0076, 0x0090}, "US", "Component1ReferencedMatingFeatureID", "1"}
-	tagDict[Tag{0x0076, 0x00A0}] = Info{Tag{0x0076, 0x00A0}, "US", "Component2ReferencedID", "1"}
-	tagDict[Tag{0x0076, 0x00B0}] = Info{Tag{0x0076, 0x00B0}, "US", "Component2ReferencedMatingFeatureSetID", "1"}
-	tagDict[Tag{0x0076, 0x00C0}] = Info{Tag{0x0076, 0x00C0}, "US", "Component2ReferencedMatingFeatureID", "1"}
-	tagDict[Tag{0x0078, 0x0001}] = Info{Tag{0x0078, 0x0001}, "LO", "ImplantTemplateGroupName", "1"}
-	tagDict[Tag{0x0078, 0x0010}] = Info{Tag{0x0078, 0x0010}, "ST", "ImplantTemplateGroupDescription", "1"}
-	tagDict[Tag{0x0078, 0x0020}] = Info{Tag{0x0078, 0x0020}, "LO", "ImplantTemplateGroupIssuer", "1"}
-	tagDict[Tag{0x0078, 0x0024}] = Info{Tag{0x0078, 0x0024}, "LO", "ImplantTemplateGroupVersion", "1"}
-	tagDict[Tag{0x0078, 0x0026}] = Info{Tag{0x0078, 0x0026}, "SQ", "ReplacedImplantTemplateGroupSequence", "1"}
-	tagDict[Tag{0x0078, 0x0028}] = Info{Tag{0x0078, 0x0028}, "SQ", "ImplantTemplateGroupTargetAnatomySequence", "1"}
-	tagDict[Tag{0x0078, 0x002A}] = Info{Tag{0x0078, 0x002A}, "SQ", "ImplantTemplateGroupMembersSequence", "1"}
-	tagDict[Tag{0x0078, 0x002E}] = Info{Tag{0x0078, 0x002E}, "US", "ImplantTemplateGroupMemberID", "1"}
-	tagDict[Tag{0x0078, 0x0050}] = Info{Tag{0x0078, 0x0050}, "FD", "ThreeDImplantTemplateGroupMemberMatchingPoint", "3"}
-	tagDict[Tag{0x0078, 0x0060}] = Info{Tag{0x0078, 0x0060}, "FD", "ThreeDImplantTemplateGroupMemberMatchingAxes", "9"}
-	tagDict[Tag{0x0078, 0x0070}] = Info{Tag{0x0078, 0x0070}, "SQ", "ImplantTemplateGroupMemberMatching2DCoordinatesSequence", "1"}
-	tagDict[Tag{0x0078, 0x0090}] = Info{Tag{0x0078, 0x0090}, "FD", "TwoDImplantTemplateGroupMemberMatchingPoint", "2"}
-	tagDict[Tag{0x0078, 0x00A0}] = Info{Tag{0x0078, 0x00A0}, "FD", "TwoDImplantTemplateGroupMemberMatchingAxes", "4"}
-	tagDict[Tag{0x0078, 0x00B0}] = Info{Tag{0x0078, 0x00B0}, "SQ", "ImplantTemplateGroupVariationDimensionSequence", "1"}
-	tagDict[Tag{0x0078, 0x00B2}] = Info{Tag{0x0078, 0x00B2}, "LO", "ImplantTemplateGroupVariationDimensionName", "1"}
-	tagDict[Tag{0x0078, 0x00B4}] = Info{Tag{0x0078, 0x00B4}, "SQ", "ImplantTemplateGroupVariationDimensionRankSequence", "1"}
-	tagDict[Tag{0x0078, 0x00B6}] = Info{Tag{0x0078, 0x00B6}, "US", "ReferencedImplantTemplateGroupMemberID", "1"}
-	tagDict[Tag{0x0078, 0x00B8}] = Info{Tag{0x0078, 0x00B8}, "US", "ImplantTemplateGroupVariationDimensionRank", "1"}
-	tagDict[Tag{0x0088, 0x0130}] = Info{Tag{0x0088, 0x0130}, "SH", "StorageMediaFileSetID", "1"}
-	tagDict[Tag{0x0088, 0x0140}] = Info{Tag{0x0088, 0x0140}, "UI", "StorageMediaFileSetUID", "1"}
-	tagDict[Tag{0x0088, 0x0200}] = Info{Tag{0x0088, 0x0200}, "SQ", "IconImageSequence", "1"}
-	tagDict[Tag{0x0100, 0x0410}] = Info{Tag{0x0100, 0x0410}, "CS", "SOPInstanceStatus", "1"}
-	tagDict[Tag{0x0100, 0x0420}] = Info{Tag{0x0100, 0x0420}, "DT", "SOPAuthorizationDateTime", "1"}
-	tagDict[Tag{0x0100, 0x0424}] = Info{Tag{0x0100, 0x0424}, "LT", "SOPAuthorizationComment", "1"}
-	tagDict[Tag{0x0100, 0x0426}] = Info{Tag{0x0100, 0x0426}, "LO", "AuthorizationEquipmentCertificationNumber", "1"}
-	tagDict[Tag{0x0400, 0x0005}] = Info{Tag{0x0400, 0x0005}, "US", "MACIDNumber", "1"}
-	tagDict[Tag{0x0400, 0x0010}] = Info{Tag{0x0400, 0x0010}, "UI", "MACCalculationTransferSyntaxUID", "1"}
-	tagDict[Tag{0x0400, 0x0015}] = Info{Tag{0x0400, 0x0015}, "CS", "MACAlgorithm", "1"}
-	tagDict[Tag{0x0400, 0x0020}] = Info{Tag{0x0400, 0x0020}, "AT", "DataElementsSigned", "1-n"}
-	tagDict[Tag{0x0400, 0x0100}] = Info{Tag{0x0400, 0x0100}, "UI", "DigitalSignatureUID", "1"}
-	tagDict[Tag{0x0400, 0x0105}] = Info{Tag{0x0400, 0x0105}, "DT", "DigitalSignatureDateTime", "1"}
-	tagDict[Tag{0x0400, 0x0110}] = Info{Tag{0x0400, 0x0110}, "CS", "CertificateType", "1"}
-	tagDict[Tag{0x0400, 0x0115}] = Info{Tag{0x0400, 0x0115}, "OB", "CertificateOfSigner", "1"}
-	tagDict[Tag{0x0400, 0x0120}] = Info{Tag{0x0400, 0x0120}, "OB", "Signature", "1"}
-	tagDict[Tag{0x0400, 0x0305}] = Info{Tag{0x0400, 0x0305}, "CS", "CertifiedTimestampType", "1"}
-	tagDict[Tag{0x0400, 0x0310}] = Info{Tag{0x0400, 0x0310}, "OB", "CertifiedTimestamp", "1"}
-	tagDict[Tag{0x0400, 0x0401}] = Info{Tag{0x0400, 0x0401}, "SQ", "DigitalSignaturePurposeCodeSequence", "1"}
-	tagDict[Tag{0x0400, 0x0402}] = Info{Tag{0x0400, 0x0402}, "SQ", "ReferencedDigitalSignatureSequence", "1"}
-	tagDict[Tag{0x0400, 0x0403}] = Info{Tag{0x0400, 0x0403}, "SQ", "ReferencedSOPInstanceMACSequence", "1"}
-	tagDict[Tag{0x0400, 0x0404}] = Info{Tag{0x0400, 0x0404}, "OB", "MAC", "1"}
-	tagDict[Tag{0x0400, 0x0500}] = Info{Tag{0x0400, 0x0500}, "SQ", "EncryptedAttributesSequence", "1"}
-	tagDict[Tag{0x0400, 0x0510}] = Info{Tag{0x0400, 0x0510}, "UI", "EncryptedContentTransferSyntaxUID", "1"}
-	tagDict[Tag{0x0400, 0x0520}] = Info{Tag{0x0400, 0x0520}, "OB", "EncryptedContent", "1"}
-	tagDict[Tag{0x0400, 0x0550}] = Info{Tag{0x0400, 0x0550}, "SQ", "ModifiedAttributesSequence", "1"}
-	tagDict[Tag{0x0400, 0x0561}] = Info{Tag{0x0400, 0x0561}, "SQ", "OriginalAttributesSequence", "1"}
-	tagDict[Tag{0x0400, 0x0562}] = Info{Tag{0x0400, 0x0562}, "DT", "AttributeModificationDateTime", "1"}
-	tagDict[Tag{0x0400, 0x0563}] = Info{Tag{0x0400, 0x0563}, "LO", "ModifyingSystem", "1"}
-	tagDict[Tag{0x0400, 0x0564}] = Info{Tag{0x0400, 0x0564}, "LO", "SourceOfPreviousValues", "1"}
-	tagDict[Tag{0x0400, 0x0565}] = Info{Tag{0x0400, 0x0565}, "CS", "ReasonForTheAttributeModification", "1"}
-	tagDict[Tag{0x2000, 0x0010}] = Info{Tag{0x2000, 0x0010}, "IS", "NumberOfCopies", "1"}
-	tagDict[Tag{0x2000, 0x001E}] = Info{Tag{0x2000, 0x001E}, "SQ", "PrinterConfigurationSequence", "1"}
-	tagDict[Tag{0x2000, 0x0020}] = Info{Tag{0x2000, 0x0020}, "CS", "PrintPriority", "1"}
-	tagDict[Tag{0x2000, 0x0030}] = Info{Tag{0x2000, 0x0030}, "CS", "MediumType", "1"}
-	tagDict[Tag{0x2000, 0x0040}] = Info{Tag{0x2000, 0x0040}, "CS", "FilmDestination", "1"}
-	tagDict[Tag{0x2000, 0x0050}] = Info{Tag{0x2000, 0x0050}, "LO", "FilmSessionLabel", "1"}
-	tagDict[Tag{0x2000, 0x0060}] = Info{Tag{0x2000, 0x0060}, "IS", "MemoryAllocation", "1"}
-	tagDict[Tag{0x2000, 0x0061}] = Info{Tag{0x2000, 0x0061}, "IS", "MaximumMemoryAllocation", "1"}
-	tagDict[Tag{0x2000, 0x00A0}] = Info{Tag{0x2000, 0x00A0}, "US", "MemoryBitDepth", "1"}
-	tagDict[Tag{0x2000, 0x00A1}] = Info{Tag{0x2000, 0x00A1}, "US", "PrintingBitDepth", "1"}
-	tagDict[Tag{0x2000, 0x00A2}] = Info{Tag{0x2000, 0x00A2}, "SQ", "MediaInstalledSequence", "1"}
-	tagDict[Tag{0x2000, 0x00A4}] = Info{Tag{0x2000, 0x00A4}, "SQ", "OtherMediaAvailableSequence", "1"}
-	tagDict[Tag{0x2000, 0x00A8}] = Info{Tag{0x2000, 0x00A8}, "SQ", "SupportedImageDisplayFormatsSequence", "1"}
-	tagDict[Tag{0x2000, 0x0500}] = Info{Tag{0x2000, 0x0500}, "SQ", "ReferencedFilmBoxSequence", "1"}
-	tagDict[Tag{0x2010, 0x0010}] = Info{Tag{0x2010, 0x0010}, "ST", "ImageDisplayFormat", "1"}
-	tagDict[Tag{0x2010, 0x0030}] = Info{Tag{0x2010, 0x0030}, "CS", "AnnotationDisplayFormatID", "1"}
-	tagDict[Tag{0x2010, 0x0040}] = Info{Tag{0x2010, 0x0040}, "CS", "FilmOrientation", "1"}
-	tagDict[Tag{0x2010, 0x0050}] = Info{Tag{0x2010, 0x0050}, "CS", "FilmSizeID", "1"}
-	tagDict[Tag{0x2010, 0x0052}] = Info{Tag{0x2010, 0x0052}, "CS", "PrinterResolutionID", "1"}
-	tagDict[Tag{0x2010, 0x0054}] = Info{Tag{0x2010, 0x0054}, "CS", "DefaultPrinterResolutionID", "1"}
-	tagDict[Tag{0x2010, 0x0060}] = Info{Tag{0x2010, 0x0060}, "CS", "MagnificationType", "1"}
-	tagDict[Tag{0x2010, 0x0080}] = Info{Tag{0x2010, 0x0080}, "CS", "SmoothingType", "1"}
-	tagDict[Tag{0x2010, 0x00A6}] = Info{Tag{0x2010, 0x00A6}, "CS", "DefaultMagnificationType", "1"}
-	tagDict[Tag{0x2010, 0x00A7}] = Info{Tag{0x2010, 0x00A7}, "CS", "OtherMagnificationTypesAvailable", "1-n"}
-	tagDict[Tag{0x2010, 0x00A8}] = Info{Tag{0x2010, 0x00A8}, "CS", "DefaultSmoothingType", "1"}
-	tagDict[Tag{0x2010, 0x00A9}] = Info{Tag{0x2010, 0x00A9}, "CS", "OtherSmoothingTypesAvailable", "1-n"}
-	tagDict[Tag{0x2010, 0x0100}] = Info{Tag{0x2010, 0x0100}, "CS", "BorderDensity", "1"}
-	tagDict[Tag{0x2010, 0x0110}] = Info{Tag{0x2010, 0x0110}, "CS", "EmptyImageDensity", "1"}
-	tagDict[Tag{0x2010, 0x0120}] = Info{Tag{0x2010, 0x0120}, "US", "MinDensity", "1"}
-	tagDict[Tag{0x2010, 0x0130}] = Info{Tag{0x2010, 0x0130}, "US", "MaxDensity", "1"}
-	tagDict[Tag{0x2010, 0x0140}] = Info{Tag{0x2010, 0x0140}, "CS", "Trim", "1"}
-	tagDict[Tag{0x2010, 0x0150}] = Info{Tag{0x2010, 0x0150}, "ST", "ConfigurationInformation", "1"}
-	tagDict[Tag{0x2010, 0x0152}] = Info{Tag{0x2010, 0x0152}, "LT", "ConfigurationInformationDescription", "1"}
-	tagDict[Tag{0x2010, 0x0154}] = Info{Tag{0x2010, 0x0154}, "IS", "MaximumCollatedFilms", "1"}
-	tagDict[Tag{0x2010, 0x015E}] = Info{Tag{0x2010, 0x015E}, "US", "Illumination", "1"}
-	tagDict[Tag{0x2010, 0x0160}] = Info{Tag{0x2010, 0x0160}, "US", "ReflectedAmbientLight", "1"}
-	tagDict[Tag{0x2010, 0x0376}] = Info{Tag{0x2010, 0x0376}, "DS", "PrinterPixelSpacing", "2"}
-	tagDict[Tag{0x2010, 0x0500}] = Info{Tag{0x2010, 0x0500}, "SQ", "ReferencedFilmSessionSequence", "1"}
-	tagDict[Tag{0x2010, 0x0510}] = Info{Tag{0x2010, 0x0510}, "SQ", "ReferencedImageBoxSequence", "1"}
-	tagDict[Tag{0x2010, 0x0520}] = Info{Tag{0x2010, 0x0520}, "SQ", "ReferencedBasicAnnotationBoxSequence", "1"}
-	tagDict[Tag{0x2020, 0x0010}] = Info{Tag{0x2020, 0x0010}, "US", "ImageBoxPosition", "1"}
-	tagDict[Tag{0x2020, 0x0020}] = Info{Tag{0x2020, 0x0020}, "CS", "Polarity", "1"}
-	tagDict[Tag{0x2020, 0x0030}] = Info{Tag{0x2020, 0x0030}, "DS", "RequestedImageSize", "1"}
-	tagDict[Tag{0x2020, 0x0040}] = Info{Tag{0x2020, 0x0040}, "CS", "RequestedDecimateCropBehavior", "1"}
-	tagDict[Tag{0x2020, 0x0050}] = Info{Tag{0x2020, 0x0050}, "CS", "RequestedResolutionID", "1"}
-	tagDict[Tag{0x2020, 0x00A0}] = Info{Tag{0x2020, 0x00A0}, "CS", "RequestedImageSizeFlag", "1"}
-	tagDict[Tag{0x2020, 0x00A2}] = Info{Tag{0x2020, 0x00A2}, "CS", "DecimateCropResult", "1"}
-	tagDict[Tag{0x2020, 0x0110}] = Info{Tag{0x2020, 0x0110}, "SQ", "BasicGrayscaleImageSequence", "1"}
-	tagDict[Tag{0x2020, 0x0111}] = Info{Tag{0x2020, 0x0111}, "SQ", "BasicColorImageSequence", "1"}
-	tagDict[Tag{0x2030, 0x0010}] = Info{Tag{0x2030, 0x0010}, "US", "AnnotationPosition", "1"}
-	tagDict[Tag{0x2030, 0x0020}] = Info{Tag{0x2030, 0x0020}, "LO", "TextString", "1"}
-	tagDict[Tag{0x2050, 0x0010}] = Info{Tag{0x2050, 0x0010}, "SQ", "PresentationLUTSequence", "1"}
-	tagDict[Tag{0x2050, 0x0020}] = Info{Tag{0x2050, 0x0020}, "CS", "PresentationLUTShape", "1"}
-	tagDict[Tag{0x2050, 0x0500}] = Info{Tag{0x2050, 0x0500}, "SQ", "ReferencedPresentationLUTSequence", "1"}
-	tagDict[Tag{0x2100, 0x0020}] = Info{Tag{0x2100, 0x0020}, "CS", "ExecutionStatus", "1"}
-	tagDict[Tag{0x2100, 0x0030}] = Info{Tag{0x2100, 0x0030}, "CS", "ExecutionStatusInfo", "1"}
-	tagDict[Tag{0x2100, 0x0040}] = Info{Tag{0x2100, 0x0040}, "DA", "CreationDate", "1"}
-	tagDict[Tag{0x2100, 0x0050}] = Info{Tag{0x2100, 0x0050}, "TM", "CreationTime", "1"}
-	tagDict[Tag{0x2100, 0x0070}] = Info{Tag{0x2100, 0x0070}, "AE", "Originator", "1"}
-	tagDict[Tag{0x2100, 0x0160}] = Info{Tag{0x2100, 0x0160}, "SH", "OwnerID", "1"}
-	tagDict[Tag{0x2100, 0x0170}] = Info{Tag{0x2100, 0x0170}, "IS", "NumberOfFilms", "1"}
-	tagDict[Tag{0x2110, 0x0010}] = Info{Tag{0x2110, 0x0010}, "CS", "PrinterStatus", "1"}
-	tagDict[Tag{0x2110, 0x0020}] = Info{Tag{0x2110, 0x0020}, "CS", "PrinterStatusInfo", "1"}
-	tagDict[Tag{0x2110, 0x0030}] = Info{Tag{0x2110, 0x0030}, "LO", "PrinterName", "1"}
-	tagDict[Tag{0x2200, 0x0001}] = Info{Tag{0x2200, 0x0001}, "CS", "LabelUsingInformationExtractedFromInstances", "1"}
-	tagDict[Tag{0x2200, 0x0002}] = Info{Tag{0x2200, 0x0002}, "UT", "LabelText", "1"}
-	tagDict[Tag{0x2200, 0x0003}] = Info{Tag{0x2200, 0x0003}, "CS", "LabelStyleSelection", "1"}
-	tagDict[Tag{0x2200, 0x0004}] = Info{Tag{0x2200, 0x0004}, "LT", "MediaDisposition", "1"}
-	tagDict[Tag{0x2200, 0x0005}] = Info{Tag{0x2200, 0x0005}, "LT", "BarcodeValue", "1"}
-	tagDict[Tag{0x2200, 0x0006}] = Info{Tag{0x2200, 0x0006}, "CS", "BarcodeSymbology", "1"}
-	tagDict[Tag{0x2200, 0x0007}] = Info{Tag{0x2200, 0x0007}, "CS", "AllowMediaSplitting", "1"}
-	tagDict[Tag{0x2200, 0x0008}] = Info{Tag{0x2200, 0x0008}, "CS", "IncludeNonDICOMObjects", "1"}
-	tagDict[Tag{0x2200, 0x0009}] = Info{Tag{0x2200, 0x0009}, "CS", "IncludeDisplayApplication", "1"}
-	tagDict[Tag{0x2200, 0x000A}] = Info{Tag{0x2200, 0x000A}, "CS", "PreserveCompositeInstancesAfterMediaCreation", "1"}
-	tagDict[Tag{0x2200, 0x000B}] = Info{Tag{0x2200, 0x000B}, "US", "TotalNumberOfPiecesOfMediaCreated", "1"}
-	tagDict[Tag{0x2200, 0x000C}] = Info{Tag{0x2200, 0x000C}, "LO", "RequestedMediaApplicationProfile", "1"}
-	tagDict[Tag{0x2200, 0x000D}] = Info{Tag{0x2200, 0x000D}, "SQ", "ReferencedStorageMediaSequence", "1"}
-	tagDict[Tag{0x2200, 0x000E}] = Info{Tag{0x2200, 0x000E}, "AT", "FailureAttributes", "1-n"}
-	tagDict[Tag{0x2200, 0x000F}] = Info{Tag{0x2200, 0x000F}, "CS", "AllowLossyCompression", "1"}
-	tagDict[Tag{0x2200, 0x0020}] = Info{Tag{0x2200, 0x0020}, "CS", "RequestPriority", "1"}
-	tagDict[Tag{0x3002, 0x0002}] = Info{Tag{0x3002, 0x0002}, "SH", "RTImageLabel", "1"}
-	tagDict[Tag{0x3002, 0x0003}] = Info{Tag{0x3002, 0x0003}, "LO", "RTImageName", "1"}
-	tagDict[Tag{0x3002, 0x0004}] = Info{Tag{0x3002, 0x0004}, "ST", "RTImageDescription", "1"}
-	tagDict[Tag{0x3002, 0x000A}] = Info{Tag{0x3002, 0x000A}, "CS", "ReportedValuesOrigin", "1"}
-	tagDict[Tag{0x3002, 0x000C}] = Info{Tag{0x3002, 0x000C}, "CS", "RTImagePlane", "1"}
-	tagDict[Tag{0x3002, 0x000D}] = Info{Tag{0x3002, 0x000D}, "DS", "XRayImageReceptorTranslation", "3"}
-	tagDict[Tag{0x3002, 0x000E}] = Info{Tag{0x3002, 0x000E}, "DS", "XRayImageReceptorAngle", "1"}
-	tagDict[Tag{0x3002, 0x0010}] = Info{Tag{0x3002, 0x0010}, "DS", "RTImageOrientation", "6"}
-	tagDict[Tag{0x3002, 0x0011}] = Info{Tag{0x3002, 0x0011}, "DS", "ImagePlanePixelSpacing", "2"}
-	tagDict[Tag{0x3002, 0x0012}] = Info{Tag{0x3002, 0x0012}, "DS", "RTImagePosition", "2"}
-	tagDict[Tag{0x3002, 0x0020}] = Info{Tag{0x3002, 0x0020}, "SH", "RadiationMachineName", "1"}
-	tagDict[Tag{0x3002, 0x0022}] = Info{Tag{0x3002, 0x0022}, "DS", "RadiationMachineSAD", "1"}
-	tagDict[Tag{0x3002, 0x0024}] = Info{Tag{0x3002, 0x0024}, "DS", "RadiationMachineSSD", "1"}
-	tagDict[Tag{0x3002, 0x0026}] = Info{Tag{0x3002, 0x0026}, "DS", "RTImageSID", "1"}
-	tagDict[Tag{0x3002, 0x0028}] = Info{Tag{0x3002, 0x0028}, "DS", "SourceToReferenceObjectDistance", "1"}
-	tagDict[Tag{0x3002, 0x0029}] = Info{Tag{0x3002, 0x0029}, "IS", "FractionNumber", "1"}
-	tagDict[Tag{0x3002, 0x0030}] = Info{Tag{0x3002, 0x0030}, "SQ", "ExposureSequence", "1"}
-	tagDict[Tag{0x3002, 0x0032}] = Info{Tag{0x3002, 0x0032}, "DS", "MetersetExposure", "1"}
-	tagDict[Tag{0x3002, 0x0034}] = Info{Tag{0x3002, 0x0034}, "DS", "DiaphragmPosition", "4"}
-	tagDict[Tag{0x3002, 0x0040}] = Info{Tag{0x3002, 0x0040}, "SQ", "FluenceMapSequence", "1"}
-	tagDict[Tag{0x3002, 0x0041}] = Info{Tag{0x3002, 0x0041}, "CS", "FluenceDataSource", "1"}
-	tagDict[Tag{0x3002, 0x0042}] = Info{Tag{0x3002, 0x0042}, "DS", "FluenceDataScale", "1"}
-	tagDict[Tag{0x3002, 0x0050}] = Info{Tag{0x3002, 0x0050}, "SQ", "PrimaryFluenceModeSequence", "1"}
-	tagDict[Tag{0x3002, 0x0051}] = Info{Tag{0x3002, 0x0051}, "CS", "FluenceMode", "1"}
-	tagDict[Tag{0x3002, 0x0052}] = Info{Tag{0x3002, 0x0052}, "SH", "FluenceModeID", "1"}
-	tagDict[Tag{0x3004, 0x0001}] = Info{Tag{0x3004, 0x0001}, "CS", "DVHType", "1"}
-	tagDict[Tag{0x3004, 0x0002}] = Info{Tag{0x3004, 0x0002}, "CS", "DoseUnits", "1"}
-	tagDict[Tag{0x3004, 0x0004}] = Info{Tag{0x3004, 0x0004}, "CS", "DoseType", "1"}
-	tagDict[Tag{0x3004, 0x0006}] = Info{Tag{0x3004, 0x0006}, "LO", "DoseComment", "1"}
-	tagDict[Tag{0x3004, 0x0008}] = Info{Tag{0x3004, 0x0008}, "DS", "NormalizationPoint", "3"}
-	tagDict[Tag{0x3004, 0x000A}] = Info{Tag{0x3004, 0x000A}, "CS", "DoseSummationType", "1"}
-	tagDict[Tag{0x3004, 0x000C}] = Info{Tag{0x3004, 0x000C}, "DS", "GridFrameOffsetVector", "2-n"}
-	tagDict[Tag{0x3004, 0x000E}] = Info{Tag{0x3004, 0x000E}, "DS", "DoseGridScaling", "1"}
-	tagDict[Tag{0x3004, 0x0010}] = Info{Tag{0x3004, 0x0010}, "SQ", "RTDoseROISequence", "1"}
-	tagDict[Tag{0x3004, 0x0012}] = Info{Tag{0x3004, 0x0012}, "DS", "DoseValue", "1"}
-	tagDict[Tag{0x3004, 0x0014}] = Info{Tag{0x3004, 0x0014}, "CS", "TissueHeterogeneityCorrection", "1-3"}
-	tagDict[Tag{0x3004, 0x0040}] = Info{Tag{0x3004, 0x0040}, "DS", "DVHNormalizationPoint", "3"}
-	tagDict[Tag{0x3004, 0x0042}] = Info{Tag{0x3004, 0x0042}, "DS", "DVHNormalizationDoseValue", "1"}
-	tagDict[Tag{0x3004, 0x0050}] = Info{Tag{0x3004, 0x0050}, "SQ", "DVHSequence", "1"}
-	tagDict[Tag{0x3004, 0x0052}] = Info{Tag{0x3004, 0x0052}, "DS", "DVHDoseScaling", "1"}
-	tagDict[Tag{0x3004, 0x0054}] = Info{Tag{0x3004, 0x0054}, "CS", "DVHVolumeUnits", "1"}
-	tagDict[Tag{0x3004, 0x0056}] = Info{Tag{0x3004, 0x0056}, "IS", "DVHNumberOfBins", "1"}
-	tagDict[Tag{0x3004, 0x0058}] = Info{Tag{0x3004, 0x0058}, "DS", "DVHData", "2-2n"}
-	tagDict[Tag{0x3004, 0x0060}] = Info{Tag{0x3004, 0x0060}, "SQ", "DVHReferencedROISequence", "1"}
-	tagDict[Tag{0x3004, 0x0062}] = Info{Tag{0x3004, 0x0062}, "CS", "DVHROIContributionType", "1"}
-	tagDict[Tag{0x3004, 0x0070}] = Info{Tag{0x3004, 0x0070}, "DS", "DVHMinimumDose", "1"}
-	tagDict[Tag{0x3004, 0x0072}] = Info{Tag{0x3004, 0x0072}, "DS", "DVHMaximumDose", "1"}
-	tagDict[Tag{0x3004, 0x0074}] = Info{Tag{0x3004, 0x0074}, "DS", "DVHMeanDose", "1"}
-	tagDict[Tag{0x3006, 0x0002}] = Info{Tag{0x3006, 0x0002}, "SH", "StructureSetLabel", "1"}
-	tagDict[Tag{0x3006, 0x0004}] = Info{Tag{0x3006, 0x0004}, "LO", "StructureSetName", "1"}
-	tagDict[Tag{0x3006, 0x0006}] = Info{Tag{0x3006, 0x0006}, "ST", "StructureSetDescription", "1"}
-	tagDict[Tag{0x3006, 0x0008}] = Info{Tag{0x3006, 0x0008}, "DA", "StructureSetDate", "1"}
-	tagDict[Tag{0x3006, 0x0009}] = Info{Tag{0x3006, 0x0009}, "TM", "StructureSetTime", "1"}
-	tagDict[Tag{0x3006, 0x0010}] = Info{Tag{0x3006, 0x0010}, "SQ", "ReferencedFrameOfReferenceSequence", "1"}
-	tagDict[Tag{0x3006, 0x0012}] = Info{Tag{0x3006, 0x0012}, "SQ", "RTReferencedStudySequence", "1"}
-	tagDict[Tag{0x3006, 0x0014}] = Info{Tag{0x3006, 0x0014}, "SQ", "RTReferencedSeriesSequence", "1"}
-	tagDict[Tag{0x3006, 0x0016}] = Info{Tag{0x3006, 0x0016}, "SQ", "ContourImageSequence", "1"}
-	tagDict[Tag{0x3006, 0x0020}] = Info{Tag{0x3006, 0x0020}, "SQ", "StructureSetROISequence", "1"}
-	tagDict[Tag{0x3006, 0x0022}] = Info{Tag{0x3006, 0x0022}, "IS", "ROINumber", "1"}
-	tagDict[Tag{0x3006, 0x0024}] = Info{Tag{0x3006, 0x0024}, "UI", "ReferencedFrameOfReferenceUID", "1"}
-	tagDict[Tag{0x3006, 0x0026}] = Info{Tag{0x3006, 0x0026}, "LO", "ROIName", "1"}
-	tagDict[Tag{0x3006, 0x0028}] = Info{Tag{0x3006, 0x0028}, "ST", "ROIDescription", "1"}
-	tagDict[Tag{0x3006, 0x002A}] = Info{Tag{0x3006, 0x002A}, "IS", "ROIDisplayColor", "3"}
-	tagDict[Tag{0x3006, 0x002C}] = Info{Tag{0x3006, 0x002C}, "DS", "ROIVolume", "1"}
-	tagDict[Tag{0x3006, 0x0030}] = Info{Tag{0x3006, 0x0030}, "SQ", "RTRelatedROISequence", "1"}
-	tagDict[Tag{0x3006, 0x0033}] = Info{Tag{0x3006, 0x0033}, "CS", "RTROIRelationship", "1"}
-	tagDict[Tag{0x3006, 0x0036}] = Info{Tag{0x3006, 0x0036}, "CS", "ROIGenerationAlgorithm", "1"}
-	tagDict[Tag{0x3006, 0x0038}] = Info{Tag{0x3006, 0x0038}, "LO", "ROIGenerationDescription", "1"}
-	tagDict[Tag{0x3006, 0x0039}] = Info{Tag{0x3006, 0x0039}, "SQ", "ROIContourSequence", "1"}
-	tagDict[Tag{0x3006, 0x0040}] = Info{Tag{0x3006, 0x0040}, "SQ", "ContourSequence", "1"}
-	tagDict[Tag{0x3006, 0x0042}] = Info{Tag{0x3006, 0x0042}, "CS", "ContourGeometricType", "1"}
-	tagDict[Tag{0x3006, 0x0044}] = Info{Tag{0x3006, 0x0044}, "DS", "ContourSlabThickness", "1"}
-	tagDict[Tag{0x3006, 0x0045}] = Info{Tag{0x3006, 0x0045}, "DS", "ContourOffsetVector", "3"}
-	tagDict[Tag{0x3006, 0x0046}] = Info{Tag{0x3006, 0x0046}, "IS", "NumberOfContourPoints", "1"}
-	tagDict[Tag{0x3006, 0x0048}] = Info{Tag{0x3006, 0x0048}, "IS", "ContourNumber", "1"}
-	tagDict[Tag{0x3006, 0x0049}] = Info{Tag{0x3006, 0x0049}, "IS", "AttachedContours", "1-n"}
-	tagDict[Tag{0x3006, 0x0050}] = Info{Tag{0x3006, 0x0050}, "DS", "ContourData", "3-3n"}
-	tagDict[Tag{0x3006, 0x0080}] = Info{Tag{0x3006, 0x0080}, "SQ", "RTROIObservationsSequence", "1"}
-	tagDict[Tag{0x3006, 0x0082}] = Info{Tag{0x3006, 0x0082}, "IS", "ObservationNumber", "1"}
-	tagDict[Tag{0x3006, 0x0084}] = Info{Tag{0x3006, 0x0084}, "IS", "ReferencedROINumber", "1"}
-	tagDict[Tag{0x3006, 0x0085}] = Info{Tag{0x3006, 0x0085}, "SH", "ROIObservationLabel", "1"}
-	tagDict[Tag{0x3006, 0x0086}] = Info{Tag{0x3006, 0x0086}, "SQ", "RTROIIdentificationCodeSequence", "1"}
-	tagDict[Tag{0x3006, 0x0088}] = Info{Tag{0x3006, 0x0088}, "ST", "ROIObservationDescription", "1"}
-	tagDict[Tag{0x3006, 0x00A0}] = Info{Tag{0x3006, 0x00A0}, "SQ", "RelatedRTROIObservationsSequence", "1"}
-	tagDict[Tag{0x3006, 0x00A4}] = Info{Tag{0x3006, 0x00A4}, "CS", "RTROIInterpretedType", "1"}
-	tagDict[Tag{0x3006, 0x00A6}] = Info{Tag{0x3006, 0x00A6}, "PN", "ROIInterpreter", "1"}
-	tagDict[Tag{0x3006, 0x00B0}] = Info{Tag{0x3006, 0x00B0}, "SQ", "ROIPhysicalPropertiesSequence", "1"}
-	tagDict[Tag{0x3006, 0x00B2}] = Info{Tag{0x3006, 0x00B2}, "CS", "ROIPhysicalProperty", "1"}
-	tagDict[Tag{0x3006, 0x00B4}] = Info{Tag{0x3006, 0x00B4}, "DS", "ROIPhysicalPropertyValue", "1"}
-	tagDict[Tag{0x3006, 0x00B6}] = Info{Tag{0x3006, 0x00B6}, "SQ", "ROIElementalCompositionSequence", "1"}
-	tagDict[Tag{0x3006, 0x00B7}] = Info{Tag{0x3006, 0x00B7}, "US", "ROIElementalCompositionAtomicNumber", "1"}
-	tagDict[Tag{0x3006, 0x00B8}] = Info{Tag{0x3006, 0x00B8}, "FL", "ROIElementalCompositionAtomicMassFraction", "1"}
-	tagDict[Tag{0x3006, 0x00C0}] = Info{Tag{0x3006, 0x00C0}, "SQ", "FrameOfReferenceRelationshipSequence", "1"}
-	tagDict[Tag{0x3006, 0x00C2}] = Info{Tag{0x3006, 0x00C2}, "UI", "RelatedFrameOfReferenceUID", "1"}
-	tagDict[Tag{0x3006, 0x00C4}] = Info{Tag{0x3006, 0x00C4}, "CS", "FrameOfReferenceTransformationType", "1"}
-	tagDict[Tag{0x3006, 0x00C6}] = Info{Tag{0x3006, 0x00C6}, "DS", "FrameOfReferenceTransformationMatrix", "16"}
-	tagDict[Tag{0x3006, 0x00C8}] = Info{Tag{0x3006, 0x00C8}, "LO", "FrameOfReferenceTransformationComment", "1"}
-	tagDict[Tag{0x3008, 0x0010}] = Info{Tag{0x3008, 0x0010}, "SQ", "MeasuredDoseReferenceSequence", "1"}
-	tagDict[Tag{0x3008, 0x0012}] = Info{Tag{0x3008, 0x0012}, "ST", "MeasuredDoseDescription", "1"}
-	tagDict[Tag{0x3008, 0x0014}] = Info{Tag{0x3008, 0x0014}, "CS", "MeasuredDoseType", "1"}
-	tagDict[Tag{0x3008, 0x0016}] = Info{Tag{0x3008, 0x0016}, "DS", "MeasuredDoseValue", "1"}
-	tagDict[Tag{0x3008, 0x0020}] = Info{Tag{0x3008, 0x0020}, "SQ", "TreatmentSessionBeamSequence", "1"}
-	tagDict[Tag{0x3008, 0x0021}] = Info{Tag{0x3008, 0x0021}, "SQ", "TreatmentSessionIonBeamSequence", "1"}
-	tagDict[Tag{0x3008, 0x0022}] = Info{Tag{0x3008, 0x0022}, "IS", "CurrentFractionNumber", "1"}
-	tagDict[Tag{0x3008, 0x0024}] = Info{Tag{0x3008, 0x0024}, "DA", "TreatmentControlPointDate", "1"}
-	tagDict[Tag{0x3008, 0x0025}] = Info{Tag{0x3008, 0x0025}, "TM", "TreatmentControlPointTime", "1"}
-	tagDict[Tag{0x3008, 0x002A}] = Info{Tag{0x3008, 0x002A}, "CS", "TreatmentTerminationStatus", "1"}
-	tagDict[Tag{0x3008, 0x002B}] = Info{Tag{0x3008, 0x002B}, "SH", "TreatmentTerminationCode", "1"}
-	tagDict[Tag{0x3008, 0x002C}] = Info{Tag{0x3008, 0x002C}, "CS", "TreatmentVerificationStatus", "1"}
-	tagDict[Tag{0x3008, 0x0030}] = Info{Tag{0x3008, 0x0030}, "SQ", "ReferencedTreatmentRecordSequence", "1"}
-	tagDict[Tag{0x3008, 0x0032}] = Info{Tag{0x3008, 0x0032}, "DS", "SpecifiedPrimaryMeterset", "1"}
-	tagDict[Tag{0x3008, 0x0033}] = Info{Tag{0x3008, 0x0033}, "DS", "SpecifiedSecondaryMeterset", "1"}
-	tagDict[Tag{0x3008, 0x0036}] = Info{Tag{0x3008, 0x0036}, "DS", "DeliveredPrimaryMeterset", "1"}
-	tagDict[Tag{0x3008, 0x0037}] = Info{Tag{0x3008, 0x0037}, "DS", "DeliveredSecondaryMeterset", "1"}
-	tagDict[Tag{0x3008, 0x003A}] = Info{Tag{0x3008, 0x003A}, "DS", "SpecifiedTreatmentTime", "1"}
-	tagDict[Tag{0x3008, 0x003B}] = Info{Tag{0x3008, 0x003B}, "DS", "DeliveredTreatmentTime", "1"}
-	tagDict[Tag{0x3008, 0x0040}] = Info{Tag{0x3008, 0x0040}, "SQ", "ControlPointDeliverySequence", "1"}
-	tagDict[Tag{0x3008, 0x0041}] = Info{Tag{0x3008, 0x0041}, "SQ", "IonControlPointDeliverySequence", "1"}
-	tagDict[Tag{0x3008, 0x0042}] = Info{Tag{0x3008, 0x0042}, "DS", "SpecifiedMeterset", "1"}
-	tagDict[Tag{0x3008, 0x0044}] = Info{Tag{0x3008, 0x0044}, "DS", "DeliveredMeterset", "1"}
-	tagDict[Tag{0x3008, 0x0045}] = Info{Tag{0x3008, 0x0045}, "FL", "MetersetRateSet", "1"}
-	tagDict[Tag{0x3008, 0x0046}] = Info{Tag{0x3008, 0x0046}, "FL", "MetersetRateDelivered", "1"}
-	tagDict[Tag{0x3008, 0x0047}] = Info{Tag{0x3008, 0x0047}, "FL", "ScanSpotMetersetsDelivered", "1-n"}
-	tagDict[Tag{0x3008, 0x0048}] = Info{Tag{0x3008, 0x0048}, "DS", "DoseRateDelivered", "1"}
-	tagDict[Tag{0x3008, 0x0050}] = Info{Tag{0x3008, 0x0050}, "SQ", "TreatmentSummaryCalculatedDoseReferenceSequence", "1"}
-	tagDict[Tag{0x3008, 0x0052}] = Info{Tag{0x3008, 0x0052}, "DS", "CumulativeDoseToDoseReference", "1"}
-	tagDict[Tag{0x3008, 0x0054}] = Info{Tag{0x3008, 0x0054}, "DA", "FirstTreatmentDate", "1"}
-	tagDict[Tag{0x3008, 0x0056}] = Info{Tag{0x3008, 0x0056}, "DA", "MostRecentTreatmentDate", "1"}
-	tagDict[Tag{0x3008, 0x005A}] = Info{Tag{0x3008, 0x005A}, "IS", "NumberOfFractionsDelivered", "1"}
-	tagDict[Tag{0x3008, 0x0060}] = Info{Tag{0x3008, 0x0060}, "SQ", "OverrideSequence", "1"}
-	tagDict[Tag{0x3008, 0x0061}] = Info{Tag{0x3008, 0x0061}, "AT", "ParameterSequencePointer", "1"}
-	tagDict[Tag{0x3008, 0x0062}] = Info{Tag{0x3008, 0x0062}, "AT", "OverrideParameterPointer", "1"}
-	tagDict[Tag{0x3008, 0x0063}] = Info{Tag{0x3008, 0x0063}, "IS", "ParameterItemIndex", "1"}
-	tagDict[Tag{0x3008, 0x0064}] = Info{Tag{0x3008, 0x0064}, "IS", "MeasuredDoseReferenceNumber", "1"}
-	tagDict[Tag{0x3008, 0x0065}] = Info{Tag{0x3008, 0x0065}, "AT", "ParameterPointer", "1"}
-	tagDict[Tag{0x3008, 0x0066}] = Info{Tag{0x3008, 0x0066}, "ST", "OverrideReason", "1"}
-	tagDict[Tag{0x3008, 0x0068}] = Info{Tag{0x3008, 0x0068}, "SQ", "CorrectedParameterSequence", "1"}
-	tagDict[Tag{0x3008, 0x006A}] = Info{Tag{0x3008, 0x006A}, "FL", "CorrectionValue", "1"}
-	tagDict[Tag{0x3008, 0x0070}] = Info{Tag{0x3008, 0x0070}, "SQ", "CalculatedDoseReferenceSequence", "1"}
-	tagDict[Tag{0x3008, 0x0072}] = Info{Tag{0x3008, 0x0072}, "IS", "CalculatedDoseReferenceNumber", "1"}
-	tagDict[Tag{0x3008, 0x0074}] = Info{Tag{0x3008, 0x0074}, "ST", "CalculatedDoseReferenceDescription", "1"}
-	tagDict[Tag{0x3008, 0x0076}] = Info{Tag{0x3008, 0x0076}, "DS", "CalculatedDoseReferenceDoseValue", "1"}
-	tagDict[Tag{0x3008, 0x0078}] = Info{Tag{0x3008, 0x0078}, "DS", "StartMeterset", "1"}
-	tagDict[Tag{0x3008, 0x007A}] = Info{Tag{0x3008, 0x007A}, "DS", "EndMeterset", "1"}
-	tagDict[Tag{0x3008, 0x0080}] = Info{Tag{0x3008, 0x0080}, "SQ", "ReferencedMeasuredDoseReferenceSequence", "1"}
-	tagDict[Tag{0x3008, 0x0082}] = Info{Tag{0x3008, 0x0082}, "IS", "ReferencedMeasuredDoseReferenceNumber", "1"}
-	tagDict[Tag{0x3008, 0x0090}] = Info{Tag{0x3008, 0x0090}, "SQ", "ReferencedCalculatedDoseReferenceSequence", "1"}
-	tagDict[Tag{0x3008, 0x0092}] = Info{Tag{0x3008, 0x0092}, "IS", "ReferencedCalculatedDoseReferenceNumber", "1"}
-	tagDict[Tag{0x3008, 0x00A0}] = Info{Tag{0x3008, 0x00A0}, "SQ", "BeamLimitingDeviceLeafPairsSequence", "1"}
-	tagDict[Tag{0x3008, 0x00B0}] = Info{Tag{0x3008, 0x00B0}, "SQ", "RecordedWedgeSequence", "1"}
-	tagDict[Tag{0x3008, 0x00C0}] = Info{Tag{0x3008, 0x00C0}, "SQ", "RecordedCompensatorSequence", "1"}
-	tagDict[Tag{0x3008, 0x00D0}] = Info{Tag{0x3008, 0x00D0}, "SQ", "RecordedBlockSequence", "1"}
-	tagDict[Tag{0x3008, 0x00E0}] = Info{Tag{0x3008, 0x00E0}, "SQ", "TreatmentSummaryMeasuredDoseReferenceSequence", "1"}
-	tagDict[Tag{0x3008, 0x00F0}] = Info{Tag{0x3008, 0x00F0}, "SQ", "RecordedSnoutSequence", "1"}
-	tagDict[Tag{0x3008, 0x00F2}] = Info{Tag{0x3008, 0x00F2}, "SQ", "RecordedRangeShifterSequence", "1"}
-	tagDict[Tag{0x3008, 0x00F4}] = Info{Tag{0x3008, 0x00F4}, "SQ", "RecordedLateralSpreadingDeviceSequence", "1"}
-	tagDict[Tag{0x3008, 0x00F6}] = Info{Tag{0x3008, 0x00F6}, "SQ", "RecordedRangeModulatorSequence", "1"}
-	tagDict[Tag{0x3008, 0x0100}] = Info{Tag{0x3008, 0x0100}, "SQ", "RecordedSourceSequence", "1"}
-	tagDict[Tag{0x3008, 0x0105}] = Info{Tag{0x3008, 0x0105}, "LO", "SourceSerialNumber", "1"}
-	tagDict[Tag{0x3008, 0x0110}] = Info{Tag{0x3008, 0x0110}, "SQ", "TreatmentSessionApplicationSetupSequence", "1"}
-	tagDict[Tag{0x3008, 0x0116}] = Info{Tag{0x3008, 0x0116}, "CS", "ApplicationSetupCheck", "1"}
-	tagDict[Tag{0x3008, 0x0120}] = Info{Tag{0x3008, 0x0120}, "SQ", "RecordedBrachyAccessoryDeviceSequence", "1"}
-	tagDict[Tag{0x3008, 0x0122}] = Info{Tag{0x3008, 0x0122}, "IS", "ReferencedBrachyAccessoryDeviceNumber", "1"}
-	tagDict[Tag{0x3008, 0x0130}] = Info{Tag{0x3008, 0x0130}, "SQ", "RecordedChannelSequence", "1"}
-	tagDict[Tag{0x3008, 0x0132}] = Info{Tag{0x3008, 0x0132}, "DS", "SpecifiedChannelTotalTime", "1"}
-	tagDict[Tag{0x3008, 0x0134}] = Info{Tag{0x3008, 0x0134}, "DS", "DeliveredChannelTotalTime", "1"}
-	tagDict[Tag{0x3008, 0x0136}] = Info{Tag{0x3008, 0x0136}, "IS", "SpecifiedNumberOfPulses", "1"}
-	tagDict[Tag{0x3008, 0x0138}] = Info{Tag{0x3008, 0x0138}, "IS", "DeliveredNumberOfPulses", "1"}
-	tagDict[Tag{0x3008, 0x013A}] = Info{Tag{0x3008, 0x013A}, "DS", "SpecifiedPulseRepetitionInterval", "1"}
-	tagDict[Tag{0x3008, 0x013C}] = Info{Tag{0x3008, 0x013C}, "DS", "DeliveredPulseRepetitionInterval", "1"}
-	tagDict[Tag{0x3008, 0x0140}] = Info{Tag{0x3008, 0x0140}, "SQ", "RecordedSourceApplicatorSequence", "1"}
-	tagDict[Tag{0x3008, 0x0142}] = Info{Tag{0x3008, 0x0142}, "IS", "ReferencedSourceApplicatorNumber", "1"}
-	tagDict[Tag{0x3008, 0x0150}] = Info{Tag{0x3008, 0x0150}, "SQ", "RecordedChannelShieldSequence", "1"}
-	tagDict[Tag{0x3008, 0x0152}] = Info{Tag{0x3008, 0x0152}, "IS", "ReferencedChannelShieldNumber", "1"}
-	tagDict[Tag{0x3008, 0x0160}] = Info{Tag{0x3008, 0x0160}, "SQ", "BrachyControlPointDeliveredSequence", "1"}
-	tagDict[Tag{0x3008, 0x0162}] = Info{Tag{0x3008, 0x0162}, "DA", "SafePositionExitDate", "1"}
-	tagDict[Tag{0x3008, 0x0164}] = Info{Tag{0x3008, 0x0164}, "TM", "SafePositionExitTime", "1"}
-	tagDict[Tag{0x3008, 0x0166}] = Info{Tag{0x3008, 0x0166}, "DA", "SafePositionReturnDate", "1"}
-	tagDict[Tag{0x3008, 0x0168}] = Info{Tag{0x3008, 0x0168}, "TM", "SafePositionReturnTime", "1"}
-	tagDict[Tag{0x3008, 0x0200}] = Info{Tag{0x3008, 0x0200}, "CS", "CurrentTreatmentStatus", "1"}
-	tagDict[Tag{0x3008, 0x0202}] = Info{Tag{0x3008, 0x0202}, "ST", "TreatmentStatusComment", "1"}
-	tagDict[Tag{0x3008, 0x0220}] = Info{Tag{0x3008, 0x0220}, "SQ", "FractionGroupSummarySequence", "1"}
-	tagDict[Tag{0x3008, 0x0223}] = Info{Tag{0x3008, 0x0223}, "IS", "ReferencedFractionNumber", "1"}
-	tagDict[Tag{0x3008, 0x0224}] = Info{Tag{0x3008, 0x0224}, "CS", "FractionGroupType", "1"}
-	tagDict[Tag{0x3008, 0x0230}] = Info{Tag{0x3008, 0x0230}, "CS", "BeamStopperPosition", "1"}
-	tagDict[Tag{0x3008, 0x0240}] = Info{Tag{0x3008, 0x0240}, "SQ", "FractionStatusSummarySequence", "1"}
-	tagDict[Tag{0x3008, 0x0250}] = Info{Tag{0x3008, 0x0250}, "DA", "TreatmentDate", "1"}
-	tagDict[Tag{0x3008, 0x0251}] = Info{Tag{0x3008, 0x0251}, "TM", "TreatmentTime", "1"}
-	tagDict[Tag{0x300A, 0x0002}] = Info{Tag{0x300A, 0x0002}, "SH", "RTPlanLabel", "1"}
-	tagDict[Tag{0x300A, 0x0003}] = Info{Tag{0x300A, 0x0003}, "LO", "RTPlanName", "1"}
-	tagDict[Tag{0x300A, 0x0004}] = Info{Tag{0x300A, 0x0004}, "ST", "RTPlanDescription", "1"}
-	tagDict[Tag{0x300A, 0x0006}] = Info{Tag{0x300A, 0x0006}, "DA", "RTPlanDate", "1"}
-	tagDict[Tag{0x300A, 0x0007}] = Info{Tag{0x300A, 0x0007}, "TM", "RTPlanTime", "1"}
-	tagDict[Tag{0x300A, 0x0009}] = Info{Tag{0x300A, 0x0009}, "LO", "TreatmentProtocols", "1-n"}
-	tagDict[Tag{0x300A, 0x000A}] = Info{Tag{0x300A, 0x000A}, "CS", "PlanIntent", "1"}
-	tagDict[Tag{0x300A, 0x000B}] = Info{Tag{0x300A, 0x000B}, "LO", "TreatmentSites", "1-n"}
-	tagDict[Tag{0x300A, 0x000C}] = Info{Tag{0x300A, 0x000C}, "CS", "RTPlanGeometry", "1"}
-	tagDict[Tag{0x300A, 0x000E}] = Info{Tag{0x300A, 0x000E}, "ST", "PrescriptionDescription", "1"}
-	tagDict[Tag{0x300A, 0x0010}] = Info{Tag{0x300A, 0x0010}, "SQ", "DoseReferenceSequence", "1"}
-	tagDict[Tag{0x300A, 0x0012}] = Info{Tag{0x300A, 0x0012}, "IS", "DoseReferenceNumber", "1"}
-	tagDict[Tag{0x300A, 0x0013}] = Info{Tag{0x300A, 0x0013}, "UI", "DoseReferenceUID", "1"}
-	tagDict[Tag{0x300A, 0x0014}] = Info{Tag{0x300A, 0x0014}, "CS", "DoseReferenceStructureType", "1"}
-	tagDict[Tag{0x300A, 0x0015}] = Info{Tag{0x300A, 0x0015}, "CS", "NominalBeamEnergyUnit", "1"}
-	tagDict[Tag{0x300A, 0x0016}] = Info{Tag{0x300A, 0x0016}, "LO", "DoseReferenceDescription", "1"}
-	tagDict[Tag{0x300A, 0x0018}] = Info{Tag{0x300A, 0x0018}, "DS", "DoseReferencePointCoordinates", "3"}
-	tagDict[Tag{0x300A, 0x001A}] = Info{Tag{0x300A, 0x001A}, "DS", "NominalPriorDose", "1"}
-	tagDict[Tag{0x300A, 0x0020}] = Info{Tag{0x300A, 0x0020}, "CS", "DoseReferenceType", "1"}
-	tagDict[Tag{0x300A, 0x0021}] = Info{Tag{0x300A, 0x0021}, "DS", "ConstraintWeight", "1"}
-	tagDict[Tag{0x300A, 0x0022}] = Info{Tag{0x300A, 0x0022}, "DS", "DeliveryWarningDose", "1"}
-	tagDict[Tag{0x300A, 0x0023}] = Info{Tag{0x300A, 0x0023}, "DS", "DeliveryMaximumDose", "1"}
-	tagDict[Tag{0x300A, 0x0025}] = Info{Tag{0x300A, 0x0025}, "DS", "TargetMinimumDose", "1"}
-	tagDict[Tag{0x300A, 0x0026}] = Info{Tag{0x300A, 0x0026}, "DS", "TargetPrescriptionDose", "1"}
-	tagDict[Tag{0x300A, 0x0027}] = Info{Tag{0x300A, 0x0027}, "DS", "TargetMaximumDose", "1"}
-	tagDict[Tag{0x300A, 0x0028}] = Info{Tag{0x300A, 0x0028}, "DS", "TargetUnderdoseVolumeFraction", "1"}
-	tagDict[Tag{0x300A, 0x002A}] = Info{Tag{0x300A, 0x002A}, "DS", "OrganAtRiskFullVolumeDose", "1"}
-	tagDict[Tag{0x300A, 0x002B}] = Info{Tag{0x300A, 0x002B}, "DS", "OrganAtRiskLimitDose", "1"}
-	tagDict[Tag{0x300A, 0x002C}] = Info{Tag{0x300A, 0x002C}, "DS", "OrganAtRiskMaximumDose", "1"}
-	tagDict[Tag{0x300A, 0x002D}] = Info{Tag{0x300A, 0x002D}, "DS", "OrganAtRiskOverdoseVolumeFraction", "1"}
-	tagDict[Tag{0x300A, 0x0040}] = Info{Tag{0x300A, 0x0040}, "SQ", "ToleranceTableSequence", "1"}
-	tagDict[Tag{0x300A, 0x0042}] = Info{Tag{0x300A, 0x0042}, "IS", "ToleranceTableNumber", "1"}
-	tagDict[Tag{0x300A, 0x0043}] = Info{Tag{0x300A, 0x0043}, "SH", "ToleranceTableLabel", "1"}
-	tagDict[Tag{0x300A, 0x0044}] = Info{Tag{0x300A, 0x0044}, "DS", "GantryAngleTolerance", "1"}
-	tagDict[Tag{0x300A, 0x0046}] = Info{Tag{0x300A, 0x0046}, "DS", "BeamLimitingDeviceAngleTolerance", "1"}
-	tagDict[Tag{0x300A, 0x0048}] = Info{Tag{0x300A, 0x0048}, "SQ", "BeamLimitingDeviceToleranceSequence", "1"}
-	tagDict[Tag{0x300A, 0x004A}] = Info{Tag{0x300A, 0x004A}, "DS", "BeamLimitingDevicePositionTolerance", "1"}
-	tagDict[Tag{0x300A, 0x004B}] = Info{Tag{0x300A, 0x004B}, "FL", "SnoutPositionTolerance", "1"}
-	tagDict[Tag{0x300A, 0x004C}] = Info{Tag{0x300A, 0x004C}, "DS", "PatientSupportAngleTolerance", "1"}
-	tagDict[Tag{0x300A, 0x004E}] = Info{Tag{0x300A, 0x004E}, "DS", "TableTopEccentricAngleTolerance", "1"}
-	tagDict[Tag{0x300A, 0x004F}] = Info{Tag{0x300A, 0x004F}, "FL", "TableTopPitchAngleTolerance", "1"}
-	tagDict[Tag{0x300A, 0x0050}] = Info{Tag{0x300A, 0x0050}, "FL", "TableTopRollAngleTolerance", "1"}
-	tagDict[Tag{0x300A, 0x0051}] = Info{Tag{0x300A, 0x0051}, "DS", "TableTopVerticalPositionTolerance", "1"}
-	tagDict[Tag{0x300A, 0x0052}] = Info{Tag{0x300A, 0x0052}, "DS", "TableTopLongitudinalPositionTolerance", "1"}
-	tagDict[Tag{0x300A, 0x0053}] = Info{Tag{0x300A, 0x0053}, "DS", "TableTopLateralPositionTolerance", "1"}
-	tagDict[Tag{0x300A, 0x0055}] = Info{Tag{0x300A, 0x0055}, "CS", "RTPlanRelationship", "1"}
-	tagDict[Tag{0x300A, 0x0070}] = Info{Tag{0x300A, 0x0070}, "SQ", "FractionGroupSequence", "1"}
-	tagDict[Tag{0x300A, 0x0071}] = Info{Tag{0x300A, 0x0071}, "IS", "FractionGroupNumber", "1"}
-	tagDict[Tag{0x300A, 0x0072}] = Info{Tag{0x300A, 0x0072}, "LO", "FractionGroupDescription", "1"}
-	tagDict[Tag{0x300A, 0x0078}] = Info{Tag{0x300A, 0x0078}, "IS", "NumberOfFractionsPlanned", "1"}
-	tagDict[Tag{0x300A, 0x0079}] = Info{Tag{0x300A, 0x0079}, "IS", "NumberOfFractionPatternDigitsPerDay", "1"}
-	tagDict[Tag{0x300A, 0x007A}] = Info{Tag{0x300A, 0x007A}, "IS", "RepeatFractionCycleLength", "1"}
-	tagDict[Tag{0x300A, 0x007B}] = Info{Tag{0x300A, 0x007B}, "LT", "FractionPattern", "1"}
-	tagDict[Tag{0x300A, 0x0080}] = Info{Tag{0x300A, 0x0080}, "IS", "NumberOfBeams", "1"}
-	tagDict[Tag{0x300A, 0x0082}] = Info{Tag{0x300A, 0x0082}, "DS", "BeamDoseSpecificationPoint", "3"}
-	tagDict[Tag{0x300A, 0x0084}] = Info{Tag{0x300A, 0x0084}, "DS", "BeamDose", "1"}
-	tagDict[Tag{0x300A, 0x0086}] = Info{Tag{0x300A, 0x0086}, "DS", "BeamMeterset", "1"}
-	tagDict[Tag{0x300A, 0x0088}] = Info{Tag{0x300A, 0x0088}, "FL", "BeamDosePointDepth", "1"}
-	tagDict[Tag{0x300A, 0x0089}] = Info{Tag{0x300A, 0x0089}, "FL", "BeamDosePointEquivalentDepth", "1"}
-	tagDict[Tag{0x300A, 0x008A}] = Info{Tag{0x300A, 0x008A}, "FL", "BeamDosePointSSD", "1"}
-	tagDict[Tag{0x300A, 0x00A0}] = Info{Tag{0x300A, 0x00A0}, "IS", "NumberOfBrachyApplicationSetups", "1"}
-	tagDict[Tag{0x300A, 0x00A2}] = Info{Tag{0x300A, 0x00A2}, "DS", "BrachyApplicationSetupDoseSpecificationPoint", "3"}
-	tagDict[Tag{0x300A, 0x00A4}] = Info{Tag{0x300A, 0x00A4}, "DS", "BrachyApplicationSetupDose", "1"}
-	tagDict[Tag{0x300A, 0x00B0}] = Info{Tag{0x300A, 0x00B0}, "SQ", "BeamSequence", "1"}
-	tagDict[Tag{0x300A, 0x00B2}] = Info{Tag{0x300A, 0x00B2}, "SH", "TreatmentMachineName", "1"}
-	tagDict[Tag{0x300A, 0x00B3}] = Info{Tag{0x300A, 0x00B3}, "CS", "PrimaryDosimeterUnit", "1"}
-	tagDict[Tag{0x300A, 0x00B4}] = Info{Tag{0x300A, 0x00B4}, "DS", "SourceAxisDistance", "1"}
-	tagDict[Tag{0x300A, 0x00B6}] = Info{Tag{0x300A, 0x00B6}, "SQ", "BeamLimitingDeviceSequence", "1"}
-	tagDict[Tag{0x300A, 0x00B8}] = Info{Tag{0x300A, 0x00B8}, "CS", "RTBeamLimitingDeviceType", "1"}
-	tagDict[Tag{0x300A, 0x00BA}] = Info{Tag{0x300A, 0x00BA}, "DS", "SourceToBeamLimitingDeviceDistance", "1"}
-	tagDict[Tag{0x300A, 0x00BB}] = Info{Tag{0x300A, 0x00BB}, "FL", "IsocenterToBeamLimitingDeviceDistance", "1"}
-	tagDict[Tag{0x300A, 0x00BC}] = Info{Tag{0x300A, 0x00BC}, "IS", "NumberOfLeafJawPairs", "1"}
-	tagDict[Tag{0x300A, 0x00BE}] = Info{Tag{0x300A, 0x00BE}, "DS", "LeafPositionBoundaries", "3-n"}
-	tagDict[Tag{0x300A, 0x00C0}] = Info{Tag{0x300A, 0x00C0}, "IS", "BeamNumber", "1"}
-	tagDict[Tag{0x300A, 0x00C2}] = Info{Tag{0x300A, 0x00C2}, "LO", "BeamName", "1"}
-	tagDict[Tag{0x300A, 0x00C3}] = Info{Tag{0x300A, 0x00C3}, "ST", "BeamDescription", "1"}
-	tagDict[Tag{0x300A, 0x00C4}] = Info{Tag{0x300A, 0x00C4}, "CS", "BeamType", "1"}
-	tagDict[Tag{0x300A, 0x00C6}] = Info{Tag{0x300A, 0x00C6}, "CS", "RadiationType", "1"}
-	tagDict[Tag{0x300A, 0x00C7}] = Info{Tag{0x300A, 0x00C7}, "CS", "HighDoseTechniqueType", "1"}
-	tagDict[Tag{0x300A, 0x00C8}] = Info{Tag{0x300A, 0x00C8}, "IS", "ReferenceImageNumber", "1"}
-	tagDict[Tag{0x300A, 0x00CA}] = Info{Tag{0x300A, 0x00CA}, "SQ", "PlannedVerificationImageSequence", "1"}
-	tagDict[Tag{0x300A, 0x00CC}] = Info{Tag{0x300A, 0x00CC}, "LO", "ImagingDeviceSpecificAcquisitionParameters", "1-n"}
-	tagDict[Tag{0x300A, 0x00CE}] = Info{Tag{0x300A, 0x00CE}, "CS", "TreatmentDeliveryType", "1"}
-	tagDict[Tag{0x300A, 0x00D0}] = Info{Tag{0x300A, 0x00D0}, "IS", "NumberOfWedges", "1"}
-	tagDict[Tag{0x300A, 0x00D1}] = Info{Tag{0x300A, 0x00D1}, "SQ", "WedgeSequence", "1"}
-	tagDict[Tag{0x300A, 0x00D2}] = Info{Tag{0x300A, 0x00D2}, "IS", "WedgeNumber", "1"}
-	tagDict[Tag{0x300A, 0x00D3}] = Info{Tag{0x300A, 0x00D3}, "CS", "WedgeType", "1"}
-	tagDict[Tag{0x300A, 0x00D4}] = Info{Tag{0x300A, 0x00D4}, "SH", "WedgeID", "1"}
-	tagDict[Tag{0x300A, 0x00D5}] = Info{Tag{0x300A, 0x00D5}, "IS", "WedgeAngle", "1"}
-	tagDict[Tag{0x300A, 0x00D6}] = Info{Tag{0x300A, 0x00D6}, "DS", "WedgeFactor", "1"}
-	tagDict[Tag{0x300A, 0x00D7}] = Info{Tag{0x300A, 0x00D7}, "FL", "TotalWedgeTrayWaterEquivalentThickness", "1"}
-	tagDict[Tag{0x300A, 0x00D8}] = Info{Tag{0x300A, 0x00D8}, "DS", "WedgeOrientation", "1"}
-	tagDict[Tag{0x300A, 0x00D9}] = Info{Tag{0x300A, 0x00D9}, "FL", "IsocenterToWedgeTrayDistance", "1"}
-	tagDict[Tag{0x300A, 0x00DA}] = Info{Tag{0x300A, 0x00DA}, "DS", "SourceToWedgeTrayDistance", "1"}
-	tagDict[Tag{0x300A, 0x00DB}] = Info{Tag{0x300A, 0x00DB}, "FL", "WedgeThinEdgePosition", "1"}
-	tagDict[Tag{0x300A, 0x00DC}] = Info{Tag{0x300A, 0x00DC}, "SH", "BolusID", "1"}
-	tagDict[Tag{0x300A, 0x00DD}] = Info{Tag{0x300A, 0x00DD}, "ST", "BolusDescription", "1"}
-	tagDict[Tag{0x300A, 0x00E0}] = Info{Tag{0x300A, 0x00E0}, "IS", "NumberOfCompensators", "1"}
-	tagDict[Tag{0x300A, 0x00E1}] = Info{Tag{0x300A, 0x00E1}, "SH", "MaterialID", "1"}
-	tagDict[Tag{0x300A, 0x00E2}] = Info{Tag{0x300A, 0x00E2}, "DS", "TotalCompensatorTrayFactor", "1"}
-	tagDict[Tag{0x300A, 0x00E3}] = Info{Tag{0x300A, 0x00E3}, "SQ", "CompensatorSequence", "1"}
-	tagDict[Tag{0x300A, 0x00E4}] = Info{Tag{0x300A, 0x00E4}, "IS", "CompensatorNumber", "1"}
-	tagDict[Tag{0x300A, 0x00E5}] = Info{Tag{0x300A, 0x00E5}, "SH", "CompensatorID", "1"}
-	tagDict[Tag{0x300A, 0x00E6}] = Info{Tag{0x300A, 0x00E6}, "DS", "SourceToCompensatorTrayDistance", "1"}
-	tagDict[Tag{0x300A, 0x00E7}] = Info{Tag{0x300A, 0x00E7}, "IS", "CompensatorRows", "1"}
-	tagDict[Tag{0x300A, 0x00E8}] = Info{Tag{0x300A, 0x00E8}, "IS", "CompensatorColumns", "1"}
-	tagDict[Tag{0x300A, 0x00E9}] = Info{Tag{0x300A, 0x00E9}, "DS", "CompensatorPixelSpacing", "2"}
-	tagDict[Tag{0x300A, 0x00EA}] = Info{Tag{0x300A, 0x00EA}, "DS", "CompensatorPosition", "2"}
-	tagDict[Tag{0x300A, 0x00EB}] = Info{Tag{0x300A, 0x00EB}, "DS", "CompensatorTransmissionData", "1-n"}
-	tagDict[Tag{0x300A, 0x00EC}] = Info{Tag{0x300A, 0x00EC}, "DS", "CompensatorThicknessData", "1-n"}
-	tagDict[Tag{0x300A, 0x00ED}] = Info{Tag{0x300A, 0x00ED}, "IS", "NumberOfBoli", "1"}
-	tagDict[Tag{0x300A, 0x00EE}] = Info{Tag{0x300A, 0x00EE}, "CS", "CompensatorType", "1"}
-	tagDict[Tag{0x300A, 0x00F0}] = Info{Tag{0x300A, 0x00F0}, "IS", "NumberOfBlocks", "1"}
-	tagDict[Tag{0x300A, 0x00F2}] = Info{Tag{0x300A, 0x00F2}, "DS", "TotalBlockTrayFactor", "1"}
-	tagDict[Tag{0x300A, 0x00F3}] = Info{Tag{0x300A, 0x00F3}, "FL", "TotalBlockTrayWaterEquivalentThickness", "1"}
-	tagDict[Tag{0x300A, 0x00F4}] = Info{Tag{0x300A, 0x00F4}, "SQ", "BlockSequence", "1"}
-	tagDict[Tag{0x300A, 0x00F5}] = Info{Tag{0x300A, 0x00F5}, "SH", "BlockTrayID", "1"}
-	tagDict[Tag{0x300A, 0x00F6}] = Info{Tag{0x300A, 0x00F6}, "DS", "SourceToBlockTrayDistance", "1"}
-	tagDict[Tag{0x300A, 0x00F7}] = Info{Tag{0x300A, 0x00F7}, "FL", "IsocenterToBlockTrayDistance", "1"}
-	tagDict[Tag{0x300A, 0x00F8}] = Info{Tag{0x300A, 0x00F8}, "CS", "BlockType", "1"}
-	tagDict[Tag{0x300A, 0x00F9}] = Info{Tag{0x300A, 0x00F9}, "LO", "AccessoryCode", "1"}
-	tagDict[Tag{0x300A, 0x00FA}] = Info{Tag{0x300A, 0x00FA}, "CS", "BlockDivergence", "1"}
-	tagDict[Tag{0x300A, 0x00FB}] = Info{Tag{0x300A, 0x00FB}, "CS", "BlockMountingPosition", "1"}
-	tagDict[Tag{0x300A, 0x00FC}] = Info{Tag{0x300A, 0x00FC}, "IS", "BlockNumber", "1"}
-	tagDict[Tag{0x300A, 0x00FE}] = Info{Tag{0x300A, 0x00FE}, "LO", "BlockName", "1"}
-	tagDict[Tag{0x300A, 0x0100}] = Info{Tag{0x300A, 0x0100}, "DS", "BlockThickness", "1"}
-	tagDict[Tag{0x300A, 0x0102}] = Info{Tag{0x300A, 0x0102}, "DS", "BlockTransmission", "1"}
-	tagDict[Tag{0x300A, 0x0104}] = Info{Tag{0x300A, 0x0104}, "IS", "BlockNumberOfPoints", "1"}
-	tagDict[Tag{0x300A, 0x0106}] = Info{Tag{0x300A, 0x0106}, "DS", "BlockData", "2-2n"}
-	tagDict[Tag{0x300A, 0x0107}] = Info{Tag{0x300A, 0x0107}, "SQ", "ApplicatorSequence", "1"}
-	tagDict[Tag{0x300A, 0x0108}] = Info{Tag{0x300A, 0x0108}, "SH", "ApplicatorID", "1"}
-	tagDict[Tag{0x300A, 0x0109}] = Info{Tag{0x300A, 0x0109}, "CS", "ApplicatorType", "1"}
-	tagDict[Tag{0x300A, 0x010A}] = Info{Tag{0x300A, 0x010A}, "LO", "ApplicatorDescription", "1"}
-	tagDict[Tag{0x300A, 0x010C}] = Info{Tag{0x300A, 0x010C}, "DS", "CumulativeDoseReferenceCoefficient", "1"}
-	tagDict[Tag{0x300A, 0x010E}] = Info{Tag{0x300A, 0x010E}, "DS", "FinalCumulativeMetersetWeight", "1"}
-	tagDict[Tag{0x300A, 0x0110}] = Info{Tag{0x300A, 0x0110}, "IS", "NumberOfControlPoints", "1"}
-	tagDict[Tag{0x300A, 0x0111}] = Info{Tag{0x300A, 0x0111}, "SQ", "ControlPointSequence", "1"}
-	tagDict[Tag{0x300A, 0x0112}] = Info{Tag{0x300A, 0x0112}, "IS", "ControlPointIndex", "1"}
-	tagDict[Tag{0x300A, 0x0114}] = Info{Tag{0x300A, 0x0114}, "DS", "NominalBeamEnergy", "1"}
-	tagDict[Tag{0x300A, 0x0115}] = Info{Tag{0x300A, 0x0115}, "DS", "DoseRateSet", "1"}
-	tagDict[Tag{0x300A, 0x0116}] = Info{Tag{0x300A, 0x0116}, "SQ", "WedgePositionSequence", "1"}
-	tagDict[Tag{0x300A, 0x0118}] = Info{Tag{0x300A, 0x0118}, "CS", "WedgePosition", "1"}
-	tagDict[Tag{0x300A, 0x011A}] = Info{Tag{0x300A, 0x011A}, "SQ", "BeamLimitingDevicePositionSequence", "1"}
-	tagDict[Tag{0x300A, 0x011C}] = Info{Tag{0x300A, 0x011C}, "DS", "LeafJawPositions", "2-2n"}
-	tagDict[Tag{0x300A, 0x011E}] = Info{Tag{0x300A, 0x011E}, "DS", "GantryAngle", "1"}
-	tagDict[Tag{0x300A, 0x011F}] = Info{Tag{0x300A, 0x011F}, "CS", "GantryRotationDirection", "1"}
-	tagDict[Tag{0x300A, 0x0120}] = Info{Tag{0x300A, 0x0120}, "DS", "BeamLimitingDeviceAngle", "1"}
-	tagDict[Tag{0x300A, 0x0121}] = Info{Tag{0x300A, 0x0121}, "CS", "BeamLimitingDeviceRotationDirection", "1"}
-	tagDict[Tag{0x300A, 0x0122}] = Info{Tag{0x300A, 0x0122}, "DS", "PatientSupportAngle", "1"}
-	tagDict[Tag{0x300A, 0x0123}] = Info{Tag{0x300A, 0x0123}, "CS", "PatientSupportRotationDirection", "1"}
-	tagDict[Tag{0x300A, 0x0124}] = Info{Tag{0x300A, 0x0124}, "DS", "TableTopEccentricAxisDistance", "1"}
-	tagDict[Tag{0x300A, 0x0125}] = Info{Tag{0x300A, 0x0125}, "DS", "TableTopEccentricAngle", "1"}
-	tagDict[Tag{0x300A, 0x0126}] = Info{Tag{0x300A, 0x0126}, "CS", "TableTopEccentricRotationDirection", "1"}
-	tagDict[Tag{0x300A, 0x0128}] = Info{Tag{0x300A, 0x0128}, "DS", "TableTopVerticalPosition", "1"}
-	tagDict[Tag{0x300A, 0x0129}] = Info{Tag{0x300A, 0x0129}, "DS", "TableTopLongitudinalPosition", "1"}
-	tagDict[Tag{0x300A, 0x012A}] = Info{Tag{0x300A, 0x012A}, "DS", "TableTopLateralPosition", "1"}
-	tagDict[Tag{0x300A, 0x012C}] = Info{Tag{0x300A, 0x012C}, "DS", "IsocenterPosition", "3"}
-	tagDict[Tag{0x300A, 0x012E}] = Info{Tag{0x300A, 0x012E}, "DS", "SurfaceEntryPoint", "3"}
-	tagDict[Tag{0x300A, 0x0130}] = Info{Tag{0x300A, 0x0130}, "DS", "SourceToSurfaceDistance", "1"}
-	tagDict[Tag{0x300A, 0x0134}] = Info{Tag{0x300A, 0x0134}, "DS", "CumulativeMetersetWeight", "1"}
-	tagDict[Tag{0x300A, 0x0140}] = Info{Tag{0x300A, 0x0140}, "FL", "TableTopPitchAngle", "1"}
-	tagDict[Tag{0x300A, 0x0142}] = Info{Tag{0x300A, 0x0142}, "CS", "TableTopPitchRotationDirection", "1"}
-	tagDict[Tag{0x300A, 0x0144}] = Info{Tag{0x300A, 0x0144}, "FL", "TableTopRollAngle", "1"}
-	tagDict[Tag{0x300A, 0x0146}] = Info{Tag{0x300A, 0x0146}, "CS", "TableTopRollRotationDirection", "1"}
-	tagDict[Tag{0x300A, 0x0148}] = Info{Tag{0x300A, 0x0148}, "FL", "HeadFixationAngle", "1"}
-	tagDict[Tag{0x300A, 0x014A}] = Info{Tag{0x300A, 0x014A}, "FL", "GantryPitchAngle", "1"}
-	tagDict[Tag{0x300A, 0x014C}] = Info{Tag{0x300A, 0x014C}, "CS", "GantryPitchRotationDirection", "1"}
-	tagDict[Tag{0x300A, 0x014E}] = Info{Tag{0x300A, 0x014E}, "FL", "GantryPitchAngleTolerance", "1"}
-	tagDict[Tag{0x300A, 0x0180}] = Info{Tag{0x300A, 0x0180}, "SQ", "PatientSetupSequence", "1"}
-	tagDict[Tag{0x300A, 0x0182}] = Info{Tag{0x300A, 0x0182}, "IS", "PatientSetupNumber", "1"}
-	tagDict[Tag{0x300A, 0x0183}] = Info{Tag{0x300A, 0x0183}, "LO", "PatientSetupLabel", "1"}
-	tagDict[Tag{0x300A, 0x0184}] = Info{Tag{0x300A, 0x0184}, "LO", "PatientAdditionalPosition", "1"}
-	tagDict[Tag{0x300A, 0x0190}] = Info{Tag{0x300A, 0x0190}, "SQ", "FixationDeviceSequence", "1"}
-	tagDict[Tag{0x300A, 0x0192}] = Info{Tag{0x300A, 0x0192}, "CS", "FixationDeviceType", "1"}
-	tagDict[Tag{0x300A, 0x0194}] = Info{Tag{0x300A, 0x0194}, "SH", "FixationDeviceLabel", "1"}
-	tagDict[Tag{0x300A, 0x0196}] = Info{Tag{0x300A, 0x0196}, "ST", "FixationDeviceDescription", "1"}
-	tagDict[Tag{0x300A, 0x0198}] = Info{Tag{0x300A, 0x0198}, "SH", "FixationDevicePosition", "1"}
-	tagDict[Tag{0x300A, 0x0199}] = Info{Tag{0x300A, 0x0199}, "FL", "FixationDevicePitchAngle", "1"}
-	tagDict[Tag{0x300A, 0x019A}] = Info{Tag{0x300A, 0x019A}, "FL", "FixationDeviceRollAngle", "1"}
-	tagDict[Tag{0x300A, 0x01A0}] = Info{Tag{0x300A, 0x01A0}, "SQ", "ShieldingDeviceSequence", "1"}
-	tagDict[Tag{0x300A, 0x01A2}] = Info{Tag{0x300A, 0x01A2}, "CS", "ShieldingDeviceType", "1"}
-	tagDict[Tag{0x300A, 0x01A4}] = Info{Tag{0x300A, 0x01A4}, "SH", "ShieldingDeviceLabel", "1"}
-	tagDict[Tag{0x300A, 0x01A6}] = Info{Tag{0x300A, 0x01A6}, "ST", "ShieldingDeviceDescription", "1"}
-	tagDict[Tag{0x300A, 0x01A8}] = Info{Tag{0x300A, 0x01A8}, "SH", "ShieldingDevicePosition", "1"}
-	tagDict[Tag{0x300A, 0x01B0}] = Info{Tag{0x300A, 0x01B0}, "CS", "SetupTechnique", "1"}
-	tagDict[Tag{0x300A, 0x01B2}] = Info{Tag{0x300A, 0x01B2}, "ST", "SetupTechniqueDescription", "1"}
-	tagDict[Tag{0x300A, 0x01B4}] = Info{Tag{0x300A, 0x01B4}, "SQ", "SetupDeviceSequence", "1"}
-	tagDict[Tag{0x300A, 0x01B6}] = Info{Tag{0x300A, 0x01B6}, "CS", "SetupDeviceType", "1"}
-	tagDict[Tag{0x300A, 0x01B8}] = Info{Tag{0x300A, 0x01B8}, "SH", "SetupDeviceLabel", "1"}
-	tagDict[Tag{0x300A, 0x01BA}] = Info{Tag{0x300A, 0x01BA}, "ST", "SetupDeviceDescription", "1"}
-	tagDict[Tag{0x300A, 0x01BC}] = Info{Tag{0x300A, 0x01BC}, "DS", "SetupDeviceParameter", "1"}
-	tagDict[Tag{0x300A, 0x01D0}] = Info{Tag{0x300A, 0x01D0}, "ST", "SetupReferenceDescription", "1"}
-	tagDict[Tag{0x300A, 0x01D2}] = Info{Tag{0x300A, 0x01D2}, "DS", "TableTopVerticalSetupDisplacement", "1"}
-	tagDict[Tag{0x300A, 0x01D4}] = Info{Tag{0x300A, 0x01D4}, "DS", "TableTopLongitudinalSetupDisplacement", "1"}
-	tagDict[Tag{0x300A, 0x01D6}] = Info{Tag{0x300A, 0x01D6}, "DS", "TableTopLateralSetupDisplacement", "1"}
-	tagDict[Tag{0x300A, 0x0200}] = Info{Tag{0x300A, 0x0200}, "CS", "BrachyTreatmentTechnique", "1"}
-	tagDict[Tag{0x300A, 0x0202}] = Info{Tag{0x300A, 0x0202}, "CS", "BrachyTreatmentType", "1"}
-	tagDict[Tag{0x300A, 0x0206}] = Info{Tag{0x300A, 0x0206}, "SQ", "TreatmentMachineSequence", "1"}
-	tagDict[Tag{0x300A, 0x0210}] = Info{Tag{0x300A, 0x0210}, "SQ", "SourceSequence", "1"}
-	tagDict[Tag{0x300A, 0x0212}] = Info{Tag{0x300A, 0x0212}, "IS", "SourceNumber", "1"}
-	tagDict[Tag{0x300A, 0x0214}] = Info{Tag{0x300A, 0x0214}, "CS", "SourceType", "1"}
-	tagDict[Tag{0x300A, 0x0216}] = Info{Tag{0x300A, 0x0216}, "LO", "SourceManufacturer", "1"}
-	tagDict[Tag{0x300A, 0x0218}] = Info{Tag{0x300A, 0x0218}, "DS", "ActiveSourceDiameter", "1"}
-	tagDict[Tag{0x300A, 0x021A}] = Info{Tag{0x300A, 0x021A}, "DS", "ActiveSourceLength", "1"}
-	tagDict[Tag{0x300A, 0x0222}] = Info{Tag{0x300A, 0x0222}, "DS", "SourceEncapsulationNominalThickness", "1"}
-	tagDict[Tag{0x300A, 0x0224}] = Info{Tag{0x300A, 0x0224}, "DS", "SourceEncapsulationNominalTransmission", "1"}
-	tagDict[Tag{0x300A, 0x0226}] = Info{Tag{0x300A, 0x0226}, "LO", "SourceIsotopeName", "1"}
-	tagDict[Tag{0x300A, 0x0228}] = Info{Tag{0x300A, 0x0228}, "DS", "SourceIsotopeHalfLife", "1"}
-	tagDict[Tag{0x300A, 0x0229}] = Info{Tag{0x300A, 0x0229}, "CS", "SourceStrengthUnits", "1"}
-	tagDict[Tag{0x300A, 0x022A}] = Info{Tag{0x300A, 0x022A}, "DS", "ReferenceAirKermaRate", "1"}
-	tagDict[Tag{0x300A, 0x022B}] = Info{Tag{0x300A, 0x022B}, "DS", "SourceStrength", "1"}
-	tagDict[Tag{0x300A, 0x022C}] = Info{Tag{0x300A, 0x022C}, "DA", "SourceStrengthReferenceDate", "1"}
-	tagDict[Tag{0x300A, 0x022E}] = Info{Tag{0x300A, 0x022E}, "TM", "SourceStrengthReferenceTime", "1"}
-	tagDict[Tag{0x300A, 0x0230}] = Info{Tag{0x300A, 0x0230}, "SQ", "ApplicationSetupSequence", "1"}
-	tagDict[Tag{0x300A, 0x0232}] = Info{Tag{0x300A, 0x0232}, "CS", "ApplicationSetupType", "1"}
-	tagDict[Tag{0x300A, 0x0234}] = Info{Tag{0x300A, 0x0234}, "IS", "ApplicationSetupNumber", "1"}
-	tagDict[Tag{0x300A, 0x0236}] = Info{Tag{0x300A, 0x0236}, "LO", "ApplicationSetupName", "1"}
-	tagDict[Tag{0x300A, 0x0238}] = Info{Tag{0x300A, 0x0238}, "LO", "ApplicationSetupManufacturer", "1"}
-	tagDict[Tag{0x300A, 0x0240}] = Info{Tag{0x300A, 0x0240}, "IS", "TemplateNumber", "1"}
-	tagDict[Tag{0x300A, 0x0242}] = Info{Tag{0x300A, 0x0242}, "SH", "TemplateType", "1"}
-	tagDict[Tag{0x300A, 0x0244}] = Info{Tag{0x300A, 0x0244}, "LO", "TemplateName", "1"}
-	tagDict[Tag{0x300A, 0x0250}] = Info{Tag{0x300A, 0x0250}, "DS", "TotalReferenceAirKerma", "1"}
-	tagDict[Tag{0x300A, 0x0260}] = Info{Tag{0x300A, 0x0260}, "SQ", "BrachyAccessoryDeviceSequence", "1"}
-	tagDict[Tag{0x300A, 0x0262}] = Info{Tag{0x300A, 0x0262}, "IS", "BrachyAccessoryDeviceNumber", "1"}
-	tagDict[Tag{0x300A, 0x0263}] = Info{Tag{0x300A, 0x0263}, "SH", "BrachyAccessoryDeviceID", "1"}
-	tagDict[Tag{0x300A, 0x0264}] = Info{Tag{0x300A, 0x0264}, "CS", "BrachyAccessoryDeviceType", "1"}
-	tagDict[Tag{0x300A, 0x0266}] = Info{Tag{0x300A, 0x0266}, "LO", "BrachyAccessoryDeviceName", "1"}
-	tagDict[Tag{0x300A, 0x026A}] = Info{Tag{0x300A, 0x026A}, "DS", "BrachyAccessoryDeviceNominalThickness", "1"}
-	tagDict[Tag{0x300A, 0x026C}] = Info{Tag{0x300A, 0x026C}, "DS", "BrachyAccessoryDeviceNominalTransmission", "1"}
-	tagDict[Tag{0x300A, 0x0280}] = Info{Tag{0x300A, 0x0280}, "SQ", "ChannelSequence", "1"}
-	tagDict[Tag{0x300A, 0x0282}] = Info{Tag{0x300A, 0x0282}, "IS", "ChannelNumber", "1"}
-	tagDict[Tag{0x300A, 0x0284}] = Info{Tag{0x300A, 0x0284}, "DS", "ChannelLength", "1"}
-	tagDict[Tag{0x300A, 0x0286}] = Info{Tag{0x300A, 0x0286}, "DS", "ChannelTotalTime", "1"}
-	tagDict[Tag{0x300A, 0x0288}] = Info{Tag{0x300A, 0x0288}, "CS", "SourceMovementType", "1"}
-	tagDict[Tag{0x300A, 0x028A}] = Info{Tag{0x300A, 0x028A}, "IS", "NumberOfPulses", "1"}
-	tagDict[Tag{0x300A, 0x028C}] = Info{Tag{0x300A, 0x028C}, "DS", "PulseRepetitionInterval", "1"}
-	tagDict[Tag{0x300A, 0x0290}] = Info{Tag{0x300A, 0x0290}, "IS", "SourceApplicatorNumber", "1"}
-	tagDict[Tag{0x300A, 0x0291}] = Info{Tag{0x300A, 0x0291}, "SH", "SourceApplicatorID", "1"}
-	tagDict[Tag{0x300A, 0x0292}] = Info{Tag{0x300A, 0x0292}, "CS", "SourceApplicatorType", "1"}
-	tagDict[Tag{0x300A, 0x0294}] = Info{Tag{0x300A, 0x0294}, "LO", "SourceApplicatorName", "1"}
-	tagDict[Tag{0x300A, 0x0296}] = Info{Tag{0x300A, 0x0296}, "DS", "SourceApplicatorLength", "1"}
-	tagDict[Tag{0x300A, 0x0298}] = Info{Tag{0x300A, 0x0298}, "LO", "SourceApplicatorManufacturer", "1"}
-	tagDict[Tag{0x300A, 0x029C}] = Info{Tag{0x300A, 0x029C}, "DS", "SourceApplicatorWallNominalThickness", "1"}
-	tagDict[Tag{0x300A, 0x029E}] = Info{Tag{0x300A, 0x029E}, "DS", "SourceApplicatorWallNominalTransmission", "1"}
-	tagDict[Tag{0x300A, 0x02A0}] = Info{Tag{0x300A, 0x02A0}, "DS", "SourceApplicatorStepSize", "1"}
-	tagDict[Tag{0x300A, 0x02A2}] = Info{Tag{0x300A, 0x02A2}, "IS", "TransferTubeNumber", "1"}
-	tagDict[Tag{0x300A, 0x02A4}] = Info{Tag{0x300A, 0x02A4}, "DS", "TransferTubeLength", "1"}
-	tagDict[Tag{0x300A, 0x02B0}] = Info{Tag{0x300A, 0x02B0}, "SQ", "ChannelShieldSequence", "1"}
-	tagDict[Tag{0x300A, 0x02B2}] = Info{Tag{0x300A, 0x02B2}, "IS", "ChannelShieldNumber", "1"}
-	tagDict[Tag{0x300A, 0x02B3}] = Info{Tag{0x300A, 0x02B3}, "SH", "ChannelShieldID", "1"}
-	tagDict[Tag{0x300A, 0x02B4}] = Info{Tag{0x300A, 0x02B4}, "LO", "ChannelShieldName", "1"}
-	tagDict[Tag{0x300A, 0x02B8}] = Info{Tag{0x300A, 0x02B8}, "DS", "ChannelShieldNominalThickness", "1"}
-	tagDict[Tag{0x300A, 0x02BA}] = Info{Tag{0x300A, 0x02BA}, "DS", "ChannelShieldNominalTransmission", "1"}
-	tagDict[Tag{0x300A, 0x02C8}] = Info{Tag{0x300A, 0x02C8}, "DS", "FinalCumulativeTimeWeight", "1"}
-	tagDict[Tag{0x300A, 0x02D0}] = Info{Tag{0x300A, 0x02D0}, "SQ", "BrachyControlPointSequence", "1"}
-	tagDict[Tag{0x300A, 0x02D2}] = Info{Tag{0x300A, 0x02D2}, "DS", "ControlPointRelativePosition", "1"}
-	tagDict[Tag{0x300A, 0x02D4}] = Info{Tag{0x300A, 0x02D4}, "DS", "ControlPoint3DPosition", "3"}
-	tagDict[Tag{0x300A, 0x02D6}] = Info{Tag{0x300A, 0x02D6}, "DS", "CumulativeTimeWeight", "1"}
-	tagDict[Tag{0x300A, 0x02E0}] = Info{Tag{0x300A, 0x02E0}, "CS", "CompensatorDivergence", "1"}
-	tagDict[Tag{0x300A, 0x02E1}] = Info{Tag{0x300A, 0x02E1}, "CS", "CompensatorMountingPosition", "1"}
-	tagDict[Tag{0x300A, 0x02E2}] = Info{Tag{0x300A, 0x02E2}, "DS", "SourceToCompensatorDistance", "1-n"}
-	tagDict[Tag{0x300A, 0x02E3}] = Info{Tag{0x300A, 0x02E3}, "FL", "TotalCompensatorTrayWaterEquivalentThickness", "1"}
-	tagDict[Tag{0x300A, 0x02E4}] = Info{Tag{0x300A, 0x02E4}, "FL", "IsocenterToCompensatorTrayDistance", "1"}
-	tagDict[Tag{0x300A, 0x02E5}] = Info{Tag{0x300A, 0x02E5}, "FL", "CompensatorColumnOffset", "1"}
-	tagDict[Tag{0x300A, 0x02E6}] = Info{Tag{0x300A, 0x02E6}, "FL", "IsocenterToCompensatorDistances", "1-n"}
-	tagDict[Tag{0x300A, 0x02E7}] = Info{Tag{0x300A, 0x02E7}, "FL", "CompensatorRelativeStoppingPowerRatio", "1"}
-	tagDict[Tag{0x300A, 0x02E8}] = Info{Tag{0x300A, 0x02E8}, "FL", "CompensatorMillingToolDiameter", "1"}
-	tagDict[Tag{0x300A, 0x02EA}] = Info{Tag{0x300A, 0x02EA}, "SQ", "IonRangeCompensatorSequence", "1"}
-	tagDict[Tag{0x300A, 0x02EB}] = Info{Tag{0x300A, 0x02EB}, "LT", "CompensatorDescription", "1"}
-	tagDict[Tag{0x300A, 0x0302}] = Info{Tag{0x300A, 0x0302}, "IS", "RadiationMassNumber", "1"}
-	tagDict[Tag{0x300A, 0x0304}] = Info{Tag{0x300A, 0x0304}, "IS", "RadiationAtomicNumber", "1"}
-	tagDict[Tag{0x300A, 0x0306}] = Info{Tag{0x300A, 0x0306}, "SS", "RadiationChargeState", "1"}
-	tagDict[Tag{0x300A, 0x0308}] = Info{Tag{0x300A, 0x0308}, "CS", "ScanMode", "1"}
-	tagDict[Tag{0x300A, 0x030A}] = Info{Tag{0x300A, 0x030A}, "FL", "VirtualSourceAxisDistances", "2"}
-	tagDict[Tag{0x300A, 0x030C}] = Info{Tag{0x300A, 0x030C}, "SQ", "SnoutSequence", "1"}
-	tagDict[Tag{0x300A, 0x030D}] = Info{Tag{0x300A, 0x030D}, "FL", "SnoutPosition", "1"}
-	tagDict[Tag{0x300A, 0x030F}] = Info{Tag{0x300A, 0x030F}, "SH", "SnoutID", "1"}
-	tagDict[Tag{0x300A, 0x0312}] = Info{Tag{0x300A, 0x0312}, "IS", "NumberOfRangeShifters", "1"}
-	tagDict[Tag{0x300A, 0x0314}] = Info{Tag{0x300A, 0x0314}, "SQ", "RangeShifterSequence", "1"}
-	tagDict[Tag{0x300A, 0x0316}] = Info{Tag{0x300A, 0x0316}, "IS", "RangeShifterNumber", "1"}
-	tagDict[Tag{0x300A, 0x0318}] = Info{Tag{0x300A, 0x0318}, "SH", "RangeShifterID", "1"}
-	tagDict[Tag{0x300A, 0x0320}] = Info{Tag{0x300A, 0x0320}, "CS", "RangeShifterType", "1"}
-	tagDict[Tag{0x300A, 0x0322}] = Info{Tag{0x300A, 0x0322}, "LO", "RangeShifterDescription", "1"}
-	tagDict[Tag{0x300A, 0x0330}] = Info{Tag{0x300A, 0x0330}, "IS", "NumberOfLateralSpreadingDevices", "1"}
-	tagDict[Tag{0x300A, 0x0332}] = Info{Tag{0x300A, 0x0332}, "SQ", "LateralSpreadingDeviceSequence", "1"}
-	tagDict[Tag{0x300A, 0x0334}] = Info{Tag{0x300A, 0x0334}, "IS", "LateralSpreadingDeviceNumber", "1"}
-	tagDict[Tag{0x300A, 0x0336}] = Info{Tag{0x300A, 0x0336}, "SH", "LateralSpreadingDeviceID", "1"}
-	tagDict[Tag{0x300A, 0x0338}] = Info{Tag{0x300A, 0x0338}, "CS", "LateralSpreadingDeviceType", "1"}
-	tagDict[Tag{0x300A, 0x033A}] = Info{Tag{0x300A, 0x033A}, "LO", "LateralSpreadingDeviceDescription", "1"}
-	tagDict[Tag{0x300A, 0x033C}] = Info{Tag{0x300A, 0x033C}, "FL", "LateralSpreadingDeviceWaterEquivalentThickness", "1"}
-	tagDict[Tag{0x300A, 0x0340}] = Info{Tag{0x300A, 0x0340}, "IS", "NumberOfRangeModulators", "1"}
-	tagDict[Tag{0x300A, 0x0342}] = Info{Tag{0x300A, 0x0342}, "SQ", "RangeModulatorSequence", "1"}
-	tagDict[Tag{0x300A, 0x0344}] = Info{Tag{0x300A, 0x0344}, "IS", "RangeModulatorNumber", "1"}
-	tagDict[Tag{0x300A, 0x0346}] = Info{Tag{0x300A, 0x0346}, "SH", "RangeModulatorID", "1"}
-	tagDict[Tag{0x300A, 0x0348}] = Info{Tag{0x300A, 0x0348}, "CS", "RangeModulatorType", "1"}
-	tagDict[Tag{0x300A, 0x034A}] = Info{Tag{0x300A, 0x034A}, "LO", "RangeModulatorDescription", "1"}
-	tagDict[Tag{0x300A, 0x034C}] = Info{Tag{0x300A, 0x034C}, "SH", "BeamCurrentModulationID", "1"}
-	tagDict[Tag{0x300A, 0x0350}] = Info{Tag{0x300A, 0x0350}, "CS", "PatientSupportType", "1"}
-	tagDict[Tag{0x300A, 0x0352}] = Info{Tag{0x300A, 0x0352}, "SH", "PatientSupportID", "1"}
-	tagDict[Tag{0x300A, 0x0354}] = Info{Tag{0x300A, 0x0354}, "LO", "PatientSupportAccessoryCode", "1"}
-	tagDict[Tag{0x300A, 0x0356}] = Info{Tag{0x300A, 0x0356}, "FL", "FixationLightAzimuthalAngle", "1"}
-	tagDict[Tag{0x300A, 0x0358}] = Info{Tag{0x300A, 0x0358}, "FL", "FixationLightPolarAngle", "1"}
-	tagDict[Tag{0x300A, 0x035A}] = Info{Tag{0x300A, 0x035A}, "FL", "MetersetRate", "1"}
-	tagDict[Tag{0x300A, 0x0360}] = Info{Tag{0x300A, 0x0360}, "SQ", "RangeShifterSettingsSequence", "1"}
-	tagDict[Tag{0x300A, 0x0362}] = Info{Tag{0x300A, 0x0362}, "LO", "RangeShifterSetting", "1"}
-	tagDict[Tag{0x300A, 0x0364}] = Info{Tag{0x300A, 0x0364}, "FL", "IsocenterToRangeShifterDistance", "1"}
-	tagDict[Tag{0x300A, 0x0366}] = Info{Tag{0x300A, 0x0366}, "FL", "RangeShifterWaterEquivalentThickness", "1"}
-	tagDict[Tag{0x300A, 0x0370}] = Info{Tag{0x300A, 0x0370}, "SQ", "LateralSpreadingDeviceSettingsSequence", "1"}
-	tagDict[Tag{0x300A, 0x0372}] = Info{Tag{0x300A, 0x0372}, "LO", "LateralSpreadingDeviceSetting", "1"}
-	tagDict[Tag{0x300A, 0x0374}] = Info{Tag{0x300A, 0x0374}, "FL", "IsocenterToLateralSpreadingDeviceDistance", "1"}
-	tagDict[Tag{0x300A, 0x0380}] = Info{Tag{0x300A, 0x0380}, "SQ", "RangeModulatorSettingsSequence", "1"}
-	tagDict[Tag{0x300A, 0x0382}] = Info{Tag{0x300A, 0x0382}, "FL", "RangeModulatorGatingStartValue", "1"}
-	tagDict[Tag{0x300A, 0x0384}] = Info{Tag{0x300A, 0x0384}, "FL", "RangeModulatorGatingStopValue", "1"}
-	tagDict[Tag{0x300A, 0x0386}] = Info{Tag{0x300A, 0x0386}, "FL", "RangeModulatorGatingStartWaterEquivalentThickness", "1"}
-	tagDict[Tag{0x300A, 0x0388}] = Info{Tag{0x300A, 0x0388}, "FL", "RangeModulatorGatingStopWaterEquivalentThickness", "1"}
-	tagDict[Tag{0x300A, 0x038A}] = Info{Tag{0x300A, 0x038A}, "FL", "IsocenterToRangeModulatorDistance", "1"}
-	tagDict[Tag{0x300A, 0x0390}] = Info{Tag{0x300A, 0x0390}, "SH", "ScanSpotTuneID", "1"}
-	tagDict[Tag{0x300A, 0x0392}] = Info{Tag{0x300A, 0x0392}, "IS", "NumberOfScanSpotPositions", "1"}
-	tagDict[Tag{0x300A, 0x0394}] = Info{Tag{0x300A, 0x0394}, "FL", "ScanSpotPositionMap", "1-n"}
-	tagDict[Tag{0x300A, 0x0396}] = Info{Tag{0x300A, 0x0396}, "FL", "ScanSpotMetersetWeights", "1-n"}
-	tagDict[Tag{0x300A, 0x0398}] = Info{Tag{0x300A, 0x0398}, "FL", "ScanningSpotSize", "2"}
-	tagDict[Tag{0x300A, 0x039A}] = Info{Tag{0x300A, 0x039A}, "IS", "NumberOfPaintings", "1"}
-	tagDict[Tag{0x300A, 0x03A0}] = Info{Tag{0x300A, 0x03A0}, "SQ", "IonToleranceTableSequence", "1"}
-	tagDict[Tag{0x300A, 0x03A2}] = Info{Tag{0x300A, 0x03A2}, "SQ", "IonBeamSequence", "1"}
-	tagDict[Tag{0x300A, 0x03A4}] = Info{Tag{0x300A, 0x03A4}, "SQ", "IonBeamLimitingDeviceSequence", "1"}
-	tagDict[Tag{0x300A, 0x03A6}] = Info{Tag{0x300A, 0x03A6}, "SQ", "IonBlockSequence", "1"}
-	tagDict[Tag{0x300A, 0x03A8}] = Info{Tag{0x300A, 0x03A8}, "SQ", "IonControlPointSequence", "1"}
-	tagDict[Tag{0x300A, 0x03AA}] = Info{Tag{0x300A, 0x03AA}, "SQ", "IonWedgeSequence", "1"}
-	tagDict[Tag{0x300A, 0x03AC}] = Info{Tag{0x300A, 0x03AC}, "SQ", "IonWedgePositionSequence", "1"}
-	tagDict[Tag{0x300A, 0x0401}] = Info{Tag{0x300A, 0x0401}, "SQ", "ReferencedSetupImageSequence", "1"}
-	tagDict[Tag{0x300A, 0x0402}] = Info{Tag{0x300A, 0x0402}, "ST", "SetupImageComment", "1"}
-	tagDict[Tag{0x300A, 0x0410}] = Info{Tag{0x300A, 0x0410}, "SQ", "MotionSynchronizationSequence", "1"}
-	tagDict[Tag{0x300A, 0x0412}] = Info{Tag{0x300A, 0x0412}, "FL", "ControlPointOrientation", "3"}
-	tagDict[Tag{0x300A, 0x0420}] = Info{Tag{0x300A, 0x0420}, "SQ", "GeneralAccessorySequence", "1"}
-	tagDict[Tag{0x300A, 0x0421}] = Info{Tag{0x300A, 0x0421}, "SH", "GeneralAccessoryID", "1"}
-	tagDict[Tag{0x300A, 0x0422}] = Info{Tag{0x300A, 0x0422}, "ST", "GeneralAccessoryDescription", "1"}
-	tagDict[Tag{0x300A, 0x0423}] = Info{Tag{0x300A, 0x0423}, "CS", "GeneralAccessoryType", "1"}
-	tagDict[Tag{0x300A, 0x0424}] = Info{Tag{0x300A, 0x0424}, "IS", "GeneralAccessoryNumber", "1"}
-	tagDict[Tag{0x300A, 0x0431}] = Info{Tag{0x300A, 0x0431}, "SQ", "ApplicatorGeometrySequence", "1"}
-	tagDict[Tag{0x300A, 0x0432}] = Info{Tag{0x300A, 0x0432}, "CS", "ApplicatorApertureShape", "1"}
-	tagDict[Tag{0x300A, 0x0433}] = Info{Tag{0x300A, 0x0433}, "FL", "ApplicatorOpening", "1"}
-	tagDict[Tag{0x300A, 0x0434}] = Info{Tag{0x300A, 0x0434}, "FL", "ApplicatorOpeningX", "1"}
-	tagDict[Tag{0x300A, 0x0435}] = Info{Tag{0x300A, 0x0435}, "FL", "ApplicatorOpeningY", "1"}
-	tagDict[Tag{0x300A, 0x0436}] = Info{Tag{0x300A, 0x0436}, "FL", "SourceToApplicatorMountingPositionDistance", "1"}
-	tagDict[Tag{0x300C, 0x0002}] = Info{Tag{0x300C, 0x0002}, "SQ", "ReferencedRTPlanSequence", "1"}
-	tagDict[Tag{0x300C, 0x0004}] = Info{Tag{0x300C, 0x0004}, "SQ", "ReferencedBeamSequence", "1"}
-	tagDict[Tag{0x300C, 0x0006}] = Info{Tag{0x300C, 0x0006}, "IS", "ReferencedBeamNumber", "1"}
-	tagDict[Tag{0x300C, 0x0007}] = Info{Tag{0x300C, 0x0007}, "IS", "ReferencedReferenceImageNumber", "1"}
-	tagDict[Tag{0x300C, 0x0008}] = Info{Tag{0x300C, 0x0008}, "DS", "StartCumulativeMetersetWeight", "1"}
-	tagDict[Tag{0x300C, 0x0009}] = Info{Tag{0x300C, 0x0009}, "DS", "EndCumulativeMetersetWeight", "1"}
-	tagDict[Tag{0x300C, 0x000A}] = Info{Tag{0x300C, 0x000A}, "SQ", "ReferencedBrachyApplicationSetupSequence", "1"}
-	tagDict[Tag{0x300C, 0x000C}] = Info{Tag{0x300C, 0x000C}, "IS", "ReferencedBrachyApplicationSetupNumber", "1"}
-	tagDict[Tag{0x300C, 0x000E}] = Info{Tag{0x300C, 0x000E}, "IS", "ReferencedSourceNumber", "1"}
-	tagDict[Tag{0x300C, 0x0020}] = Info{Tag{0x300C, 0x0020}, "SQ", "ReferencedFractionGroupSequence", "1"}
-	tagDict[Tag{0x300C, 0x0022}] = Info{Tag{0x300C, 0x0022}, "IS", "ReferencedFractionGroupNumber", "1"}
-	tagDict[Tag{0x300C, 0x0040}] = Info{Tag{0x300C, 0x0040}, "SQ", "ReferencedVerificationImageSequence", "1"}
-	tagDict[Tag{0x300C, 0x0042}] = Info{Tag{0x300C, 0x0042}, "SQ", "ReferencedReferenceImageSequence", "1"}
-	tagDict[Tag{0x300C, 0x0050}] = Info{Tag{0x300C, 0x0050}, "SQ", "ReferencedDoseReferenceSequence", "1"}
-	tagDict[Tag{0x300C, 0x0051}] = Info{Tag{0x300C, 0x0051}, "IS", "ReferencedDoseReferenceNumber", "1"}
-	tagDict[Tag{0x300C, 0x0055}] = Info{Tag{0x300C, 0x0055}, "SQ", "BrachyReferencedDoseReferenceSequence", "1"}
-	tagDict[Tag{0x300C, 0x0060}] = Info{Tag{0x300C, 0x0060}, "SQ", "ReferencedStructureSetSequence", "1"}
-	tagDict[Tag{0x300C, 0x006A}] = Info{Tag{0x300C, 0x006A}, "IS", "ReferencedPatientSetupNumber", "1"}
-	tagDict[Tag{0x300C, 0x0080}] = Info{Tag{0x300C, 0x0080}, "SQ", "ReferencedDoseSequence", "1"}
-	tagDict[Tag{0x300C, 0x00A0}] = Info{Tag{0x300C, 0x00A0}, "IS", "ReferencedToleranceTableNumber", "1"}
-	tagDict[Tag{0x300C, 0x00B0}] = Info{Tag{0x300C, 0x00B0}, "SQ", "ReferencedBolusSequence", "1"}
-	tagDict[Tag{0x300C, 0x00C0}] = Info{Tag{0x300C, 0x00C0}, "IS", "ReferencedWedgeNumber", "1"}
-	tagDict[Tag{0x300C, 0x00D0}] = Info{Tag{0x300C, 0x00D0}, "IS", "ReferencedCompensatorNumber", "1"}
-	tagDict[Tag{0x300C, 0x00E0}] = Info{Tag{0x300C, 0x00E0}, "IS", "ReferencedBlockNumber", "1"}
-	tagDict[Tag{0x300C, 0x00F0}] = Info{Tag{0x300C, 0x00F0}, "IS", "ReferencedControlPointIndex", "1"}
-	tagDict[Tag{0x300C, 0x00F2}] = Info{Tag{0x300C, 0x00F2}, "SQ", "ReferencedControlPointSequence", "1"}
-	tagDict[Tag{0x300C, 0x00F4}] = Info{Tag{0x300C, 0x00F4}, "IS", "ReferencedStartControlPointIndex", "1"}
-	tagDict[Tag{0x300C, 0x00F6}] = Info{Tag{0x300C, 0x00F6}, "IS", "ReferencedStopControlPointIndex", "1"}
-	tagDict[Tag{0x300C, 0x0100}] = Info{Tag{0x300C, 0x0100}, "IS", "ReferencedRangeShifterNumber", "1"}
-	tagDict[Tag{0x300C, 0x0102}] = Info{Tag{0x300C, 0x0102}, "IS", "ReferencedLateralSpreadingDeviceNumber", "1"}
-	tagDict[Tag{0x300C, 0x0104}] = Info{Tag{0x300C, 0x0104}, "IS", "ReferencedRangeModulatorNumber", "1"}
-	tagDict[Tag{0x300E, 0x0002}] = Info{Tag{0x300E, 0x0002}, "CS", "ApprovalStatus", "1"}
-	tagDict[Tag{0x300E, 0x0004}] = Info{Tag{0x300E, 0x0004}, "DA", "ReviewDate", "1"}
-	tagDict[Tag{0x300E, 0x0005}] = Info{Tag{0x300E, 0x0005}, "TM", "ReviewTime", "1"}
-	tagDict[Tag{0x300E, 0x0008}] = Info{Tag{0x300E, 0x0008}, "PN", "ReviewerName", "1"}
-	tagDict[Tag{0x4010, 0x0001}] = Info{Tag{0x4010, 0x0001}, "CS", "LowEnergyDetectors", "1"}
-	tagDict[Tag{0x4010, 0x0002}] = Info{Tag{0x4010, 0x0002}, "CS", "HighEnergyDetectors", "1"}
-	tagDict[Tag{0x4010, 0x0004}] = Info{Tag{0x4010, 0x0004}, "SQ", "DetectorGeometrySequence", "1"}
-	tagDict[Tag{0x4010, 0x1001}] = Info{Tag{0x4010, 0x1001}, "SQ", "ThreatROIVoxelSequence", "1"}
-	tagDict[Tag{0x4010, 0x1004}] = Info{Tag{0x4010, 0x1004}, "FL", "ThreatROIBase", "3"}
-	tagDict[Tag{0x4010, 0x1005}] = Info{Tag{0x4010, 0x1005}, "FL", "ThreatROIExtents", "3"}
-	tagDict[Tag{0x4010, 0x1006}] = Info{Tag{0x4010, 0x1006}, "OB", "ThreatROIBitmap", "1"}
-	tagDict[Tag{0x4010, 0x1007}] = Info{Tag{0x4010, 0x1007}, "SH", "RouteSegmentID", "1"}
-	tagDict[Tag{0x4010, 0x1008}] = Info{Tag{0x4010, 0x1008}, "CS", "GantryType", "1"}
-	tagDict[Tag{0x4010, 0x1009}] = Info{Tag{0x4010, 0x1009}, "CS", "OOIOwnerType", "1"}
-	tagDict[Tag{0x4010, 0x100A}] = Info{Tag{0x4010, 0x100A}, "SQ", "RouteSegmentSequence", "1"}
-	tagDict[Tag{0x4010, 0x1010}] = Info{Tag{0x4010, 0x1010}, "US", "PotentialThreatObjectID", "1"}
-	tagDict[Tag{0x4010, 0x1011}] = Info{Tag{0x4010, 0x1011}, "SQ", "ThreatSequence", "1"}
-	tagDict[Tag{0x4010, 0x1012}] = Info{Tag{0x4010, 0x1012}, "CS", "ThreatCategory", "1"}
-	tagDict[Tag{0x4010, 0x1013}] = Info{Tag{0x4010, 0x1013}, "LT", "ThreatCategoryDescription", "1"}
-	tagDict[Tag{0x4010, 0x1014}] = Info{Tag{0x4010, 0x1014}, "CS", "ATDAbilityAssessment", "1"}
-	tagDict[Tag{0x4010, 0x1015}] = Info{Tag{0x4010, 0x1015}, "CS", "ATDAssessmentFlag", "1"}
-	tagDict[Tag{0x4010, 0x1016}] = Info{Tag{0x4010, 0x1016}, "FL", "ATDAssessmentProbability", "1"}
-	tagDict[Tag{0x4010, 0x1017}] = Info{Tag{0x4010, 0x1017}, "FL", "Mass", "1"}
-	tagDict[Tag{0x4010, 0x1018}] = Info{Tag{0x4010, 0x1018}, "FL", "Density", "1"}
-	tagDict[Tag{0x4010, 0x1019}] = Info{Tag{0x4010, 0x1019}, "FL", "ZEffective", "1"}
-	tagDict[Tag{0x4010, 0x101A}] = Info{Tag{0x4010, 0x101A}, "SH", "BoardingPassID", "1"}
-	tagDict[Tag{0x4010, 0x101B}] = Info{Tag{0x4010, 0x101B}, "FL", "CenterOfMass", "3"}
-	tagDict[Tag{0x4010, 0x101C}] = Info{Tag{0x4010, 0x101C}, "FL", "CenterOfPTO", "3"}
-	tagDict[Tag{0x4010, 0x101D}] = Info{Tag{0x4010, 0x101D}, "FL", "BoundingPolygon", "6-n"}
-	tagDict[Tag{0x4010, 0x101E}] = Info{Tag{0x4010, 0x101E}, "SH", "RouteSegmentStartLocationID", "1"}
-	tagDict[Tag{0x4010, 0x101F}] = Info{Tag{0x4010, 0x101F}, "SH", "RouteSegmentEndLocationID", "1"}
-	tagDict[Tag{0x4010, 0x1020}] = Info{Tag{0x4010, 0x1020}, "CS", "RouteSegmentLocationIDType", "1"}
-	tagDict[Tag{0x4010, 0x1021}] = Info{Tag{0x4010, 0x1021}, "CS", "AbortReason", "1-n"}
-	tagDict[Tag{0x4010, 0x1023}] = Info{Tag{0x4010, 0x1023}, "FL", "VolumeOfPTO", "1"}
-	tagDict[Tag{0x4010, 0x1024}] = Info{Tag{0x4010, 0x1024}, "CS", "AbortFlag", "1"}
-	tagDict[Tag{0x4010, 0x1025}] = Info{Tag{0x4010, 0x1025}, "DT", "RouteSegmentStartTime", "1"}
-	tagDict[Tag{0x4010, 0x1026}] = Info{Tag{0x4010, 0x1026}, "DT", "RouteSegmentEndTime", "1"}
-	tagDict[Tag{0x4010, 0x1027}] = Info{Tag{0x4010, 0x1027}, "CS", "TDRType", "1"}
-	tagDict[Tag{0x4010, 0x1028}] = Info{Tag{0x4010, 0x1028}, "CS", "InternationalRouteSegment", "1"}
-	tagDict[Tag{0x4010, 0x1029}] = Info{Tag{0x4010, 0x1029}, "LO", "ThreatDetectionAlgorithmandVersion", "1-n"}
-	tagDict[Tag{0x4010, 0x102A}] = Info{Tag{0x4010, 0x102A}, "SH", "AssignedLocation", "1"}
-	tagDict[Tag{0x4010, 0x102B}] = Info{Tag{0x4010, 0x102B}, "DT", "AlarmDecisionTime", "1"}
-	tagDict[Tag{0x4010, 0x1031}] = Info{Tag{0x4010, 0x1031}, "CS", "AlarmDecision", "1"}
-	tagDict[Tag{0x4010, 0x1033}] = Info{Tag{0x4010, 0x1033}, "US", "NumberOfTotalObjects", "1"}
-	tagDict[Tag{0x4010, 0x1034}] = Info{Tag{0x4010, 0x1034}, "US", "NumberOfAlarmObjects", "1"}
-	tagDict[Tag{0x4010, 0x1037}] = Info{Tag{0x4010, 0x1037}, "SQ", "PTORepresentationSequence", "1"}
-	tagDict[Tag{0x4010, 0x1038}] = Info{Tag{0x4010, 0x1038}, "SQ", "ATDAssessmentSequence", "1"}
-	tagDict[Tag{0x4010, 0x1039}] = Info{Tag{0x4010, 0x1039}, "CS", "TIPType", "1"}
-	tagDict[Tag{0x4010, 0x103A}] = Info{Tag{0x4010, 0x103A}, "CS", "DICOSVersion", "1"}
-	tagDict[Tag{0x4010, 0x1041}] = Info{Tag{0x4010, 0x1041}, "DT", "OOIOwnerCreationTime", "1"}
-	tagDict[Tag{0x4010, 0x1042}] = Info{Tag{0x4010, 0x1042}, "CS", "OOIType", "1"}
-	tagDict[Tag{0x4010, 0x1043}] = Info{Tag{0x4010, 0x1043}, "FL", "OOISize", "3"}
-	tagDict[Tag{0x4010, 0x1044}] = Info{Tag{0x4010, 0x1044}, "CS", "AcquisitionStatus", "1"}
-	tagDict[Tag{0x4010, 0x1045}] = Info{Tag{0x4010, 0x1045}, "SQ", "BasisMaterialsCodeSequence", "1"}
-	tagDict[Tag{0x4010, 0x1046}] = Info{Tag{0x4010, 0x1046}, "CS", "PhantomType", "1"}
-	tagDict[Tag{0x4010, 0x1047}] = Info{Tag{0x4010, 0x1047}, "SQ", "OOIOwnerSequence", "1"}
-	tagDict[Tag{0x4010, 0x1048}] = Info{Tag{0x4010, 0x1048}, "CS", "ScanType", "1"}
-	tagDict[Tag{0x4010, 0x1051}] = Info{Tag{0x4010, 0x1051}, "LO", "ItineraryID", "1"}
-	tagDict[Tag{0x4010, 0x1052}] = Info{Tag{0x4010, 0x1052}, "SH", "ItineraryIDType", "1"}
-	tagDict[Tag{0x4010, 0x1053}] = Info{Tag{0x4010, 0x1053}, "LO", "ItineraryIDAssigningAuthority", "1"}
-	tagDict[Tag{0x4010, 0x1054}] = Info{Tag{0x4010, 0x1054}, "SH", "RouteID", "1"}
-	tagDict[Tag{0x4010, 0x1055}] = Info{Tag{0x4010, 0x1055}, "SH", "RouteIDAssigningAuthority", "1"}
-	tagDict[Tag{0x4010, 0x1056}] = Info{Tag{0x4010, 0x1056}, "CS", "InboundArrivalType", "1"}
-	tagDict[Tag{0x4010, 0x1058}] = Info{Tag{0x4010, 0x1058}, "SH", "CarrierID", "1"}
-	tagDict[Tag{0x4010, 0x1059}] = Info{Tag{0x4010, 0x1059}, "CS", "CarrierIDAssigningAuthority", "1"}
-	tagDict[Tag{0x4010, 0x1060}] = Info{Tag{0x4010, 0x1060}, "FL", "SourceOrientation", "3"}
-	tagDict[Tag{0x4010, 0x1061}] = Info{Tag{0x4010, 0x1061}, "FL", "SourcePosition", "3"}
-	tagDict[Tag{0x4010, 0x1062}] = Info{Tag{0x4010, 0x1062}, "FL", "BeltHeight", "1"}
-	tagDict[Tag{0x4010, 0x1064}] = Info{Tag{0x4010, 0x1064}, "SQ", "AlgorithmRoutingCodeSequence", "1"}
-	tagDict[Tag{0x4010, 0x1067}] = Info{Tag{0x4010, 0x1067}, "CS", "TransportClassification", "1"}
-	tagDict[Tag{0x4010, 0x1068}] = Info{Tag{0x4010, 0x1068}, "LT", "OOITypeDescriptor", "1"}
-	tagDict[Tag{0x4010, 0x1069}] = Info{Tag{0x4010, 0x1069}, "FL", "TotalProcessingTime", "1"}
-	tagDict[Tag{0x4010, 0x106C}] = Info{Tag{0x4010, 0x106C}, "OB", "DetectorCalibrationData", "1"}
-	tagDict[Tag{0x4FFE, 0x0001}] = Info{Tag{0x4FFE, 0x0001}, "SQ", "MACParametersSequence", "1"}
-	tagDict[Tag{0x5200, 0x9229}] = Info{Tag{0x5200, 0x9229}, "SQ", "SharedFunctionalGroupsSequence", "1"}
-	tagDict[Tag{0x5200, 0x9230}] = Info{Tag{0x5200, 0x9230}, "SQ", "PerFrameFunctionalGroupsSequence", "1"}
-	tagDict[Tag{0x5400, 0x0100}] = Info{Tag{0x5400, 0x0100}, "SQ", "WaveformSequence", "1"}
-	tagDict[Tag{0x5400, 0x0110}] = Info{Tag{0x5400, 0x0110}, "OW", "ChannelMinimumValue", "1"}
-	tagDict[Tag{0x5400, 0x0112}] = Info{Tag{0x5400, 0x0112}, "OW", "ChannelMaximumValue", "1"}
-	tagDict[Tag{0x5400, 0x1004}] = Info{Tag{0x5400, 0x1004}, "US", "WaveformBitsAllocated", "1"}
-	tagDict[Tag{0x5400, 0x1006}] = Info{Tag{0x5400, 0x1006}, "CS", "WaveformSampleInterpretation", "1"}
-	tagDict[Tag{0x5400, 0x100A}] = Info{Tag{0x5400, 0x100A}, "OW", "WaveformPaddingValue", "1"}
-	tagDict[Tag{0x5400, 0x1010}] = Info{Tag{0x5400, 0x1010}, "OW", "WaveformData", "1"}
-	tagDict[Tag{0x5600, 0x0010}] = Info{Tag{0x5600, 0x0010}, "OF", "FirstOrderPhaseCorrectionAngle", "1"}
-	tagDict[Tag{0x5600, 0x0020}] = Info{Tag{0x5600, 0x0020}, "OF", "SpectroscopyData", "1"}
-	tagDict[Tag{0x7FE0, 0x0010}] = Info{Tag{0x7FE0, 0x0010}, "OW", "PixelData", "1"}
-	tagDict[Tag{0xFFFA, 0xFFFA}] = Info{Tag{0xFFFA, 0xFFFA}, "SQ", "DigitalSignaturesSequence", "1"}
-	tagDict[Tag{0xFFFC, 0xFFFC}] = Info{Tag{0xFFFC, 0xFFFC}, "OB", "DataSetTrailingPadding", "1"}
-	tagDict[Tag{0xFFFE, 0xE000}] = Info{Tag{0xFFFE, 0xE000}, "NA", "Item", "1"}
-	tagDict[Tag{0xFFFE, 0xE00D}] = Info{Tag{0xFFFE, 0xE00D}, "NA", "ItemDelimitationItem", "1"}
-	tagDict[Tag{0xFFFE, 0xE0DD}] = Info{Tag{0xFFFE, 0xE0DD}, "NA", "SequenceDelimitationItem", "1"}
-	tagDict[Tag{0x0022, 0x1415}] = Info{Tag{0x0022, 0x1415}, "CS", "OphthalmicMappingDeviceType", "1"}
-	tagDict[Tag{0x0022, 0x1420}] = Info{Tag{0x0022, 0x1420}, "SQ", "AcquisitionTypeMethodSequence", "1"}
-	tagDict[Tag{0x0022, 0x1423}] = Info{Tag{0x0022, 0x1423}, "SQ", "AcquisitonMethodAlgorithmSequence", "1"}
-	tagDict[Tag{0x0022, 0x1436}] = Info{Tag{0x0022, 0x1436}, "SQ", "OphthalmicThicknessMapTypeCodeSequence", "1"}
-	tagDict[Tag{0x0022, 0x1443}] = Info{Tag{0x0022, 0x1443}, "SQ", "OphthalmicThicknessMappingNormalsSequence", "1"}
-	tagDict[Tag{0x0022, 0x1445}] = Info{Tag{0x0022, 0x1445}, "SQ", "RetinalThicknessDefinitionCodeSequence", "1"}
-	tagDict[Tag{0x0022, 0x1450}] = Info{Tag{0x0022, 0x1450}, "SQ", "PixelValueMappingtoCodedConceptSequence", "1"}
-	tagDict[Tag{0x0022, 0x1452}] = Info{Tag{0x0022, 0x1452}, "US", "MappedPixelValue", "1"}
-	tagDict[Tag{0x0022, 0x1454}] = Info{Tag{0x0022, 0x1454}, "LO", "PixelValueMappingExplanation", "1"}
-	tagDict[Tag{0x0022, 0x1458}] = Info{Tag{0x0022, 0x1458}, "SQ", "OphthalmicThicknessMapQualityThresholdSequence", "1"}
-	tagDict[Tag{0x0022, 0x1460}] = Info{Tag{0x0022, 0x1460}, "FL", "OphthalmicThicknessMapThresholdQualityRating", "1"}
-	tagDict[Tag{0x0022, 0x1463}] = Info{Tag{0x0022, 0x1463}, "FL", "AnatomicStructureReferencePoint", "2"}
-	tagDict[Tag{0x0022, 0x1465}] = Info{Tag{0x0022, 0x1465}, "SQ", "RegistrationtoLocalizerSequence", "1"}
-	tagDict[Tag{0x0022, 0x1466}] = Info{Tag{0x0022, 0x1466}, "CS", "RegisteredLocalizerUnits", "1"}
-	tagDict[Tag{0x0022, 0x1467}] = Info{Tag{0x0022, 0x1467}, "FL", "RegisteredTopLeftHandCorner", "2"}
-	tagDict[Tag{0x0022, 0x1468}] = Info{Tag{0x0022, 0x1468}, "FL", "RegisteredBottomRightHandCorner", "2"}
-	tagDict[Tag{0x0022, 0x1470}] = Info{Tag{0x0022, 0x1470}, "SQ", "OphthalmicThicknessMapQualityRatingSequence", "1"}
-	tagDict[Tag{0x0022, 0x1472}] = Info{Tag{0x0022, 0x1472}, "SQ", "RelevantOPTAttributesSequence", "1"}
-	tagDict[Tag{0x0040, 0x4001}] = Info{Tag{0x0040, 0x4001}, "CS", "RETIRED_GeneralPurposeScheduledProcedureStepStatus", "1"}
-	tagDict[Tag{0x0040, 0x4002}] = Info{Tag{0x0040, 0x4002}, "CS", "RETIRED_GeneralPurposePerformedProcedureStepStatus", "1"}
-	tagDict[Tag{0x0040, 0x4003}] = Info{Tag{0x0040, 0x4003}, "CS", "RETIRED_GeneralPurposeScheduledProcedureStepPriority", "1"}
-	tagDict[Tag{0x0040, 0x4004}] = Info{Tag{0x0040, 0x4004}, "SQ", "RETIRED_ScheduledProcessingApplicationsCodeSequence", "1"}
-	tagDict[Tag{0x0040, 0x4006}] = Info{Tag{0x0040, 0x4006}, "CS", "RETIRED_MultipleCopiesFlag", "1"}
-	tagDict[Tag{0x0040, 0x4015}] = Info{Tag{0x0040, 0x4015}, "SQ", "RETIRED_ResultingGeneralPurposePerformedProcedureStepsSequence", "1"}
-	tagDict[Tag{0x0040, 0x4016}] = Info{Tag{0x0040, 0x4016}, "SQ", "RETIRED_ReferencedGeneralPurposeScheduledProcedureStepSequence", "1"}
-	tagDict[Tag{0x0040, 0x4022}] = Info{Tag{0x0040, 0x4022}, "SQ", "RETIRED_RelevantInformationSequence", "1"}
-	tagDict[Tag{0x0040, 0x4023}] = Info{Tag{0x0040, 0x4023}, "UI", "RETIRED_ReferencedGeneralPurposeScheduledProcedureStepTransactionUID", "1"}
-	tagDict[Tag{0x0040, 0x4031}] = Info{Tag{0x0040, 0x4031}, "SQ", "RETIRED_RequestedSubsequentWorkitemCodeSequence", "1"}
-	tagDict[Tag{0x0040, 0x4032}] = Info{Tag{0x0040, 0x4032}, "SQ", "RETIRED_NonDICOMOutputCodeSequence", "1"}
-	tagDict[Tag{0x0040, 0xA161}] = Info{Tag{0x0040, 0xA161}, "FD", "FloatingPointValue", "1-n"}
-	tagDict[Tag{0x0040, 0xA162}] = Info{Tag{0x0040, 0xA162}, "SL", "RationalNumeratorValue", "1-n"}
-	tagDict[Tag{0x0040, 0xA163}] = Info{Tag{0x0040, 0xA163}, "UL", "RationalDenominatorValue", "1-n"}
-	tagDict[Tag{0x0010, 0x0200}] = Info{Tag{0x0010, 0x0200}, "CS", "QualityControlSubject", "1"}
-	tagDict[Tag{0x3006, 0x0018}] = Info{Tag{0x3006, 0x0018}, "SQ", "PredecessorStructureSetSequence", "1"}
-	tagDict[Tag{0x300A, 0x0088}] = Info{Tag{0x300A, 0x0088}, "FL", "RETIRED_BeamDosePointDepth", "1"}
-	tagDict[Tag{0x300A, 0x0089}] = Info{Tag{0x300A, 0x0089}, "FL", "RETIRED_BeamDosePointEquivalentDepth", "1"}
-	tagDict[Tag{0x300A, 0x008A}] = Info{Tag{0x300A, 0x008A}, "FL", "RETIRED_BeamDosePointSSD", "1"}
-	tagDict[Tag{0x300A, 0x008C}] = Info{Tag{0x300A, 0x008C}, "SQ", "BeamDoseVerificationControlPointSequence", "1"}
-	tagDict[Tag{0x300A, 0x008D}] = Info{Tag{0x300A, 0x008D}, "FL", "AverageBeamDosePointDepth", "1"}
-	tagDict[Tag{0x300A, 0x008E}] = Info{Tag{0x300A, 0x008E}, "FL", "AverageBeamDosePointEquivalentDepth", "1"}
-	tagDict[Tag{0x300A, 0x008F}] = Info{Tag{0x300A, 0x008F}, "FL", "AverageBeamDosePointSSD", "1"}
-	tagDict[Tag{0x0040, 0xA171}] = Info{Tag{0x0040, 0xA171}, "UI", "ObservationUID", "1"}
-	tagDict[Tag{0x300A, 0x00EF}] = Info{Tag{0x300A, 0x00EF}, "SH", "CompensatorTrayID", "1"}
-	tagDict[Tag{0x0066, 0x0037}] = Info{Tag{0x0066, 0x0037}, "FL", "RecommendedPointRadius", "1"}
-	tagDict[Tag{0x0066, 0x0038}] = Info{Tag{0x0066, 0x0038}, "FL", "RecommendedLineThickness", "1"}
-	tagDict[Tag{0x300A, 0x021B}] = Info{Tag{0x300A, 0x021B}, "SH", "SourceModel", "1"}
-	tagDict[Tag{0x300A, 0x021C}] = Info{Tag{0x300A, 0x021C}, "LO", "SourceDescription", "1"}
-	tagDict[Tag{0x0008, 0x0015}] = Info{Tag{0x0008, 0x0015}, "DT", "InstanceCoercionDateTime", "1"}
-	tagDict[Tag{0x0000, 0x0001}] = Info{Tag{0x0000, 0x0001}, "UL", "ACR_NEMA_CommandGroupLengthToEnd", "1"}
-	tagDict[Tag{0x0000, 0x0010}] = Info{Tag{0x0000, 0x0010}, "CS", "ACR_NEMA_CommandRecognitionCode", "1"}
-	tagDict[Tag{0x0000, 0x0200}] = Info{Tag{0x0000, 0x0200}, "LO", "ACR_NEMA_Initiator", "1"}
-	tagDict[Tag{0x0000, 0x0300}] = Info{Tag{0x0000, 0x0300}, "LO", "ACR_NEMA_Receiver", "1"}
-	tagDict[Tag{0x0000, 0x0400}] = Info{Tag{0x0000, 0x0400}, "LO", "ACR_NEMA_FindLocation", "1"}
-	tagDict[Tag{0x0000, 0x0850}] = Info{Tag{0x0000, 0x0850}, "US", "ACR_NEMA_NumberOfMatches", "1"}
-	tagDict[Tag{0x0000, 0x0860}] = Info{Tag{0x0000, 0x0860}, "US", "ACR_NEMA_ResponseSequenceNumber", "1"}
-	tagDict[Tag{0x0000, 0x4000}] = Info{Tag{0x0000, 0x4000}, "LO", "ACR_NEMA_DialogReceiver", "1"}
-	tagDict[Tag{0x0000, 0x4010}] = Info{Tag{0x0000, 0x4010}, "LO", "ACR_NEMA_TerminalType", "1"}
-	tagDict[Tag{0x0000, 0x5010}] = Info{Tag{0x0000, 0x5010}, "LO", "ACR_NEMA_MessageSetID", "1"}
-	tagDict[Tag{0x0000, 0x5020}] = Info{Tag{0x0000, 0x5020}, "LO", "ACR_NEMA_EndMessageSet", "1"}
-	tagDict[Tag{0x0000, 0x5110}] = Info{Tag{0x0000, 0x5110}, "LO", "ACR_NEMA_DisplayFormat", "1"}
-	tagDict[Tag{0x0000, 0x5120}] = Info{Tag{0x0000, 0x5120}, "LO", "ACR_NEMA_PagePositionID", "1"}
-	tagDict[Tag{0x0000, 0x5130}] = Info{Tag{0x0000, 0x5130}, "LO", "ACR_NEMA_TextFormatID", "1"}
-	tagDict[Tag{0x0000, 0x5140}] = Info{Tag{0x0000, 0x5140}, "CS", "ACR_NEMA_NormalReverse", "1"}
-	tagDict[Tag{0x0000, 0x5150}] = Info{Tag{0x0000, 0x5150}, "CS", "ACR_NEMA_AddGrayScale", "1"}
-	tagDict[Tag{0x0000, 0x5160}] = Info{Tag{0x0000, 0x5160}, "CS", "ACR_NEMA_Borders", "1"}
-	tagDict[Tag{0x0000, 0x5170}] = Info{Tag{0x0000, 0x5170}, "IS", "ACR_NEMA_Copies", "1"}
-	tagDict[Tag{0x0000, 0x5180}] = Info{Tag{0x0000, 0x5180}, "LO", "ACR_NEMA_MagnificationType", "1"}
-	tagDict[Tag{0x0000, 0x5190}] = Info{Tag{0x0000, 0x5190}, "LO", "ACR_NEMA_Erase", "1-n"}
-	tagDict[Tag{0x0000, 0x51A0}] = Info{Tag{0x0000, 0x51A0}, "CS", "ACR_NEMA_Print", "1"}
-	tagDict[Tag{0x0000, 0x51B0}] = Info{Tag{0x0000, 0x51B0}, "US", "ACR_NEMA_Overlays", "1-n"}
-	tagDict[Tag{0x0008, 0x0001}] = Info{Tag{0x0008, 0x0001}, "UL", "ACR_NEMA_IdentifyingGroupLengthToEnd", "1"}
-	tagDict[Tag{0x0008, 0x0010}] = Info{Tag{0x0008, 0x0010}, "LO", "ACR_NEMA_RecognitionCode", "1"}
-	tagDict[Tag{0x0008, 0x0040}] = Info{Tag{0x0008, 0x0040}, "US", "ACR_NEMA_OldDataSetType", "1"}
-	tagDict[Tag{0x0008, 0x0041}] = Info{Tag{0x0008, 0x0041}, "LO", "ACR_NEMA_DataSetSubtype", "1"}
-	tagDict[Tag{0x0008, 0x1000}] = Info{Tag{0x0008, 0x1000}, "LO", "ACR_NEMA_NetworkID", "1"}
-	tagDict[Tag{0x0008, 0x4000}] = Info{Tag{0x0008, 0x4000}, "LT", "ACR_NEMA_IdentifyingComments", "1-n"}
-	tagDict[Tag{0x0010, 0x1050}] = Info{Tag{0x0010, 0x1050}, "LT", "ACR_NEMA_InsurancePlanIdentification", "1-n"}
-	tagDict[Tag{0x0018, 0x1240}] = Info{Tag{0x0018, 0x1240}, "IS", "ACR_NEMA_UpperLowerPixelValues", "1-n"}
-	tagDict[Tag{0x0018, 0x4000}] = Info{Tag{0x0018, 0x4000}, "LT", "ACR_NEMA_AcquisitionComments", "1-n"}
-	tagDict[Tag{0x0018, 0x5030}] = Info{Tag{0x0018, 0x5030}, "DS", "ACR_NEMA_DynamicRange", "1"}
-	tagDict[Tag{0x0018, 0x5040}] = Info{Tag{0x0018, 0x5040}, "DS", "ACR_NEMA_TotalGain", "1"}
-	tagDict[Tag{0x0020, 0x0030}] = Info{Tag{0x0020, 0x0030}, "DS", "ACR_NEMA_ImagePosition", "3"}
-	tagDict[Tag{0x0020, 0x0035}] = Info{Tag{0x0020, 0x0035}, "DS", "ACR_NEMA_ImageOrientation", "6"}
-	tagDict[Tag{0x0020, 0x0050}] = Info{Tag{0x0020, 0x0050}, "DS", "ACR_NEMA_Location", "1"}
-	tagDict[Tag{0x0020, 0x0070}] = Info{Tag{0x0020, 0x0070}, "LO", "ACR_NEMA_ImageGeometryType", "1"}
-	tagDict[Tag{0x0020, 0x0080}] = Info{Tag{0x0020, 0x0080}, "LO", "ACR_NEMA_MaskingImage", "1-n"}
-	tagDict[Tag{0x0020, 0x1001}] = Info{Tag{0x0020, 0x1001}, "IS", "ACR_NEMA_AcquisitionsInSeries", "1"}
-	tagDict[Tag{0x0020, 0x1003}] = Info{Tag{0x0020, 0x1003}, "IS", "ACR_NEMA_ImagesInSeries", "1"}
-	tagDict[Tag{0x0020, 0x1005}] = Info{Tag{0x0020, 0x1005}, "IS", "ACR_NEMA_ImagesInStudy", "1"}
-	tagDict[Tag{0x0020, 0x1020}] = Info{Tag{0x0020, 0x1020}, "LO", "ACR_NEMA_Reference", "1-n"}
-	tagDict[Tag{0x0020, 0x3401}] = Info{Tag{0x0020, 0x3401}, "LO", "ACR_NEMA_ModifyingDeviceID", "1"}
-	tagDict[Tag{0x0020, 0x3402}] = Info{Tag{0x0020, 0x3402}, "LO", "ACR_NEMA_ModifiedImageID", "1"}
-	tagDict[Tag{0x0020, 0x3403}] = Info{Tag{0x0020, 0x3403}, "DA", "ACR_NEMA_ModifiedImageDate", "1"}
-	tagDict[Tag{0x0020, 0x3404}] = Info{Tag{0x0020, 0x3404}, "LO", "ACR_NEMA_ModifyingDeviceManufacturer", "1"}
-	tagDict[Tag{0x0020, 0x3405}] = Info{Tag{0x0020, 0x3405}, "TM", "ACR_NEMA_ModifiedImageTime", "1"}
-	tagDict[Tag{0x0020, 0x3406}] = Info{Tag{0x0020, 0x3406}, "LO", "ACR_NEMA_ModifiedImageDescription", "1"}
-	tagDict[Tag{0x0020, 0x5000}] = Info{Tag{0x0020, 0x5000}, "AT", "ACR_NEMA_OriginalImageIdentification", "1-n"}
-	tagDict[Tag{0x0020, 0x5002}] = Info{Tag{0x0020, 0x5002}, "LO", "ACR_NEMA_OriginalImageIdentificationNomenclature", "1-n"}
-	tagDict[Tag{0x0028, 0x0005}] = Info{Tag{0x0028, 0x0005}, "US", "ACR_NEMA_ImageDimensions", "1"}
-	tagDict[Tag{0x0028, 0x0040}] = Info{Tag{0x0028, 0x0040}, "CS", "ACR_NEMA_ImageFormat", "1"}
-	tagDict[Tag{0x0028, 0x0050}] = Info{Tag{0x0028, 0x0050}, "LO", "ACR_NEMA_ManipulatedImage", "1-n"}
-	tagDict[Tag{0x0028, 0x0060}] = Info{Tag{0x0028, 0x0060}, "CS", "ACR_NEMA_CompressionCode", "1"}
-	tagDict[Tag{0x0028, 0x0104}] = Info{Tag{0x0028, 0x0104}, "US", "ACR_NEMA_SmallestValidPixelValue", "1"}
-	tagDict[Tag{0x0028, 0x0105}] = Info{Tag{0x0028, 0x0105}, "US", "ACR_NEMA_LargestValidPixelValue", "1"}
-	tagDict[Tag{0x0028, 0x0200}] = Info{Tag{0x0028, 0x0200}, "US", "ACR_NEMA_ImageLocation", "1"}
-	tagDict[Tag{0x0028, 0x1080}] = Info{Tag{0x0028, 0x1080}, "CS", "ACR_NEMA_GrayScale", "1"}
-	tagDict[Tag{0x0028, 0x1100}] = Info{Tag{0x0028, 0x1100}, "US", "ACR_NEMA_GrayLookupTableDescriptor", "3"}
-	tagDict[Tag{0x0028, 0x1200}] = Info{Tag{0x0028, 0x1200}, "US", "ACR_NEMA_GrayLookupTableData", "1-n"}
-	tagDict[Tag{0x0028, 0x4000}] = Info{Tag{0x0028, 0x4000}, "LT", "ACR_NEMA_ImagePresentationComments", "1-n"}
-	tagDict[Tag{0x4000, 0x0000}] = Info{Tag{0x4000, 0x0000}, "UL", "ACR_NEMA_TextGroupLength", "1"}
-	tagDict[Tag{0x4000, 0x0010}] = Info{Tag{0x4000, 0x0010}, "LT", "ACR_NEMA_TextArbitrary", "1-n"}
-	tagDict[Tag{0x4000, 0x4000}] = Info{Tag{0x4000, 0x4000}, "LT", "ACR_NEMA_TextComments", "1-n"}
-	tagDict[Tag{0x0028, 0x005F}] = Info{Tag{0x0028, 0x005F}, "CS", "ACR_NEMA_2C_CompressionRecognitionCode", "1"}
-	tagDict[Tag{0x0028, 0x0061}] = Info{Tag{0x0028, 0x0061}, "SH", "ACR_NEMA_2C_CompressionOriginator", "1"}
-	tagDict[Tag{0x0028, 0x0062}] = Info{Tag{0x0028, 0x0062}, "SH", "ACR_NEMA_2C_CompressionLabel", "1"}
-	tagDict[Tag{0x0028, 0x0063}] = Info{Tag{0x0028, 0x0063}, "SH", "ACR_NEMA_2C_CompressionDescription", "1"}
-	tagDict[Tag{0x0028, 0x0065}] = Info{Tag{0x0028, 0x0065}, "CS", "ACR_NEMA_2C_CompressionSequence", "1-n"}
-	tagDict[Tag{0x0028, 0x0066}] = Info{Tag{0x0028, 0x0066}, "AT", "ACR_NEMA_2C_CompressionStepPointers", "1-n"}
-	tagDict[Tag{0x0028, 0x0068}] = Info{Tag{0x0028, 0x0068}, "US", "ACR_NEMA_2C_RepeatInterval", "1"}
-	tagDict[Tag{0x0028, 0x0069}] = Info{Tag{0x0028, 0x0069}, "US", "ACR_NEMA_2C_BitsGrouped", "1"}
-	tagDict[Tag{0x0028, 0x0070}] = Info{Tag{0x0028, 0x0070}, "US", "ACR_NEMA_2C_PerimeterTable", "1-n"}
-	tagDict[Tag{0x0028, 0x0071}] = Info{Tag{0x0028, 0x0071}, "US", "ACR_NEMA_2C_PerimeterValue", "1"}
-	tagDict[Tag{0x0028, 0x0080}] = Info{Tag{0x0028, 0x0080}, "US", "ACR_NEMA_2C_PredictorRows", "1"}
-	tagDict[Tag{0x0028, 0x0081}] = Info{Tag{0x0028, 0x0081}, "US", "ACR_NEMA_2C_PredictorColumns", "1"}
-	tagDict[Tag{0x0028, 0x0082}] = Info{Tag{0x0028, 0x0082}, "US", "ACR_NEMA_2C_PredictorConstants", "1-n"}
-	tagDict[Tag{0x0028, 0x0090}] = Info{Tag{0x0028, 0x0090}, "CS", "ACR_NEMA_2C_BlockedPixels", "1"}
-	tagDict[Tag{0x0028, 0x0091}] = Info{Tag{0x0028, 0x0091}, "US", "ACR_NEMA_2C_BlockRows", "1"}
-	tagDict[Tag{0x0028, 0x0092}] = Info{Tag{0x0028, 0x0092}, "US", "ACR_NEMA_2C_BlockColumns", "1"}
-	tagDict[Tag{0x0028, 0x0093}] = Info{Tag{0x0028, 0x0093}, "US", "ACR_NEMA_2C_RowOverlap", "1"}
-	tagDict[Tag{0x0028, 0x0094}] = Info{Tag{0x0028, 0x0094}, "US", "ACR_NEMA_2C_ColumnOverlap", "1"}
-	tagDict[Tag{0x0028, 0x0400}] = Info{Tag{0x0028, 0x0400}, "CS", "ACR_NEMA_2C_TransformLabel", "1"}
-	tagDict[Tag{0x0028, 0x0401}] = Info{Tag{0x0028, 0x0401}, "CS", "ACR_NEMA_2C_TransformVersionNumber", "1"}
-	tagDict[Tag{0x0028, 0x0402}] = Info{Tag{0x0028, 0x0402}, "US", "ACR_NEMA_2C_NumberOfTransformSteps", "1"}
-	tagDict[Tag{0x0028, 0x0403}] = Info{Tag{0x0028, 0x0403}, "CS", "ACR_NEMA_2C_SequenceOfCompressedData", "1-n"}
-	tagDict[Tag{0x0028, 0x0404}] = Info{Tag{0x0028, 0x0404}, "AT", "ACR_NEMA_2C_DetailsOfCoefficients", "1-n"}
-	tagDict[Tag{0x0028, 0x0410}] = Info{Tag{0x0028, 0x0410}, "US", "ACR_NEMA_2C_RowsForNthOrderCoefficients", "1"}
-	tagDict[Tag{0x0028, 0x0411}] = Info{Tag{0x0028, 0x0411}, "US", "ACR_NEMA_2C_ColumnsForNthOrderCoefficients", "1"}
-	tagDict[Tag{0x0028, 0x0412}] = Info{Tag{0x0028, 0x0412}, "CS", "ACR_NEMA_2C_CoefficientCoding", "1-n"}
-	tagDict[Tag{0x0028, 0x0413}] = Info{Tag{0x0028, 0x0413}, "AT", "ACR_NEMA_2C_CoefficientCodingPointers", "1-n"}
-	tagDict[Tag{0x0028, 0x0700}] = Info{Tag{0x0028, 0x0700}, "CS", "ACR_NEMA_2C_DCTLabel", "1"}
-	tagDict[Tag{0x0028, 0x0701}] = Info{Tag{0x0028, 0x0701}, "CS", "ACR_NEMA_2C_DataBlockDescription", "1-n"}
-	tagDict[Tag{0x0028, 0x0702}] = Info{Tag{0x0028, 0x0702}, "AT", "ACR_NEMA_2C_DataBlock", "1-n"}
-	tagDict[Tag{0x0028, 0x0710}] = Info{Tag{0x0028, 0x0710}, "US", "ACR_NEMA_2C_NormalizationFactorFormat", "1"}
-	tagDict[Tag{0x0028, 0x0720}] = Info{Tag{0x0028, 0x0720}, "US", "ACR_NEMA_2C_ZonalMapNumberFormat", "1"}
-	tagDict[Tag{0x0028, 0x0721}] = Info{Tag{0x0028, 0x0721}, "AT", "ACR_NEMA_2C_ZonalMapLocation", "1-n"}
-	tagDict[Tag{0x0028, 0x0722}] = Info{Tag{0x0028, 0x0722}, "US", "ACR_NEMA_2C_ZonalMapFormat", "1"}
-	tagDict[Tag{0x0028, 0x0730}] = Info{Tag{0x0028, 0x0730}, "US", "ACR_NEMA_2C_AdaptiveMapFormat", "1"}
-	tagDict[Tag{0x0028, 0x0740}] = Info{Tag{0x0028, 0x0740}, "US", "ACR_NEMA_2C_CodeNumberFormat", "1"}
-	tagDict[Tag{0x0028, 0x0800}] = Info{Tag{0x0028, 0x0800}, "CS", "ACR_NEMA_2C_CodeLabel", "1-n"}
-	tagDict[Tag{0x0028, 0x0802}] = Info{Tag{0x0028, 0x0802}, "US", "ACR_NEMA_2C_NumberOfTables", "1"}
-	tagDict[Tag{0x0028, 0x0803}] = Info{Tag{0x0028, 0x0803}, "AT", "ACR_NEMA_2C_CodeTableLocation", "1-n"}
-	tagDict[Tag{0x0028, 0x0804}] = Info{Tag{0x0028, 0x0804}, "US", "ACR_NEMA_2C_BitsForCodeWord", "1"}
-	tagDict[Tag{0x0028, 0x0808}] = Info{Tag{0x0028, 0x0808}, "AT", "ACR_NEMA_2C_ImageDataLocation", "1-n"}
-	tagDict[Tag{0x1000, 0x0000}] = Info{Tag{0x1000, 0x0000}, "UL", "ACR_NEMA_2C_CodeTableGroupLength", "1"}
-	tagDict[Tag{0x1000, 0x0010}] = Info{Tag{0x1000, 0x0010}, "US", "ACR_NEMA_2C_EscapeTriplet", "3"}
-	tagDict[Tag{0x1000, 0x0011}] = Info{Tag{0x1000, 0x0011}, "US", "ACR_NEMA_2C_RunLengthTriplet", "3"}
-	tagDict[Tag{0x1000, 0x0012}] = Info{Tag{0x1000, 0x0012}, "US", "ACR_NEMA_2C_HuffmanTableSize", "1"}
-	tagDict[Tag{0x1000, 0x0013}] = Info{Tag{0x1000, 0x0013}, "US", "ACR_NEMA_2C_HuffmanTableTriplet", "3"}
-	tagDict[Tag{0x1000, 0x0014}] = Info{Tag{0x1000, 0x0014}, "US", "ACR_NEMA_2C_ShiftTableSize", "1"}
-	tagDict[Tag{0x1000, 0x0015}] = Info{Tag{0x1000, 0x0015}, "US", "ACR_NEMA_2C_ShiftTableTriplet", "3"}
-	tagDict[Tag{0x1010, 0x0000}] = Info{Tag{0x1010, 0x0000}, "UL", "ACR_NEMA_2C_ZonalMapGroupLength", "1"}
-	tagDict[Tag{0x1010, 0x0004}] = Info{Tag{0x1010, 0x0004}, "US", "ACR_NEMA_2C_ZonalMap", "1-n"}
-	tagDict[Tag{0x7FE0, 0x0020}] = Info{Tag{0x7FE0, 0x0020}, "OW", "ACR_NEMA_2C_CoefficientsSDVN", "1-n"}
-	tagDict[Tag{0x7FE0, 0x0030}] = Info{Tag{0x7FE0, 0x0030}, "OW", "ACR_NEMA_2C_CoefficientsSDHN", "1-n"}
-	tagDict[Tag{0x7FE0, 0x0040}] = Info{Tag{0x7FE0, 0x0040}, "OW", "ACR_NEMA_2C_CoefficientsSDDN", "1-n"}
-	tagDict[Tag{0x0000, 0x0001}] = Info{Tag{0x0000, 0x0001}, "UL", "RETIRED_CommandLengthToEnd", "1"}
-	tagDict[Tag{0x0000, 0x0010}] = Info{Tag{0x0000, 0x0010}, "SH", "RETIRED_CommandRecognitionCode", "1"}
-	tagDict[Tag{0x0000, 0x0200}] = Info{Tag{0x0000, 0x0200}, "AE", "RETIRED_Initiator", "1"}
-	tagDict[Tag{0x0000, 0x0300}] = Info{Tag{0x0000, 0x0300}, "AE", "RETIRED_Receiver", "1"}
-	tagDict[Tag{0x0000, 0x0400}] = Info{Tag{0x0000, 0x0400}, "AE", "RETIRED_FindLocation", "1"}
-	tagDict[Tag{0x0000, 0x0850}] = Info{Tag{0x0000, 0x0850}, "US", "RETIRED_NumberOfMatches", "1"}
-	tagDict[Tag{0x0000, 0x0860}] = Info{Tag{0x0000, 0x0860}, "US", "RETIRED_ResponseSequenceNumber", "1"}
-	tagDict[Tag{0x0000, 0x4000}] = Info{Tag{0x0000, 0x4000}, "AT", "RETIRED_DialogReceiver", "1"}
-	tagDict[Tag{0x0000, 0x4010}] = Info{Tag{0x0000, 0x4010}, "AT", "RETIRED_TerminalType", "1"}
-	tagDict[Tag{0x0000, 0x5010}] = Info{Tag{0x0000, 0x5010}, "SH", "RETIRED_MessageSetID", "1"}
-	tagDict[Tag{0x0000, 0x5020}] = Info{Tag{0x0000, 0x5020}, "SH", "RETIRED_EndMessageID", "1"}
-	tagDict[Tag{0x0000, 0x5110}] = Info{Tag{0x0000, 0x5110}, "AT", "RETIRED_DisplayFormat", "1"}
-	tagDict[Tag{0x0000, 0x5120}] = Info{Tag{0x0000, 0x5120}, "AT", "RETIRED_PagePositionID", "1"}
-	tagDict[Tag{0x0000, 0x5130}] = Info{Tag{0x0000, 0x5130}, "CS", "RETIRED_TextFormatID", "1"}
-	tagDict[Tag{0x0000, 0x5140}] = Info{Tag{0x0000, 0x5140}, "CS", "RETIRED_NormalReverse", "1"}
-	tagDict[Tag{0x0000, 0x5150}] = Info{Tag{0x0000, 0x5150}, "CS", "RETIRED_AddGrayScale", "1"}
-	tagDict[Tag{0x0000, 0x5160}] = Info{Tag{0x0000, 0x5160}, "CS", "RETIRED_Borders", "1"}
-	tagDict[Tag{0x0000, 0x5170}] = Info{Tag{0x0000, 0x5170}, "IS", "RETIRED_Copies", "1"}
-	tagDict[Tag{0x0000, 0x5180}] = Info{Tag{0x0000, 0x5180}, "CS", "RETIRED_CommandMagnificationType", "1"}
-	tagDict[Tag{0x0000, 0x5190}] = Info{Tag{0x0000, 0x5190}, "CS", "RETIRED_Erase", "1"}
-	tagDict[Tag{0x0000, 0x51A0}] = Info{Tag{0x0000, 0x51A0}, "CS", "RETIRED_Print", "1"}
-	tagDict[Tag{0x0000, 0x51B0}] = Info{Tag{0x0000, 0x51B0}, "US", "RETIRED_Overlays", "1-n"}
-	tagDict[Tag{0x0004, 0x1504}] = Info{Tag{0x0004, 0x1504}, "UP", "RETIRED_MRDRDirectoryRecordOffset", "1"}
-	tagDict[Tag{0x0004, 0x1600}] = Info{Tag{0x0004, 0x1600}, "UL", "RETIRED_NumberOfReferences", "1"}
-	tagDict[Tag{0x0008, 0x0001}] = Info{Tag{0x0008, 0x0001}, "UL", "RETIRED_LengthToEnd", "1"}
-	tagDict[Tag{0x0008, 0x0010}] = Info{Tag{0x0008, 0x0010}, "SH", "RETIRED_RecognitionCode", "1"}
-	tagDict[Tag{0x0008, 0x0024}] = Info{Tag{0x0008, 0x0024}, "DA", "RETIRED_OverlayDate", "1"}
-	tagDict[Tag{0x0008, 0x0025}] = Info{Tag{0x0008, 0x0025}, "DA", "RETIRED_CurveDate", "1"}
-	tagDict[Tag{0x0008, 0x0034}] = Info{Tag{0x0008, 0x0034}, "TM", "RETIRED_OverlayTime", "1"}
-	tagDict[Tag{0x0008, 0x0035}] = Info{Tag{0x0008, 0x0035}, "TM", "RETIRED_CurveTime", "1"}
-	tagDict[Tag{0x0008, 0x0040}] = Info{Tag{0x0008, 0x0040}, "US", "RETIRED_DataSetType", "1"}
-	tagDict[Tag{0x0008, 0x0041}] = Info{Tag{0x0008, 0x0041}, "LO", "RETIRED_DataSetSubtype", "1"}
-	tagDict[Tag{0x0008, 0x0042}] = Info{Tag{0x0008, 0x0042}, "CS", "RETIRED_NuclearMedicineSeriesType", "1"}
-	tagDict[Tag{0x0008, 0x1000}] = Info{Tag{0x0008, 0x1000}, "AE", "RETIRED_NetworkID", "1"}
-	tagDict[Tag{0x0008, 0x1100}] = Info{Tag{0x0008, 0x1100}, "SQ", "RETIRED_ReferencedResultsSequence", "1"}
-	tagDict[Tag{0x0008, 0x1130}] = Info{Tag{0x0008, 0x1130}, "SQ", "RETIRED_ReferencedOverlaySequence", "1"}
-	tagDict[Tag{0x0008, 0x1145}] = Info{Tag{0x0008, 0x1145}, "SQ", "RETIRED_ReferencedCurveSequence", "1"}
-	tagDict[Tag{0x0008, 0x2110}] = Info{Tag{0x0008, 0x2110}, "CS", "RETIRED_LossyImageCompressionRetired", "1"}
-	tagDict[Tag{0x0008, 0x2200}] = Info{Tag{0x0008, 0x2200}, "CS", "RETIRED_TransducerPosition", "1"}
-	tagDict[Tag{0x0008, 0x2204}] = Info{Tag{0x0008, 0x2204}, "CS", "RETIRED_TransducerOrientation", "1"}
-	tagDict[Tag{0x0008, 0x2208}] = Info{Tag{0x0008, 0x2208}, "CS", "RETIRED_AnatomicStructure", "1"}
-	tagDict[Tag{0x0008, 0x2240}] = Info{Tag{0x0008, 0x2240}, "SQ", "RETIRED_TransducerPositionSequence", "1"}
-	tagDict[Tag{0x0008, 0x2242}] = Info{Tag{0x0008, 0x2242}, "SQ", "RETIRED_TransducerPositionModifierSequence", "1"}
-	tagDict[Tag{0x0008, 0x2244}] = Info{Tag{0x0008, 0x2244}, "SQ", "RETIRED_TransducerOrientationSequence", "1"}
-	tagDict[Tag{0x0008, 0x2246}] = Info{Tag{0x0008, 0x2246}, "SQ", "RETIRED_TransducerOrientationModifierSequence", "1"}
-	tagDict[Tag{0x0008, 0x2251}] = Info{Tag{0x0008, 0x2251}, "SQ", "RETIRED_AnatomicStructureSpaceOrRegionCodeSequenceTrial", "1"}
-	tagDict[Tag{0x0008, 0x2253}] = Info{Tag{0x0008, 0x2253}, "SQ", "RETIRED_AnatomicPortalOfEntranceCodeSequenceTrial", "1"}
-	tagDict[Tag{0x0008, 0x2255}] = Info{Tag{0x0008, 0x2255}, "SQ", "RETIRED_AnatomicApproachDirectionCodeSequenceTrial", "1"}
-	tagDict[Tag{0x0008, 0x2256}] = Info{Tag{0x0008, 0x2256}, "ST", "RETIRED_AnatomicPerspectiveDescriptionTrial", "1"}
-	tagDict[Tag{0x0008, 0x2257}] = Info{Tag{0x0008, 0x2257}, "SQ", "RETIRED_AnatomicPerspectiveCodeSequenceTrial", "1"}
-	tagDict[Tag{0x0008, 0x2258}] = Info{Tag{0x0008, 0x2258}, "ST", "RETIRED_AnatomicLocationOfExaminingInstrumentDescriptionTrial", "1"}
-	tagDict[Tag{0x0008, 0x2259}] = Info{Tag{0x0008, 0x2259}, "SQ", "RETIRED_AnatomicLocationOfExaminingInstrumentCodeSequenceTrial", "1"}
-	tagDict[Tag{0x0008, 0x225A}] = Info{Tag{0x0008, 0x225A}, "SQ", "RETIRED_AnatomicStructureSpaceOrRegionModifierCodeSequenceTrial", "1"}
-	tagDict[Tag{0x0008, 0x225C}] = Info{Tag{0x0008, 0x225C}, "SQ", "RETIRED_OnAxisBackgroundAnatomicStructureCodeSequenceTrial", "1"}
-	tagDict[Tag{0x0008, 0x4000}] = Info{Tag{0x0008, 0x4000}, "LT", "RETIRED_IdentifyingComments", "1"}
-	tagDict[Tag{0x0010, 0x1050}] = Info{Tag{0x0010, 0x1050}, "LO", "RETIRED_InsurancePlanIdentification", "1-n"}
-	tagDict[Tag{0x0018, 0x0030}] = Info{Tag{0x0018, 0x0030}, "LO", "RETIRED_Radionuclide", "1-n"}
-	tagDict[Tag{0x0018, 0x0032}] = Info{Tag{0x0018, 0x0032}, "DS", "RETIRED_EnergyWindowCenterline", "1"}
-	tagDict[Tag{0x0018, 0x0033}] = Info{Tag{0x0018, 0x0033}, "DS", "RETIRED_EnergyWindowTotalWidth", "1-n"}
-	tagDict[Tag{0x0018, 0x0037}] = Info{Tag{0x0018, 0x0037}, "CS", "RETIRED_TherapyType", "1"}
-	tagDict[Tag{0x0018, 0x0039}] = Info{Tag{0x0018, 0x0039}, "CS", "RETIRED_TherapyDescription", "1"}
-	tagDict[Tag{0x0018, 0x1011}] = Info{Tag{0x0018, 0x1011}, "LO", "RETIRED_HardcopyCreationDeviceID", "1"}
-	tagDict[Tag{0x0018, 0x1017}] = Info{Tag{0x0018, 0x1017}, "LO", "RETIRED_HardcopyDeviceManufacturer", "1"}
-	tagDict[Tag{0x0018, 0x101A}] = Info{Tag{0x0018, 0x101A}, "LO", "RETIRED_HardcopyDeviceSoftwareVersion", "1-n"}
-	tagDict[Tag{0x0018, 0x101B}] = Info{Tag{0x0018, 0x101B}, "LO", "RETIRED_HardcopyDeviceManufacturerModelName", "1"}
-	tagDict[Tag{0x0018, 0x1141}] = Info{Tag{0x0018, 0x1141}, "DS", "RETIRED_AngularPosition", "1"}
-	tagDict[Tag{0x0018, 0x1146}] = Info{Tag{0x0018, 0x1146}, "DS", "RETIRED_RotationOffset", "1-n"}
-	tagDict[Tag{0x0018, 0x1240}] = Info{Tag{0x0018, 0x1240}, "IS", "RETIRED_UpperLowerPixelValues", "1-n"}
-	tagDict[Tag{0x0018, 0x4000}] = Info{Tag{0x0018, 0x4000}, "LT", "RETIRED_AcquisitionComments", "1"}
-	tagDict[Tag{0x0018, 0x5021}] = Info{Tag{0x0018, 0x5021}, "LO", "RETIRED_PostprocessingFunction", "1"}
-	tagDict[Tag{0x0018, 0x5030}] = Info{Tag{0x0018, 0x5030}, "DS", "RETIRED_DynamicRange", "1"}
-	tagDict[Tag{0x0018, 0x5040}] = Info{Tag{0x0018, 0x5040}, "DS", "RETIRED_TotalGain", "1"}
-	tagDict[Tag{0x0018, 0x5210}] = Info{Tag{0x0018, 0x5210}, "DS", "RETIRED_ImageTransformationMatrix", "6"}
-	tagDict[Tag{0x0018, 0x5212}] = Info{Tag{0x0018, 0x5212}, "DS", "RETIRED_ImageTranslationVector", "3"}
-	tagDict[Tag{0x0018, 0x6038}] = Info{Tag{0x0018, 0x6038}, "UL", "RETIRED_DopplerSampleVolumeXPositionRetired", "1"}
-	tagDict[Tag{0x0018, 0x603A}] = Info{Tag{0x0018, 0x603A}, "UL", "RETIRED_DopplerSampleVolumeYPositionRetired", "1"}
-	tagDict[Tag{0x0018, 0x603C}] = Info{Tag{0x0018, 0x603C}, "UL", "RETIRED_TMLinePositionX0Retired", "1"}
-	tagDict[Tag{0x0018, 0x603E}] = Info{Tag{0x0018, 0x603E}, "UL", "RETIRED_TMLinePositionY0Retired", "1"}
-	tagDict[Tag{0x0018, 0x6040}] = Info{Tag{0x0018, 0x6040}, "UL", "RETIRED_TMLinePositionX1Retired", "1"}
-	tagDict[Tag{0x0018, 0x6042}] = Info{Tag{0x0018, 0x6042}, "UL", "RETIRED_TMLinePositionY1Retired", "1"}
-	tagDict[Tag{0x0018, 0x9096}] = Info{Tag{0x0018, 0x9096}, "FD", "RETIRED_ParallelReductionFactorInPlaneRetired", "1"}
-	tagDict[Tag{0x0018, 0x9166}] = Info{Tag{0x0018, 0x9166}, "CS", "RETIRED_BulkMotionStatus", "1"}
-	tagDict[Tag{0x0018, 0x9195}] = Info{Tag{0x0018, 0x9195}, "FD", "RETIRED_ChemicalShiftMinimumIntegrationLimitInHz", "1"}
-	tagDict[Tag{0x0018, 0x9196}] = Info{Tag{0x0018, 0x9196}, "FD", "RETIRED_ChemicalShiftMaximumIntegrationLimitInHz", "1"}
-	tagDict[Tag{0x0020, 0x0014}] = Info{Tag{0x0020, 0x0014}, "IS", "RETIRED_IsotopeNumber", "1"}
-	tagDict[Tag{0x0020, 0x0015}] = Info{Tag{0x0020, 0x0015}, "IS", "RETIRED_PhaseNumber", "1"}
-	tagDict[Tag{0x0020, 0x0016}] = Info{Tag{0x0020, 0x0016}, "IS", "RETIRED_IntervalNumber", "1"}
-	tagDict[Tag{0x0020, 0x0017}] = Info{Tag{0x0020, 0x0017}, "IS", "RETIRED_TimeSlotNumber", "1"}
-	tagDict[Tag{0x0020, 0x0018}] = Info{Tag{0x0020, 0x0018}, "IS", "RETIRED_AngleNumber", "1"}
-	tagDict[Tag{0x0020, 0x0022}] = Info{Tag{0x0020, 0x0022}, "IS", "RETIRED_OverlayNumber", "1"}
-	tagDict[Tag{0x0020, 0x0024}] = Info{Tag{0x0020, 0x0024}, "IS", "RETIRED_CurveNumber", "1"}
-	tagDict[Tag{0x0020, 0x0026}] = Info{Tag{0x0020, 0x0026}, "IS", "RETIRED_LUTNumber", "1"}
-	tagDict[Tag{0x0020, 0x0030}] = Info{Tag{0x0020, 0x0030}, "DS", "RETIRED_ImagePosition", "3"}
-	tagDict[Tag{0x0020, 0x0035}] = Info{Tag{0x0020, 0x0035}, "DS", "RETIRED_ImageOrientation", "6"}
-	tagDict[Tag{0x0020, 0x0050}] = Info{Tag{0x0020, 0x0050}, "DS", "RETIRED_Location", "1"}
-	tagDict[Tag{0x0020, 0x0070}] = Info{Tag{0x0020, 0x0070}, "LO", "RETIRED_ImageGeometryType", "1"}
-	tagDict[Tag{0x0020, 0x0080}] = Info{Tag{0x0020, 0x0080}, "CS", "RETIRED_MaskingImage", "1-n"}
-	tagDict[Tag{0x0020, 0x00AA}] = Info{Tag{0x0020, 0x00AA}, "IS", "RETIRED_ReportNumber", "1"}
-	tagDict[Tag{0x0020, 0x1000}] = Info{Tag{0x0020, 0x1000}, "IS", "RETIRED_SeriesInStudy", "1"}
-	tagDict[Tag{0x0020, 0x1001}] = Info{Tag{0x0020, 0x1001}, "IS", "RETIRED_AcquisitionsInSeries", "1"}
-	tagDict[Tag{0x0020, 0x1003}] = Info{Tag{0x0020, 0x1003}, "IS", "RETIRED_ImagesInSeries", "1"}
-	tagDict[Tag{0x0020, 0x1004}] = Info{Tag{0x0020, 0x1004}, "IS", "RETIRED_AcquisitionsInStudy", "1"}
-	tagDict[Tag{0x0020, 0x1005}] = Info{Tag{0x0020, 0x1005}, "IS", "RETIRED_ImagesInStudy", "1"}
-	tagDict[Tag{0x0020, 0x1020}] = Info{Tag{0x0020, 0x1020}, "LO", "RETIRED_Reference", "1-n"}
-	tagDict[Tag{0x0020, 0x1070}] = Info{Tag{0x0020, 0x1070}, "IS", "RETIRED_OtherStudyNumbers", "1-n"}
-	tagDict[Tag{0x0020, 0x3401}] = Info{Tag{0x0020, 0x3401}, "CS", "RETIRED_ModifyingDeviceID", "1"}
-	tagDict[Tag{0x0020, 0x3402}] = Info{Tag{0x0020, 0x3402}, "CS", "RETIRED_ModifiedImageID", "1"}
-	tagDict[Tag{0x0020, 0x3403}] = Info{Tag{0x0020, 0x3403}, "DA", "RETIRED_ModifiedImageDate", "1"}
-	tagDict[Tag{0x0020, 0x3404}] = Info{Tag{0x0020, 0x3404}, "LO", "RETIRED_ModifyingDeviceManufacturer", "1"}
-	tagDict[Tag{0x0020, 0x3405}] = Info{Tag{0x0020, 0x3405}, "TM", "RETIRED_ModifiedImageTime", "1"}
-	tagDict[Tag{0x0020, 0x3406}] = Info{Tag{0x0020, 0x3406}, "LO", "RETIRED_ModifiedImageDescription", "1"}
-	tagDict[Tag{0x0020, 0x5000}] = Info{Tag{0x0020, 0x5000}, "AT", "RETIRED_OriginalImageIdentification", "1-n"}
-	tagDict[Tag{0x0020, 0x5002}] = Info{Tag{0x0020, 0x5002}, "LO", "RETIRED_OriginalImageIdentificationNomenclature", "1-n"}
-	tagDict[Tag{0x0028, 0x0005}] = Info{Tag{0x0028, 0x0005}, "US", "RETIRED_ImageDimensions", "1"}
-	tagDict[Tag{0x0028, 0x0012}] = Info{Tag{0x0028, 0x0012}, "US", "RETIRED_Planes", "1"}
-	tagDict[Tag{0x0028, 0x0040}] = Info{Tag{0x0028, 0x0040}, "CS", "RETIRED_ImageFormat", "1"}
-	tagDict[Tag{0x0028, 0x0050}] = Info{Tag{0x0028, 0x0050}, "LO", "RETIRED_ManipulatedImage", "1-n"}
-	tagDict[Tag{0x0028, 0x005F}] = Info{Tag{0x0028, 0x005F}, "LO", "RETIRED_CompressionRecognitionCode", "1"}
-	tagDict[Tag{0x0028, 0x0060}] = Info{Tag{0x0028, 0x0060}, "CS", "RETIRED_CompressionCode", "1"}
-	tagDict[Tag{0x0028, 0x0061}] = Info{Tag{0x0028, 0x0061}, "SH", "RETIRED_CompressionOriginator", "1"}
-	tagDict[Tag{0x0028, 0x0062}] = Info{Tag{0x0028, 0x0062}, "LO", "RETIRED_CompressionLabel", "1"}
-	tagDict[Tag{0x0028, 0x0063}] = Info{Tag{0x0028, 0x0063}, "SH", "RETIRED_CompressionDescription", "1"}
-	tagDict[Tag{0x0028, 0x0065}] = Info{Tag{0x0028, 0x0065}, "CS", "RETIRED_CompressionSequence", "1-n"}
-	tagDict[Tag{0x0028, 0x0066}] = Info{Tag{0x0028, 0x0066}, "AT", "RETIRED_CompressionStepPointers", "1-n"}
-	tagDict[Tag{0x0028, 0x0068}] = Info{Tag{0x0028, 0x0068}, "US", "RETIRED_RepeatInterval", "1"}
-	tagDict[Tag{0x0028, 0x0069}] = Info{Tag{0x0028, 0x0069}, "US", "RETIRED_BitsGrouped", "1"}
-	tagDict[Tag{0x0028, 0x0070}] = Info{Tag{0x0028, 0x0070}, "US", "RETIRED_PerimeterTable", "1-n"}
-	tagDict[Tag{0x0028, 0x0071}] = Info{Tag{0x0028, 0x0071}, "US", "RETIRED_PerimeterValue", "1"}
-	tagDict[Tag{0x0028, 0x0080}] = Info{Tag{0x0028, 0x0080}, "US", "RETIRED_PredictorRows", "1"}
-	tagDict[Tag{0x0028, 0x0081}] = Info{Tag{0x0028, 0x0081}, "US", "RETIRED_PredictorColumns", "1"}
-	tagDict[Tag{0x0028, 0x0082}] = Info{Tag{0x0028, 0x0082}, "US", "RETIRED_PredictorConstants", "1-n"}
-	tagDict[Tag{0x0028, 0x0090}] = Info{Tag{0x0028, 0x0090}, "CS", "RETIRED_BlockedPixels", "1"}
-	tagDict[Tag{0x0028, 0x0091}] = Info{Tag{0x0028, 0x0091}, "US", "RETIRED_BlockRows", "1"}
-	tagDict[Tag{0x0028, 0x0092}] = Info{Tag{0x0028, 0x0092}, "US", "RETIRED_BlockColumns", "1"}
-	tagDict[Tag{0x0028, 0x0093}] = Info{Tag{0x0028, 0x0093}, "US", "RETIRED_RowOverlap", "1"}
-	tagDict[Tag{0x0028, 0x0094}] = Info{Tag{0x0028, 0x0094}, "US", "RETIRED_ColumnOverlap", "1"}
-	tagDict[Tag{0x0028, 0x0104}] = Info{Tag{0x0028, 0x0104}, "US", "RETIRED_SmallestValidPixelValue", "1"}
-	tagDict[Tag{0x0028, 0x0105}] = Info{Tag{0x0028, 0x0105}, "US", "RETIRED_LargestValidPixelValue", "1"}
-	tagDict[Tag{0x0028, 0x0110}] = Info{Tag{0x0028, 0x0110}, "US", "RETIRED_SmallestImagePixelValueInPlane", "1"}
-	tagDict[Tag{0x0028, 0x0111}] = Info{Tag{0x0028, 0x0111}, "US", "RETIRED_LargestImagePixelValueInPlane", "1"}
-	tagDict[Tag{0x0028, 0x0200}] = Info{Tag{0x0028, 0x0200}, "US", "RETIRED_ImageLocation", "1"}
-	tagDict[Tag{0x0028, 0x0400}] = Info{Tag{0x0028, 0x0400}, "LO", "RETIRED_TransformLabel", "1"}
-	tagDict[Tag{0x0028, 0x0401}] = Info{Tag{0x0028, 0x0401}, "LO", "RETIRED_TransformVersionNumber", "1"}
-	tagDict[Tag{0x0028, 0x0402}] = Info{Tag{0x0028, 0x0402}, "US", "RETIRED_NumberOfTransformSteps", "1"}
-	tagDict[Tag{0x0028, 0x0403}] = Info{Tag{0x0028, 0x0403}, "LO", "RETIRED_SequenceOfCompressedData", "1-n"}
-	tagDict[Tag{0x0028, 0x0404}] = Info{Tag{0x0028, 0x0404}, "AT", "RETIRED_DetailsOfCoefficients", "1-n"}
-	tagDict[Tag{0x0028, 0x0700}] = Info{Tag{0x0028, 0x0700}, "LO", "RETIRED_DCTLabel", "1"}
-	tagDict[Tag{0x0028, 0x0701}] = Info{Tag{0x0028, 0x0701}, "CS", "RETIRED_DataBlockDescription", "1-n"}
-	tagDict[Tag{0x0028, 0x0702}] = Info{Tag{0x0028, 0x0702}, "AT", "RETIRED_DataBlock", "1-n"}
-	tagDict[Tag{0x0028, 0x0710}] = Info{Tag{0x0028, 0x0710}, "US", "RETIRED_NormalizationFactorFormat", "1"}
-	tagDict[Tag{0x0028, 0x0720}] = Info{Tag{0x0028, 0x0720}, "US", "RETIRED_ZonalMapNumberFormat", "1"}
-	tagDict[Tag{0x0028, 0x0721}] = Info{Tag{0x0028, 0x0721}, "AT", "RETIRED_ZonalMapLocation", "1-n"}
-	tagDict[Tag{0x0028, 0x0722}] = Info{Tag{0x0028, 0x0722}, "US", "RETIRED_ZonalMapFormat", "1"}
-	tagDict[Tag{0x0028, 0x0730}] = Info{Tag{0x0028, 0x0730}, "US", "RETIRED_AdaptiveMapFormat", "1"}
-	tagDict[Tag{0x0028, 0x0740}] = Info{Tag{0x0028, 0x0740}, "US", "RETIRED_CodeNumberFormat", "1"}
-	tagDict[Tag{0x0028, 0x1080}] = Info{Tag{0x0028, 0x1080}, "CS", "RETIRED_GrayScale", "1"}
-	tagDict[Tag{0x0028, 0x1100}] = Info{Tag{0x0028, 0x1100}, "US", "RETIRED_GrayLookupTableDescriptor", "3"}
-	tagDict[Tag{0x0028, 0x1111}] = Info{Tag{0x0028, 0x1111}, "US", "RETIRED_LargeRedPaletteColorLookupTableDescriptor", "4"}
-	tagDict[Tag{0x0028, 0x1112}] = Info{Tag{0x0028, 0x1112}, "US", "RETIRED_LargeGreenPaletteColorLookupTableDescriptor", "4"}
-	tagDict[Tag{0x0028, 0x1113}] = Info{Tag{0x0028, 0x1113}, "US", "RETIRED_LargeBluePaletteColorLookupTableDescriptor", "4"}
-	tagDict[Tag{0x0028, 0x1200}] = Info{Tag{0x0028, 0x1200}, "LT", "RETIRED_GrayLookupTableData", "1-n"}
-	tagDict[Tag{0x0028, 0x1211}] = Info{Tag{0x0028, 0x1211}, "OW", "RETIRED_LargeRedPaletteColorLookupTableData", "1"}
-	tagDict[Tag{0x0028, 0x1212}] = Info{Tag{0x0028, 0x1212}, "OW", "RETIRED_LargeGreenPaletteColorLookupTableData", "1"}
-	tagDict[Tag{0x0028, 0x1213}] = Info{Tag{0x0028, 0x1213}, "OW", "RETIRED_LargeBluePaletteColorLookupTableData", "1"}
-	tagDict[Tag{0x0028, 0x1214}] = Info{Tag{0x0028, 0x1214}, "UI", "RETIRED_LargePaletteColorLookupTableUID", "1"}
-	tagDict[Tag{0x0028, 0x4000}] = Info{Tag{0x0028, 0x4000}, "LT", "RETIRED_ImagePresentationComments", "1"}
-	tagDict[Tag{0x0028, 0x5000}] = Info{Tag{0x0028, 0x5000}, "SQ", "RETIRED_BiPlaneAcquisitionSequence", "1"}
-	tagDict[Tag{0x0028, 0x6030}] = Info{Tag{0x0028, 0x6030}, "US", "RETIRED_MaskPointers", "1-n"}
-	tagDict[Tag{0x0028, 0x9099}] = Info{Tag{0x0028, 0x9099}, "US", "RETIRED_LargestMonochromePixelValue", "1"}
-	tagDict[Tag{0x0032, 0x000A}] = Info{Tag{0x0032, 0x000A}, "CS", "RETIRED_StudyStatusID", "1"}
-	tagDict[Tag{0x0032, 0x000C}] = Info{Tag{0x0032, 0x000C}, "CS", "RETIRED_StudyPriorityID", "1"}
-	tagDict[Tag{0x0032, 0x0012}] = Info{Tag{0x0032, 0x0012}, "LO", "RETIRED_StudyIDIssuer", "1"}
-	tagDict[Tag{0x0032, 0x0032}] = Info{Tag{0x0032, 0x0032}, "DA", "RETIRED_StudyVerifiedDate", "1"}
-	tagDict[Tag{0x0032, 0x0033}] = Info{Tag{0x0032, 0x0033}, "TM", "RETIRED_StudyVerifiedTime", "1"}
-	tagDict[Tag{0x0032, 0x0034}] = Info{Tag{0x0032, 0x0034}, "DA", "RETIRED_StudyReadDate", "1"}
-	tagDict[Tag{0x0032, 0x0035}] = Info{Tag{0x0032, 0x0035}, "TM", "RETIRED_StudyReadTime", "1"}
-	tagDict[Tag{0x0032, 0x1000}] = Info{Tag{0x0032, 0x1000}, "DA", "RETIRED_ScheduledStudyStartDate", "1"}
-	tagDict[Tag{0x0032, 0x1001}] = Info{Tag{0x0032, 0x1001}, "TM", "RETIRED_ScheduledStudyStartTime", "1"}
-	tagDict[Tag{0x0032, 0x1010}] = Info{Tag{0x0032, 0x1010}, "DA", "RETIRED_ScheduledStudyStopDate", "1"}
-	tagDict[Tag{0x0032, 0x1011}] = Info{Tag{0x0032, 0x1011}, "TM", "RETIRED_ScheduledStudyStopTime", "1"}
-	tagDict[Tag{0x0032, 0x1020}] = Info{Tag{0x0032, 0x1020}, "LO", "RETIRED_ScheduledStudyLocation", "1"}
-	tagDict[Tag{0x0032, 0x1021}] = Info{Tag{0x0032, 0x1021}, "AE", "RETIRED_ScheduledStudyLocationAETitle", "1-n"}
-	tagDict[Tag{0x0032, 0x1030}] = Info{Tag{0x0032, 0x1030}, "LO", "RETIRED_ReasonForStudy", "1"}
-	tagDict[Tag{0x0032, 0x1040}] = Info{Tag{0x0032, 0x1040}, "DA", "RETIRED_StudyArrivalDate", "1"}
-	tagDict[Tag{0x0032, 0x1041}] = Info{Tag{0x0032, 0x1041}, "TM", "RETIRED_StudyArrivalTime", "1"}
-	tagDict[Tag{0x0032, 0x1050}] = Info{Tag{0x0032, 0x1050}, "DA", "RETIRED_StudyCompletionDate", "1"}
-	tagDict[Tag{0x0032, 0x1051}] = Info{Tag{0x0032, 0x1051}, "TM", "RETIRED_StudyCompletionTime", "1"}
-	tagDict[Tag{0x0032, 0x1055}] = Info{Tag{0x0032, 0x1055}, "CS", "RETIRED_StudyComponentStatusID", "1"}
-	tagDict[Tag{0x0032, 0x4000}] = Info{Tag{0x0032, 0x4000}, "LT", "RETIRED_StudyComments", "1"}
-	tagDict[Tag{0x0038, 0x0011}] = Info{Tag{0x0038, 0x0011}, "LO", "RETIRED_IssuerOfAdmissionID", "1"}
-	tagDict[Tag{0x0038, 0x001A}] = Info{Tag{0x0038, 0x001A}, "DA", "RETIRED_ScheduledAdmissionDate", "1"}
-	tagDict[Tag{0x0038, 0x001B}] = Info{Tag{0x0038, 0x001B}, "TM", "RETIRED_ScheduledAdmissionTime", "1"}
-	tagDict[Tag{0x0038, 0x001C}] = Info{Tag{0x0038, 0x001C}, "DA", "RETIRED_ScheduledDischargeDate", "1"}
-	tagDict[Tag{0x0038, 0x001D}] = Info{Tag{0x0038, 0x001D}, "TM", "RETIRED_ScheduledDischargeTime", "1"}
-	tagDict[Tag{0x0038, 0x001E}] = Info{Tag{0x0038, 0x001E}, "LO", "RETIRED_ScheduledPatientInstitutionResidence", "1"}
-	tagDict[Tag{0x0038, 0x0030}] = Info{Tag{0x0038, 0x0030}, "DA", "RETIRED_DischargeDate", "1"}
-	tagDict[Tag{0x0038, 0x0032}] = Info{Tag{0x0038, 0x0032}, "TM", "RETIRED_DischargeTime", "1"}
-	tagDict[Tag{0x0038, 0x0040}] = Info{Tag{0x0038, 0x0040}, "LO", "RETIRED_DischargeDiagnosisDescription", "1"}
-	tagDict[Tag{0x0038, 0x0044}] = Info{Tag{0x0038, 0x0044}, "SQ", "RETIRED_DischargeDiagnosisCodeSequence", "1"}
-	tagDict[Tag{0x0038, 0x0061}] = Info{Tag{0x0038, 0x0061}, "LO", "RETIRED_IssuerOfServiceEpisodeID", "1"}
-	tagDict[Tag{0x0040, 0x0307}] = Info{Tag{0x0040, 0x0307}, "DS", "RETIRED_DistanceSourceToSupport", "1"}
-	tagDict[Tag{0x0040, 0x0330}] = Info{Tag{0x0040, 0x0330}, "SQ", "RETIRED_ReferencedProcedureStepSequence", "1"}
-	tagDict[Tag{0x0040, 0x050A}] = Info{Tag{0x0040, 0x050A}, "LO", "RETIRED_SpecimenAccessionNumber", "1"}
-	tagDict[Tag{0x0040, 0x0550}] = Info{Tag{0x0040, 0x0550}, "SQ", "RETIRED_SpecimenSequence", "1"}
-	tagDict[Tag{0x0040, 0x0552}] = Info{Tag{0x0040, 0x0552}, "SQ", "RETIRED_SpecimenDescriptionSequenceTrial", "1"}
-	tagDict[Tag{0x0040, 0x0553}] = Info{Tag{0x0040, 0x0553}, "ST", "RETIRED_SpecimenDescriptionTrial", "1"}
-	tagDict[Tag{0x0040, 0x06FA}] = Info{Tag{0x0040, 0x06FA}, "LO", "RETIRED_SlideIdentifier", "1"}
-	tagDict[Tag{0x0040, 0x08D8}] = Info{Tag{0x0040, 0x08D8}, "SQ", "RETIRED_PixelSpacingSequence", "1"}
-	tagDict[Tag{0x0040, 0x08DA}] = Info{Tag{0x0040, 0x08DA}, "SQ", "RETIRED_CoordinateSystemAxisCodeSequence", "1"}
-	tagDict[Tag{0x0040, 0x09F8}] = Info{Tag{0x0040, 0x09F8}, "SQ", "RETIRED_VitalStainCodeSequenceTrial", "1"}
-	tagDict[Tag{0x0040, 0x1006}] = Info{Tag{0x0040, 0x1006}, "SH", "RETIRED_PlacerOrderNumberProcedure", "1"}
-	tagDict[Tag{0x0040, 0x1007}] = Info{Tag{0x0040, 0x1007}, "SH", "RETIRED_FillerOrderNumberProcedure", "1"}
-	tagDict[Tag{0x0040, 0x1060}] = Info{Tag{0x0040, 0x1060}, "LO", "RETIRED_RequestedProcedureDescriptionTrial", "1"}
-	tagDict[Tag{0x0040, 0x2001}] = Info{Tag{0x0040, 0x2001}, "LO", "RETIRED_ReasonForTheImagingServiceRequest", "1"}
-	tagDict[Tag{0x0040, 0x2006}] = Info{Tag{0x0040, 0x2006}, "SH", "RETIRED_PlacerOrderNumberImagingServiceRequestRetired", "1"}
-	tagDict[Tag{0x0040, 0x2007}] = Info{Tag{0x0040, 0x2007}, "SH", "RETIRED_FillerOrderNumberImagingServiceRequestRetired", "1"}
-	tagDict[Tag{0x0040, 0xA007}] = Info{Tag{0x0040, 0xA007}, "CS", "RETIRED_FindingsFlagTrial", "1"}
-	tagDict[Tag{0x0040, 0xA020}] = Info{Tag{0x0040, 0xA020}, "SQ", "RETIRED_FindingsSequenceTrial", "1"}
-	tagDict[Tag{0x0040, 0xA021}] = Info{Tag{0x0040, 0xA021}, "UI", "RETIRED_FindingsGroupUIDTrial", "1"}
-	tagDict[Tag{0x0040, 0xA022}] = Info{Tag{0x0040, 0xA022}, "UI", "RETIRED_ReferencedFindingsGroupUIDTrial", "1"}
-	tagDict[Tag{0x0040, 0xA023}] = Info{Tag{0x0040, 0xA023}, "DA", "RETIRED_FindingsGroupRecordingDateTrial", "1"}
-	tagDict[Tag{0x0040, 0xA024}] = Info{Tag{0x0040, 0xA024}, "TM", "RETIRED_FindingsGroupRecordingTimeTrial", "1"}
-	tagDict[Tag{0x0040, 0xA026}] = Info{Tag{0x0040, 0xA026}, "SQ", "RETIRED_FindingsSourceCategoryCodeSequenceTrial", "1"}
-	tagDict[Tag{0x0040, 0xA028}] = Info{Tag{0x0040, 0xA028}, "SQ", "RETIRED_DocumentingOrganizationIdentifierCodeSequenceTrial", "1"}
-	tagDict[Tag{0x0040, 0xA047}] = Info{Tag{0x0040, 0xA047}, "LO", "RETIRED_MeasurementPrecisionDescriptionTrial", "1"}
-	tagDict[Tag{0x0040, 0xA057}] = Info{Tag{0x0040, 0xA057}, "CS", "RETIRED_UrgencyOrPriorityAlertsTrial", "1-n"}
-	tagDict[Tag{0x0040, 0xA060}] = Info{Tag{0x0040, 0xA060}, "LO", "RETIRED_SequencingIndicatorTrial", "1"}
-	tagDict[Tag{0x0040, 0xA066}] = Info{Tag{0x0040, 0xA066}, "SQ", "RETIRED_DocumentIdentifierCodeSequenceTrial", "1"}
-	tagDict[Tag{0x0040, 0xA067}] = Info{Tag{0x0040, 0xA067}, "PN", "RETIRED_DocumentAuthorTrial", "1"}
-	tagDict[Tag{0x0040, 0xA068}] = Info{Tag{0x0040, 0xA068}, "SQ", "RETIRED_DocumentAuthorIdentifierCodeSequenceTrial", "1"}
-	tagDict[Tag{0x0040, 0xA070}] = Info{Tag{0x0040, 0xA070}, "SQ", "RETIRED_IdentifierCodeSequenceTrial", "1"}
-	tagDict[Tag{0x0040, 0xA074}] = Info{Tag{0x0040, 0xA074}, "OB", "RETIRED_ObjectBinaryIdentifierTrial", "1"}
-	tagDict[Tag{0x0040, 0xA076}] = Info{Tag{0x0040, 0xA076}, "SQ", "RETIRED_DocumentingObserverIdentifierCodeSequenceTrial", "1"}
-	tagDict[Tag{0x0040, 0xA085}] = Info{Tag{0x0040, 0xA085}, "SQ", "RETIRED_ProcedureIdentifierCodeSequenceTrial", "1"}
-	tagDict[Tag{0x0040, 0xA089}] = Info{Tag{0x0040, 0xA089}, "OB", "RETIRED_ObjectDirectoryBinaryIdentifierTrial", "1"}
-	tagDict[Tag{0x0040, 0xA090}] = Info{Tag{0x0040, 0xA090}, "SQ", "RETIRED_EquivalentCDADocumentSequence", "1"}
-	tagDict[Tag{0x0040, 0xA110}] = Info{Tag{0x0040, 0xA110}, "DA", "RETIRED_DateOfDocumentOrVerbalTransactionTrial", "1"}
-	tagDict[Tag{0x0040, 0xA112}] = Info{Tag{0x0040, 0xA112}, "TM", "RETIRED_TimeOfDocumentCreationOrVerbalTransactionTrial", "1"}
-	tagDict[Tag{0x0040, 0xA125}] = Info{Tag{0x0040, 0xA125}, "CS", "RETIRED_ReportStatusIDTrial", "2"}
-	tagDict[Tag{0x0040, 0xA167}] = Info{Tag{0x0040, 0xA167}, "SQ", "RETIRED_ObservationCategoryCodeSequenceTrial", "1"}
-	tagDict[Tag{0x0040, 0xA16A}] = Info{Tag{0x0040, 0xA16A}, "ST", "RETIRED_BibliographicCitationTrial", "1"}
-	tagDict[Tag{0x0040, 0xA172}] = Info{Tag{0x0040, 0xA172}, "UI", "RETIRED_ReferencedObservationUIDTrial", "1"}
-	tagDict[Tag{0x0040, 0xA173}] = Info{Tag{0x0040, 0xA173}, "CS", "RETIRED_ReferencedObservationClassTrial", "1"}
-	tagDict[Tag{0x0040, 0xA174}] = Info{Tag{0x0040, 0xA174}, "CS", "RETIRED_ReferencedObjectObservationClassTrial", "1"}
-	tagDict[Tag{0x0040, 0xA192}] = Info{Tag{0x0040, 0xA192}, "DA", "RETIRED_ObservationDateTrial", "1"}
-	tagDict[Tag{0x0040, 0xA193}] = Info{Tag{0x0040, 0xA193}, "TM", "RETIRED_ObservationTimeTrial", "1"}
-	tagDict[Tag{0x0040, 0xA194}] = Info{Tag{0x0040, 0xA194}, "CS", "RETIRED_MeasurementAutomationTrial", "1"}
-	tagDict[Tag{0x0040, 0xA224}] = Info{Tag{0x0040, 0xA224}, "ST", "RETIRED_IdentificationDescriptionTrial", "1"}
-	tagDict[Tag{0x0040, 0xA290}] = Info{Tag{0x0040, 0xA290}, "CS", "RETIRED_CoordinatesSetGeometricTypeTrial", "1"}
-	tagDict[Tag{0x0040, 0xA296}] = Info{Tag{0x0040, 0xA296}, "SQ", "RETIRED_AlgorithmCodeSequenceTrial", "1"}
-	tagDict[Tag{0x0040, 0xA297}] = Info{Tag{0x0040, 0xA297}, "ST", "RETIRED_AlgorithmDescriptionTrial", "1"}
-	tagDict[Tag{0x0040, 0xA29A}] = Info{Tag{0x0040, 0xA29A}, "SL", "RETIRED_PixelCoordinatesSetTrial", "2-2n"}
-	tagDict[Tag{0x0040, 0xA307}] = Info{Tag{0x0040, 0xA307}, "PN", "RETIRED_CurrentObserverTrial", "1"}
-	tagDict[Tag{0x0040, 0xA313}] = Info{Tag{0x0040, 0xA313}, "SQ", "RETIRED_ReferencedAccessionSequenceTrial", "1"}
-	tagDict[Tag{0x0040, 0xA33A}] = Info{Tag{0x0040, 0xA33A}, "ST", "RETIRED_ReportStatusCommentTrial", "1"}
-	tagDict[Tag{0x0040, 0xA340}] = Info{Tag{0x0040, 0xA340}, "SQ", "RETIRED_ProcedureContextSequenceTrial", "1"}
-	tagDict[Tag{0x0040, 0xA352}] = Info{Tag{0x0040, 0xA352}, "PN", "RETIRED_VerbalSourceTrial", "1"}
-	tagDict[Tag{0x0040, 0xA353}] = Info{Tag{0x0040, 0xA353}, "ST", "RETIRED_AddressTrial", "1"}
-	tagDict[Tag{0x0040, 0xA354}] = Info{Tag{0x0040, 0xA354}, "LO", "RETIRED_TelephoneNumberTrial", "1"}
-	tagDict[Tag{0x0040, 0xA358}] = Info{Tag{0x0040, 0xA358}, "SQ", "RETIRED_VerbalSourceIdentifierCodeSequenceTrial", "1"}
-	tagDict[Tag{0x0040, 0xA380}] = Info{Tag{0x0040, 0xA380}, "SQ", "RETIRED_ReportDetailSequenceTrial", "1"}
-	tagDict[Tag{0x0040, 0xA402}] = Info{Tag{0x0040, 0xA402}, "UI", "RETIRED_ObservationSubjectUIDTrial", "1"}
-	tagDict[Tag{0x0040, 0xA403}] = Info{Tag{0x0040, 0xA403}, "CS", "RETIRED_ObservationSubjectClassTrial", "1"}
-	tagDict[Tag{0x0040, 0xA404}] = Info{Tag{0x0040, 0xA404}, "SQ", "RETIRED_ObservationSubjectTypeCodeSequenceTrial", "1"}
-	tagDict[Tag{0x0040, 0xA600}] = Info{Tag{0x0040, 0xA600}, "CS", "RETIRED_ObservationSubjectContextFlagTrial", "1"}
-	tagDict[Tag{0x0040, 0xA601}] = Info{Tag{0x0040, 0xA601}, "CS", "RETIRED_ObserverContextFlagTrial", "1"}
-	tagDict[Tag{0x0040, 0xA603}] = Info{Tag{0x0040, 0xA603}, "CS", "RETIRED_ProcedureContextFlagTrial", "1"}
-	tagDict[Tag{0x0040, 0xA731}] = Info{Tag{0x0040, 0xA731}, "SQ", "RETIRED_RelationshipSequenceTrial", "1"}
-	tagDict[Tag{0x0040, 0xA732}] = Info{Tag{0x0040, 0xA732}, "SQ", "RETIRED_RelationshipTypeCodeSequenceTrial", "1"}
-	tagDict[Tag{0x0040, 0xA744}] = Info{Tag{0x0040, 0xA744}, "SQ", "RETIRED_LanguageCodeSequenceTrial", "1"}
-	tagDict[Tag{0x0040, 0xA992}] = Info{Tag{0x0040, 0xA992}, "ST", "RETIRED_UniformResourceLocatorTrial", "1"}
-	tagDict[Tag{0x0040, 0xDB06}] = Info{Tag{0x0040, 0xDB06}, "DT", "RETIRED_TemplateVersion", "1"}
-	tagDict[Tag{0x0040, 0xDB07}] = Info{Tag{0x0040, 0xDB07}, "DT", "RETIRED_TemplateLocalVersion", "1"}
-	tagDict[Tag{0x0040, 0xDB0B}] = Info{Tag{0x0040, 0xDB0B}, "CS", "RETIRED_TemplateExtensionFlag", "1"}
-	tagDict[Tag{0x0040, 0xDB0C}] = Info{Tag{0x0040, 0xDB0C}, "UI", "RETIRED_TemplateExtensionOrganizationUID", "1"}
-	tagDict[Tag{0x0040, 0xDB0D}] = Info{Tag{0x0040, 0xDB0D}, "UI", "RETIRED_TemplateExtensionCreatorUID", "1"}
-	tagDict[Tag{0x0054, 0x1400}] = Info{Tag{0x0054, 0x1400}, "CS", "RETIRED_CountsIncluded", "1-n"}
-	tagDict[Tag{0x0054, 0x1401}] = Info{Tag{0x0054, 0x1401}, "CS", "RETIRED_DeadTimeCorrectionFlag", "1"}
-	tagDict[Tag{0x0070, 0x0040}] = Info{Tag{0x0070, 0x0040}, "IS", "RETIRED_ImageRotationRetired", "1"}
-	tagDict[Tag{0x0070, 0x0050}] = Info{Tag{0x0070, 0x0050}, "US", "RETIRED_DisplayedAreaTopLeftHandCornerTrial", "2"}
-	tagDict[Tag{0x0070, 0x0051}] = Info{Tag{0x0070, 0x0051}, "US", "RETIRED_DisplayedAreaBottomRightHandCornerTrial", "2"}
-	tagDict[Tag{0x0070, 0x0067}] = Info{Tag{0x0070, 0x0067}, "US", "RETIRED_GraphicLayerRecommendedDisplayRGBValue", "3"}
-	tagDict[Tag{0x0074, 0x1024}] = Info{Tag{0x0074, 0x1024}, "IS", "RETIRED_BeamOrderIndexTrial", "1"}
-	tagDict[Tag{0x0074, 0x1038}] = Info{Tag{0x0074, 0x1038}, "DS", "RETIRED_DoubleExposureMetersetTrial", "1"}
-	tagDict[Tag{0x0074, 0x103A}] = Info{Tag{0x0074, 0x103A}, "DS", "RETIRED_DoubleExposureFieldDeltaTrial", "4"}
-	tagDict[Tag{0x0074, 0x1220}] = Info{Tag{0x0074, 0x1220}, "SQ", "RETIRED_RelatedProcedureStepSequence", "1"}
-	tagDict[Tag{0x0074, 0x1222}] = Info{Tag{0x0074, 0x1222}, "LO", "RETIRED_ProcedureStepRelationshipType", "1"}
-	tagDict[Tag{0x0088, 0x0904}] = Info{Tag{0x0088, 0x0904}, "LO", "RETIRED_TopicTitle", "1"}
-	tagDict[Tag{0x0088, 0x0906}] = Info{Tag{0x0088, 0x0906}, "ST", "RETIRED_TopicSubject", "1"}
-	tagDict[Tag{0x0088, 0x0910}] = Info{Tag{0x0088, 0x0910}, "LO", "RETIRED_TopicAuthor", "1"}
-	tagDict[Tag{0x0088, 0x0912}] = Info{Tag{0x0088, 0x0912}, "LO", "RETIRED_TopicKeywords", "1-32"}
-	tagDict[Tag{0x2000, 0x0062}] = Info{Tag{0x2000, 0x0062}, "CS", "RETIRED_ColorImagePrintingFlag", "1"}
-	tagDict[Tag{0x2000, 0x0063}] = Info{Tag{0x2000, 0x0063}, "CS", "RETIRED_CollationFlag", "1"}
-	tagDict[Tag{0x2000, 0x0065}] = Info{Tag{0x2000, 0x0065}, "CS", "RETIRED_AnnotationFlag", "1"}
-	tagDict[Tag{0x2000, 0x0067}] = Info{Tag{0x2000, 0x0067}, "CS", "RETIRED_ImageOverlayFlag", "1"}
-	tagDict[Tag{0x2000, 0x0069}] = Info{Tag{0x2000, 0x0069}, "CS", "RETIRED_PresentationLUTFlag", "1"}
-	tagDict[Tag{0x2000, 0x006A}] = Info{Tag{0x2000, 0x006A}, "CS", "RETIRED_ImageBoxPresentationLUTFlag", "1"}
-	tagDict[Tag{0x2000, 0x0510}] = Info{Tag{0x2000, 0x0510}, "SQ", "RETIRED_ReferencedStoredPrintSequence", "1"}
-	tagDict[Tag{0x2020, 0x0130}] = Info{Tag{0x2020, 0x0130}, "SQ", "RETIRED_ReferencedImageOverlayBoxSequence", "1"}
-	tagDict[Tag{0x2020, 0x0140}] = Info{Tag{0x2020, 0x0140}, "SQ", "RETIRED_ReferencedVOILUTBoxSequence", "1"}
-	tagDict[Tag{0x2040, 0x0010}] = Info{Tag{0x2040, 0x0010}, "SQ", "RETIRED_ReferencedOverlayPlaneSequence", "1"}
-	tagDict[Tag{0x2040, 0x0011}] = Info{Tag{0x2040, 0x0011}, "US", "RETIRED_ReferencedOverlayPlaneGroups", "1-99"}
-	tagDict[Tag{0x2040, 0x0020}] = Info{Tag{0x2040, 0x0020}, "SQ", "RETIRED_OverlayPixelDataSequence", "1"}
-	tagDict[Tag{0x2040, 0x0060}] = Info{Tag{0x2040, 0x0060}, "CS", "RETIRED_OverlayMagnificationType", "1"}
-	tagDict[Tag{0x2040, 0x0070}] = Info{Tag{0x2040, 0x0070}, "CS", "RETIRED_OverlaySmoothingType", "1"}
-	tagDict[Tag{0x2040, 0x0072}] = Info{Tag{0x2040, 0x0072}, "CS", "RETIRED_OverlayOrImageMagnification", "1"}
-	tagDict[Tag{0x2040, 0x0074}] = Info{Tag{0x2040, 0x0074}, "US", "RETIRED_MagnifyToNumberOfColumns", "1"}
-	tagDict[Tag{0x2040, 0x0080}] = Info{Tag{0x2040, 0x0080}, "CS", "RETIRED_OverlayForegroundDensity", "1"}
-	tagDict[Tag{0x2040, 0x0082}] = Info{Tag{0x2040, 0x0082}, "CS", "RETIRED_OverlayBackgroundDensity", "1"}
-	tagDict[Tag{0x2040, 0x0090}] = Info{Tag{0x2040, 0x0090}, "CS", "RETIRED_OverlayMode", "1"}
-	tagDict[Tag{0x2040, 0x0100}] = Info{Tag{0x2040, 0x0100}, "CS", "RETIRED_ThresholdDensity", "1"}
-	tagDict[Tag{0x2040, 0x0500}] = Info{Tag{0x2040, 0x0500}, "SQ", "RETIRED_ReferencedImageBoxSequenceRetired", "1"}
-	tagDict[Tag{0x2100, 0x0010}] = Info{Tag{0x2100, 0x0010}, "SH", "RETIRED_PrintJobID", "1"}
-	tagDict[Tag{0x2100, 0x0140}] = Info{Tag{0x2100, 0x0140}, "AE", "RETIRED_DestinationAE", "1"}
-	tagDict[Tag{0x2100, 0x0500}] = Info{Tag{0x2100, 0x0500}, "SQ", "RETIRED_ReferencedPrintJobSequencePullStoredPrint", "1"}
-	tagDict[Tag{0x2110, 0x0099}] = Info{Tag{0x2110, 0x0099}, "SH", "RETIRED_PrintQueueID", "1"}
-	tagDict[Tag{0x2120, 0x0010}] = Info{Tag{0x2120, 0x0010}, "CS", "RETIRED_QueueStatus", "1"}
-	tagDict[Tag{0x2120, 0x0050}] = Info{Tag{0x2120, 0x0050}, "SQ", "RETIRED_PrintJobDescriptionSequence", "1"}
-	tagDict[Tag{0x2120, 0x0070}] = Info{Tag{0x2120, 0x0070}, "SQ", "RETIRED_ReferencedPrintJobSequence", "1"}
-	tagDict[Tag{0x2130, 0x0010}] = Info{Tag{0x2130, 0x0010}, "SQ", "RETIRED_PrintManagementCapabilitiesSequence", "1"}
-	tagDict[Tag{0x2130, 0x0015}] = Info{Tag{0x2130, 0x0015}, "SQ", "RETIRED_PrinterCharacteristicsSequence", "1"}
-	tagDict[Tag{0x2130, 0x0030}] = Info{Tag{0x2130, 0x0030}, "SQ", "RETIRED_FilmBoxContentSequence", "1"}
-	tagDict[Tag{0x2130, 0x0040}] = Info{Tag{0x2130, 0x0040}, "SQ", "RETIRED_ImageBoxContentSequence", "1"}
-	tagDict[Tag{0x2130, 0x0050}] = Info{Tag{0x2130, 0x0050}, "SQ", "RETIRED_AnnotationContentSequence", "1"}
-	tagDict[Tag{0x2130, 0x0060}] = Info{Tag{0x2130, 0x0060}, "SQ", "RETIRED_ImageOverlayBoxContentSequence", "1"}
-	tagDict[Tag{0x2130, 0x0080}] = Info{Tag{0x2130, 0x0080}, "SQ", "RETIRED_PresentationLUTContentSequence", "1"}
-	tagDict[Tag{0x2130, 0x00A0}] = Info{Tag{0x2130, 0x00A0}, "SQ", "RETIRED_ProposedStudySequence", "1"}
-	tagDict[Tag{0x2130, 0x00C0}] = Info{Tag{0x2130, 0x00C0}, "SQ", "RETIRED_OriginalImageSequence", "1"}
-	tagDict[Tag{0x4000, 0x0010}] = Info{Tag{0x4000, 0x0010}, "LT", "RETIRED_Arbitrary", "1"}
-	tagDict[Tag{0x4000, 0x4000}] = Info{Tag{0x4000, 0x4000}, "LT", "RETIRED_TextComments", "1"}
-	tagDict[Tag{0x4008, 0x0040}] = Info{Tag{0x4008, 0x0040}, "SH", "RETIRED_ResultsID", "1"}
-	tagDict[Tag{0x4008, 0x0042}] = Info{Tag{0x4008, 0x0042}, "LO", "RETIRED_ResultsIDIssuer", "1"}
-	tagDict[Tag{0x4008, 0x0050}] = Info{Tag{0x4008, 0x0050}, "SQ", "RETIRED_ReferencedInterpretationSequence", "1"}
-	tagDict[Tag{0x4008, 0x00FF}] = Info{Tag{0x4008, 0x00FF}, "CS", "RETIRED_ReportProductionStatusTrial", "1"}
-	tagDict[Tag{0x4008, 0x0100}] = Info{Tag{0x4008, 0x0100}, "DA", "RETIRED_InterpretationRecordedDate", "1"}
-	tagDict[Tag{0x4008, 0x0101}] = Info{Tag{0x4008, 0x0101}, "TM", "RETIRED_InterpretationRecordedTime", "1"}
-	tagDict[Tag{0x4008, 0x0102}] = Info{Tag{0x4008, 0x0102}, "PN", "RETIRED_InterpretationRecorder", "1"}
-	tagDict[Tag{0x4008, 0x0103}] = Info{Tag{0x4008, 0x0103}, "LO", "RETIRED_ReferenceToRecordedSound", "1"}
-	tagDict[Tag{0x4008, 0x0108}] = Info{Tag{0x4008, 0x0108}, "DA", "RETIRED_InterpretationTranscriptionDate", "1"}
-	tagDict[Tag{0x4008, 0x0109}] = Info{Tag{0x4008, 0x0109}, "TM", "RETIRED_InterpretationTranscriptionTime", "1"}
-	tagDict[Tag{0x4008, 0x010A}] = Info{Tag{0x4008, 0x010A}, "PN", "RETIRED_InterpretationTranscriber", "1"}
-	tagDict[Tag{0x4008, 0x010B}] = Info{Tag{0x4008, 0x010B}, "ST", "RETIRED_InterpretationText", "1"}
-	tagDict[Tag{0x4008, 0x010C}] = Info{Tag{0x4008, 0x010C}, "PN", "RETIRED_InterpretationAuthor", "1"}
-	tagDict[Tag{0x4008, 0x0111}] = Info{Tag{0x4008, 0x0111}, "SQ", "RETIRED_InterpretationApproverSequence", "1"}
-	tagDict[Tag{0x4008, 0x0112}] = Info{Tag{0x4008, 0x0112}, "DA", "RETIRED_InterpretationApprovalDate", "1"}
-	tagDict[Tag{0x4008, 0x0113}] = Info{Tag{0x4008, 0x0113}, "TM", "RETIRED_InterpretationApprovalTime", "1"}
-	tagDict[Tag{0x4008, 0x0114}] = Info{Tag{0x4008, 0x0114}, "PN", "RETIRED_PhysicianApprovingInterpretation", "1"}
-	tagDict[Tag{0x4008, 0x0115}] = Info{Tag{0x4008, 0x0115}, "LT", "RETIRED_InterpretationDiagnosisDescription", "1"}
-	tagDict[Tag{0x4008, 0x0117}] = Info{Tag{0x4008, 0x0117}, "SQ", "RETIRED_InterpretationDiagnosisCodeSequence", "1"}
-	tagDict[Tag{0x4008, 0x0118}] = Info{Tag{0x4008, 0x0118}, "SQ", "RETIRED_ResultsDistributionListSequence", "1"}
-	tagDict[Tag{0x4008, 0x0119}] = Info{Tag{0x4008, 0x0119}, "PN", "RETIRED_DistributionName", "1"}
-	tagDict[Tag{0x4008, 0x011A}] = Info{Tag{0x4008, 0x011A}, "LO", "RETIRED_DistributionAddress", "1"}
-	tagDict[Tag{0x4008, 0x0200}] = Info{Tag{0x4008, 0x0200}, "SH", "RETIRED_InterpretationID", "1"}
-	tagDict[Tag{0x4008, 0x0202}] = Info{Tag{0x4008, 0x0202}, "LO", "RETIRED_InterpretationIDIssuer", "1"}
-	tagDict[Tag{0x4008, 0x0210}] = Info{Tag{0x4008, 0x0210}, "CS", "RETIRED_InterpretationTypeID", "1"}
-	tagDict[Tag{0x4008, 0x0212}] = Info{Tag{0x4008, 0x0212}, "CS", "RETIRED_InterpretationStatusID", "1"}
-	tagDict[Tag{0x4008, 0x0300}] = Info{Tag{0x4008, 0x0300}, "ST", "RETIRED_Impressions", "1"}
-	tagDict[Tag{0x4008, 0x4000}] = Info{Tag{0x4008, 0x4000}, "ST", "RETIRED_ResultsComments", "1"}
-	tagDict[Tag{0x7FE0, 0x0020}] = Info{Tag{0x7FE0, 0x0020}, "OW", "RETIRED_CoefficientsSDVN", "1"}
-	tagDict[Tag{0x7FE0, 0x0030}] = Info{Tag{0x7FE0, 0x0030}, "OW", "RETIRED_CoefficientsSDHN", "1"}
-	tagDict[Tag{0x7FE0, 0x0040}] = Info{Tag{0x7FE0, 0x0040}, "OW", "RETIRED_CoefficientsSDDN", "1"}
+	tagDict[Tag{0x0000, 0x0000}] = newInfo(Tag{0x0000, 0x0000}, "UL", "CommandGroupLength", "1", false)
+	tagDict[Tag{0x0000, 0x0002}] = newInfo(Tag{0x0000, 0x0002}, "UI", "AffectedSOPClassUID", "1", false)
+	tagDict[Tag{0x0000, 0x0003}] = newInfo(Tag{0x0000, 0x0003}, "UI", "RequestedSOPClassUID", "1", false)
+	tagDict[Tag{0x0000, 0x0100}] = newInfo(Tag{0x0000, 0x0100}, "US", "CommandField", "1", false)
+	tagDict[Tag{0x0000, 0x0110}] = newInfo(Tag{0x0000, 0x0110}, "US", "MessageID", "1", false)
+	tagDict[Tag{0x0000, 0x0120}] = newInfo(Tag{0x0000, 0x0120}, "US", "MessageIDBeingRespondedTo", "1", false)
+	tagDict[Tag{0x0000, 0x0600}] = newInfo(Tag{0x0000, 0x0600}, "AE", "MoveDestination", "1", false)
+	tagDict[Tag{0x0000, 0x0700}] = newInfo(Tag{0x0000, 0x0700}, "US", "Priority", "1", false)
+	tagDict[Tag{0x0000, 0x0800}] = newInfo(Tag{0x0000, 0x0800}, "US", "CommandDataSetType", "1", false)
+	tagDict[Tag{0x0000, 0x0900}] = newInfo(Tag{0x0000, 0x0900}, "US", "Status", "1", false)
+	tagDict[Tag{0x0000, 0x0901}] = newInfo(Tag{0x0000, 0x0901}, "AT", "OffendingElement", "1-n", false)
+	tagDict[Tag{0x0000, 0x0902}] = newInfo(Tag{0x0000, 0x0902}, "LO", "ErrorComment", "1", false)
+	tagDict[Tag{0x0000, 0x0903}] = newInfo(Tag{0x0000, 0x0903}, "US", "ErrorID", "1", false)
+	tagDict[Tag{0x0000, 0x1000}] = newInfo(Tag{0x0000, 0x1000}, "UI", "AffectedSOPInstanceUID", "1", false)
+	tagDict[Tag{0x0000, 0x1001}] = newInfo(Tag{0x0000, 0x1001}, "UI", "RequestedSOPInstanceUID", "1", false)
+	tagDict[Tag{0x0000, 0x1002}] = newInfo(Tag{0x0000, 0x1002}, "US", "EventTypeID", "1", false)
+	tagDict[Tag{0x0000, 0x1005}] = newInfo(Tag{0x0000, 0x1005}, "AT", "AttributeIdentifierList", "1-n", false)
+	tagDict[Tag{0x0000, 0x1008}] = newInfo(Tag{0x0000, 0x1008}, "US", "ActionTypeID", "1", false)
+	tagDict[Tag{0x0000, 0x1020}] = newInfo(Tag{0x0000, 0x1020}, "US", "NumberOfRemainingSuboperations", "1", false)
+	tagDict[Tag{0x0000, 0x1021}] = newInfo(Tag{0x0000, 0x1021}, "US", "NumberOfCompletedSuboperations", "1", false)
+	tagDict[Tag{0x0000, 0x1022}] = newInfo(Tag{0x0000, 0x1022}, "US", "NumberOfFailedSuboperations", "1", false)
+	tagDict[Tag{0x0000, 0x1023}] = newInfo(Tag{0x0000, 0x1023}, "US", "NumberOfWarningSuboperations", "1", false)
+	tagDict[Tag{0x0000, 0x1030}] = newInfo(Tag{0x0000, 0x1030}, "AE", "MoveOriginatorApplicationEntityTitle", "1", false)
+	tagDict[Tag{0x0000, 0x1031}] = newInfo(Tag{0x0000, 0x1031}, "US", "MoveOriginatorMessageID", "1", false)
+	tagDict[Tag{0x0002, 0x0000}] = newInfo(Tag{0x0002, 0x0000}, "UL", "FileMetaInformationGroupLength", "1", false)
+	tagDict[Tag{0x0002, 0x0001}] = newInfo(Tag{0x0002, 0x0001}, "OB", "FileMetaInformationVersion", "1", false)
+	tagDict[Tag{0x0002, 0x0002}] = newInfo(Tag{0x0002, 0x0002}, "UI", "MediaStorageSOPClassUID", "1", false)
+	tagDict[Tag{0x0002, 0x0003}] = newInfo(Tag{0x0002, 0x0003}, "UI", "MediaStorageSOPInstanceUID", "1", false)
+	tagDict[Tag{0x0002, 0x0010}] = newInfo(Tag{0x0002, 0x0010}, "UI", "TransferSyntaxUID", "1", false)
+	tagDict[Tag{0x0002, 0x0012}] = newInfo(Tag{0x0002, 0x0012}, "UI", "ImplementationClassUID", "1", false)
+	tagDict[Tag{0x0002, 0x0013}] = newInfo(Tag{0x0002, 0x0013}, "SH", "ImplementationVersionName", "1", false)
+	tagDict[Tag{0x0002, 0x0016}] = newInfo(Tag{0x0002, 0x0016}, "AE", "SourceApplicationEntityTitle", "1", false)
+	tagDict[Tag{0x0002, 0x0100}] = newInfo(Tag{0x0002, 0x0100}, "UI", "PrivateInformationCreatorUID", "1", false)
+	tagDict[Tag{0x0002, 0x0102}] = newInfo(Tag{0x0002, 0x0102}, "OB", "PrivateInformation", "1", false)
+	tagDict[Tag{0x0004, 0x1130}] = newInfo(Tag{0x0004, 0x1130}, "CS", "FileSetID", "1", false)
+	tagDict[Tag{0x0004, 0x1141}] = newInfo(Tag{0x0004, 0x1141}, "CS", "FileSetDescriptorFileID", "1-8", false)
+	tagDict[Tag{0x0004, 0x1142}] = newInfo(Tag{0x0004, 0x1142}, "CS", "SpecificCharacterSetOfFileSetDescriptorFile", "1", false)
+	tagDict[Tag{0x0004, 0x1200}] = newInfo(Tag{0x0004, 0x1200}, "UL", "OffsetOfTheFirstDirectoryRecordOfTheRootDirectoryEntity", "1", false)
+	tagDict[Tag{0x0004, 0x1202}] = newInfo(Tag{0x0004, 0x1202}, "UL", "OffsetOfTheLastDirectoryRecordOfTheRootDirectoryEntity", "1", false)
+	tagDict[Tag{0x0004, 0x1212}] = newInfo(Tag{0x0004, 0x1212}, "US", "FileSetConsistencyFlag", "1", false)
+	tagDict[Tag{0x0004, 0x1220}] = newInfo(Tag{0x0004, 0x1220}, "SQ", "DirectoryRecordSequence", "1", false)
+	tagDict[Tag{0x0004, 0x1400}] = newInfo(Tag{0x0004, 0x1400}, "UL", "OffsetOfTheNextDirectoryRecord", "1", false)
+	tagDict[Tag{0x0004, 0x1410}] = newInfo(Tag{0x0004, 0x1410}, "US", "RecordInUseFlag", "1", false)
+	tagDict[Tag{0x0004, 0x1420}] = newInfo(Tag{0x0004, 0x1420}, "UL", "OffsetOfReferencedLowerLevelDirectoryEntity", "1", false)
+	tagDict[Tag{0x0004, 0x1430}] = newInfo(Tag{0x0004, 0x1430}, "CS", "DirectoryRecordType", "1", false)
+	tagDict[Tag{0x0004, 0x1432}] = newInfo(Tag{0x0004, 0x1432}, "UI", "PrivateRecordUID", "1", false)
+	tagDict[Tag{0x0004, 0x1500}] = newInfo(Tag{0x0004, 0x1500}, "CS", "ReferencedFileID", "1-8", false)
+	tagDict[Tag{0x0004, 0x1510}] = newInfo(Tag{0x0004, 0x1510}, "UI", "ReferencedSOPClassUIDInFile", "1", false)
+	tagDict[Tag{0x0004, 0x1511}] = newInfo(Tag{0x0004, 0x1511}, "UI", "ReferencedSOPInstanceUIDInFile", "1", false)
+	tagDict[Tag{0x0004, 0x1512}] = newInfo(Tag{0x0004, 0x1512}, "UI", "ReferencedTransferSyntaxUIDInFile", "1", false)
+	tagDict[Tag{0x0004, 0x151A}] = newInfo(Tag{0x0004, 0x151A}, "UI", "ReferencedRelatedGeneralSOPClassUIDInFile", "1-n", false)
+	tagDict[Tag{0x0008, 0x0005}] = newInfo(Tag{0x0008, 0x0005}, "CS", "SpecificCharacterSet", "1-n", false)
+	tagDict[Tag{0x0008, 0x0006}] = newInfo(Tag{0x0008, 0x0006}, "SQ", "LanguageCodeSequence", "1", false)
+	tagDict[Tag{0x0008, 0x0008}] = newInfo(Tag{0x0008, 0x0008}, "CS", "ImageType", "2-n", false)
+	tagDict[Tag{0x0008, 0x0012}] = newInfo(Tag{0x0008, 0x0012}, "DA", "InstanceCreationDate", "1", false)
+	tagDict[Tag{0x0008, 0x0013}] = newInfo(Tag{0x0008, 0x0013}, "TM", "InstanceCreationTime", "1", false)
+	tagDict[Tag{0x0008, 0x0014}] = newInfo(Tag{0x0008, 0x0014}, "UI", "InstanceCreatorUID", "1", false)
+	tagDict[Tag{0x0008, 0x0016}] = newInfo(Tag{0x0008, 0x0016}, "UI", "SOPClassUID", "1", false)
+	tagDict[Tag{0x0008, 0x0018}] = newInfo(Tag{0x0008, 0x0018}, "UI", "SOPInstanceUID", "1", false)
+	tagDict[Tag{0x0008, 0x001A}] = newInfo(Tag{0x0008, 0x001A}, "UI", "RelatedGeneralSOPClassUID", "1-n", false)
+	tagDict[Tag{0x0008, 0x001B}] = newInfo(Tag{0x0008, 0x001B}, "UI", "OriginalSpecializedSOPClassUID", "1", false)
+	tagDict[Tag{0x0008, 0x0020}] = newInfo(Tag{0x0008, 0x0020}, "DA", "StudyDate", "1", false)
+	tagDict[Tag{0x0008, 0x0021}] = newInfo(Tag{0x0008, 0x0021}, "DA", "SeriesDate", "1", false)
+	tagDict[Tag{0x0008, 0x0022}] = newInfo(Tag{0x0008, 0x0022}, "DA", "AcquisitionDate", "1", false)
+	tagDict[Tag{0x0008, 0x0023}] = newInfo(Tag{0x0008, 0x0023}, "DA", "ContentDate", "1", false)
+	tagDict[Tag{0x0008, 0x002A}] = newInfo(Tag{0x0008, 0x002A}, "DT", "AcquisitionDateTime", "1", false)
+	tagDict[Tag{0x0008, 0x0030}] = newInfo(Tag{0x0008, 0x0030}, "TM", "StudyTime", "1", false)
+	tagDict[Tag{0x0008, 0x0031}] = newInfo(Tag{0x0008, 0x0031}, "TM", "SeriesTime", "1", false)
+	tagDict[Tag{0x0008, 0x0032}] = newInfo(Tag{0x0008, 0x0032}, "TM", "AcquisitionTime", "1", false)
+	tagDict[Tag{0x0008, 0x0033}] = newInfo(Tag{0x0008, 0x0033}, "TM", "ContentTime", "1", false)
+	tagDict[Tag{0x0008, 0x0050}] = newInfo(Tag{0x0008, 0x0050}, "SH", "AccessionNumber", "1", false)
+	tagDict[Tag{0x0008, 0x0051}] = newInfo(Tag{0x0008, 0x0051}, "SQ", "IssuerOfAccessionNumberSequence", "1", false)
+	tagDict[Tag{0x0008, 0x0052}] = newInfo(Tag{0x0008, 0x0052}, "CS", "QueryRetrieveLevel", "1", false)
+	tagDict[Tag{0x0008, 0x0054}] = newInfo(Tag{0x0008, 0x0054}, "AE", "RetrieveAETitle", "1-n", false)
+	tagDict[Tag{0x0008, 0x0056}] = newInfo(Tag{0x0008, 0x0056}, "CS", "InstanceAvailability", "1", false)
+	tagDict[Tag{0x0008, 0x0058}] = newInfo(Tag{0x0008, 0x0058}, "UI", "FailedSOPInstanceUIDList", "1-n", false)
+	tagDict[Tag{0x0008, 0x0060}] = newInfo(Tag{0x0008, 0x0060}, "CS", "Modality", "1", false)
+	tagDict[Tag{0x0008, 0x0061}] = newInfo(Tag{0x0008, 0x0061}, "CS", "ModalitiesInStudy", "1-n", false)
+	tagDict[Tag{0x0008, 0x0062}] = newInfo(Tag{0x0008, 0x0062}, "UI", "SOPClassesInStudy", "1-n", false)
+	tagDict[Tag{0x0008, 0x0064}] = newInfo(Tag{0x0008, 0x0064}, "CS", "ConversionType", "1", false)
+	tagDict[Tag{0x0008, 0x0068}] = newInfo(Tag{0x0008, 0x0068}, "CS", "PresentationIntentType", "1", false)
+	tagDict[Tag{0x0008, 0x0070}] = newInfo(Tag{0x0008, 0x0070}, "LO", "Manufacturer", "1", false)
+	tagDict[Tag{0x0008, 0x0080}] = newInfo(Tag{0x0008, 0x0080}, "LO", "InstitutionName", "1", false)
+	tagDict[Tag{0x0008, 0x0081}] = newInfo(Tag{0x0008, 0x0081}, "ST", "InstitutionAddress", "1", false)
+	tagDict[Tag{0x0008, 0x0082}] = newInfo(Tag{0x0008, 0x0082}, "SQ", "InstitutionCodeSequence", "1", false)
+	tagDict[Tag{0x0008, 0x0090}] = newInfo(Tag{0x0008, 0x0090}, "PN", "ReferringPhysicianName", "1", false)
+	tagDict[Tag{0x0008, 0x0092}] = newInfo(Tag{0x0008, 0x0092}, "ST", "ReferringPhysicianAddress", "1", false)
+	tagDict[Tag{0x0008, 0x0094}] = newInfo(Tag{0x0008, 0x0094}, "SH", "ReferringPhysicianTelephoneNumbers", "1-n", false)
+	tagDict[Tag{0x0008, 0x0096}] = newInfo(Tag{0x0008, 0x0096}, "SQ", "ReferringPhysicianIdentificationSequence", "1", false)
+	tagDict[Tag{0x0008, 0x0100}] = newInfo(Tag{0x0008, 0x0100}, "SH", "CodeValue", "1", false)
+	tagDict[Tag{0x0008, 0x0102}] = newInfo(Tag{0x0008, 0x0102}, "SH", "CodingSchemeDesignator", "1", false)
+	tagDict[Tag{0x0008, 0x0103}] = newInfo(Tag{0x0008, 0x0103}, "SH", "CodingSchemeVersion", "1", false)
+	tagDict[Tag{0x0008, 0x0104}] = newInfo(Tag{0x0008, 0x0104}, "LO", "CodeMeaning", "1", false)
+	tagDict[Tag{0x0008, 0x0105}] = newInfo(Tag{0x0008, 0x0105}, "CS", "MappingResource", "1", false)
+	tagDict[Tag{0x0008, 0x0106}] = newInfo(Tag{0x0008, 0x0106}, "DT", "ContextGroupVersion", "1", false)
+	tagDict[Tag{0x0008, 0x0107}] = newInfo(Tag{0x0008, 0x0107}, "DT", "ContextGroupLocalVersion", "1", false)
+	tagDict[Tag{0x0008, 0x010B}] = newInfo(Tag{0x0008, 0x010B}, "CS", "ContextGroupExtensionFlag", "1", false)
+	tagDict[Tag{0x0008, 0x010C}] = newInfo(Tag{0x0008, 0x010C}, "UI", "CodingSchemeUID", "1", false)
+	tagDict[Tag{0x0008, 0x010D}] = newInfo(Tag{0x0008, 0x010D}, "UI", "ContextGroupExtensionCreatorUID", "1", false)
+	tagDict[Tag{0x0008, 0x010F}] = newInfo(Tag{0x0008, 0x010F}, "CS", "ContextIdentifier", "1", false)
+	tagDict[Tag{0x0008, 0x0110}] = newInfo(Tag{0x0008, 0x0110}, "SQ", "CodingSchemeIdentificationSequence", "1", false)
+	tagDict[Tag{0x0008, 0x0112}] = newInfo(Tag{0x0008, 0x0112}, "LO", "CodingSchemeRegistry", "1", false)
+	tagDict[Tag{0x0008, 0x0114}] = newInfo(Tag{0x0008, 0x0114}, "ST", "CodingSchemeExternalID", "1", false)
+	tagDict[Tag{0x0008, 0x0115}] = newInfo(Tag{0x0008, 0x0115}, "ST", "CodingSchemeName", "1", false)
+	tagDict[Tag{0x0008, 0x0116}] = newInfo(Tag{0x0008, 0x0116}, "ST", "CodingSchemeResponsibleOrganization", "1", false)
+	tagDict[Tag{0x0008, 0x0117}] = newInfo(Tag{0x0008, 0x0117}, "UI", "ContextUID", "1", false)
+	tagDict[Tag{0x0008, 0x0201}] = newInfo(Tag{0x0008, 0x0201}, "SH", "TimezoneOffsetFromUTC", "1", false)
+	tagDict[Tag{0x0008, 0x1010}] = newInfo(Tag{0x0008, 0x1010}, "SH", "StationName", "1", false)
+	tagDict[Tag{0x0008, 0x1030}] = newInfo(Tag{0x0008, 0x1030}, "LO", "StudyDescription", "1", false)
+	tagDict[Tag{0x0008, 0x1032}] = newInfo(Tag{0x0008, 0x1032}, "SQ", "ProcedureCodeSequence", "1", false)
+	tagDict[Tag{0x0008, 0x103E}] = newInfo(Tag{0x0008, 0x103E}, "LO", "SeriesDescription", "1", false)
+	tagDict[Tag{0x0008, 0x103F}] = newInfo(Tag{0x0008, 0x103F}, "SQ", "SeriesDescriptionCodeSequence", "1", false)
+	tagDict[Tag{0x0008, 0x1040}] = newInfo(Tag{0x0008, 0x1040}, "LO", "InstitutionalDepartmentName", "1", false)
+	tagDict[Tag{0x0008, 0x1048}] = newInfo(Tag{0x0008, 0x1048}, "PN", "PhysiciansOfRecord", "1-n", false)
+	tagDict[Tag{0x0008, 0x1049}] = newInfo(Tag{0x0008, 0x1049}, "SQ", "PhysiciansOfRecordIdentificationSequence", "1", false)
+	tagDict[Tag{0x0008, 0x1050}] = newInfo(Tag{0x0008, 0x1050}, "PN", "PerformingPhysicianName", "1-n", false)
+	tagDict[Tag{0x0008, 0x1052}] = newInfo(Tag{0x0008, 0x1052}, "SQ", "PerformingPhysicianIdentificationSequence", "1", false)
+	tagDict[Tag{0x0008, 0x1060}] = newInfo(Tag{0x0008, 0x1060}, "PN", "NameOfPhysiciansReadingStudy", "1-n", false)
+	tagDict[Tag{0x0008, 0x1062}] = newInfo(Tag{0x0008, 0x1062}, "SQ", "PhysiciansReadingStudyIdentificationSequence", "1", false)
+	tagDict[Tag{0x0008, 0x1070}] = newInfo(Tag{0x0008, 0x1070}, "PN", "OperatorsName", "1-n", false)
+	tagDict[Tag{0x0008, 0x1072}] = newInfo(Tag{0x0008, 0x1072}, "SQ", "OperatorIdentificationSequence", "1", false)
+	tagDict[Tag{0x0008, 0x1080}] = newInfo(Tag{0x0008, 0x1080}, "LO", "AdmittingDiagnosesDescription", "1-n", false)
+	tagDict[Tag{0x0008, 0x1084}] = newInfo(Tag{0x0008, 0x1084}, "SQ", "AdmittingDiagnosesCodeSequence", "1", false)
+	tagDict[Tag{0x0008, 0x1090}] = newInfo(Tag{0x0008, 0x1090}, "LO", "ManufacturerModelName", "1", false)
+	tagDict[Tag{0x0008, 0x1110}] = newInfo(Tag{0x0008, 0x1110}, "SQ", "ReferencedStudySequence", "1", false)
+	tagDict[Tag{0x0008, 0x1111}] = newInfo(Tag{0x0008, 0x1111}, "SQ", "ReferencedPerformedProcedureStepSequence", "1", false)
+	tagDict[Tag{0x0008, 0x1115}] = newInfo(Tag{0x0008, 0x1115}, "SQ", "ReferencedSeriesSequence", "1", false)
+	tagDict[Tag{0x0008, 0x1120}] = newInfo(Tag{0x0008, 0x1120}, "SQ", "ReferencedPatientSequence", "1", false)
+	tagDict[Tag{0x0008, 0x1125}] = newInfo(Tag{0x0008, 0x1125}, "SQ", "ReferencedVisitSequence", "1", false)
+	tagDict[Tag{0x0008, 0x1134}] = newInfo(Tag{0x0008, 0x1134}, "SQ", "ReferencedStereometricInstanceSequence", "1", false)
+	tagDict[Tag{0x0008, 0x113A}] = newInfo(Tag{0x0008, 0x113A}, "SQ", "ReferencedWaveformSequence", "1", false)
+	tagDict[Tag{0x0008, 0x1140}] = newInfo(Tag{0x0008, 0x1140}, "SQ", "ReferencedImageSequence", "1", false)
+	tagDict[Tag{0x0008, 0x114A}] = newInfo(Tag{0x0008, 0x114A}, "SQ", "ReferencedInstanceSequence", "1", false)
+	tagDict[Tag{0x0008, 0x114B}] = newInfo(Tag{0x0008, 0x114B}, "SQ", "ReferencedRealWorldValueMappingInstanceSequence", "1", false)
+	tagDict[Tag{0x0008, 0x1150}] = newInfo(Tag{0x0008, 0x1150}, "UI", "ReferencedSOPClassUID", "1", false)
+	tagDict[Tag{0x0008, 0x1155}] = newInfo(Tag{0x0008, 0x1155}, "UI", "ReferencedSOPInstanceUID", "1", false)
+	tagDict[Tag{0x0008, 0x115A}] = newInfo(Tag{0x0008, 0x115A}, "UI", "SOPClassesSupported", "1-n", false)
+	tagDict[Tag{0x0008, 0x1160}] = newInfo(Tag{0x0008, 0x1160}, "IS", "ReferencedFrameNumber", "1-n", false)
+	tagDict[Tag{0x0008, 0x1161}] = newInfo(Tag{0x0008, 0x1161}, "UL", "SimpleFrameList", "1-n", false)
+	tagDict[Tag{0x0008, 0x1162}] = newInfo(Tag{0x0008, 0x1162}, "UL", "CalculatedFrameList", "3-3n", false)
+	tagDict[Tag{0x0008, 0x1163}] = newInfo(Tag{0x0008, 0x1163}, "FD", "TimeRange", "2", false)
+	tagDict[Tag{0x0008, 0x1164}] = newInfo(Tag{0x0008, 0x1164}, "SQ", "FrameExtractionSequence", "1", false)
+	tagDict[Tag{0x0008, 0x1167}] = newInfo(Tag{0x0008, 0x1167}, "UI", "MultiFrameSourceSOPInstanceUID", "1", false)
+	tagDict[Tag{0x0008, 0x1195}] = newInfo(Tag{0x0008, 0x1195}, "UI", "TransactionUID", "1", false)
+	tagDict[Tag{0x0008, 0x1197}] = newInfo(Tag{0x0008, 0x1197}, "US", "FailureReason", "1", false)
+	tagDict[Tag{0x0008, 0x1198}] = newInfo(Tag{0x0008, 0x1198}, "SQ", "FailedSOPSequence", "1", false)
+	tagDict[Tag{0x0008, 0x1199}] = newInfo(Tag{0x0008, 0x1199}, "SQ", "ReferencedSOPSequence", "1", false)
+	tagDict[Tag{0x0008, 0x1200}] = newInfo(Tag{0x0008, 0x1200}, "SQ", "StudiesContainingOtherReferencedInstancesSequence", "1", false)
+	tagDict[Tag{0x0008, 0x1250}] = newInfo(Tag{0x0008, 0x1250}, "SQ", "RelatedSeriesSequence", "1", false)
+	tagDict[Tag{0x0008, 0x2111}] = newInfo(Tag{0x0008, 0x2111}, "ST", "DerivationDescription", "1", false)
+	tagDict[Tag{0x0008, 0x2112}] = newInfo(Tag{0x0008, 0x2112}, "SQ", "SourceImageSequence", "1", false)
+	tagDict[Tag{0x0008, 0x2120}] = newInfo(Tag{0x0008, 0x2120}, "SH", "StageName", "1", false)
+	tagDict[Tag{0x0008, 0x2122}] = newInfo(Tag{0x0008, 0x2122}, "IS", "StageNumber", "1", false)
+	tagDict[Tag{0x0008, 0x2124}] = newInfo(Tag{0x0008, 0x2124}, "IS", "NumberOfStages", "1", false)
+	tagDict[Tag{0x0008, 0x2127}] = newInfo(Tag{0x0008, 0x2127}, "SH", "ViewName", "1", false)
+	tagDict[Tag{0x0008, 0x2128}] = newInfo(Tag{0x0008, 0x2128}, "IS", "ViewNumber", "1", false)
+	tagDict[Tag{0x0008, 0x2129}] = newInfo(Tag{0x0008, 0x2129}, "IS", "NumberOfEventTimers", "1", false)
+	tagDict[Tag{0x0008, 0x212A}] = newInfo(Tag{0x0008, 0x212A}, "IS", "NumberOfViewsInStage", "1", false)
+	tagDict[Tag{0x0008, 0x2130}] = newInfo(Tag{0x0008, 0x2130}, "DS", "EventElapsedTimes", "1-n", false)
+	tagDict[Tag{0x0008, 0x2132}] = newInfo(Tag{0x0008, 0x2132}, "LO", "EventTimerNames", "1-n", false)
+	tagDict[Tag{0x0008, 0x2133}] = newInfo(Tag{0x0008, 0x2133}, "SQ", "EventTimerSequence", "1", false)
+	tagDict[Tag{0x0008, 0x2134}] = newInfo(Tag{0x0008, 0x2134}, "FD", "EventTimeOffset", "1", false)
+	tagDict[Tag{0x0008, 0x2135}] = newInfo(Tag{0x0008, 0x2135}, "SQ", "EventCodeSequence", "1", false)
+	tagDict[Tag{0x0008, 0x2142}] = newInfo(Tag{0x0008, 0x2142}, "IS", "StartTrim", "1", false)
+	tagDict[Tag{0x0008, 0x2143}] = newInfo(Tag{0x0008, 0x2143}, "IS", "StopTrim", "1", false)
+	tagDict[Tag{0x0008, 0x2144}] = newInfo(Tag{0x0008, 0x2144}, "IS", "RecommendedDisplayFrameRate", "1", false)
+	tagDict[Tag{0x0008, 0x2218}] = newInfo(Tag{0x0008, 0x2218}, "SQ", "AnatomicRegionSequence", "1", false)
+	tagDict[Tag{0x0008, 0x2220}] = newInfo(Tag{0x0008, 0x2220}, "SQ", "AnatomicRegionModifierSequence", "1", false)
+	tagDict[Tag{0x0008, 0x2228}] = newInfo(Tag{0x0008, 0x2228}, "SQ", "PrimaryAnatomicStructureSequence", "1", false)
+	tagDict[Tag{0x0008, 0x2229}] = newInfo(Tag{0x0008, 0x2229}, "SQ", "AnatomicStructureSpaceOrRegionSequence", "1", false)
+	tagDict[Tag{0x0008, 0x2230}] = newInfo(Tag{0x0008, 0x2230}, "SQ", "PrimaryAnatomicStructureModifierSequence", "1", false)
+	tagDict[Tag{0x0008, 0x3001}] = newInfo(Tag{0x0008, 0x3001}, "SQ", "AlternateRepresentationSequence", "1", false)
+	tagDict[Tag{0x0008, 0x3010}] = newInfo(Tag{0x0008, 0x3010}, "UI", "IrradiationEventUID", "1", false)
+	tagDict[Tag{0x0008, 0x9007}] = newInfo(Tag{0x0008, 0x9007}, "CS", "FrameType", "4", false)
+	tagDict[Tag{0x0008, 0x9092}] = newInfo(Tag{0x0008, 0x9092}, "SQ", "ReferencedImageEvidenceSequence", "1", false)
+	tagDict[Tag{0x0008, 0x9121}] = newInfo(Tag{0x0008, 0x9121}, "SQ", "ReferencedRawDataSequence", "1", false)
+	tagDict[Tag{0x0008, 0x9123}] = newInfo(Tag{0x0008, 0x9123}, "UI", "CreatorVersionUID", "1", false)
+	tagDict[Tag{0x0008, 0x9124}] = newInfo(Tag{0x0008, 0x9124}, "SQ", "DerivationImageSequence", "1", false)
+	tagDict[Tag{0x0008, 0x9154}] = newInfo(Tag{0x0008, 0x9154}, "SQ", "SourceImageEvidenceSequence", "1", false)
+	tagDict[Tag{0x0008, 0x9205}] = newInfo(Tag{0x0008, 0x9205}, "CS", "PixelPresentation", "1", false)
+	tagDict[Tag{0x0008, 0x9206}] = newInfo(Tag{0x0008, 0x9206}, "CS", "VolumetricProperties", "1", false)
+	tagDict[Tag{0x0008, 0x9207}] = newInfo(Tag{0x0008, 0x9207}, "CS", "VolumeBasedCalculationTechnique", "1", false)
+	tagDict[Tag{0x0008, 0x9208}] = newInfo(Tag{0x0008, 0x9208}, "CS", "ComplexImageComponent", "1", false)
+	tagDict[Tag{0x0008, 0x9209}] = newInfo(Tag{0x0008, 0x9209}, "CS", "AcquisitionContrast", "1", false)
+	tagDict[Tag{0x0008, 0x9215}] = newInfo(Tag{0x0008, 0x9215}, "SQ", "DerivationCodeSequence", "1", false)
+	tagDict[Tag{0x0008, 0x9237}] = newInfo(Tag{0x0008, 0x9237}, "SQ", "ReferencedPresentationStateSequence", "1", false)
+	tagDict[Tag{0x0008, 0x9410}] = newInfo(Tag{0x0008, 0x9410}, "SQ", "ReferencedOtherPlaneSequence", "1", false)
+	tagDict[Tag{0x0008, 0x9458}] = newInfo(Tag{0x0008, 0x9458}, "SQ", "FrameDisplaySequence", "1", false)
+	tagDict[Tag{0x0008, 0x9459}] = newInfo(Tag{0x0008, 0x9459}, "FL", "RecommendedDisplayFrameRateInFloat", "1", false)
+	tagDict[Tag{0x0008, 0x9460}] = newInfo(Tag{0x0008, 0x9460}, "CS", "SkipFrameRangeFlag", "1", false)
+	tagDict[Tag{0x0010, 0x0010}] = newInfo(Tag{0x0010, 0x0010}, "PN", "PatientName", "1", false)
+	tagDict[Tag{0x0010, 0x0020}] = newInfo(Tag{0x0010, 0x0020}, "LO", "PatientID", "1", false)
+	tagDict[Tag{0x0010, 0x0021}] = newInfo(Tag{0x0010, 0x0021}, "LO", "IssuerOfPatientID", "1", false)
+	tagDict[Tag{0x0010, 0x0022}] = newInfo(Tag{0x0010, 0x0022}, "CS", "TypeOfPatientID", "1", false)
+	tagDict[Tag{0x0010, 0x0024}] = newInfo(Tag{0x0010, 0x0024}, "SQ", "IssuerOfPatientIDQualifiersSequence", "1", false)
+	tagDict[Tag{0x0010, 0x0030}] = newInfo(Tag{0x0010, 0x0030}, "DA", "PatientBirthDate", "1", false)
+	tagDict[Tag{0x0010, 0x0032}] = newInfo(Tag{0x0010, 0x0032}, "TM", "PatientBirthTime", "1", false)
+	tagDict[Tag{0x0010, 0x0040}] = newInfo(Tag{0x0010, 0x0040}, "CS", "PatientSex", "1", false)
+	tagDict[Tag{0x0010, 0x0050}] = newInfo(Tag{0x0010, 0x0050}, "SQ", "PatientInsurancePlanCodeSequence", "1", false)
+	tagDict[Tag{0x0010, 0x0101}] = newInfo(Tag{0x0010, 0x0101}, "SQ", "PatientPrimaryLanguageCodeSequence", "1", false)
+	tagDict[Tag{0x0010, 0x0102}] = newInfo(Tag{0x0010, 0x0102}, "SQ", "PatientPrimaryLanguageModifierCodeSequence", "1", false)
+	tagDict[Tag{0x0010, 0x1000}] = newInfo(Tag{0x0010, 0x1000}, "LO", "OtherPatientIDs", "1-n", false)
+	tagDict[Tag{0x0010, 0x1001}] = newInfo(Tag{0x0010, 0x1001}, "PN", "OtherPatientNames", "1-n", false)
+	tagDict[Tag{0x0010, 0x1002}] = newInfo(Tag{0x0010, 0x1002}, "SQ", "OtherPatientIDsSequence", "1", false)
+	tagDict[Tag{0x0010, 0x1005}] = newInfo(Tag{0x0010, 0x1005}, "PN", "PatientBirthName", "1", false)
+	tagDict[Tag{0x0010, 0x1010}] = newInfo(Tag{0x0010, 0x1010}, "AS", "PatientAge", "1", false)
+	tagDict[Tag{0x0010, 0x1020}] = newInfo(Tag{0x0010, 0x1020}, "DS", "PatientSize", "1", false)
+	tagDict[Tag{0x0010, 0x1021}] = newInfo(Tag{0x0010, 0x1021}, "SQ", "PatientSizeCodeSequence", "1", false)
+	tagDict[Tag{0x0010, 0x1030}] = newInfo(Tag{0x0010, 0x1030}, "DS", "PatientWeight", "1", false)
+	tagDict[Tag{0x0010, 0x1040}] = newInfo(Tag{0x0010, 0x1040}, "LO", "PatientAddress", "1", false)
+	tagDict[Tag{0x0010, 0x1060}] = newInfo(Tag{0x0010, 0x1060}, "PN", "PatientMotherBirthName", "1", false)
+	tagDict[Tag{0x0010, 0x1080}] = newInfo(Tag{0x0010, 0x1080}, "LO", "MilitaryRank", "1", false)
+	tagDict[Tag{0x0010, 0x1081}] = newInfo(Tag{0x0010, 0x1081}, "LO", "BranchOfService", "1", false)
+	tagDict[Tag{0x0010, 0x1090}] = newInfo(Tag{0x0010, 0x1090}, "LO", "MedicalRecordLocator", "1", false)
+	tagDict[Tag{0x0010, 0x2000}] = newInfo(Tag{0x0010, 0x2000}, "LO", "MedicalAlerts", "1-n", false)
+	tagDict[Tag{0x0010, 0x2110}] = newInfo(Tag{0x0010, 0x2110}, "LO", "Allergies", "1-n", false)
+	tagDict[Tag{0x0010, 0x2150}] = newInfo(Tag{0x0010, 0x2150}, "LO", "CountryOfResidence", "1", false)
+	tagDict[Tag{0x0010, 0x2152}] = newInfo(Tag{0x0010, 0x2152}, "LO", "RegionOfResidence", "1", false)
+	tagDict[Tag{0x0010, 0x2154}] = newInfo(Tag{0x0010, 0x2154}, "SH", "PatientTelephoneNumbers", "1-n", false)
+	tagDict[Tag{0x0010, 0x2160}] = newInfo(Tag{0x0010, 0x2160}, "SH", "EthnicGroup", "1", false)
+	tagDict[Tag{0x0010, 0x2180}] = newInfo(Tag{0x0010, 0x2180}, "SH", "Occupation", "1", false)
+	tagDict[Tag{0x0010, 0x21A0}] = newInfo(Tag{0x0010, 0x21A0}, "CS", "SmokingStatus", "1", false)
+	tagDict[Tag{0x0010, 0x21B0}] = newInfo(Tag{0x0010, 0x21B0}, "LT", "AdditionalPatientHistory", "1", false)
+	tagDict[Tag{0x0010, 0x21C0}] = newInfo(Tag{0x0010, 0x21C0}, "US", "PregnancyStatus", "1", false)
+	tagDict[Tag{0x0010, 0x21D0}] = newInfo(Tag{0x0010, 0x21D0}, "DA", "LastMenstrualDate", "1", false)
+	tagDict[Tag{0x0010, 0x21F0}] = newInfo(Tag{0x0010, 0x21F0}, "LO", "PatientReligiousPreference", "1", false)
+	tagDict[Tag{0x0010, 0x2201}] = newInfo(Tag{0x0010, 0x2201}, "LO", "PatientSpeciesDescription", "1", false)
+	tagDict[Tag{0x0010, 0x2202}] = newInfo(Tag{0x0010, 0x2202}, "SQ", "PatientSpeciesCodeSequence", "1", false)
+	tagDict[Tag{0x0010, 0x2203}] = newInfo(Tag{0x0010, 0x2203}, "CS", "PatientSexNeutered", "1", false)
+	tagDict[Tag{0x0010, 0x2210}] = newInfo(Tag{0x0010, 0x2210}, "CS", "AnatomicalOrientationType", "1", false)
+	tagDict[Tag{0x0010, 0x2292}] = newInfo(Tag{0x0010, 0x2292}, "LO", "PatientBreedDescription", "1", false)
+	tagDict[Tag{0x0010, 0x2293}] = newInfo(Tag{0x0010, 0x2293}, "SQ", "PatientBreedCodeSequence", "1", false)
+	tagDict[Tag{0x0010, 0x2294}] = newInfo(Tag{0x0010, 0x2294}, "SQ", "BreedRegistrationSequence", "1", false)
+	tagDict[Tag{0x0010, 0x2295}] = newInfo(Tag{0x0010, 0x2295}, "LO", "BreedRegistrationNumber", "1", false)
+	tagDict[Tag{0x0010, 0x2296}] = newInfo(Tag{0x0010, 0x2296}, "SQ", "BreedRegistryCodeSequence", "1", false)
+	tagDict[Tag{0x0010, 0x2297}] = newInfo(Tag{0x0010, 0x2297}, "PN", "ResponsiblePerson", "1", false)
+	tagDict[Tag{0x0010, 0x2298}] = newInfo(Tag{0x0010, 0x2298}, "CS", "ResponsiblePersonRole", "1", false)
+	tagDict[Tag{0x0010, 0x2299}] = newInfo(Tag{0x0010, 0x2299}, "LO", "ResponsibleOrganization", "1", false)
+	tagDict[Tag{0x0010, 0x4000}] = newInfo(Tag{0x0010, 0x4000}, "LT", "PatientComments", "1", false)
+	tagDict[Tag{0x0010, 0x9431}] = newInfo(Tag{0x0010, 0x9431}, "FL", "ExaminedBodyThickness", "1", false)
+	tagDict[Tag{0x0012, 0x0010}] = newInfo(Tag{0x0012, 0x0010}, "LO", "ClinicalTrialSponsorName", "1", false)
+	tagDict[Tag{0x0012, 0x0020}] = newInfo(Tag{0x0012, 0x0020}, "LO", "ClinicalTrialProtocolID", "1", false)
+	tagDict[Tag{0x0012, 0x0021}] = newInfo(Tag{0x0012, 0x0021}, "LO", "ClinicalTrialProtocolName", "1", false)
+	tagDict[Tag{0x0012, 0x0030}] = newInfo(Tag{0x0012, 0x0030}, "LO", "ClinicalTrialSiteID", "1", false)
+	tagDict[Tag{0x0012, 0x0031}] = newInfo(Tag{0x0012, 0x0031}, "LO", "ClinicalTrialSiteName", "1", false)
+	tagDict[Tag{0x0012, 0x0040}] = newInfo(Tag{0x0012, 0x0040}, "LO", "ClinicalTrialSubjectID", "1", false)
+	tagDict[Tag{0x0012, 0x0042}] = newInfo(Tag{0x0012, 0x0042}, "LO", "ClinicalTrialSubjectReadingID", "1", false)
+	tagDict[Tag{0x0012, 0x0050}] = newInfo(Tag{0x0012, 0x0050}, "LO", "ClinicalTrialTimePointID", "1", false)
+	tagDict[Tag{0x0012, 0x0051}] = newInfo(Tag{0x0012, 0x0051}, "ST", "ClinicalTrialTimePointDescription", "1", false)
+	tagDict[Tag{0x0012, 0x0060}] = newInfo(Tag{0x0012, 0x0060}, "LO", "ClinicalTrialCoordinatingCenterName", "1", false)
+	tagDict[Tag{0x0012, 0x0062}] = newInfo(Tag{0x0012, 0x0062}, "CS", "PatientIdentityRemoved", "1", false)
+	tagDict[Tag{0x0012, 0x0063}] = newInfo(Tag{0x0012, 0x0063}, "LO", "DeidentificationMethod", "1-n", false)
+	tagDict[Tag{0x0012, 0x0064}] = newInfo(Tag{0x0012, 0x0064}, "SQ", "DeidentificationMethodCodeSequence", "1", false)
+	tagDict[Tag{0x0012, 0x0071}] = newInfo(Tag{0x0012, 0x0071}, "LO", "ClinicalTrialSeriesID", "1", false)
+	tagDict[Tag{0x0012, 0x0072}] = newInfo(Tag{0x0012, 0x0072}, "LO", "ClinicalTrialSeriesDescription", "1", false)
+	tagDict[Tag{0x0012, 0x0081}] = newInfo(Tag{0x0012, 0x0081}, "LO", "ClinicalTrialProtocolEthicsCommitteeName", "1", false)
+	tagDict[Tag{0x0012, 0x0082}] = newInfo(Tag{0x0012, 0x0082}, "LO", "ClinicalTrialProtocolEthicsCommitteeApprovalNumber", "1", false)
+	tagDict[Tag{0x0012, 0x0083}] = newInfo(Tag{0x0012, 0x0083}, "SQ", "ConsentForClinicalTrialUseSequence", "1", false)
+	tagDict[Tag{0x0012, 0x0084}] = newInfo(Tag{0x0012, 0x0084}, "CS", "DistributionType", "1", false)
+	tagDict[Tag{0x0012, 0x0085}] = newInfo(Tag{0x0012, 0x0085}, "CS", "ConsentForDistributionFlag", "1", false)
+	tagDict[Tag{0x0014, 0x0023}] = newInfo(Tag{0x0014, 0x0023}, "ST", "CADFileFormat", "1-n", false)
+	tagDict[Tag{0x0014, 0x0024}] = newInfo(Tag{0x0014, 0x0024}, "ST", "ComponentReferenceSystem", "1-n", false)
+	tagDict[Tag{0x0014, 0x0025}] = newInfo(Tag{0x0014, 0x0025}, "ST", "ComponentManufacturingProcedure", "1-n", false)
+	tagDict[Tag{0x0014, 0x0028}] = newInfo(Tag{0x0014, 0x0028}, "ST", "ComponentManufacturer", "1-n", false)
+	tagDict[Tag{0x0014, 0x0030}] = newInfo(Tag{0x0014, 0x0030}, "DS", "MaterialThickness", "1-n", false)
+	tagDict[Tag{0x0014, 0x0032}] = newInfo(Tag{0x0014, 0x0032}, "DS", "MaterialPipeDiameter", "1-n", false)
+	tagDict[Tag{0x0014, 0x0034}] = newInfo(Tag{0x0014, 0x0034}, "DS", "MaterialIsolationDiameter", "1-n", false)
+	tagDict[Tag{0x0014, 0x0042}] = newInfo(Tag{0x0014, 0x0042}, "ST", "MaterialGrade", "1-n", false)
+	tagDict[Tag{0x0014, 0x0044}] = newInfo(Tag{0x0014, 0x0044}, "ST", "MaterialPropertiesFileID", "1-n", false)
+	tagDict[Tag{0x0014, 0x0045}] = newInfo(Tag{0x0014, 0x0045}, "ST", "MaterialPropertiesFileFormat", "1-n", false)
+	tagDict[Tag{0x0014, 0x0046}] = newInfo(Tag{0x0014, 0x0046}, "LT", "MaterialNotes", "1", false)
+	tagDict[Tag{0x0014, 0x0050}] = newInfo(Tag{0x0014, 0x0050}, "CS", "ComponentShape", "1", false)
+	tagDict[Tag{0x0014, 0x0052}] = newInfo(Tag{0x0014, 0x0052}, "CS", "CurvatureType", "1", false)
+	tagDict[Tag{0x0014, 0x0054}] = newInfo(Tag{0x0014, 0x0054}, "DS", "OuterDiameter", "1", false)
+	tagDict[Tag{0x0014, 0x0056}] = newInfo(Tag{0x0014, 0x0056}, "DS", "InnerDiameter", "1", false)
+	tagDict[Tag{0x0014, 0x1010}] = newInfo(Tag{0x0014, 0x1010}, "ST", "ActualEnvironmentalConditions", "1", false)
+	tagDict[Tag{0x0014, 0x1020}] = newInfo(Tag{0x0014, 0x1020}, "DA", "ExpiryDate", "1", false)
+	tagDict[Tag{0x0014, 0x1040}] = newInfo(Tag{0x0014, 0x1040}, "ST", "EnvironmentalConditions", "1", false)
+	tagDict[Tag{0x0014, 0x2002}] = newInfo(Tag{0x0014, 0x2002}, "SQ", "EvaluatorSequence", "1", false)
+	tagDict[Tag{0x0014, 0x2004}] = newInfo(Tag{0x0014, 0x2004}, "IS", "EvaluatorNumber", "1", false)
+	tagDict[Tag{0x0014, 0x2006}] = newInfo(Tag{0x0014, 0x2006}, "PN", "EvaluatorName", "1", false)
+	tagDict[Tag{0x0014, 0x2008}] = newInfo(Tag{0x0014, 0x2008}, "IS", "EvaluationAttempt", "1", false)
+	tagDict[Tag{0x0014, 0x2012}] = newInfo(Tag{0x0014, 0x2012}, "SQ", "IndicationSequence", "1", false)
+	tagDict[Tag{0x0014, 0x2014}] = newInfo(Tag{0x0014, 0x2014}, "IS", "IndicationNumber", "1", false)
+	tagDict[Tag{0x0014, 0x2016}] = newInfo(Tag{0x0014, 0x2016}, "SH", "IndicationLabel", "1", false)
+	tagDict[Tag{0x0014, 0x2018}] = newInfo(Tag{0x0014, 0x2018}, "ST", "IndicationDescription", "1", false)
+	tagDict[Tag{0x0014, 0x201A}] = newInfo(Tag{0x0014, 0x201A}, "CS", "IndicationType", "1-n", false)
+	tagDict[Tag{0x0014, 0x201C}] = newInfo(Tag{0x0014, 0x201C}, "CS", "IndicationDisposition", "1", false)
+	tagDict[Tag{0x0014, 0x201E}] = newInfo(Tag{0x0014, 0x201E}, "SQ", "IndicationROISequence", "1", false)
+	tagDict[Tag{0x0014, 0x2030}] = newInfo(Tag{0x0014, 0x2030}, "SQ", "IndicationPhysicalPropertySequence", "1", false)
+	tagDict[Tag{0x0014, 0x2032}] = newInfo(Tag{0x0014, 0x2032}, "SH", "PropertyLabel", "1", false)
+	tagDict[Tag{0x0014, 0x2202}] = newInfo(Tag{0x0014, 0x2202}, "IS", "CoordinateSystemNumberOfAxes", "1", false)
+	tagDict[Tag{0x0014, 0x2204}] = newInfo(Tag{0x0014, 0x2204}, "SQ", "CoordinateSystemAxesSequence", "1", false)
+	tagDict[Tag{0x0014, 0x2206}] = newInfo(Tag{0x0014, 0x2206}, "ST", "CoordinateSystemAxisDescription", "1", false)
+	tagDict[Tag{0x0014, 0x2208}] = newInfo(Tag{0x0014, 0x2208}, "CS", "CoordinateSystemDataSetMapping", "1", false)
+	tagDict[Tag{0x0014, 0x220A}] = newInfo(Tag{0x0014, 0x220A}, "IS", "CoordinateSystemAxisNumber", "1", false)
+	tagDict[Tag{0x0014, 0x220C}] = newInfo(Tag{0x0014, 0x220C}, "CS", "CoordinateSystemAxisType", "1", false)
+	tagDict[Tag{0x0014, 0x220E}] = newInfo(Tag{0x0014, 0x220E}, "CS", "CoordinateSystemAxisUnits", "1", false)
+	tagDict[Tag{0x0014, 0x2210}] = newInfo(Tag{0x0014, 0x2210}, "OB", "CoordinateSystemAxisValues", "1", false)
+	tagDict[Tag{0x0014, 0x2220}] = newInfo(Tag{0x0014, 0x2220}, "SQ", "CoordinateSystemTransformSequence", "1", false)
+	tagDict[Tag{0x0014, 0x2222}] = newInfo(Tag{0x0014, 0x2222}, "ST", "TransformDescription", "1", false)
+	tagDict[Tag{0x0014, 0x2224}] = newInfo(Tag{0x0014, 0x2224}, "IS", "TransformNumberOfAxes", "1", false)
+	tagDict[Tag{0x0014, 0x2226}] = newInfo(Tag{0x0014, 0x2226}, "IS", "TransformOrderOfAxes", "1-n", false)
+	tagDict[Tag{0x0014, 0x2228}] = newInfo(Tag{0x0014, 0x2228}, "CS", "TransformedAxisUnits", "1", false)
+	tagDict[Tag{0x0014, 0x222A}] = newInfo(Tag{0x0014, 0x222A}, "DS", "CoordinateSystemTransformRotationAndScaleMatrix", "1-n", false)
+	tagDict[Tag{0x0014, 0x222C}] = newInfo(Tag{0x0014, 0x222C}, "DS", "CoordinateSystemTransformTranslationMatrix", "1-n", false)
+	tagDict[Tag{0x0014, 0x3011}] = newInfo(Tag{0x0014, 0x3011}, "DS", "InternalDetectorFrameTime", "1", false)
+	tagDict[Tag{0x0014, 0x3012}] = newInfo(Tag{0x0014, 0x3012}, "DS", "NumberOfFramesIntegrated", "1", false)
+	tagDict[Tag{0x0014, 0x3020}] = newInfo(Tag{0x0014, 0x3020}, "SQ", "DetectorTemperatureSequence", "1", false)
+	tagDict[Tag{0x0014, 0x3022}] = newInfo(Tag{0x0014, 0x3022}, "ST", "SensorName", "1", false)
+	tagDict[Tag{0x0014, 0x3024}] = newInfo(Tag{0x0014, 0x3024}, "DS", "HorizontalOffsetOfSensor", "1", false)
+	tagDict[Tag{0x0014, 0x3026}] = newInfo(Tag{0x0014, 0x3026}, "DS", "VerticalOffsetOfSensor", "1", false)
+	tagDict[Tag{0x0014, 0x3028}] = newInfo(Tag{0x0014, 0x3028}, "DS", "SensorTemperature", "1", false)
+	tagDict[Tag{0x0014, 0x3040}] = newInfo(Tag{0x0014, 0x3040}, "SQ", "DarkCurrentSequence", "1", false)
+	tagDict[Tag{0x0014, 0x3050}] = newInfo(Tag{0x0014, 0x3050}, "OW or OB", "DarkCurrentCounts", "1", false)
+	tagDict[Tag{0x0014, 0x3060}] = newInfo(Tag{0x0014, 0x3060}, "SQ", "GainCorrectionReferenceSequence", "1", false)
+	tagDict[Tag{0x0014, 0x3070}] = newInfo(Tag{0x0014, 0x3070}, "OW or OB", "AirCounts", "1", false)
+	tagDict[Tag{0x0014, 0x3071}] = newInfo(Tag{0x0014, 0x3071}, "DS", "KVUsedInGainCalibration", "1", false)
+	tagDict[Tag{0x0014, 0x3072}] = newInfo(Tag{0x0014, 0x3072}, "DS", "MAUsedInGainCalibration", "1", false)
+	tagDict[Tag{0x0014, 0x3073}] = newInfo(Tag{0x0014, 0x3073}, "DS", "NumberOfFramesUsedForIntegration", "1", false)
+	tagDict[Tag{0x0014, 0x3074}] = newInfo(Tag{0x0014, 0x3074}, "LO", "FilterMaterialUsedInGainCalibration", "1", false)
+	tagDict[Tag{0x0014, 0x3075}] = newInfo(Tag{0x0014, 0x3075}, "DS", "FilterThicknessUsedInGainCalibration", "1", false)
+	tagDict[Tag{0x0014, 0x3076}] = newInfo(Tag{0x0014, 0x3076}, "DA", "DateOfGainCalibration", "1", false)
+	tagDict[Tag{0x0014, 0x3077}] = newInfo(Tag{0x0014, 0x3077}, "TM", "TimeOfGainCalibration", "1", false)
+	tagDict[Tag{0x0014, 0x3080}] = newInfo(Tag{0x0014, 0x3080}, "OB", "BadPixelImage", "1", false)
+	tagDict[Tag{0x0014, 0x3099}] = newInfo(Tag{0x0014, 0x3099}, "LT", "CalibrationNotes", "1", false)
+	tagDict[Tag{0x0014, 0x4002}] = newInfo(Tag{0x0014, 0x4002}, "SQ", "PulserEquipmentSequence", "1", false)
+	tagDict[Tag{0x0014, 0x4004}] = newInfo(Tag{0x0014, 0x4004}, "CS", "PulserType", "1", false)
+	tagDict[Tag{0x0014, 0x4006}] = newInfo(Tag{0x0014, 0x4006}, "LT", "PulserNotes", "1", false)
+	tagDict[Tag{0x0014, 0x4008}] = newInfo(Tag{0x0014, 0x4008}, "SQ", "ReceiverEquipmentSequence", "1", false)
+	tagDict[Tag{0x0014, 0x400A}] = newInfo(Tag{0x0014, 0x400A}, "CS", "AmplifierType", "1", false)
+	tagDict[Tag{0x0014, 0x400C}] = newInfo(Tag{0x0014, 0x400C}, "LT", "ReceiverNotes", "1", false)
+	tagDict[Tag{0x0014, 0x400E}] = newInfo(Tag{0x0014, 0x400E}, "SQ", "PreAmplifierEquipmentSequence", "1", false)
+	tagDict[Tag{0x0014, 0x400F}] = newInfo(Tag{0x0014, 0x400F}, "LT", "PreAmplifierNotes", "1", false)
+	tagDict[Tag{0x0014, 0x4010}] = newInfo(Tag{0x0014, 0x4010}, "SQ", "TransmitTransducerSequence", "1", false)
+	tagDict[Tag{0x0014, 0x4011}] = newInfo(Tag{0x0014, 0x4011}, "SQ", "ReceiveTransducerSequence", "1", false)
+	tagDict[Tag{0x0014, 0x4012}] = newInfo(Tag{0x0014, 0x4012}, "US", "NumberOfElements", "1", false)
+	tagDict[Tag{0x0014, 0x4013}] = newInfo(Tag{0x0014, 0x4013}, "CS", "ElementShape", "1", false)
+	tagDict[Tag{0x0014, 0x4014}] = newInfo(Tag{0x0014, 0x4014}, "DS", "ElementDimensionA", "1", false)
+	tagDict[Tag{0x0014, 0x4015}] = newInfo(Tag{0x0014, 0x4015}, "DS", "ElementDimensionB", "1", false)
+	tagDict[Tag{0x0014, 0x4016}] = newInfo(Tag{0x0014, 0x4016}, "DS", "ElementPitch", "1", false)
+	tagDict[Tag{0x0014, 0x4017}] = newInfo(Tag{0x0014, 0x4017}, "DS", "MeasuredBeamDimensionA", "1", false)
+	tagDict[Tag{0x0014, 0x4018}] = newInfo(Tag{0x0014, 0x4018}, "DS", "MeasuredBeamDimensionB", "1", false)
+	tagDict[Tag{0x0014, 0x4019}] = newInfo(Tag{0x0014, 0x4019}, "DS", "LocationOfMeasuredBeamDiameter", "1", false)
+	tagDict[Tag{0x0014, 0x401A}] = newInfo(Tag{0x0014, 0x401A}, "DS", "NominalFrequency", "1", false)
+	tagDict[Tag{0x0014, 0x401B}] = newInfo(Tag{0x0014, 0x401B}, "DS", "MeasuredCenterFrequency", "1", false)
+	tagDict[Tag{0x0014, 0x401C}] = newInfo(Tag{0x0014, 0x401C}, "DS", "MeasuredBandwidth", "1", false)
+	tagDict[Tag{0x0014, 0x4020}] = newInfo(Tag{0x0014, 0x4020}, "SQ", "PulserSettingsSequence", "1", false)
+	tagDict[Tag{0x0014, 0x4022}] = newInfo(Tag{0x0014, 0x4022}, "DS", "PulseWidth", "1", false)
+	tagDict[Tag{0x0014, 0x4024}] = newInfo(Tag{0x0014, 0x4024}, "DS", "ExcitationFrequency", "1", false)
+	tagDict[Tag{0x0014, 0x4026}] = newInfo(Tag{0x0014, 0x4026}, "CS", "ModulationType", "1", false)
+	tagDict[Tag{0x0014, 0x4028}] = newInfo(Tag{0x0014, 0x4028}, "DS", "Damping", "1", false)
+	tagDict[Tag{0x0014, 0x4030}] = newInfo(Tag{0x0014, 0x4030}, "SQ", "ReceiverSettingsSequence", "1", false)
+	tagDict[Tag{0x0014, 0x4031}] = newInfo(Tag{0x0014, 0x4031}, "DS", "AcquiredSoundpathLength", "1", false)
+	tagDict[Tag{0x0014, 0x4032}] = newInfo(Tag{0x0014, 0x4032}, "CS", "AcquisitionCompressionType", "1", false)
+	tagDict[Tag{0x0014, 0x4033}] = newInfo(Tag{0x0014, 0x4033}, "IS", "AcquisitionSampleSize", "1", false)
+	tagDict[Tag{0x0014, 0x4034}] = newInfo(Tag{0x0014, 0x4034}, "DS", "RectifierSmoothing", "1", false)
+	tagDict[Tag{0x0014, 0x4035}] = newInfo(Tag{0x0014, 0x4035}, "SQ", "DACSequence", "1", false)
+	tagDict[Tag{0x0014, 0x4036}] = newInfo(Tag{0x0014, 0x4036}, "CS", "DACType", "1", false)
+	tagDict[Tag{0x0014, 0x4038}] = newInfo(Tag{0x0014, 0x4038}, "DS", "DACGainPoints", "1-n", false)
+	tagDict[Tag{0x0014, 0x403A}] = newInfo(Tag{0x0014, 0x403A}, "DS", "DACTimePoints", "1-n", false)
+	tagDict[Tag{0x0014, 0x403C}] = newInfo(Tag{0x0014, 0x403C}, "DS", "DACAmplitude", "1-n", false)
+	tagDict[Tag{0x0014, 0x4040}] = newInfo(Tag{0x0014, 0x4040}, "SQ", "PreAmplifierSettingsSequence", "1", false)
+	tagDict[Tag{0x0014, 0x4050}] = newInfo(Tag{0x0014, 0x4050}, "SQ", "TransmitTransducerSettingsSequence", "1", false)
+	tagDict[Tag{0x0014, 0x4051}] = newInfo(Tag{0x0014, 0x4051}, "SQ", "ReceiveTransducerSettingsSequence", "1", false)
+	tagDict[Tag{0x0014, 0x4052}] = newInfo(Tag{0x0014, 0x4052}, "DS", "IncidentAngle", "1", false)
+	tagDict[Tag{0x0014, 0x4054}] = newInfo(Tag{0x0014, 0x4054}, "ST", "CouplingTechnique", "1", false)
+	tagDict[Tag{0x0014, 0x4056}] = newInfo(Tag{0x0014, 0x4056}, "ST", "CouplingMedium", "1", false)
+	tagDict[Tag{0x0014, 0x4057}] = newInfo(Tag{0x0014, 0x4057}, "DS", "CouplingVelocity", "1", false)
+	tagDict[Tag{0x0014, 0x4058}] = newInfo(Tag{0x0014, 0x4058}, "DS", "CrystalCenterLocationX", "1", false)
+	tagDict[Tag{0x0014, 0x4059}] = newInfo(Tag{0x0014, 0x4059}, "DS", "CrystalCenterLocationZ", "1", false)
+	tagDict[Tag{0x0014, 0x405A}] = newInfo(Tag{0x0014, 0x405A}, "DS", "SoundPathLength", "1", false)
+	tagDict[Tag{0x0014, 0x405C}] = newInfo(Tag{0x0014, 0x405C}, "ST", "DelayLawIdentifier", "1", false)
+	tagDict[Tag{0x0014, 0x4060}] = newInfo(Tag{0x0014, 0x4060}, "SQ", "GateSettingsSequence", "1", false)
+	tagDict[Tag{0x0014, 0x4062}] = newInfo(Tag{0x0014, 0x4062}, "DS", "GateThreshold", "1", false)
+	tagDict[Tag{0x0014, 0x4064}] = newInfo(Tag{0x0014, 0x4064}, "DS", "VelocityOfSound", "1", false)
+	tagDict[Tag{0x0014, 0x4070}] = newInfo(Tag{0x0014, 0x4070}, "SQ", "CalibrationSettingsSequence", "1", false)
+	tagDict[Tag{0x0014, 0x4072}] = newInfo(Tag{0x0014, 0x4072}, "ST", "CalibrationProcedure", "1", false)
+	tagDict[Tag{0x0014, 0x4074}] = newInfo(Tag{0x0014, 0x4074}, "SH", "ProcedureVersion", "1", false)
+	tagDict[Tag{0x0014, 0x4076}] = newInfo(Tag{0x0014, 0x4076}, "DA", "ProcedureCreationDate", "1", false)
+	tagDict[Tag{0x0014, 0x4078}] = newInfo(Tag{0x0014, 0x4078}, "DA", "ProcedureExpirationDate", "1", false)
+	tagDict[Tag{0x0014, 0x407A}] = newInfo(Tag{0x0014, 0x407A}, "DA", "ProcedureLastModifiedDate", "1", false)
+	tagDict[Tag{0x0014, 0x407C}] = newInfo(Tag{0x0014, 0x407C}, "TM", "CalibrationTime", "1-n", false)
+	tagDict[Tag{0x0014, 0x407E}] = newInfo(Tag{0x0014, 0x407E}, "DA", "CalibrationDate", "1-n", false)
+	tagDict[Tag{0x0014, 0x5002}] = newInfo(Tag{0x0014, 0x5002}, "IS", "LINACEnergy", "1", false)
+	tagDict[Tag{0x0014, 0x5004}] = newInfo(Tag{0x0014, 0x5004}, "IS", "LINACOutput", "1", false)
+	tagDict[Tag{0x0018, 0x0010}] = newInfo(Tag{0x0018, 0x0010}, "LO", "ContrastBolusAgent", "1", false)
+	tagDict[Tag{0x0018, 0x0012}] = newInfo(Tag{0x0018, 0x0012}, "SQ", "ContrastBolusAgentSequence", "1", false)
+	tagDict[Tag{0x0018, 0x0014}] = newInfo(Tag{0x0018, 0x0014}, "SQ", "ContrastBolusAdministrationRouteSequence", "1", false)
+	tagDict[Tag{0x0018, 0x0015}] = newInfo(Tag{0x0018, 0x0015}, "CS", "BodyPartExamined", "1", false)
+	tagDict[Tag{0x0018, 0x0020}] = newInfo(Tag{0x0018, 0x0020}, "CS", "ScanningSequence", "1-n", false)
+	tagDict[Tag{0x0018, 0x0021}] = newInfo(Tag{0x0018, 0x0021}, "CS", "SequenceVariant", "1-n", false)
+	tagDict[Tag{0x0018, 0x0022}] = newInfo(Tag{0x0018, 0x0022}, "CS", "ScanOptions", "1-n", false)
+	tagDict[Tag{0x0018, 0x0023}] = newInfo(Tag{0x0018, 0x0023}, "CS", "MRAcquisitionType", "1", false)
+	tagDict[Tag{0x0018, 0x0024}] = newInfo(Tag{0x0018, 0x0024}, "SH", "SequenceName", "1", false)
+	tagDict[Tag{0x0018, 0x0025}] = newInfo(Tag{0x0018, 0x0025}, "CS", "AngioFlag", "1", false)
+	tagDict[Tag{0x0018, 0x0026}] = newInfo(Tag{0x0018, 0x0026}, "SQ", "InterventionDrugInformationSequence", "1", false)
+	tagDict[Tag{0x0018, 0x0027}] = newInfo(Tag{0x0018, 0x0027}, "TM", "InterventionDrugStopTime", "1", false)
+	tagDict[Tag{0x0018, 0x0028}] = newInfo(Tag{0x0018, 0x0028}, "DS", "InterventionDrugDose", "1", false)
+	tagDict[Tag{0x0018, 0x0029}] = newInfo(Tag{0x0018, 0x0029}, "SQ", "InterventionDrugCodeSequence", "1", false)
+	tagDict[Tag{0x0018, 0x002A}] = newInfo(Tag{0x0018, 0x002A}, "SQ", "AdditionalDrugSequence", "1", false)
+	tagDict[Tag{0x0018, 0x0031}] = newInfo(Tag{0x0018, 0x0031}, "LO", "Radiopharmaceutical", "1", false)
+	tagDict[Tag{0x0018, 0x0034}] = newInfo(Tag{0x0018, 0x0034}, "LO", "InterventionDrugName", "1", false)
+	tagDict[Tag{0x0018, 0x0035}] = newInfo(Tag{0x0018, 0x0035}, "TM", "InterventionDrugStartTime", "1", false)
+	tagDict[Tag{0x0018, 0x0036}] = newInfo(Tag{0x0018, 0x0036}, "SQ", "InterventionSequence", "1", false)
+	tagDict[Tag{0x0018, 0x0038}] = newInfo(Tag{0x0018, 0x0038}, "CS", "InterventionStatus", "1", false)
+	tagDict[Tag{0x0018, 0x003A}] = newInfo(Tag{0x0018, 0x003A}, "ST", "InterventionDescription", "1", false)
+	tagDict[Tag{0x0018, 0x0040}] = newInfo(Tag{0x0018, 0x0040}, "IS", "CineRate", "1", false)
+	tagDict[Tag{0x0018, 0x0042}] = newInfo(Tag{0x0018, 0x0042}, "CS", "InitialCineRunState", "1", false)
+	tagDict[Tag{0x0018, 0x0050}] = newInfo(Tag{0x0018, 0x0050}, "DS", "SliceThickness", "1", false)
+	tagDict[Tag{0x0018, 0x0060}] = newInfo(Tag{0x0018, 0x0060}, "DS", "KVP", "1", false)
+	tagDict[Tag{0x0018, 0x0070}] = newInfo(Tag{0x0018, 0x0070}, "IS", "CountsAccumulated", "1", false)
+	tagDict[Tag{0x0018, 0x0071}] = newInfo(Tag{0x0018, 0x0071}, "CS", "AcquisitionTerminationCondition", "1", false)
+	tagDict[Tag{0x0018, 0x0072}] = newInfo(Tag{0x0018, 0x0072}, "DS", "EffectiveDuration", "1", false)
+	tagDict[Tag{0x0018, 0x0073}] = newInfo(Tag{0x0018, 0x0073}, "CS", "AcquisitionStartCondition", "1", false)
+	tagDict[Tag{0x0018, 0x0074}] = newInfo(Tag{0x0018, 0x0074}, "IS", "AcquisitionStartConditionData", "1", false)
+	tagDict[Tag{0x0018, 0x0075}] = newInfo(Tag{0x0018, 0x0075}, "IS", "AcquisitionTerminationConditionData", "1", false)
+	tagDict[Tag{0x0018, 0x0080}] = newInfo(Tag{0x0018, 0x0080}, "DS", "RepetitionTime", "1", false)
+	tagDict[Tag{0x0018, 0x0081}] = newInfo(Tag{0x0018, 0x0081}, "DS", "EchoTime", "1", false)
+	tagDict[Tag{0x0018, 0x0082}] = newInfo(Tag{0x0018, 0x0082}, "DS", "InversionTime", "1", false)
+	tagDict[Tag{0x0018, 0x0083}] = newInfo(Tag{0x0018, 0x0083}, "DS", "NumberOfAverages", "1", false)
+	tagDict[Tag{0x0018, 0x0084}] = newInfo(Tag{0x0018, 0x0084}, "DS", "ImagingFrequency", "1", false)
+	tagDict[Tag{0x0018, 0x0085}] = newInfo(Tag{0x0018, 0x0085}, "SH", "ImagedNucleus", "1", false)
+	tagDict[Tag{0x0018, 0x0086}] = newInfo(Tag{0x0018, 0x0086}, "IS", "EchoNumbers", "1-n", false)
+	tagDict[Tag{0x0018, 0x0087}] = newInfo(Tag{0x0018, 0x0087}, "DS", "MagneticFieldStrength", "1", false)
+	tagDict[Tag{0x0018, 0x0088}] = newInfo(Tag{0x0018, 0x0088}, "DS", "SpacingBetweenSlices", "1", false)
+	tagDict[Tag{0x0018, 0x0089}] = newInfo(Tag{0x0018, 0x0089}, "IS", "NumberOfPhaseEncodingSteps", "1", false)
+	tagDict[Tag{0x0018, 0x0090}] = newInfo(Tag{0x0018, 0x0090}, "DS", "DataCollectionDiameter", "1", false)
+	tagDict[Tag{0x0018, 0x0091}] = newInfo(Tag{0x0018, 0x0091}, "IS", "EchoTrainLength", "1", false)
+	tagDict[Tag{0x0018, 0x0093}] = newInfo(Tag{0x0018, 0x0093}, "DS", "PercentSampling", "1", false)
+	tagDict[Tag{0x0018, 0x0094}] = newInfo(Tag{0x0018, 0x0094}, "DS", "PercentPhaseFieldOfView", "1", false)
+	tagDict[Tag{0x0018, 0x0095}] = newInfo(Tag{0x0018, 0x0095}, "DS", "PixelBandwidth", "1", false)
+	tagDict[Tag{0x0018, 0x1000}] = newInfo(Tag{0x0018, 0x1000}, "LO", "DeviceSerialNumber", "1", false)
+	tagDict[Tag{0x0018, 0x1002}] = newInfo(Tag{0x0018, 0x1002}, "UI", "DeviceUID", "1", false)
+	tagDict[Tag{0x0018, 0x1003}] = newInfo(Tag{0x0018, 0x1003}, "LO", "DeviceID", "1", false)
+	tagDict[Tag{0x0018, 0x1004}] = newInfo(Tag{0x0018, 0x1004}, "LO", "PlateID", "1", false)
+	tagDict[Tag{0x0018, 0x1005}] = newInfo(Tag{0x0018, 0x1005}, "LO", "GeneratorID", "1", false)
+	tagDict[Tag{0x0018, 0x1006}] = newInfo(Tag{0x0018, 0x1006}, "LO", "GridID", "1", false)
+	tagDict[Tag{0x0018, 0x1007}] = newInfo(Tag{0x0018, 0x1007}, "LO", "CassetteID", "1", false)
+	tagDict[Tag{0x0018, 0x1008}] = newInfo(Tag{0x0018, 0x1008}, "LO", "GantryID", "1", false)
+	tagDict[Tag{0x0018, 0x1010}] = newInfo(Tag{0x0018, 0x1010}, "LO", "SecondaryCaptureDeviceID", "1", false)
+	tagDict[Tag{0x0018, 0x1012}] = newInfo(Tag{0x0018, 0x1012}, "DA", "DateOfSecondaryCapture", "1", false)
+	tagDict[Tag{0x0018, 0x1014}] = newInfo(Tag{0x0018, 0x1014}, "TM", "TimeOfSecondaryCapture", "1", false)
+	tagDict[Tag{0x0018, 0x1016}] = newInfo(Tag{0x0018, 0x1016}, "LO", "SecondaryCaptureDeviceManufacturer", "1", false)
+	tagDict[Tag{0x0018, 0x1018}] = newInfo(Tag{0x0018, 0x1018}, "LO", "SecondaryCaptureDeviceManufacturerModelName", "1", false)
+	tagDict[Tag{0x0018, 0x1019}] = newInfo(Tag{0x0018, 0x1019}, "LO", "SecondaryCaptureDeviceSoftwareVersions", "1-n", false)
+	tagDict[Tag{0x0018, 0x1020}] = newInfo(Tag{0x0018, 0x1020}, "LO", "SoftwareVersions", "1-n", false)
+	tagDict[Tag{0x0018, 0x1022}] = newInfo(Tag{0x0018, 0x1022}, "SH", "VideoImageFormatAcquired", "1", false)
+	tagDict[Tag{0x0018, 0x1023}] = newInfo(Tag{0x0018, 0x1023}, "LO", "DigitalImageFormatAcquired", "1", false)
+	tagDict[Tag{0x0018, 0x1030}] = newInfo(Tag{0x0018, 0x1030}, "LO", "ProtocolName", "1", false)
+	tagDict[Tag{0x0018, 0x1040}] = newInfo(Tag{0x0018, 0x1040}, "LO", "ContrastBolusRoute", "1", false)
+	tagDict[Tag{0x0018, 0x1041}] = newInfo(Tag{0x0018, 0x1041}, "DS", "ContrastBolusVolume", "1", false)
+	tagDict[Tag{0x0018, 0x1042}] = newInfo(Tag{0x0018, 0x1042}, "TM", "ContrastBolusStartTime", "1", false)
+	tagDict[Tag{0x0018, 0x1043}] = newInfo(Tag{0x0018, 0x1043}, "TM", "ContrastBolusStopTime", "1", false)
+	tagDict[Tag{0x0018, 0x1044}] = newInfo(Tag{0x0018, 0x1044}, "DS", "ContrastBolusTotalDose", "1", false)
+	tagDict[Tag{0x0018, 0x1045}] = newInfo(Tag{0x0018, 0x1045}, "IS", "SyringeCounts", "1", false)
+	tagDict[Tag{0x0018, 0x1046}] = newInfo(Tag{0x0018, 0x1046}, "DS", "ContrastFlowRate", "1-n", false)
+	tagDict[Tag{0x0018, 0x1047}] = newInfo(Tag{0x0018, 0x1047}, "DS", "ContrastFlowDuration", "1-n", false)
+	tagDict[Tag{0x0018, 0x1048}] = newInfo(Tag{0x0018, 0x1048}, "CS", "ContrastBolusIngredient", "1", false)
+	tagDict[Tag{0x0018, 0x1049}] = newInfo(Tag{0x0018, 0x1049}, "DS", "ContrastBolusIngredientConcentration", "1", false)
+	tagDict[Tag{0x0018, 0x1050}] = newInfo(Tag{0x0018, 0x1050}, "DS", "SpatialResolution", "1", false)
+	tagDict[Tag{0x0018, 0x1060}] = newInfo(Tag{0x0018, 0x1060}, "DS", "TriggerTime", "1", false)
+	tagDict[Tag{0x0018, 0x1061}] = newInfo(Tag{0x0018, 0x1061}, "LO", "TriggerSourceOrType", "1", false)
+	tagDict[Tag{0x0018, 0x1062}] = newInfo(Tag{0x0018, 0x1062}, "IS", "NominalInterval", "1", false)
+	tagDict[Tag{0x0018, 0x1063}] = newInfo(Tag{0x0018, 0x1063}, "DS", "FrameTime", "1", false)
+	tagDict[Tag{0x0018, 0x1064}] = newInfo(Tag{0x0018, 0x1064}, "LO", "CardiacFramingType", "1", false)
+	tagDict[Tag{0x0018, 0x1065}] = newInfo(Tag{0x0018, 0x1065}, "DS", "FrameTimeVector", "1-n", false)
+	tagDict[Tag{0x0018, 0x1066}] = newInfo(Tag{0x0018, 0x1066}, "DS", "FrameDelay", "1", false)
+	tagDict[Tag{0x0018, 0x1067}] = newInfo(Tag{0x0018, 0x1067}, "DS", "ImageTriggerDelay", "1", false)
+	tagDict[Tag{0x0018, 0x1068}] = newInfo(Tag{0x0018, 0x1068}, "DS", "MultiplexGroupTimeOffset", "1", false)
+	tagDict[Tag{0x0018, 0x1069}] = newInfo(Tag{0x0018, 0x1069}, "DS", "TriggerTimeOffset", "1", false)
+	tagDict[Tag{0x0018, 0x106A}] = newInfo(Tag{0x0018, 0x106A}, "CS", "SynchronizationTrigger", "1", false)
+	tagDict[Tag{0x0018, 0x106C}] = newInfo(Tag{0x0018, 0x106C}, "US", "SynchronizationChannel", "2", false)
+	tagDict[Tag{0x0018, 0x106E}] = newInfo(Tag{0x0018, 0x106E}, "UL", "TriggerSamplePosition", "1", false)
+	tagDict[Tag{0x0018, 0x1070}] = newInfo(Tag{0x0018, 0x1070}, "LO", "RadiopharmaceuticalRoute", "1", false)
+	tagDict[Tag{0x0018, 0x1071}] = newInfo(Tag{0x0018, 0x1071}, "DS", "RadiopharmaceuticalVolume", "1", false)
+	tagDict[Tag{0x0018, 0x1072}] = newInfo(Tag{0x0018, 0x1072}, "TM", "RadiopharmaceuticalStartTime", "1", false)
+	tagDict[Tag{0x0018, 0x1073}] = newInfo(Tag{0x0018, 0x1073}, "TM", "RadiopharmaceuticalStopTime", "1", false)
+	tagDict[Tag{0x0018, 0x1074}] = newInfo(Tag{0x0018, 0x1074}, "DS", "RadionuclideTotalDose", "1", false)
+	tagDict[Tag{0x0018, 0x1075}] = newInfo(Tag{0x0018, 0x1075}, "DS", "RadionuclideHalfLife", "1", false)
+	tagDict[Tag{0x0018, 0x1076}] = newInfo(Tag{0x0018, 0x1076}, "DS", "RadionuclidePositronFraction", "1", false)
+	tagDict[Tag{0x0018, 0x1077}] = newInfo(Tag{0x0018, 0x1077}, "DS", "RadiopharmaceuticalSpecificActivity", "1", false)
+	tagDict[Tag{0x0018, 0x1078}] = newInfo(Tag{0x0018, 0x1078}, "DT", "RadiopharmaceuticalStartDateTime", "1", false)
+	tagDict[Tag{0x0018, 0x1079}] = newInfo(Tag{0x0018, 0x1079}, "DT", "RadiopharmaceuticalStopDateTime", "1", false)
+	tagDict[Tag{0x0018, 0x1080}] = newInfo(Tag{0x0018, 0x1080}, "CS", "BeatRejectionFlag", "1", false)
+	tagDict[Tag{0x0018, 0x1081}] = newInfo(Tag{0x0018, 0x1081}, "IS", "LowRRValue", "1", false)
+	tagDict[Tag{0x0018, 0x1082}] = newInfo(Tag{0x0018, 0x1082}, "IS", "HighRRValue", "1", false)
+	tagDict[Tag{0x0018, 0x1083}] = newInfo(Tag{0x0018, 0x1083}, "IS", "IntervalsAcquired", "1", false)
+	tagDict[Tag{0x0018, 0x1084}] = newInfo(Tag{0x0018, 0x1084}, "IS", "IntervalsRejected", "1", false)
+	tagDict[Tag{0x0018, 0x1085}] = newInfo(Tag{0x0018, 0x1085}, "LO", "PVCRejection", "1", false)
+	tagDict[Tag{0x0018, 0x1086}] = newInfo(Tag{0x0018, 0x1086}, "IS", "SkipBeats", "1", false)
+	tagDict[Tag{0x0018, 0x1088}] = newInfo(Tag{0x0018, 0x1088}, "IS", "HeartRate", "1", false)
+	tagDict[Tag{0x0018, 0x1090}] = newInfo(Tag{0x0018, 0x1090}, "IS", "CardiacNumberOfImages", "1", false)
+	tagDict[Tag{0x0018, 0x1094}] = newInfo(Tag{0x0018, 0x1094}, "IS", "TriggerWindow", "1", false)
+	tagDict[Tag{0x0018, 0x1100}] = newInfo(Tag{0x0018, 0x1100}, "DS", "ReconstructionDiameter", "1", false)
+	tagDict[Tag{0x0018, 0x1110}] = newInfo(Tag{0x0018, 0x1110}, "DS", "DistanceSourceToDetector", "1", false)
+	tagDict[Tag{0x0018, 0x1111}] = newInfo(Tag{0x0018, 0x1111}, "DS", "DistanceSourceToPatient", "1", false)
+	tagDict[Tag{0x0018, 0x1114}] = newInfo(Tag{0x0018, 0x1114}, "DS", "EstimatedRadiographicMagnificationFactor", "1", false)
+	tagDict[Tag{0x0018, 0x1120}] = newInfo(Tag{0x0018, 0x1120}, "DS", "GantryDetectorTilt", "1", false)
+	tagDict[Tag{0x0018, 0x1121}] = newInfo(Tag{0x0018, 0x1121}, "DS", "GantryDetectorSlew", "1", false)
+	tagDict[Tag{0x0018, 0x1130}] = newInfo(Tag{0x0018, 0x1130}, "DS", "TableHeight", "1", false)
+	tagDict[Tag{0x0018, 0x1131}] = newInfo(Tag{0x0018, 0x1131}, "DS", "TableTraverse", "1", false)
+	tagDict[Tag{0x0018, 0x1134}] = newInfo(Tag{0x0018, 0x1134}, "CS", "TableMotion", "1", false)
+	tagDict[Tag{0x0018, 0x1135}] = newInfo(Tag{0x0018, 0x1135}, "DS", "TableVerticalIncrement", "1-n", false)
+	tagDict[Tag{0x0018, 0x1136}] = newInfo(Tag{0x0018, 0x1136}, "DS", "TableLateralIncrement", "1-n", false)
+	tagDict[Tag{0x0018, 0x1137}] = newInfo(Tag{0x0018, 0x1137}, "DS", "TableLongitudinalIncrement", "1-n", false)
+	tagDict[Tag{0x0018, 0x1138}] = newInfo(Tag{0x0018, 0x1138}, "DS", "TableAngle", "1", false)
+	tagDict[Tag{0x0018, 0x113A}] = newInfo(Tag{0x0018, 0x113A}, "CS", "TableType", "1", false)
+	tagDict[Tag{0x0018, 0x1140}] = newInfo(Tag{0x0018, 0x1140}, "CS", "RotationDirection", "1", false)
+	tagDict[Tag{0x0018, 0x1142}] = newInfo(Tag{0x0018, 0x1142}, "DS", "RadialPosition", "1-n", false)
+	tagDict[Tag{0x0018, 0x1143}] = newInfo(Tag{0x0018, 0x1143}, "DS", "ScanArc", "1", false)
+	tagDict[Tag{0x0018, 0x1144}] = newInfo(Tag{0x0018, 0x1144}, "DS", "AngularStep", "1", false)
+	tagDict[Tag{0x0018, 0x1145}] = newInfo(Tag{0x0018, 0x1145}, "DS", "CenterOfRotationOffset", "1", false)
+	tagDict[Tag{0x0018, 0x1147}] = newInfo(Tag{0x0018, 0x1147}, "CS", "FieldOfViewShape", "1", false)
+	tagDict[Tag{0x0018, 0x1149}] = newInfo(Tag{0x0018, 0x1149}, "IS", "FieldOfViewDimensions", "1-2", false)
+	tagDict[Tag{0x0018, 0x1150}] = newInfo(Tag{0x0018, 0x1150}, "IS", "ExposureTime", "1", false)
+	tagDict[Tag{0x0018, 0x1151}] = newInfo(Tag{0x0018, 0x1151}, "IS", "XRayTubeCurrent", "1", false)
+	tagDict[Tag{0x0018, 0x1152}] = newInfo(Tag{0x0018, 0x1152}, "IS", "Exposure", "1", false)
+	tagDict[Tag{0x0018, 0x1153}] = newInfo(Tag{0x0018, 0x1153}, "IS", "ExposureInuAs", "1", false)
+	tagDict[Tag{0x0018, 0x1154}] = newInfo(Tag{0x0018, 0x1154}, "DS", "AveragePulseWidth", "1", false)
+	tagDict[Tag{0x0018, 0x1155}] = newInfo(Tag{0x0018, 0x1155}, "CS", "RadiationSetting", "1", false)
+	tagDict[Tag{0x0018, 0x1156}] = newInfo(Tag{0x0018, 0x1156}, "CS", "RectificationType", "1", false)
+	tagDict[Tag{0x0018, 0x115A}] = newInfo(Tag{0x0018, 0x115A}, "CS", "RadiationMode", "1", false)
+	tagDict[Tag{0x0018, 0x115E}] = newInfo(Tag{0x0018, 0x115E}, "DS", "ImageAndFluoroscopyAreaDoseProduct", "1", false)
+	tagDict[Tag{0x0018, 0x1160}] = newInfo(Tag{0x0018, 0x1160}, "SH", "FilterType", "1", false)
+	tagDict[Tag{0x0018, 0x1161}] = newInfo(Tag{0x0018, 0x1161}, "LO", "TypeOfFilters", "1-n", false)
+	tagDict[Tag{0x0018, 0x1162}] = newInfo(Tag{0x0018, 0x1162}, "DS", "IntensifierSize", "1", false)
+	tagDict[Tag{0x0018, 0x1164}] = newInfo(Tag{0x0018, 0x1164}, "DS", "ImagerPixelSpacing", "2", false)
+	tagDict[Tag{0x0018, 0x1166}] = newInfo(Tag{0x0018, 0x1166}, "CS", "Grid", "1-n", false)
+	tagDict[Tag{0x0018, 0x1170}] = newInfo(Tag{0x0018, 0x1170}, "IS", "GeneratorPower", "1", false)
+	tagDict[Tag{0x0018, 0x1180}] = newInfo(Tag{0x0018, 0x1180}, "SH", "CollimatorGridName", "1", false)
+	tagDict[Tag{0x0018, 0x1181}] = newInfo(Tag{0x0018, 0x1181}, "CS", "CollimatorType", "1", false)
+	tagDict[Tag{0x0018, 0x1182}] = newInfo(Tag{0x0018, 0x1182}, "IS", "FocalDistance", "1-2", false)
+	tagDict[Tag{0x0018, 0x1183}] = newInfo(Tag{0x0018, 0x1183}, "DS", "XFocusCenter", "1-2", false)
+	tagDict[Tag{0x0018, 0x1184}] = newInfo(Tag{0x0018, 0x1184}, "DS", "YFocusCenter", "1-2", false)
+	tagDict[Tag{0x0018, 0x1190}] = newInfo(Tag{0x0018, 0x1190}, "DS", "FocalSpots", "1-n", false)
+	tagDict[Tag{0x0018, 0x1191}] = newInfo(Tag{0x0018, 0x1191}, "CS", "AnodeTargetMaterial", "1", false)
+	tagDict[Tag{0x0018, 0x11A0}] = newInfo(Tag{0x0018, 0x11A0}, "DS", "BodyPartThickness", "1", false)
+	tagDict[Tag{0x0018, 0x11A2}] = newInfo(Tag{0x0018, 0x11A2}, "DS", "CompressionForce", "1", false)
+	tagDict[Tag{0x0018, 0x1200}] = newInfo(Tag{0x0018, 0x1200}, "DA", "DateOfLastCalibration", "1-n", false)
+	tagDict[Tag{0x0018, 0x1201}] = newInfo(Tag{0x0018, 0x1201}, "TM", "TimeOfLastCalibration", "1-n", false)
+	tagDict[Tag{0x0018, 0x1210}] = newInfo(Tag{0x0018, 0x1210}, "SH", "ConvolutionKernel", "1-n", false)
+	tagDict[Tag{0x0018, 0x1242}] = newInfo(Tag{0x0018, 0x1242}, "IS", "ActualFrameDuration", "1", false)
+	tagDict[Tag{0x0018, 0x1243}] = newInfo(Tag{0x0018, 0x1243}, "IS", "CountRate", "1", false)
+	tagDict[Tag{0x0018, 0x1244}] = newInfo(Tag{0x0018, 0x1244}, "US", "PreferredPlaybackSequencing", "1", false)
+	tagDict[Tag{0x0018, 0x1250}] = newInfo(Tag{0x0018, 0x1250}, "SH", "ReceiveCoilName", "1", false)
+	tagDict[Tag{0x0018, 0x1251}] = newInfo(Tag{0x0018, 0x1251}, "SH", "TransmitCoilName", "1", false)
+	tagDict[Tag{0x0018, 0x1260}] = newInfo(Tag{0x0018, 0x1260}, "SH", "PlateType", "1", false)
+	tagDict[Tag{0x0018, 0x1261}] = newInfo(Tag{0x0018, 0x1261}, "LO", "PhosphorType", "1", false)
+	tagDict[Tag{0x0018, 0x1300}] = newInfo(Tag{0x0018, 0x1300}, "DS", "ScanVelocity", "1", false)
+	tagDict[Tag{0x0018, 0x1301}] = newInfo(Tag{0x0018, 0x1301}, "CS", "WholeBodyTechnique", "1-n", false)
+	tagDict[Tag{0x0018, 0x1302}] = newInfo(Tag{0x0018, 0x1302}, "IS", "ScanLength", "1", false)
+	tagDict[Tag{0x0018, 0x1310}] = newInfo(Tag{0x0018, 0x1310}, "US", "AcquisitionMatrix", "4", false)
+	tagDict[Tag{0x0018, 0x1312}] = newInfo(Tag{0x0018, 0x1312}, "CS", "InPlanePhaseEncodingDirection", "1", false)
+	tagDict[Tag{0x0018, 0x1314}] = newInfo(Tag{0x0018, 0x1314}, "DS", "FlipAngle", "1", false)
+	tagDict[Tag{0x0018, 0x1315}] = newInfo(Tag{0x0018, 0x1315}, "CS", "VariableFlipAngleFlag", "1", false)
+	tagDict[Tag{0x0018, 0x1316}] = newInfo(Tag{0x0018, 0x1316}, "DS", "SAR", "1", false)
+	tagDict[Tag{0x0018, 0x1318}] = newInfo(Tag{0x0018, 0x1318}, "DS", "dBdt", "1", false)
+	tagDict[Tag{0x0018, 0x1400}] = newInfo(Tag{0x0018, 0x1400}, "LO", "AcquisitionDeviceProcessingDescription", "1", false)
+	tagDict[Tag{0x0018, 0x1401}] = newInfo(Tag{0x0018, 0x1401}, "LO", "AcquisitionDeviceProcessingCode", "1", false)
+	tagDict[Tag{0x0018, 0x1402}] = newInfo(Tag{0x0018, 0x1402}, "CS", "CassetteOrientation", "1", false)
+	tagDict[Tag{0x0018, 0x1403}] = newInfo(Tag{0x0018, 0x1403}, "CS", "CassetteSize", "1", false)
+	tagDict[Tag{0x0018, 0x1404}] = newInfo(Tag{0x0018, 0x1404}, "US", "ExposuresOnPlate", "1", false)
+	tagDict[Tag{0x0018, 0x1405}] = newInfo(Tag{0x0018, 0x1405}, "IS", "RelativeXRayExposure", "1", false)
+	tagDict[Tag{0x0018, 0x1411}] = newInfo(Tag{0x0018, 0x1411}, "DS", "ExposureIndex", "1", false)
+	tagDict[Tag{0x0018, 0x1412}] = newInfo(Tag{0x0018, 0x1412}, "DS", "TargetExposureIndex", "1", false)
+	tagDict[Tag{0x0018, 0x1413}] = newInfo(Tag{0x0018, 0x1413}, "DS", "DeviationIndex", "1", false)
+	tagDict[Tag{0x0018, 0x1450}] = newInfo(Tag{0x0018, 0x1450}, "DS", "ColumnAngulation", "1", false)
+	tagDict[Tag{0x0018, 0x1460}] = newInfo(Tag{0x0018, 0x1460}, "DS", "TomoLayerHeight", "1", false)
+	tagDict[Tag{0x0018, 0x1470}] = newInfo(Tag{0x0018, 0x1470}, "DS", "TomoAngle", "1", false)
+	tagDict[Tag{0x0018, 0x1480}] = newInfo(Tag{0x0018, 0x1480}, "DS", "TomoTime", "1", false)
+	tagDict[Tag{0x0018, 0x1490}] = newInfo(Tag{0x0018, 0x1490}, "CS", "TomoType", "1", false)
+	tagDict[Tag{0x0018, 0x1491}] = newInfo(Tag{0x0018, 0x1491}, "CS", "TomoClass", "1", false)
+	tagDict[Tag{0x0018, 0x1495}] = newInfo(Tag{0x0018, 0x1495}, "IS", "NumberOfTomosynthesisSourceImages", "1", false)
+	tagDict[Tag{0x0018, 0x1500}] = newInfo(Tag{0x0018, 0x1500}, "CS", "PositionerMotion", "1", false)
+	tagDict[Tag{0x0018, 0x1508}] = newInfo(Tag{0x0018, 0x1508}, "CS", "PositionerType", "1", false)
+	tagDict[Tag{0x0018, 0x1510}] = newInfo(Tag{0x0018, 0x1510}, "DS", "PositionerPrimaryAngle", "1", false)
+	tagDict[Tag{0x0018, 0x1511}] = newInfo(Tag{0x0018, 0x1511}, "DS", "PositionerSecondaryAngle", "1", false)
+	tagDict[Tag{0x0018, 0x1520}] = newInfo(Tag{0x0018, 0x1520}, "DS", "PositionerPrimaryAngleIncrement", "1-n", false)
+	tagDict[Tag{0x0018, 0x1521}] = newInfo(Tag{0x0018, 0x1521}, "DS", "PositionerSecondaryAngleIncrement", "1-n", false)
+	tagDict[Tag{0x0018, 0x1530}] = newInfo(Tag{0x0018, 0x1530}, "DS", "DetectorPrimaryAngle", "1", false)
+	tagDict[Tag{0x0018, 0x1531}] = newInfo(Tag{0x0018, 0x1531}, "DS", "DetectorSecondaryAngle", "1", false)
+	tagDict[Tag{0x0018, 0x1600}] = newInfo(Tag{0x0018, 0x1600}, "CS", "ShutterShape", "1-3", false)
+	tagDict[Tag{0x0018, 0x1602}] = newInfo(Tag{0x0018, 0x1602}, "IS", "ShutterLeftVerticalEdge", "1", false)
+	tagDict[Tag{0x0018, 0x1604}] = newInfo(Tag{0x0018, 0x1604}, "IS", "ShutterRightVerticalEdge", "1", false)
+	tagDict[Tag{0x0018, 0x1606}] = newInfo(Tag{0x0018, 0x1606}, "IS", "ShutterUpperHorizontalEdge", "1", false)
+	tagDict[Tag{0x0018, 0x1608}] = newInfo(Tag{0x0018, 0x1608}, "IS", "ShutterLowerHorizontalEdge", "1", false)
+	tagDict[Tag{0x0018, 0x1610}] = newInfo(Tag{0x0018, 0x1610}, "IS", "CenterOfCircularShutter", "2", false)
+	tagDict[Tag{0x0018, 0x1612}] = newInfo(Tag{0x0018, 0x1612}, "IS", "RadiusOfCircularShutter", "1", false)
+	tagDict[Tag{0x0018, 0x1620}] = newInfo(Tag{0x0018, 0x1620}, "IS", "VerticesOfThePolygonalShutter", "2-2n", false)
+	tagDict[Tag{0x0018, 0x1622}] = newInfo(Tag{0x0018, 0x1622}, "US", "ShutterPresentationValue", "1", false)
+	tagDict[Tag{0x0018, 0x1623}] = newInfo(Tag{0x0018, 0x1623}, "US", "ShutterOverlayGroup", "1", false)
+	tagDict[Tag{0x0018, 0x1624}] = newInfo(Tag{0x0018, 0x1624}, "US", "ShutterPresentationColorCIELabValue", "3", false)
+	tagDict[Tag{0x0018, 0x1700}] = newInfo(Tag{0x0018, 0x1700}, "CS", "CollimatorShape", "1-3", false)
+	tagDict[Tag{0x0018, 0x1702}] = newInfo(Tag{0x0018, 0x1702}, "IS", "CollimatorLeftVerticalEdge", "1", false)
+	tagDict[Tag{0x0018, 0x1704}] = newInfo(Tag{0x0018, 0x1704}, "IS", "CollimatorRightVerticalEdge", "1", false)
+	tagDict[Tag{0x0018, 0x1706}] = newInfo(Tag{0x0018, 0x1706}, "IS", "CollimatorUpperHorizontalEdge", "1", false)
+	tagDict[Tag{0x0018, 0x1708}] = newInfo(Tag{0x0018, 0x1708}, "IS", "CollimatorLowerHorizontalEdge", "1", false)
+	tagDict[Tag{0x0018, 0x1710}] = newInfo(Tag{0x0018, 0x1710}, "IS", "CenterOfCircularCollimator", "2", false)
+	tagDict[Tag{0x0018, 0x1712}] = newInfo(Tag{0x0018, 0x1712}, "IS", "RadiusOfCircularCollimator", "1", false)
+	tagDict[Tag{0x0018, 0x1720}] = newInfo(Tag{0x0018, 0x1720}, "IS", "VerticesOfThePolygonalCollimator", "2-2n", false)
+	tagDict[Tag{0x0018, 0x1800}] = newInfo(Tag{0x0018, 0x1800}, "CS", "AcquisitionTimeSynchronized", "1", false)
+	tagDict[Tag{0x0018, 0x1801}] = newInfo(Tag{0x0018, 0x1801}, "SH", "TimeSource", "1", false)
+	tagDict[Tag{0x0018, 0x1802}] = newInfo(Tag{0x0018, 0x1802}, "CS", "TimeDistributionProtocol", "1", false)
+	tagDict[Tag{0x0018, 0x1803}] = newInfo(Tag{0x0018, 0x1803}, "LO", "NTPSourceAddress", "1", false)
+	tagDict[Tag{0x0018, 0x2001}] = newInfo(Tag{0x0018, 0x2001}, "IS", "PageNumberVector", "1-n", false)
+	tagDict[Tag{0x0018, 0x2002}] = newInfo(Tag{0x0018, 0x2002}, "SH", "FrameLabelVector", "1-n", false)
+	tagDict[Tag{0x0018, 0x2003}] = newInfo(Tag{0x0018, 0x2003}, "DS", "FramePrimaryAngleVector", "1-n", false)
+	tagDict[Tag{0x0018, 0x2004}] = newInfo(Tag{0x0018, 0x2004}, "DS", "FrameSecondaryAngleVector", "1-n", false)
+	tagDict[Tag{0x0018, 0x2005}] = newInfo(Tag{0x0018, 0x2005}, "DS", "SliceLocationVector", "1-n", false)
+	tagDict[Tag{0x0018, 0x2006}] = newInfo(Tag{0x0018, 0x2006}, "SH", "DisplayWindowLabelVector", "1-n", false)
+	tagDict[Tag{0x0018, 0x2010}] = newInfo(Tag{0x0018, 0x2010}, "DS", "NominalScannedPixelSpacing", "2", false)
+	tagDict[Tag{0x0018, 0x2020}] = newInfo(Tag{0x0018, 0x2020}, "CS", "DigitizingDeviceTransportDirection", "1", false)
+	tagDict[Tag{0x0018, 0x2030}] = newInfo(Tag{0x0018, 0x2030}, "DS", "RotationOfScannedFilm", "1", false)
+	tagDict[Tag{0x0018, 0x3100}] = newInfo(Tag{0x0018, 0x3100}, "CS", "IVUSAcquisition", "1", false)
+	tagDict[Tag{0x0018, 0x3101}] = newInfo(Tag{0x0018, 0x3101}, "DS", "IVUSPullbackRate", "1", false)
+	tagDict[Tag{0x0018, 0x3102}] = newInfo(Tag{0x0018, 0x3102}, "DS", "IVUSGatedRate", "1", false)
+	tagDict[Tag{0x0018, 0x3103}] = newInfo(Tag{0x0018, 0x3103}, "IS", "IVUSPullbackStartFrameNumber", "1", false)
+	tagDict[Tag{0x0018, 0x3104}] = newInfo(Tag{0x0018, 0x3104}, "IS", "IVUSPullbackStopFrameNumber", "1", false)
+	tagDict[Tag{0x0018, 0x3105}] = newInfo(Tag{0x0018, 0x3105}, "IS", "LesionNumber", "1-n", false)
+	tagDict[Tag{0x0018, 0x5000}] = newInfo(Tag{0x0018, 0x5000}, "SH", "OutputPower", "1-n", false)
+	tagDict[Tag{0x0018, 0x5010}] = newInfo(Tag{0x0018, 0x5010}, "LO", "TransducerData", "1-n", false)
+	tagDict[Tag{0x0018, 0x5012}] = newInfo(Tag{0x0018, 0x5012}, "DS", "FocusDepth", "1", false)
+	tagDict[Tag{0x0018, 0x5020}] = newInfo(Tag{0x0018, 0x5020}, "LO", "ProcessingFunction", "1", false)
+	tagDict[Tag{0x0018, 0x5022}] = newInfo(Tag{0x0018, 0x5022}, "DS", "MechanicalIndex", "1", false)
+	tagDict[Tag{0x0018, 0x5024}] = newInfo(Tag{0x0018, 0x5024}, "DS", "BoneThermalIndex", "1", false)
+	tagDict[Tag{0x0018, 0x5026}] = newInfo(Tag{0x0018, 0x5026}, "DS", "CranialThermalIndex", "1", false)
+	tagDict[Tag{0x0018, 0x5027}] = newInfo(Tag{0x0018, 0x5027}, "DS", "SoftTissueThermalIndex", "1", false)
+	tagDict[Tag{0x0018, 0x5028}] = newInfo(Tag{0x0018, 0x5028}, "DS", "SoftTissueFocusThermalIndex", "1", false)
+	tagDict[Tag{0x0018, 0x5029}] = newInfo(Tag{0x0018, 0x5029}, "DS", "SoftTissueSurfaceThermalIndex", "1", false)
+	tagDict[Tag{0x0018, 0x5050}] = newInfo(Tag{0x0018, 0x5050}, "IS", "DepthOfScanField", "1", false)
+	tagDict[Tag{0x0018, 0x5100}] = newInfo(Tag{0x0018, 0x5100}, "CS", "PatientPosition", "1", false)
+	tagDict[Tag{0x0018, 0x5101}] = newInfo(Tag{0x0018, 0x5101}, "CS", "ViewPosition", "1", false)
+	tagDict[Tag{0x0018, 0x5104}] = newInfo(Tag{0x0018, 0x5104}, "SQ", "ProjectionEponymousNameCodeSequence", "1", false)
+	tagDict[Tag{0x0018, 0x6000}] = newInfo(Tag{0x0018, 0x6000}, "DS", "Sensitivity", "1", false)
+	tagDict[Tag{0x0018, 0x6011}] = newInfo(Tag{0x0018, 0x6011}, "SQ", "SequenceOfUltrasoundRegions", "1", false)
+	tagDict[Tag{0x0018, 0x6012}] = newInfo(Tag{0x0018, 0x6012}, "US", "RegionSpatialFormat", "1", false)
+	tagDict[Tag{0x0018, 0x6014}] = newInfo(Tag{0x0018, 0x6014}, "US", "RegionDataType", "1", false)
+	tagDict[Tag{0x0018, 0x6016}] = newInfo(Tag{0x0018, 0x6016}, "UL", "RegionFlags", "1", false)
+	tagDict[Tag{0x0018, 0x6018}] = newInfo(Tag{0x0018, 0x6018}, "UL", "RegionLocationMinX0", "1", false)
+	tagDict[Tag{0x0018, 0x601A}] = newInfo(Tag{0x0018, 0x601A}, "UL", "RegionLocationMinY0", "1", false)
+	tagDict[Tag{0x0018, 0x601C}] = newInfo(Tag{0x0018, 0x601C}, "UL", "RegionLocationMaxX1", "1", false)
+	tagDict[Tag{0x0018, 0x601E}] = newInfo(Tag{0x0018, 0x601E}, "UL", "RegionLocationMaxY1", "1", false)
+	tagDict[Tag{0x0018, 0x6020}] = newInfo(Tag{0x0018, 0x6020}, "SL", "ReferencePixelX0", "1", false)
+	tagDict[Tag{0x0018, 0x6022}] = newInfo(Tag{0x0018, 0x6022}, "SL", "ReferencePixelY0", "1", false)
+	tagDict[Tag{0x0018, 0x6024}] = newInfo(Tag{0x0018, 0x6024}, "US", "PhysicalUnitsXDirection", "1", false)
+	tagDict[Tag{0x0018, 0x6026}] = newInfo(Tag{0x0018, 0x6026}, "US", "PhysicalUnitsYDirection", "1", false)
+	tagDict[Tag{0x0018, 0x6028}] = newInfo(Tag{0x0018, 0x6028}, "FD", "ReferencePixelPhysicalValueX", "1", false)
+	tagDict[Tag{0x0018, 0x602A}] = newInfo(Tag{0x0018, 0x602A}, "FD", "ReferencePixelPhysicalValueY", "1", false)
+	tagDict[Tag{0x0018, 0x602C}] = newInfo(Tag{0x0018, 0x602C}, "FD", "PhysicalDeltaX", "1", false)
+	tagDict[Tag{0x0018, 0x602E}] = newInfo(Tag{0x0018, 0x602E}, "FD", "PhysicalDeltaY", "1", false)
+	tagDict[Tag{0x0018, 0x6030}] = newInfo(Tag{0x0018, 0x6030}, "UL", "TransducerFrequency", "1", false)
+	tagDict[Tag{0x0018, 0x6031}] = newInfo(Tag{0x0018, 0x6031}, "CS", "TransducerType", "1", false)
+	tagDict[Tag{0x0018, 0x6032}] = newInfo(Tag{0x0018, 0x6032}, "UL", "PulseRepetitionFrequency", "1", false)
+	tagDict[Tag{0x0018, 0x6034}] = newInfo(Tag{0x0018, 0x6034}, "FD", "DopplerCorrectionAngle", "1", false)
+	tagDict[Tag{0x0018, 0x6036}] = newInfo(Tag{0x0018, 0x6036}, "FD", "SteeringAngle", "1", false)
+	tagDict[Tag{0x0018, 0x6039}] = newInfo(Tag{0x0018, 0x6039}, "SL", "DopplerSampleVolumeXPosition", "1", false)
+	tagDict[Tag{0x0018, 0x603B}] = newInfo(Tag{0x0018, 0x603B}, "SL", "DopplerSampleVolumeYPosition", "1", false)
+	tagDict[Tag{0x0018, 0x603D}] = newInfo(Tag{0x0018, 0x603D}, "SL", "TMLinePositionX0", "1", false)
+	tagDict[Tag{0x0018, 0x603F}] = newInfo(Tag{0x0018, 0x603F}, "SL", "TMLinePositionY0", "1", false)
+	tagDict[Tag{0x0018, 0x6041}] = newInfo(Tag{0x0018, 0x6041}, "SL", "TMLinePositionX1", "1", false)
+	tagDict[Tag{0x0018, 0x6043}] = newInfo(Tag{0x0018, 0x6043}, "SL", "TMLinePositionY1", "1", false)
+	tagDict[Tag{0x0018, 0x6044}] = newInfo(Tag{0x0018, 0x6044}, "US", "PixelComponentOrganization", "1", false)
+	tagDict[Tag{0x0018, 0x6046}] = newInfo(Tag{0x0018, 0x6046}, "UL", "PixelComponentMask", "1", false)
+	tagDict[Tag{0x0018, 0x6048}] = newInfo(Tag{0x0018, 0x6048}, "UL", "PixelComponentRangeStart", "1", false)
+	tagDict[Tag{0x0018, 0x604A}] = newInfo(Tag{0x0018, 0x604A}, "UL", "PixelComponentRangeStop", "1", false)
+	tagDict[Tag{0x0018, 0x604C}] = newInfo(Tag{0x0018, 0x604C}, "US", "PixelComponentPhysicalUnits", "1", false)
+	tagDict[Tag{0x0018, 0x604E}] = newInfo(Tag{0x0018, 0x604E}, "US", "PixelComponentDataType", "1", false)
+	tagDict[Tag{0x0018, 0x6050}] = newInfo(Tag{0x0018, 0x6050}, "UL", "NumberOfTableBreakPoints", "1", false)
+	tagDict[Tag{0x0018, 0x6052}] = newInfo(Tag{0x0018, 0x6052}, "UL", "TableOfXBreakPoints", "1-n", false)
+	tagDict[Tag{0x0018, 0x6054}] = newInfo(Tag{0x0018, 0x6054}, "FD", "TableOfYBreakPoints", "1-n", false)
+	tagDict[Tag{0x0018, 0x6056}] = newInfo(Tag{0x0018, 0x6056}, "UL", "NumberOfTableEntries", "1", false)
+	tagDict[Tag{0x0018, 0x6058}] = newInfo(Tag{0x0018, 0x6058}, "UL", "TableOfPixelValues", "1-n", false)
+	tagDict[Tag{0x0018, 0x605A}] = newInfo(Tag{0x0018, 0x605A}, "FL", "TableOfParameterValues", "1-n", false)
+	tagDict[Tag{0x0018, 0x6060}] = newInfo(Tag{0x0018, 0x6060}, "FL", "RWaveTimeVector", "1-n", false)
+	tagDict[Tag{0x0018, 0x7000}] = newInfo(Tag{0x0018, 0x7000}, "CS", "DetectorConditionsNominalFlag", "1", false)
+	tagDict[Tag{0x0018, 0x7001}] = newInfo(Tag{0x0018, 0x7001}, "DS", "DetectorTemperature", "1", false)
+	tagDict[Tag{0x0018, 0x7004}] = newInfo(Tag{0x0018, 0x7004}, "CS", "DetectorType", "1", false)
+	tagDict[Tag{0x0018, 0x7005}] = newInfo(Tag{0x0018, 0x7005}, "CS", "DetectorConfiguration", "1", false)
+	tagDict[Tag{0x0018, 0x7006}] = newInfo(Tag{0x0018, 0x7006}, "LT", "DetectorDescription", "1", false)
+	tagDict[Tag{0x0018, 0x7008}] = newInfo(Tag{0x0018, 0x7008}, "LT", "DetectorMode", "1", false)
+	tagDict[Tag{0x0018, 0x700A}] = newInfo(Tag{0x0018, 0x700A}, "SH", "DetectorID", "1", false)
+	tagDict[Tag{0x0018, 0x700C}] = newInfo(Tag{0x0018, 0x700C}, "DA", "DateOfLastDetectorCalibration", "1", false)
+	tagDict[Tag{0x0018, 0x700E}] = newInfo(Tag{0x0018, 0x700E}, "TM", "TimeOfLastDetectorCalibration", "1", false)
+	tagDict[Tag{0x0018, 0x7010}] = newInfo(Tag{0x0018, 0x7010}, "IS", "ExposuresOnDetectorSinceLastCalibration", "1", false)
+	tagDict[Tag{0x0018, 0x7011}] = newInfo(Tag{0x0018, 0x7011}, "IS", "ExposuresOnDetectorSinceManufactured", "1", false)
+	tagDict[Tag{0x0018, 0x7012}] = newInfo(Tag{0x0018, 0x7012}, "DS", "DetectorTimeSinceLastExposure", "1", false)
+	tagDict[Tag{0x0018, 0x7014}] = newInfo(Tag{0x0018, 0x7014}, "DS", "DetectorActiveTime", "1", false)
+	tagDict[Tag{0x0018, 0x7016}] = newInfo(Tag{0x0018, 0x7016}, "DS", "DetectorActivationOffsetFromExposure", "1", false)
+	tagDict[Tag{0x0018, 0x701A}] = newInfo(Tag{0x0018, 0x701A}, "DS", "DetectorBinning", "2", false)
+	tagDict[Tag{0x0018, 0x7020}] = newInfo(Tag{0x0018, 0x7020}, "DS", "DetectorElementPhysicalSize", "2", false)
+	tagDict[Tag{0x0018, 0x7022}] = newInfo(Tag{0x0018, 0x7022}, "DS", "DetectorElementSpacing", "2", false)
+	tagDict[Tag{0x0018, 0x7024}] = newInfo(Tag{0x0018, 0x7024}, "CS", "DetectorActiveShape", "1", false)
+	tagDict[Tag{0x0018, 0x7026}] = newInfo(Tag{0x0018, 0x7026}, "DS", "DetectorActiveDimensions", "1-2", false)
+	tagDict[Tag{0x0018, 0x7028}] = newInfo(Tag{0x0018, 0x7028}, "DS", "DetectorActiveOrigin", "2", false)
+	tagDict[Tag{0x0018, 0x702A}] = newInfo(Tag{0x0018, 0x702A}, "LO", "DetectorManufacturerName", "1", false)
+	tagDict[Tag{0x0018, 0x702B}] = newInfo(Tag{0x0018, 0x702B}, "LO", "DetectorManufacturerModelName", "1", false)
+	tagDict[Tag{0x0018, 0x7030}] = newInfo(Tag{0x0018, 0x7030}, "DS", "FieldOfViewOrigin", "2", false)
+	tagDict[Tag{0x0018, 0x7032}] = newInfo(Tag{0x0018, 0x7032}, "DS", "FieldOfViewRotation", "1", false)
+	tagDict[Tag{0x0018, 0x7034}] = newInfo(Tag{0x0018, 0x7034}, "CS", "FieldOfViewHorizontalFlip", "1", false)
+	tagDict[Tag{0x0018, 0x7036}] = newInfo(Tag{0x0018, 0x7036}, "FL", "PixelDataAreaOriginRelativeToFOV", "2", false)
+	tagDict[Tag{0x0018, 0x7038}] = newInfo(Tag{0x0018, 0x7038}, "FL", "PixelDataAreaRotationAngleRelativeToFOV", "1", false)
+	tagDict[Tag{0x0018, 0x7040}] = newInfo(Tag{0x0018, 0x7040}, "LT", "GridAbsorbingMaterial", "1", false)
+	tagDict[Tag{0x0018, 0x7041}] = newInfo(Tag{0x0018, 0x7041}, "LT", "GridSpacingMaterial", "1", false)
+	tagDict[Tag{0x0018, 0x7042}] = newInfo(Tag{0x0018, 0x7042}, "DS", "GridThickness", "1", false)
+	tagDict[Tag{0x0018, 0x7044}] = newInfo(Tag{0x0018, 0x7044}, "DS", "GridPitch", "1", false)
+	tagDict[Tag{0x0018, 0x7046}] = newInfo(Tag{0x0018, 0x7046}, "IS", "GridAspectRatio", "2", false)
+	tagDict[Tag{0x0018, 0x7048}] = newInfo(Tag{0x0018, 0x7048}, "DS", "GridPeriod", "1", false)
+	tagDict[Tag{0x0018, 0x704C}] = newInfo(Tag{0x0018, 0x704C}, "DS", "GridFocalDistance", "1", false)
+	tagDict[Tag{0x0018, 0x7050}] = newInfo(Tag{0x0018, 0x7050}, "CS", "FilterMaterial", "1-n", false)
+	tagDict[Tag{0x0018, 0x7052}] = newInfo(Tag{0x0018, 0x7052}, "DS", "FilterThicknessMinimum", "1-n", false)
+	tagDict[Tag{0x0018, 0x7054}] = newInfo(Tag{0x0018, 0x7054}, "DS", "FilterThicknessMaximum", "1-n", false)
+	tagDict[Tag{0x0018, 0x7056}] = newInfo(Tag{0x0018, 0x7056}, "FL", "FilterBeamPathLengthMinimum", "1-n", false)
+	tagDict[Tag{0x0018, 0x7058}] = newInfo(Tag{0x0018, 0x7058}, "FL", "FilterBeamPathLengthMaximum", "1-n", false)
+	tagDict[Tag{0x0018, 0x7060}] = newInfo(Tag{0x0018, 0x7060}, "CS", "ExposureControlMode", "1", false)
+	tagDict[Tag{0x0018, 0x7062}] = newInfo(Tag{0x0018, 0x7062}, "LT", "ExposureControlModeDescription", "1", false)
+	tagDict[Tag{0x0018, 0x7064}] = newInfo(Tag{0x0018, 0x7064}, "CS", "ExposureStatus", "1", false)
+	tagDict[Tag{0x0018, 0x7065}] = newInfo(Tag{0x0018, 0x7065}, "DS", "PhototimerSetting", "1", false)
+	tagDict[Tag{0x0018, 0x8150}] = newInfo(Tag{0x0018, 0x8150}, "DS", "ExposureTimeInuS", "1", false)
+	tagDict[Tag{0x0018, 0x8151}] = newInfo(Tag{0x0018, 0x8151}, "DS", "XRayTubeCurrentInuA", "1", false)
+	tagDict[Tag{0x0018, 0x9004}] = newInfo(Tag{0x0018, 0x9004}, "CS", "ContentQualification", "1", false)
+	tagDict[Tag{0x0018, 0x9005}] = newInfo(Tag{0x0018, 0x9005}, "SH", "PulseSequenceName", "1", false)
+	tagDict[Tag{0x0018, 0x9006}] = newInfo(Tag{0x0018, 0x9006}, "SQ", "MRImagingModifierSequence", "1", false)
+	tagDict[Tag{0x0018, 0x9008}] = newInfo(Tag{0x0018, 0x9008}, "CS", "EchoPulseSequence", "1", false)
+	tagDict[Tag{0x0018, 0x9009}] = newInfo(Tag{0x0018, 0x9009}, "CS", "InversionRecovery", "1", false)
+	tagDict[Tag{0x0018, 0x9010}] = newInfo(Tag{0x0018, 0x9010}, "CS", "FlowCompensation", "1", false)
+	tagDict[Tag{0x0018, 0x9011}] = newInfo(Tag{0x0018, 0x9011}, "CS", "MultipleSpinEcho", "1", false)
+	tagDict[Tag{0x0018, 0x9012}] = newInfo(Tag{0x0018, 0x9012}, "CS", "MultiPlanarExcitation", "1", false)
+	tagDict[Tag{0x0018, 0x9014}] = newInfo(Tag{0x0018, 0x9014}, "CS", "PhaseContrast", "1", false)
+	tagDict[Tag{0x0018, 0x9015}] = newInfo(Tag{0x0018, 0x9015}, "CS", "TimeOfFlightContrast", "1", false)
+	tagDict[Tag{0x0018, 0x9016}] = newInfo(Tag{0x0018, 0x9016}, "CS", "Spoiling", "1", false)
+	tagDict[Tag{0x0018, 0x9017}] = newInfo(Tag{0x0018, 0x9017}, "CS", "SteadyStatePulseSequence", "1", false)
+	tagDict[Tag{0x0018, 0x9018}] = newInfo(Tag{0x0018, 0x9018}, "CS", "EchoPlanarPulseSequence", "1", false)
+	tagDict[Tag{0x0018, 0x9019}] = newInfo(Tag{0x0018, 0x9019}, "FD", "TagAngleFirstAxis", "1", false)
+	tagDict[Tag{0x0018, 0x9020}] = newInfo(Tag{0x0018, 0x9020}, "CS", "MagnetizationTransfer", "1", false)
+	tagDict[Tag{0x0018, 0x9021}] = newInfo(Tag{0x0018, 0x9021}, "CS", "T2Preparation", "1", false)
+	tagDict[Tag{0x0018, 0x9022}] = newInfo(Tag{0x0018, 0x9022}, "CS", "BloodSignalNulling", "1", false)
+	tagDict[Tag{0x0018, 0x9024}] = newInfo(Tag{0x0018, 0x9024}, "CS", "SaturationRecovery", "1", false)
+	tagDict[Tag{0x0018, 0x9025}] = newInfo(Tag{0x0018, 0x9025}, "CS", "SpectrallySelectedSuppression", "1", false)
+	tagDict[Tag{0x0018, 0x9026}] = newInfo(Tag{0x0018, 0x9026}, "CS", "SpectrallySelectedExcitation", "1", false)
+	tagDict[Tag{0x0018, 0x9027}] = newInfo(Tag{0x0018, 0x9027}, "CS", "SpatialPresaturation", "1", false)
+	tagDict[Tag{0x0018, 0x9028}] = newInfo(Tag{0x0018, 0x9028}, "CS", "Tagging", "1", false)
+	tagDict[Tag{0x0018, 0x9029}] = newInfo(Tag{0x0018, 0x9029}, "CS", "OversamplingPhase", "1", false)
+	tagDict[Tag{0x0018, 0x9030}] = newInfo(Tag{0x0018, 0x9030}, "FD", "TagSpacingFirstDimension", "1", false)
+	tagDict[Tag{0x0018, 0x9032}] = newInfo(Tag{0x0018, 0x9032}, "CS", "GeometryOfKSpaceTraversal", "1", false)
+	tagDict[Tag{0x0018, 0x9033}] = newInfo(Tag{0x0018, 0x9033}, "CS", "SegmentedKSpaceTraversal", "1", false)
+	tagDict[Tag{0x0018, 0x9034}] = newInfo(Tag{0x0018, 0x9034}, "CS", "RectilinearPhaseEncodeReordering", "1", false)
+	tagDict[Tag{0x0018, 0x9035}] = newInfo(Tag{0x0018, 0x9035}, "FD", "TagThickness", "1", false)
+	tagDict[Tag{0x0018, 0x9036}] = newInfo(Tag{0x0018, 0x9036}, "CS", "PartialFourierDirection", "1", false)
+	tagDict[Tag{0x0018, 0x9037}] = newInfo(Tag{0x0018, 0x9037}, "CS", "CardiacSynchronizationTechnique", "1", false)
+	tagDict[Tag{0x0018, 0x9041}] = newInfo(Tag{0x0018, 0x9041}, "LO", "ReceiveCoilManufacturerName", "1", false)
+	tagDict[Tag{0x0018, 0x9042}] = newInfo(Tag{0x0018, 0x9042}, "SQ", "MRReceiveCoilSequence", "1", false)
+	tagDict[Tag{0x0018, 0x9043}] = newInfo(Tag{0x0018, 0x9043}, "CS", "ReceiveCoilType", "1", false)
+	tagDict[Tag{0x0018, 0x9044}] = newInfo(Tag{0x0018, 0x9044}, "CS", "QuadratureReceiveCoil", "1", false)
+	tagDict[Tag{0x0018, 0x9045}] = newInfo(Tag{0x0018, 0x9045}, "SQ", "MultiCoilDefinitionSequence", "1", false)
+	tagDict[Tag{0x0018, 0x9046}] = newInfo(Tag{0x0018, 0x9046}, "LO", "MultiCoilConfiguration", "1", false)
+	tagDict[Tag{0x0018, 0x9047}] = newInfo(Tag{0x0018, 0x9047}, "SH", "MultiCoilElementName", "1", false)
+	tagDict[Tag{0x0018, 0x9048}] = newInfo(Tag{0x0018, 0x9048}, "CS", "MultiCoilElementUsed", "1", false)
+	tagDict[Tag{0x0018, 0x9049}] = newInfo(Tag{0x0018, 0x9049}, "SQ", "MRTransmitCoilSequence", "1", false)
+	tagDict[Tag{0x0018, 0x9050}] = newInfo(Tag{0x0018, 0x9050}, "LO", "TransmitCoilManufacturerName", "1", false)
+	tagDict[Tag{0x0018, 0x9051}] = newInfo(Tag{0x0018, 0x9051}, "CS", "TransmitCoilType", "1", false)
+	tagDict[Tag{0x0018, 0x9052}] = newInfo(Tag{0x0018, 0x9052}, "FD", "SpectralWidth", "1-2", false)
+	tagDict[Tag{0x0018, 0x9053}] = newInfo(Tag{0x0018, 0x9053}, "FD", "ChemicalShiftReference", "1-2", false)
+	tagDict[Tag{0x0018, 0x9054}] = newInfo(Tag{0x0018, 0x9054}, "CS", "VolumeLocalizationTechnique", "1", false)
+	tagDict[Tag{0x0018, 0x9058}] = newInfo(Tag{0x0018, 0x9058}, "US", "MRAcquisitionFrequencyEncodingSteps", "1", false)
+	tagDict[Tag{0x0018, 0x9059}] = newInfo(Tag{0x0018, 0x9059}, "CS", "Decoupling", "1", false)
+	tagDict[Tag{0x0018, 0x9060}] = newInfo(Tag{0x0018, 0x9060}, "CS", "DecoupledNucleus", "1-2", false)
+	tagDict[Tag{0x0018, 0x9061}] = newInfo(Tag{0x0018, 0x9061}, "FD", "DecouplingFrequency", "1-2", false)
+	tagDict[Tag{0x0018, 0x9062}] = newInfo(Tag{0x0018, 0x9062}, "CS", "DecouplingMethod", "1", false)
+	tagDict[Tag{0x0018, 0x9063}] = newInfo(Tag{0x0018, 0x9063}, "FD", "DecouplingChemicalShiftReference", "1-2", false)
+	tagDict[Tag{0x0018, 0x9064}] = newInfo(Tag{0x0018, 0x9064}, "CS", "KSpaceFiltering", "1", false)
+	tagDict[Tag{0x0018, 0x9065}] = newInfo(Tag{0x0018, 0x9065}, "CS", "TimeDomainFiltering", "1-2", false)
+	tagDict[Tag{0x0018, 0x9066}] = newInfo(Tag{0x0018, 0x9066}, "US", "NumberOfZeroFills", "1-2", false)
+	tagDict[Tag{0x0018, 0x9067}] = newInfo(Tag{0x0018, 0x9067}, "CS", "BaselineCorrection", "1", false)
+	tagDict[Tag{0x0018, 0x9069}] = newInfo(Tag{0x0018, 0x9069}, "FD", "ParallelReductionFactorInPlane", "1", false)
+	tagDict[Tag{0x0018, 0x9070}] = newInfo(Tag{0x0018, 0x9070}, "FD", "CardiacRRIntervalSpecified", "1", false)
+	tagDict[Tag{0x0018, 0x9073}] = newInfo(Tag{0x0018, 0x9073}, "FD", "AcquisitionDuration", "1", false)
+	tagDict[Tag{0x0018, 0x9074}] = newInfo(Tag{0x0018, 0x9074}, "DT", "FrameAcquisitionDateTime", "1", false)
+	tagDict[Tag{0x0018, 0x9075}] = newInfo(Tag{0x0018, 0x9075}, "CS", "DiffusionDirectionality", "1", false)
+	tagDict[Tag{0x0018, 0x9076}] = newInfo(Tag{0x0018, 0x9076}, "SQ", "DiffusionGradientDirectionSequence", "1", false)
+	tagDict[Tag{0x0018, 0x9077}] = newInfo(Tag{0x0018, 0x9077}, "CS", "ParallelAcquisition", "1", false)
+	tagDict[Tag{0x0018, 0x9078}] = newInfo(Tag{0x0018, 0x9078}, "CS", "ParallelAcquisitionTechnique", "1", false)
+	tagDict[Tag{0x0018, 0x9079}] = newInfo(Tag{0x0018, 0x9079}, "FD", "InversionTimes", "1-n", false)
+	tagDict[Tag{0x0018, 0x9080}] = newInfo(Tag{0x0018, 0x9080}, "ST", "MetaboliteMapDescription", "1", false)
+	tagDict[Tag{0x0018, 0x9081}] = newInfo(Tag{0x0018, 0x9081}, "CS", "PartialFourier", "1", false)
+	tagDict[Tag{0x0018, 0x9082}] = newInfo(Tag{0x0018, 0x9082}, "FD", "EffectiveEchoTime", "1", false)
+	tagDict[Tag{0x0018, 0x9083}] = newInfo(Tag{0x0018, 0x9083}, "SQ", "MetaboliteMapCodeSequence", "1", false)
+	tagDict[Tag{0x0018, 0x9084}] = newInfo(Tag{0x0018, 0x9084}, "SQ", "ChemicalShiftSequence", "1", false)
+	tagDict[Tag{0x0018, 0x9085}] = newInfo(Tag{0x0018, 0x9085}, "CS", "CardiacSignalSource", "1", false)
+	tagDict[Tag{0x0018, 0x9087}] = newInfo(Tag{0x0018, 0x9087}, "FD", "DiffusionBValue", "1", false)
+	tagDict[Tag{0x0018, 0x9089}] = newInfo(Tag{0x0018, 0x9089}, "FD", "DiffusionGradientOrientation", "3", false)
+	tagDict[Tag{0x0018, 0x9090}] = newInfo(Tag{0x0018, 0x9090}, "FD", "VelocityEncodingDirection", "3", false)
+	tagDict[Tag{0x0018, 0x9091}] = newInfo(Tag{0x0018, 0x9091}, "FD", "VelocityEncodingMinimumValue", "1", false)
+	tagDict[Tag{0x0018, 0x9092}] = newInfo(Tag{0x0018, 0x9092}, "SQ", "VelocityEncodingAcquisitionSequence", "1", false)
+	tagDict[Tag{0x0018, 0x9093}] = newInfo(Tag{0x0018, 0x9093}, "US", "NumberOfKSpaceTrajectories", "1", false)
+	tagDict[Tag{0x0018, 0x9094}] = newInfo(Tag{0x0018, 0x9094}, "CS", "CoverageOfKSpace", "1", false)
+	tagDict[Tag{0x0018, 0x9095}] = newInfo(Tag{0x0018, 0x9095}, "UL", "SpectroscopyAcquisitionPhaseRows", "1", false)
+	tagDict[Tag{0x0018, 0x9098}] = newInfo(Tag{0x0018, 0x9098}, "FD", "TransmitterFrequency", "1-2", false)
+	tagDict[Tag{0x0018, 0x9100}] = newInfo(Tag{0x0018, 0x9100}, "CS", "ResonantNucleus", "1-2", false)
+	tagDict[Tag{0x0018, 0x9101}] = newInfo(Tag{0x0018, 0x9101}, "CS", "FrequencyCorrection", "1", false)
+	tagDict[Tag{0x0018, 0x9103}] = newInfo(Tag{0x0018, 0x9103}, "SQ", "MRSpectroscopyFOVGeometrySequence", "1", false)
+	tagDict[Tag{0x0018, 0x9104}] = newInfo(Tag{0x0018, 0x9104}, "FD", "SlabThickness", "1", false)
+	tagDict[Tag{0x0018, 0x9105}] = newInfo(Tag{0x0018, 0x9105}, "FD", "SlabOrientation", "3", false)
+	tagDict[Tag{0x0018, 0x9106}] = newInfo(Tag{0x0018, 0x9106}, "FD", "MidSlabPosition", "3", false)
+	tagDict[Tag{0x0018, 0x9107}] = newInfo(Tag{0x0018, 0x9107}, "SQ", "MRSpatialSaturationSequence", "1", false)
+	tagDict[Tag{0x0018, 0x9112}] = newInfo(Tag{0x0018, 0x9112}, "SQ", "MRTimingAndRelatedParametersSequence", "1", false)
+	tagDict[Tag{0x0018, 0x9114}] = newInfo(Tag{0x0018, 0x9114}, "SQ", "MREchoSequence", "1", false)
+	tagDict[Tag{0x0018, 0x9115}] = newInfo(Tag{0x0018, 0x9115}, "SQ", "MRModifierSequence", "1", false)
+	tagDict[Tag{0x0018, 0x9117}] = newInfo(Tag{0x0018, 0x9117}, "SQ", "MRDiffusionSequence", "1", false)
+	tagDict[Tag{0x0018, 0x9118}] = newInfo(Tag{0x0018, 0x9118}, "SQ", "CardiacSynchronizationSequence", "1", false)
+	tagDict[Tag{0x0018, 0x9119}] = newInfo(Tag{0x0018, 0x9119}, "SQ", "MRAveragesSequence", "1", false)
+	tagDict[Tag{0x0018, 0x9125}] = newInfo(Tag{0x0018, 0x9125}, "SQ", "MRFOVGeometrySequence", "1", false)
+	tagDict[Tag{0x0018, 0x9126}] = newInfo(Tag{0x0018, 0x9126}, "SQ", "VolumeLocalizationSequence", "1", false)
+	tagDict[Tag{0x0018, 0x9127}] = newInfo(Tag{0x0018, 0x9127}, "UL", "SpectroscopyAcquisitionDataColumns", "1", false)
+	tagDict[Tag{0x0018, 0x9147}] = newInfo(Tag{0x0018, 0x9147}, "CS", "DiffusionAnisotropyType", "1", false)
+	tagDict[Tag{0x0018, 0x9151}] = newInfo(Tag{0x0018, 0x9151}, "DT", "FrameReferenceDateTime", "1", false)
+	tagDict[Tag{0x0018, 0x9152}] = newInfo(Tag{0x0018, 0x9152}, "SQ", "MRMetaboliteMapSequence", "1", false)
+	tagDict[Tag{0x0018, 0x9155}] = newInfo(Tag{0x0018, 0x9155}, "FD", "ParallelReductionFactorOutOfPlane", "1", false)
+	tagDict[Tag{0x0018, 0x9159}] = newInfo(Tag{0x0018, 0x9159}, "UL", "SpectroscopyAcquisitionOutOfPlanePhaseSteps", "1", false)
+	tagDict[Tag{0x0018, 0x9168}] = newInfo(Tag{0x0018, 0x9168}, "FD", "ParallelReductionFactorSecondInPlane", "1", false)
+	tagDict[Tag{0x0018, 0x9169}] = newInfo(Tag{0x0018, 0x9169}, "CS", "CardiacBeatRejectionTechnique", "1", false)
+	tagDict[Tag{0x0018, 0x9170}] = newInfo(Tag{0x0018, 0x9170}, "CS", "RespiratoryMotionCompensationTechnique", "1", false)
+	tagDict[Tag{0x0018, 0x9171}] = newInfo(Tag{0x0018, 0x9171}, "CS", "RespiratorySignalSource", "1", false)
+	tagDict[Tag{0x0018, 0x9172}] = newInfo(Tag{0x0018, 0x9172}, "CS", "BulkMotionCompensationTechnique", "1", false)
+	tagDict[Tag{0x0018, 0x9173}] = newInfo(Tag{0x0018, 0x9173}, "CS", "BulkMotionSignalSource", "1", false)
+	tagDict[Tag{0x0018, 0x9174}] = newInfo(Tag{0x0018, 0x9174}, "CS", "ApplicableSafetyStandardAgency", "1", false)
+	tagDict[Tag{0x0018, 0x9175}] = newInfo(Tag{0x0018, 0x9175}, "LO", "ApplicableSafetyStandardDescription", "1", false)
+	tagDict[Tag{0x0018, 0x9176}] = newInfo(Tag{0x0018, 0x9176}, "SQ", "OperatingModeSequence", "1", false)
+	tagDict[Tag{0x0018, 0x9177}] = newInfo(Tag{0x0018, 0x9177}, "CS", "OperatingModeType", "1", false)
+	tagDict[Tag{0x0018, 0x9178}] = newInfo(Tag{0x0018, 0x9178}, "CS", "OperatingMode", "1", false)
+	tagDict[Tag{0x0018, 0x9179}] = newInfo(Tag{0x0018, 0x9179}, "CS", "SpecificAbsorptionRateDefinition", "1", false)
+	tagDict[Tag{0x0018, 0x9180}] = newInfo(Tag{0x0018, 0x9180}, "CS", "GradientOutputType", "1", false)
+	tagDict[Tag{0x0018, 0x9181}] = newInfo(Tag{0x0018, 0x9181}, "FD", "SpecificAbsorptionRateValue", "1", false)
+	tagDict[Tag{0x0018, 0x9182}] = newInfo(Tag{0x0018, 0x9182}, "FD", "GradientOutput", "1", false)
+	tagDict[Tag{0x0018, 0x9183}] = newInfo(Tag{0x0018, 0x9183}, "CS", "FlowCompensationDirection", "1", false)
+	tagDict[Tag{0x0018, 0x9184}] = newInfo(Tag{0x0018, 0x9184}, "FD", "TaggingDelay", "1", false)
+	tagDict[Tag{0x0018, 0x9185}] = newInfo(Tag{0x0018, 0x9185}, "ST", "RespiratoryMotionCompensationTechniqueDescription", "1", false)
+	tagDict[Tag{0x0018, 0x9186}] = newInfo(Tag{0x0018, 0x9186}, "SH", "RespiratorySignalSourceID", "1", false)
+	tagDict[Tag{0x0018, 0x9197}] = newInfo(Tag{0x0018, 0x9197}, "SQ", "MRVelocityEncodingSequence", "1", false)
+	tagDict[Tag{0x0018, 0x9198}] = newInfo(Tag{0x0018, 0x9198}, "CS", "FirstOrderPhaseCorrection", "1", false)
+	tagDict[Tag{0x0018, 0x9199}] = newInfo(Tag{0x0018, 0x9199}, "CS", "WaterReferencedPhaseCorrection", "1", false)
+	tagDict[Tag{0x0018, 0x9200}] = newInfo(Tag{0x0018, 0x9200}, "CS", "MRSpectroscopyAcquisitionType", "1", false)
+	tagDict[Tag{0x0018, 0x9214}] = newInfo(Tag{0x0018, 0x9214}, "CS", "RespiratoryCyclePosition", "1", false)
+	tagDict[Tag{0x0018, 0x9217}] = newInfo(Tag{0x0018, 0x9217}, "FD", "VelocityEncodingMaximumValue", "1", false)
+	tagDict[Tag{0x0018, 0x9218}] = newInfo(Tag{0x0018, 0x9218}, "FD", "TagSpacingSecondDimension", "1", false)
+	tagDict[Tag{0x0018, 0x9219}] = newInfo(Tag{0x0018, 0x9219}, "SS", "TagAngleSecondAxis", "1", false)
+	tagDict[Tag{0x0018, 0x9220}] = newInfo(Tag{0x0018, 0x9220}, "FD", "FrameAcquisitionDuration", "1", false)
+	tagDict[Tag{0x0018, 0x9226}] = newInfo(Tag{0x0018, 0x9226}, "SQ", "MRImageFrameTypeSequence", "1", false)
+	tagDict[Tag{0x0018, 0x9227}] = newInfo(Tag{0x0018, 0x9227}, "SQ", "MRSpectroscopyFrameTypeSequence", "1", false)
+	tagDict[Tag{0x0018, 0x9231}] = newInfo(Tag{0x0018, 0x9231}, "US", "MRAcquisitionPhaseEncodingStepsInPlane", "1", false)
+	tagDict[Tag{0x0018, 0x9232}] = newInfo(Tag{0x0018, 0x9232}, "US", "MRAcquisitionPhaseEncodingStepsOutOfPlane", "1", false)
+	tagDict[Tag{0x0018, 0x9234}] = newInfo(Tag{0x0018, 0x9234}, "UL", "SpectroscopyAcquisitionPhaseColumns", "1", false)
+	tagDict[Tag{0x0018, 0x9236}] = newInfo(Tag{0x0018, 0x9236}, "CS", "CardiacCyclePosition", "1", false)
+	tagDict[Tag{0x0018, 0x9239}] = newInfo(Tag{0x0018, 0x9239}, "SQ", "SpecificAbsorptionRateSequence", "1", false)
+	tagDict[Tag{0x0018, 0x9240}] = newInfo(Tag{0x0018, 0x9240}, "US", "RFEchoTrainLength", "1", false)
+	tagDict[Tag{0x0018, 0x9241}] = newInfo(Tag{0x0018, 0x9241}, "US", "GradientEchoTrainLength", "1", false)
+	tagDict[Tag{0x0018, 0x9250}] = newInfo(Tag{0x0018, 0x9250}, "CS", "ArterialSpinLabelingContrast", "1", false)
+	tagDict[Tag{0x0018, 0x9251}] = newInfo(Tag{0x0018, 0x9251}, "SQ", "MRArterialSpinLabelingSequence", "1", false)
+	tagDict[Tag{0x0018, 0x9252}] = newInfo(Tag{0x0018, 0x9252}, "LO", "ASLTechniqueDescription", "1", false)
+	tagDict[Tag{0x0018, 0x9253}] = newInfo(Tag{0x0018, 0x9253}, "US", "ASLSlabNumber", "1", false)
+	tagDict[Tag{0x0018, 0x9254}] = newInfo(Tag{0x0018, 0x9254}, "FD", "ASLSlabThickness", "1", false)
+	tagDict[Tag{0x0018, 0x9255}] = newInfo(Tag{0x0018, 0x9255}, "FD", "ASLSlabOrientation", "3", false)
+	tagDict[Tag{0x0018, 0x9256}] = newInfo(Tag{0x0018, 0x9256}, "FD", "ASLMidSlabPosition", "3", false)
+	tagDict[Tag{0x0018, 0x9257}] = newInfo(Tag{0x0018, 0x9257}, "CS", "ASLContext", "1", false)
+	tagDict[Tag{0x0018, 0x9258}] = newInfo(Tag{0x0018, 0x9258}, "UL", "ASLPulseTrainDuration", "1", false)
+	tagDict[Tag{0x0018, 0x9259}] = newInfo(Tag{0x0018, 0x9259}, "CS", "ASLCrusherFlag", "1", false)
+	tagDict[Tag{0x0018, 0x925A}] = newInfo(Tag{0x0018, 0x925A}, "FD", "ASLCrusherFlow", "1", false)
+	tagDict[Tag{0x0018, 0x925B}] = newInfo(Tag{0x0018, 0x925B}, "LO", "ASLCrusherDescription", "1", false)
+	tagDict[Tag{0x0018, 0x925C}] = newInfo(Tag{0x0018, 0x925C}, "CS", "ASLBolusCutoffFlag", "1", false)
+	tagDict[Tag{0x0018, 0x925D}] = newInfo(Tag{0x0018, 0x925D}, "SQ", "ASLBolusCutoffTimingSequence", "1", false)
+	tagDict[Tag{0x0018, 0x925E}] = newInfo(Tag{0x0018, 0x925E}, "LO", "ASLBolusCutoffTechnique", "1", false)
+	tagDict[Tag{0x0018, 0x925F}] = newInfo(Tag{0x0018, 0x925F}, "UL", "ASLBolusCutoffDelayTime", "1", false)
+	tagDict[Tag{0x0018, 0x9260}] = newInfo(Tag{0x0018, 0x9260}, "SQ", "ASLSlabSequence", "1", false)
+	tagDict[Tag{0x0018, 0x9295}] = newInfo(Tag{0x0018, 0x9295}, "FD", "ChemicalShiftMinimumIntegrationLimitInppm", "1", false)
+	tagDict[Tag{0x0018, 0x9296}] = newInfo(Tag{0x0018, 0x9296}, "FD", "ChemicalShiftMaximumIntegrationLimitInppm", "1", false)
+	tagDict[Tag{0x0018, 0x9301}] = newInfo(Tag{0x0018, 0x9301}, "SQ", "CTAcquisitionTypeSequence", "1", false)
+	tagDict[Tag{0x0018, 0x9302}] = newInfo(Tag{0x0018, 0x9302}, "CS", "AcquisitionType", "1", false)
+	tagDict[Tag{0x0018, 0x9303}] = newInfo(Tag{0x0018, 0x9303}, "FD", "TubeAngle", "1", false)
+	tagDict[Tag{0x0018, 0x9304}] = newInfo(Tag{0x0018, 0x9304}, "SQ", "CTAcquisitionDetailsSequence", "1", false)
+	tagDict[Tag{0x0018, 0x9305}] = newInfo(Tag{0x0018, 0x9305}, "FD", "RevolutionTime", "1", false)
+	tagDict[Tag{0x0018, 0x9306}] = newInfo(Tag{0x0018, 0x9306}, "FD", "SingleCollimationWidth", "1", false)
+	tagDict[Tag{0x0018, 0x9307}] = newInfo(Tag{0x0018, 0x9307}, "FD", "TotalCollimationWidth", "1", false)
+	tagDict[Tag{0x0018, 0x9308}] = newInfo(Tag{0x0018, 0x9308}, "SQ", "CTTableDynamicsSequence", "1", false)
+	tagDict[Tag{0x0018, 0x9309}] = newInfo(Tag{0x0018, 0x9309}, "FD", "TableSpeed", "1", false)
+	tagDict[Tag{0x0018, 0x9310}] = newInfo(Tag{0x0018, 0x9310}, "FD", "TableFeedPerRotation", "1", false)
+	tagDict[Tag{0x0018, 0x9311}] = newInfo(Tag{0x0018, 0x9311}, "FD", "SpiralPitchFactor", "1", false)
+	tagDict[Tag{0x0018, 0x9312}] = newInfo(Tag{0x0018, 0x9312}, "SQ", "CTGeometrySequence", "1", false)
+	tagDict[Tag{0x0018, 0x9313}] = newInfo(Tag{0x0018, 0x9313}, "FD", "DataCollectionCenterPatient", "3", false)
+	tagDict[Tag{0x0018, 0x9314}] = newInfo(Tag{0x0018, 0x9314}, "SQ", "CTReconstructionSequence", "1", false)
+	tagDict[Tag{0x0018, 0x9315}] = newInfo(Tag{0x0018, 0x9315}, "CS", "ReconstructionAlgorithm", "1", false)
+	tagDict[Tag{0x0018, 0x9316}] = newInfo(Tag{0x0018, 0x9316}, "CS", "ConvolutionKernelGroup", "1", false)
+	tagDict[Tag{0x0018, 0x9317}] = newInfo(Tag{0x0018, 0x9317}, "FD", "ReconstructionFieldOfView", "2", false)
+	tagDict[Tag{0x0018, 0x9318}] = newInfo(Tag{0x0018, 0x9318}, "FD", "ReconstructionTargetCenterPatient", "3", false)
+	tagDict[Tag{0x0018, 0x9319}] = newInfo(Tag{0x0018, 0x9319}, "FD", "ReconstructionAngle", "1", false)
+	tagDict[Tag{0x0018, 0x9320}] = newInfo(Tag{0x0018, 0x9320}, "SH", "ImageFilter", "1", false)
+	tagDict[Tag{0x0018, 0x9321}] = newInfo(Tag{0x0018, 0x9321}, "SQ", "CTExposureSequence", "1", false)
+	tagDict[Tag{0x0018, 0x9322}] = newInfo(Tag{0x0018, 0x9322}, "FD", "ReconstructionPixelSpacing", "2", false)
+	tagDict[Tag{0x0018, 0x9323}] = newInfo(Tag{0x0018, 0x9323}, "CS", "ExposureModulationType", "1", false)
+	tagDict[Tag{0x0018, 0x9324}] = newInfo(Tag{0x0018, 0x9324}, "FD", "EstimatedDoseSaving", "1", false)
+	tagDict[Tag{0x0018, 0x9325}] = newInfo(Tag{0x0018, 0x9325}, "SQ", "CTXRayDetailsSequence", "1", false)
+	tagDict[Tag{0x0018, 0x9326}] = newInfo(Tag{0x0018, 0x9326}, "SQ", "CTPositionSequence", "1", false)
+	tagDict[Tag{0x0018, 0x9327}] = newInfo(Tag{0x0018, 0x9327}, "FD", "TablePosition", "1", false)
+	tagDict[Tag{0x0018, 0x9328}] = newInfo(Tag{0x0018, 0x9328}, "FD", "ExposureTimeInms", "1", false)
+	tagDict[Tag{0x0018, 0x9329}] = newInfo(Tag{0x0018, 0x9329}, "SQ", "CTImageFrameTypeSequence", "1", false)
+	tagDict[Tag{0x0018, 0x9330}] = newInfo(Tag{0x0018, 0x9330}, "FD", "XRayTubeCurrentInmA", "1", false)
+	tagDict[Tag{0x0018, 0x9332}] = newInfo(Tag{0x0018, 0x9332}, "FD", "ExposureInmAs", "1", false)
+	tagDict[Tag{0x0018, 0x9333}] = newInfo(Tag{0x0018, 0x9333}, "CS", "ConstantVolumeFlag", "1", false)
+	tagDict[Tag{0x0018, 0x9334}] = newInfo(Tag{0x0018, 0x9334}, "CS", "FluoroscopyFlag", "1", false)
+	tagDict[Tag{0x0018, 0x9335}] = newInfo(Tag{0x0018, 0x9335}, "FD", "DistanceSourceToDataCollectionCenter", "1", false)
+	tagDict[Tag{0x0018, 0x9337}] = newInfo(Tag{0x0018, 0x9337}, "US", "ContrastBolusAgentNumber", "1", false)
+	tagDict[Tag{0x0018, 0x9338}] = newInfo(Tag{0x0018, 0x9338}, "SQ", "ContrastBolusIngredientCodeSequence", "1", false)
+	tagDict[Tag{0x0018, 0x9340}] = newInfo(Tag{0x0018, 0x9340}, "SQ", "ContrastAdministrationProfileSequence", "1", false)
+	tagDict[Tag{0x0018, 0x9341}] = newInfo(Tag{0x0018, 0x9341}, "SQ", "ContrastBolusUsageSequence", "1", false)
+	tagDict[Tag{0x0018, 0x9342}] = newInfo(Tag{0x0018, 0x9342}, "CS", "ContrastBolusAgentAdministered", "1", false)
+	tagDict[Tag{0x0018, 0x9343}] = newInfo(Tag{0x0018, 0x9343}, "CS", "ContrastBolusAgentDetected", "1", false)
+	tagDict[Tag{0x0018, 0x9344}] = newInfo(Tag{0x0018, 0x9344}, "CS", "ContrastBolusAgentPhase", "1", false)
+	tagDict[Tag{0x0018, 0x9345}] = newInfo(Tag{0x0018, 0x9345}, "FD", "CTDIvol", "1", false)
+	tagDict[Tag{0x0018, 0x9346}] = newInfo(Tag{0x0018, 0x9346}, "SQ", "CTDIPhantomTypeCodeSequence", "1", false)
+	tagDict[Tag{0x0018, 0x9351}] = newInfo(Tag{0x0018, 0x9351}, "FL", "CalciumScoringMassFactorPatient", "1", false)
+	tagDict[Tag{0x0018, 0x9352}] = newInfo(Tag{0x0018, 0x9352}, "FL", "CalciumScoringMassFactorDevice", "3", false)
+	tagDict[Tag{0x0018, 0x9353}] = newInfo(Tag{0x0018, 0x9353}, "FL", "EnergyWeightingFactor", "1", false)
+	tagDict[Tag{0x0018, 0x9360}] = newInfo(Tag{0x0018, 0x9360}, "SQ", "CTAdditionalXRaySourceSequence", "1", false)
+	tagDict[Tag{0x0018, 0x9401}] = newInfo(Tag{0x0018, 0x9401}, "SQ", "ProjectionPixelCalibrationSequence", "1", false)
+	tagDict[Tag{0x0018, 0x9402}] = newInfo(Tag{0x0018, 0x9402}, "FL", "DistanceSourceToIsocenter", "1", false)
+	tagDict[Tag{0x0018, 0x9403}] = newInfo(Tag{0x0018, 0x9403}, "FL", "DistanceObjectToTableTop", "1", false)
+	tagDict[Tag{0x0018, 0x9404}] = newInfo(Tag{0x0018, 0x9404}, "FL", "ObjectPixelSpacingInCenterOfBeam", "2", false)
+	tagDict[Tag{0x0018, 0x9405}] = newInfo(Tag{0x0018, 0x9405}, "SQ", "PositionerPositionSequence", "1", false)
+	tagDict[Tag{0x0018, 0x9406}] = newInfo(Tag{0x0018, 0x9406}, "SQ", "TablePositionSequence", "1", false)
+	tagDict[Tag{0x0018, 0x9407}] = newInfo(Tag{0x0018, 0x9407}, "SQ", "CollimatorShapeSequence", "1", false)
+	tagDict[Tag{0x0018, 0x9410}] = newInfo(Tag{0x0018, 0x9410}, "CS", "PlanesInAcquisition", "1", false)
+	tagDict[Tag{0x0018, 0x9412}] = newInfo(Tag{0x0018, 0x9412}, "SQ", "XAXRFFrameCharacteristicsSequence", "1", false)
+	tagDict[Tag{0x0018, 0x9417}] = newInfo(Tag{0x0018, 0x9417}, "SQ", "FrameAcquisitionSequence", "1", false)
+	tagDict[Tag{0x0018, 0x9420}] = newInfo(Tag{0x0018, 0x9420}, "CS", "XRayReceptorType", "1", false)
+	tagDict[Tag{0x0018, 0x9423}] = newInfo(Tag{0x0018, 0x9423}, "LO", "AcquisitionProtocolName", "1", false)
+	tagDict[Tag{0x0018, 0x9424}] = newInfo(Tag{0x0018, 0x9424}, "LT", "AcquisitionProtocolDescription", "1", false)
+	tagDict[Tag{0x0018, 0x9425}] = newInfo(Tag{0x0018, 0x9425}, "CS", "ContrastBolusIngredientOpaque", "1", false)
+	tagDict[Tag{0x0018, 0x9426}] = newInfo(Tag{0x0018, 0x9426}, "FL", "DistanceReceptorPlaneToDetectorHousing", "1", false)
+	tagDict[Tag{0x0018, 0x9427}] = newInfo(Tag{0x0018, 0x9427}, "CS", "IntensifierActiveShape", "1", false)
+	tagDict[Tag{0x0018, 0x9428}] = newInfo(Tag{0x0018, 0x9428}, "FL", "IntensifierActiveDimensions", "1-2", false)
+	tagDict[Tag{0x0018, 0x9429}] = newInfo(Tag{0x0018, 0x9429}, "FL", "PhysicalDetectorSize", "2", false)
+	tagDict[Tag{0x0018, 0x9430}] = newInfo(Tag{0x0018, 0x9430}, "FL", "PositionOfIsocenterProjection", "2", false)
+	tagDict[Tag{0x0018, 0x9432}] = newInfo(Tag{0x0018, 0x9432}, "SQ", "FieldOfViewSequence", "1", false)
+	tagDict[Tag{0x0018, 0x9433}] = newInfo(Tag{0x0018, 0x9433}, "LO", "FieldOfViewDescription", "1", false)
+	tagDict[Tag{0x0018, 0x9434}] = newInfo(Tag{0x0018, 0x9434}, "SQ", "ExposureControlSensingRegionsSequence", "1", false)
+	tagDict[Tag{0x0018, 0x9435}] = newInfo(Tag{0x0018, 0x9435}, "CS", "ExposureControlSensingRegionShape", "1", false)
+	tagDict[Tag{0x0018, 0x9436}] = newInfo(Tag{0x0018, 0x9436}, "SS", "ExposureControlSensingRegionLeftVerticalEdge", "1", false)
+	tagDict[Tag{0x0018, 0x9437}] = newInfo(Tag{0x0018, 0x9437}, "SS", "ExposureControlSensingRegionRightVerticalEdge", "1", false)
+	tagDict[Tag{0x0018, 0x9438}] = newInfo(Tag{0x0018, 0x9438}, "SS", "ExposureControlSensingRegionUpperHorizontalEdge", "1", false)
+	tagDict[Tag{0x0018, 0x9439}] = newInfo(Tag{0x0018, 0x9439}, "SS", "ExposureControlSensingRegionLowerHorizontalEdge", "1", false)
+	tagDict[Tag{0x0018, 0x9440}] = newInfo(Tag{0x0018, 0x9440}, "SS", "CenterOfCircularExposureControlSensingRegion", "2", false)
+	tagDict[Tag{0x0018, 0x9441}] = newInfo(Tag{0x0018, 0x9441}, "US", "RadiusOfCircularExposureControlSensingRegion", "1", false)
+	tagDict[Tag{0x0018, 0x9442}] = newInfo(Tag{0x0018, 0x9442}, "SS", "VerticesOfThePolygonalExposureControlSensingRegion", "2-n", false)
+	tagDict[Tag{0x0018, 0x9447}] = newInfo(Tag{0x0018, 0x9447}, "FL", "ColumnAngulationPatient", "1", false)
+	tagDict[Tag{0x0018, 0x9449}] = newInfo(Tag{0x0018, 0x9449}, "FL", "BeamAngle", "1", false)
+	tagDict[Tag{0x0018, 0x9451}] = newInfo(Tag{0x0018, 0x9451}, "SQ", "FrameDetectorParametersSequence", "1", false)
+	tagDict[Tag{0x0018, 0x9452}] = newInfo(Tag{0x0018, 0x9452}, "FL", "CalculatedAnatomyThickness", "1", false)
+	tagDict[Tag{0x0018, 0x9455}] = newInfo(Tag{0x0018, 0x9455}, "SQ", "CalibrationSequence", "1", false)
+	tagDict[Tag{0x0018, 0x9456}] = newInfo(Tag{0x0018, 0x9456}, "SQ", "ObjectThicknessSequence", "1", false)
+	tagDict[Tag{0x0018, 0x9457}] = newInfo(Tag{0x0018, 0x9457}, "CS", "PlaneIdentification", "1", false)
+	tagDict[Tag{0x0018, 0x9461}] = newInfo(Tag{0x0018, 0x9461}, "FL", "FieldOfViewDimensionsInFloat", "1-2", false)
+	tagDict[Tag{0x0018, 0x9462}] = newInfo(Tag{0x0018, 0x9462}, "SQ", "IsocenterReferenceSystemSequence", "1", false)
+	tagDict[Tag{0x0018, 0x9463}] = newInfo(Tag{0x0018, 0x9463}, "FL", "PositionerIsocenterPrimaryAngle", "1", false)
+	tagDict[Tag{0x0018, 0x9464}] = newInfo(Tag{0x0018, 0x9464}, "FL", "PositionerIsocenterSecondaryAngle", "1", false)
+	tagDict[Tag{0x0018, 0x9465}] = newInfo(Tag{0x0018, 0x9465}, "FL", "PositionerIsocenterDetectorRotationAngle", "1", false)
+	tagDict[Tag{0x0018, 0x9466}] = newInfo(Tag{0x0018, 0x9466}, "FL", "TableXPositionToIsocenter", "1", false)
+	tagDict[Tag{0x0018, 0x9467}] = newInfo(Tag{0x0018, 0x9467}, "FL", "TableYPositionToIsocenter", "1", false)
+	tagDict[Tag{0x0018, 0x9468}] = newInfo(Tag{0x0018, 0x9468}, "FL", "TableZPositionToIsocenter", "1", false)
+	tagDict[Tag{0x0018, 0x9469}] = newInfo(Tag{0x0018, 0x9469}, "FL", "TableHorizontalRotationAngle", "1", false)
+	tagDict[Tag{0x0018, 0x9470}] = newInfo(Tag{0x0018, 0x9470}, "FL", "TableHeadTiltAngle", "1", false)
+	tagDict[Tag{0x0018, 0x9471}] = newInfo(Tag{0x0018, 0x9471}, "FL", "TableCradleTiltAngle", "1", false)
+	tagDict[Tag{0x0018, 0x9472}] = newInfo(Tag{0x0018, 0x9472}, "SQ", "FrameDisplayShutterSequence", "1", false)
+	tagDict[Tag{0x0018, 0x9473}] = newInfo(Tag{0x0018, 0x9473}, "FL", "AcquiredImageAreaDoseProduct", "1", false)
+	tagDict[Tag{0x0018, 0x9474}] = newInfo(Tag{0x0018, 0x9474}, "CS", "CArmPositionerTabletopRelationship", "1", false)
+	tagDict[Tag{0x0018, 0x9476}] = newInfo(Tag{0x0018, 0x9476}, "SQ", "XRayGeometrySequence", "1", false)
+	tagDict[Tag{0x0018, 0x9477}] = newInfo(Tag{0x0018, 0x9477}, "SQ", "IrradiationEventIdentificationSequence", "1", false)
+	tagDict[Tag{0x0018, 0x9504}] = newInfo(Tag{0x0018, 0x9504}, "SQ", "XRay3DFrameTypeSequence", "1", false)
+	tagDict[Tag{0x0018, 0x9506}] = newInfo(Tag{0x0018, 0x9506}, "SQ", "ContributingSourcesSequence", "1", false)
+	tagDict[Tag{0x0018, 0x9507}] = newInfo(Tag{0x0018, 0x9507}, "SQ", "XRay3DAcquisitionSequence", "1", false)
+	tagDict[Tag{0x0018, 0x9508}] = newInfo(Tag{0x0018, 0x9508}, "FL", "PrimaryPositionerScanArc", "1", false)
+	tagDict[Tag{0x0018, 0x9509}] = newInfo(Tag{0x0018, 0x9509}, "FL", "SecondaryPositionerScanArc", "1", false)
+	tagDict[Tag{0x0018, 0x9510}] = newInfo(Tag{0x0018, 0x9510}, "FL", "PrimaryPositionerScanStartAngle", "1", false)
+	tagDict[Tag{0x0018, 0x9511}] = newInfo(Tag{0x0018, 0x9511}, "FL", "SecondaryPositionerScanStartAngle", "1", false)
+	tagDict[Tag{0x0018, 0x9514}] = newInfo(Tag{0x0018, 0x9514}, "FL", "PrimaryPositionerIncrement", "1", false)
+	tagDict[Tag{0x0018, 0x9515}] = newInfo(Tag{0x0018, 0x9515}, "FL", "SecondaryPositionerIncrement", "1", false)
+	tagDict[Tag{0x0018, 0x9516}] = newInfo(Tag{0x0018, 0x9516}, "DT", "StartAcquisitionDateTime", "1", false)
+	tagDict[Tag{0x0018, 0x9517}] = newInfo(Tag{0x0018, 0x9517}, "DT", "EndAcquisitionDateTime", "1", false)
+	tagDict[Tag{0x0018, 0x9524}] = newInfo(Tag{0x0018, 0x9524}, "LO", "ApplicationName", "1", false)
+	tagDict[Tag{0x0018, 0x9525}] = newInfo(Tag{0x0018, 0x9525}, "LO", "ApplicationVersion", "1", false)
+	tagDict[Tag{0x0018, 0x9526}] = newInfo(Tag{0x0018, 0x9526}, "LO", "ApplicationManufacturer", "1", false)
+	tagDict[Tag{0x0018, 0x9527}] = newInfo(Tag{0x0018, 0x9527}, "CS", "AlgorithmType", "1", false)
+	tagDict[Tag{0x0018, 0x9528}] = newInfo(Tag{0x0018, 0x9528}, "LO", "AlgorithmDescription", "1", false)
+	tagDict[Tag{0x0018, 0x9530}] = newInfo(Tag{0x0018, 0x9530}, "SQ", "XRay3DReconstructionSequence", "1", false)
+	tagDict[Tag{0x0018, 0x9531}] = newInfo(Tag{0x0018, 0x9531}, "LO", "ReconstructionDescription", "1", false)
+	tagDict[Tag{0x0018, 0x9538}] = newInfo(Tag{0x0018, 0x9538}, "SQ", "PerProjectionAcquisitionSequence", "1", false)
+	tagDict[Tag{0x0018, 0x9601}] = newInfo(Tag{0x0018, 0x9601}, "SQ", "DiffusionBMatrixSequence", "1", false)
+	tagDict[Tag{0x0018, 0x9602}] = newInfo(Tag{0x0018, 0x9602}, "FD", "DiffusionBValueXX", "1", false)
+	tagDict[Tag{0x0018, 0x9603}] = newInfo(Tag{0x0018, 0x9603}, "FD", "DiffusionBValueXY", "1", false)
+	tagDict[Tag{0x0018, 0x9604}] = newInfo(Tag{0x0018, 0x9604}, "FD", "DiffusionBValueXZ", "1", false)
+	tagDict[Tag{0x0018, 0x9605}] = newInfo(Tag{0x0018, 0x9605}, "FD", "DiffusionBValueYY", "1", false)
+	tagDict[Tag{0x0018, 0x9606}] = newInfo(Tag{0x0018, 0x9606}, "FD", "DiffusionBValueYZ", "1", false)
+	tagDict[Tag{0x0018, 0x9607}] = newInfo(Tag{0x0018, 0x9607}, "FD", "DiffusionBValueZZ", "1", false)
+	tagDict[Tag{0x0018, 0x9701}] = newInfo(Tag{0x0018, 0x9701}, "DT", "DecayCorrectionDateTime", "1", false)
+	tagDict[Tag{0x0018, 0x9715}] = newInfo(Tag{0x0018, 0x9715}, "FD", "StartDensityThreshold", "1", false)
+	tagDict[Tag{0x0018, 0x9716}] = newInfo(Tag{0x0018, 0x9716}, "FD", "StartRelativeDensityDifferenceThreshold", "1", false)
+	tagDict[Tag{0x0018, 0x9717}] = newInfo(Tag{0x0018, 0x9717}, "FD", "StartCardiacTriggerCountThreshold", "1", false)
+	tagDict[Tag{0x0018, 0x9718}] = newInfo(Tag{0x0018, 0x9718}, "FD", "StartRespiratoryTriggerCountThreshold", "1", false)
+	tagDict[Tag{0x0018, 0x9719}] = newInfo(Tag{0x0018, 0x9719}, "FD", "TerminationCountsThreshold", "1", false)
+	tagDict[Tag{0x0018, 0x9720}] = newInfo(Tag{0x0018, 0x9720}, "FD", "TerminationDensityThreshold", "1", false)
+	tagDict[Tag{0x0018, 0x9721}] = newInfo(Tag{0x0018, 0x9721}, "FD", "TerminationRelativeDensityThreshold", "1", false)
+	tagDict[Tag{0x0018, 0x9722}] = newInfo(Tag{0x0018, 0x9722}, "FD", "TerminationTimeThreshold", "1", false)
+	tagDict[Tag{0x0018, 0x9723}] = newInfo(Tag{0x0018, 0x9723}, "FD", "TerminationCardiacTriggerCountThreshold", "1", false)
+	tagDict[Tag{0x0018, 0x9724}] = newInfo(Tag{0x0018, 0x9724}, "FD", "TerminationRespiratoryTriggerCountThreshold", "1", false)
+	tagDict[Tag{0x0018, 0x9725}] = newInfo(Tag{0x0018, 0x9725}, "CS", "DetectorGeometry", "1", false)
+	tagDict[Tag{0x0018, 0x9726}] = newInfo(Tag{0x0018, 0x9726}, "FD", "TransverseDetectorSeparation", "1", false)
+	tagDict[Tag{0x0018, 0x9727}] = newInfo(Tag{0x0018, 0x9727}, "FD", "AxialDetectorDimension", "1", false)
+	tagDict[Tag{0x0018, 0x9729}] = newInfo(Tag{0x0018, 0x9729}, "US", "RadiopharmaceuticalAgentNumber", "1", false)
+	tagDict[Tag{0x0018, 0x9732}] = newInfo(Tag{0x0018, 0x9732}, "SQ", "PETFrameAcquisitionSequence", "1", false)
+	tagDict[Tag{0x0018, 0x9733}] = newInfo(Tag{0x0018, 0x9733}, "SQ", "PETDetectorMotionDetailsSequence", "1", false)
+	tagDict[Tag{0x0018, 0x9734}] = newInfo(Tag{0x0018, 0x9734}, "SQ", "PETTableDynamicsSequence", "1", false)
+	tagDict[Tag{0x0018, 0x9735}] = newInfo(Tag{0x0018, 0x9735}, "SQ", "PETPositionSequence", "1", false)
+	tagDict[Tag{0x0018, 0x9736}] = newInfo(Tag{0x0018, 0x9736}, "SQ", "PETFrameCorrectionFactorsSequence", "1", false)
+	tagDict[Tag{0x0018, 0x9737}] = newInfo(Tag{0x0018, 0x9737}, "SQ", "RadiopharmaceuticalUsageSequence", "1", false)
+	tagDict[Tag{0x0018, 0x9738}] = newInfo(Tag{0x0018, 0x9738}, "CS", "AttenuationCorrectionSource", "1", false)
+	tagDict[Tag{0x0018, 0x9739}] = newInfo(Tag{0x0018, 0x9739}, "US", "NumberOfIterations", "1", false)
+	tagDict[Tag{0x0018, 0x9740}] = newInfo(Tag{0x0018, 0x9740}, "US", "NumberOfSubsets", "1", false)
+	tagDict[Tag{0x0018, 0x9749}] = newInfo(Tag{0x0018, 0x9749}, "SQ", "PETReconstructionSequence", "1", false)
+	tagDict[Tag{0x0018, 0x9751}] = newInfo(Tag{0x0018, 0x9751}, "SQ", "PETFrameTypeSequence", "1", false)
+	tagDict[Tag{0x0018, 0x9755}] = newInfo(Tag{0x0018, 0x9755}, "CS", "TimeOfFlightInformationUsed", "1", false)
+	tagDict[Tag{0x0018, 0x9756}] = newInfo(Tag{0x0018, 0x9756}, "CS", "ReconstructionType", "1", false)
+	tagDict[Tag{0x0018, 0x9758}] = newInfo(Tag{0x0018, 0x9758}, "CS", "DecayCorrected", "1", false)
+	tagDict[Tag{0x0018, 0x9759}] = newInfo(Tag{0x0018, 0x9759}, "CS", "AttenuationCorrected", "1", false)
+	tagDict[Tag{0x0018, 0x9760}] = newInfo(Tag{0x0018, 0x9760}, "CS", "ScatterCorrected", "1", false)
+	tagDict[Tag{0x0018, 0x9761}] = newInfo(Tag{0x0018, 0x9761}, "CS", "DeadTimeCorrected", "1", false)
+	tagDict[Tag{0x0018, 0x9762}] = newInfo(Tag{0x0018, 0x9762}, "CS", "GantryMotionCorrected", "1", false)
+	tagDict[Tag{0x0018, 0x9763}] = newInfo(Tag{0x0018, 0x9763}, "CS", "PatientMotionCorrected", "1", false)
+	tagDict[Tag{0x0018, 0x9764}] = newInfo(Tag{0x0018, 0x9764}, "CS", "CountLossNormalizationCorrected", "1", false)
+	tagDict[Tag{0x0018, 0x9765}] = newInfo(Tag{0x0018, 0x9765}, "CS", "RandomsCorrected", "1", false)
+	tagDict[Tag{0x0018, 0x9766}] = newInfo(Tag{0x0018, 0x9766}, "CS", "NonUniformRadialSamplingCorrected", "1", false)
+	tagDict[Tag{0x0018, 0x9767}] = newInfo(Tag{0x0018, 0x9767}, "CS", "SensitivityCalibrated", "1", false)
+	tagDict[Tag{0x0018, 0x9768}] = newInfo(Tag{0x0018, 0x9768}, "CS", "DetectorNormalizationCorrection", "1", false)
+	tagDict[Tag{0x0018, 0x9769}] = newInfo(Tag{0x0018, 0x9769}, "CS", "IterativeReconstructionMethod", "1", false)
+	tagDict[Tag{0x0018, 0x9770}] = newInfo(Tag{0x0018, 0x9770}, "CS", "AttenuationCorrectionTemporalRelationship", "1", false)
+	tagDict[Tag{0x0018, 0x9771}] = newInfo(Tag{0x0018, 0x9771}, "SQ", "PatientPhysiologicalStateSequence", "1", false)
+	tagDict[Tag{0x0018, 0x9772}] = newInfo(Tag{0x0018, 0x9772}, "SQ", "PatientPhysiologicalStateCodeSequence", "1", false)
+	tagDict[Tag{0x0018, 0x9801}] = newInfo(Tag{0x0018, 0x9801}, "FD", "DepthsOfFocus", "1-n", false)
+	tagDict[Tag{0x0018, 0x9803}] = newInfo(Tag{0x0018, 0x9803}, "SQ", "ExcludedIntervalsSequence", "1", false)
+	tagDict[Tag{0x0018, 0x9804}] = newInfo(Tag{0x0018, 0x9804}, "DT", "ExclusionStartDatetime", "1", false)
+	tagDict[Tag{0x0018, 0x9805}] = newInfo(Tag{0x0018, 0x9805}, "FD", "ExclusionDuration", "1", false)
+	tagDict[Tag{0x0018, 0x9806}] = newInfo(Tag{0x0018, 0x9806}, "SQ", "USImageDescriptionSequence", "1", false)
+	tagDict[Tag{0x0018, 0x9807}] = newInfo(Tag{0x0018, 0x9807}, "SQ", "ImageDataTypeSequence", "1", false)
+	tagDict[Tag{0x0018, 0x9808}] = newInfo(Tag{0x0018, 0x9808}, "CS", "DataType", "1", false)
+	tagDict[Tag{0x0018, 0x9809}] = newInfo(Tag{0x0018, 0x9809}, "SQ", "TransducerScanPatternCodeSequence", "1", false)
+	tagDict[Tag{0x0018, 0x980B}] = newInfo(Tag{0x0018, 0x980B}, "CS", "AliasedDataType", "1", false)
+	tagDict[Tag{0x0018, 0x980C}] = newInfo(Tag{0x0018, 0x980C}, "CS", "PositionMeasuringDeviceUsed", "1", false)
+	tagDict[Tag{0x0018, 0x980D}] = newInfo(Tag{0x0018, 0x980D}, "SQ", "TransducerGeometryCodeSequence", "1", false)
+	tagDict[Tag{0x0018, 0x980E}] = newInfo(Tag{0x0018, 0x980E}, "SQ", "TransducerBeamSteeringCodeSequence", "1", false)
+	tagDict[Tag{0x0018, 0x980F}] = newInfo(Tag{0x0018, 0x980F}, "SQ", "TransducerApplicationCodeSequence", "1", false)
+	tagDict[Tag{0x0018, 0xA001}] = newInfo(Tag{0x0018, 0xA001}, "SQ", "ContributingEquipmentSequence", "1", false)
+	tagDict[Tag{0x0018, 0xA002}] = newInfo(Tag{0x0018, 0xA002}, "DT", "ContributionDateTime", "1", false)
+	tagDict[Tag{0x0018, 0xA003}] = newInfo(Tag{0x0018, 0xA003}, "ST", "ContributionDescription", "1", false)
+	tagDict[Tag{0x0020, 0x000D}] = newInfo(Tag{0x0020, 0x000D}, "UI", "StudyInstanceUID", "1", false)
+	tagDict[Tag{0x0020, 0x000E}] = newInfo(Tag{0x0020, 0x000E}, "UI", "SeriesInstanceUID", "1", false)
+	tagDict[Tag{0x0020, 0x0010}] = newInfo(Tag{0x0020, 0x0010}, "SH", "StudyID", "1", false)
+	tagDict[Tag{0x0020, 0x0011}] = newInfo(Tag{0x0020, 0x0011}, "IS", "SeriesNumber", "1", false)
+	tagDict[Tag{0x0020, 0x0012}] = newInfo(Tag{0x0020, 0x0012}, "IS", "AcquisitionNumber", "1", false)
+	tagDict[Tag{0x0020, 0x0013}] = newInfo(Tag{0x0020, 0x0013}, "IS", "InstanceNumber", "1", false)
+	tagDict[Tag{0x0020, 0x0019}] = newInfo(Tag{0x0020, 0x0019}, "IS", "ItemNumber", "1", false)
+	tagDict[Tag{0x0020, 0x0020}] = newInfo(Tag{0x0020, 0x0020}, "CS", "PatientOrientation", "2", false)
+	tagDict[Tag{0x0020, 0x0032}] = newInfo(Tag{0x0020, 0x0032}, "DS", "ImagePositionPatient", "3", false)
+	tagDict[Tag{0x0020, 0x0037}] = newInfo(Tag{0x0020, 0x0037}, "DS", "ImageOrientationPatient", "6", false)
+	tagDict[Tag{0x0020, 0x0052}] = newInfo(Tag{0x0020, 0x0052}, "UI", "FrameOfReferenceUID", "1", false)
+	tagDict[Tag{0x0020, 0x0060}] = newInfo(Tag{0x0020, 0x0060}, "CS", "Laterality", "1", false)
+	tagDict[Tag{0x0020, 0x0062}] = newInfo(Tag{0x0020, 0x0062}, "CS", "ImageLaterality", "1", false)
+	tagDict[Tag{0x0020, 0x0100}] = newInfo(Tag{0x0020, 0x0100}, "IS", "TemporalPositionIdentifier", "1", false)
+	tagDict[Tag{0x0020, 0x0105}] = newInfo(Tag{0x0020, 0x0105}, "IS", "NumberOfTemporalPositions", "1", false)
+	tagDict[Tag{0x0020, 0x0110}] = newInfo(Tag{0x0020, 0x0110}, "DS", "TemporalResolution", "1", false)
+	tagDict[Tag{0x0020, 0x0200}] = newInfo(Tag{0x0020, 0x0200}, "UI", "SynchronizationFrameOfReferenceUID", "1", false)
+	tagDict[Tag{0x0020, 0x0242}] = newInfo(Tag{0x0020, 0x0242}, "UI", "SOPInstanceUIDOfConcatenationSource", "1", false)
+	tagDict[Tag{0x0020, 0x1002}] = newInfo(Tag{0x0020, 0x1002}, "IS", "ImagesInAcquisition", "1", false)
+	tagDict[Tag{0x0020, 0x1040}] = newInfo(Tag{0x0020, 0x1040}, "LO", "PositionReferenceIndicator", "1", false)
+	tagDict[Tag{0x0020, 0x1041}] = newInfo(Tag{0x0020, 0x1041}, "DS", "SliceLocation", "1", false)
+	tagDict[Tag{0x0020, 0x1200}] = newInfo(Tag{0x0020, 0x1200}, "IS", "NumberOfPatientRelatedStudies", "1", false)
+	tagDict[Tag{0x0020, 0x1202}] = newInfo(Tag{0x0020, 0x1202}, "IS", "NumberOfPatientRelatedSeries", "1", false)
+	tagDict[Tag{0x0020, 0x1204}] = newInfo(Tag{0x0020, 0x1204}, "IS", "NumberOfPatientRelatedInstances", "1", false)
+	tagDict[Tag{0x0020, 0x1206}] = newInfo(Tag{0x0020, 0x1206}, "IS", "NumberOfStudyRelatedSeries", "1", false)
+	tagDict[Tag{0x0020, 0x1208}] = newInfo(Tag{0x0020, 0x1208}, "IS", "NumberOfStudyRelatedInstances", "1", false)
+	tagDict[Tag{0x0020, 0x1209}] = newInfo(Tag{0x0020, 0x1209}, "IS", "NumberOfSeriesRelatedInstances", "1", false)
+	tagDict[Tag{0x0020, 0x4000}] = newInfo(Tag{0x0020, 0x4000}, "LT", "ImageComments", "1", false)
+	tagDict[Tag{0x0020, 0x9056}] = newInfo(Tag{0x0020, 0x9056}, "SH", "StackID", "1", false)
+	tagDict[Tag{0x0020, 0x9057}] = newInfo(Tag{0x0020, 0x9057}, "UL", "InStackPositionNumber", "1", false)
+	tagDict[Tag{0x0020, 0x9071}] = newInfo(Tag{0x0020, 0x9071}, "SQ", "FrameAnatomySequence", "1", false)
+	tagDict[Tag{0x0020, 0x9072}] = newInfo(Tag{0x0020, 0x9072}, "CS", "FrameLaterality", "1", false)
+	tagDict[Tag{0x0020, 0x9111}] = newInfo(Tag{0x0020, 0x9111}, "SQ", "FrameContentSequence", "1", false)
+	tagDict[Tag{0x0020, 0x9113}] = newInfo(Tag{0x0020, 0x9113}, "SQ", "PlanePositionSequence", "1", false)
+	tagDict[Tag{0x0020, 0x9116}] = newInfo(Tag{0x0020, 0x9116}, "SQ", "PlaneOrientationSequence", "1", false)
+	tagDict[Tag{0x0020, 0x9128}] = newInfo(Tag{0x0020, 0x9128}, "UL", "TemporalPositionIndex", "1", false)
+	tagDict[Tag{0x0020, 0x9153}] = newInfo(Tag{0x0020, 0x9153}, "FD", "NominalCardiacTriggerDelayTime", "1", false)
+	tagDict[Tag{0x0020, 0x9154}] = newInfo(Tag{0x0020, 0x9154}, "FL", "NominalCardiacTriggerTimePriorToRPeak", "1", false)
+	tagDict[Tag{0x0020, 0x9155}] = newInfo(Tag{0x0020, 0x9155}, "FL", "ActualCardiacTriggerTimePriorToRPeak", "1", false)
+	tagDict[Tag{0x0020, 0x9156}] = newInfo(Tag{0x0020, 0x9156}, "US", "FrameAcquisitionNumber", "1", false)
+	tagDict[Tag{0x0020, 0x9157}] = newInfo(Tag{0x0020, 0x9157}, "UL", "DimensionIndexValues", "1-n", false)
+	tagDict[Tag{0x0020, 0x9158}] = newInfo(Tag{0x0020, 0x9158}, "LT", "FrameComments", "1", false)
+	tagDict[Tag{0x0020, 0x9161}] = newInfo(Tag{0x0020, 0x9161}, "UI", "ConcatenationUID", "1", false)
+	tagDict[Tag{0x0020, 0x9162}] = newInfo(Tag{0x0020, 0x9162}, "US", "InConcatenationNumber", "1", false)
+	tagDict[Tag{0x0020, 0x9163}] = newInfo(Tag{0x0020, 0x9163}, "US", "InConcatenationTotalNumber", "1", false)
+	tagDict[Tag{0x0020, 0x9164}] = newInfo(Tag{0x0020, 0x9164}, "UI", "DimensionOrganizationUID", "1", false)
+	tagDict[Tag{0x0020, 0x9165}] = newInfo(Tag{0x0020, 0x9165}, "AT", "DimensionIndexPointer", "1", false)
+	tagDict[Tag{0x0020, 0x9167}] = newInfo(Tag{0x0020, 0x9167}, "AT", "FunctionalGroupPointer", "1", false)
+	tagDict[Tag{0x0020, 0x9213}] = newInfo(Tag{0x0020, 0x9213}, "LO", "DimensionIndexPrivateCreator", "1", false)
+	tagDict[Tag{0x0020, 0x9221}] = newInfo(Tag{0x0020, 0x9221}, "SQ", "DimensionOrganizationSequence", "1", false)
+	tagDict[Tag{0x0020, 0x9222}] = newInfo(Tag{0x0020, 0x9222}, "SQ", "DimensionIndexSequence", "1", false)
+	tagDict[Tag{0x0020, 0x9228}] = newInfo(Tag{0x0020, 0x9228}, "UL", "ConcatenationFrameOffsetNumber", "1", false)
+	tagDict[Tag{0x0020, 0x9238}] = newInfo(Tag{0x0020, 0x9238}, "LO", "FunctionalGroupPrivateCreator", "1", false)
+	tagDict[Tag{0x0020, 0x9241}] = newInfo(Tag{0x0020, 0x9241}, "FL", "NominalPercentageOfCardiacPhase", "1", false)
+	tagDict[Tag{0x0020, 0x9245}] = newInfo(Tag{0x0020, 0x9245}, "FL", "NominalPercentageOfRespiratoryPhase", "1", false)
+	tagDict[Tag{0x0020, 0x9246}] = newInfo(Tag{0x0020, 0x9246}, "FL", "StartingRespiratoryAmplitude", "1", false)
+	tagDict[Tag{0x0020, 0x9247}] = newInfo(Tag{0x0020, 0x9247}, "CS", "StartingRespiratoryPhase", "1", false)
+	tagDict[Tag{0x0020, 0x9248}] = newInfo(Tag{0x0020, 0x9248}, "FL", "EndingRespiratoryAmplitude", "1", false)
+	tagDict[Tag{0x0020, 0x9249}] = newInfo(Tag{0x0020, 0x9249}, "CS", "EndingRespiratoryPhase", "1", false)
+	tagDict[Tag{0x0020, 0x9250}] = newInfo(Tag{0x0020, 0x9250}, "CS", "RespiratoryTriggerType", "1", false)
+	tagDict[Tag{0x0020, 0x9251}] = newInfo(Tag{0x0020, 0x9251}, "FD", "RRIntervalTimeNominal", "1", false)
+	tagDict[Tag{0x0020, 0x9252}] = newInfo(Tag{0x0020, 0x9252}, "FD", "ActualCardiacTriggerDelayTime", "1", false)
+	tagDict[Tag{0x0020, 0x9253}] = newInfo(Tag{0x0020, 0x9253}, "SQ", "RespiratorySynchronizationSequence", "1", false)
+	tagDict[Tag{0x0020, 0x9254}] = newInfo(Tag{0x0020, 0x9254}, "FD", "RespiratoryIntervalTime", "1", false)
+	tagDict[Tag{0x0020, 0x9255}] = newInfo(Tag{0x0020, 0x9255}, "FD", "NominalRespiratoryTriggerDelayTime", "1", false)
+	tagDict[Tag{0x0020, 0x9256}] = newInfo(Tag{0x0020, 0x9256}, "FD", "RespiratoryTriggerDelayThreshold", "1", false)
+	tagDict[Tag{0x0020, 0x9257}] = newInfo(Tag{0x0020, 0x9257}, "FD", "ActualRespiratoryTriggerDelayTime", "1", false)
+	tagDict[Tag{0x0020, 0x9301}] = newInfo(Tag{0x0020, 0x9301}, "FD", "ImagePositionVolume", "3", false)
+	tagDict[Tag{0x0020, 0x9302}] = newInfo(Tag{0x0020, 0x9302}, "FD", "ImageOrientationVolume", "6", false)
+	tagDict[Tag{0x0020, 0x9307}] = newInfo(Tag{0x0020, 0x9307}, "CS", "UltrasoundAcquisitionGeometry", "1", false)
+	tagDict[Tag{0x0020, 0x9308}] = newInfo(Tag{0x0020, 0x9308}, "FD", "ApexPosition", "3", false)
+	tagDict[Tag{0x0020, 0x9309}] = newInfo(Tag{0x0020, 0x9309}, "FD", "VolumeToTransducerMappingMatrix", "16", false)
+	tagDict[Tag{0x0020, 0x930A}] = newInfo(Tag{0x0020, 0x930A}, "FD", "VolumeToTableMappingMatrix", "16", false)
+	tagDict[Tag{0x0020, 0x930C}] = newInfo(Tag{0x0020, 0x930C}, "CS", "PatientFrameOfReferenceSource", "1", false)
+	tagDict[Tag{0x0020, 0x930D}] = newInfo(Tag{0x0020, 0x930D}, "FD", "TemporalPositionTimeOffset", "1", false)
+	tagDict[Tag{0x0020, 0x930E}] = newInfo(Tag{0x0020, 0x930E}, "SQ", "PlanePositionVolumeSequence", "1", false)
+	tagDict[Tag{0x0020, 0x930F}] = newInfo(Tag{0x0020, 0x930F}, "SQ", "PlaneOrientationVolumeSequence", "1", false)
+	tagDict[Tag{0x0020, 0x9310}] = newInfo(Tag{0x0020, 0x9310}, "SQ", "TemporalPositionSequence", "1", false)
+	tagDict[Tag{0x0020, 0x9311}] = newInfo(Tag{0x0020, 0x9311}, "CS", "DimensionOrganizationType", "1", false)
+	tagDict[Tag{0x0020, 0x9312}] = newInfo(Tag{0x0020, 0x9312}, "UI", "VolumeFrameOfReferenceUID", "1", false)
+	tagDict[Tag{0x0020, 0x9313}] = newInfo(Tag{0x0020, 0x9313}, "UI", "TableFrameOfReferenceUID", "1", false)
+	tagDict[Tag{0x0020, 0x9421}] = newInfo(Tag{0x0020, 0x9421}, "LO", "DimensionDescriptionLabel", "1", false)
+	tagDict[Tag{0x0020, 0x9450}] = newInfo(Tag{0x0020, 0x9450}, "SQ", "PatientOrientationInFrameSequence", "1", false)
+	tagDict[Tag{0x0020, 0x9453}] = newInfo(Tag{0x0020, 0x9453}, "LO", "FrameLabel", "1", false)
+	tagDict[Tag{0x0020, 0x9518}] = newInfo(Tag{0x0020, 0x9518}, "US", "AcquisitionIndex", "1-n", false)
+	tagDict[Tag{0x0020, 0x9529}] = newInfo(Tag{0x0020, 0x9529}, "SQ", "ContributingSOPInstancesReferenceSequence", "1", false)
+	tagDict[Tag{0x0020, 0x9536}] = newInfo(Tag{0x0020, 0x9536}, "US", "ReconstructionIndex", "1", false)
+	tagDict[Tag{0x0022, 0x0001}] = newInfo(Tag{0x0022, 0x0001}, "US", "LightPathFilterPassThroughWavelength", "1", false)
+	tagDict[Tag{0x0022, 0x0002}] = newInfo(Tag{0x0022, 0x0002}, "US", "LightPathFilterPassBand", "2", false)
+	tagDict[Tag{0x0022, 0x0003}] = newInfo(Tag{0x0022, 0x0003}, "US", "ImagePathFilterPassThroughWavelength", "1", false)
+	tagDict[Tag{0x0022, 0x0004}] = newInfo(Tag{0x0022, 0x0004}, "US", "ImagePathFilterPassBand", "2", false)
+	tagDict[Tag{0x0022, 0x0005}] = newInfo(Tag{0x0022, 0x0005}, "CS", "PatientEyeMovementCommanded", "1", false)
+	tagDict[Tag{0x0022, 0x0006}] = newInfo(Tag{0x0022, 0x0006}, "SQ", "PatientEyeMovementCommandCodeSequence", "1", false)
+	tagDict[Tag{0x0022, 0x0007}] = newInfo(Tag{0x0022, 0x0007}, "FL", "SphericalLensPower", "1", false)
+	tagDict[Tag{0x0022, 0x0008}] = newInfo(Tag{0x0022, 0x0008}, "FL", "CylinderLensPower", "1", false)
+	tagDict[Tag{0x0022, 0x0009}] = newInfo(Tag{0x0022, 0x0009}, "FL", "CylinderAxis", "1", false)
+	tagDict[Tag{0x0022, 0x000A}] = newInfo(Tag{0x0022, 0x000A}, "FL", "EmmetropicMagnification", "1", false)
+	tagDict[Tag{0x0022, 0x000B}] = newInfo(Tag{0x0022, 0x000B}, "FL", "IntraOcularPressure", "1", false)
+	tagDict[Tag{0x0022, 0x000C}] = newInfo(Tag{0x0022, 0x000C}, "FL", "HorizontalFieldOfView", "1", false)
+	tagDict[Tag{0x0022, 0x000D}] = newInfo(Tag{0x0022, 0x000D}, "CS", "PupilDilated", "1", false)
+	tagDict[Tag{0x0022, 0x000E}] = newInfo(Tag{0x0022, 0x000E}, "FL", "DegreeOfDilation", "1", false)
+	tagDict[Tag{0x0022, 0x0010}] = newInfo(Tag{0x0022, 0x0010}, "FL", "StereoBaselineAngle", "1", false)
+	tagDict[Tag{0x0022, 0x0011}] = newInfo(Tag{0x0022, 0x0011}, "FL", "StereoBaselineDisplacement", "1", false)
+	tagDict[Tag{0x0022, 0x0012}] = newInfo(Tag{0x0022, 0x0012}, "FL", "StereoHorizontalPixelOffset", "1", false)
+	tagDict[Tag{0x0022, 0x0013}] = newInfo(Tag{0x0022, 0x0013}, "FL", "StereoVerticalPixelOffset", "1", false)
+	tagDict[Tag{0x0022, 0x0014}] = newInfo(Tag{0x0022, 0x0014}, "FL", "StereoRotation", "1", false)
+	tagDict[Tag{0x0022, 0x0015}] = newInfo(Tag{0x0022, 0x0015}, "SQ", "AcquisitionDeviceTypeCodeSequence", "1", false)
+	tagDict[Tag{0x0022, 0x0016}] = newInfo(Tag{0x0022, 0x0016}, "SQ", "IlluminationTypeCodeSequence", "1", false)
+	tagDict[Tag{0x0022, 0x0017}] = newInfo(Tag{0x0022, 0x0017}, "SQ", "LightPathFilterTypeStackCodeSequence", "1", false)
+	tagDict[Tag{0x0022, 0x0018}] = newInfo(Tag{0x0022, 0x0018}, "SQ", "ImagePathFilterTypeStackCodeSequence", "1", false)
+	tagDict[Tag{0x0022, 0x0019}] = newInfo(Tag{0x0022, 0x0019}, "SQ", "LensesCodeSequence", "1", false)
+	tagDict[Tag{0x0022, 0x001A}] = newInfo(Tag{0x0022, 0x001A}, "SQ", "ChannelDescriptionCodeSequence", "1", false)
+	tagDict[Tag{0x0022, 0x001B}] = newInfo(Tag{0x0022, 0x001B}, "SQ", "RefractiveStateSequence", "1", false)
+	tagDict[Tag{0x0022, 0x001C}] = newInfo(Tag{0x0022, 0x001C}, "SQ", "MydriaticAgentCodeSequence", "1", false)
+	tagDict[Tag{0x0022, 0x001D}] = newInfo(Tag{0x0022, 0x001D}, "SQ", "RelativeImagePositionCodeSequence", "1", false)
+	tagDict[Tag{0x0022, 0x001E}] = newInfo(Tag{0x0022, 0x001E}, "FL", "CameraAngleOfView", "1", false)
+	tagDict[Tag{0x0022, 0x0020}] = newInfo(Tag{0x0022, 0x0020}, "SQ", "StereoPairsSequence", "1", false)
+	tagDict[Tag{0x0022, 0x0021}] = newInfo(Tag{0x0022, 0x0021}, "SQ", "LeftImageSequence", "1", false)
+	tagDict[Tag{0x0022, 0x0022}] = newInfo(Tag{0x0022, 0x0022}, "SQ", "RightImageSequence", "1", false)
+	tagDict[Tag{0x0022, 0x0030}] = newInfo(Tag{0x0022, 0x0030}, "FL", "AxialLengthOfTheEye", "1", false)
+	tagDict[Tag{0x0022, 0x0031}] = newInfo(Tag{0x0022, 0x0031}, "SQ", "OphthalmicFrameLocationSequence", "1", false)
+	tagDict[Tag{0x0022, 0x0032}] = newInfo(Tag{0x0022, 0x0032}, "FL", "ReferenceCoordinates", "2-2n", false)
+	tagDict[Tag{0x0022, 0x0035}] = newInfo(Tag{0x0022, 0x0035}, "FL", "DepthSpatialResolution", "1", false)
+	tagDict[Tag{0x0022, 0x0036}] = newInfo(Tag{0x0022, 0x0036}, "FL", "MaximumDepthDistortion", "1", false)
+	tagDict[Tag{0x0022, 0x0037}] = newInfo(Tag{0x0022, 0x0037}, "FL", "AlongScanSpatialResolution", "1", false)
+	tagDict[Tag{0x0022, 0x0038}] = newInfo(Tag{0x0022, 0x0038}, "FL", "MaximumAlongScanDistortion", "1", false)
+	tagDict[Tag{0x0022, 0x0039}] = newInfo(Tag{0x0022, 0x0039}, "CS", "OphthalmicImageOrientation", "1", false)
+	tagDict[Tag{0x0022, 0x0041}] = newInfo(Tag{0x0022, 0x0041}, "FL", "DepthOfTransverseImage", "1", false)
+	tagDict[Tag{0x0022, 0x0042}] = newInfo(Tag{0x0022, 0x0042}, "SQ", "MydriaticAgentConcentrationUnitsSequence", "1", false)
+	tagDict[Tag{0x0022, 0x0048}] = newInfo(Tag{0x0022, 0x0048}, "FL", "AcrossScanSpatialResolution", "1", false)
+	tagDict[Tag{0x0022, 0x0049}] = newInfo(Tag{0x0022, 0x0049}, "FL", "MaximumAcrossScanDistortion", "1", false)
+	tagDict[Tag{0x0022, 0x004E}] = newInfo(Tag{0x0022, 0x004E}, "DS", "MydriaticAgentConcentration", "1", false)
+	tagDict[Tag{0x0022, 0x0055}] = newInfo(Tag{0x0022, 0x0055}, "FL", "IlluminationWaveLength", "1", false)
+	tagDict[Tag{0x0022, 0x0056}] = newInfo(Tag{0x0022, 0x0056}, "FL", "IlluminationPower", "1", false)
+	tagDict[Tag{0x0022, 0x0057}] = newInfo(Tag{0x0022, 0x0057}, "FL", "IlluminationBandwidth", "1", false)
+	tagDict[Tag{0x0022, 0x0058}] = newInfo(Tag{0x0022, 0x0058}, "SQ", "MydriaticAgentSequence", "1", false)
+	tagDict[Tag{0x0022, 0x1007}] = newInfo(Tag{0x0022, 0x1007}, "SQ", "OphthalmicAxialMeasurementsRightEyeSequence", "1", false)
+	tagDict[Tag{0x0022, 0x1008}] = newInfo(Tag{0x0022, 0x1008}, "SQ", "OphthalmicAxialMeasurementsLeftEyeSequence", "1", false)
+	tagDict[Tag{0x0022, 0x1009}] = newInfo(Tag{0x0022, 0x1009}, "CS", "OphthalmicAxialMeasurementsDeviceType", "1", false)
+	tagDict[Tag{0x0022, 0x1010}] = newInfo(Tag{0x0022, 0x1010}, "CS", "OphthalmicAxialLengthMeasurementsType", "1", false)
+	tagDict[Tag{0x0022, 0x1012}] = newInfo(Tag{0x0022, 0x1012}, "SQ", "OphthalmicAxialLengthSequence", "1", false)
+	tagDict[Tag{0x0022, 0x1019}] = newInfo(Tag{0x0022, 0x1019}, "FL", "OphthalmicAxialLength", "1", false)
+	tagDict[Tag{0x0022, 0x1024}] = newInfo(Tag{0x0022, 0x1024}, "SQ", "LensStatusCodeSequence", "1", false)
+	tagDict[Tag{0x0022, 0x1025}] = newInfo(Tag{0x0022, 0x1025}, "SQ", "VitreousStatusCodeSequence", "1", false)
+	tagDict[Tag{0x0022, 0x1028}] = newInfo(Tag{0x0022, 0x1028}, "SQ", "IOLFormulaCodeSequence", "1", false)
+	tagDict[Tag{0x0022, 0x1029}] = newInfo(Tag{0x0022, 0x1029}, "LO", "IOLFormulaDetail", "1", false)
+	tagDict[Tag{0x0022, 0x1033}] = newInfo(Tag{0x0022, 0x1033}, "FL", "KeratometerIndex", "1", false)
+	tagDict[Tag{0x0022, 0x1035}] = newInfo(Tag{0x0022, 0x1035}, "SQ", "SourceOfOphthalmicAxialLengthCodeSequence", "1", false)
+	tagDict[Tag{0x0022, 0x1037}] = newInfo(Tag{0x0022, 0x1037}, "FL", "TargetRefraction", "1", false)
+	tagDict[Tag{0x0022, 0x1039}] = newInfo(Tag{0x0022, 0x1039}, "CS", "RefractiveProcedureOccurred", "1", false)
+	tagDict[Tag{0x0022, 0x1040}] = newInfo(Tag{0x0022, 0x1040}, "SQ", "RefractiveSurgeryTypeCodeSequence", "1", false)
+	tagDict[Tag{0x0022, 0x1044}] = newInfo(Tag{0x0022, 0x1044}, "SQ", "OphthalmicUltrasoundMethodCodeSequence", "1", false)
+	tagDict[Tag{0x0022, 0x1050}] = newInfo(Tag{0x0022, 0x1050}, "SQ", "OphthalmicAxialLengthMeasurementsSequence", "1", false)
+	tagDict[Tag{0x0022, 0x1053}] = newInfo(Tag{0x0022, 0x1053}, "FL", "IOLPower", "1", false)
+	tagDict[Tag{0x0022, 0x1054}] = newInfo(Tag{0x0022, 0x1054}, "FL", "PredictedRefractiveError", "1", false)
+	tagDict[Tag{0x0022, 0x1059}] = newInfo(Tag{0x0022, 0x1059}, "FL", "OphthalmicAxialLengthVelocity", "1", false)
+	tagDict[Tag{0x0022, 0x1065}] = newInfo(Tag{0x0022, 0x1065}, "LO", "LensStatusDescription", "1", false)
+	tagDict[Tag{0x0022, 0x1066}] = newInfo(Tag{0x0022, 0x1066}, "LO", "VitreousStatusDescription", "1", false)
+	tagDict[Tag{0x0022, 0x1090}] = newInfo(Tag{0x0022, 0x1090}, "SQ", "IOLPowerSequence", "1", false)
+	tagDict[Tag{0x0022, 0x1092}] = newInfo(Tag{0x0022, 0x1092}, "SQ", "LensConstantSequence", "1", false)
+	tagDict[Tag{0x0022, 0x1093}] = newInfo(Tag{0x0022, 0x1093}, "LO", "IOLManufacturer", "1", false)
+	tagDict[Tag{0x0022, 0x1094}] = newInfo(Tag{0x0022, 0x1094}, "LO", "LensConstantDescription", "1", false)
+	tagDict[Tag{0x0022, 0x1095}] = newInfo(Tag{0x0022, 0x1095}, "LO", "ImplantName", "1", false)
+	tagDict[Tag{0x0022, 0x1096}] = newInfo(Tag{0x0022, 0x1096}, "SQ", "KeratometryMeasurementTypeCodeSequence", "1", false)
+	tagDict[Tag{0x0022, 0x1097}] = newInfo(Tag{0x0022, 0x1097}, "LO", "ImplantPartNumber", "1", false)
+	tagDict[Tag{0x0022, 0x1100}] = newInfo(Tag{0x0022, 0x1100}, "SQ", "ReferencedOphthalmicAxialMeasurementsSequence", "1", false)
+	tagDict[Tag{0x0022, 0x1101}] = newInfo(Tag{0x0022, 0x1101}, "SQ", "OphthalmicAxialLengthMeasurementsSegmentNameCodeSequence", "1", false)
+	tagDict[Tag{0x0022, 0x1103}] = newInfo(Tag{0x0022, 0x1103}, "SQ", "RefractiveErrorBeforeRefractiveSurgeryCodeSequence", "1", false)
+	tagDict[Tag{0x0022, 0x1121}] = newInfo(Tag{0x0022, 0x1121}, "FL", "IOLPowerForExactEmmetropia", "1", false)
+	tagDict[Tag{0x0022, 0x1122}] = newInfo(Tag{0x0022, 0x1122}, "FL", "IOLPowerForExactTargetRefraction", "1", false)
+	tagDict[Tag{0x0022, 0x1125}] = newInfo(Tag{0x0022, 0x1125}, "SQ", "AnteriorChamberDepthDefinitionCodeSequence", "1", false)
+	tagDict[Tag{0x0022, 0x1127}] = newInfo(Tag{0x0022, 0x1127}, "SQ", "LensThicknessSequence", "1", false)
+	tagDict[Tag{0x0022, 0x1128}] = newInfo(Tag{0x0022, 0x1128}, "SQ", "AnteriorChamberDepthSequence", "1", false)
+	tagDict[Tag{0x0022, 0x1130}] = newInfo(Tag{0x0022, 0x1130}, "FL", "LensThickness", "1", false)
+	tagDict[Tag{0x0022, 0x1131}] = newInfo(Tag{0x0022, 0x1131}, "FL", "AnteriorChamberDepth", "1", false)
+	tagDict[Tag{0x0022, 0x1132}] = newInfo(Tag{0x0022, 0x1132}, "SQ", "SourceOfLensThicknessDataCodeSequence", "1", false)
+	tagDict[Tag{0x0022, 0x1133}] = newInfo(Tag{0x0022, 0x1133}, "SQ", "SourceOfAnteriorChamberDepthDataCodeSequence", "1", false)
+	tagDict[Tag{0x0022, 0x1134}] = newInfo(Tag{0x0022, 0x1134}, "SQ", "SourceOfRefractiveMeasurementsSequence", "1", false)
+	tagDict[Tag{0x0022, 0x1135}] = newInfo(Tag{0x0022, 0x1135}, "SQ", "SourceOfRefractiveMeasurementsCodeSequence", "1", false)
+	tagDict[Tag{0x0022, 0x1140}] = newInfo(Tag{0x0022, 0x1140}, "CS", "OphthalmicAxialLengthMeasurementModified", "1", false)
+	tagDict[Tag{0x0022, 0x1150}] = newInfo(Tag{0x0022, 0x1150}, "SQ", "OphthalmicAxialLengthDataSourceCodeSequence", "1", false)
+	tagDict[Tag{0x0022, 0x1153}] = newInfo(Tag{0x0022, 0x1153}, "SQ", "OphthalmicAxialLengthAcquisitionMethodCodeSequence", "1", false)
+	tagDict[Tag{0x0022, 0x1155}] = newInfo(Tag{0x0022, 0x1155}, "FL", "SignalToNoiseRatio", "1", false)
+	tagDict[Tag{0x0022, 0x1159}] = newInfo(Tag{0x0022, 0x1159}, "LO", "OphthalmicAxialLengthDataSourceDescription", "1", false)
+	tagDict[Tag{0x0022, 0x1210}] = newInfo(Tag{0x0022, 0x1210}, "SQ", "OphthalmicAxialLengthMeasurementsTotalLengthSequence", "1", false)
+	tagDict[Tag{0x0022, 0x1211}] = newInfo(Tag{0x0022, 0x1211}, "SQ", "OphthalmicAxialLengthMeasurementsSegmentalLengthSequence", "1", false)
+	tagDict[Tag{0x0022, 0x1212}] = newInfo(Tag{0x0022, 0x1212}, "SQ", "OphthalmicAxialLengthMeasurementsLengthSummationSequence", "1", false)
+	tagDict[Tag{0x0022, 0x1220}] = newInfo(Tag{0x0022, 0x1220}, "SQ", "UltrasoundOphthalmicAxialLengthMeasurementsSequence", "1", false)
+	tagDict[Tag{0x0022, 0x1225}] = newInfo(Tag{0x0022, 0x1225}, "SQ", "OpticalOphthalmicAxialLengthMeasurementsSequence", "1", false)
+	tagDict[Tag{0x0022, 0x1230}] = newInfo(Tag{0x0022, 0x1230}, "SQ", "UltrasoundSelectedOphthalmicAxialLengthSequence", "1", false)
+	tagDict[Tag{0x0022, 0x1250}] = newInfo(Tag{0x0022, 0x1250}, "SQ", "OphthalmicAxialLengthSelectionMethodCodeSequence", "1", false)
+	tagDict[Tag{0x0022, 0x1255}] = newInfo(Tag{0x0022, 0x1255}, "SQ", "OpticalSelectedOphthalmicAxialLengthSequence", "1", false)
+	tagDict[Tag{0x0022, 0x1257}] = newInfo(Tag{0x0022, 0x1257}, "SQ", "SelectedSegmentalOphthalmicAxialLengthSequence", "1", false)
+	tagDict[Tag{0x0022, 0x1260}] = newInfo(Tag{0x0022, 0x1260}, "SQ", "SelectedTotalOphthalmicAxialLengthSequence", "1", false)
+	tagDict[Tag{0x0022, 0x1262}] = newInfo(Tag{0x0022, 0x1262}, "SQ", "OphthalmicAxialLengthQualityMetricSequence", "1", false)
+	tagDict[Tag{0x0022, 0x1265}] = newInfo(Tag{0x0022, 0x1265}, "SQ", "OphthalmicAxialLengthQualityMetricTypeCodeSequence", "1", false)
+	tagDict[Tag{0x0022, 0x1273}] = newInfo(Tag{0x0022, 0x1273}, "LO", "OphthalmicAxialLengthQualityMetricTypeDescription", "1", false)
+	tagDict[Tag{0x0022, 0x1300}] = newInfo(Tag{0x0022, 0x1300}, "SQ", "IntraocularLensCalculationsRightEyeSequence", "1", false)
+	tagDict[Tag{0x0022, 0x1310}] = newInfo(Tag{0x0022, 0x1310}, "SQ", "IntraocularLensCalculationsLeftEyeSequence", "1", false)
+	tagDict[Tag{0x0022, 0x1330}] = newInfo(Tag{0x0022, 0x1330}, "SQ", "ReferencedOphthalmicAxialLengthMeasurementQCImageSequence", "1", false)
+	tagDict[Tag{0x0024, 0x0010}] = newInfo(Tag{0x0024, 0x0010}, "FL", "VisualFieldHorizontalExtent", "1", false)
+	tagDict[Tag{0x0024, 0x0011}] = newInfo(Tag{0x0024, 0x0011}, "FL", "VisualFieldVerticalExtent", "1", false)
+	tagDict[Tag{0x0024, 0x0012}] = newInfo(Tag{0x0024, 0x0012}, "CS", "VisualFieldShape", "1", false)
+	tagDict[Tag{0x0024, 0x0016}] = newInfo(Tag{0x0024, 0x0016}, "SQ", "ScreeningTestModeCodeSequence", "1", false)
+	tagDict[Tag{0x0024, 0x0018}] = newInfo(Tag{0x0024, 0x0018}, "FL", "MaximumStimulusLuminance", "1", false)
+	tagDict[Tag{0x0024, 0x0020}] = newInfo(Tag{0x0024, 0x0020}, "FL", "BackgroundLuminance", "1", false)
+	tagDict[Tag{0x0024, 0x0021}] = newInfo(Tag{0x0024, 0x0021}, "SQ", "StimulusColorCodeSequence", "1", false)
+	tagDict[Tag{0x0024, 0x0024}] = newInfo(Tag{0x0024, 0x0024}, "SQ", "BackgroundIlluminationColorCodeSequence", "1", false)
+	tagDict[Tag{0x0024, 0x0025}] = newInfo(Tag{0x0024, 0x0025}, "FL", "StimulusArea", "1", false)
+	tagDict[Tag{0x0024, 0x0028}] = newInfo(Tag{0x0024, 0x0028}, "FL", "StimulusPresentationTime", "1", false)
+	tagDict[Tag{0x0024, 0x0032}] = newInfo(Tag{0x0024, 0x0032}, "SQ", "FixationSequence", "1", false)
+	tagDict[Tag{0x0024, 0x0033}] = newInfo(Tag{0x0024, 0x0033}, "SQ", "FixationMonitoringCodeSequence", "1", false)
+	tagDict[Tag{0x0024, 0x0034}] = newInfo(Tag{0x0024, 0x0034}, "SQ", "VisualFieldCatchTrialSequence", "1", false)
+	tagDict[Tag{0x0024, 0x0035}] = newInfo(Tag{0x0024, 0x0035}, "US", "FixationCheckedQuantity", "1", false)
+	tagDict[Tag{0x0024, 0x0036}] = newInfo(Tag{0x0024, 0x0036}, "US", "PatientNotProperlyFixatedQuantity", "1", false)
+	tagDict[Tag{0x0024, 0x0037}] = newInfo(Tag{0x0024, 0x0037}, "CS", "PresentedVisualStimuliDataFlag", "1", false)
+	tagDict[Tag{0x0024, 0x0038}] = newInfo(Tag{0x0024, 0x0038}, "US", "NumberOfVisualStimuli", "1", false)
+	tagDict[Tag{0x0024, 0x0039}] = newInfo(Tag{0x0024, 0x0039}, "CS", "ExcessiveFixationLossesDataFlag", "1", false)
+	tagDict[Tag{0x0024, 0x0040}] = newInfo(Tag{0x0024, 0x0040}, "CS", "ExcessiveFixationLosses", "1", false)
+	tagDict[Tag{0x0024, 0x0042}] = newInfo(Tag{0x0024, 0x0042}, "US", "StimuliRetestingQuantity", "1", false)
+	tagDict[Tag{0x0024, 0x0044}] = newInfo(Tag{0x0024, 0x0044}, "LT", "CommentsOnPatientPerformanceOfVisualField", "1", false)
+	tagDict[Tag{0x0024, 0x0045}] = newInfo(Tag{0x0024, 0x0045}, "CS", "FalseNegativesEstimateFlag", "1", false)
+	tagDict[Tag{0x0024, 0x0046}] = newInfo(Tag{0x0024, 0x0046}, "FL", "FalseNegativesEstimate", "1", false)
+	tagDict[Tag{0x0024, 0x0048}] = newInfo(Tag{0x0024, 0x0048}, "US", "NegativeCatchTrialsQuantity", "1", false)
+	tagDict[Tag{0x0024, 0x0050}] = newInfo(Tag{0x0024, 0x0050}, "US", "FalseNegativesQuantity", "1", false)
+	tagDict[Tag{0x0024, 0x0051}] = newInfo(Tag{0x0024, 0x0051}, "CS", "ExcessiveFalseNegativesDataFlag", "1", false)
+	tagDict[Tag{0x0024, 0x0052}] = newInfo(Tag{0x0024, 0x0052}, "CS", "ExcessiveFalseNegatives", "1", false)
+	tagDict[Tag{0x0024, 0x0053}] = newInfo(Tag{0x0024, 0x0053}, "CS", "FalsePositivesEstimateFlag", "1", false)
+	tagDict[Tag{0x0024, 0x0054}] = newInfo(Tag{0x0024, 0x0054}, "FL", "FalsePositivesEstimate", "1", false)
+	tagDict[Tag{0x0024, 0x0055}] = newInfo(Tag{0x0024, 0x0055}, "CS", "CatchTrialsDataFlag", "1", false)
+	tagDict[Tag{0x0024, 0x0056}] = newInfo(Tag{0x0024, 0x0056}, "US", "PositiveCatchTrialsQuantity", "1", false)
+	tagDict[Tag{0x0024, 0x0057}] = newInfo(Tag{0x0024, 0x0057}, "CS", "TestPointNormalsDataFlag", "1", false)
+	tagDict[Tag{0x0024, 0x0058}] = newInfo(Tag{0x0024, 0x0058}, "SQ", "TestPointNormalsSequence", "1", false)
+	tagDict[Tag{0x0024, 0x0059}] = newInfo(Tag{0x0024, 0x0059}, "CS", "GlobalDeviationProbabilityNormalsFlag", "1", false)
+	tagDict[Tag{0x0024, 0x0060}] = newInfo(Tag{0x0024, 0x0060}, "US", "FalsePositivesQuantity", "1", false)
+	tagDict[Tag{0x0024, 0x0061}] = newInfo(Tag{0x0024, 0x0061}, "CS", "ExcessiveFalsePositivesDataFlag", "1", false)
+	tagDict[Tag{0x0024, 0x0062}] = newInfo(Tag{0x0024, 0x0062}, "CS", "ExcessiveFalsePositives", "1", false)
+	tagDict[Tag{0x0024, 0x0063}] = newInfo(Tag{0x0024, 0x0063}, "CS", "VisualFieldTestNormalsFlag", "1", false)
+	tagDict[Tag{0x0024, 0x0064}] = newInfo(Tag{0x0024, 0x0064}, "SQ", "ResultsNormalsSequence", "1", false)
+	tagDict[Tag{0x0024, 0x0065}] = newInfo(Tag{0x0024, 0x0065}, "SQ", "AgeCorrectedSensitivityDeviationAlgorithmSequence", "1", false)
+	tagDict[Tag{0x0024, 0x0066}] = newInfo(Tag{0x0024, 0x0066}, "FL", "GlobalDeviationFromNormal", "1", false)
+	tagDict[Tag{0x0024, 0x0067}] = newInfo(Tag{0x0024, 0x0067}, "SQ", "GeneralizedDefectSensitivityDeviationAlgorithmSequence", "1", false)
+	tagDict[Tag{0x0024, 0x0068}] = newInfo(Tag{0x0024, 0x0068}, "FL", "LocalizedDeviationfromNormal", "1", false)
+	tagDict[Tag{0x0024, 0x0069}] = newInfo(Tag{0x0024, 0x0069}, "LO", "PatientReliabilityIndicator", "1", false)
+	tagDict[Tag{0x0024, 0x0070}] = newInfo(Tag{0x0024, 0x0070}, "FL", "VisualFieldMeanSensitivity", "1", false)
+	tagDict[Tag{0x0024, 0x0071}] = newInfo(Tag{0x0024, 0x0071}, "FL", "GlobalDeviationProbability", "1", false)
+	tagDict[Tag{0x0024, 0x0072}] = newInfo(Tag{0x0024, 0x0072}, "CS", "LocalDeviationProbabilityNormalsFlag", "1", false)
+	tagDict[Tag{0x0024, 0x0073}] = newInfo(Tag{0x0024, 0x0073}, "FL", "LocalizedDeviationProbability", "1", false)
+	tagDict[Tag{0x0024, 0x0074}] = newInfo(Tag{0x0024, 0x0074}, "CS", "ShortTermFluctuationCalculated", "1", false)
+	tagDict[Tag{0x0024, 0x0075}] = newInfo(Tag{0x0024, 0x0075}, "FL", "ShortTermFluctuation", "1", false)
+	tagDict[Tag{0x0024, 0x0076}] = newInfo(Tag{0x0024, 0x0076}, "CS", "ShortTermFluctuationProbabilityCalculated", "1", false)
+	tagDict[Tag{0x0024, 0x0077}] = newInfo(Tag{0x0024, 0x0077}, "FL", "ShortTermFluctuationProbability", "1", false)
+	tagDict[Tag{0x0024, 0x0078}] = newInfo(Tag{0x0024, 0x0078}, "CS", "CorrectedLocalizedDeviationFromNormalCalculated", "1", false)
+	tagDict[Tag{0x0024, 0x0079}] = newInfo(Tag{0x0024, 0x0079}, "FL", "CorrectedLocalizedDeviationFromNormal", "1", false)
+	tagDict[Tag{0x0024, 0x0080}] = newInfo(Tag{0x0024, 0x0080}, "CS", "CorrectedLocalizedDeviationFromNormalProbabilityCalculated", "1", false)
+	tagDict[Tag{0x0024, 0x0081}] = newInfo(Tag{0x0024, 0x0081}, "FL", "CorrectedLocalizedDeviationFromNormalProbability", "1", false)
+	tagDict[Tag{0x0024, 0x0083}] = newInfo(Tag{0x0024, 0x0083}, "SQ", "GlobalDeviationProbabilitySequence", "1", false)
+	tagDict[Tag{0x0024, 0x0085}] = newInfo(Tag{0x0024, 0x0085}, "SQ", "LocalizedDeviationProbabilitySequence", "1", false)
+	tagDict[Tag{0x0024, 0x0086}] = newInfo(Tag{0x0024, 0x0086}, "CS", "FovealSensitivityMeasured", "1", false)
+	tagDict[Tag{0x0024, 0x0087}] = newInfo(Tag{0x0024, 0x0087}, "FL", "FovealSensitivity", "1", false)
+	tagDict[Tag{0x0024, 0x0088}] = newInfo(Tag{0x0024, 0x0088}, "FL", "VisualFieldTestDuration", "1", false)
+	tagDict[Tag{0x0024, 0x0089}] = newInfo(Tag{0x0024, 0x0089}, "SQ", "VisualFieldTestPointSequence", "1", false)
+	tagDict[Tag{0x0024, 0x0090}] = newInfo(Tag{0x0024, 0x0090}, "FL", "VisualFieldTestPointXCoordinate", "1", false)
+	tagDict[Tag{0x0024, 0x0091}] = newInfo(Tag{0x0024, 0x0091}, "FL", "VisualFieldTestPointYCoordinate", "1", false)
+	tagDict[Tag{0x0024, 0x0092}] = newInfo(Tag{0x0024, 0x0092}, "FL", "AgeCorrectedSensitivityDeviationValue", "1", false)
+	tagDict[Tag{0x0024, 0x0093}] = newInfo(Tag{0x0024, 0x0093}, "CS", "StimulusResults", "1", false)
+	tagDict[Tag{0x0024, 0x0094}] = newInfo(Tag{0x0024, 0x0094}, "FL", "SensitivityValue", "1", false)
+	tagDict[Tag{0x0024, 0x0095}] = newInfo(Tag{0x0024, 0x0095}, "CS", "RetestStimulusSeen", "1", false)
+	tagDict[Tag{0x0024, 0x0096}] = newInfo(Tag{0x0024, 0x0096}, "FL", "RetestSensitivityValue", "1", false)
+	tagDict[Tag{0x0024, 0x0097}] = newInfo(Tag{0x0024, 0x0097}, "SQ", "VisualFieldTestPointNormalsSequence", "1", false)
+	tagDict[Tag{0x0024, 0x0098}] = newInfo(Tag{0x0024, 0x0098}, "FL", "QuantifiedDefect", "1", false)
+	tagDict[Tag{0x0024, 0x0100}] = newInfo(Tag{0x0024, 0x0100}, "FL", "AgeCorrectedSensitivityDeviationProbabilityValue", "1", false)
+	tagDict[Tag{0x0024, 0x0102}] = newInfo(Tag{0x0024, 0x0102}, "CS", "GeneralizedDefectCorrectedSensitivityDeviationFlag", "1", false)
+	tagDict[Tag{0x0024, 0x0103}] = newInfo(Tag{0x0024, 0x0103}, "FL", "GeneralizedDefectCorrectedSensitivityDeviationValue", "1", false)
+	tagDict[Tag{0x0024, 0x0104}] = newInfo(Tag{0x0024, 0x0104}, "FL", "GeneralizedDefectCorrectedSensitivityDeviationProbabilityValue", "1", false)
+	tagDict[Tag{0x0024, 0x0105}] = newInfo(Tag{0x0024, 0x0105}, "FL", "MinimumSensitivityValue", "1", false)
+	tagDict[Tag{0x0024, 0x0106}] = newInfo(Tag{0x0024, 0x0106}, "CS", "BlindSpotLocalized", "1", false)
+	tagDict[Tag{0x0024, 0x0107}] = newInfo(Tag{0x0024, 0x0107}, "FL", "BlindSpotXCoordinate", "1", false)
+	tagDict[Tag{0x0024, 0x0108}] = newInfo(Tag{0x0024, 0x0108}, "FL", "BlindSpotYCoordinate", "1", false)
+	tagDict[Tag{0x0024, 0x0110}] = newInfo(Tag{0x0024, 0x0110}, "SQ", "VisualAcuityMeasurementSequence", "1", false)
+	tagDict[Tag{0x0024, 0x0112}] = newInfo(Tag{0x0024, 0x0112}, "SQ", "RefractiveParametersUsedOnPatientSequence", "1", false)
+	tagDict[Tag{0x0024, 0x0113}] = newInfo(Tag{0x0024, 0x0113}, "CS", "MeasurementLaterality", "1", false)
+	tagDict[Tag{0x0024, 0x0114}] = newInfo(Tag{0x0024, 0x0114}, "SQ", "OphthalmicPatientClinicalInformationLeftEyeSequence", "1", false)
+	tagDict[Tag{0x0024, 0x0115}] = newInfo(Tag{0x0024, 0x0115}, "SQ", "OphthalmicPatientClinicalInformationRightEyeSequence", "1", false)
+	tagDict[Tag{0x0024, 0x0117}] = newInfo(Tag{0x0024, 0x0117}, "CS", "FovealPointNormativeDataFlag", "1", false)
+	tagDict[Tag{0x0024, 0x0118}] = newInfo(Tag{0x0024, 0x0118}, "FL", "FovealPointProbabilityValue", "1", false)
+	tagDict[Tag{0x0024, 0x0120}] = newInfo(Tag{0x0024, 0x0120}, "CS", "ScreeningBaselineMeasured", "1", false)
+	tagDict[Tag{0x0024, 0x0122}] = newInfo(Tag{0x0024, 0x0122}, "SQ", "ScreeningBaselineMeasuredSequence", "1", false)
+	tagDict[Tag{0x0024, 0x0124}] = newInfo(Tag{0x0024, 0x0124}, "CS", "ScreeningBaselineType", "1", false)
+	tagDict[Tag{0x0024, 0x0126}] = newInfo(Tag{0x0024, 0x0126}, "FL", "ScreeningBaselineValue", "1", false)
+	tagDict[Tag{0x0024, 0x0202}] = newInfo(Tag{0x0024, 0x0202}, "LO", "AlgorithmSource", "1", false)
+	tagDict[Tag{0x0024, 0x0306}] = newInfo(Tag{0x0024, 0x0306}, "LO", "DataSetName", "1", false)
+	tagDict[Tag{0x0024, 0x0307}] = newInfo(Tag{0x0024, 0x0307}, "LO", "DataSetVersion", "1", false)
+	tagDict[Tag{0x0024, 0x0308}] = newInfo(Tag{0x0024, 0x0308}, "LO", "DataSetSource", "1", false)
+	tagDict[Tag{0x0024, 0x0309}] = newInfo(Tag{0x0024, 0x0309}, "LO", "DataSetDescription", "1", false)
+	tagDict[Tag{0x0024, 0x0317}] = newInfo(Tag{0x0024, 0x0317}, "SQ", "VisualFieldTestReliabilityGlobalIndexSequence", "1", false)
+	tagDict[Tag{0x0024, 0x0320}] = newInfo(Tag{0x0024, 0x0320}, "SQ", "VisualFieldGlobalResultsIndexSequence", "1", false)
+	tagDict[Tag{0x0024, 0x0325}] = newInfo(Tag{0x0024, 0x0325}, "SQ", "DataObservationSequence", "1", false)
+	tagDict[Tag{0x0024, 0x0338}] = newInfo(Tag{0x0024, 0x0338}, "CS", "IndexNormalsFlag", "1", false)
+	tagDict[Tag{0x0024, 0x0341}] = newInfo(Tag{0x0024, 0x0341}, "FL", "IndexProbability", "1", false)
+	tagDict[Tag{0x0024, 0x0344}] = newInfo(Tag{0x0024, 0x0344}, "SQ", "IndexProbabilitySequence", "1", false)
+	tagDict[Tag{0x0028, 0x0002}] = newInfo(Tag{0x0028, 0x0002}, "US", "SamplesPerPixel", "1", false)
+	tagDict[Tag{0x0028, 0x0003}] = newInfo(Tag{0x0028, 0x0003}, "US", "SamplesPerPixelUsed", "1", false)
+	tagDict[Tag{0x0028, 0x0004}] = newInfo(Tag{0x0028, 0x0004}, "CS", "PhotometricInterpretation", "1", false)
+	tagDict[Tag{0x0028, 0x0006}] = newInfo(Tag{0x0028, 0x0006}, "US", "PlanarConfiguration", "1", false)
+	tagDict[Tag{0x0028, 0x0008}] = newInfo(Tag{0x0028, 0x0008}, "IS", "NumberOfFrames", "1", false)
+	tagDict[Tag{0x0028, 0x0009}] = newInfo(Tag{0x0028, 0x0009}, "AT", "FrameIncrementPointer", "1-n", false)
+	tagDict[Tag{0x0028, 0x000A}] = newInfo(Tag{0x0028, 0x000A}, "AT", "FrameDimensionPointer", "1-n", false)
+	tagDict[Tag{0x0028, 0x0010}] = newInfo(Tag{0x0028, 0x0010}, "US", "Rows", "1", false)
+	tagDict[Tag{0x0028, 0x0011}] = newInfo(Tag{0x0028, 0x0011}, "US", "Columns", "1", false)
+	tagDict[Tag{0x0028, 0x0014}] = newInfo(Tag{0x0028, 0x0014}, "US", "UltrasoundColorDataPresent", "1", false)
+	tagDict[Tag{0x0028, 0x0030}] = newInfo(Tag{0x0028, 0x0030}, "DS", "PixelSpacing", "2", false)
+	tagDict[Tag{0x0028, 0x0031}] = newInfo(Tag{0x0028, 0x0031}, "DS", "ZoomFactor", "2", false)
+	tagDict[Tag{0x0028, 0x0032}] = newInfo(Tag{0x0028, 0x0032}, "DS", "ZoomCenter", "2", false)
+	tagDict[Tag{0x0028, 0x0034}] = newInfo(Tag{0x0028, 0x0034}, "IS", "PixelAspectRatio", "2", false)
+	tagDict[Tag{0x0028, 0x0051}] = newInfo(Tag{0x0028, 0x0051}, "CS", "CorrectedImage", "1-n", false)
+	tagDict[Tag{0x0028, 0x0100}] = newInfo(Tag{0x0028, 0x0100}, "US", "BitsAllocated", "1", false)
+	tagDict[Tag{0x0028, 0x0101}] = newInfo(Tag{0x0028, 0x0101}, "US", "BitsStored", "1", false)
+	tagDict[Tag{0x0028, 0x0102}] = newInfo(Tag{0x0028, 0x0102}, "US", "HighBit", "1", false)
+	tagDict[Tag{0x0028, 0x0103}] = newInfo(Tag{0x0028, 0x0103}, "US", "PixelRepresentation", "1", false)
+	tagDict[Tag{0x0028, 0x0106}] = newInfo(Tag{0x0028, 0x0106}, "US or SS", "SmallestImagePixelValue", "1", false)
+	tagDict[Tag{0x0028, 0x0107}] = newInfo(Tag{0x0028, 0x0107}, "US or SS", "LargestImagePixelValue", "1", false)
+	tagDict[Tag{0x0028, 0x0108}] = newInfo(Tag{0x0028, 0x0108}, "US or SS", "SmallestPixelValueInSeries", "1", false)
+	tagDict[Tag{0x0028, 0x0109}] = newInfo(Tag{0x0028, 0x0109}, "US or SS", "LargestPixelValueInSeries", "1", false)
+	tagDict[Tag{0x0028, 0x0120}] = newInfo(Tag{0x0028, 0x0120}, "US or SS", "PixelPaddingValue", "1", false)
+	tagDict[Tag{0x0028, 0x0121}] = newInfo(Tag{0x0028, 0x0121}, "US or SS", "PixelPaddingRangeLimit", "1", false)
+	tagDict[Tag{0x0028, 0x0300}] = newInfo(Tag{0x0028, 0x0300}, "CS", "QualityControlImage", "1", false)
+	tagDict[Tag{0x0028, 0x0301}] = newInfo(Tag{0x0028, 0x0301}, "CS", "BurnedInAnnotation", "1", false)
+	tagDict[Tag{0x0028, 0x0302}] = newInfo(Tag{0x0028, 0x0302}, "CS", "RecognizableVisualFeatures", "1", false)
+	tagDict[Tag{0x0028, 0x0303}] = newInfo(Tag{0x0028, 0x0303}, "CS", "LongitudinalTemporalInformationModified", "1", false)
+	tagDict[Tag{0x0028, 0x0304}] = newInfo(Tag{0x0028, 0x0304}, "UI", "ReferencedColorPaletteInstanceUID", "1", false)
+	tagDict[Tag{0x0028, 0x0A02}] = newInfo(Tag{0x0028, 0x0A02}, "CS", "PixelSpacingCalibrationType", "1", false)
+	tagDict[Tag{0x0028, 0x0A04}] = newInfo(Tag{0x0028, 0x0A04}, "LO", "PixelSpacingCalibrationDescription", "1", false)
+	tagDict[Tag{0x0028, 0x1040}] = newInfo(Tag{0x0028, 0x1040}, "CS", "PixelIntensityRelationship", "1", false)
+	tagDict[Tag{0x0028, 0x1041}] = newInfo(Tag{0x0028, 0x1041}, "SS", "PixelIntensityRelationshipSign", "1", false)
+	tagDict[Tag{0x0028, 0x1050}] = newInfo(Tag{0x0028, 0x1050}, "DS", "WindowCenter", "1-n", false)
+	tagDict[Tag{0x0028, 0x1051}] = newInfo(Tag{0x0028, 0x1051}, "DS", "WindowWidth", "1-n", false)
+	tagDict[Tag{0x0028, 0x1052}] = newInfo(Tag{0x0028, 0x1052}, "DS", "RescaleIntercept", "1", false)
+	tagDict[Tag{0x0028, 0x1053}] = newInfo(Tag{0x0028, 0x1053}, "DS", "RescaleSlope", "1", false)
+	tagDict[Tag{0x0028, 0x1054}] = newInfo(Tag{0x0028, 0x1054}, "LO", "RescaleType", "1", false)
+	tagDict[Tag{0x0028, 0x1055}] = newInfo(Tag{0x0028, 0x1055}, "LO", "WindowCenterWidthExplanation", "1-n", false)
+	tagDict[Tag{0x0028, 0x1056}] = newInfo(Tag{0x0028, 0x1056}, "CS", "VOILUTFunction", "1", false)
+	tagDict[Tag{0x0028, 0x1090}] = newInfo(Tag{0x0028, 0x1090}, "CS", "RecommendedViewingMode", "1", false)
+	tagDict[Tag{0x0028, 0x1101}] = newInfo(Tag{0x0028, 0x1101}, "US or SS", "RedPaletteColorLookupTableDescriptor", "3", false)
+	tagDict[Tag{0x0028, 0x1102}] = newInfo(Tag{0x0028, 0x1102}, "US or SS", "GreenPaletteColorLookupTableDescriptor", "3", false)
+	tagDict[Tag{0x0028, 0x1103}] = newInfo(Tag{0x0028, 0x1103}, "US or SS", "BluePaletteColorLookupTableDescriptor", "3", false)
+	tagDict[Tag{0x0028, 0x1104}] = newInfo(Tag{0x0028, 0x1104}, "US", "AlphaPaletteColorLookupTableDescriptor", "3", false)
+	tagDict[Tag{0x0028, 0x1199}] = newInfo(Tag{0x0028, 0x1199}, "UI", "PaletteColorLookupTableUID", "1", false)
+	tagDict[Tag{0x0028, 0x1201}] = newInfo(Tag{0x0028, 0x1201}, "OW", "RedPaletteColorLookupTableData", "1", false)
+	tagDict[Tag{0x0028, 0x1202}] = newInfo(Tag{0x0028, 0x1202}, "OW", "GreenPaletteColorLookupTableData", "1", false)
+	tagDict[Tag{0x0028, 0x1203}] = newInfo(Tag{0x0028, 0x1203}, "OW", "BluePaletteColorLookupTableData", "1", false)
+	tagDict[Tag{0x0028, 0x1204}] = newInfo(Tag{0x0028, 0x1204}, "OW", "AlphaPaletteColorLookupTableData", "1", false)
+	tagDict[Tag{0x0028, 0x1221}] = newInfo(Tag{0x0028, 0x1221}, "OW", "SegmentedRedPaletteColorLookupTableData", "1", false)
+	tagDict[Tag{0x0028, 0x1222}] = newInfo(Tag{0x0028, 0x1222}, "OW", "SegmentedGreenPaletteColorLookupTableData", "1", false)
+	tagDict[Tag{0x0028, 0x1223}] = newInfo(Tag{0x0028, 0x1223}, "OW", "SegmentedBluePaletteColorLookupTableData", "1", false)
+	tagDict[Tag{0x0028, 0x1300}] = newInfo(Tag{0x0028, 0x1300}, "CS", "BreastImplantPresent", "1", false)
+	tagDict[Tag{0x0028, 0x1350}] = newInfo(Tag{0x0028, 0x1350}, "CS", "PartialView", "1", false)
+	tagDict[Tag{0x0028, 0x1351}] = newInfo(Tag{0x0028, 0x1351}, "ST", "PartialViewDescription", "1", false)
+	tagDict[Tag{0x0028, 0x1352}] = newInfo(Tag{0x0028, 0x1352}, "SQ", "PartialViewCodeSequence", "1", false)
+	tagDict[Tag{0x0028, 0x135A}] = newInfo(Tag{0x0028, 0x135A}, "CS", "SpatialLocationsPreserved", "1", false)
+	tagDict[Tag{0x0028, 0x1401}] = newInfo(Tag{0x0028, 0x1401}, "SQ", "DataFrameAssignmentSequence", "1", false)
+	tagDict[Tag{0x0028, 0x1402}] = newInfo(Tag{0x0028, 0x1402}, "CS", "DataPathAssignment", "1", false)
+	tagDict[Tag{0x0028, 0x1403}] = newInfo(Tag{0x0028, 0x1403}, "US", "BitsMappedToColorLookupTable", "1", false)
+	tagDict[Tag{0x0028, 0x1404}] = newInfo(Tag{0x0028, 0x1404}, "SQ", "BlendingLUT1Sequence", "1", false)
+	tagDict[Tag{0x0028, 0x1405}] = newInfo(Tag{0x0028, 0x1405}, "CS", "BlendingLUT1TransferFunction", "1", false)
+	tagDict[Tag{0x0028, 0x1406}] = newInfo(Tag{0x0028, 0x1406}, "FD", "BlendingWeightConstant", "1", false)
+	tagDict[Tag{0x0028, 0x1407}] = newInfo(Tag{0x0028, 0x1407}, "US", "BlendingLookupTableDescriptor", "3", false)
+	tagDict[Tag{0x0028, 0x1408}] = newInfo(Tag{0x0028, 0x1408}, "OW", "BlendingLookupTableData", "1", false)
+	tagDict[Tag{0x0028, 0x140B}] = newInfo(Tag{0x0028, 0x140B}, "SQ", "EnhancedPaletteColorLookupTableSequence", "1", false)
+	tagDict[Tag{0x0028, 0x140C}] = newInfo(Tag{0x0028, 0x140C}, "SQ", "BlendingLUT2Sequence", "1", false)
+	tagDict[Tag{0x0028, 0x140D}] = newInfo(Tag{0x0028, 0x140D}, "CS", "BlendingLUT2TransferFunction", "1", false)
+	tagDict[Tag{0x0028, 0x140E}] = newInfo(Tag{0x0028, 0x140E}, "CS", "DataPathID", "1", false)
+	tagDict[Tag{0x0028, 0x140F}] = newInfo(Tag{0x0028, 0x140F}, "CS", "RGBLUTTransferFunction", "1", false)
+	tagDict[Tag{0x0028, 0x1410}] = newInfo(Tag{0x0028, 0x1410}, "CS", "AlphaLUTTransferFunction", "1", false)
+	tagDict[Tag{0x0028, 0x2000}] = newInfo(Tag{0x0028, 0x2000}, "OB", "ICCProfile", "1", false)
+	tagDict[Tag{0x0028, 0x2110}] = newInfo(Tag{0x0028, 0x2110}, "CS", "LossyImageCompression", "1", false)
+	tagDict[Tag{0x0028, 0x2112}] = newInfo(Tag{0x0028, 0x2112}, "DS", "LossyImageCompressionRatio", "1-n", false)
+	tagDict[Tag{0x0028, 0x2114}] = newInfo(Tag{0x0028, 0x2114}, "CS", "LossyImageCompressionMethod", "1-n", false)
+	tagDict[Tag{0x0028, 0x3000}] = newInfo(Tag{0x0028, 0x3000}, "SQ", "ModalityLUTSequence", "1", false)
+	tagDict[Tag{0x0028, 0x3002}] = newInfo(Tag{0x0028, 0x3002}, "US or SS", "LUTDescriptor", "3", false)
+	tagDict[Tag{0x0028, 0x3003}] = newInfo(Tag{0x0028, 0x3003}, "LO", "LUTExplanation", "1", false)
+	tagDict[Tag{0x0028, 0x3004}] = newInfo(Tag{0x0028, 0x3004}, "LO", "ModalityLUTType", "1", false)
+	tagDict[Tag{0x0028, 0x3006}] = newInfo(Tag{0x0028, 0x3006}, "OW or US or SS", "LUTData", "1-n", false)
+	tagDict[Tag{0x0028, 0x3010}] = newInfo(Tag{0x0028, 0x3010}, "SQ", "VOILUTSequence", "1", false)
+	tagDict[Tag{0x0028, 0x3110}] = newInfo(Tag{0x0028, 0x3110}, "SQ", "SoftcopyVOILUTSequence", "1", false)
+	tagDict[Tag{0x0028, 0x6010}] = newInfo(Tag{0x0028, 0x6010}, "US", "RepresentativeFrameNumber", "1", false)
+	tagDict[Tag{0x0028, 0x6020}] = newInfo(Tag{0x0028, 0x6020}, "US", "FrameNumbersOfInterest", "1-n", false)
+	tagDict[Tag{0x0028, 0x6022}] = newInfo(Tag{0x0028, 0x6022}, "LO", "FrameOfInterestDescription", "1-n", false)
+	tagDict[Tag{0x0028, 0x6023}] = newInfo(Tag{0x0028, 0x6023}, "CS", "FrameOfInterestType", "1-n", false)
+	tagDict[Tag{0x0028, 0x6040}] = newInfo(Tag{0x0028, 0x6040}, "US", "RWavePointer", "1-n", false)
+	tagDict[Tag{0x0028, 0x6100}] = newInfo(Tag{0x0028, 0x6100}, "SQ", "MaskSubtractionSequence", "1", false)
+	tagDict[Tag{0x0028, 0x6101}] = newInfo(Tag{0x0028, 0x6101}, "CS", "MaskOperation", "1", false)
+	tagDict[Tag{0x0028, 0x6102}] = newInfo(Tag{0x0028, 0x6102}, "US", "ApplicableFrameRange", "2-2n", false)
+	tagDict[Tag{0x0028, 0x6110}] = newInfo(Tag{0x0028, 0x6110}, "US", "MaskFrameNumbers", "1-n", false)
+	tagDict[Tag{0x0028, 0x6112}] = newInfo(Tag{0x0028, 0x6112}, "US", "ContrastFrameAveraging", "1", false)
+	tagDict[Tag{0x0028, 0x6114}] = newInfo(Tag{0x0028, 0x6114}, "FL", "MaskSubPixelShift", "2", false)
+	tagDict[Tag{0x0028, 0x6120}] = newInfo(Tag{0x0028, 0x6120}, "SS", "TIDOffset", "1", false)
+	tagDict[Tag{0x0028, 0x6190}] = newInfo(Tag{0x0028, 0x6190}, "ST", "MaskOperationExplanation", "1", false)
+	tagDict[Tag{0x0028, 0x7FE0}] = newInfo(Tag{0x0028, 0x7FE0}, "UT", "PixelDataProviderURL", "1", false)
+	tagDict[Tag{0x0028, 0x9001}] = newInfo(Tag{0x0028, 0x9001}, "UL", "DataPointRows", "1", false)
+	tagDict[Tag{0x0028, 0x9002}] = newInfo(Tag{0x0028, 0x9002}, "UL", "DataPointColumns", "1", false)
+	tagDict[Tag{0x0028, 0x9003}] = newInfo(Tag{0x0028, 0x9003}, "CS", "SignalDomainColumns", "1", false)
+	tagDict[Tag{0x0028, 0x9108}] = newInfo(Tag{0x0028, 0x9108}, "CS", "DataRepresentation", "1", false)
+	tagDict[Tag{0x0028, 0x9110}] = newInfo(Tag{0x0028, 0x9110}, "SQ", "PixelMeasuresSequence", "1", false)
+	tagDict[Tag{0x0028, 0x9132}] = newInfo(Tag{0x0028, 0x9132}, "SQ", "FrameVOILUTSequence", "1", false)
+	tagDict[Tag{0x0028, 0x9145}] = newInfo(Tag{0x0028, 0x9145}, "SQ", "PixelValueTransformationSequence", "1", false)
+	tagDict[Tag{0x0028, 0x9235}] = newInfo(Tag{0x0028, 0x9235}, "CS", "SignalDomainRows", "1", false)
+	tagDict[Tag{0x0028, 0x9411}] = newInfo(Tag{0x0028, 0x9411}, "FL", "DisplayFilterPercentage", "1", false)
+	tagDict[Tag{0x0028, 0x9415}] = newInfo(Tag{0x0028, 0x9415}, "SQ", "FramePixelShiftSequence", "1", false)
+	tagDict[Tag{0x0028, 0x9416}] = newInfo(Tag{0x0028, 0x9416}, "US", "SubtractionItemID", "1", false)
+	tagDict[Tag{0x0028, 0x9422}] = newInfo(Tag{0x0028, 0x9422}, "SQ", "PixelIntensityRelationshipLUTSequence", "1", false)
+	tagDict[Tag{0x0028, 0x9443}] = newInfo(Tag{0x0028, 0x9443}, "SQ", "FramePixelDataPropertiesSequence", "1", false)
+	tagDict[Tag{0x0028, 0x9444}] = newInfo(Tag{0x0028, 0x9444}, "CS", "GeometricalProperties", "1", false)
+	tagDict[Tag{0x0028, 0x9445}] = newInfo(Tag{0x0028, 0x9445}, "FL", "GeometricMaximumDistortion", "1", false)
+	tagDict[Tag{0x0028, 0x9446}] = newInfo(Tag{0x0028, 0x9446}, "CS", "ImageProcessingApplied", "1-n", false)
+	tagDict[Tag{0x0028, 0x9454}] = newInfo(Tag{0x0028, 0x9454}, "CS", "MaskSelectionMode", "1", false)
+	tagDict[Tag{0x0028, 0x9474}] = newInfo(Tag{0x0028, 0x9474}, "CS", "LUTFunction", "1", false)
+	tagDict[Tag{0x0028, 0x9478}] = newInfo(Tag{0x0028, 0x9478}, "FL", "MaskVisibilityPercentage", "1", false)
+	tagDict[Tag{0x0028, 0x9501}] = newInfo(Tag{0x0028, 0x9501}, "SQ", "PixelShiftSequence", "1", false)
+	tagDict[Tag{0x0028, 0x9502}] = newInfo(Tag{0x0028, 0x9502}, "SQ", "RegionPixelShiftSequence", "1", false)
+	tagDict[Tag{0x0028, 0x9503}] = newInfo(Tag{0x0028, 0x9503}, "SS", "VerticesOfTheRegion", "2-2n", false)
+	tagDict[Tag{0x0028, 0x9505}] = newInfo(Tag{0x0028, 0x9505}, "SQ", "MultiFramePresentationSequence", "1", false)
+	tagDict[Tag{0x0028, 0x9506}] = newInfo(Tag{0x0028, 0x9506}, "US", "PixelShiftFrameRange", "2-2n", false)
+	tagDict[Tag{0x0028, 0x9507}] = newInfo(Tag{0x0028, 0x9507}, "US", "LUTFrameRange", "2-2n", false)
+	tagDict[Tag{0x0028, 0x9520}] = newInfo(Tag{0x0028, 0x9520}, "DS", "ImageToEquipmentMappingMatrix", "16", false)
+	tagDict[Tag{0x0028, 0x9537}] = newInfo(Tag{0x0028, 0x9537}, "CS", "EquipmentCoordinateSystemIdentification", "1", false)
+	tagDict[Tag{0x0032, 0x1031}] = newInfo(Tag{0x0032, 0x1031}, "SQ", "RequestingPhysicianIdentificationSequence", "1", false)
+	tagDict[Tag{0x0032, 0x1032}] = newInfo(Tag{0x0032, 0x1032}, "PN", "RequestingPhysician", "1", false)
+	tagDict[Tag{0x0032, 0x1033}] = newInfo(Tag{0x0032, 0x1033}, "LO", "RequestingService", "1", false)
+	tagDict[Tag{0x0032, 0x1034}] = newInfo(Tag{0x0032, 0x1034}, "SQ", "RequestingServiceCodeSequence", "1", false)
+	tagDict[Tag{0x0032, 0x1060}] = newInfo(Tag{0x0032, 0x1060}, "LO", "RequestedProcedureDescription", "1", false)
+	tagDict[Tag{0x0032, 0x1064}] = newInfo(Tag{0x0032, 0x1064}, "SQ", "RequestedProcedureCodeSequence", "1", false)
+	tagDict[Tag{0x0032, 0x1070}] = newInfo(Tag{0x0032, 0x1070}, "LO", "RequestedContrastAgent", "1", false)
+	tagDict[Tag{0x0038, 0x0004}] = newInfo(Tag{0x0038, 0x0004}, "SQ", "ReferencedPatientAliasSequence", "1", false)
+	tagDict[Tag{0x0038, 0x0008}] = newInfo(Tag{0x0038, 0x0008}, "CS", "VisitStatusID", "1", false)
+	tagDict[Tag{0x0038, 0x0010}] = newInfo(Tag{0x0038, 0x0010}, "LO", "AdmissionID", "1", false)
+	tagDict[Tag{0x0038, 0x0014}] = newInfo(Tag{0x0038, 0x0014}, "SQ", "IssuerOfAdmissionIDSequence", "1", false)
+	tagDict[Tag{0x0038, 0x0016}] = newInfo(Tag{0x0038, 0x0016}, "LO", "RouteOfAdmissions", "1", false)
+	tagDict[Tag{0x0038, 0x0020}] = newInfo(Tag{0x0038, 0x0020}, "DA", "AdmittingDate", "1", false)
+	tagDict[Tag{0x0038, 0x0021}] = newInfo(Tag{0x0038, 0x0021}, "TM", "AdmittingTime", "1", false)
+	tagDict[Tag{0x0038, 0x0050}] = newInfo(Tag{0x0038, 0x0050}, "LO", "SpecialNeeds", "1", false)
+	tagDict[Tag{0x0038, 0x0060}] = newInfo(Tag{0x0038, 0x0060}, "LO", "ServiceEpisodeID", "1", false)
+	tagDict[Tag{0x0038, 0x0062}] = newInfo(Tag{0x0038, 0x0062}, "LO", "ServiceEpisodeDescription", "1", false)
+	tagDict[Tag{0x0038, 0x0064}] = newInfo(Tag{0x0038, 0x0064}, "SQ", "IssuerOfServiceEpisodeIDSequence", "1", false)
+	tagDict[Tag{0x0038, 0x0100}] = newInfo(Tag{0x0038, 0x0100}, "SQ", "PertinentDocumentsSequence", "1", false)
+	tagDict[Tag{0x0038, 0x0300}] = newInfo(Tag{0x0038, 0x0300}, "LO", "CurrentPatientLocation", "1", false)
+	tagDict[Tag{0x0038, 0x0400}] = newInfo(Tag{0x0038, 0x0400}, "LO", "PatientInstitutionResidence", "1", false)
+	tagDict[Tag{0x0038, 0x0500}] = newInfo(Tag{0x0038, 0x0500}, "LO", "PatientState", "1", false)
+	tagDict[Tag{0x0038, 0x0502}] = newInfo(Tag{0x0038, 0x0502}, "SQ", "PatientClinicalTrialParticipationSequence", "1", false)
+	tagDict[Tag{0x0038, 0x4000}] = newInfo(Tag{0x0038, 0x4000}, "LT", "VisitComments", "1", false)
+	tagDict[Tag{0x003A, 0x0004}] = newInfo(Tag{0x003A, 0x0004}, "CS", "WaveformOriginality", "1", false)
+	tagDict[Tag{0x003A, 0x0005}] = newInfo(Tag{0x003A, 0x0005}, "US", "NumberOfWaveformChannels", "1", false)
+	tagDict[Tag{0x003A, 0x0010}] = newInfo(Tag{0x003A, 0x0010}, "UL", "NumberOfWaveformSamples", "1", false)
+	tagDict[Tag{0x003A, 0x001A}] = newInfo(Tag{0x003A, 0x001A}, "DS", "SamplingFrequency", "1", false)
+	tagDict[Tag{0x003A, 0x0020}] = newInfo(Tag{0x003A, 0x0020}, "SH", "MultiplexGroupLabel", "1", false)
+	tagDict[Tag{0x003A, 0x0200}] = newInfo(Tag{0x003A, 0x0200}, "SQ", "ChannelDefinitionSequence", "1", false)
+	tagDict[Tag{0x003A, 0x0202}] = newInfo(Tag{0x003A, 0x0202}, "IS", "WaveformChannelNumber", "1", false)
+	tagDict[Tag{0x003A, 0x0203}] = newInfo(Tag{0x003A, 0x0203}, "SH", "ChannelLabel", "1", false)
+	tagDict[Tag{0x003A, 0x0205}] = newInfo(Tag{0x003A, 0x0205}, "CS", "ChannelStatus", "1-n", false)
+	tagDict[Tag{0x003A, 0x0208}] = newInfo(Tag{0x003A, 0x0208}, "SQ", "ChannelSourceSequence", "1", false)
+	tagDict[Tag{0x003A, 0x0209}] = newInfo(Tag{0x003A, 0x0209}, "SQ", "ChannelSourceModifiersSequence", "1", false)
+	tagDict[Tag{0x003A, 0x020A}] = newInfo(Tag{0x003A, 0x020A}, "SQ", "SourceWaveformSequence", "1", false)
+	tagDict[Tag{0x003A, 0x020C}] = newInfo(Tag{0x003A, 0x020C}, "LO", "ChannelDerivationDescription", "1", false)
+	tagDict[Tag{0x003A, 0x0210}] = newInfo(Tag{0x003A, 0x0210}, "DS", "ChannelSensitivity", "1", false)
+	tagDict[Tag{0x003A, 0x0211}] = newInfo(Tag{0x003A, 0x0211}, "SQ", "ChannelSensitivityUnitsSequence", "1", false)
+	tagDict[Tag{0x003A, 0x0212}] = newInfo(Tag{0x003A, 0x0212}, "DS", "ChannelSensitivityCorrectionFactor", "1", false)
+	tagDict[Tag{0x003A, 0x0213}] = newInfo(Tag{0x003A, 0x0213}, "DS", "ChannelBaseline", "1", false)
+	tagDict[Tag{0x003A, 0x0214}] = newInfo(Tag{0x003A, 0x0214}, "DS", "ChannelTimeSkew", "1", false)
+	tagDict[Tag{0x003A, 0x0215}] = newInfo(Tag{0x003A, 0x0215}, "DS", "ChannelSampleSkew", "1", false)
+	tagDict[Tag{0x003A, 0x0218}] = newInfo(Tag{0x003A, 0x0218}, "DS", "ChannelOffset", "1", false)
+	tagDict[Tag{0x003A, 0x021A}] = newInfo(Tag{0x003A, 0x021A}, "US", "WaveformBitsStored", "1", false)
+	tagDict[Tag{0x003A, 0x0220}] = newInfo(Tag{0x003A, 0x0220}, "DS", "FilterLowFrequency", "1", false)
+	tagDict[Tag{0x003A, 0x0221}] = newInfo(Tag{0x003A, 0x0221}, "DS", "FilterHighFrequency", "1", false)
+	tagDict[Tag{0x003A, 0x0222}] = newInfo(Tag{0x003A, 0x0222}, "DS", "NotchFilterFrequency", "1", false)
+	tagDict[Tag{0x003A, 0x0223}] = newInfo(Tag{0x003A, 0x0223}, "DS", "NotchFilterBandwidth", "1", false)
+	tagDict[Tag{0x003A, 0x0230}] = newInfo(Tag{0x003A, 0x0230}, "FL", "WaveformDataDisplayScale", "1", false)
+	tagDict[Tag{0x003A, 0x0231}] = newInfo(Tag{0x003A, 0x0231}, "US", "WaveformDisplayBackgroundCIELabValue", "3", false)
+	tagDict[Tag{0x003A, 0x0240}] = newInfo(Tag{0x003A, 0x0240}, "SQ", "WaveformPresentationGroupSequence", "1", false)
+	tagDict[Tag{0x003A, 0x0241}] = newInfo(Tag{0x003A, 0x0241}, "US", "PresentationGroupNumber", "1", false)
+	tagDict[Tag{0x003A, 0x0242}] = newInfo(Tag{0x003A, 0x0242}, "SQ", "ChannelDisplaySequence", "1", false)
+	tagDict[Tag{0x003A, 0x0244}] = newInfo(Tag{0x003A, 0x0244}, "US", "ChannelRecommendedDisplayCIELabValue", "3", false)
+	tagDict[Tag{0x003A, 0x0245}] = newInfo(Tag{0x003A, 0x0245}, "FL", "ChannelPosition", "1", false)
+	tagDict[Tag{0x003A, 0x0246}] = newInfo(Tag{0x003A, 0x0246}, "CS", "DisplayShadingFlag", "1", false)
+	tagDict[Tag{0x003A, 0x0247}] = newInfo(Tag{0x003A, 0x0247}, "FL", "FractionalChannelDisplayScale", "1", false)
+	tagDict[Tag{0x003A, 0x0248}] = newInfo(Tag{0x003A, 0x0248}, "FL", "AbsoluteChannelDisplayScale", "1", false)
+	tagDict[Tag{0x003A, 0x0300}] = newInfo(Tag{0x003A, 0x0300}, "SQ", "MultiplexedAudioChannelsDescriptionCodeSequence", "1", false)
+	tagDict[Tag{0x003A, 0x0301}] = newInfo(Tag{0x003A, 0x0301}, "IS", "ChannelIdentificationCode", "1", false)
+	tagDict[Tag{0x003A, 0x0302}] = newInfo(Tag{0x003A, 0x0302}, "CS", "ChannelMode", "1", false)
+	tagDict[Tag{0x0040, 0x0001}] = newInfo(Tag{0x0040, 0x0001}, "AE", "ScheduledStationAETitle", "1-n", false)
+	tagDict[Tag{0x0040, 0x0002}] = newInfo(Tag{0x0040, 0x0002}, "DA", "ScheduledProcedureStepStartDate", "1", false)
+	tagDict[Tag{0x0040, 0x0003}] = newInfo(Tag{0x0040, 0x0003}, "TM", "ScheduledProcedureStepStartTime", "1", false)
+	tagDict[Tag{0x0040, 0x0004}] = newInfo(Tag{0x0040, 0x0004}, "DA", "ScheduledProcedureStepEndDate", "1", false)
+	tagDict[Tag{0x0040, 0x0005}] = newInfo(Tag{0x0040, 0x0005}, "TM", "ScheduledProcedureStepEndTime", "1", false)
+	tagDict[Tag{0x0040, 0x0006}] = newInfo(Tag{0x0040, 0x0006}, "PN", "ScheduledPerformingPhysicianName", "1", false)
+	tagDict[Tag{0x0040, 0x0007}] = newInfo(Tag{0x0040, 0x0007}, "LO", "ScheduledProcedureStepDescription", "1", false)
+	tagDict[Tag{0x0040, 0x0008}] = newInfo(Tag{0x0040, 0x0008}, "SQ", "ScheduledProtocolCodeSequence", "1", false)
+	tagDict[Tag{0x0040, 0x0009}] = newInfo(Tag{0x0040, 0x0009}, "SH", "ScheduledProcedureStepID", "1", false)
+	tagDict[Tag{0x0040, 0x000A}] = newInfo(Tag{0x0040, 0x000A}, "SQ", "StageCodeSequence", "1", false)
+	tagDict[Tag{0x0040, 0x000B}] = newInfo(Tag{0x0040, 0x000B}, "SQ", "ScheduledPerformingPhysicianIdentificationSequence", "1", false)
+	tagDict[Tag{0x0040, 0x0010}] = newInfo(Tag{0x0040, 0x0010}, "SH", "ScheduledStationName", "1-n", false)
+	tagDict[Tag{0x0040, 0x0011}] = newInfo(Tag{0x0040, 0x0011}, "SH", "ScheduledProcedureStepLocation", "1", false)
+	tagDict[Tag{0x0040, 0x0012}] = newInfo(Tag{0x0040, 0x0012}, "LO", "PreMedication", "1", false)
+	tagDict[Tag{0x0040, 0x0020}] = newInfo(Tag{0x0040, 0x0020}, "CS", "ScheduledProcedureStepStatus", "1", false)
+	tagDict[Tag{0x0040, 0x0026}] = newInfo(Tag{0x0040, 0x0026}, "SQ", "OrderPlacerIdentifierSequence", "1", false)
+	tagDict[Tag{0x0040, 0x0027}] = newInfo(Tag{0x0040, 0x0027}, "SQ", "OrderFillerIdentifierSequence", "1", false)
+	tagDict[Tag{0x0040, 0x0031}] = newInfo(Tag{0x0040, 0x0031}, "UT", "LocalNamespaceEntityID", "1", false)
+	tagDict[Tag{0x0040, 0x0032}] = newInfo(Tag{0x0040, 0x0032}, "UT", "UniversalEntityID", "1", false)
+	tagDict[Tag{0x0040, 0x0033}] = newInfo(Tag{0x0040, 0x0033}, "CS", "UniversalEntityIDType", "1", false)
+	tagDict[Tag{0x0040, 0x0035}] = newInfo(Tag{0x0040, 0x0035}, "CS", "IdentifierTypeCode", "1", false)
+	tagDict[Tag{0x0040, 0x0036}] = newInfo(Tag{0x0040, 0x0036}, "SQ", "AssigningFacilitySequence", "1", false)
+	tagDict[Tag{0x0040, 0x0039}] = newInfo(Tag{0x0040, 0x0039}, "SQ", "AssigningJurisdictionCodeSequence", "1", false)
+	tagDict[Tag{0x0040, 0x003A}] = newInfo(Tag{0x0040, 0x003A}, "SQ", "AssigningAgencyOrDepartmentCodeSequence", "1", false)
+	tagDict[Tag{0x0040, 0x0100}] = newInfo(Tag{0x0040, 0x0100}, "SQ", "ScheduledProcedureStepSequence", "1", false)
+	tagDict[Tag{0x0040, 0x0220}] = newInfo(Tag{0x0040, 0x0220}, "SQ", "ReferencedNonImageCompositeSOPInstanceSequence", "1", false)
+	tagDict[Tag{0x0040, 0x0241}] = newInfo(Tag{0x0040, 0x0241}, "AE", "PerformedStationAETitle", "1", false)
+	tagDict[Tag{0x0040, 0x0242}] = newInfo(Tag{0x0040, 0x0242}, "SH", "PerformedStationName", "1", false)
+	tagDict[Tag{0x0040, 0x0243}] = newInfo(Tag{0x0040, 0x0243}, "SH", "PerformedLocation", "1", false)
+	tagDict[Tag{0x0040, 0x0244}] = newInfo(Tag{0x0040, 0x0244}, "DA", "PerformedProcedureStepStartDate", "1", false)
+	tagDict[Tag{0x0040, 0x0245}] = newInfo(Tag{0x0040, 0x0245}, "TM", "PerformedProcedureStepStartTime", "1", false)
+	tagDict[Tag{0x0040, 0x0250}] = newInfo(Tag{0x0040, 0x0250}, "DA", "PerformedProcedureStepEndDate", "1", false)
+	tagDict[Tag{0x0040, 0x0251}] = newInfo(Tag{0x0040, 0x0251}, "TM", "PerformedProcedureStepEndTime", "1", false)
+	tagDict[Tag{0x0040, 0x0252}] = newInfo(Tag{0x0040, 0x0252}, "CS", "PerformedProcedureStepStatus", "1", false)
+	tagDict[Tag{0x0040, 0x0253}] = newInfo(Tag{0x0040, 0x0253}, "SH", "PerformedProcedureStepID", "1", false)
+	tagDict[Tag{0x0040, 0x0254}] = newInfo(Tag{0x0040, 0x0254}, "LO", "PerformedProcedureStepDescription", "1", false)
+	tagDict[Tag{0x0040, 0x0255}] = newInfo(Tag{0x0040, 0x0255}, "LO", "PerformedProcedureTypeDescription", "1", false)
+	tagDict[Tag{0x0040, 0x0260}] = newInfo(Tag{0x0040, 0x0260}, "SQ", "PerformedProtocolCodeSequence", "1", false)
+	tagDict[Tag{0x0040, 0x0261}] = newInfo(Tag{0x0040, 0x0261}, "CS", "PerformedProtocolType", "1", false)
+	tagDict[Tag{0x0040, 0x0270}] = newInfo(Tag{0x0040, 0x0270}, "SQ", "ScheduledStepAttributesSequence", "1", false)
+	tagDict[Tag{0x0040, 0x0275}] = newInfo(Tag{0x0040, 0x0275}, "SQ", "RequestAttributesSequence", "1", false)
+	tagDict[Tag{0x0040, 0x0280}] = newInfo(Tag{0x0040, 0x0280}, "ST", "CommentsOnThePerformedProcedureStep", "1", false)
+	tagDict[Tag{0x0040, 0x0281}] = newInfo(Tag{0x0040, 0x0281}, "SQ", "PerformedProcedureStepDiscontinuationReasonCodeSequence", "1", false)
+	tagDict[Tag{0x0040, 0x0293}] = newInfo(Tag{0x0040, 0x0293}, "SQ", "QuantitySequence", "1", false)
+	tagDict[Tag{0x0040, 0x0294}] = newInfo(Tag{0x0040, 0x0294}, "DS", "Quantity", "1", false)
+	tagDict[Tag{0x0040, 0x0295}] = newInfo(Tag{0x0040, 0x0295}, "SQ", "MeasuringUnitsSequence", "1", false)
+	tagDict[Tag{0x0040, 0x0296}] = newInfo(Tag{0x0040, 0x0296}, "SQ", "BillingItemSequence", "1", false)
+	tagDict[Tag{0x0040, 0x0300}] = newInfo(Tag{0x0040, 0x0300}, "US", "TotalTimeOfFluoroscopy", "1", false)
+	tagDict[Tag{0x0040, 0x0301}] = newInfo(Tag{0x0040, 0x0301}, "US", "TotalNumberOfExposures", "1", false)
+	tagDict[Tag{0x0040, 0x0302}] = newInfo(Tag{0x0040, 0x0302}, "US", "EntranceDose", "1", false)
+	tagDict[Tag{0x0040, 0x0303}] = newInfo(Tag{0x0040, 0x0303}, "US", "ExposedArea", "1-2", false)
+	tagDict[Tag{0x0040, 0x0306}] = newInfo(Tag{0x0040, 0x0306}, "DS", "DistanceSourceToEntrance", "1", false)
+	tagDict[Tag{0x0040, 0x030E}] = newInfo(Tag{0x0040, 0x030E}, "SQ", "ExposureDoseSequence", "1", false)
+	tagDict[Tag{0x0040, 0x0310}] = newInfo(Tag{0x0040, 0x0310}, "ST", "CommentsOnRadiationDose", "1", false)
+	tagDict[Tag{0x0040, 0x0312}] = newInfo(Tag{0x0040, 0x0312}, "DS", "XRayOutput", "1", false)
+	tagDict[Tag{0x0040, 0x0314}] = newInfo(Tag{0x0040, 0x0314}, "DS", "HalfValueLayer", "1", false)
+	tagDict[Tag{0x0040, 0x0316}] = newInfo(Tag{0x0040, 0x0316}, "DS", "OrganDose", "1", false)
+	tagDict[Tag{0x0040, 0x0318}] = newInfo(Tag{0x0040, 0x0318}, "CS", "OrganExposed", "1", false)
+	tagDict[Tag{0x0040, 0x0320}] = newInfo(Tag{0x0040, 0x0320}, "SQ", "BillingProcedureStepSequence", "1", false)
+	tagDict[Tag{0x0040, 0x0321}] = newInfo(Tag{0x0040, 0x0321}, "SQ", "FilmConsumptionSequence", "1", false)
+	tagDict[Tag{0x0040, 0x0324}] = newInfo(Tag{0x0040, 0x0324}, "SQ", "BillingSuppliesAndDevicesSequence", "1", false)
+	tagDict[Tag{0x0040, 0x0340}] = newInfo(Tag{0x0040, 0x0340}, "SQ", "PerformedSeriesSequence", "1", false)
+	tagDict[Tag{0x0040, 0x0400}] = newInfo(Tag{0x0040, 0x0400}, "LT", "CommentsOnTheScheduledProcedureStep", "1", false)
+	tagDict[Tag{0x0040, 0x0440}] = newInfo(Tag{0x0040, 0x0440}, "SQ", "ProtocolContextSequence", "1", false)
+	tagDict[Tag{0x0040, 0x0441}] = newInfo(Tag{0x0040, 0x0441}, "SQ", "ContentItemModifierSequence", "1", false)
+	tagDict[Tag{0x0040, 0x0500}] = newInfo(Tag{0x0040, 0x0500}, "SQ", "ScheduledSpecimenSequence", "1", false)
+	tagDict[Tag{0x0040, 0x0512}] = newInfo(Tag{0x0040, 0x0512}, "LO", "ContainerIdentifier", "1", false)
+	tagDict[Tag{0x0040, 0x0513}] = newInfo(Tag{0x0040, 0x0513}, "SQ", "IssuerOfTheContainerIdentifierSequence", "1", false)
+	tagDict[Tag{0x0040, 0x0515}] = newInfo(Tag{0x0040, 0x0515}, "SQ", "AlternateContainerIdentifierSequence", "1", false)
+	tagDict[Tag{0x0040, 0x0518}] = newInfo(Tag{0x0040, 0x0518}, "SQ", "ContainerTypeCodeSequence", "1", false)
+	tagDict[Tag{0x0040, 0x051A}] = newInfo(Tag{0x0040, 0x051A}, "LO", "ContainerDescription", "1", false)
+	tagDict[Tag{0x0040, 0x0520}] = newInfo(Tag{0x0040, 0x0520}, "SQ", "ContainerComponentSequence", "1", false)
+	tagDict[Tag{0x0040, 0x0551}] = newInfo(Tag{0x0040, 0x0551}, "LO", "SpecimenIdentifier", "1", false)
+	tagDict[Tag{0x0040, 0x0554}] = newInfo(Tag{0x0040, 0x0554}, "UI", "SpecimenUID", "1", false)
+	tagDict[Tag{0x0040, 0x0555}] = newInfo(Tag{0x0040, 0x0555}, "SQ", "AcquisitionContextSequence", "1", false)
+	tagDict[Tag{0x0040, 0x0556}] = newInfo(Tag{0x0040, 0x0556}, "ST", "AcquisitionContextDescription", "1", false)
+	tagDict[Tag{0x0040, 0x0560}] = newInfo(Tag{0x0040, 0x0560}, "SQ", "SpecimenDescriptionSequence", "1", false)
+	tagDict[Tag{0x0040, 0x0562}] = newInfo(Tag{0x0040, 0x0562}, "SQ", "IssuerOfTheSpecimenIdentifierSequence", "1", false)
+	tagDict[Tag{0x0040, 0x059A}] = newInfo(Tag{0x0040, 0x059A}, "SQ", "SpecimenTypeCodeSequence", "1", false)
+	tagDict[Tag{0x0040, 0x0600}] = newInfo(Tag{0x0040, 0x0600}, "LO", "SpecimenShortDescription", "1", false)
+	tagDict[Tag{0x0040, 0x0602}] = newInfo(Tag{0x0040, 0x0602}, "UT", "SpecimenDetailedDescription", "1", false)
+	tagDict[Tag{0x0040, 0x0610}] = newInfo(Tag{0x0040, 0x0610}, "SQ", "SpecimenPreparationSequence", "1", false)
+	tagDict[Tag{0x0040, 0x0612}] = newInfo(Tag{0x0040, 0x0612}, "SQ", "SpecimenPreparationStepContentItemSequence", "1", false)
+	tagDict[Tag{0x0040, 0x0620}] = newInfo(Tag{0x0040, 0x0620}, "SQ", "SpecimenLocalizationContentItemSequence", "1", false)
+	tagDict[Tag{0x0040, 0x071A}] = newInfo(Tag{0x0040, 0x071A}, "SQ", "ImageCenterPointCoordinatesSequence", "1", false)
+	tagDict[Tag{0x0040, 0x072A}] = newInfo(Tag{0x0040, 0x072A}, "DS", "XOffsetInSlideCoordinateSystem", "1", false)
+	tagDict[Tag{0x0040, 0x073A}] = newInfo(Tag{0x0040, 0x073A}, "DS", "YOffsetInSlideCoordinateSystem", "1", false)
+	tagDict[Tag{0x0040, 0x074A}] = newInfo(Tag{0x0040, 0x074A}, "DS", "ZOffsetInSlideCoordinateSystem", "1", false)
+	tagDict[Tag{0x0040, 0x08EA}] = newInfo(Tag{0x0040, 0x08EA}, "SQ", "MeasurementUnitsCodeSequence", "1", false)
+	tagDict[Tag{0x0040, 0x1001}] = newInfo(Tag{0x0040, 0x1001}, "SH", "RequestedProcedureID", "1", false)
+	tagDict[Tag{0x0040, 0x1002}] = newInfo(Tag{0x0040, 0x1002}, "LO", "ReasonForTheRequestedProcedure", "1", false)
+	tagDict[Tag{0x0040, 0x1003}] = newInfo(Tag{0x0040, 0x1003}, "SH", "RequestedProcedurePriority", "1", false)
+	tagDict[Tag{0x0040, 0x1004}] = newInfo(Tag{0x0040, 0x1004}, "LO", "PatientTransportArrangements", "1", false)
+	tagDict[Tag{0x0040, 0x1005}] = newInfo(Tag{0x0040, 0x1005}, "LO", "RequestedProcedureLocation", "1", false)
+	tagDict[Tag{0x0040, 0x1008}] = newInfo(Tag{0x0040, 0x1008}, "LO", "ConfidentialityCode", "1", false)
+	tagDict[Tag{0x0040, 0x1009}] = newInfo(Tag{0x0040, 0x1009}, "SH", "ReportingPriority", "1", false)
+	tagDict[Tag{0x0040, 0x100A}] = newInfo(Tag{0x0040, 0x100A}, "SQ", "ReasonForRequestedProcedureCodeSequence", "1", false)
+	tagDict[Tag{0x0040, 0x1010}] = newInfo(Tag{0x0040, 0x1010}, "PN", "NamesOfIntendedRecipientsOfResults", "1-n", false)
+	tagDict[Tag{0x0040, 0x1011}] = newInfo(Tag{0x0040, 0x1011}, "SQ", "IntendedRecipientsOfResultsIdentificationSequence", "1", false)
+	tagDict[Tag{0x0040, 0x1012}] = newInfo(Tag{0x0040, 0x1012}, "SQ", "ReasonForPerformedProcedureCodeSequence", "1", false)
+	tagDict[Tag{0x0040, 0x1101}] = newInfo(Tag{0x0040, 0x1101}, "SQ", "PersonIdentificationCodeSequence", "1", false)
+	tagDict[Tag{0x0040, 0x1102}] = newInfo(Tag{0x0040, 0x1102}, "ST", "PersonAddress", "1", false)
+	tagDict[Tag{0x0040, 0x1103}] = newInfo(Tag{0x0040, 0x1103}, "LO", "PersonTelephoneNumbers", "1-n", false)
+	tagDict[Tag{0x0040, 0x1400}] = newInfo(Tag{0x0040, 0x1400}, "LT", "RequestedProcedureComments", "1", false)
+	tagDict[Tag{0x0040, 0x2004}] = newInfo(Tag{0x0040, 0x2004}, "DA", "IssueDateOfImagingServiceRequest", "1", false)
+	tagDict[Tag{0x0040, 0x2005}] = newInfo(Tag{0x0040, 0x2005}, "TM", "IssueTimeOfImagingServiceRequest", "1", false)
+	tagDict[Tag{0x0040, 0x2008}] = newInfo(Tag{0x0040, 0x2008}, "PN", "OrderEnteredBy", "1", false)
+	tagDict[Tag{0x0040, 0x2009}] = newInfo(Tag{0x0040, 0x2009}, "SH", "OrderEntererLocation", "1", false)
+	tagDict[Tag{0x0040, 0x2010}] = newInfo(Tag{0x0040, 0x2010}, "SH", "OrderCallbackPhoneNumber", "1", false)
+	tagDict[Tag{0x0040, 0x2016}] = newInfo(Tag{0x0040, 0x2016}, "LO", "PlacerOrderNumberImagingServiceRequest", "1", false)
+	tagDict[Tag{0x0040, 0x2017}] = newInfo(Tag{0x0040, 0x2017}, "LO", "FillerOrderNumberImagingServiceRequest", "1", false)
+	tagDict[Tag{0x0040, 0x2400}] = newInfo(Tag{0x0040, 0x2400}, "LT", "ImagingServiceRequestComments", "1", false)
+	tagDict[Tag{0x0040, 0x3001}] = newInfo(Tag{0x0040, 0x3001}, "LO", "ConfidentialityConstraintOnPatientDataDescription", "1", false)
+	tagDict[Tag{0x0040, 0x4001}] = newInfo(Tag{0x0040, 0x4001}, "CS", "GeneralPurposeScheduledProcedureStepStatus", "1", false)
+	tagDict[Tag{0x0040, 0x4002}] = newInfo(Tag{0x0040, 0x4002}, "CS", "GeneralPurposePerformedProcedureStepStatus", "1", false)
+	tagDict[Tag{0x0040, 0x4003}] = newInfo(Tag{0x0040, 0x4003}, "CS", "GeneralPurposeScheduledProcedureStepPriority", "1", false)
+	tagDict[Tag{0x0040, 0x4004}] = newInfo(Tag{0x0040, 0x4004}, "SQ", "ScheduledProcessingApplicationsCodeSequence", "1", false)
+	tagDict[Tag{0x0040, 0x4005}] = newInfo(Tag{0x0040, 0x4005}, "DT", "ScheduledProcedureStepStartDateTime", "1", false)
+	tagDict[Tag{0x0040, 0x4006}] = newInfo(Tag{0x0040, 0x4006}, "CS", "MultipleCopiesFlag", "1", false)
+	tagDict[Tag{0x0040, 0x4007}] = newInfo(Tag{0x0040, 0x4007}, "SQ", "PerformedProcessingApplicationsCodeSequence", "1", false)
+	tagDict[Tag{0x0040, 0x4009}] = newInfo(Tag{0x0040, 0x4009}, "SQ", "HumanPerformerCodeSequence", "1", false)
+	tagDict[Tag{0x0040, 0x4010}] = newInfo(Tag{0x0040, 0x4010}, "DT", "ScheduledProcedureStepModificationDateTime", "1", false)
+	tagDict[Tag{0x0040, 0x4011}] = newInfo(Tag{0x0040, 0x4011}, "DT", "ExpectedCompletionDateTime", "1", false)
+	tagDict[Tag{0x0040, 0x4015}] = newInfo(Tag{0x0040, 0x4015}, "SQ", "ResultingGeneralPurposePerformedProcedureStepsSequence", "1", false)
+	tagDict[Tag{0x0040, 0x4016}] = newInfo(Tag{0x0040, 0x4016}, "SQ", "ReferencedGeneralPurposeScheduledProcedureStepSequence", "1", false)
+	tagDict[Tag{0x0040, 0x4018}] = newInfo(Tag{0x0040, 0x4018}, "SQ", "ScheduledWorkitemCodeSequence", "1", false)
+	tagDict[Tag{0x0040, 0x4019}] = newInfo(Tag{0x0040, 0x4019}, "SQ", "PerformedWorkitemCodeSequence", "1", false)
+	tagDict[Tag{0x0040, 0x4020}] = newInfo(Tag{0x0040, 0x4020}, "CS", "InputAvailabilityFlag", "1", false)
+	tagDict[Tag{0x0040, 0x4021}] = newInfo(Tag{0x0040, 0x4021}, "SQ", "InputInformationSequence", "1", false)
+	tagDict[Tag{0x0040, 0x4022}] = newInfo(Tag{0x0040, 0x4022}, "SQ", "RelevantInformationSequence", "1", false)
+	tagDict[Tag{0x0040, 0x4023}] = newInfo(Tag{0x0040, 0x4023}, "UI", "ReferencedGeneralPurposeScheduledProcedureStepTransactionUID", "1", false)
+	tagDict[Tag{0x0040, 0x4025}] = newInfo(Tag{0x0040, 0x4025}, "SQ", "ScheduledStationNameCodeSequence", "1", false)
+	tagDict[Tag{0x0040, 0x4026}] = newInfo(Tag{0x0040, 0x4026}, "SQ", "ScheduledStationClassCodeSequence", "1", false)
+	tagDict[Tag{0x0040, 0x4027}] = newInfo(Tag{0x0040, 0x4027}, "SQ", "ScheduledStationGeographicLocationCodeSequence", "1", false)
+	tagDict[Tag{0x0040, 0x4028}] = newInfo(Tag{0x0040, 0x4028}, "SQ", "PerformedStationNameCodeSequence", "1", false)
+	tagDict[Tag{0x0040, 0x4029}] = newInfo(Tag{0x0040, 0x4029}, "SQ", "PerformedStationClassCodeSequence", "1", false)
+	tagDict[Tag{0x0040, 0x4030}] = newInfo(Tag{0x0040, 0x4030}, "SQ", "PerformedStationGeographicLocationCodeSequence", "1", false)
+	tagDict[Tag{0x0040, 0x4031}] = newInfo(Tag{0x0040, 0x4031}, "SQ", "RequestedSubsequentWorkitemCodeSequence", "1", false)
+	tagDict[Tag{0x0040, 0x4032}] = newInfo(Tag{0x0040, 0x4032}, "SQ", "NonDICOMOutputCodeSequence", "1", false)
+	tagDict[Tag{0x0040, 0x4033}] = newInfo(Tag{0x0040, 0x4033}, "SQ", "OutputInformationSequence", "1", false)
+	tagDict[Tag{0x0040, 0x4034}] = newInfo(Tag{0x0040, 0x4034}, "SQ", "ScheduledHumanPerformersSequence", "1", false)
+	tagDict[Tag{0x0040, 0x4035}] = newInfo(Tag{0x0040, 0x4035}, "SQ", "ActualHumanPerformersSequence", "1", false)
+	tagDict[Tag{0x0040, 0x4036}] = newInfo(Tag{0x0040, 0x4036}, "LO", "HumanPerformerOrganization", "1", false)
+	tagDict[Tag{0x0040, 0x4037}] = newInfo(Tag{0x0040, 0x4037}, "PN", "HumanPerformerName", "1", false)
+	tagDict[Tag{0x0040, 0x4040}] = newInfo(Tag{0x0040, 0x4040}, "CS", "RawDataHandling", "1", false)
+	tagDict[Tag{0x0040, 0x4041}] = newInfo(Tag{0x0040, 0x4041}, "CS", "InputReadinessState", "1", false)
+	tagDict[Tag{0x0040, 0x4050}] = newInfo(Tag{0x0040, 0x4050}, "DT", "PerformedProcedureStepStartDateTime", "1", false)
+	tagDict[Tag{0x0040, 0x4051}] = newInfo(Tag{0x0040, 0x4051}, "DT", "PerformedProcedureStepEndDateTime", "1", false)
+	tagDict[Tag{0x0040, 0x4052}] = newInfo(Tag{0x0040, 0x4052}, "DT", "ProcedureStepCancellationDateTime", "1", false)
+	tagDict[Tag{0x0040, 0x8302}] = newInfo(Tag{0x0040, 0x8302}, "DS", "EntranceDoseInmGy", "1", false)
+	tagDict[Tag{0x0040, 0x9094}] = newInfo(Tag{0x0040, 0x9094}, "SQ", "ReferencedImageRealWorldValueMappingSequence", "1", false)
+	tagDict[Tag{0x0040, 0x9096}] = newInfo(Tag{0x0040, 0x9096}, "SQ", "RealWorldValueMappingSequence", "1", false)
+	tagDict[Tag{0x0040, 0x9098}] = newInfo(Tag{0x0040, 0x9098}, "SQ", "PixelValueMappingCodeSequence", "1", false)
+	tagDict[Tag{0x0040, 0x9210}] = newInfo(Tag{0x0040, 0x9210}, "SH", "LUTLabel", "1", false)
+	tagDict[Tag{0x0040, 0x9211}] = newInfo(Tag{0x0040, 0x9211}, "US or SS", "RealWorldValueLastValueMapped", "1", false)
+	tagDict[Tag{0x0040, 0x9212}] = newInfo(Tag{0x0040, 0x9212}, "FD", "RealWorldValueLUTData", "1-n", false)
+	tagDict[Tag{0x0040, 0x9216}] = newInfo(Tag{0x0040, 0x9216}, "US or SS", "RealWorldValueFirstValueMapped", "1", false)
+	tagDict[Tag{0x0040, 0x9224}] = newInfo(Tag{0x0040, 0x9224}, "FD", "RealWorldValueIntercept", "1", false)
+	tagDict[Tag{0x0040, 0x9225}] = newInfo(Tag{0x0040, 0x9225}, "FD", "RealWorldValueSlope", "1", false)
+	tagDict[Tag{0x0040, 0xA010}] = newInfo(Tag{0x0040, 0xA010}, "CS", "RelationshipType", "1", false)
+	tagDict[Tag{0x0040, 0xA027}] = newInfo(Tag{0x0040, 0xA027}, "LO", "VerifyingOrganization", "1", false)
+	tagDict[Tag{0x0040, 0xA030}] = newInfo(Tag{0x0040, 0xA030}, "DT", "VerificationDateTime", "1", false)
+	tagDict[Tag{0x0040, 0xA032}] = newInfo(Tag{0x0040, 0xA032}, "DT", "ObservationDateTime", "1", false)
+	tagDict[Tag{0x0040, 0xA040}] = newInfo(Tag{0x0040, 0xA040}, "CS", "ValueType", "1", false)
+	tagDict[Tag{0x0040, 0xA043}] = newInfo(Tag{0x0040, 0xA043}, "SQ", "ConceptNameCodeSequence", "1", false)
+	tagDict[Tag{0x0040, 0xA050}] = newInfo(Tag{0x0040, 0xA050}, "CS", "ContinuityOfContent", "1", false)
+	tagDict[Tag{0x0040, 0xA073}] = newInfo(Tag{0x0040, 0xA073}, "SQ", "VerifyingObserverSequence", "1", false)
+	tagDict[Tag{0x0040, 0xA075}] = newInfo(Tag{0x0040, 0xA075}, "PN", "VerifyingObserverName", "1", false)
+	tagDict[Tag{0x0040, 0xA078}] = newInfo(Tag{0x0040, 0xA078}, "SQ", "AuthorObserverSequence", "1", false)
+	tagDict[Tag{0x0040, 0xA07A}] = newInfo(Tag{0x0040, 0xA07A}, "SQ", "ParticipantSequence", "1", false)
+	tagDict[Tag{0x0040, 0xA07C}] = newInfo(Tag{0x0040, 0xA07C}, "SQ", "CustodialOrganizationSequence", "1", false)
+	tagDict[Tag{0x0040, 0xA080}] = newInfo(Tag{0x0040, 0xA080}, "CS", "ParticipationType", "1", false)
+	tagDict[Tag{0x0040, 0xA082}] = newInfo(Tag{0x0040, 0xA082}, "DT", "ParticipationDateTime", "1", false)
+	tagDict[Tag{0x0040, 0xA084}] = newInfo(Tag{0x0040, 0xA084}, "CS", "ObserverType", "1", false)
+	tagDict[Tag{0x0040, 0xA088}] = newInfo(Tag{0x0040, 0xA088}, "SQ", "VerifyingObserverIdentificationCodeSequence", "1", false)
+	tagDict[Tag{0x0040, 0xA0B0}] = newInfo(Tag{0x0040, 0xA0B0}, "US", "ReferencedWaveformChannels", "2-2n", false)
+	tagDict[Tag{0x0040, 0xA120}] = newInfo(Tag{0x0040, 0xA120}, "DT", "DateTime", "1", false)
+	tagDict[Tag{0x0040, 0xA121}] = newInfo(Tag{0x0040, 0xA121}, "DA", "Date", "1", false)
+	tagDict[Tag{0x0040, 0xA122}] = newInfo(Tag{0x0040, 0xA122}, "TM", "Time", "1", false)
+	tagDict[Tag{0x0040, 0xA123}] = newInfo(Tag{0x0040, 0xA123}, "PN", "PersonName", "1", false)
+	tagDict[Tag{0x0040, 0xA124}] = newInfo(Tag{0x0040, 0xA124}, "UI", "UID", "1", false)
+	tagDict[Tag{0x0040, 0xA130}] = newInfo(Tag{0x0040, 0xA130}, "CS", "TemporalRangeType", "1", false)
+	tagDict[Tag{0x0040, 0xA132}] = newInfo(Tag{0x0040, 0xA132}, "UL", "ReferencedSamplePositions", "1-n", false)
+	tagDict[Tag{0x0040, 0xA136}] = newInfo(Tag{0x0040, 0xA136}, "US", "ReferencedFrameNumbers", "1-n", false)
+	tagDict[Tag{0x0040, 0xA138}] = newInfo(Tag{0x0040, 0xA138}, "DS", "ReferencedTimeOffsets", "1-n", false)
+	tagDict[Tag{0x0040, 0xA13A}] = newInfo(Tag{0x0040, 0xA13A}, "DT", "ReferencedDateTime", "1-n", false)
+	tagDict[Tag{0x0040, 0xA160}] = newInfo(Tag{0x0040, 0xA160}, "UT", "TextValue", "1", false)
+	tagDict[Tag{0x0040, 0xA168}] = newInfo(Tag{0x0040, 0xA168}, "SQ", "ConceptCodeSequence", "1", false)
+	tagDict[Tag{0x0040, 0xA170}] = newInfo(Tag{0x0040, 0xA170}, "SQ", "PurposeOfReferenceCodeSequence", "1", false)
+	tagDict[Tag{0x0040, 0xA180}] = newInfo(Tag{0x0040, 0xA180}, "US", "AnnotationGroupNumber", "1", false)
+	tagDict[Tag{0x0040, 0xA195}] = newInfo(Tag{0x0040, 0xA195}, "SQ", "ModifierCodeSequence", "1", false)
+	tagDict[Tag{0x0040, 0xA300}] = newInfo(Tag{0x0040, 0xA300}, "SQ", "MeasuredValueSequence", "1", false)
+	tagDict[Tag{0x0040, 0xA301}] = newInfo(Tag{0x0040, 0xA301}, "SQ", "NumericValueQualifierCodeSequence", "1", false)
+	tagDict[Tag{0x0040, 0xA30A}] = newInfo(Tag{0x0040, 0xA30A}, "DS", "NumericValue", "1-n", false)
+	tagDict[Tag{0x0040, 0xA360}] = newInfo(Tag{0x0040, 0xA360}, "SQ", "PredecessorDocumentsSequence", "1", false)
+	tagDict[Tag{0x0040, 0xA370}] = newInfo(Tag{0x0040, 0xA370}, "SQ", "ReferencedRequestSequence", "1", false)
+	tagDict[Tag{0x0040, 0xA372}] = newInfo(Tag{0x0040, 0xA372}, "SQ", "PerformedProcedureCodeSequence", "1", false)
+	tagDict[Tag{0x0040, 0xA375}] = newInfo(Tag{0x0040, 0xA375}, "SQ", "CurrentRequestedProcedureEvidenceSequence", "1", false)
+	tagDict[Tag{0x0040, 0xA385}] = newInfo(Tag{0x0040, 0xA385}, "SQ", "PertinentOtherEvidenceSequence", "1", false)
+	tagDict[Tag{0x0040, 0xA390}] = newInfo(Tag{0x0040, 0xA390}, "SQ", "HL7StructuredDocumentReferenceSequence", "1", false)
+	tagDict[Tag{0x0040, 0xA491}] = newInfo(Tag{0x0040, 0xA491}, "CS", "CompletionFlag", "1", false)
+	tagDict[Tag{0x0040, 0xA492}] = newInfo(Tag{0x0040, 0xA492}, "LO", "CompletionFlagDescription", "1", false)
+	tagDict[Tag{0x0040, 0xA493}] = newInfo(Tag{0x0040, 0xA493}, "CS", "VerificationFlag", "1", false)
+	tagDict[Tag{0x0040, 0xA494}] = newInfo(Tag{0x0040, 0xA494}, "CS", "ArchiveRequested", "1", false)
+	tagDict[Tag{0x0040, 0xA496}] = newInfo(Tag{0x0040, 0xA496}, "CS", "PreliminaryFlag", "1", false)
+	tagDict[Tag{0x0040, 0xA504}] = newInfo(Tag{0x0040, 0xA504}, "SQ", "ContentTemplateSequence", "1", false)
+	tagDict[Tag{0x0040, 0xA525}] = newInfo(Tag{0x0040, 0xA525}, "SQ", "IdenticalDocumentsSequence", "1", false)
+	tagDict[Tag{0x0040, 0xA730}] = newInfo(Tag{0x0040, 0xA730}, "SQ", "ContentSequence", "1", false)
+	tagDict[Tag{0x0040, 0xB020}] = newInfo(Tag{0x0040, 0xB020}, "SQ", "WaveformAnnotationSequence", "1", false)
+	tagDict[Tag{0x0040, 0xDB00}] = newInfo(Tag{0x0040, 0xDB00}, "CS", "TemplateIdentifier", "1", false)
+	tagDict[Tag{0x0040, 0xDB73}] = newInfo(Tag{0x0040, 0xDB73}, "UL", "ReferencedContentItemIdentifier", "1-n", false)
+	tagDict[Tag{0x0040, 0xE001}] = newInfo(Tag{0x0040, 0xE001}, "ST", "HL7InstanceIdentifier", "1", false)
+	tagDict[Tag{0x0040, 0xE004}] = newInfo(Tag{0x0040, 0xE004}, "DT", "HL7DocumentEffectiveTime", "1", false)
+	tagDict[Tag{0x0040, 0xE006}] = newInfo(Tag{0x0040, 0xE006}, "SQ", "HL7DocumentTypeCodeSequence", "1", false)
+	tagDict[Tag{0x0040, 0xE008}] = newInfo(Tag{0x0040, 0xE008}, "SQ", "DocumentClassCodeSequence", "1", false)
+	tagDict[Tag{0x0040, 0xE010}] = newInfo(Tag{0x0040, 0xE010}, "UT", "RetrieveURI", "1", false)
+	tagDict[Tag{0x0040, 0xE011}] = newInfo(Tag{0x0040, 0xE011}, "UI", "RetrieveLocationUID", "1", false)
+	tagDict[Tag{0x0040, 0xE020}] = newInfo(Tag{0x0040, 0xE020}, "CS", "TypeOfInstances", "1", false)
+	tagDict[Tag{0x0040, 0xE021}] = newInfo(Tag{0x0040, 0xE021}, "SQ", "DICOMRetrievalSequence", "1", false)
+	tagDict[Tag{0x0040, 0xE022}] = newInfo(Tag{0x0040, 0xE022}, "SQ", "DICOMMediaRetrievalSequence", "1", false)
+	tagDict[Tag{0x0040, 0xE023}] = newInfo(Tag{0x0040, 0xE023}, "SQ", "WADORetrievalSequence", "1", false)
+	tagDict[Tag{0x0040, 0xE024}] = newInfo(Tag{0x0040, 0xE024}, "SQ", "XDSRetrievalSequence", "1", false)
+	tagDict[Tag{0x0040, 0xE030}] = newInfo(Tag{0x0040, 0xE030}, "UI", "RepositoryUniqueID", "1", false)
+	tagDict[Tag{0x0040, 0xE031}] = newInfo(Tag{0x0040, 0xE031}, "UI", "HomeCommunityID", "1", false)
+	tagDict[Tag{0x0042, 0x0010}] = newInfo(Tag{0x0042, 0x0010}, "ST", "DocumentTitle", "1", false)
+	tagDict[Tag{0x0042, 0x0011}] = newInfo(Tag{0x0042, 0x0011}, "OB", "EncapsulatedDocument", "1", false)
+	tagDict[Tag{0x0042, 0x0012}] = newInfo(Tag{0x0042, 0x0012}, "LO", "MIMETypeOfEncapsulatedDocument", "1", false)
+	tagDict[Tag{0x0042, 0x0013}] = newInfo(Tag{0x0042, 0x0013}, "SQ", "SourceInstanceSequence", "1", false)
+	tagDict[Tag{0x0042, 0x0014}] = newInfo(Tag{0x0042, 0x0014}, "LO", "ListOfMIMETypes", "1-n", false)
+	tagDict[Tag{0x0044, 0x0001}] = newInfo(Tag{0x0044, 0x0001}, "ST", "ProductPackageIdentifier", "1", false)
+	tagDict[Tag{0x0044, 0x0002}] = newInfo(Tag{0x0044, 0x0002}, "CS", "SubstanceAdministrationApproval", "1", false)
+	tagDict[Tag{0x0044, 0x0003}] = newInfo(Tag{0x0044, 0x0003}, "LT", "ApprovalStatusFurtherDescription", "1", false)
+	tagDict[Tag{0x0044, 0x0004}] = newInfo(Tag{0x0044, 0x0004}, "DT", "ApprovalStatusDateTime", "1", false)
+	tagDict[Tag{0x0044, 0x0007}] = newInfo(Tag{0x0044, 0x0007}, "SQ", "ProductTypeCodeSequence", "1", false)
+	tagDict[Tag{0x0044, 0x0008}] = newInfo(Tag{0x0044, 0x0008}, "LO", "ProductName", "1-n", false)
+	tagDict[Tag{0x0044, 0x0009}] = newInfo(Tag{0x0044, 0x0009}, "LT", "ProductDescription", "1", false)
+	tagDict[Tag{0x0044, 0x000A}] = newInfo(Tag{0x0044, 0x000A}, "LO", "ProductLotIdentifier", "1", false)
+	tagDict[Tag{0x0044, 0x000B}] = newInfo(Tag{0x0044, 0x000B}, "DT", "ProductExpirationDateTime", "1", false)
+	tagDict[Tag{0x0044, 0x0010}] = newInfo(Tag{0x0044, 0x0010}, "DT", "SubstanceAdministrationDateTime", "1", false)
+	tagDict[Tag{0x0044, 0x0011}] = newInfo(Tag{0x0044, 0x0011}, "LO", "SubstanceAdministrationNotes", "1", false)
+	tagDict[Tag{0x0044, 0x0012}] = newInfo(Tag{0x0044, 0x0012}, "LO", "SubstanceAdministrationDeviceID", "1", false)
+	tagDict[Tag{0x0044, 0x0013}] = newInfo(Tag{0x0044, 0x0013}, "SQ", "ProductParameterSequence", "1", false)
+	tagDict[Tag{0x0044, 0x0019}] = newInfo(Tag{0x0044, 0x0019}, "SQ", "SubstanceAdministrationParameterSequence", "1", false)
+	tagDict[Tag{0x0046, 0x0012}] = newInfo(Tag{0x0046, 0x0012}, "LO", "LensDescription", "1", false)
+	tagDict[Tag{0x0046, 0x0014}] = newInfo(Tag{0x0046, 0x0014}, "SQ", "RightLensSequence", "1", false)
+	tagDict[Tag{0x0046, 0x0015}] = newInfo(Tag{0x0046, 0x0015}, "SQ", "LeftLensSequence", "1", false)
+	tagDict[Tag{0x0046, 0x0016}] = newInfo(Tag{0x0046, 0x0016}, "SQ", "UnspecifiedLateralityLensSequence", "1", false)
+	tagDict[Tag{0x0046, 0x0018}] = newInfo(Tag{0x0046, 0x0018}, "SQ", "CylinderSequence", "1", false)
+	tagDict[Tag{0x0046, 0x0028}] = newInfo(Tag{0x0046, 0x0028}, "SQ", "PrismSequence", "1", false)
+	tagDict[Tag{0x0046, 0x0030}] = newInfo(Tag{0x0046, 0x0030}, "FD", "HorizontalPrismPower", "1", false)
+	tagDict[Tag{0x0046, 0x0032}] = newInfo(Tag{0x0046, 0x0032}, "CS", "HorizontalPrismBase", "1", false)
+	tagDict[Tag{0x0046, 0x0034}] = newInfo(Tag{0x0046, 0x0034}, "FD", "VerticalPrismPower", "1", false)
+	tagDict[Tag{0x0046, 0x0036}] = newInfo(Tag{0x0046, 0x0036}, "CS", "VerticalPrismBase", "1", false)
+	tagDict[Tag{0x0046, 0x0038}] = newInfo(Tag{0x0046, 0x0038}, "CS", "LensSegmentType", "1", false)
+	tagDict[Tag{0x0046, 0x0040}] = newInfo(Tag{0x0046, 0x0040}, "FD", "OpticalTransmittance", "1", false)
+	tagDict[Tag{0x0046, 0x0042}] = newInfo(Tag{0x0046, 0x0042}, "FD", "ChannelWidth", "1", false)
+	tagDict[Tag{0x0046, 0x0044}] = newInfo(Tag{0x0046, 0x0044}, "FD", "PupilSize", "1", false)
+	tagDict[Tag{0x0046, 0x0046}] = newInfo(Tag{0x0046, 0x0046}, "FD", "CornealSize", "1", false)
+	tagDict[Tag{0x0046, 0x0050}] = newInfo(Tag{0x0046, 0x0050}, "SQ", "AutorefractionRightEyeSequence", "1", false)
+	tagDict[Tag{0x0046, 0x0052}] = newInfo(Tag{0x0046, 0x0052}, "SQ", "AutorefractionLeftEyeSequence", "1", false)
+	tagDict[Tag{0x0046, 0x0060}] = newInfo(Tag{0x0046, 0x0060}, "FD", "DistancePupillaryDistance", "1", false)
+	tagDict[Tag{0x0046, 0x0062}] = newInfo(Tag{0x0046, 0x0062}, "FD", "NearPupillaryDistance", "1", false)
+	tagDict[Tag{0x0046, 0x0063}] = newInfo(Tag{0x0046, 0x0063}, "FD", "IntermediatePupillaryDistance", "1", false)
+	tagDict[Tag{0x0046, 0x0064}] = newInfo(Tag{0x0046, 0x0064}, "FD", "OtherPupillaryDistance", "1", false)
+	tagDict[Tag{0x0046, 0x0070}] = newInfo(Tag{0x0046, 0x0070}, "SQ", "KeratometryRightEyeSequence", "1", false)
+	tagDict[Tag{0x0046, 0x0071}] = newInfo(Tag{0x0046, 0x0071}, "SQ", "KeratometryLeftEyeSequence", "1", false)
+	tagDict[Tag{0x0046, 0x0074}] = newInfo(Tag{0x0046, 0x0074}, "SQ", "SteepKeratometricAxisSequence", "1", false)
+	tagDict[Tag{0x0046, 0x0075}] = newInfo(Tag{0x0046, 0x0075}, "FD", "RadiusOfCurvature", "1", false)
+	tagDict[Tag{0x0046, 0x0076}] = newInfo(Tag{0x0046, 0x0076}, "FD", "KeratometricPower", "1", false)
+	tagDict[Tag{0x0046, 0x0077}] = newInfo(Tag{0x0046, 0x0077}, "FD", "KeratometricAxis", "1", false)
+	tagDict[Tag{0x0046, 0x0080}] = newInfo(Tag{0x0046, 0x0080}, "SQ", "FlatKeratometricAxisSequence", "1", false)
+	tagDict[Tag{0x0046, 0x0092}] = newInfo(Tag{0x0046, 0x0092}, "CS", "BackgroundColor", "1", false)
+	tagDict[Tag{0x0046, 0x0094}] = newInfo(Tag{0x0046, 0x0094}, "CS", "Optotype", "1", false)
+	tagDict[Tag{0x0046, 0x0095}] = newInfo(Tag{0x0046, 0x0095}, "CS", "OptotypePresentation", "1", false)
+	tagDict[Tag{0x0046, 0x0097}] = newInfo(Tag{0x0046, 0x0097}, "SQ", "SubjectiveRefractionRightEyeSequence", "1", false)
+	tagDict[Tag{0x0046, 0x0098}] = newInfo(Tag{0x0046, 0x0098}, "SQ", "SubjectiveRefractionLeftEyeSequence", "1", false)
+	tagDict[Tag{0x0046, 0x0100}] = newInfo(Tag{0x0046, 0x0100}, "SQ", "AddNearSequence", "1", false)
+	tagDict[Tag{0x0046, 0x0101}] = newInfo(Tag{0x0046, 0x0101}, "SQ", "AddIntermediateSequence", "1", false)
+	tagDict[Tag{0x0046, 0x0102}] = newInfo(Tag{0x0046, 0x0102}, "SQ", "AddOtherSequence", "1", false)
+	tagDict[Tag{0x0046, 0x0104}] = newInfo(Tag{0x0046, 0x0104}, "FD", "AddPower", "1", false)
+	tagDict[Tag{0x0046, 0x0106}] = newInfo(Tag{0x0046, 0x0106}, "FD", "ViewingDistance", "1", false)
+	tagDict[Tag{0x0046, 0x0121}] = newInfo(Tag{0x0046, 0x0121}, "SQ", "VisualAcuityTypeCodeSequence", "1", false)
+	tagDict[Tag{0x0046, 0x0122}] = newInfo(Tag{0x0046, 0x0122}, "SQ", "VisualAcuityRightEyeSequence", "1", false)
+	tagDict[Tag{0x0046, 0x0123}] = newInfo(Tag{0x0046, 0x0123}, "SQ", "VisualAcuityLeftEyeSequence", "1", false)
+	tagDict[Tag{0x0046, 0x0124}] = newInfo(Tag{0x0046, 0x0124}, "SQ", "VisualAcuityBothEyesOpenSequence", "1", false)
+	tagDict[Tag{0x0046, 0x0125}] = newInfo(Tag{0x0046, 0x0125}, "CS", "ViewingDistanceType", "1", false)
+	tagDict[Tag{0x0046, 0x0135}] = newInfo(Tag{0x0046, 0x0135}, "SS", "VisualAcuityModifiers", "2", false)
+	tagDict[Tag{0x0046, 0x0137}] = newInfo(Tag{0x0046, 0x0137}, "FD", "DecimalVisualAcuity", "1", false)
+	tagDict[Tag{0x0046, 0x0139}] = newInfo(Tag{0x0046, 0x0139}, "LO", "OptotypeDetailedDefinition", "1", false)
+	tagDict[Tag{0x0046, 0x0145}] = newInfo(Tag{0x0046, 0x0145}, "SQ", "ReferencedRefractiveMeasurementsSequence", "1", false)
+	tagDict[Tag{0x0046, 0x0146}] = newInfo(Tag{0x0046, 0x0146}, "FD", "SpherePower", "1", false)
+	tagDict[Tag{0x0046, 0x0147}] = newInfo(Tag{0x0046, 0x0147}, "FD", "CylinderPower", "1", false)
+	tagDict[Tag{0x0048, 0x0001}] = newInfo(Tag{0x0048, 0x0001}, "FL", "ImagedVolumeWidth", "1", false)
+	tagDict[Tag{0x0048, 0x0002}] = newInfo(Tag{0x0048, 0x0002}, "FL", "ImagedVolumeHeight", "1", false)
+	tagDict[Tag{0x0048, 0x0003}] = newInfo(Tag{0x0048, 0x0003}, "FL", "ImagedVolumeDepth", "1", false)
+	tagDict[Tag{0x0048, 0x0006}] = newInfo(Tag{0x0048, 0x0006}, "UL", "TotalPixelMatrixColumns", "1", false)
+	tagDict[Tag{0x0048, 0x0007}] = newInfo(Tag{0x0048, 0x0007}, "UL", "TotalPixelMatrixRows", "1", false)
+	tagDict[Tag{0x0048, 0x0008}] = newInfo(Tag{0x0048, 0x0008}, "SQ", "TotalPixelMatrixOriginSequence", "1", false)
+	tagDict[Tag{0x0048, 0x0010}] = newInfo(Tag{0x0048, 0x0010}, "CS", "SpecimenLabelInImage", "1", false)
+	tagDict[Tag{0x0048, 0x0011}] = newInfo(Tag{0x0048, 0x0011}, "CS", "FocusMethod", "1", false)
+	tagDict[Tag{0x0048, 0x0012}] = newInfo(Tag{0x0048, 0x0012}, "CS", "ExtendedDepthOfField", "1", false)
+	tagDict[Tag{0x0048, 0x0013}] = newInfo(Tag{0x0048, 0x0013}, "US", "NumberOfFocalPlanes", "1", false)
+	tagDict[Tag{0x0048, 0x0014}] = newInfo(Tag{0x0048, 0x0014}, "FL", "DistanceBetweenFocalPlanes", "1", false)
+	tagDict[Tag{0x0048, 0x0015}] = newInfo(Tag{0x0048, 0x0015}, "US", "RecommendedAbsentPixelCIELabValue", "3", false)
+	tagDict[Tag{0x0048, 0x0100}] = newInfo(Tag{0x0048, 0x0100}, "SQ", "IlluminatorTypeCodeSequence", "1", false)
+	tagDict[Tag{0x0048, 0x0102}] = newInfo(Tag{0x0048, 0x0102}, "DS", "ImageOrientationSlide", "6", false)
+	tagDict[Tag{0x0048, 0x0105}] = newInfo(Tag{0x0048, 0x0105}, "SQ", "OpticalPathSequence", "1", false)
+	tagDict[Tag{0x0048, 0x0106}] = newInfo(Tag{0x0048, 0x0106}, "SH", "OpticalPathIdentifier", "1", false)
+	tagDict[Tag{0x0048, 0x0107}] = newInfo(Tag{0x0048, 0x0107}, "ST", "OpticalPathDescription", "1", false)
+	tagDict[Tag{0x0048, 0x0108}] = newInfo(Tag{0x0048, 0x0108}, "SQ", "IlluminationColorCodeSequence", "1", false)
+	tagDict[Tag{0x0048, 0x0110}] = newInfo(Tag{0x0048, 0x0110}, "SQ", "SpecimenReferenceSequence", "1", false)
+	tagDict[Tag{0x0048, 0x0111}] = newInfo(Tag{0x0048, 0x0111}, "DS", "CondenserLensPower", "1", false)
+	tagDict[Tag{0x0048, 0x0112}] = newInfo(Tag{0x0048, 0x0112}, "DS", "ObjectiveLensPower", "1", false)
+	tagDict[Tag{0x0048, 0x0113}] = newInfo(Tag{0x0048, 0x0113}, "DS", "ObjectiveLensNumericalAperture", "1", false)
+	tagDict[Tag{0x0048, 0x0120}] = newInfo(Tag{0x0048, 0x0120}, "SQ", "PaletteColorLookupTableSequence", "1", false)
+	tagDict[Tag{0x0048, 0x0200}] = newInfo(Tag{0x0048, 0x0200}, "SQ", "ReferencedImageNavigationSequence", "1", false)
+	tagDict[Tag{0x0048, 0x0201}] = newInfo(Tag{0x0048, 0x0201}, "US", "TopLeftHandCornerOfLocalizerArea", "2", false)
+	tagDict[Tag{0x0048, 0x0202}] = newInfo(Tag{0x0048, 0x0202}, "US", "BottomRightHandCornerOfLocalizerArea", "2", false)
+	tagDict[Tag{0x0048, 0x0207}] = newInfo(Tag{0x0048, 0x0207}, "SQ", "OpticalPathIdentificationSequence", "1", false)
+	tagDict[Tag{0x0048, 0x021A}] = newInfo(Tag{0x0048, 0x021A}, "SQ", "PlanePositionSlideSequence", "1", false)
+	tagDict[Tag{0x0048, 0x021E}] = newInfo(Tag{0x0048, 0x021E}, "SL", "ColumnPositionInTotalImagePixelMatrix", "1", false)
+	tagDict[Tag{0x0048, 0x021F}] = newInfo(Tag{0x0048, 0x021F}, "SL", "RowPositionInTotalImagePixelMatrix", "1", false)
+	tagDict[Tag{0x0048, 0x0301}] = newInfo(Tag{0x0048, 0x0301}, "CS", "PixelOriginInterpretation", "1", false)
+	tagDict[Tag{0x0050, 0x0004}] = newInfo(Tag{0x0050, 0x0004}, "CS", "CalibrationImage", "1", false)
+	tagDict[Tag{0x0050, 0x0010}] = newInfo(Tag{0x0050, 0x0010}, "SQ", "DeviceSequence", "1", false)
+	tagDict[Tag{0x0050, 0x0012}] = newInfo(Tag{0x0050, 0x0012}, "SQ", "ContainerComponentTypeCodeSequence", "1", false)
+	tagDict[Tag{0x0050, 0x0013}] = newInfo(Tag{0x0050, 0x0013}, "FD", "ContainerComponentThickness", "1", false)
+	tagDict[Tag{0x0050, 0x0014}] = newInfo(Tag{0x0050, 0x0014}, "DS", "DeviceLength", "1", false)
+	tagDict[Tag{0x0050, 0x0015}] = newInfo(Tag{0x0050, 0x0015}, "FD", "ContainerComponentWidth", "1", false)
+	tagDict[Tag{0x0050, 0x0016}] = newInfo(Tag{0x0050, 0x0016}, "DS", "DeviceDiameter", "1", false)
+	tagDict[Tag{0x0050, 0x0017}] = newInfo(Tag{0x0050, 0x0017}, "CS", "DeviceDiameterUnits", "1", false)
+	tagDict[Tag{0x0050, 0x0018}] = newInfo(Tag{0x0050, 0x0018}, "DS", "DeviceVolume", "1", false)
+	tagDict[Tag{0x0050, 0x0019}] = newInfo(Tag{0x0050, 0x0019}, "DS", "InterMarkerDistance", "1", false)
+	tagDict[Tag{0x0050, 0x001A}] = newInfo(Tag{0x0050, 0x001A}, "CS", "ContainerComponentMaterial", "1", false)
+	tagDict[Tag{0x0050, 0x001B}] = newInfo(Tag{0x0050, 0x001B}, "LO", "ContainerComponentID", "1", false)
+	tagDict[Tag{0x0050, 0x001C}] = newInfo(Tag{0x0050, 0x001C}, "FD", "ContainerComponentLength", "1", false)
+	tagDict[Tag{0x0050, 0x001D}] = newInfo(Tag{0x0050, 0x001D}, "FD", "ContainerComponentDiameter", "1", false)
+	tagDict[Tag{0x0050, 0x001E}] = newInfo(Tag{0x0050, 0x001E}, "LO", "ContainerComponentDescription", "1", false)
+	tagDict[Tag{0x0050, 0x0020}] = newInfo(Tag{0x0050, 0x0020}, "LO", "DeviceDescription", "1", false)
+	tagDict[Tag{0x0052, 0x0001}] = newInfo(Tag{0x0052, 0x0001}, "FL", "ContrastBolusIngredientPercentByVolume", "1", false)
+	tagDict[Tag{0x0052, 0x0002}] = newInfo(Tag{0x0052, 0x0002}, "FD", "OCTFocalDistance", "1", false)
+	tagDict[Tag{0x0052, 0x0003}] = newInfo(Tag{0x0052, 0x0003}, "FD", "BeamSpotSize", "1", false)
+	tagDict[Tag{0x0052, 0x0004}] = newInfo(Tag{0x0052, 0x0004}, "FD", "EffectiveRefractiveIndex", "1", false)
+	tagDict[Tag{0x0052, 0x0006}] = newInfo(Tag{0x0052, 0x0006}, "CS", "OCTAcquisitionDomain", "1", false)
+	tagDict[Tag{0x0052, 0x0007}] = newInfo(Tag{0x0052, 0x0007}, "FD", "OCTOpticalCenterWavelength", "1", false)
+	tagDict[Tag{0x0052, 0x0008}] = newInfo(Tag{0x0052, 0x0008}, "FD", "AxialResolution", "1", false)
+	tagDict[Tag{0x0052, 0x0009}] = newInfo(Tag{0x0052, 0x0009}, "FD", "RangingDepth", "1", false)
+	tagDict[Tag{0x0052, 0x0011}] = newInfo(Tag{0x0052, 0x0011}, "FD", "ALineRate", "1", false)
+	tagDict[Tag{0x0052, 0x0012}] = newInfo(Tag{0x0052, 0x0012}, "US", "ALinesPerFrame", "1", false)
+	tagDict[Tag{0x0052, 0x0013}] = newInfo(Tag{0x0052, 0x0013}, "FD", "CatheterRotationalRate", "1", false)
+	tagDict[Tag{0x0052, 0x0014}] = newInfo(Tag{0x0052, 0x0014}, "FD", "ALinePixelSpacing", "1", false)
+	tagDict[Tag{0x0052, 0x0016}] = newInfo(Tag{0x0052, 0x0016}, "SQ", "ModeOfPercutaneousAccessSequence", "1", false)
+	tagDict[Tag{0x0052, 0x0025}] = newInfo(Tag{0x0052, 0x0025}, "SQ", "IntravascularOCTFrameTypeSequence", "1", false)
+	tagDict[Tag{0x0052, 0x0026}] = newInfo(Tag{0x0052, 0x0026}, "CS", "OCTZOffsetApplied", "1", false)
+	tagDict[Tag{0x0052, 0x0027}] = newInfo(Tag{0x0052, 0x0027}, "SQ", "IntravascularFrameContentSequence", "1", false)
+	tagDict[Tag{0x0052, 0x0028}] = newInfo(Tag{0x0052, 0x0028}, "FD", "IntravascularLongitudinalDistance", "1", false)
+	tagDict[Tag{0x0052, 0x0029}] = newInfo(Tag{0x0052, 0x0029}, "SQ", "IntravascularOCTFrameContentSequence", "1", false)
+	tagDict[Tag{0x0052, 0x0030}] = newInfo(Tag{0x0052, 0x0030}, "SS", "OCTZOffsetCorrection", "1", false)
+	tagDict[Tag{0x0052, 0x0031}] = newInfo(Tag{0x0052, 0x0031}, "CS", "CatheterDirectionOfRotation", "1", false)
+	tagDict[Tag{0x0052, 0x0033}] = newInfo(Tag{0x0052, 0x0033}, "FD", "SeamLineLocation", "1", false)
+	tagDict[Tag{0x0052, 0x0034}] = newInfo(Tag{0x0052, 0x0034}, "FD", "FirstALineLocation", "1", false)
+	tagDict[Tag{0x0052, 0x0036}] = newInfo(Tag{0x0052, 0x0036}, "US", "SeamLineIndex", "1", false)
+	tagDict[Tag{0x0052, 0x0038}] = newInfo(Tag{0x0052, 0x0038}, "US", "NumberOfPaddedAlines", "1", false)
+	tagDict[Tag{0x0052, 0x0039}] = newInfo(Tag{0x0052, 0x0039}, "CS", "InterpolationType", "1", false)
+	tagDict[Tag{0x0052, 0x003A}] = newInfo(Tag{0x0052, 0x003A}, "CS", "RefractiveIndexApplied", "1", false)
+	tagDict[Tag{0x0054, 0x0010}] = newInfo(Tag{0x0054, 0x0010}, "US", "EnergyWindowVector", "1-n", false)
+	tagDict[Tag{0x0054, 0x0011}] = newInfo(Tag{0x0054, 0x0011}, "US", "NumberOfEnergyWindows", "1", false)
+	tagDict[Tag{0x0054, 0x0012}] = newInfo(Tag{0x0054, 0x0012}, "SQ", "EnergyWindowInformationSequence", "1", false)
+	tagDict[Tag{0x0054, 0x0013}] = newInfo(Tag{0x0054, 0x0013}, "SQ", "EnergyWindowRangeSequence", "1", false)
+	tagDict[Tag{0x0054, 0x0014}] = newInfo(Tag{0x0054, 0x0014}, "DS", "EnergyWindowLowerLimit", "1", false)
+	tagDict[Tag{0x0054, 0x0015}] = newInfo(Tag{0x0054, 0x0015}, "DS", "EnergyWindowUpperLimit", "1", false)
+	tagDict[Tag{0x0054, 0x0016}] = newInfo(Tag{0x0054, 0x0016}, "SQ", "RadiopharmaceuticalInformationSequence", "1", false)
+	tagDict[Tag{0x0054, 0x0017}] = newInfo(Tag{0x0054, 0x0017}, "IS", "ResidualSyringeCounts", "1", false)
+	tagDict[Tag{0x0054, 0x0018}] = newInfo(Tag{0x0054, 0x0018}, "SH", "EnergyWindowName", "1", false)
+	tagDict[Tag{0x0054, 0x0020}] = newInfo(Tag{0x0054, 0x0020}, "US", "DetectorVector", "1-n", false)
+	tagDict[Tag{0x0054, 0x0021}] = newInfo(Tag{0x0054, 0x0021}, "US", "NumberOfDetectors", "1", false)
+	tagDict[Tag{0x0054, 0x0022}] = newInfo(Tag{0x0054, 0x0022}, "SQ", "DetectorInformationSequence", "1", false)
+	tagDict[Tag{0x0054, 0x0030}] = newInfo(Tag{0x0054, 0x0030}, "US", "PhaseVector", "1-n", false)
+	tagDict[Tag{0x0054, 0x0031}] = newInfo(Tag{0x0054, 0x0031}, "US", "NumberOfPhases", "1", false)
+	tagDict[Tag{0x0054, 0x0032}] = newInfo(Tag{0x0054, 0x0032}, "SQ", "PhaseInformationSequence", "1", false)
+	tagDict[Tag{0x0054, 0x0033}] = newInfo(Tag{0x0054, 0x0033}, "US", "NumberOfFramesInPhase", "1", false)
+	tagDict[Tag{0x0054, 0x0036}] = newInfo(Tag{0x0054, 0x0036}, "IS", "PhaseDelay", "1", false)
+	tagDict[Tag{0x0054, 0x0038}] = newInfo(Tag{0x0054, 0x0038}, "IS", "PauseBetweenFrames", "1", false)
+	tagDict[Tag{0x0054, 0x0039}] = newInfo(Tag{0x0054, 0x0039}, "CS", "PhaseDescription", "1", false)
+	tagDict[Tag{0x0054, 0x0050}] = newInfo(Tag{0x0054, 0x0050}, "US", "RotationVector", "1-n", false)
+	tagDict[Tag{0x0054, 0x0051}] = newInfo(Tag{0x0054, 0x0051}, "US", "NumberOfRotations", "1", false)
+	tagDict[Tag{0x0054, 0x0052}] = newInfo(Tag{0x0054, 0x0052}, "SQ", "RotationInformationSequence", "1", false)
+	tagDict[Tag{0x0054, 0x0053}] = newInfo(Tag{0x0054, 0x0053}, "US", "NumberOfFramesInRotation", "1", false)
+	tagDict[Tag{0x0054, 0x0060}] = newInfo(Tag{0x0054, 0x0060}, "US", "RRIntervalVector", "1-n", false)
+	tagDict[Tag{0x0054, 0x0061}] = newInfo(Tag{0x0054, 0x0061}, "US", "NumberOfRRIntervals", "1", false)
+	tagDict[Tag{0x0054, 0x0062}] = newInfo(Tag{0x0054, 0x0062}, "SQ", "GatedInformationSequence", "1", false)
+	tagDict[Tag{0x0054, 0x0063}] = newInfo(Tag{0x0054, 0x0063}, "SQ", "DataInformationSequence", "1", false)
+	tagDict[Tag{0x0054, 0x0070}] = newInfo(Tag{0x0054, 0x0070}, "US", "TimeSlotVector", "1-n", false)
+	tagDict[Tag{0x0054, 0x0071}] = newInfo(Tag{0x0054, 0x0071}, "US", "NumberOfTimeSlots", "1", false)
+	tagDict[Tag{0x0054, 0x0072}] = newInfo(Tag{0x0054, 0x0072}, "SQ", "TimeSlotInformationSequence", "1", false)
+	tagDict[Tag{0x0054, 0x0073}] = newInfo(Tag{0x0054, 0x0073}, "DS", "TimeSlotTime", "1", false)
+	tagDict[Tag{0x0054, 0x0080}] = newInfo(Tag{0x0054, 0x0080}, "US", "SliceVector", "1-n", false)
+	tagDict[Tag{0x0054, 0x0081}] = newInfo(Tag{0x0054, 0x0081}, "US", "NumberOfSlices", "1", false)
+	tagDict[Tag{0x0054, 0x0090}] = newInfo(Tag{0x0054, 0x0090}, "US", "AngularViewVector", "1-n", false)
+	tagDict[Tag{0x0054, 0x0100}] = newInfo(Tag{0x0054, 0x0100}, "US", "TimeSliceVector", "1-n", false)
+	tagDict[Tag{0x0054, 0x0101}] = newInfo(Tag{0x0054, 0x0101}, "US", "NumberOfTimeSlices", "1", false)
+	tagDict[Tag{0x0054, 0x0200}] = newInfo(Tag{0x0054, 0x0200}, "DS", "StartAngle", "1", false)
+	tagDict[Tag{0x0054, 0x0202}] = newInfo(Tag{0x0054, 0x0202}, "CS", "TypeOfDetectorMotion", "1", false)
+	tagDict[Tag{0x0054, 0x0210}] = newInfo(Tag{0x0054, 0x0210}, "IS", "TriggerVector", "1-n", false)
+	tagDict[Tag{0x0054, 0x0211}] = newInfo(Tag{0x0054, 0x0211}, "US", "NumberOfTriggersInPhase", "1", false)
+	tagDict[Tag{0x0054, 0x0220}] = newInfo(Tag{0x0054, 0x0220}, "SQ", "ViewCodeSequence", "1", false)
+	tagDict[Tag{0x0054, 0x0222}] = newInfo(Tag{0x0054, 0x0222}, "SQ", "ViewModifierCodeSequence", "1", false)
+	tagDict[Tag{0x0054, 0x0300}] = newInfo(Tag{0x0054, 0x0300}, "SQ", "RadionuclideCodeSequence", "1", false)
+	tagDict[Tag{0x0054, 0x0302}] = newInfo(Tag{0x0054, 0x0302}, "SQ", "AdministrationRouteCodeSequence", "1", false)
+	tagDict[Tag{0x0054, 0x0304}] = newInfo(Tag{0x0054, 0x0304}, "SQ", "RadiopharmaceuticalCodeSequence", "1", false)
+	tagDict[Tag{0x0054, 0x0306}] = newInfo(Tag{0x0054, 0x0306}, "SQ", "CalibrationDataSequence", "1", false)
+	tagDict[Tag{0x0054, 0x0308}] = newInfo(Tag{0x0054, 0x0308}, "US", "EnergyWindowNumber", "1", false)
+	tagDict[Tag{0x0054, 0x0400}] = newInfo(Tag{0x0054, 0x0400}, "SH", "ImageID", "1", false)
+	tagDict[Tag{0x0054, 0x0410}] = newInfo(Tag{0x0054, 0x0410}, "SQ", "PatientOrientationCodeSequence", "1", false)
+	tagDict[Tag{0x0054, 0x0412}] = newInfo(Tag{0x0054, 0x0412}, "SQ", "PatientOrientationModifierCodeSequence", "1", false)
+	tagDict[Tag{0x0054, 0x0414}] = newInfo(Tag{0x0054, 0x0414}, "SQ", "PatientGantryRelationshipCodeSequence", "1", false)
+	tagDict[Tag{0x0054, 0x0500}] = newInfo(Tag{0x0054, 0x0500}, "CS", "SliceProgressionDirection", "1", false)
+	tagDict[Tag{0x0054, 0x1000}] = newInfo(Tag{0x0054, 0x1000}, "CS", "SeriesType", "2", false)
+	tagDict[Tag{0x0054, 0x1001}] = newInfo(Tag{0x0054, 0x1001}, "CS", "Units", "1", false)
+	tagDict[Tag{0x0054, 0x1002}] = newInfo(Tag{0x0054, 0x1002}, "CS", "CountsSource", "1", false)
+	tagDict[Tag{0x0054, 0x1004}] = newInfo(Tag{0x0054, 0x1004}, "CS", "ReprojectionMethod", "1", false)
+	tagDict[Tag{0x0054, 0x1006}] = newInfo(Tag{0x0054, 0x1006}, "CS", "SUVType", "1", false)
+	tagDict[Tag{0x0054, 0x1100}] = newInfo(Tag{0x0054, 0x1100}, "CS", "RandomsCorrectionMethod", "1", false)
+	tagDict[Tag{0x0054, 0x1101}] = newInfo(Tag{0x0054, 0x1101}, "LO", "AttenuationCorrectionMethod", "1", false)
+	tagDict[Tag{0x0054, 0x1102}] = newInfo(Tag{0x0054, 0x1102}, "CS", "DecayCorrection", "1", false)
+	tagDict[Tag{0x0054, 0x1103}] = newInfo(Tag{0x0054, 0x1103}, "LO", "ReconstructionMethod", "1", false)
+	tagDict[Tag{0x0054, 0x1104}] = newInfo(Tag{0x0054, 0x1104}, "LO", "DetectorLinesOfResponseUsed", "1", false)
+	tagDict[Tag{0x0054, 0x1105}] = newInfo(Tag{0x0054, 0x1105}, "LO", "ScatterCorrectionMethod", "1", false)
+	tagDict[Tag{0x0054, 0x1200}] = newInfo(Tag{0x0054, 0x1200}, "DS", "AxialAcceptance", "1", false)
+	tagDict[Tag{0x0054, 0x1201}] = newInfo(Tag{0x0054, 0x1201}, "IS", "AxialMash", "2", false)
+	tagDict[Tag{0x0054, 0x1202}] = newInfo(Tag{0x0054, 0x1202}, "IS", "TransverseMash", "1", false)
+	tagDict[Tag{0x0054, 0x1203}] = newInfo(Tag{0x0054, 0x1203}, "DS", "DetectorElementSize", "2", false)
+	tagDict[Tag{0x0054, 0x1210}] = newInfo(Tag{0x0054, 0x1210}, "DS", "CoincidenceWindowWidth", "1", false)
+	tagDict[Tag{0x0054, 0x1220}] = newInfo(Tag{0x0054, 0x1220}, "CS", "SecondaryCountsType", "1-n", false)
+	tagDict[Tag{0x0054, 0x1300}] = newInfo(Tag{0x0054, 0x1300}, "DS", "FrameReferenceTime", "1", false)
+	tagDict[Tag{0x0054, 0x1310}] = newInfo(Tag{0x0054, 0x1310}, "IS", "PrimaryPromptsCountsAccumulated", "1", false)
+	tagDict[Tag{0x0054, 0x1311}] = newInfo(Tag{0x0054, 0x1311}, "IS", "SecondaryCountsAccumulated", "1-n", false)
+	tagDict[Tag{0x0054, 0x1320}] = newInfo(Tag{0x0054, 0x1320}, "DS", "SliceSensitivityFactor", "1", false)
+	tagDict[Tag{0x0054, 0x1321}] = newInfo(Tag{0x0054, 0x1321}, "DS", "DecayFactor", "1", false)
+	tagDict[Tag{0x0054, 0x1322}] = newInfo(Tag{0x0054, 0x1322}, "DS", "DoseCalibrationFactor", "1", false)
+	tagDict[Tag{0x0054, 0x1323}] = newInfo(Tag{0x0054, 0x1323}, "DS", "ScatterFractionFactor", "1", false)
+	tagDict[Tag{0x0054, 0x1324}] = newInfo(Tag{0x0054, 0x1324}, "DS", "DeadTimeFactor", "1", false)
+	tagDict[Tag{0x0054, 0x1330}] = newInfo(Tag{0x0054, 0x1330}, "US", "ImageIndex", "1", false)
+	tagDict[Tag{0x0060, 0x3000}] = newInfo(Tag{0x0060, 0x3000}, "SQ", "HistogramSequence", "1", false)
+	tagDict[Tag{0x0060, 0x3002}] = newInfo(Tag{0x0060, 0x3002}, "US", "HistogramNumberOfBins", "1", false)
+	tagDict[Tag{0x0060, 0x3004}] = newInfo(Tag{0x0060, 0x3004}, "US or SS", "HistogramFirstBinValue", "1", false)
+	tagDict[Tag{0x0060, 0x3006}] = newInfo(Tag{0x0060, 0x3006}, "US or SS", "HistogramLastBinValue", "1", false)
+	tagDict[Tag{0x0060, 0x3008}] = newInfo(Tag{0x0060, 0x3008}, "US", "HistogramBinWidth", "1", false)
+	tagDict[Tag{0x0060, 0x3010}] = newInfo(Tag{0x0060, 0x3010}, "LO", "HistogramExplanation", "1", false)
+	tagDict[Tag{0x0060, 0x3020}] = newInfo(Tag{0x0060, 0x3020}, "UL", "HistogramData", "1-n", false)
+	tagDict[Tag{0x0062, 0x0001}] = newInfo(Tag{0x0062, 0x0001}, "CS", "SegmentationType", "1", false)
+	tagDict[Tag{0x0062, 0x0002}] = newInfo(Tag{0x0062, 0x0002}, "SQ", "SegmentSequence", "1", false)
+	tagDict[Tag{0x0062, 0x0003}] = newInfo(Tag{0x0062, 0x0003}, "SQ", "SegmentedPropertyCategoryCodeSequence", "1", false)
+	tagDict[Tag{0x0062, 0x0004}] = newInfo(Tag{0x0062, 0x0004}, "US", "SegmentNumber", "1", false)
+	tagDict[Tag{0x0062, 0x0005}] = newInfo(Tag{0x0062, 0x0005}, "LO", "SegmentLabel", "1", false)
+	tagDict[Tag{0x0062, 0x0006}] = newInfo(Tag{0x0062, 0x0006}, "ST", "SegmentDescription", "1", false)
+	tagDict[Tag{0x0062, 0x0008}] = newInfo(Tag{0x0062, 0x0008}, "CS", "SegmentAlgorithmType", "1", false)
+	tagDict[Tag{0x0062, 0x0009}] = newInfo(Tag{0x0062, 0x0009}, "LO", "SegmentAlgorithmName", "1", false)
+	tagDict[Tag{0x0062, 0x000A}] = newInfo(Tag{0x0062, 0x000A}, "SQ", "SegmentIdentificationSequence", "1", false)
+	tagDict[Tag{0x0062, 0x000B}] = newInfo(Tag{0x0062, 0x000B}, "US", "ReferencedSegmentNumber", "1-n", false)
+	tagDict[Tag{0x0062, 0x000C}] = newInfo(Tag{0x0062, 0x000C}, "US", "RecommendedDisplayGrayscaleValue", "1", false)
+	tagDict[Tag{0x0062, 0x000D}] = newInfo(Tag{0x0062, 0x000D}, "US", "RecommendedDisplayCIELabValue", "3", false)
+	tagDict[Tag{0x0062, 0x000E}] = newInfo(Tag{0x0062, 0x000E}, "US", "MaximumFractionalValue", "1", false)
+	tagDict[Tag{0x0062, 0x000F}] = newInfo(Tag{0x0062, 0x000F}, "SQ", "SegmentedPropertyTypeCodeSequence", "1", false)
+	tagDict[Tag{0x0062, 0x0010}] = newInfo(Tag{0x0062, 0x0010}, "CS", "SegmentationFractionalType", "1", false)
+	tagDict[Tag{0x0064, 0x0002}] = newInfo(Tag{0x0064, 0x0002}, "SQ", "DeformableRegistrationSequence", "1", false)
+	tagDict[Tag{0x0064, 0x0003}] = newInfo(Tag{0x0064, 0x0003}, "UI", "SourceFrameOfReferenceUID", "1", false)
+	tagDict[Tag{0x0064, 0x0005}] = newInfo(Tag{0x0064, 0x0005}, "SQ", "DeformableRegistrationGridSequence", "1", false)
+	tagDict[Tag{0x0064, 0x0007}] = newInfo(Tag{0x0064, 0x0007}, "UL", "GridDimensions", "3", false)
+	tagDict[Tag{0x0064, 0x0008}] = newInfo(Tag{0x0064, 0x0008}, "FD", "GridResolution", "3", false)
+	tagDict[Tag{0x0064, 0x0009}] = newInfo(Tag{0x0064, 0x0009}, "OF", "VectorGridData", "1", false)
+	tagDict[Tag{0x0064, 0x000F}] = newInfo(Tag{0x0064, 0x000F}, "SQ", "PreDeformationMatrixRegistrationSequence", "1", false)
+	tagDict[Tag{0x0064, 0x0010}] = newInfo(Tag{0x0064, 0x0010}, "SQ", "PostDeformationMatrixRegistrationSequence", "1", false)
+	tagDict[Tag{0x0066, 0x0001}] = newInfo(Tag{0x0066, 0x0001}, "UL", "NumberOfSurfaces", "1", false)
+	tagDict[Tag{0x0066, 0x0002}] = newInfo(Tag{0x0066, 0x0002}, "SQ", "SurfaceSequence", "1", false)
+	tagDict[Tag{0x0066, 0x0003}] = newInfo(Tag{0x0066, 0x0003}, "UL", "SurfaceNumber", "1", false)
+	tagDict[Tag{0x0066, 0x0004}] = newInfo(Tag{0x0066, 0x0004}, "LT", "SurfaceComments", "1", false)
+	tagDict[Tag{0x0066, 0x0009}] = newInfo(Tag{0x0066, 0x0009}, "CS", "SurfaceProcessing", "1", false)
+	tagDict[Tag{0x0066, 0x000A}] = newInfo(Tag{0x0066, 0x000A}, "FL", "SurfaceProcessingRatio", "1", false)
+	tagDict[Tag{0x0066, 0x000B}] = newInfo(Tag{0x0066, 0x000B}, "LO", "SurfaceProcessingDescription", "1", false)
+	tagDict[Tag{0x0066, 0x000C}] = newInfo(Tag{0x0066, 0x000C}, "FL", "RecommendedPresentationOpacity", "1", false)
+	tagDict[Tag{0x0066, 0x000D}] = newInfo(Tag{0x0066, 0x000D}, "CS", "RecommendedPresentationType", "1", false)
+	tagDict[Tag{0x0066, 0x000E}] = newInfo(Tag{0x0066, 0x000E}, "CS", "FiniteVolume", "1", false)
+	tagDict[Tag{0x0066, 0x0010}] = newInfo(Tag{0x0066, 0x0010}, "CS", "Manifold", "1", false)
+	tagDict[Tag{0x0066, 0x0011}] = newInfo(Tag{0x0066, 0x0011}, "SQ", "SurfacePointsSequence", "1", false)
+	tagDict[Tag{0x0066, 0x0012}] = newInfo(Tag{0x0066, 0x0012}, "SQ", "SurfacePointsNormalsSequence", "1", false)
+	tagDict[Tag{0x0066, 0x0013}] = newInfo(Tag{0x0066, 0x0013}, "SQ", "SurfaceMeshPrimitivesSequence", "1", false)
+	tagDict[Tag{0x0066, 0x0015}] = newInfo(Tag{0x0066, 0x0015}, "UL", "NumberOfSurfacePoints", "1", false)
+	tagDict[Tag{0x0066, 0x0016}] = newInfo(Tag{0x0066, 0x0016}, "OF", "PointCoordinatesData", "1", false)
+	tagDict[Tag{0x0066, 0x0017}] = newInfo(Tag{0x0066, 0x0017}, "FL", "PointPositionAccuracy", "3", false)
+	tagDict[Tag{0x0066, 0x0018}] = newInfo(Tag{0x0066, 0x0018}, "FL", "MeanPointDistance", "1", false)
+	tagDict[Tag{0x0066, 0x0019}] = newInfo(Tag{0x0066, 0x0019}, "FL", "MaximumPointDistance", "1", false)
+	tagDict[Tag{0x0066, 0x001A}] = newInfo(Tag{0x0066, 0x001A}, "FL", "PointsBoundingBoxCoordinates", "6", false)
+	tagDict[Tag{0x0066, 0x001B}] = newInfo(Tag{0x0066, 0x001B}, "FL", "AxisOfRotation", "3", false)
+	tagDict[Tag{0x0066, 0x001C}] = newInfo(Tag{0x0066, 0x001C}, "FL", "CenterOfRotation", "3", false)
+	tagDict[Tag{0x0066, 0x001E}] = newInfo(Tag{0x0066, 0x001E}, "UL", "NumberOfVectors", "1", false)
+	tagDict[Tag{0x0066, 0x001F}] = newInfo(Tag{0x0066, 0x001F}, "US", "VectorDimensionality", "1", false)
+	tagDict[Tag{0x0066, 0x0020}] = newInfo(Tag{0x0066, 0x0020}, "FL", "VectorAccuracy", "1-n", false)
+	tagDict[Tag{0x0066, 0x0021}] = newInfo(Tag{0x0066, 0x0021}, "OF", "VectorCoordinateData", "1", false)
+	tagDict[Tag{0x0066, 0x0023}] = newInfo(Tag{0x0066, 0x0023}, "OW", "TrianglePointIndexList", "1", false)
+	tagDict[Tag{0x0066, 0x0024}] = newInfo(Tag{0x0066, 0x0024}, "OW", "EdgePointIndexList", "1", false)
+	tagDict[Tag{0x0066, 0x0025}] = newInfo(Tag{0x0066, 0x0025}, "OW", "VertexPointIndexList", "1", false)
+	tagDict[Tag{0x0066, 0x0026}] = newInfo(Tag{0x0066, 0x0026}, "SQ", "TriangleStripSequence", "1", false)
+	tagDict[Tag{0x0066, 0x0027}] = newInfo(Tag{0x0066, 0x0027}, "SQ", "TriangleFanSequence", "1", false)
+	tagDict[Tag{0x0066, 0x0028}] = newInfo(Tag{0x0066, 0x0028}, "SQ", "LineSequence", "1", false)
+	tagDict[Tag{0x0066, 0x0029}] = newInfo(Tag{0x0066, 0x0029}, "OW", "PrimitivePointIndexList", "1", false)
+	tagDict[Tag{0x0066, 0x002A}] = newInfo(Tag{0x0066, 0x002A}, "UL", "SurfaceCount", "1", false)
+	tagDict[Tag{0x0066, 0x002B}] = newInfo(Tag{0x0066, 0x002B}, "SQ", "ReferencedSurfaceSequence", "1", false)
+	tagDict[Tag{0x0066, 0x002C}] = newInfo(Tag{0x0066, 0x002C}, "UL", "ReferencedSurfaceNumber", "1", false)
+	tagDict[Tag{0x0066, 0x002D}] = newInfo(Tag{0x0066, 0x002D}, "SQ", "SegmentSurfaceGenerationAlgorithmIdentificationSequence", "1", false)
+	tagDict[Tag{0x0066, 0x002E}] = newInfo(Tag{0x0066, 0x002E}, "SQ", "SegmentSurfaceSourceInstanceSequence", "1", false)
+	tagDict[Tag{0x0066, 0x002F}] = newInfo(Tag{0x0066, 0x002F}, "SQ", "AlgorithmFamilyCodeSequence", "1", false)
+	tagDict[Tag{0x0066, 0x0030}] = newInfo(Tag{0x0066, 0x0030}, "SQ", "AlgorithmNameCodeSequence", "1", false)
+	tagDict[Tag{0x0066, 0x0031}] = newInfo(Tag{0x0066, 0x0031}, "LO", "AlgorithmVersion", "1", false)
+	tagDict[Tag{0x0066, 0x0032}] = newInfo(Tag{0x0066, 0x0032}, "LT", "AlgorithmParameters", "1", false)
+	tagDict[Tag{0x0066, 0x0034}] = newInfo(Tag{0x0066, 0x0034}, "SQ", "FacetSequence", "1", false)
+	tagDict[Tag{0x0066, 0x0035}] = newInfo(Tag{0x0066, 0x0035}, "SQ", "SurfaceProcessingAlgorithmIdentificationSequence", "1", false)
+	tagDict[Tag{0x0066, 0x0036}] = newInfo(Tag{0x0066, 0x0036}, "LO", "AlgorithmName", "1", false)
+	tagDict[Tag{0x0068, 0x6210}] = newInfo(Tag{0x0068, 0x6210}, "LO", "ImplantSize", "1", false)
+	tagDict[Tag{0x0068, 0x6221}] = newInfo(Tag{0x0068, 0x6221}, "LO", "ImplantTemplateVersion", "1", false)
+	tagDict[Tag{0x0068, 0x6222}] = newInfo(Tag{0x0068, 0x6222}, "SQ", "ReplacedImplantTemplateSequence", "1", false)
+	tagDict[Tag{0x0068, 0x6223}] = newInfo(Tag{0x0068, 0x6223}, "CS", "ImplantType", "1", false)
+	tagDict[Tag{0x0068, 0x6224}] = newInfo(Tag{0x0068, 0x6224}, "SQ", "DerivationImplantTemplateSequence", "1", false)
+	tagDict[Tag{0x0068, 0x6225}] = newInfo(Tag{0x0068, 0x6225}, "SQ", "OriginalImplantTemplateSequence", "1", false)
+	tagDict[Tag{0x0068, 0x6226}] = newInfo(Tag{0x0068, 0x6226}, "DT", "EffectiveDateTime", "1", false)
+	tagDict[Tag{0x0068, 0x6230}] = newInfo(Tag{0x0068, 0x6230}, "SQ", "ImplantTargetAnatomySequence", "1", false)
+	tagDict[Tag{0x0068, 0x6260}] = newInfo(Tag{0x0068, 0x6260}, "SQ", "InformationFromManufacturerSequence", "1", false)
+	tagDict[Tag{0x0068, 0x6265}] = newInfo(Tag{0x0068, 0x6265}, "SQ", "NotificationFromManufacturerSequence", "1", false)
+	tagDict[Tag{0x0068, 0x6270}] = newInfo(Tag{0x0068, 0x6270}, "DT", "InformationIssueDateTime", "1", false)
+	tagDict[Tag{0x0068, 0x6280}] = newInfo(Tag{0x0068, 0x6280}, "ST", "InformationSummary", "1", false)
+	tagDict[Tag{0x0068, 0x62A0}] = newInfo(Tag{0x0068, 0x62A0}, "SQ", "ImplantRegulatoryDisapprovalCodeSequence", "1", false)
+	tagDict[Tag{0x0068, 0x62A5}] = newInfo(Tag{0x0068, 0x62A5}, "FD", "OverallTemplateSpatialTolerance", "1", false)
+	tagDict[Tag{0x0068, 0x62C0}] = newInfo(Tag{0x0068, 0x62C0}, "SQ", "HPGLDocumentSequence", "1", false)
+	tagDict[Tag{0x0068, 0x62D0}] = newInfo(Tag{0x0068, 0x62D0}, "US", "HPGLDocumentID", "1", false)
+	tagDict[Tag{0x0068, 0x62D5}] = newInfo(Tag{0x0068, 0x62D5}, "LO", "HPGLDocumentLabel", "1", false)
+	tagDict[Tag{0x0068, 0x62E0}] = newInfo(Tag{0x0068, 0x62E0}, "SQ", "ViewOrientationCodeSequence", "1", false)
+	tagDict[Tag{0x0068, 0x62F0}] = newInfo(Tag{0x0068, 0x62F0}, "FD", "ViewOrientationModifier", "9", false)
+	tagDict[Tag{0x0068, 0x62F2}] = newInfo(Tag{0x0068, 0x62F2}, "FD", "HPGLDocumentScaling", "1", false)
+	tagDict[Tag{0x0068, 0x6300}] = newInfo(Tag{0x0068, 0x6300}, "OB", "HPGLDocument", "1", false)
+	tagDict[Tag{0x0068, 0x6310}] = newInfo(Tag{0x0068, 0x6310}, "US", "HPGLContourPenNumber", "1", false)
+	tagDict[Tag{0x0068, 0x6320}] = newInfo(Tag{0x0068, 0x6320}, "SQ", "HPGLPenSequence", "1", false)
+	tagDict[Tag{0x0068, 0x6330}] = newInfo(Tag{0x0068, 0x6330}, "US", "HPGLPenNumber", "1", false)
+	tagDict[Tag{0x0068, 0x6340}] = newInfo(Tag{0x0068, 0x6340}, "LO", "HPGLPenLabel", "1", false)
+	tagDict[Tag{0x0068, 0x6345}] = newInfo(Tag{0x0068, 0x6345}, "ST", "HPGLPenDescription", "1", false)
+	tagDict[Tag{0x0068, 0x6346}] = newInfo(Tag{0x0068, 0x6346}, "FD", "RecommendedRotationPoint", "2", false)
+	tagDict[Tag{0x0068, 0x6347}] = newInfo(Tag{0x0068, 0x6347}, "FD", "BoundingRectangle", "4", false)
+	tagDict[Tag{0x0068, 0x6350}] = newInfo(Tag{0x0068, 0x6350}, "US", "ImplantTemplate3DModelSurfaceNumber", "1-n", false)
+	tagDict[Tag{0x0068, 0x6360}] = newInfo(Tag{0x0068, 0x6360}, "SQ", "SurfaceModelDescriptionSequence", "1", false)
+	tagDict[Tag{0x0068, 0x6380}] = newInfo(Tag{0x0068, 0x6380}, "LO", "SurfaceModelLabel", "1", false)
+	tagDict[Tag{0x0068, 0x6390}] = newInfo(Tag{0x0068, 0x6390}, "FD", "SurfaceModelScalingFactor", "1", false)
+	tagDict[Tag{0x0068, 0x63A0}] = newInfo(Tag{0x0068, 0x63A0}, "SQ", "MaterialsCodeSequence", "1", false)
+	tagDict[Tag{0x0068, 0x63A4}] = newInfo(Tag{0x0068, 0x63A4}, "SQ", "CoatingMaterialsCodeSequence", "1", false)
+	tagDict[Tag{0x0068, 0x63A8}] = newInfo(Tag{0x0068, 0x63A8}, "SQ", "ImplantTypeCodeSequence", "1", false)
+	tagDict[Tag{0x0068, 0x63AC}] = newInfo(Tag{0x0068, 0x63AC}, "SQ", "FixationMethodCodeSequence", "1", false)
+	tagDict[Tag{0x0068, 0x63B0}] = newInfo(Tag{0x0068, 0x63B0}, "SQ", "MatingFeatureSetsSequence", "1", false)
+	tagDict[Tag{0x0068, 0x63C0}] = newInfo(Tag{0x0068, 0x63C0}, "US", "MatingFeatureSetID", "1", false)
+	tagDict[Tag{0x0068, 0x63D0}] = newInfo(Tag{0x0068, 0x63D0}, "LO", "MatingFeatureSetLabel", "1", false)
+	tagDict[Tag{0x0068, 0x63E0}] = newInfo(Tag{0x0068, 0x63E0}, "SQ", "MatingFeatureSequence", "1", false)
+	tagDict[Tag{0x0068, 0x63F0}] = newInfo(Tag{0x0068, 0x63F0}, "US", "MatingFeatureID", "1", false)
+	tagDict[Tag{0x0068, 0x6400}] = newInfo(Tag{0x0068, 0x6400}, "SQ", "MatingFeatureDegreeOfFreedomSequence", "1", false)
+	tagDict[Tag{0x0068, 0x6410}] = newInfo(Tag{0x0068, 0x6410}, "US", "DegreeOfFreedomID", "1", false)
+	tagDict[Tag{0x0068, 0x6420}] = newInfo(Tag{0x0068, 0x6420}, "CS", "DegreeOfFreedomType", "1", false)
+	tagDict[Tag{0x0068, 0x6430}] = newInfo(Tag{0x0068, 0x6430}, "SQ", "TwoDMatingFeatureCoordinatesSequence", "1", false)
+	tagDict[Tag{0x0068, 0x6440}] = newInfo(Tag{0x0068, 0x6440}, "US", "ReferencedHPGLDocumentID", "1", false)
+	tagDict[Tag{0x0068, 0x6450}] = newInfo(Tag{0x0068, 0x6450}, "FD", "TwoDMatingPoint", "2", false)
+	tagDict[Tag{0x0068, 0x6460}] = newInfo(Tag{0x0068, 0x6460}, "FD", "TwoDMatingAxes", "4", false)
+	tagDict[Tag{0x0068, 0x6470}] = newInfo(Tag{0x0068, 0x6470}, "SQ", "TwoDDegreeOfFreedomSequence", "1", false)
+	tagDict[Tag{0x0068, 0x6490}] = newInfo(Tag{0x0068, 0x6490}, "FD", "ThreeDDegreeOfFreedomAxis", "3", false)
+	tagDict[Tag{0x0068, 0x64A0}] = newInfo(Tag{0x0068, 0x64A0}, "FD", "RangeOfFreedom", "2", false)
+	tagDict[Tag{0x0068, 0x64C0}] = newInfo(Tag{0x0068, 0x64C0}, "FD", "ThreeDMatingPoint", "3", false)
+	tagDict[Tag{0x0068, 0x64D0}] = newInfo(Tag{0x0068, 0x64D0}, "FD", "ThreeDMatingAxes", "9", false)
+	tagDict[Tag{0x0068, 0x64F0}] = newInfo(Tag{0x0068, 0x64F0}, "FD", "TwoDDegreeOfFreedomAxis", "3", false)
+	tagDict[Tag{0x0068, 0x6500}] = newInfo(Tag{0x0068, 0x6500}, "SQ", "PlanningLandmarkPointSequence", "1", false)
+	tagDict[Tag{0x0068, 0x6510}] = newInfo(Tag{0x0068, 0x6510}, "SQ", "PlanningLandmarkLineSequence", "1", false)
+	tagDict[Tag{0x0068, 0x6520}] = newInfo(Tag{0x0068, 0x6520}, "SQ", "PlanningLandmarkPlaneSequence", "1", false)
+	tagDict[Tag{0x0068, 0x6530}] = newInfo(Tag{0x0068, 0x6530}, "US", "PlanningLandmarkID", "1", false)
+	tagDict[Tag{0x0068, 0x6540}] = newInfo(Tag{0x0068, 0x6540}, "LO", "PlanningLandmarkDescription", "1", false)
+	tagDict[Tag{0x0068, 0x6545}] = newInfo(Tag{0x0068, 0x6545}, "SQ", "PlanningLandmarkIdentificationCodeSequence", "1", false)
+	tagDict[Tag{0x0068, 0x6550}] = newInfo(Tag{0x0068, 0x6550}, "SQ", "TwoDPointCoordinatesSequence", "1", false)
+	tagDict[Tag{0x0068, 0x6560}] = newInfo(Tag{0x0068, 0x6560}, "FD", "TwoDPointCoordinates", "2", false)
+	tagDict[Tag{0x0068, 0x6590}] = newInfo(Tag{0x0068, 0x6590}, "FD", "ThreeDPointCoordinates", "3", false)
+	tagDict[Tag{0x0068, 0x65A0}] = newInfo(Tag{0x0068, 0x65A0}, "SQ", "TwoDLineCoordinatesSequence", "1", false)
+	tagDict[Tag{0x0068, 0x65B0}] = newInfo(Tag{0x0068, 0x65B0}, "FD", "TwoDLineCoordinates", "4", false)
+	tagDict[Tag{0x0068, 0x65D0}] = newInfo(Tag{0x0068, 0x65D0}, "FD", "ThreeDLineCoordinates", "6", false)
+	tagDict[Tag{0x0068, 0x65E0}] = newInfo(Tag{0x0068, 0x65E0}, "SQ", "TwoDPlaneCoordinatesSequence", "1", false)
+	tagDict[Tag{0x0068, 0x65F0}] = newInfo(Tag{0x0068, 0x65F0}, "FD", "TwoDPlaneIntersection", "4", false)
+	tagDict[Tag{0x0068, 0x6610}] = newInfo(Tag{0x0068, 0x6610}, "FD", "ThreeDPlaneOrigin", "3", false)
+	tagDict[Tag{0x0068, 0x6620}] = newInfo(Tag{0x0068, 0x6620}, "FD", "ThreeDPlaneNormal", "3", false)
+	tagDict[Tag{0x0070, 0x0001}] = newInfo(Tag{0x0070, 0x0001}, "SQ", "GraphicAnnotationSequence", "1", false)
+	tagDict[Tag{0x0070, 0x0002}] = newInfo(Tag{0x0070, 0x0002}, "CS", "GraphicLayer", "1", false)
+	tagDict[Tag{0x0070, 0x0003}] = newInfo(Tag{0x0070, 0x0003}, "CS", "BoundingBoxAnnotationUnits", "1", false)
+	tagDict[Tag{0x0070, 0x0004}] = newInfo(Tag{0x0070, 0x0004}, "CS", "AnchorPointAnnotationUnits", "1", false)
+	tagDict[Tag{0x0070, 0x0005}] = newInfo(Tag{0x0070, 0x0005}, "CS", "GraphicAnnotationUnits", "1", false)
+	tagDict[Tag{0x0070, 0x0006}] = newInfo(Tag{0x0070, 0x0006}, "ST", "UnformattedTextValue", "1", false)
+	tagDict[Tag{0x0070, 0x0008}] = newInfo(Tag{0x0070, 0x0008}, "SQ", "TextObjectSequence", "1", false)
+	tagDict[Tag{0x0070, 0x0009}] = newInfo(Tag{0x0070, 0x0009}, "SQ", "GraphicObjectSequence", "1", false)
+	tagDict[Tag{0x0070, 0x0010}] = newInfo(Tag{0x0070, 0x0010}, "FL", "BoundingBoxTopLeftHandCorner", "2", false)
+	tagDict[Tag{0x0070, 0x0011}] = newInfo(Tag{0x0070, 0x0011}, "FL", "BoundingBoxBottomRightHandCorner", "2", false)
+	tagDict[Tag{0x0070, 0x0012}] = newInfo(Tag{0x0070, 0x0012}, "CS", "BoundingBoxTextHorizontalJustification", "1", false)
+	tagDict[Tag{0x0070, 0x0014}] = newInfo(Tag{0x0070, 0x0014}, "FL", "AnchorPoint", "2", false)
+	tagDict[Tag{0x0070, 0x0015}] = newInfo(Tag{0x0070, 0x0015}, "CS", "AnchorPointVisibility", "1", false)
+	tagDict[Tag{0x0070, 0x0020}] = newInfo(Tag{0x0070, 0x0020}, "US", "GraphicDimensions", "1", false)
+	tagDict[Tag{0x0070, 0x0021}] = newInfo(Tag{0x0070, 0x0021}, "US", "NumberOfGraphicPoints", "1", false)
+	tagDict[Tag{0x0070, 0x0022}] = newInfo(Tag{0x0070, 0x0022}, "FL", "GraphicData", "2-n", false)
+	tagDict[Tag{0x0070, 0x0023}] = newInfo(Tag{0x0070, 0x0023}, "CS", "GraphicType", "1", false)
+	tagDict[Tag{0x0070, 0x0024}] = newInfo(Tag{0x0070, 0x0024}, "CS", "GraphicFilled", "1", false)
+	tagDict[Tag{0x0070, 0x0041}] = newInfo(Tag{0x0070, 0x0041}, "CS", "ImageHorizontalFlip", "1", false)
+	tagDict[Tag{0x0070, 0x0042}] = newInfo(Tag{0x0070, 0x0042}, "US", "ImageRotation", "1", false)
+	tagDict[Tag{0x0070, 0x0052}] = newInfo(Tag{0x0070, 0x0052}, "SL", "DisplayedAreaTopLeftHandCorner", "2", false)
+	tagDict[Tag{0x0070, 0x0053}] = newInfo(Tag{0x0070, 0x0053}, "SL", "DisplayedAreaBottomRightHandCorner", "2", false)
+	tagDict[Tag{0x0070, 0x005A}] = newInfo(Tag{0x0070, 0x005A}, "SQ", "DisplayedAreaSelectionSequence", "1", false)
+	tagDict[Tag{0x0070, 0x0060}] = newInfo(Tag{0x0070, 0x0060}, "SQ", "GraphicLayerSequence", "1", false)
+	tagDict[Tag{0x0070, 0x0062}] = newInfo(Tag{0x0070, 0x0062}, "IS", "GraphicLayerOrder", "1", false)
+	tagDict[Tag{0x0070, 0x0066}] = newInfo(Tag{0x0070, 0x0066}, "US", "GraphicLayerRecommendedDisplayGrayscaleValue", "1", false)
+	tagDict[Tag{0x0070, 0x0068}] = newInfo(Tag{0x0070, 0x0068}, "LO", "GraphicLayerDescription", "1", false)
+	tagDict[Tag{0x0070, 0x0080}] = newInfo(Tag{0x0070, 0x0080}, "CS", "ContentLabel", "1", false)
+	tagDict[Tag{0x0070, 0x0081}] = newInfo(Tag{0x0070, 0x0081}, "LO", "ContentDescription", "1", false)
+	tagDict[Tag{0x0070, 0x0082}] = newInfo(Tag{0x0070, 0x0082}, "DA", "PresentationCreationDate", "1", false)
+	tagDict[Tag{0x0070, 0x0083}] = newInfo(Tag{0x0070, 0x0083}, "TM", "PresentationCreationTime", "1", false)
+	tagDict[Tag{0x0070, 0x0084}] = newInfo(Tag{0x0070, 0x0084}, "PN", "ContentCreatorName", "1", false)
+	tagDict[Tag{0x0070, 0x0086}] = newInfo(Tag{0x0070, 0x0086}, "SQ", "ContentCreatorIdentificationCodeSequence", "1", false)
+	tagDict[Tag{0x0070, 0x0087}] = newInfo(Tag{0x0070, 0x0087}, "SQ", "AlternateContentDescriptionSequence", "1", false)
+	tagDict[Tag{0x0070, 0x0100}] = newInfo(Tag{0x0070, 0x0100}, "CS", "PresentationSizeMode", "1", false)
+	tagDict[Tag{0x0070, 0x0101}] = newInfo(Tag{0x0070, 0x0101}, "DS", "PresentationPixelSpacing", "2", false)
+	tagDict[Tag{0x0070, 0x0102}] = newInfo(Tag{0x0070, 0x0102}, "IS", "PresentationPixelAspectRatio", "2", false)
+	tagDict[Tag{0x0070, 0x0103}] = newInfo(Tag{0x0070, 0x0103}, "FL", "PresentationPixelMagnificationRatio", "1", false)
+	tagDict[Tag{0x0070, 0x0207}] = newInfo(Tag{0x0070, 0x0207}, "LO", "GraphicGroupLabel", "1", false)
+	tagDict[Tag{0x0070, 0x0208}] = newInfo(Tag{0x0070, 0x0208}, "ST", "GraphicGroupDescription", "1", false)
+	tagDict[Tag{0x0070, 0x0209}] = newInfo(Tag{0x0070, 0x0209}, "SQ", "CompoundGraphicSequence", "1", false)
+	tagDict[Tag{0x0070, 0x0226}] = newInfo(Tag{0x0070, 0x0226}, "UL", "CompoundGraphicInstanceID", "1", false)
+	tagDict[Tag{0x0070, 0x0227}] = newInfo(Tag{0x0070, 0x0227}, "LO", "FontName", "1", false)
+	tagDict[Tag{0x0070, 0x0228}] = newInfo(Tag{0x0070, 0x0228}, "CS", "FontNameType", "1", false)
+	tagDict[Tag{0x0070, 0x0229}] = newInfo(Tag{0x0070, 0x0229}, "LO", "CSSFontName", "1", false)
+	tagDict[Tag{0x0070, 0x0230}] = newInfo(Tag{0x0070, 0x0230}, "FD", "RotationAngle", "1", false)
+	tagDict[Tag{0x0070, 0x0231}] = newInfo(Tag{0x0070, 0x0231}, "SQ", "TextStyleSequence", "1", false)
+	tagDict[Tag{0x0070, 0x0232}] = newInfo(Tag{0x0070, 0x0232}, "SQ", "LineStyleSequence", "1", false)
+	tagDict[Tag{0x0070, 0x0233}] = newInfo(Tag{0x0070, 0x0233}, "SQ", "FillStyleSequence", "1", false)
+	tagDict[Tag{0x0070, 0x0234}] = newInfo(Tag{0x0070, 0x0234}, "SQ", "GraphicGroupSequence", "1", false)
+	tagDict[Tag{0x0070, 0x0241}] = newInfo(Tag{0x0070, 0x0241}, "US", "TextColorCIELabValue", "3", false)
+	tagDict[Tag{0x0070, 0x0242}] = newInfo(Tag{0x0070, 0x0242}, "CS", "HorizontalAlignment", "1", false)
+	tagDict[Tag{0x0070, 0x0243}] = newInfo(Tag{0x0070, 0x0243}, "CS", "VerticalAlignment", "1", false)
+	tagDict[Tag{0x0070, 0x0244}] = newInfo(Tag{0x0070, 0x0244}, "CS", "ShadowStyle", "1", false)
+	tagDict[Tag{0x0070, 0x0245}] = newInfo(Tag{0x0070, 0x0245}, "FL", "ShadowOffsetX", "1", false)
+	tagDict[Tag{0x0070, 0x0246}] = newInfo(Tag{0x0070, 0x0246}, "FL", "ShadowOffsetY", "1", false)
+	tagDict[Tag{0x0070, 0x0247}] = newInfo(Tag{0x0070, 0x0247}, "US", "ShadowColorCIELabValue", "3", false)
+	tagDict[Tag{0x0070, 0x0248}] = newInfo(Tag{0x0070, 0x0248}, "CS", "Underlined", "1", false)
+	tagDict[Tag{0x0070, 0x0249}] = newInfo(Tag{0x0070, 0x0249}, "CS", "Bold", "1", false)
+	tagDict[Tag{0x0070, 0x0250}] = newInfo(Tag{0x0070, 0x0250}, "CS", "Italic", "1", false)
+	tagDict[Tag{0x0070, 0x0251}] = newInfo(Tag{0x0070, 0x0251}, "US", "PatternOnColorCIELabValue", "3", false)
+	tagDict[Tag{0x0070, 0x0252}] = newInfo(Tag{0x0070, 0x0252}, "US", "PatternOffColorCIELabValue", "3", false)
+	tagDict[Tag{0x0070, 0x0253}] = newInfo(Tag{0x0070, 0x0253}, "FL", "LineThickness", "1", false)
+	tagDict[Tag{0x0070, 0x0254}] = newInfo(Tag{0x0070, 0x0254}, "CS", "LineDashingStyle", "1", false)
+	tagDict[Tag{0x0070, 0x0255}] = newInfo(Tag{0x0070, 0x0255}, "UL", "LinePattern", "1", false)
+	tagDict[Tag{0x0070, 0x0256}] = newInfo(Tag{0x0070, 0x0256}, "OB", "FillPattern", "1", false)
+	tagDict[Tag{0x0070, 0x0257}] = newInfo(Tag{0x0070, 0x0257}, "CS", "FillMode", "1", false)
+	tagDict[Tag{0x0070, 0x0258}] = newInfo(Tag{0x0070, 0x0258}, "FL", "ShadowOpacity", "1", false)
+	tagDict[Tag{0x0070, 0x0261}] = newInfo(Tag{0x0070, 0x0261}, "FL", "GapLength", "1", false)
+	tagDict[Tag{0x0070, 0x0262}] = newInfo(Tag{0x0070, 0x0262}, "FL", "DiameterOfVisibility", "1", false)
+	tagDict[Tag{0x0070, 0x0273}] = newInfo(Tag{0x0070, 0x0273}, "FL", "RotationPoint", "2", false)
+	tagDict[Tag{0x0070, 0x0274}] = newInfo(Tag{0x0070, 0x0274}, "CS", "TickAlignment", "1", false)
+	tagDict[Tag{0x0070, 0x0278}] = newInfo(Tag{0x0070, 0x0278}, "CS", "ShowTickLabel", "1", false)
+	tagDict[Tag{0x0070, 0x0279}] = newInfo(Tag{0x0070, 0x0279}, "CS", "TickLabelAlignment", "1", false)
+	tagDict[Tag{0x0070, 0x0282}] = newInfo(Tag{0x0070, 0x0282}, "CS", "CompoundGraphicUnits", "1", false)
+	tagDict[Tag{0x0070, 0x0284}] = newInfo(Tag{0x0070, 0x0284}, "FL", "PatternOnOpacity", "1", false)
+	tagDict[Tag{0x0070, 0x0285}] = newInfo(Tag{0x0070, 0x0285}, "FL", "PatternOffOpacity", "1", false)
+	tagDict[Tag{0x0070, 0x0287}] = newInfo(Tag{0x0070, 0x0287}, "SQ", "MajorTicksSequence", "1", false)
+	tagDict[Tag{0x0070, 0x0288}] = newInfo(Tag{0x0070, 0x0288}, "FL", "TickPosition", "1", false)
+	tagDict[Tag{0x0070, 0x0289}] = newInfo(Tag{0x0070, 0x0289}, "SH", "TickLabel", "1", false)
+	tagDict[Tag{0x0070, 0x0294}] = newInfo(Tag{0x0070, 0x0294}, "CS", "CompoundGraphicType", "1", false)
+	tagDict[Tag{0x0070, 0x0295}] = newInfo(Tag{0x0070, 0x0295}, "UL", "GraphicGroupID", "1", false)
+	tagDict[Tag{0x0070, 0x0306}] = newInfo(Tag{0x0070, 0x0306}, "CS", "ShapeType", "1", false)
+	tagDict[Tag{0x0070, 0x0308}] = newInfo(Tag{0x0070, 0x0308}, "SQ", "RegistrationSequence", "1", false)
+	tagDict[Tag{0x0070, 0x0309}] = newInfo(Tag{0x0070, 0x0309}, "SQ", "MatrixRegistrationSequence", "1", false)
+	tagDict[Tag{0x0070, 0x030A}] = newInfo(Tag{0x0070, 0x030A}, "SQ", "MatrixSequence", "1", false)
+	tagDict[Tag{0x0070, 0x030C}] = newInfo(Tag{0x0070, 0x030C}, "CS", "FrameOfReferenceTransformationMatrixType", "1", false)
+	tagDict[Tag{0x0070, 0x030D}] = newInfo(Tag{0x0070, 0x030D}, "SQ", "RegistrationTypeCodeSequence", "1", false)
+	tagDict[Tag{0x0070, 0x030F}] = newInfo(Tag{0x0070, 0x030F}, "ST", "FiducialDescription", "1", false)
+	tagDict[Tag{0x0070, 0x0310}] = newInfo(Tag{0x0070, 0x0310}, "SH", "FiducialIdentifier", "1", false)
+	tagDict[Tag{0x0070, 0x0311}] = newInfo(Tag{0x0070, 0x0311}, "SQ", "FiducialIdentifierCodeSequence", "1", false)
+	tagDict[Tag{0x0070, 0x0312}] = newInfo(Tag{0x0070, 0x0312}, "FD", "ContourUncertaintyRadius", "1", false)
+	tagDict[Tag{0x0070, 0x0314}] = newInfo(Tag{0x0070, 0x0314}, "SQ", "UsedFiducialsSequence", "1", false)
+	tagDict[Tag{0x0070, 0x0318}] = newInfo(Tag{0x0070, 0x0318}, "SQ", "GraphicCoordinatesDataSequence", "1", false)
+	tagDict[Tag{0x0070, 0x031A}] = newInfo(Tag{0x0070, 0x031A}, "UI", "FiducialUID", "1", false)
+	tagDict[Tag{0x0070, 0x031C}] = newInfo(Tag{0x0070, 0x031C}, "SQ", "FiducialSetSequence", "1", false)
+	tagDict[Tag{0x0070, 0x031E}] = newInfo(Tag{0x0070, 0x031E}, "SQ", "FiducialSequence", "1", false)
+	tagDict[Tag{0x0070, 0x0401}] = newInfo(Tag{0x0070, 0x0401}, "US", "GraphicLayerRecommendedDisplayCIELabValue", "3", false)
+	tagDict[Tag{0x0070, 0x0402}] = newInfo(Tag{0x0070, 0x0402}, "SQ", "BlendingSequence", "1", false)
+	tagDict[Tag{0x0070, 0x0403}] = newInfo(Tag{0x0070, 0x0403}, "FL", "RelativeOpacity", "1", false)
+	tagDict[Tag{0x0070, 0x0404}] = newInfo(Tag{0x0070, 0x0404}, "SQ", "ReferencedSpatialRegistrationSequence", "1", false)
+	tagDict[Tag{0x0070, 0x0405}] = newInfo(Tag{0x0070, 0x0405}, "CS", "BlendingPosition", "1", false)
+	tagDict[Tag{0x0072, 0x0002}] = newInfo(Tag{0x0072, 0x0002}, "SH", "HangingProtocolName", "1", false)
+	tagDict[Tag{0x0072, 0x0004}] = newInfo(Tag{0x0072, 0x0004}, "LO", "HangingProtocolDescription", "1", false)
+	tagDict[Tag{0x0072, 0x0006}] = newInfo(Tag{0x0072, 0x0006}, "CS", "HangingProtocolLevel", "1", false)
+	tagDict[Tag{0x0072, 0x0008}] = newInfo(Tag{0x0072, 0x0008}, "LO", "HangingProtocolCreator", "1", false)
+	tagDict[Tag{0x0072, 0x000A}] = newInfo(Tag{0x0072, 0x000A}, "DT", "HangingProtocolCreationDateTime", "1", false)
+	tagDict[Tag{0x0072, 0x000C}] = newInfo(Tag{0x0072, 0x000C}, "SQ", "HangingProtocolDefinitionSequence", "1", false)
+	tagDict[Tag{0x0072, 0x000E}] = newInfo(Tag{0x0072, 0x000E}, "SQ", "HangingProtocolUserIdentificationCodeSequence", "1", false)
+	tagDict[Tag{0x0072, 0x0010}] = newInfo(Tag{0x0072, 0x0010}, "LO", "HangingProtocolUserGroupName", "1", false)
+	tagDict[Tag{0x0072, 0x0012}] = newInfo(Tag{0x0072, 0x0012}, "SQ", "SourceHangingProtocolSequence", "1", false)
+	tagDict[Tag{0x0072, 0x0014}] = newInfo(Tag{0x0072, 0x0014}, "US", "NumberOfPriorsReferenced", "1", false)
+	tagDict[Tag{0x0072, 0x0020}] = newInfo(Tag{0x0072, 0x0020}, "SQ", "ImageSetsSequence", "1", false)
+	tagDict[Tag{0x0072, 0x0022}] = newInfo(Tag{0x0072, 0x0022}, "SQ", "ImageSetSelectorSequence", "1", false)
+	tagDict[Tag{0x0072, 0x0024}] = newInfo(Tag{0x0072, 0x0024}, "CS", "ImageSetSelectorUsageFlag", "1", false)
+	tagDict[Tag{0x0072, 0x0026}] = newInfo(Tag{0x0072, 0x0026}, "AT", "SelectorAttribute", "1", false)
+	tagDict[Tag{0x0072, 0x0028}] = newInfo(Tag{0x0072, 0x0028}, "US", "SelectorValueNumber", "1", false)
+	tagDict[Tag{0x0072, 0x0030}] = newInfo(Tag{0x0072, 0x0030}, "SQ", "TimeBasedImageSetsSequence", "1", false)
+	tagDict[Tag{0x0072, 0x0032}] = newInfo(Tag{0x0072, 0x0032}, "US", "ImageSetNumber", "1", false)
+	tagDict[Tag{0x0072, 0x0034}] = newInfo(Tag{0x0072, 0x0034}, "CS", "ImageSetSelectorCategory", "1", false)
+	tagDict[Tag{0x0072, 0x0038}] = newInfo(Tag{0x0072, 0x0038}, "US", "RelativeTime", "2", false)
+	tagDict[Tag{0x0072, 0x003A}] = newInfo(Tag{0x0072, 0x003A}, "CS", "RelativeTimeUnits", "1", false)
+	tagDict[Tag{0x0072, 0x003C}] = newInfo(Tag{0x0072, 0x003C}, "SS", "AbstractPriorValue", "2", false)
+	tagDict[Tag{0x0072, 0x003E}] = newInfo(Tag{0x0072, 0x003E}, "SQ", "AbstractPriorCodeSequence", "1", false)
+	tagDict[Tag{0x0072, 0x0040}] = newInfo(Tag{0x0072, 0x0040}, "LO", "ImageSetLabel", "1", false)
+	tagDict[Tag{0x0072, 0x0050}] = newInfo(Tag{0x0072, 0x0050}, "CS", "SelectorAttributeVR", "1", false)
+	tagDict[Tag{0x0072, 0x0052}] = newInfo(Tag{0x0072, 0x0052}, "AT", "SelectorSequencePointer", "1-n", false)
+	tagDict[Tag{0x0072, 0x0054}] = newInfo(Tag{0x0072, 0x0054}, "LO", "SelectorSequencePointerPrivateCreator", "1-n", false)
+	tagDict[Tag{0x0072, 0x0056}] = newInfo(Tag{0x0072, 0x0056}, "LO", "SelectorAttributePrivateCreator", "1", false)
+	tagDict[Tag{0x0072, 0x0060}] = newInfo(Tag{0x0072, 0x0060}, "AT", "SelectorATValue", "1-n", false)
+	tagDict[Tag{0x0072, 0x0062}] = newInfo(Tag{0x0072, 0x0062}, "CS", "SelectorCSValue", "1-n", false)
+	tagDict[Tag{0x0072, 0x0064}] = newInfo(Tag{0x0072, 0x0064}, "IS", "SelectorISValue", "1-n", false)
+	tagDict[Tag{0x0072, 0x0066}] = newInfo(Tag{0x0072, 0x0066}, "LO", "SelectorLOValue", "1-n", false)
+	tagDict[Tag{0x0072, 0x0068}] = newInfo(Tag{0x0072, 0x0068}, "LT", "SelectorLTValue", "1", false)
+	tagDict[Tag{0x0072, 0x006A}] = newInfo(Tag{0x0072, 0x006A}, "PN", "SelectorPNValue", "1-n", false)
+	tagDict[Tag{0x0072, 0x006C}] = newInfo(Tag{0x0072, 0x006C}, "SH", "SelectorSHValue", "1-n", false)
+	tagDict[Tag{0x0072, 0x006E}] = newInfo(Tag{0x0072, 0x006E}, "ST", "SelectorSTValue", "1", false)
+	tagDict[Tag{0x0072, 0x0070}] = newInfo(Tag{0x0072, 0x0070}, "UT", "SelectorUTValue", "1", false)
+	tagDict[Tag{0x0072, 0x0072}] = newInfo(Tag{0x0072, 0x0072}, "DS", "SelectorDSValue", "1-n", false)
+	tagDict[Tag{0x0072, 0x0074}] = newInfo(Tag{0x0072, 0x0074}, "FD", "SelectorFDValue", "1-n", false)
+	tagDict[Tag{0x0072, 0x0076}] = newInfo(Tag{0x0072, 0x0076}, "FL", "SelectorFLValue", "1-n", false)
+	tagDict[Tag{0x0072, 0x0078}] = newInfo(Tag{0x0072, 0x0078}, "UL", "SelectorULValue", "1-n", false)
+	tagDict[Tag{0x0072, 0x007A}] = newInfo(Tag{0x0072, 0x007A}, "US", "SelectorUSValue", "1-n", false)
+	tagDict[Tag{0x0072, 0x007C}] = newInfo(Tag{0x0072, 0x007C}, "SL", "SelectorSLValue", "1-n", false)
+	tagDict[Tag{0x0072, 0x007E}] = newInfo(Tag{0x0072, 0x007E}, "SS", "SelectorSSValue", "1-n", false)
+	tagDict[Tag{0x0072, 0x0080}] = newInfo(Tag{0x0072, 0x0080}, "SQ", "SelectorCodeSequenceValue", "1", false)
+	tagDict[Tag{0x0072, 0x0100}] = newInfo(Tag{0x0072, 0x0100}, "US", "NumberOfScreens", "1", false)
+	tagDict[Tag{0x0072, 0x0102}] = newInfo(Tag{0x0072, 0x0102}, "SQ", "NominalScreenDefinitionSequence", "1", false)
+	tagDict[Tag{0x0072, 0x0104}] = newInfo(Tag{0x0072, 0x0104}, "US", "NumberOfVerticalPixels", "1", false)
+	tagDict[Tag{0x0072, 0x0106}] = newInfo(Tag{0x0072, 0x0106}, "US", "NumberOfHorizontalPixels", "1", false)
+	tagDict[Tag{0x0072, 0x0108}] = newInfo(Tag{0x0072, 0x0108}, "FD", "DisplayEnvironmentSpatialPosition", "4", false)
+	tagDict[Tag{0x0072, 0x010A}] = newInfo(Tag{0x0072, 0x010A}, "US", "ScreenMinimumGrayscaleBitDepth", "1", false)
+	tagDict[Tag{0x0072, 0x010C}] = newInfo(Tag{0x0072, 0x010C}, "US", "ScreenMinimumColorBitDepth", "1", false)
+	tagDict[Tag{0x0072, 0x010E}] = newInfo(Tag{0x0072, 0x010E}, "US", "ApplicationMaximumRepaintTime", "1", false)
+	tagDict[Tag{0x0072, 0x0200}] = newInfo(Tag{0x0072, 0x0200}, "SQ", "DisplaySetsSequence", "1", false)
+	tagDict[Tag{0x0072, 0x0202}] = newInfo(Tag{0x0072, 0x0202}, "US", "DisplaySetNumber", "1", false)
+	tagDict[Tag{0x0072, 0x0203}] = newInfo(Tag{0x0072, 0x0203}, "LO", "DisplaySetLabel", "1", false)
+	tagDict[Tag{0x0072, 0x0204}] = newInfo(Tag{0x0072, 0x0204}, "US", "DisplaySetPresentationGroup", "1", false)
+	tagDict[Tag{0x0072, 0x0206}] = newInfo(Tag{0x0072, 0x0206}, "LO", "DisplaySetPresentationGroupDescription", "1", false)
+	tagDict[Tag{0x0072, 0x0208}] = newInfo(Tag{0x0072, 0x0208}, "CS", "PartialDataDisplayHandling", "1", false)
+	tagDict[Tag{0x0072, 0x0210}] = newInfo(Tag{0x0072, 0x0210}, "SQ", "SynchronizedScrollingSequence", "1", false)
+	tagDict[Tag{0x0072, 0x0212}] = newInfo(Tag{0x0072, 0x0212}, "US", "DisplaySetScrollingGroup", "2-n", false)
+	tagDict[Tag{0x0072, 0x0214}] = newInfo(Tag{0x0072, 0x0214}, "SQ", "NavigationIndicatorSequence", "1", false)
+	tagDict[Tag{0x0072, 0x0216}] = newInfo(Tag{0x0072, 0x0216}, "US", "NavigationDisplaySet", "1", false)
+	tagDict[Tag{0x0072, 0x0218}] = newInfo(Tag{0x0072, 0x0218}, "US", "ReferenceDisplaySets", "1-n", false)
+	tagDict[Tag{0x0072, 0x0300}] = newInfo(Tag{0x0072, 0x0300}, "SQ", "ImageBoxesSequence", "1", false)
+	tagDict[Tag{0x0072, 0x0302}] = newInfo(Tag{0x0072, 0x0302}, "US", "ImageBoxNumber", "1", false)
+	tagDict[Tag{0x0072, 0x0304}] = newInfo(Tag{0x0072, 0x0304}, "CS", "ImageBoxLayoutType", "1", false)
+	tagDict[Tag{0x0072, 0x0306}] = newInfo(Tag{0x0072, 0x0306}, "US", "ImageBoxTileHorizontalDimension", "1", false)
+	tagDict[Tag{0x0072, 0x0308}] = newInfo(Tag{0x0072, 0x0308}, "US", "ImageBoxTileVerticalDimension", "1", false)
+	tagDict[Tag{0x0072, 0x0310}] = newInfo(Tag{0x0072, 0x0310}, "CS", "ImageBoxScrollDirection", "1", false)
+	tagDict[Tag{0x0072, 0x0312}] = newInfo(Tag{0x0072, 0x0312}, "CS", "ImageBoxSmallScrollType", "1", false)
+	tagDict[Tag{0x0072, 0x0314}] = newInfo(Tag{0x0072, 0x0314}, "US", "ImageBoxSmallScrollAmount", "1", false)
+	tagDict[Tag{0x0072, 0x0316}] = newInfo(Tag{0x0072, 0x0316}, "CS", "ImageBoxLargeScrollType", "1", false)
+	tagDict[Tag{0x0072, 0x0318}] = newInfo(Tag{0x0072, 0x0318}, "US", "ImageBoxLargeScrollAmount", "1", false)
+	tagDict[Tag{0x0072, 0x0320}] = newInfo(Tag{0x0072, 0x0320}, "US", "ImageBoxOverlapPriority", "1", false)
+	tagDict[Tag{0x0072, 0x0330}] = newInfo(Tag{0x0072, 0x0330}, "FD", "CineRelativeToRealTime", "1", false)
+	tagDict[Tag{0x0072, 0x0400}] = newInfo(Tag{0x0072, 0x0400}, "SQ", "FilterOperationsSequence", "1", false)
+	tagDict[Tag{0x0072, 0x0402}] = newInfo(Tag{0x0072, 0x0402}, "CS", "FilterByCategory", "1", false)
+	tagDict[Tag{0x0072, 0x0404}] = newInfo(Tag{0x0072, 0x0404}, "CS", "FilterByAttributePresence", "1", false)
+	tagDict[Tag{0x0072, 0x0406}] = newInfo(Tag{0x0072, 0x0406}, "CS", "FilterByOperator", "1", false)
+	tagDict[Tag{0x0072, 0x0420}] = newInfo(Tag{0x0072, 0x0420}, "US", "StructuredDisplayBackgroundCIELabValue", "3", false)
+	tagDict[Tag{0x0072, 0x0421}] = newInfo(Tag{0x0072, 0x0421}, "US", "EmptyImageBoxCIELabValue", "3", false)
+	tagDict[Tag{0x0072, 0x0422}] = newInfo(Tag{0x0072, 0x0422}, "SQ", "StructuredDisplayImageBoxSequence", "1", false)
+	tagDict[Tag{0x0072, 0x0424}] = newInfo(Tag{0x0072, 0x0424}, "SQ", "StructuredDisplayTextBoxSequence", "1", false)
+	tagDict[Tag{0x0072, 0x0427}] = newInfo(Tag{0x0072, 0x0427}, "SQ", "ReferencedFirstFrameSequence", "1", false)
+	tagDict[Tag{0x0072, 0x0430}] = newInfo(Tag{0x0072, 0x0430}, "SQ", "ImageBoxSynchronizationSequence", "1", false)
+	tagDict[Tag{0x0072, 0x0432}] = newInfo(Tag{0x0072, 0x0432}, "US", "SynchronizedImageBoxList", "2-n", false)
+	tagDict[Tag{0x0072, 0x0434}] = newInfo(Tag{0x0072, 0x0434}, "CS", "TypeOfSynchronization", "1", false)
+	tagDict[Tag{0x0072, 0x0500}] = newInfo(Tag{0x0072, 0x0500}, "CS", "BlendingOperationType", "1", false)
+	tagDict[Tag{0x0072, 0x0510}] = newInfo(Tag{0x0072, 0x0510}, "CS", "ReformattingOperationType", "1", false)
+	tagDict[Tag{0x0072, 0x0512}] = newInfo(Tag{0x0072, 0x0512}, "FD", "ReformattingThickness", "1", false)
+	tagDict[Tag{0x0072, 0x0514}] = newInfo(Tag{0x0072, 0x0514}, "FD", "ReformattingInterval", "1", false)
+	tagDict[Tag{0x0072, 0x0516}] = newInfo(Tag{0x0072, 0x0516}, "CS", "ReformattingOperationInitialViewDirection", "1", false)
+	tagDict[Tag{0x0072, 0x0520}] = newInfo(Tag{0x0072, 0x0520}, "CS", "ThreeDRenderingType", "1-n", false)
+	tagDict[Tag{0x0072, 0x0600}] = newInfo(Tag{0x0072, 0x0600}, "SQ", "SortingOperationsSequence", "1", false)
+	tagDict[Tag{0x0072, 0x0602}] = newInfo(Tag{0x0072, 0x0602}, "CS", "SortByCategory", "1", false)
+	tagDict[Tag{0x0072, 0x0604}] = newInfo(Tag{0x0072, 0x0604}, "CS", "SortingDirection", "1", false)
+	tagDict[Tag{0x0072, 0x0700}] = newInfo(Tag{0x0072, 0x0700}, "CS", "DisplaySetPatientOrientation", "2", false)
+	tagDict[Tag{0x0072, 0x0702}] = newInfo(Tag{0x0072, 0x0702}, "CS", "VOIType", "1", false)
+	tagDict[Tag{0x0072, 0x0704}] = newInfo(Tag{0x0072, 0x0704}, "CS", "PseudoColorType", "1", false)
+	tagDict[Tag{0x0072, 0x0705}] = newInfo(Tag{0x0072, 0x0705}, "SQ", "PseudoColorPaletteInstanceReferenceSequence", "1", false)
+	tagDict[Tag{0x0072, 0x0706}] = newInfo(Tag{0x0072, 0x0706}, "CS", "ShowGrayscaleInverted", "1", false)
+	tagDict[Tag{0x0072, 0x0710}] = newInfo(Tag{0x0072, 0x0710}, "CS", "ShowImageTrueSizeFlag", "1", false)
+	tagDict[Tag{0x0072, 0x0712}] = newInfo(Tag{0x0072, 0x0712}, "CS", "ShowGraphicAnnotationFlag", "1", false)
+	tagDict[Tag{0x0072, 0x0714}] = newInfo(Tag{0x0072, 0x0714}, "CS", "ShowPatientDemographicsFlag", "1", false)
+	tagDict[Tag{0x0072, 0x0716}] = newInfo(Tag{0x0072, 0x0716}, "CS", "ShowAcquisitionTechniquesFlag", "1", false)
+	tagDict[Tag{0x0072, 0x0717}] = newInfo(Tag{0x0072, 0x0717}, "CS", "DisplaySetHorizontalJustification", "1", false)
+	tagDict[Tag{0x0072, 0x0718}] = newInfo(Tag{0x0072, 0x0718}, "CS", "DisplaySetVerticalJustification", "1", false)
+	tagDict[Tag{0x0074, 0x0120}] = newInfo(Tag{0x0074, 0x0120}, "FD", "ContinuationStartMeterset", "1", false)
+	tagDict[Tag{0x0074, 0x0121}] = newInfo(Tag{0x0074, 0x0121}, "FD", "ContinuationEndMeterset", "1", false)
+	tagDict[Tag{0x0074, 0x1000}] = newInfo(Tag{0x0074, 0x1000}, "CS", "ProcedureStepState", "1", false)
+	tagDict[Tag{0x0074, 0x1002}] = newInfo(Tag{0x0074, 0x1002}, "SQ", "ProcedureStepProgressInformationSequence", "1", false)
+	tagDict[Tag{0x0074, 0x1004}] = newInfo(Tag{0x0074, 0x1004}, "DS", "ProcedureStepProgress", "1", false)
+	tagDict[Tag{0x0074, 0x1006}] = newInfo(Tag{0x0074, 0x1006}, "ST", "ProcedureStepProgressDescription", "1", false)
+	tagDict[Tag{0x0074, 0x1008}] = newInfo(Tag{0x0074, 0x1008}, "SQ", "ProcedureStepCommunicationsURISequence", "1", false)
+	tagDict[Tag{0x0074, 0x100A}] = newInfo(Tag{0x0074, 0x100A}, "ST", "ContactURI", "1", false)
+	tagDict[Tag{0x0074, 0x100C}] = newInfo(Tag{0x0074, 0x100C}, "LO", "ContactDisplayName", "1", false)
+	tagDict[Tag{0x0074, 0x100E}] = newInfo(Tag{0x0074, 0x100E}, "SQ", "ProcedureStepDiscontinuationReasonCodeSequence", "1", false)
+	tagDict[Tag{0x0074, 0x1020}] = newInfo(Tag{0x0074, 0x1020}, "SQ", "BeamTaskSequence", "1", false)
+	tagDict[Tag{0x0074, 0x1022}] = newInfo(Tag{0x0074, 0x1022}, "CS", "BeamTaskType", "1", false)
+	tagDict[Tag{0x0074, 0x1026}] = newInfo(Tag{0x0074, 0x1026}, "FD", "TableTopVerticalAdjustedPosition", "1", false)
+	tagDict[Tag{0x0074, 0x1027}] = newInfo(Tag{0x0074, 0x1027}, "FD", "TableTopLongitudinalAdjustedPosition", "1", false)
+	tagDict[Tag{0x0074, 0x1028}] = newInfo(Tag{0x0074, 0x1028}, "FD", "TableTopLateralAdjustedPosition", "1", false)
+	tagDict[Tag{0x0074, 0x102A}] = newInfo(Tag{0x0074, 0x102A}, "FD", "PatientSupportAdjustedAngle", "1", false)
+	tagDict[Tag{0x0074, 0x102B}] = newInfo(Tag{0x0074, 0x102B}, "FD", "TableTopEccentricAdjustedAngle", "1", false)
+	tagDict[Tag{0x0074, 0x102C}] = newInfo(Tag{0x0074, 0x102C}, "FD", "TableTopPitchAdjustedAngle", "1", false)
+	tagDict[Tag{0x0074, 0x102D}] = newInfo(Tag{0x0074, 0x102D}, "FD", "TableTopRollAdjustedAngle", "1", false)
+	tagDict[Tag{0x0074, 0x1030}] = newInfo(Tag{0x0074, 0x1030}, "SQ", "DeliveryVerificationImageSequence", "1", false)
+	tagDict[Tag{0x0074, 0x1032}] = newInfo(Tag{0x0074, 0x1032}, "CS", "VerificationImageTiming", "1", false)
+	tagDict[Tag{0x0074, 0x1034}] = newInfo(Tag{0x0074, 0x1034}, "CS", "DoubleExposureFlag", "1", false)
+	tagDict[Tag{0x0074, 0x1036}] = newInfo(Tag{0x0074, 0x1036}, "CS", "DoubleExposureOrdering", "1", false)
+	tagDict[Tag{0x0074, 0x1040}] = newInfo(Tag{0x0074, 0x1040}, "SQ", "RelatedReferenceRTImageSequence", "1", false)
+	tagDict[Tag{0x0074, 0x1042}] = newInfo(Tag{0x0074, 0x1042}, "SQ", "GeneralMachineVerificationSequence", "1", false)
+	tagDict[Tag{0x0074, 0x1044}] = newInfo(Tag{0x0074, 0x1044}, "SQ", "ConventionalMachineVerificationSequence", "1", false)
+	tagDict[Tag{0x0074, 0x1046}] = newInfo(Tag{0x0074, 0x1046}, "SQ", "IonMachineVerificationSequence", "1", false)
+	tagDict[Tag{0x0074, 0x1048}] = newInfo(Tag{0x0074, 0x1048}, "SQ", "FailedAttributesSequence", "1", false)
+	tagDict[Tag{0x0074, 0x104A}] = newInfo(Tag{0x0074, 0x104A}, "SQ", "OverriddenAttributesSequence", "1", false)
+	tagDict[Tag{0x0074, 0x104C}] = newInfo(Tag{0x0074, 0x104C}, "SQ", "ConventionalControlPointVerificationSequence", "1", false)
+	tagDict[Tag{0x0074, 0x104E}] = newInfo(Tag{0x0074, 0x104E}, "SQ", "IonControlPointVerificationSequence", "1", false)
+	tagDict[Tag{0x0074, 0x1050}] = newInfo(Tag{0x0074, 0x1050}, "SQ", "AttributeOccurrenceSequence", "1", false)
+	tagDict[Tag{0x0074, 0x1052}] = newInfo(Tag{0x0074, 0x1052}, "AT", "AttributeOccurrencePointer", "1", false)
+	tagDict[Tag{0x0074, 0x1054}] = newInfo(Tag{0x0074, 0x1054}, "UL", "AttributeItemSelector", "1", false)
+	tagDict[Tag{0x0074, 0x1056}] = newInfo(Tag{0x0074, 0x1056}, "LO", "AttributeOccurrencePrivateCreator", "1", false)
+	tagDict[Tag{0x0074, 0x1057}] = newInfo(Tag{0x0074, 0x1057}, "IS", "SelectorSequencePointerItems", "1-n", false)
+	tagDict[Tag{0x0074, 0x1200}] = newInfo(Tag{0x0074, 0x1200}, "CS", "ScheduledProcedureStepPriority", "1", false)
+	tagDict[Tag{0x0074, 0x1202}] = newInfo(Tag{0x0074, 0x1202}, "LO", "WorklistLabel", "1", false)
+	tagDict[Tag{0x0074, 0x1204}] = newInfo(Tag{0x0074, 0x1204}, "LO", "ProcedureStepLabel", "1", false)
+	tagDict[Tag{0x0074, 0x1210}] = newInfo(Tag{0x0074, 0x1210}, "SQ", "ScheduledProcessingParametersSequence", "1", false)
+	tagDict[Tag{0x0074, 0x1212}] = newInfo(Tag{0x0074, 0x1212}, "SQ", "PerformedProcessingParametersSequence", "1", false)
+	tagDict[Tag{0x0074, 0x1216}] = newInfo(Tag{0x0074, 0x1216}, "SQ", "UnifiedProcedureStepPerformedProcedureSequence", "1", false)
+	tagDict[Tag{0x0074, 0x1224}] = newInfo(Tag{0x0074, 0x1224}, "SQ", "ReplacedProcedureStepSequence", "1", false)
+	tagDict[Tag{0x0074, 0x1230}] = newInfo(Tag{0x0074, 0x1230}, "LO", "DeletionLock", "1", false)
+	tagDict[Tag{0x0074, 0x1234}] = newInfo(Tag{0x0074, 0x1234}, "AE", "ReceivingAE", "1", false)
+	tagDict[Tag{0x0074, 0x1236}] = newInfo(Tag{0x0074, 0x1236}, "AE", "RequestingAE", "1", false)
+	tagDict[Tag{0x0074, 0x1238}] = newInfo(Tag{0x0074, 0x1238}, "LT", "ReasonForCancellation", "1", false)
+	tagDict[Tag{0x0074, 0x1242}] = newInfo(Tag{0x0074, 0x1242}, "CS", "SCPStatus", "1", false)
+	tagDict[Tag{0x0074, 0x1244}] = newInfo(Tag{0x0074, 0x1244}, "CS", "SubscriptionListStatus", "1", false)
+	tagDict[Tag{0x0074, 0x1246}] = newInfo(Tag{0x0074, 0x1246}, "CS", "UnifiedProcedureStepListStatus", "1", false)
+	tagDict[Tag{0x0074, 0x1324}] = newInfo(Tag{0x0074, 0x1324}, "UL", "BeamOrderIndex", "1", false)
+	tagDict[Tag{0x0074, 0x1338}] = newInfo(Tag{0x0074, 0x1338}, "FD", "DoubleExposureMeterset", "1", false)
+	tagDict[Tag{0x0074, 0x133A}] = newInfo(Tag{0x0074, 0x133A}, "FD", "DoubleExposureFieldDelta", "4", false)
+	tagDict[Tag{0x0076, 0x0001}] = newInfo(Tag{0x0076, 0x0001}, "LO", "ImplantAssemblyTemplateName", "1", false)
+	tagDict[Tag{0x0076, 0x0003}] = newInfo(Tag{0x0076, 0x0003}, "LO", "ImplantAssemblyTemplateIssuer", "1", false)
+	tagDict[Tag{0x0076, 0x0006}] = newInfo(Tag{0x0076, 0x0006}, "LO", "ImplantAssemblyTemplateVersion", "1", false)
+	tagDict[Tag{0x0076, 0x0008}] = newInfo(Tag{0x0076, 0x0008}, "SQ", "ReplacedImplantAssemblyTemplateSequence", "1", false)
+	tagDict[Tag{0x0076, 0x000A}] = newInfo(Tag{0x0076, 0x000A}, "CS", "ImplantAssemblyTemplateType", "1", false)
+	tagDict[Tag{0x0076, 0x000C}] = newInfo(Tag{0x0076, 0x000C}, "SQ", "OriginalImplantAssemblyTemplateSequence", "1", false)
+	tagDict[Tag{0x0076, 0x000E}] = newInfo(Tag{0x0076, 0x000E}, "SQ", "DerivationImplantAssemblyTemplateSequence", "1", false)
+	tagDict[Tag{0x0076, 0x0010}] = newInfo(Tag{0x0076, 0x0010}, "SQ", "ImplantAssemblyTemplateTargetAnatomySequence", "1", false)
+	tagDict[Tag{0x0076, 0x0020}] = newInfo(Tag{0x0076, 0x0020}, "SQ", "ProcedureTypeCodeSequence", "1", false)
+	tagDict[Tag{0x0076, 0x0030}] = newInfo(Tag{0x0076, 0x0030}, "LO", "SurgicalTechnique", "1", false)
+	tagDict[Tag{0x0076, 0x0032}] = newInfo(Tag{0x0076, 0x0032}, "SQ", "ComponentTypesSequence", "1", false)
+	tagDict[Tag{0x0076, 0x0034}] = newInfo(Tag{0x0076, 0x0034}, "CS", "ComponentTypeCodeSequence", "1", false)
+	tagDict[Tag{0x0076, 0x0036}] = newInfo(Tag{0x0076, 0x0036}, "CS", "ExclusiveComponentType", "1", false)
+	tagDict[Tag{0x0076, 0x0038}] = newInfo(Tag{0x0076, 0x0038}, "CS", "MandatoryComponentType", "1", false)
+	tagDict[Tag{0x0076, 0x0040}] = newInfo(Tag{0x0076, 0x0040}, "SQ", "ComponentSequence", "1", false)
+	tagDict[Tag{0x0076, 0x0055}] = newInfo(Tag{0x0076, 0x0055}, "US", "ComponentID", "1", false)
+	tagDict[Tag{0x0076, 0x0060}] = newInfo(Tag{0x0076, 0x0060}, "SQ", "ComponentAssemblySequence", "1", false)
+	tagDict[Tag{0x0076, 0x0070}] = newInfo(Tag{0x0076, 0x0070}, "US", "Component1ReferencedID", "1", false)
+	tagDict[Tag{0x0076, 0x0080}] = newInfo(Tag{0x0076, 0x0080}, "US", "Component1ReferencedMatingFeatureSetID", "1", false)
+	tagDict[Tag{0x0076, 0x0090}] = newInfo(Tag{0x0076, 0x0090}, "US", "Component1ReferencedMatingFeatureID", "1", false)
+	tagDict[Tag{0x0076, 0x00A0}] = newInfo(Tag{0x0076, 0x00A0}, "US", "Component2ReferencedID", "1", false)
+	tagDict[Tag{0x0076, 0x00B0}] = newInfo(Tag{0x0076, 0x00B0}, "US", "Component2ReferencedMatingFeatureSetID", "1", false)
+	tagDict[Tag{0x0076, 0x00C0}] = newInfo(Tag{0x0076, 0x00C0}, "US", "Component2ReferencedMatingFeatureID", "1", false)
+	tagDict[Tag{0x0078, 0x0001}] = newInfo(Tag{0x0078, 0x0001}, "LO", "ImplantTemplateGroupName", "1", false)
+	tagDict[Tag{0x0078, 0x0010}] = newInfo(Tag{0x0078, 0x0010}, "ST", "ImplantTemplateGroupDescription", "1", false)
+	tagDict[Tag{0x0078, 0x0020}] = newInfo(Tag{0x0078, 0x0020}, "LO", "ImplantTemplateGroupIssuer", "1", false)
+	tagDict[Tag{0x0078, 0x0024}] = newInfo(Tag{0x0078, 0x0024}, "LO", "ImplantTemplateGroupVersion", "1", false)
+	tagDict[Tag{0x0078, 0x0026}] = newInfo(Tag{0x0078, 0x0026}, "SQ", "ReplacedImplantTemplateGroupSequence", "1", false)
+	tagDict[Tag{0x0078, 0x0028}] = newInfo(Tag{0x0078, 0x0028}, "SQ", "ImplantTemplateGroupTargetAnatomySequence", "1", false)
+	tagDict[Tag{0x0078, 0x002A}] = newInfo(Tag{0x0078, 0x002A}, "SQ", "ImplantTemplateGroupMembersSequence", "1", false)
+	tagDict[Tag{0x0078, 0x002E}] = newInfo(Tag{0x0078, 0x002E}, "US", "ImplantTemplateGroupMemberID", "1", false)
+	tagDict[Tag{0x0078, 0x0050}] = newInfo(Tag{0x0078, 0x0050}, "FD", "ThreeDImplantTemplateGroupMemberMatchingPoint", "3", false)
+	tagDict[Tag{0x0078, 0x0060}] = newInfo(Tag{0x0078, 0x0060}, "FD", "ThreeDImplantTemplateGroupMemberMatchingAxes", "9", false)
+	tagDict[Tag{0x0078, 0x0070}] = newInfo(Tag{0x0078, 0x0070}, "SQ", "ImplantTemplateGroupMemberMatching2DCoordinatesSequence", "1", false)
+	tagDict[Tag{0x0078, 0x0090}] = newInfo(Tag{0x0078, 0x0090}, "FD", "TwoDImplantTemplateGroupMemberMatchingPoint", "2", false)
+	tagDict[Tag{0x0078, 0x00A0}] = newInfo(Tag{0x0078, 0x00A0}, "FD", "TwoDImplantTemplateGroupMemberMatchingAxes", "4", false)
+	tagDict[Tag{0x0078, 0x00B0}] = newInfo(Tag{0x0078, 0x00B0}, "SQ", "ImplantTemplateGroupVariationDimensionSequence", "1", false)
+	tagDict[Tag{0x0078, 0x00B2}] = newInfo(Tag{0x0078, 0x00B2}, "LO", "ImplantTemplateGroupVariationDimensionName", "1", false)
+	tagDict[Tag{0x0078, 0x00B4}] = newInfo(Tag{0x0078, 0x00B4}, "SQ", "ImplantTemplateGroupVariationDimensionRankSequence", "1", false)
+	tagDict[Tag{0x0078, 0x00B6}] = newInfo(Tag{0x0078, 0x00B6}, "US", "ReferencedImplantTemplateGroupMemberID", "1", false)
+	tagDict[Tag{0x0078, 0x00B8}] = newInfo(Tag{0x0078, 0x00B8}, "US", "ImplantTemplateGroupVariationDimensionRank", "1", false)
+	tagDict[Tag{0x0088, 0x0130}] = newInfo(Tag{0x0088, 0x0130}, "SH", "StorageMediaFileSetID", "1", false)
+	tagDict[Tag{0x0088, 0x0140}] = newInfo(Tag{0x0088, 0x0140}, "UI", "StorageMediaFileSetUID", "1", false)
+	tagDict[Tag{0x0088, 0x0200}] = newInfo(Tag{0x0088, 0x0200}, "SQ", "IconImageSequence", "1", false)
+	tagDict[Tag{0x0100, 0x0410}] = newInfo(Tag{0x0100, 0x0410}, "CS", "SOPInstanceStatus", "1", false)
+	tagDict[Tag{0x0100, 0x0420}] = newInfo(Tag{0x0100, 0x0420}, "DT", "SOPAuthorizationDateTime", "1", false)
+	tagDict[Tag{0x0100, 0x0424}] = newInfo(Tag{0x0100, 0x0424}, "LT", "SOPAuthorizationComment", "1", false)
+	tagDict[Tag{0x0100, 0x0426}] = newInfo(Tag{0x0100, 0x0426}, "LO", "AuthorizationEquipmentCertificationNumber", "1", false)
+	tagDict[Tag{0x0400, 0x0005}] = newInfo(Tag{0x0400, 0x0005}, "US", "MACIDNumber", "1", false)
+	tagDict[Tag{0x0400, 0x0010}] = newInfo(Tag{0x0400, 0x0010}, "UI", "MACCalculationTransferSyntaxUID", "1", false)
+	tagDict[Tag{0x0400, 0x0015}] = newInfo(Tag{0x0400, 0x0015}, "CS", "MACAlgorithm", "1", false)
+	tagDict[Tag{0x0400, 0x0020}] = newInfo(Tag{0x0400, 0x0020}, "AT", "DataElementsSigned", "1-n", false)
+	tagDict[Tag{0x0400, 0x0100}] = newInfo(Tag{0x0400, 0x0100}, "UI", "DigitalSignatureUID", "1", false)
+	tagDict[Tag{0x0400, 0x0105}] = newInfo(Tag{0x0400, 0x0105}, "DT", "DigitalSignatureDateTime", "1", false)
+	tagDict[Tag{0x0400, 0x0110}] = newInfo(Tag{0x0400, 0x0110}, "CS", "CertificateType", "1", false)
+	tagDict[Tag{0x0400, 0x0115}] = newInfo(Tag{0x0400, 0x0115}, "OB", "CertificateOfSigner", "1", false)
+	tagDict[Tag{0x0400, 0x0120}] = newInfo(Tag{0x0400, 0x0120}, "OB", "Signature", "1", false)
+	tagDict[Tag{0x0400, 0x0305}] = newInfo(Tag{0x0400, 0x0305}, "CS", "CertifiedTimestampType", "1", false)
+	tagDict[Tag{0x0400, 0x0310}] = newInfo(Tag{0x0400, 0x0310}, "OB", "CertifiedTimestamp", "1", false)
+	tagDict[Tag{0x0400, 0x0401}] = newInfo(Tag{0x0400, 0x0401}, "SQ", "DigitalSignaturePurposeCodeSequence", "1", false)
+	tagDict[Tag{0x0400, 0x0402}] = newInfo(Tag{0x0400, 0x0402}, "SQ", "ReferencedDigitalSignatureSequence", "1", false)
+	tagDict[Tag{0x0400, 0x0403}] = newInfo(Tag{0x0400, 0x0403}, "SQ", "ReferencedSOPInstanceMACSequence", "1", false)
+	tagDict[Tag{0x0400, 0x0404}] = newInfo(Tag{0x0400, 0x0404}, "OB", "MAC", "1", false)
+	tagDict[Tag{0x0400, 0x0500}] = newInfo(Tag{0x0400, 0x0500}, "SQ", "EncryptedAttributesSequence", "1", false)
+	tagDict[Tag{0x0400, 0x0510}] = newInfo(Tag{0x0400, 0x0510}, "UI", "EncryptedContentTransferSyntaxUID", "1", false)
+	tagDict[Tag{0x0400, 0x0520}] = newInfo(Tag{0x0400, 0x0520}, "OB", "EncryptedContent", "1", false)
+	tagDict[Tag{0x0400, 0x0550}] = newInfo(Tag{0x0400, 0x0550}, "SQ", "ModifiedAttributesSequence", "1", false)
+	tagDict[Tag{0x0400, 0x0561}] = newInfo(Tag{0x0400, 0x0561}, "SQ", "OriginalAttributesSequence", "1", false)
+	tagDict[Tag{0x0400, 0x0562}] = newInfo(Tag{0x0400, 0x0562}, "DT", "AttributeModificationDateTime", "1", false)
+	tagDict[Tag{0x0400, 0x0563}] = newInfo(Tag{0x0400, 0x0563}, "LO", "ModifyingSystem", "1", false)
+	tagDict[Tag{0x0400, 0x0564}] = newInfo(Tag{0x0400, 0x0564}, "LO", "SourceOfPreviousValues", "1", false)
+	tagDict[Tag{0x0400, 0x0565}] = newInfo(Tag{0x0400, 0x0565}, "CS", "ReasonForTheAttributeModification", "1", false)
+	tagDict[Tag{0x2000, 0x0010}] = newInfo(Tag{0x2000, 0x0010}, "IS", "NumberOfCopies", "1", false)
+	tagDict[Tag{0x2000, 0x001E}] = newInfo(Tag{0x2000, 0x001E}, "SQ", "PrinterConfigurationSequence", "1", false)
+	tagDict[Tag{0x2000, 0x0020}] = newInfo(Tag{0x2000, 0x0020}, "CS", "PrintPriority", "1", false)
+	tagDict[Tag{0x2000, 0x0030}] = newInfo(Tag{0x2000, 0x0030}, "CS", "MediumType", "1", false)
+	tagDict[Tag{0x2000, 0x0040}] = newInfo(Tag{0x2000, 0x0040}, "CS", "FilmDestination", "1", false)
+	tagDict[Tag{0x2000, 0x0050}] = newInfo(Tag{0x2000, 0x0050}, "LO", "FilmSessionLabel", "1", false)
+	tagDict[Tag{0x2000, 0x0060}] = newInfo(Tag{0x2000, 0x0060}, "IS", "MemoryAllocation", "1", false)
+	tagDict[Tag{0x2000, 0x0061}] = newInfo(Tag{0x2000, 0x0061}, "IS", "MaximumMemoryAllocation", "1", false)
+	tagDict[Tag{0x2000, 0x00A0}] = newInfo(Tag{0x2000, 0x00A0}, "US", "MemoryBitDepth", "1", false)
+	tagDict[Tag{0x2000, 0x00A1}] = newInfo(Tag{0x2000, 0x00A1}, "US", "PrintingBitDepth", "1", false)
+	tagDict[Tag{0x2000, 0x00A2}] = newInfo(Tag{0x2000, 0x00A2}, "SQ", "MediaInstalledSequence", "1", false)
+	tagDict[Tag{0x2000, 0x00A4}] = newInfo(Tag{0x2000, 0x00A4}, "SQ", "OtherMediaAvailableSequence", "1", false)
+	tagDict[Tag{0x2000, 0x00A8}] = newInfo(Tag{0x2000, 0x00A8}, "SQ", "SupportedImageDisplayFormatsSequence", "1", false)
+	tagDict[Tag{0x2000, 0x0500}] = newInfo(Tag{0x2000, 0x0500}, "SQ", "ReferencedFilmBoxSequence", "1", false)
+	tagDict[Tag{0x2010, 0x0010}] = newInfo(Tag{0x2010, 0x0010}, "ST", "ImageDisplayFormat", "1", false)
+	tagDict[Tag{0x2010, 0x0030}] = newInfo(Tag{0x2010, 0x0030}, "CS", "AnnotationDisplayFormatID", "1", false)
+	tagDict[Tag{0x2010, 0x0040}] = newInfo(Tag{0x2010, 0x0040}, "CS", "FilmOrientation", "1", false)
+	tagDict[Tag{0x2010, 0x0050}] = newInfo(Tag{0x2010, 0x0050}, "CS", "FilmSizeID", "1", false)
+	tagDict[Tag{0x2010, 0x0052}] = newInfo(Tag{0x2010, 0x0052}, "CS", "PrinterResolutionID", "1", false)
+	tagDict[Tag{0x2010, 0x0054}] = newInfo(Tag{0x2010, 0x0054}, "CS", "DefaultPrinterResolutionID", "1", false)
+	tagDict[Tag{0x2010, 0x0060}] = newInfo(Tag{0x2010, 0x0060}, "CS", "MagnificationType", "1", false)
+	tagDict[Tag{0x2010, 0x0080}] = newInfo(Tag{0x2010, 0x0080}, "CS", "SmoothingType", "1", false)
+	tagDict[Tag{0x2010, 0x00A6}] = newInfo(Tag{0x2010, 0x00A6}, "CS", "DefaultMagnificationType", "1", false)
+	tagDict[Tag{0x2010, 0x00A7}] = newInfo(Tag{0x2010, 0x00A7}, "CS", "OtherMagnificationTypesAvailable", "1-n", false)
+	tagDict[Tag{0x2010, 0x00A8}] = newInfo(Tag{0x2010, 0x00A8}, "CS", "DefaultSmoothingType", "1", false)
+	tagDict[Tag{0x2010, 0x00A9}] = newInfo(Tag{0x2010, 0x00A9}, "CS", "OtherSmoothingTypesAvailable", "1-n", false)
+	tagDict[Tag{0x2010, 0x0100}] = newInfo(Tag{0x2010, 0x0100}, "CS", "BorderDensity", "1", false)
+	tagDict[Tag{0x2010, 0x0110}] = newInfo(Tag{0x2010, 0x0110}, "CS", "EmptyImageDensity", "1", false)
+	tagDict[Tag{0x2010, 0x0120}] = newInfo(Tag{0x2010, 0x0120}, "US", "MinDensity", "1", false)
+	tagDict[Tag{0x2010, 0x0130}] = newInfo(Tag{0x2010, 0x0130}, "US", "MaxDensity", "1", false)
+	tagDict[Tag{0x2010, 0x0140}] = newInfo(Tag{0x2010, 0x0140}, "CS", "Trim", "1", false)
+	tagDict[Tag{0x2010, 0x0150}] = newInfo(Tag{0x2010, 0x0150}, "ST", "ConfigurationInformation", "1", false)
+	tagDict[Tag{0x2010, 0x0152}] = newInfo(Tag{0x2010, 0x0152}, "LT", "ConfigurationInformationDescription", "1", false)
+	tagDict[Tag{0x2010, 0x0154}] = newInfo(Tag{0x2010, 0x0154}, "IS", "MaximumCollatedFilms", "1", false)
+	tagDict[Tag{0x2010, 0x015E}] = newInfo(Tag{0x2010, 0x015E}, "US", "Illumination", "1", false)
+	tagDict[Tag{0x2010, 0x0160}] = newInfo(Tag{0x2010, 0x0160}, "US", "ReflectedAmbientLight", "1", false)
+	tagDict[Tag{0x2010, 0x0376}] = newInfo(Tag{0x2010, 0x0376}, "DS", "PrinterPixelSpacing", "2", false)
+	tagDict[Tag{0x2010, 0x0500}] = newInfo(Tag{0x2010, 0x0500}, "SQ", "ReferencedFilmSessionSequence", "1", false)
+	tagDict[Tag{0x2010, 0x0510}] = newInfo(Tag{0x2010, 0x0510}, "SQ", "ReferencedImageBoxSequence", "1", false)
+	tagDict[Tag{0x2010, 0x0520}] = newInfo(Tag{0x2010, 0x0520}, "SQ", "ReferencedBasicAnnotationBoxSequence", "1", false)
+	tagDict[Tag{0x2020, 0x0010}] = newInfo(Tag{0x2020, 0x0010}, "US", "ImageBoxPosition", "1", false)
+	tagDict[Tag{0x2020, 0x0020}] = newInfo(Tag{0x2020, 0x0020}, "CS", "Polarity", "1", false)
+	tagDict[Tag{0x2020, 0x0030}] = newInfo(Tag{0x2020, 0x0030}, "DS", "RequestedImageSize", "1", false)
+	tagDict[Tag{0x2020, 0x0040}] = newInfo(Tag{0x2020, 0x0040}, "CS", "RequestedDecimateCropBehavior", "1", false)
+	tagDict[Tag{0x2020, 0x0050}] = newInfo(Tag{0x2020, 0x0050}, "CS", "RequestedResolutionID", "1", false)
+	tagDict[Tag{0x2020, 0x00A0}] = newInfo(Tag{0x2020, 0x00A0}, "CS", "RequestedImageSizeFlag", "1", false)
+	tagDict[Tag{0x2020, 0x00A2}] = newInfo(Tag{0x2020, 0x00A2}, "CS", "DecimateCropResult", "1", false)
+	tagDict[Tag{0x2020, 0x0110}] = newInfo(Tag{0x2020, 0x0110}, "SQ", "BasicGrayscaleImageSequence", "1", false)
+	tagDict[Tag{0x2020, 0x0111}] = newInfo(Tag{0x2020, 0x0111}, "SQ", "BasicColorImageSequence", "1", false)
+	tagDict[Tag{0x2030, 0x0010}] = newInfo(Tag{0x2030, 0x0010}, "US", "AnnotationPosition", "1", false)
+	tagDict[Tag{0x2030, 0x0020}] = newInfo(Tag{0x2030, 0x0020}, "LO", "TextString", "1", false)
+	tagDict[Tag{0x2050, 0x0010}] = newInfo(Tag{0x2050, 0x0010}, "SQ", "PresentationLUTSequence", "1", false)
+	tagDict[Tag{0x2050, 0x0020}] = newInfo(Tag{0x2050, 0x0020}, "CS", "PresentationLUTShape", "1", false)
+	tagDict[Tag{0x2050, 0x0500}] = newInfo(Tag{0x2050, 0x0500}, "SQ", "ReferencedPresentationLUTSequence", "1", false)
+	tagDict[Tag{0x2100, 0x0020}] = newInfo(Tag{0x2100, 0x0020}, "CS", "ExecutionStatus", "1", false)
+	tagDict[Tag{0x2100, 0x0030}] = newInfo(Tag{0x2100, 0x0030}, "CS", "ExecutionStatusInfo", "1", false)
+	tagDict[Tag{0x2100, 0x0040}] = newInfo(Tag{0x2100, 0x0040}, "DA", "CreationDate", "1", false)
+	tagDict[Tag{0x2100, 0x0050}] = newInfo(Tag{0x2100, 0x0050}, "TM", "CreationTime", "1", false)
+	tagDict[Tag{0x2100, 0x0070}] = newInfo(Tag{0x2100, 0x0070}, "AE", "Originator", "1", false)
+	tagDict[Tag{0x2100, 0x0160}] = newInfo(Tag{0x2100, 0x0160}, "SH", "OwnerID", "1", false)
+	tagDict[Tag{0x2100, 0x0170}] = newInfo(Tag{0x2100, 0x0170}, "IS", "NumberOfFilms", "1", false)
+	tagDict[Tag{0x2110, 0x0010}] = newInfo(Tag{0x2110, 0x0010}, "CS", "PrinterStatus", "1", false)
+	tagDict[Tag{0x2110, 0x0020}] = newInfo(Tag{0x2110, 0x0020}, "CS", "PrinterStatusInfo", "1", false)
+	tagDict[Tag{0x2110, 0x0030}] = newInfo(Tag{0x2110, 0x0030}, "LO", "PrinterName", "1", false)
+	tagDict[Tag{0x2200, 0x0001}] = newInfo(Tag{0x2200, 0x0001}, "CS", "LabelUsingInformationExtractedFromInstances", "1", false)
+	tagDict[Tag{0x2200, 0x0002}] = newInfo(Tag{0x2200, 0x0002}, "UT", "LabelText", "1", false)
+	tagDict[Tag{0x2200, 0x0003}] = newInfo(Tag{0x2200, 0x0003}, "CS", "LabelStyleSelection", "1", false)
+	tagDict[Tag{0x2200, 0x0004}] = newInfo(Tag{0x2200, 0x0004}, "LT", "MediaDisposition", "1", false)
+	tagDict[Tag{0x2200, 0x0005}] = newInfo(Tag{0x2200, 0x0005}, "LT", "BarcodeValue", "1", false)
+	tagDict[Tag{0x2200, 0x0006}] = newInfo(Tag{0x2200, 0x0006}, "CS", "BarcodeSymbology", "1", false)
+	tagDict[Tag{0x2200, 0x0007}] = newInfo(Tag{0x2200, 0x0007}, "CS", "AllowMediaSplitting", "1", false)
+	tagDict[Tag{0x2200, 0x0008}] = newInfo(Tag{0x2200, 0x0008}, "CS", "IncludeNonDICOMObjects", "1", false)
+	tagDict[Tag{0x2200, 0x0009}] = newInfo(Tag{0x2200, 0x0009}, "CS", "IncludeDisplayApplication", "1", false)
+	tagDict[Tag{0x2200, 0x000A}] = newInfo(Tag{0x2200, 0x000A}, "CS", "PreserveCompositeInstancesAfterMediaCreation", "1", false)
+	tagDict[Tag{0x2200, 0x000B}] = newInfo(Tag{0x2200, 0x000B}, "US", "TotalNumberOfPiecesOfMediaCreated", "1", false)
+	tagDict[Tag{0x2200, 0x000C}] = newInfo(Tag{0x2200, 0x000C}, "LO", "RequestedMediaApplicationProfile", "1", false)
+	tagDict[Tag{0x2200, 0x000D}] = newInfo(Tag{0x2200, 0x000D}, "SQ", "ReferencedStorageMediaSequence", "1", false)
+	tagDict[Tag{0x2200, 0x000E}] = newInfo(Tag{0x2200, 0x000E}, "AT", "FailureAttributes", "1-n", false)
+	tagDict[Tag{0x2200, 0x000F}] = newInfo(Tag{0x2200, 0x000F}, "CS", "AllowLossyCompression", "1", false)
+	tagDict[Tag{0x2200, 0x0020}] = newInfo(Tag{0x2200, 0x0020}, "CS", "RequestPriority", "1", false)
+	tagDict[Tag{0x3002, 0x0002}] = newInfo(Tag{0x3002, 0x0002}, "SH", "RTImageLabel", "1", false)
+	tagDict[Tag{0x3002, 0x0003}] = newInfo(Tag{0x3002, 0x0003}, "LO", "RTImageName", "1", false)
+	tagDict[Tag{0x3002, 0x0004}] = newInfo(Tag{0x3002, 0x0004}, "ST", "RTImageDescription", "1", false)
+	tagDict[Tag{0x3002, 0x000A}] = newInfo(Tag{0x3002, 0x000A}, "CS", "ReportedValuesOrigin", "1", false)
+	tagDict[Tag{0x3002, 0x000C}] = newInfo(Tag{0x3002, 0x000C}, "CS", "RTImagePlane", "1", false)
+	tagDict[Tag{0x3002, 0x000D}] = newInfo(Tag{0x3002, 0x000D}, "DS", "XRayImageReceptorTranslation", "3", false)
+	tagDict[Tag{0x3002, 0x000E}] = newInfo(Tag{0x3002, 0x000E}, "DS", "XRayImageReceptorAngle", "1", false)
+	tagDict[Tag{0x3002, 0x0010}] = newInfo(Tag{0x3002, 0x0010}, "DS", "RTImageOrientation", "6", false)
+	tagDict[Tag{0x3002, 0x0011}] = newInfo(Tag{0x3002, 0x0011}, "DS", "ImagePlanePixelSpacing", "2", false)
+	tagDict[Tag{0x3002, 0x0012}] = newInfo(Tag{0x3002, 0x0012}, "DS", "RTImagePosition", "2", false)
+	tagDict[Tag{0x3002, 0x0020}] = newInfo(Tag{0x3002, 0x0020}, "SH", "RadiationMachineName", "1", false)
+	tagDict[Tag{0x3002, 0x0022}] = newInfo(Tag{0x3002, 0x0022}, "DS", "RadiationMachineSAD", "1", false)
+	tagDict[Tag{0x3002, 0x0024}] = newInfo(Tag{0x3002, 0x0024}, "DS", "RadiationMachineSSD", "1", false)
+	tagDict[Tag{0x3002, 0x0026}] = newInfo(Tag{0x3002, 0x0026}, "DS", "RTImageSID", "1", false)
+	tagDict[Tag{0x3002, 0x0028}] = newInfo(Tag{0x3002, 0x0028}, "DS", "SourceToReferenceObjectDistance", "1", false)
+	tagDict[Tag{0x3002, 0x0029}] = newInfo(Tag{0x3002, 0x0029}, "IS", "FractionNumber", "1", false)
+	tagDict[Tag{0x3002, 0x0030}] = newInfo(Tag{0x3002, 0x0030}, "SQ", "ExposureSequence", "1", false)
+	tagDict[Tag{0x3002, 0x0032}] = newInfo(Tag{0x3002, 0x0032}, "DS", "MetersetExposure", "1", false)
+	tagDict[Tag{0x3002, 0x0034}] = newInfo(Tag{0x3002, 0x0034}, "DS", "DiaphragmPosition", "4", false)
+	tagDict[Tag{0x3002, 0x0040}] = newInfo(Tag{0x3002, 0x0040}, "SQ", "FluenceMapSequence", "1", false)
+	tagDict[Tag{0x3002, 0x0041}] = newInfo(Tag{0x3002, 0x0041}, "CS", "FluenceDataSource", "1", false)
+	tagDict[Tag{0x3002, 0x0042}] = newInfo(Tag{0x3002, 0x0042}, "DS", "FluenceDataScale", "1", false)
+	tagDict[Tag{0x3002, 0x0050}] = newInfo(Tag{0x3002, 0x0050}, "SQ", "PrimaryFluenceModeSequence", "1", false)
+	tagDict[Tag{0x3002, 0x0051}] = newInfo(Tag{0x3002, 0x0051}, "CS", "FluenceMode", "1", false)
+	tagDict[Tag{0x3002, 0x0052}] = newInfo(Tag{0x3002, 0x0052}, "SH", "FluenceModeID", "1", false)
+	tagDict[Tag{0x3004, 0x0001}] = newInfo(Tag{0x3004, 0x0001}, "CS", "DVHType", "1", false)
+	tagDict[Tag{0x3004, 0x0002}] = newInfo(Tag{0x3004, 0x0002}, "CS", "DoseUnits", "1", false)
+	tagDict[Tag{0x3004, 0x0004}] = newInfo(Tag{0x3004, 0x0004}, "CS", "DoseType", "1", false)
+	tagDict[Tag{0x3004, 0x0006}] = newInfo(Tag{0x3004, 0x0006}, "LO", "DoseComment", "1", false)
+	tagDict[Tag{0x3004, 0x0008}] = newInfo(Tag{0x3004, 0x0008}, "DS", "NormalizationPoint", "3", false)
+	tagDict[Tag{0x3004, 0x000A}] = newInfo(Tag{0x3004, 0x000A}, "CS", "DoseSummationType", "1", false)
+	tagDict[Tag{0x3004, 0x000C}] = newInfo(Tag{0x3004, 0x000C}, "DS", "GridFrameOffsetVector", "2-n", false)
+	tagDict[Tag{0x3004, 0x000E}] = newInfo(Tag{0x3004, 0x000E}, "DS", "DoseGridScaling", "1", false)
+	tagDict[Tag{0x3004, 0x0010}] = newInfo(Tag{0x3004, 0x0010}, "SQ", "RTDoseROISequence", "1", false)
+	tagDict[Tag{0x3004, 0x0012}] = newInfo(Tag{0x3004, 0x0012}, "DS", "DoseValue", "1", false)
+	tagDict[Tag{0x3004, 0x0014}] = newInfo(Tag{0x3004, 0x0014}, "CS", "TissueHeterogeneityCorrection", "1-3", false)
+	tagDict[Tag{0x3004, 0x0040}] = newInfo(Tag{0x3004, 0x0040}, "DS", "DVHNormalizationPoint", "3", false)
+	tagDict[Tag{0x3004, 0x0042}] = newInfo(Tag{0x3004, 0x0042}, "DS", "DVHNormalizationDoseValue", "1", false)
+	tagDict[Tag{0x3004, 0x0050}] = newInfo(Tag{0x3004, 0x0050}, "SQ", "DVHSequence", "1", false)
+	tagDict[Tag{0x3004, 0x0052}] = newInfo(Tag{0x3004, 0x0052}, "DS", "DVHDoseScaling", "1", false)
+	tagDict[Tag{0x3004, 0x0054}] = newInfo(Tag{0x3004, 0x0054}, "CS", "DVHVolumeUnits", "1", false)
+	tagDict[Tag{0x3004, 0x0056}] = newInfo(Tag{0x3004, 0x0056}, "IS", "DVHNumberOfBins", "1", false)
+	tagDict[Tag{0x3004, 0x0058}] = newInfo(Tag{0x3004, 0x0058}, "DS", "DVHData", "2-2n", false)
+	tagDict[Tag{0x3004, 0x0060}] = newInfo(Tag{0x3004, 0x0060}, "SQ", "DVHReferencedROISequence", "1", false)
+	tagDict[Tag{0x3004, 0x0062}] = newInfo(Tag{0x3004, 0x0062}, "CS", "DVHROIContributionType", "1", false)
+	tagDict[Tag{0x3004, 0x0070}] = newInfo(Tag{0x3004, 0x0070}, "DS", "DVHMinimumDose", "1", false)
+	tagDict[Tag{0x3004, 0x0072}] = newInfo(Tag{0x3004, 0x0072}, "DS", "DVHMaximumDose", "1", false)
+	tagDict[Tag{0x3004, 0x0074}] = newInfo(Tag{0x3004, 0x0074}, "DS", "DVHMeanDose", "1", false)
+	tagDict[Tag{0x3006, 0x0002}] = newInfo(Tag{0x3006, 0x0002}, "SH", "StructureSetLabel", "1", false)
+	tagDict[Tag{0x3006, 0x0004}] = newInfo(Tag{0x3006, 0x0004}, "LO", "StructureSetName", "1", false)
+	tagDict[Tag{0x3006, 0x0006}] = newInfo(Tag{0x3006, 0x0006}, "ST", "StructureSetDescription", "1", false)
+	tagDict[Tag{0x3006, 0x0008}] = newInfo(Tag{0x3006, 0x0008}, "DA", "StructureSetDate", "1", false)
+	tagDict[Tag{0x3006, 0x0009}] = newInfo(Tag{0x3006, 0x0009}, "TM", "StructureSetTime", "1", false)
+	tagDict[Tag{0x3006, 0x0010}] = newInfo(Tag{0x3006, 0x0010}, "SQ", "ReferencedFrameOfReferenceSequence", "1", false)
+	tagDict[Tag{0x3006, 0x0012}] = newInfo(Tag{0x3006, 0x0012}, "SQ", "RTReferencedStudySequence", "1", false)
+	tagDict[Tag{0x3006, 0x0014}] = newInfo(Tag{0x3006, 0x0014}, "SQ", "RTReferencedSeriesSequence", "1", false)
+	tagDict[Tag{0x3006, 0x0016}] = newInfo(Tag{0x3006, 0x0016}, "SQ", "ContourImageSequence", "1", false)
+	tagDict[Tag{0x3006, 0x0020}] = newInfo(Tag{0x3006, 0x0020}, "SQ", "StructureSetROISequence", "1", false)
+	tagDict[Tag{0x3006, 0x0022}] = newInfo(Tag{0x3006, 0x0022}, "IS", "ROINumber", "1", false)
+	tagDict[Tag{0x3006, 0x0024}] = newInfo(Tag{0x3006, 0x0024}, "UI", "ReferencedFrameOfReferenceUID", "1", false)
+	tagDict[Tag{0x3006, 0x0026}] = newInfo(Tag{0x3006, 0x0026}, "LO", "ROIName", "1", false)
+	tagDict[Tag{0x3006, 0x0028}] = newInfo(Tag{0x3006, 0x0028}, "ST", "ROIDescription", "1", false)
+	tagDict[Tag{0x3006, 0x002A}] = newInfo(Tag{0x3006, 0x002A}, "IS", "ROIDisplayColor", "3", false)
+	tagDict[Tag{0x3006, 0x002C}] = newInfo(Tag{0x3006, 0x002C}, "DS", "ROIVolume", "1", false)
+	tagDict[Tag{0x3006, 0x0030}] = newInfo(Tag{0x3006, 0x0030}, "SQ", "RTRelatedROISequence", "1", false)
+	tagDict[Tag{0x3006, 0x0033}] = newInfo(Tag{0x3006, 0x0033}, "CS", "RTROIRelationship", "1", false)
+	tagDict[Tag{0x3006, 0x0036}] = newInfo(Tag{0x3006, 0x0036}, "CS", "ROIGenerationAlgorithm", "1", false)
+	tagDict[Tag{0x3006, 0x0038}] = newInfo(Tag{0x3006, 0x0038}, "LO", "ROIGenerationDescription", "1", false)
+	tagDict[Tag{0x3006, 0x0039}] = newInfo(Tag{0x3006, 0x0039}, "SQ", "ROIContourSequence", "1", false)
+	tagDict[Tag{0x3006, 0x0040}] = newInfo(Tag{0x3006, 0x0040}, "SQ", "ContourSequence", "1", false)
+	tagDict[Tag{0x3006, 0x0042}] = newInfo(Tag{0x3006, 0x0042}, "CS", "ContourGeometricType", "1", false)
+	tagDict[Tag{0x3006, 0x0044}] = newInfo(Tag{0x3006, 0x0044}, "DS", "ContourSlabThickness", "1", false)
+	tagDict[Tag{0x3006, 0x0045}] = newInfo(Tag{0x3006, 0x0045}, "DS", "ContourOffsetVector", "3", false)
+	tagDict[Tag{0x3006, 0x0046}] = newInfo(Tag{0x3006, 0x0046}, "IS", "NumberOfContourPoints", "1", false)
+	tagDict[Tag{0x3006, 0x0048}] = newInfo(Tag{0x3006, 0x0048}, "IS", "ContourNumber", "1", false)
+	tagDict[Tag{0x3006, 0x0049}] = newInfo(Tag{0x3006, 0x0049}, "IS", "AttachedContours", "1-n", false)
+	tagDict[Tag{0x3006, 0x0050}] = newInfo(Tag{0x3006, 0x0050}, "DS", "ContourData", "3-3n", false)
+	tagDict[Tag{0x3006, 0x0080}] = newInfo(Tag{0x3006, 0x0080}, "SQ", "RTROIObservationsSequence", "1", false)
+	tagDict[Tag{0x3006, 0x0082}] = newInfo(Tag{0x3006, 0x0082}, "IS", "ObservationNumber", "1", false)
+	tagDict[Tag{0x3006, 0x0084}] = newInfo(Tag{0x3006, 0x0084}, "IS", "ReferencedROINumber", "1", false)
+	tagDict[Tag{0x3006, 0x0085}] = newInfo(Tag{0x3006, 0x0085}, "SH", "ROIObservationLabel", "1", false)
+	tagDict[Tag{0x3006, 0x0086}] = newInfo(Tag{0x3006, 0x0086}, "SQ", "RTROIIdentificationCodeSequence", "1", false)
+	tagDict[Tag{0x3006, 0x0088}] = newInfo(Tag{0x3006, 0x0088}, "ST", "ROIObservationDescription", "1", false)
+	tagDict[Tag{0x3006, 0x00A0}] = newInfo(Tag{0x3006, 0x00A0}, "SQ", "RelatedRTROIObservationsSequence", "1", false)
+	tagDict[Tag{0x3006, 0x00A4}] = newInfo(Tag{0x3006, 0x00A4}, "CS", "RTROIInterpretedType", "1", false)
+	tagDict[Tag{0x3006, 0x00A6}] = newInfo(Tag{0x3006, 0x00A6}, "PN", "ROIInterpreter", "1", false)
+	tagDict[Tag{0x3006, 0x00B0}] = newInfo(Tag{0x3006, 0x00B0}, "SQ", "ROIPhysicalPropertiesSequence", "1", false)
+	tagDict[Tag{0x3006, 0x00B2}] = newInfo(Tag{0x3006, 0x00B2}, "CS", "ROIPhysicalProperty", "1", false)
+	tagDict[Tag{0x3006, 0x00B4}] = newInfo(Tag{0x3006, 0x00B4}, "DS", "ROIPhysicalPropertyValue", "1", false)
+	tagDict[Tag{0x3006, 0x00B6}] = newInfo(Tag{0x3006, 0x00B6}, "SQ", "ROIElementalCompositionSequence", "1", false)
+	tagDict[Tag{0x3006, 0x00B7}] = newInfo(Tag{0x3006, 0x00B7}, "US", "ROIElementalCompositionAtomicNumber", "1", false)
+	tagDict[Tag{0x3006, 0x00B8}] = newInfo(Tag{0x3006, 0x00B8}, "FL", "ROIElementalCompositionAtomicMassFraction", "1", false)
+	tagDict[Tag{0x3006, 0x00C0}] = newInfo(Tag{0x3006, 0x00C0}, "SQ", "FrameOfReferenceRelationshipSequence", "1", false)
+	tagDict[Tag{0x3006, 0x00C2}] = newInfo(Tag{0x3006, 0x00C2}, "UI", "RelatedFrameOfReferenceUID", "1", false)
+	tagDict[Tag{0x3006, 0x00C4}] = newInfo(Tag{0x3006, 0x00C4}, "CS", "FrameOfReferenceTransformationType", "1", false)
+	tagDict[Tag{0x3006, 0x00C6}] = newInfo(Tag{0x3006, 0x00C6}, "DS", "FrameOfReferenceTransformationMatrix", "16", false)
+	tagDict[Tag{0x3006, 0x00C8}] = newInfo(Tag{0x3006, 0x00C8}, "LO", "FrameOfReferenceTransformationComment", "1", false)
+	tagDict[Tag{0x3008, 0x0010}] = newInfo(Tag{0x3008, 0x0010}, "SQ", "MeasuredDoseReferenceSequence", "1", false)
+	tagDict[Tag{0x3008, 0x0012}] = newInfo(Tag{0x3008, 0x0012}, "ST", "MeasuredDoseDescription", "1", false)
+	tagDict[Tag{0x3008, 0x0014}] = newInfo(Tag{0x3008, 0x0014}, "CS", "MeasuredDoseType", "1", false)
+	tagDict[Tag{0x3008, 0x0016}] = newInfo(Tag{0x3008, 0x0016}, "DS", "MeasuredDoseValue", "1", false)
+	tagDict[Tag{0x3008, 0x0020}] = newInfo(Tag{0x3008, 0x0020}, "SQ", "TreatmentSessionBeamSequence", "1", false)
+	tagDict[Tag{0x3008, 0x0021}] = newInfo(Tag{0x3008, 0x0021}, "SQ", "TreatmentSessionIonBeamSequence", "1", false)
+	tagDict[Tag{0x3008, 0x0022}] = newInfo(Tag{0x3008, 0x0022}, "IS", "CurrentFractionNumber", "1", false)
+	tagDict[Tag{0x3008, 0x0024}] = newInfo(Tag{0x3008, 0x0024}, "DA", "TreatmentControlPointDate", "1", false)
+	tagDict[Tag{0x3008, 0x0025}] = newInfo(Tag{0x3008, 0x0025}, "TM", "TreatmentControlPointTime", "1", false)
+	tagDict[Tag{0x3008, 0x002A}] = newInfo(Tag{0x3008, 0x002A}, "CS", "TreatmentTerminationStatus", "1", false)
+	tagDict[Tag{0x3008, 0x002B}] = newInfo(Tag{0x3008, 0x002B}, "SH", "TreatmentTerminationCode", "1", false)
+	tagDict[Tag{0x3008, 0x002C}] = newInfo(Tag{0x3008, 0x002C}, "CS", "TreatmentVerificationStatus", "1", false)
+	tagDict[Tag{0x3008, 0x0030}] = newInfo(Tag{0x3008, 0x0030}, "SQ", "ReferencedTreatmentRecordSequence", "1", false)
+	tagDict[Tag{0x3008, 0x0032}] = newInfo(Tag{0x3008, 0x0032}, "DS", "SpecifiedPrimaryMeterset", "1", false)
+	tagDict[Tag{0x3008, 0x0033}] = newInfo(Tag{0x3008, 0x0033}, "DS", "SpecifiedSecondaryMeterset", "1", false)
+	tagDict[Tag{0x3008, 0x0036}] = newInfo(Tag{0x3008, 0x0036}, "DS", "DeliveredPrimaryMeterset", "1", false)
+	tagDict[Tag{0x3008, 0x0037}] = newInfo(Tag{0x3008, 0x0037}, "DS", "DeliveredSecondaryMeterset", "1", false)
+	tagDict[Tag{0x3008, 0x003A}] = newInfo(Tag{0x3008, 0x003A}, "DS", "SpecifiedTreatmentTime", "1", false)
+	tagDict[Tag{0x3008, 0x003B}] = newInfo(Tag{0x3008, 0x003B}, "DS", "DeliveredTreatmentTime", "1", false)
+	tagDict[Tag{0x3008, 0x0040}] = newInfo(Tag{0x3008, 0x0040}, "SQ", "ControlPointDeliverySequence", "1", false)
+	tagDict[Tag{0x3008, 0x0041}] = newInfo(Tag{0x3008, 0x0041}, "SQ", "IonControlPointDeliverySequence", "1", false)
+	tagDict[Tag{0x3008, 0x0042}] = newInfo(Tag{0x3008, 0x0042}, "DS", "SpecifiedMeterset", "1", false)
+	tagDict[Tag{0x3008, 0x0044}] = newInfo(Tag{0x3008, 0x0044}, "DS", "DeliveredMeterset", "1", false)
+	tagDict[Tag{0x3008, 0x0045}] = newInfo(Tag{0x3008, 0x0045}, "FL", "MetersetRateSet", "1", false)
+	tagDict[Tag{0x3008, 0x0046}] = newInfo(Tag{0x3008, 0x0046}, "FL", "MetersetRateDelivered", "1", false)
+	tagDict[Tag{0x3008, 0x0047}] = newInfo(Tag{0x3008, 0x0047}, "FL", "ScanSpotMetersetsDelivered", "1-n", false)
+	tagDict[Tag{0x3008, 0x0048}] = newInfo(Tag{0x3008, 0x0048}, "DS", "DoseRateDelivered", "1", false)
+	tagDict[Tag{0x3008, 0x0050}] = newInfo(Tag{0x3008, 0x0050}, "SQ", "TreatmentSummaryCalculatedDoseReferenceSequence", "1", false)
+	tagDict[Tag{0x3008, 0x0052}] = newInfo(Tag{0x3008, 0x0052}, "DS", "CumulativeDoseToDoseReference", "1", false)
+	tagDict[Tag{0x3008, 0x0054}] = newInfo(Tag{0x3008, 0x0054}, "DA", "FirstTreatmentDate", "1", false)
+	tagDict[Tag{0x3008, 0x0056}] = newInfo(Tag{0x3008, 0x0056}, "DA", "MostRecentTreatmentDate", "1", false)
+	tagDict[Tag{0x3008, 0x005A}] = newInfo(Tag{0x3008, 0x005A}, "IS", "NumberOfFractionsDelivered", "1", false)
+	tagDict[Tag{0x3008, 0x0060}] = newInfo(Tag{0x3008, 0x0060}, "SQ", "OverrideSequence", "1", false)
+	tagDict[Tag{0x3008, 0x0061}] = newInfo(Tag{0x3008, 0x0061}, "AT", "ParameterSequencePointer", "1", false)
+	tagDict[Tag{0x3008, 0x0062}] = newInfo(Tag{0x3008, 0x0062}, "AT", "OverrideParameterPointer", "1", false)
+	tagDict[Tag{0x3008, 0x0063}] = newInfo(Tag{0x3008, 0x0063}, "IS", "ParameterItemIndex", "1", false)
+	tagDict[Tag{0x3008, 0x0064}] = newInfo(Tag{0x3008, 0x0064}, "IS", "MeasuredDoseReferenceNumber", "1", false)
+	tagDict[Tag{0x3008, 0x0065}] = newInfo(Tag{0x3008, 0x0065}, "AT", "ParameterPointer", "1", false)
+	tagDict[Tag{0x3008, 0x0066}] = newInfo(Tag{0x3008, 0x0066}, "ST", "OverrideReason", "1", false)
+	tagDict[Tag{0x3008, 0x0068}] = newInfo(Tag{0x3008, 0x0068}, "SQ", "CorrectedParameterSequence", "1", false)
+	tagDict[Tag{0x3008, 0x006A}] = newInfo(Tag{0x3008, 0x006A}, "FL", "CorrectionValue", "1", false)
+	tagDict[Tag{0x3008, 0x0070}] = newInfo(Tag{0x3008, 0x0070}, "SQ", "CalculatedDoseReferenceSequence", "1", false)
+	tagDict[Tag{0x3008, 0x0072}] = newInfo(Tag{0x3008, 0x0072}, "IS", "CalculatedDoseReferenceNumber", "1", false)
+	tagDict[Tag{0x3008, 0x0074}] = newInfo(Tag{0x3008, 0x0074}, "ST", "CalculatedDoseReferenceDescription", "1", false)
+	tagDict[Tag{0x3008, 0x0076}] = newInfo(Tag{0x3008, 0x0076}, "DS", "CalculatedDoseReferenceDoseValue", "1", false)
+	tagDict[Tag{0x3008, 0x0078}] = newInfo(Tag{0x3008, 0x0078}, "DS", "StartMeterset", "1", false)
+	tagDict[Tag{0x3008, 0x007A}] = newInfo(Tag{0x3008, 0x007A}, "DS", "EndMeterset", "1", false)
+	tagDict[Tag{0x3008, 0x0080}] = newInfo(Tag{0x3008, 0x0080}, "SQ", "ReferencedMeasuredDoseReferenceSequence", "1", false)
+	tagDict[Tag{0x3008, 0x0082}] = newInfo(Tag{0x3008, 0x0082}, "IS", "ReferencedMeasuredDoseReferenceNumber", "1", false)
+	tagDict[Tag{0x3008, 0x0090}] = newInfo(Tag{0x3008, 0x0090}, "SQ", "ReferencedCalculatedDoseReferenceSequence", "1", false)
+	tagDict[Tag{0x3008, 0x0092}] = newInfo(Tag{0x3008, 0x0092}, "IS", "ReferencedCalculatedDoseReferenceNumber", "1", false)
+	tagDict[Tag{0x3008, 0x00A0}] = newInfo(Tag{0x3008, 0x00A0}, "SQ", "BeamLimitingDeviceLeafPairsSequence", "1", false)
+	tagDict[Tag{0x3008, 0x00B0}] = newInfo(Tag{0x3008, 0x00B0}, "SQ", "RecordedWedgeSequence", "1", false)
+	tagDict[Tag{0x3008, 0x00C0}] = newInfo(Tag{0x3008, 0x00C0}, "SQ", "RecordedCompensatorSequence", "1", false)
+	tagDict[Tag{0x3008, 0x00D0}] = newInfo(Tag{0x3008, 0x00D0}, "SQ", "RecordedBlockSequence", "1", false)
+	tagDict[Tag{0x3008, 0x00E0}] = newInfo(Tag{0x3008, 0x00E0}, "SQ", "TreatmentSummaryMeasuredDoseReferenceSequence", "1", false)
+	tagDict[Tag{0x3008, 0x00F0}] = newInfo(Tag{0x3008, 0x00F0}, "SQ", "RecordedSnoutSequence", "1", false)
+	tagDict[Tag{0x3008, 0x00F2}] = newInfo(Tag{0x3008, 0x00F2}, "SQ", "RecordedRangeShifterSequence", "1", false)
+	tagDict[Tag{0x3008, 0x00F4}] = newInfo(Tag{0x3008, 0x00F4}, "SQ", "RecordedLateralSpreadingDeviceSequence", "1", false)
+	tagDict[Tag{0x3008, 0x00F6}] = newInfo(Tag{0x3008, 0x00F6}, "SQ", "RecordedRangeModulatorSequence", "1", false)
+	tagDict[Tag{0x3008, 0x0100}] = newInfo(Tag{0x3008, 0x0100}, "SQ", "RecordedSourceSequence", "1", false)
+	tagDict[Tag{0x3008, 0x0105}] = newInfo(Tag{0x3008, 0x0105}, "LO", "SourceSerialNumber", "1", false)
+	tagDict[Tag{0x3008, 0x0110}] = newInfo(Tag{0x3008, 0x0110}, "SQ", "TreatmentSessionApplicationSetupSequence", "1", false)
+	tagDict[Tag{0x3008, 0x0116}] = newInfo(Tag{0x3008, 0x0116}, "CS", "ApplicationSetupCheck", "1", false)
+	tagDict[Tag{0x3008, 0x0120}] = newInfo(Tag{0x3008, 0x0120}, "SQ", "RecordedBrachyAccessoryDeviceSequence", "1", false)
+	tagDict[Tag{0x3008, 0x0122}] = newInfo(Tag{0x3008, 0x0122}, "IS", "ReferencedBrachyAccessoryDeviceNumber", "1", false)
+	tagDict[Tag{0x3008, 0x0130}] = newInfo(Tag{0x3008, 0x0130}, "SQ", "RecordedChannelSequence", "1", false)
+	tagDict[Tag{0x3008, 0x0132}] = newInfo(Tag{0x3008, 0x0132}, "DS", "SpecifiedChannelTotalTime", "1", false)
+	tagDict[Tag{0x3008, 0x0134}] = newInfo(Tag{0x3008, 0x0134}, "DS", "DeliveredChannelTotalTime", "1", false)
+	tagDict[Tag{0x3008, 0x0136}] = newInfo(Tag{0x3008, 0x0136}, "IS", "SpecifiedNumberOfPulses", "1", false)
+	tagDict[Tag{0x3008, 0x0138}] = newInfo(Tag{0x3008, 0x0138}, "IS", "DeliveredNumberOfPulses", "1", false)
+	tagDict[Tag{0x3008, 0x013A}] = newInfo(Tag{0x3008, 0x013A}, "DS", "SpecifiedPulseRepetitionInterval", "1", false)
+	tagDict[Tag{0x3008, 0x013C}] = newInfo(Tag{0x3008, 0x013C}, "DS", "DeliveredPulseRepetitionInterval", "1", false)
+	tagDict[Tag{0x3008, 0x0140}] = newInfo(Tag{0x3008, 0x0140}, "SQ", "RecordedSourceApplicatorSequence", "1", false)
+	tagDict[Tag{0x3008, 0x0142}] = newInfo(Tag{0x3008, 0x0142}, "IS", "ReferencedSourceApplicatorNumber", "1", false)
+	tagDict[Tag{0x3008, 0x0150}] = newInfo(Tag{0x3008, 0x0150}, "SQ", "RecordedChannelShieldSequence", "1", false)
+	tagDict[Tag{0x3008, 0x0152}] = newInfo(Tag{0x3008, 0x0152}, "IS", "ReferencedChannelShieldNumber", "1", false)
+	tagDict[Tag{0x3008, 0x0160}] = newInfo(Tag{0x3008, 0x0160}, "SQ", "BrachyControlPointDeliveredSequence", "1", false)
+	tagDict[Tag{0x3008, 0x0162}] = newInfo(Tag{0x3008, 0x0162}, "DA", "SafePositionExitDate", "1", false)
+	tagDict[Tag{0x3008, 0x0164}] = newInfo(Tag{0x3008, 0x0164}, "TM", "SafePositionExitTime", "1", false)
+	tagDict[Tag{0x3008, 0x0166}] = newInfo(Tag{0x3008, 0x0166}, "DA", "SafePositionReturnDate", "1", false)
+	tagDict[Tag{0x3008, 0x0168}] = newInfo(Tag{0x3008, 0x0168}, "TM", "SafePositionReturnTime", "1", false)
+	tagDict[Tag{0x3008, 0x0200}] = newInfo(Tag{0x3008, 0x0200}, "CS", "CurrentTreatmentStatus", "1", false)
+	tagDict[Tag{0x3008, 0x0202}] = newInfo(Tag{0x3008, 0x0202}, "ST", "TreatmentStatusComment", "1", false)
+	tagDict[Tag{0x3008, 0x0220}] = newInfo(Tag{0x3008, 0x0220}, "SQ", "FractionGroupSummarySequence", "1", false)
+	tagDict[Tag{0x3008, 0x0223}] = newInfo(Tag{0x3008, 0x0223}, "IS", "ReferencedFractionNumber", "1", false)
+	tagDict[Tag{0x3008, 0x0224}] = newInfo(Tag{0x3008, 0x0224}, "CS", "FractionGroupType", "1", false)
+	tagDict[Tag{0x3008, 0x0230}] = newInfo(Tag{0x3008, 0x0230}, "CS", "BeamStopperPosition", "1", false)
+	tagDict[Tag{0x3008, 0x0240}] = newInfo(Tag{0x3008, 0x0240}, "SQ", "FractionStatusSummarySequence", "1", false)
+	tagDict[Tag{0x3008, 0x0250}] = newInfo(Tag{0x3008, 0x0250}, "DA", "TreatmentDate", "1", false)
+	tagDict[Tag{0x3008, 0x0251}] = newInfo(Tag{0x3008, 0x0251}, "TM", "TreatmentTime", "1", false)
+	tagDict[Tag{0x300A, 0x0002}] = newInfo(Tag{0x300A, 0x0002}, "SH", "RTPlanLabel", "1", false)
+	tagDict[Tag{0x300A, 0x0003}] = newInfo(Tag{0x300A, 0x0003}, "LO", "RTPlanName", "1", false)
+	tagDict[Tag{0x300A, 0x0004}] = newInfo(Tag{0x300A, 0x0004}, "ST", "RTPlanDescription", "1", false)
+	tagDict[Tag{0x300A, 0x0006}] = newInfo(Tag{0x300A, 0x0006}, "DA", "RTPlanDate", "1", false)
+	tagDict[Tag{0x300A, 0x0007}] = newInfo(Tag{0x300A, 0x0007}, "TM", "RTPlanTime", "1", false)
+	tagDict[Tag{0x300A, 0x0009}] = newInfo(Tag{0x300A, 0x0009}, "LO", "TreatmentProtocols", "1-n", false)
+	tagDict[Tag{0x300A, 0x000A}] = newInfo(Tag{0x300A, 0x000A}, "CS", "PlanIntent", "1", false)
+	tagDict[Tag{0x300A, 0x000B}] = newInfo(Tag{0x300A, 0x000B}, "LO", "TreatmentSites", "1-n", false)
+	tagDict[Tag{0x300A, 0x000C}] = newInfo(Tag{0x300A, 0x000C}, "CS", "RTPlanGeometry", "1", false)
+	tagDict[Tag{0x300A, 0x000E}] = newInfo(Tag{0x300A, 0x000E}, "ST", "PrescriptionDescription", "1", false)
+	tagDict[Tag{0x300A, 0x0010}] = newInfo(Tag{0x300A, 0x0010}, "SQ", "DoseReferenceSequence", "1", false)
+	tagDict[Tag{0x300A, 0x0012}] = newInfo(Tag{0x300A, 0x0012}, "IS", "DoseReferenceNumber", "1", false)
+	tagDict[Tag{0x300A, 0x0013}] = newInfo(Tag{0x300A, 0x0013}, "UI", "DoseReferenceUID", "1", false)
+	tagDict[Tag{0x300A, 0x0014}] = newInfo(Tag{0x300A, 0x0014}, "CS", "DoseReferenceStructureType", "1", false)
+	tagDict[Tag{0x300A, 0x0015}] = newInfo(Tag{0x300A, 0x0015}, "CS", "NominalBeamEnergyUnit", "1", false)
+	tagDict[Tag{0x300A, 0x0016}] = newInfo(Tag{0x300A, 0x0016}, "LO", "DoseReferenceDescription", "1", false)
+	tagDict[Tag{0x300A, 0x0018}] = newInfo(Tag{0x300A, 0x0018}, "DS", "DoseReferencePointCoordinates", "3", false)
+	tagDict[Tag{0x300A, 0x001A}] = newInfo(Tag{0x300A, 0x001A}, "DS", "NominalPriorDose", "1", false)
+	tagDict[Tag{0x300A, 0x0020}] = newInfo(Tag{0x300A, 0x0020}, "CS", "DoseReferenceType", "1", false)
+	tagDict[Tag{0x300A, 0x0021}] = newInfo(Tag{0x300A, 0x0021}, "DS", "ConstraintWeight", "1", false)
+	tagDict[Tag{0x300A, 0x0022}] = newInfo(Tag{0x300A, 0x0022}, "DS", "DeliveryWarningDose", "1", false)
+	tagDict[Tag{0x300A, 0x0023}] = newInfo(Tag{0x300A, 0x0023}, "DS", "DeliveryMaximumDose", "1", false)
+	tagDict[Tag{0x300A, 0x0025}] = newInfo(Tag{0x300A, 0x0025}, "DS", "TargetMinimumDose", "1", false)
+	tagDict[Tag{0x300A, 0x0026}] = newInfo(Tag{0x300A, 0x0026}, "DS", "TargetPrescriptionDose", "1", false)
+	tagDict[Tag{0x300A, 0x0027}] = newInfo(Tag{0x300A, 0x0027}, "DS", "TargetMaximumDose", "1", false)
+	tagDict[Tag{0x300A, 0x0028}] = newInfo(Tag{0x300A, 0x0028}, "DS", "TargetUnderdoseVolumeFraction", "1", false)
+	tagDict[Tag{0x300A, 0x002A}] = newInfo(Tag{0x300A, 0x002A}, "DS", "OrganAtRiskFullVolumeDose", "1", false)
+	tagDict[Tag{0x300A, 0x002B}] = newInfo(Tag{0x300A, 0x002B}, "DS", "OrganAtRiskLimitDose", "1", false)
+	tagDict[Tag{0x300A, 0x002C}] = newInfo(Tag{0x300A, 0x002C}, "DS", "OrganAtRiskMaximumDose", "1", false)
+	tagDict[Tag{0x300A, 0x002D}] = newInfo(Tag{0x300A, 0x002D}, "DS", "OrganAtRiskOverdoseVolumeFraction", "1", false)
+	tagDict[Tag{0x300A, 0x0040}] = newInfo(Tag{0x300A, 0x0040}, "SQ", "ToleranceTableSequence", "1", false)
+	tagDict[Tag{0x300A, 0x0042}] = newInfo(Tag{0x300A, 0x0042}, "IS", "ToleranceTableNumber", "1", false)
+	tagDict[Tag{0x300A, 0x0043}] = newInfo(Tag{0x300A, 0x0043}, "SH", "ToleranceTableLabel", "1", false)
+	tagDict[Tag{0x300A, 0x0044}] = newInfo(Tag{0x300A, 0x0044}, "DS", "GantryAngleTolerance", "1", false)
+	tagDict[Tag{0x300A, 0x0046}] = newInfo(Tag{0x300A, 0x0046}, "DS", "BeamLimitingDeviceAngleTolerance", "1", false)
+	tagDict[Tag{0x300A, 0x0048}] = newInfo(Tag{0x300A, 0x0048}, "SQ", "BeamLimitingDeviceToleranceSequence", "1", false)
+	tagDict[Tag{0x300A, 0x004A}] = newInfo(Tag{0x300A, 0x004A}, "DS", "BeamLimitingDevicePositionTolerance", "1", false)
+	tagDict[Tag{0x300A, 0x004B}] = newInfo(Tag{0x300A, 0x004B}, "FL", "SnoutPositionTolerance", "1", false)
+	tagDict[Tag{0x300A, 0x004C}] = newInfo(Tag{0x300A, 0x004C}, "DS", "PatientSupportAngleTolerance", "1", false)
+	tagDict[Tag{0x300A, 0x004E}] = newInfo(Tag{0x300A, 0x004E}, "DS", "TableTopEccentricAngleTolerance", "1", false)
+	tagDict[Tag{0x300A, 0x004F}] = newInfo(Tag{0x300A, 0x004F}, "FL", "TableTopPitchAngleTolerance", "1", false)
+	tagDict[Tag{0x300A, 0x0050}] = newInfo(Tag{0x300A, 0x0050}, "FL", "TableTopRollAngleTolerance", "1", false)
+	tagDict[Tag{0x300A, 0x0051}] = newInfo(Tag{0x300A, 0x0051}, "DS", "TableTopVerticalPositionTolerance", "1", false)
+	tagDict[Tag{0x300A, 0x0052}] = newInfo(Tag{0x300A, 0x0052}, "DS", "TableTopLongitudinalPositionTolerance", "1", false)
+	tagDict[Tag{0x300A, 0x0053}] = newInfo(Tag{0x300A, 0x0053}, "DS", "TableTopLateralPositionTolerance", "1", false)
+	tagDict[Tag{0x300A, 0x0055}] = newInfo(Tag{0x300A, 0x0055}, "CS", "RTPlanRelationship", "1", false)
+	tagDict[Tag{0x300A, 0x0070}] = newInfo(Tag{0x300A, 0x0070}, "SQ", "FractionGroupSequence", "1", false)
+	tagDict[Tag{0x300A, 0x0071}] = newInfo(Tag{0x300A, 0x0071}, "IS", "FractionGroupNumber", "1", false)
+	tagDict[Tag{0x300A, 0x0072}] = newInfo(Tag{0x300A, 0x0072}, "LO", "FractionGroupDescription", "1", false)
+	tagDict[Tag{0x300A, 0x0078}] = newInfo(Tag{0x300A, 0x0078}, "IS", "NumberOfFractionsPlanned", "1", false)
+	tagDict[Tag{0x300A, 0x0079}] = newInfo(Tag{0x300A, 0x0079}, "IS", "NumberOfFractionPatternDigitsPerDay", "1", false)
+	tagDict[Tag{0x300A, 0x007A}] = newInfo(Tag{0x300A, 0x007A}, "IS", "RepeatFractionCycleLength", "1", false)
+	tagDict[Tag{0x300A, 0x007B}] = newInfo(Tag{0x300A, 0x007B}, "LT", "FractionPattern", "1", false)
+	tagDict[Tag{0x300A, 0x0080}] = newInfo(Tag{0x300A, 0x0080}, "IS", "NumberOfBeams", "1", false)
+	tagDict[Tag{0x300A, 0x0082}] = newInfo(Tag{0x300A, 0x0082}, "DS", "BeamDoseSpecificationPoint", "3", false)
+	tagDict[Tag{0x300A, 0x0084}] = newInfo(Tag{0x300A, 0x0084}, "DS", "BeamDose", "1", false)
+	tagDict[Tag{0x300A, 0x0086}] = newInfo(Tag{0x300A, 0x0086}, "DS", "BeamMeterset", "1", false)
+	tagDict[Tag{0x300A, 0x0088}] = newInfo(Tag{0x300A, 0x0088}, "FL", "BeamDosePointDepth", "1", false)
+	tagDict[Tag{0x300A, 0x0089}] = newInfo(Tag{0x300A, 0x0089}, "FL", "BeamDosePointEquivalentDepth", "1", false)
+	tagDict[Tag{0x300A, 0x008A}] = newInfo(Tag{0x300A, 0x008A}, "FL", "BeamDosePointSSD", "1", false)
+	tagDict[Tag{0x300A, 0x00A0}] = newInfo(Tag{0x300A, 0x00A0}, "IS", "NumberOfBrachyApplicationSetups", "1", false)
+	tagDict[Tag{0x300A, 0x00A2}] = newInfo(Tag{0x300A, 0x00A2}, "DS", "BrachyApplicationSetupDoseSpecificationPoint", "3", false)
+	tagDict[Tag{0x300A, 0x00A4}] = newInfo(Tag{0x300A, 0x00A4}, "DS", "BrachyApplicationSetupDose", "1", false)
+	tagDict[Tag{0x300A, 0x00B0}] = newInfo(Tag{0x300A, 0x00B0}, "SQ", "BeamSequence", "1", false)
+	tagDict[Tag{0x300A, 0x00B2}] = newInfo(Tag{0x300A, 0x00B2}, "SH", "TreatmentMachineName", "1", false)
+	tagDict[Tag{0x300A, 0x00B3}] = newInfo(Tag{0x300A, 0x00B3}, "CS", "PrimaryDosimeterUnit", "1", false)
+	tagDict[Tag{0x300A, 0x00B4}] = newInfo(Tag{0x300A, 0x00B4}, "DS", "SourceAxisDistance", "1", false)
+	tagDict[Tag{0x300A, 0x00B6}] = newInfo(Tag{0x300A, 0x00B6}, "SQ", "BeamLimitingDeviceSequence", "1", false)
+	tagDict[Tag{0x300A, 0x00B8}] = newInfo(Tag{0x300A, 0x00B8}, "CS", "RTBeamLimitingDeviceType", "1", false)
+	tagDict[Tag{0x300A, 0x00BA}] = newInfo(Tag{0x300A, 0x00BA}, "DS", "SourceToBeamLimitingDeviceDistance", "1", false)
+	tagDict[Tag{0x300A, 0x00BB}] = newInfo(Tag{0x300A, 0x00BB}, "FL", "IsocenterToBeamLimitingDeviceDistance", "1", false)
+	tagDict[Tag{0x300A, 0x00BC}] = newInfo(Tag{0x300A, 0x00BC}, "IS", "NumberOfLeafJawPairs", "1", false)
+	tagDict[Tag{0x300A, 0x00BE}] = newInfo(Tag{0x300A, 0x00BE}, "DS", "LeafPositionBoundaries", "3-n", false)
+	tagDict[Tag{0x300A, 0x00C0}] = newInfo(Tag{0x300A, 0x00C0}, "IS", "BeamNumber", "1", false)
+	tagDict[Tag{0x300A, 0x00C2}] = newInfo(Tag{0x300A, 0x00C2}, "LO", "BeamName", "1", false)
+	tagDict[Tag{0x300A, 0x00C3}] = newInfo(Tag{0x300A, 0x00C3}, "ST", "BeamDescription", "1", false)
+	tagDict[Tag{0x300A, 0x00C4}] = newInfo(Tag{0x300A, 0x00C4}, "CS", "BeamType", "1", false)
+	tagDict[Tag{0x300A, 0x00C6}] = newInfo(Tag{0x300A, 0x00C6}, "CS", "RadiationType", "1", false)
+	tagDict[Tag{0x300A, 0x00C7}] = newInfo(Tag{0x300A, 0x00C7}, "CS", "HighDoseTechniqueType", "1", false)
+	tagDict[Tag{0x300A, 0x00C8}] = newInfo(Tag{0x300A, 0x00C8}, "IS", "ReferenceImageNumber", "1", false)
+	tagDict[Tag{0x300A, 0x00CA}] = newInfo(Tag{0x300A, 0x00CA}, "SQ", "PlannedVerificationImageSequence", "1", false)
+	tagDict[Tag{0x300A, 0x00CC}] = newInfo(Tag{0x300A, 0x00CC}, "LO", "ImagingDeviceSpecificAcquisitionParameters", "1-n", false)
+	tagDict[Tag{0x300A, 0x00CE}] = newInfo(Tag{0x300A, 0x00CE}, "CS", "TreatmentDeliveryType", "1", false)
+	tagDict[Tag{0x300A, 0x00D0}] = newInfo(Tag{0x300A, 0x00D0}, "IS", "NumberOfWedges", "1", false)
+	tagDict[Tag{0x300A, 0x00D1}] = newInfo(Tag{0x300A, 0x00D1}, "SQ", "WedgeSequence", "1", false)
+	tagDict[Tag{0x300A, 0x00D2}] = newInfo(Tag{0x300A, 0x00D2}, "IS", "WedgeNumber", "1", false)
+	tagDict[Tag{0x300A, 0x00D3}] = newInfo(Tag{0x300A, 0x00D3}, "CS", "WedgeType", "1", false)
+	tagDict[Tag{0x300A, 0x00D4}] = newInfo(Tag{0x300A, 0x00D4}, "SH", "WedgeID", "1", false)
+	tagDict[Tag{0x300A, 0x00D5}] = newInfo(Tag{0x300A, 0x00D5}, "IS", "WedgeAngle", "1", false)
+	tagDict[Tag{0x300A, 0x00D6}] = newInfo(Tag{0x300A, 0x00D6}, "DS", "WedgeFactor", "1", false)
+	tagDict[Tag{0x300A, 0x00D7}] = newInfo(Tag{0x300A, 0x00D7}, "FL", "TotalWedgeTrayWaterEquivalentThickness", "1", false)
+	tagDict[Tag{0x300A, 0x00D8}] = newInfo(Tag{0x300A, 0x00D8}, "DS", "WedgeOrientation", "1", false)
+	tagDict[Tag{0x300A, 0x00D9}] = newInfo(Tag{0x300A, 0x00D9}, "FL", "IsocenterToWedgeTrayDistance", "1", false)
+	tagDict[Tag{0x300A, 0x00DA}] = newInfo(Tag{0x300A, 0x00DA}, "DS", "SourceToWedgeTrayDistance", "1", false)
+	tagDict[Tag{0x300A, 0x00DB}] = newInfo(Tag{0x300A, 0x00DB}, "FL", "WedgeThinEdgePosition", "1", false)
+	tagDict[Tag{0x300A, 0x00DC}] = newInfo(Tag{0x300A, 0x00DC}, "SH", "BolusID", "1", false)
+	tagDict[Tag{0x300A, 0x00DD}] = newInfo(Tag{0x300A, 0x00DD}, "ST", "BolusDescription", "1", false)
+	tagDict[Tag{0x300A, 0x00E0}] = newInfo(Tag{0x300A, 0x00E0}, "IS", "NumberOfCompensators", "1", false)
+	tagDict[Tag{0x300A, 0x00E1}] = newInfo(Tag{0x300A, 0x00E1}, "SH", "MaterialID", "1", false)
+	tagDict[Tag{0x300A, 0x00E2}] = newInfo(Tag{0x300A, 0x00E2}, "DS", "TotalCompensatorTrayFactor", "1", false)
+	tagDict[Tag{0x300A, 0x00E3}] = newInfo(Tag{0x300A, 0x00E3}, "SQ", "CompensatorSequence", "1", false)
+	tagDict[Tag{0x300A, 0x00E4}] = newInfo(Tag{0x300A, 0x00E4}, "IS", "CompensatorNumber", "1", false)
+	tagDict[Tag{0x300A, 0x00E5}] = newInfo(Tag{0x300A, 0x00E5}, "SH", "CompensatorID", "1", false)
+	tagDict[Tag{0x300A, 0x00E6}] = newInfo(Tag{0x300A, 0x00E6}, "DS", "SourceToCompensatorTrayDistance", "1", false)
+	tagDict[Tag{0x300A, 0x00E7}] = newInfo(Tag{0x300A, 0x00E7}, "IS", "CompensatorRows", "1", false)
+	tagDict[Tag{0x300A, 0x00E8}] = newInfo(Tag{0x300A, 0x00E8}, "IS", "CompensatorColumns", "1", false)
+	tagDict[Tag{0x300A, 0x00E9}] = newInfo(Tag{0x300A, 0x00E9}, "DS", "CompensatorPixelSpacing", "2", false)
+	tagDict[Tag{0x300A, 0x00EA}] = newInfo(Tag{0x300A, 0x00EA}, "DS", "CompensatorPosition", "2", false)
+	tagDict[Tag{0x300A, 0x00EB}] = newInfo(Tag{0x300A, 0x00EB}, "DS", "CompensatorTransmissionData", "1-n", false)
+	tagDict[Tag{0x300A, 0x00EC}] = newInfo(Tag{0x300A, 0x00EC}, "DS", "CompensatorThicknessData", "1-n", false)
+	tagDict[Tag{0x300A, 0x00ED}] = newInfo(Tag{0x300A, 0x00ED}, "IS", "NumberOfBoli", "1", false)
+	tagDict[Tag{0x300A, 0x00EE}] = newInfo(Tag{0x300A, 0x00EE}, "CS", "CompensatorType", "1", false)
+	tagDict[Tag{0x300A, 0x00F0}] = newInfo(Tag{0x300A, 0x00F0}, "IS", "NumberOfBlocks", "1", false)
+	tagDict[Tag{0x300A, 0x00F2}] = newInfo(Tag{0x300A, 0x00F2}, "DS", "TotalBlockTrayFactor", "1", false)
+	tagDict[Tag{0x300A, 0x00F3}] = newInfo(Tag{0x300A, 0x00F3}, "FL", "TotalBlockTrayWaterEquivalentThickness", "1", false)
+	tagDict[Tag{0x300A, 0x00F4}] = newInfo(Tag{0x300A, 0x00F4}, "SQ", "BlockSequence", "1", false)
+	tagDict[Tag{0x300A, 0x00F5}] = newInfo(Tag{0x300A, 0x00F5}, "SH", "BlockTrayID", "1", false)
+	tagDict[Tag{0x300A, 0x00F6}] = newInfo(Tag{0x300A, 0x00F6}, "DS", "SourceToBlockTrayDistance", "1", false)
+	tagDict[Tag{0x300A, 0x00F7}] = newInfo(Tag{0x300A, 0x00F7}, "FL", "IsocenterToBlockTrayDistance", "1", false)
+	tagDict[Tag{0x300A, 0x00F8}] = newInfo(Tag{0x300A, 0x00F8}, "CS", "BlockType", "1", false)
+	tagDict[Tag{0x300A, 0x00F9}] = newInfo(Tag{0x300A, 0x00F9}, "LO", "AccessoryCode", "1", false)
+	tagDict[Tag{0x300A, 0x00FA}] = newInfo(Tag{0x300A, 0x00FA}, "CS", "BlockDivergence", "1", false)
+	tagDict[Tag{0x300A, 0x00FB}] = newInfo(Tag{0x300A, 0x00FB}, "CS", "BlockMountingPosition", "1", false)
+	tagDict[Tag{0x300A, 0x00FC}] = newInfo(Tag{0x300A, 0x00FC}, "IS", "BlockNumber", "1", false)
+	tagDict[Tag{0x300A, 0x00FE}] = newInfo(Tag{0x300A, 0x00FE}, "LO", "BlockName", "1", false)
+	tagDict[Tag{0x300A, 0x0100}] = newInfo(Tag{0x300A, 0x0100}, "DS", "BlockThickness", "1", false)
+	tagDict[Tag{0x300A, 0x0102}] = newInfo(Tag{0x300A, 0x0102}, "DS", "BlockTransmission", "1", false)
+	tagDict[Tag{0x300A, 0x0104}] = newInfo(Tag{0x300A, 0x0104}, "IS", "BlockNumberOfPoints", "1", false)
+	tagDict[Tag{0x300A, 0x0106}] = newInfo(Tag{0x300A, 0x0106}, "DS", "BlockData", "2-2n", false)
+	tagDict[Tag{0x300A, 0x0107}] = newInfo(Tag{0x300A, 0x0107}, "SQ", "ApplicatorSequence", "1", false)
+	tagDict[Tag{0x300A, 0x0108}] = newInfo(Tag{0x300A, 0x0108}, "SH", "ApplicatorID", "1", false)
+	tagDict[Tag{0x300A, 0x0109}] = newInfo(Tag{0x300A, 0x0109}, "CS", "ApplicatorType", "1", false)
+	tagDict[Tag{0x300A, 0x010A}] = newInfo(Tag{0x300A, 0x010A}, "LO", "ApplicatorDescription", "1", false)
+	tagDict[Tag{0x300A, 0x010C}] = newInfo(Tag{0x300A, 0x010C}, "DS", "CumulativeDoseReferenceCoefficient", "1", false)
+	tagDict[Tag{0x300A, 0x010E}] = newInfo(Tag{0x300A, 0x010E}, "DS", "FinalCumulativeMetersetWeight", "1", false)
+	tagDict[Tag{0x300A, 0x0110}] = newInfo(Tag{0x300A, 0x0110}, "IS", "NumberOfControlPoints", "1", false)
+	tagDict[Tag{0x300A, 0x0111}] = newInfo(Tag{0x300A, 0x0111}, "SQ", "ControlPointSequence", "1", false)
+	tagDict[Tag{0x300A, 0x0112}] = newInfo(Tag{0x300A, 0x0112}, "IS", "ControlPointIndex", "1", false)
+	tagDict[Tag{0x300A, 0x0114}] = newInfo(Tag{0x300A, 0x0114}, "DS", "NominalBeamEnergy", "1", false)
+	tagDict[Tag{0x300A, 0x0115}] = newInfo(Tag{0x300A, 0x0115}, "DS", "DoseRateSet", "1", false)
+	tagDict[Tag{0x300A, 0x0116}] = newInfo(Tag{0x300A, 0x0116}, "SQ", "WedgePositionSequence", "1", false)
+	tagDict[Tag{0x300A, 0x0118}] = newInfo(Tag{0x300A, 0x0118}, "CS", "WedgePosition", "1", false)
+	tagDict[Tag{0x300A, 0x011A}] = newInfo(Tag{0x300A, 0x011A}, "SQ", "BeamLimitingDevicePositionSequence", "1", false)
+	tagDict[Tag{0x300A, 0x011C}] = newInfo(Tag{0x300A, 0x011C}, "DS", "LeafJawPositions", "2-2n", false)
+	tagDict[Tag{0x300A, 0x011E}] = newInfo(Tag{0x300A, 0x011E}, "DS", "GantryAngle", "1", false)
+	tagDict[Tag{0x300A, 0x011F}] = newInfo(Tag{0x300A, 0x011F}, "CS", "GantryRotationDirection", "1", false)
+	tagDict[Tag{0x300A, 0x0120}] = newInfo(Tag{0x300A, 0x0120}, "DS", "BeamLimitingDeviceAngle", "1", false)
+	tagDict[Tag{0x300A, 0x0121}] = newInfo(Tag{0x300A, 0x0121}, "CS", "BeamLimitingDeviceRotationDirection", "1", false)
+	tagDict[Tag{0x300A, 0x0122}] = newInfo(Tag{0x300A, 0x0122}, "DS", "PatientSupportAngle", "1", false)
+	tagDict[Tag{0x300A, 0x0123}] = newInfo(Tag{0x300A, 0x0123}, "CS", "PatientSupportRotationDirection", "1", false)
+	tagDict[Tag{0x300A, 0x0124}] = newInfo(Tag{0x300A, 0x0124}, "DS", "TableTopEccentricAxisDistance", "1", false)
+	tagDict[Tag{0x300A, 0x0125}] = newInfo(Tag{0x300A, 0x0125}, "DS", "TableTopEccentricAngle", "1", false)
+	tagDict[Tag{0x300A, 0x0126}] = newInfo(Tag{0x300A, 0x0126}, "CS", "TableTopEccentricRotationDirection", "1", false)
+	tagDict[Tag{0x300A, 0x0128}] = newInfo(Tag{0x300A, 0x0128}, "DS", "TableTopVerticalPosition", "1", false)
+	tagDict[Tag{0x300A, 0x0129}] = newInfo(Tag{0x300A, 0x0129}, "DS", "TableTopLongitudinalPosition", "1", false)
+	tagDict[Tag{0x300A, 0x012A}] = newInfo(Tag{0x300A, 0x012A}, "DS", "TableTopLateralPosition", "1", false)
+	tagDict[Tag{0x300A, 0x012C}] = newInfo(Tag{0x300A, 0x012C}, "DS", "IsocenterPosition", "3", false)
+	tagDict[Tag{0x300A, 0x012E}] = newInfo(Tag{0x300A, 0x012E}, "DS", "SurfaceEntryPoint", "3", false)
+	tagDict[Tag{0x300A, 0x0130}] = newInfo(Tag{0x300A, 0x0130}, "DS", "SourceToSurfaceDistance", "1", false)
+	tagDict[Tag{0x300A, 0x0134}] = newInfo(Tag{0x300A, 0x0134}, "DS", "CumulativeMetersetWeight", "1", false)
+	tagDict[Tag{0x300A, 0x0140}] = newInfo(Tag{0x300A, 0x0140}, "FL", "TableTopPitchAngle", "1", false)
+	tagDict[Tag{0x300A, 0x0142}] = newInfo(Tag{0x300A, 0x0142}, "CS", "TableTopPitchRotationDirection", "1", false)
+	tagDict[Tag{0x300A, 0x0144}] = newInfo(Tag{0x300A, 0x0144}, "FL", "TableTopRollAngle", "1", false)
+	tagDict[Tag{0x300A, 0x0146}] = newInfo(Tag{0x300A, 0x0146}, "CS", "TableTopRollRotationDirection", "1", false)
+	tagDict[Tag{0x300A, 0x0148}] = newInfo(Tag{0x300A, 0x0148}, "FL", "HeadFixationAngle", "1", false)
+	tagDict[Tag{0x300A, 0x014A}] = newInfo(Tag{0x300A, 0x014A}, "FL", "GantryPitchAngle", "1", false)
+	tagDict[Tag{0x300A, 0x014C}] = newInfo(Tag{0x300A, 0x014C}, "CS", "GantryPitchRotationDirection", "1", false)
+	tagDict[Tag{0x300A, 0x014E}] = newInfo(Tag{0x300A, 0x014E}, "FL", "GantryPitchAngleTolerance", "1", false)
+	tagDict[Tag{0x300A, 0x0180}] = newInfo(Tag{0x300A, 0x0180}, "SQ", "PatientSetupSequence", "1", false)
+	tagDict[Tag{0x300A, 0x0182}] = newInfo(Tag{0x300A, 0x0182}, "IS", "PatientSetupNumber", "1", false)
+	tagDict[Tag{0x300A, 0x0183}] = newInfo(Tag{0x300A, 0x0183}, "LO", "PatientSetupLabel", "1", false)
+	tagDict[Tag{0x300A, 0x0184}] = newInfo(Tag{0x300A, 0x0184}, "LO", "PatientAdditionalPosition", "1", false)
+	tagDict[Tag{0x300A, 0x0190}] = newInfo(Tag{0x300A, 0x0190}, "SQ", "FixationDeviceSequence", "1", false)
+	tagDict[Tag{0x300A, 0x0192}] = newInfo(Tag{0x300A, 0x0192}, "CS", "FixationDeviceType", "1", false)
+	tagDict[Tag{0x300A, 0x0194}] = newInfo(Tag{0x300A, 0x0194}, "SH", "FixationDeviceLabel", "1", false)
+	tagDict[Tag{0x300A, 0x0196}] = newInfo(Tag{0x300A, 0x0196}, "ST", "FixationDeviceDescription", "1", false)
+	tagDict[Tag{0x300A, 0x0198}] = newInfo(Tag{0x300A, 0x0198}, "SH", "FixationDevicePosition", "1", false)
+	tagDict[Tag{0x300A, 0x0199}] = newInfo(Tag{0x300A, 0x0199}, "FL", "FixationDevicePitchAngle", "1", false)
+	tagDict[Tag{0x300A, 0x019A}] = newInfo(Tag{0x300A, 0x019A}, "FL", "FixationDeviceRollAngle", "1", false)
+	tagDict[Tag{0x300A, 0x01A0}] = newInfo(Tag{0x300A, 0x01A0}, "SQ", "ShieldingDeviceSequence", "1", false)
+	tagDict[Tag{0x300A, 0x01A2}] = newInfo(Tag{0x300A, 0x01A2}, "CS", "ShieldingDeviceType", "1", false)
+	tagDict[Tag{0x300A, 0x01A4}] = newInfo(Tag{0x300A, 0x01A4}, "SH", "ShieldingDeviceLabel", "1", false)
+	tagDict[Tag{0x300A, 0x01A6}] = newInfo(Tag{0x300A, 0x01A6}, "ST", "ShieldingDeviceDescription", "1", false)
+	tagDict[Tag{0x300A, 0x01A8}] = newInfo(Tag{0x300A, 0x01A8}, "SH", "ShieldingDevicePosition", "1", false)
+	tagDict[Tag{0x300A, 0x01B0}] = newInfo(Tag{0x300A, 0x01B0}, "CS", "SetupTechnique", "1", false)
+	tagDict[Tag{0x300A, 0x01B2}] = newInfo(Tag{0x300A, 0x01B2}, "ST", "SetupTechniqueDescription", "1", false)
+	tagDict[Tag{0x300A, 0x01B4}] = newInfo(Tag{0x300A, 0x01B4}, "SQ", "SetupDeviceSequence", "1", false)
+	tagDict[Tag{0x300A, 0x01B6}] = newInfo(Tag{0x300A, 0x01B6}, "CS", "SetupDeviceType", "1", false)
+	tagDict[Tag{0x300A, 0x01B8}] = newInfo(Tag{0x300A, 0x01B8}, "SH", "SetupDeviceLabel", "1", false)
+	tagDict[Tag{0x300A, 0x01BA}] = newInfo(Tag{0x300A, 0x01BA}, "ST", "SetupDeviceDescription", "1", false)
+	tagDict[Tag{0x300A, 0x01BC}] = newInfo(Tag{0x300A, 0x01BC}, "DS", "SetupDeviceParameter", "1", false)
+	tagDict[Tag{0x300A, 0x01D0}] = newInfo(Tag{0x300A, 0x01D0}, "ST", "SetupReferenceDescription", "1", false)
+	tagDict[Tag{0x300A, 0x01D2}] = newInfo(Tag{0x300A, 0x01D2}, "DS", "TableTopVerticalSetupDisplacement", "1", false)
+	tagDict[Tag{0x300A, 0x01D4}] = newInfo(Tag{0x300A, 0x01D4}, "DS", "TableTopLongitudinalSetupDisplacement", "1", false)
+	tagDict[Tag{0x300A, 0x01D6}] = newInfo(Tag{0x300A, 0x01D6}, "DS", "TableTopLateralSetupDisplacement", "1", false)
+	tagDict[Tag{0x300A, 0x0200}] = newInfo(Tag{0x300A, 0x0200}, "CS", "BrachyTreatmentTechnique", "1", false)
+	tagDict[Tag{0x300A, 0x0202}] = newInfo(Tag{0x300A, 0x0202}, "CS", "BrachyTreatmentType", "1", false)
+	tagDict[Tag{0x300A, 0x0206}] = newInfo(Tag{0x300A, 0x0206}, "SQ", "TreatmentMachineSequence", "1", false)
+	tagDict[Tag{0x300A, 0x0210}] = newInfo(Tag{0x300A, 0x0210}, "SQ", "SourceSequence", "1", false)
+	tagDict[Tag{0x300A, 0x0212}] = newInfo(Tag{0x300A, 0x0212}, "IS", "SourceNumber", "1", false)
+	tagDict[Tag{0x300A, 0x0214}] = newInfo(Tag{0x300A, 0x0214}, "CS", "SourceType", "1", false)
+	tagDict[Tag{0x300A, 0x0216}] = newInfo(Tag{0x300A, 0x0216}, "LO", "SourceManufacturer", "1", false)
+	tagDict[Tag{0x300A, 0x0218}] = newInfo(Tag{0x300A, 0x0218}, "DS", "ActiveSourceDiameter", "1", false)
+	tagDict[Tag{0x300A, 0x021A}] = newInfo(Tag{0x300A, 0x021A}, "DS", "ActiveSourceLength", "1", false)
+	tagDict[Tag{0x300A, 0x0222}] = newInfo(Tag{0x300A, 0x0222}, "DS", "SourceEncapsulationNominalThickness", "1", false)
+	tagDict[Tag{0x300A, 0x0224}] = newInfo(Tag{0x300A, 0x0224}, "DS", "SourceEncapsulationNominalTransmission", "1", false)
+	tagDict[Tag{0x300A, 0x0226}] = newInfo(Tag{0x300A, 0x0226}, "LO", "SourceIsotopeName", "1", false)
+	tagDict[Tag{0x300A, 0x0228}] = newInfo(Tag{0x300A, 0x0228}, "DS", "SourceIsotopeHalfLife", "1", false)
+	tagDict[Tag{0x300A, 0x0229}] = newInfo(Tag{0x300A, 0x0229}, "CS", "SourceStrengthUnits", "1", false)
+	tagDict[Tag{0x300A, 0x022A}] = newInfo(Tag{0x300A, 0x022A}, "DS", "ReferenceAirKermaRate", "1", false)
+	tagDict[Tag{0x300A, 0x022B}] = newInfo(Tag{0x300A, 0x022B}, "DS", "SourceStrength", "1", false)
+	tagDict[Tag{0x300A, 0x022C}] = newInfo(Tag{0x300A, 0x022C}, "DA", "SourceStrengthReferenceDate", "1", false)
+	tagDict[Tag{0x300A, 0x022E}] = newInfo(Tag{0x300A, 0x022E}, "TM", "SourceStrengthReferenceTime", "1", false)
+	tagDict[Tag{0x300A, 0x0230}] = newInfo(Tag{0x300A, 0x0230}, "SQ", "ApplicationSetupSequence", "1", false)
+	tagDict[Tag{0x300A, 0x0232}] = newInfo(Tag{0x300A, 0x0232}, "CS", "ApplicationSetupType", "1", false)
+	tagDict[Tag{0x300A, 0x0234}] = newInfo(Tag{0x300A, 0x0234}, "IS", "ApplicationSetupNumber", "1", false)
+	tagDict[Tag{0x300A, 0x0236}] = newInfo(Tag{0x300A, 0x0236}, "LO", "ApplicationSetupName", "1", false)
+	tagDict[Tag{0x300A, 0x0238}] = newInfo(Tag{0x300A, 0x0238}, "LO", "ApplicationSetupManufacturer", "1", false)
+	tagDict[Tag{0x300A, 0x0240}] = newInfo(Tag{0x300A, 0x0240}, "IS", "TemplateNumber", "1", false)
+	tagDict[Tag{0x300A, 0x0242}] = newInfo(Tag{0x300A, 0x0242}, "SH", "TemplateType", "1", false)
+	tagDict[Tag{0x300A, 0x0244}] = newInfo(Tag{0x300A, 0x0244}, "LO", "TemplateName", "1", false)
+	tagDict[Tag{0x300A, 0x0250}] = newInfo(Tag{0x300A, 0x0250}, "DS", "TotalReferenceAirKerma", "1", false)
+	tagDict[Tag{0x300A, 0x0260}] = newInfo(Tag{0x300A, 0x0260}, "SQ", "BrachyAccessoryDeviceSequence", "1", false)
+	tagDict[Tag{0x300A, 0x0262}] = newInfo(Tag{0x300A, 0x0262}, "IS", "BrachyAccessoryDeviceNumber", "1", false)
+	tagDict[Tag{0x300A, 0x0263}] = newInfo(Tag{0x300A, 0x0263}, "SH", "BrachyAccessoryDeviceID", "1", false)
+	tagDict[Tag{0x300A, 0x0264}] = newInfo(Tag{0x300A, 0x0264}, "CS", "BrachyAccessoryDeviceType", "1", false)
+	tagDict[Tag{0x300A, 0x0266}] = newInfo(Tag{0x300A, 0x0266}, "LO", "BrachyAccessoryDeviceName", "1", false)
+	tagDict[Tag{0x300A, 0x026A}] = newInfo(Tag{0x300A, 0x026A}, "DS", "BrachyAccessoryDeviceNominalThickness", "1", false)
+	tagDict[Tag{0x300A, 0x026C}] = newInfo(Tag{0x300A, 0x026C}, "DS", "BrachyAccessoryDeviceNominalTransmission", "1", false)
+	tagDict[Tag{0x300A, 0x0280}] = newInfo(Tag{0x300A, 0x0280}, "SQ", "ChannelSequence", "1", false)
+	tagDict[Tag{0x300A, 0x0282}] = newInfo(Tag{0x300A, 0x0282}, "IS", "ChannelNumber", "1", false)
+	tagDict[Tag{0x300A, 0x0284}] = newInfo(Tag{0x300A, 0x0284}, "DS", "ChannelLength", "1", false)
+	tagDict[Tag{0x300A, 0x0286}] = newInfo(Tag{0x300A, 0x0286}, "DS", "ChannelTotalTime", "1", false)
+	tagDict[Tag{0x300A, 0x0288}] = newInfo(Tag{0x300A, 0x0288}, "CS", "SourceMovementType", "1", false)
+	tagDict[Tag{0x300A, 0x028A}] = newInfo(Tag{0x300A, 0x028A}, "IS", "NumberOfPulses", "1", false)
+	tagDict[Tag{0x300A, 0x028C}] = newInfo(Tag{0x300A, 0x028C}, "DS", "PulseRepetitionInterval", "1", false)
+	tagDict[Tag{0x300A, 0x0290}] = newInfo(Tag{0x300A, 0x0290}, "IS", "SourceApplicatorNumber", "1", false)
+	tagDict[Tag{0x300A, 0x0291}] = newInfo(Tag{0x300A, 0x0291}, "SH", "SourceApplicatorID", "1", false)
+	tagDict[Tag{0x300A, 0x0292}] = newInfo(Tag{0x300A, 0x0292}, "CS", "SourceApplicatorType", "1", false)
+	tagDict[Tag{0x300A, 0x0294}] = newInfo(Tag{0x300A, 0x0294}, "LO", "SourceApplicatorName", "1", false)
+	tagDict[Tag{0x300A, 0x0296}] = newInfo(Tag{0x300A, 0x0296}, "DS", "SourceApplicatorLength", "1", false)
+	tagDict[Tag{0x300A, 0x0298}] = newInfo(Tag{0x300A, 0x0298}, "LO", "SourceApplicatorManufacturer", "1", false)
+	tagDict[Tag{0x300A, 0x029C}] = newInfo(Tag{0x300A, 0x029C}, "DS", "SourceApplicatorWallNominalThickness", "1", false)
+	tagDict[Tag{0x300A, 0x029E}] = newInfo(Tag{0x300A, 0x029E}, "DS", "SourceApplicatorWallNominalTransmission", "1", false)
+	tagDict[Tag{0x300A, 0x02A0}] = newInfo(Tag{0x300A, 0x02A0}, "DS", "SourceApplicatorStepSize", "1", false)
+	tagDict[Tag{0x300A, 0x02A2}] = newInfo(Tag{0x300A, 0x02A2}, "IS", "TransferTubeNumber", "1", false)
+	tagDict[Tag{0x300A, 0x02A4}] = newInfo(Tag{0x300A, 0x02A4}, "DS", "TransferTubeLength", "1", false)
+	tagDict[Tag{0x300A, 0x02B0}] = newInfo(Tag{0x300A, 0x02B0}, "SQ", "ChannelShieldSequence", "1", false)
+	tagDict[Tag{0x300A, 0x02B2}] = newInfo(Tag{0x300A, 0x02B2}, "IS", "ChannelShieldNumber", "1", false)
+	tagDict[Tag{0x300A, 0x02B3}] = newInfo(Tag{0x300A, 0x02B3}, "SH", "ChannelShieldID", "1", false)
+	tagDict[Tag{0x300A, 0x02B4}] = newInfo(Tag{0x300A, 0x02B4}, "LO", "ChannelShieldName", "1", false)
+	tagDict[Tag{0x300A, 0x02B8}] = newInfo(Tag{0x300A, 0x02B8}, "DS", "ChannelShieldNominalThickness", "1", false)
+	tagDict[Tag{0x300A, 0x02BA}] = newInfo(Tag{0x300A, 0x02BA}, "DS", "ChannelShieldNominalTransmission", "1", false)
+	tagDict[Tag{0x300A, 0x02C8}] = newInfo(Tag{0x300A, 0x02C8}, "DS", "FinalCumulativeTimeWeight", "1", false)
+	tagDict[Tag{0x300A, 0x02D0}] = newInfo(Tag{0x300A, 0x02D0}, "SQ", "BrachyControlPointSequence", "1", false)
+	tagDict[Tag{0x300A, 0x02D2}] = newInfo(Tag{0x300A, 0x02D2}, "DS", "ControlPointRelativePosition", "1", false)
+	tagDict[Tag{0x300A, 0x02D4}] = newInfo(Tag{0x300A, 0x02D4}, "DS", "ControlPoint3DPosition", "3", false)
+	tagDict[Tag{0x300A, 0x02D6}] = newInfo(Tag{0x300A, 0x02D6}, "DS", "CumulativeTimeWeight", "1", false)
+	tagDict[Tag{0x300A, 0x02E0}] = newInfo(Tag{0x300A, 0x02E0}, "CS", "CompensatorDivergence", "1", false)
+	tagDict[Tag{0x300A, 0x02E1}] = newInfo(Tag{0x300A, 0x02E1}, "CS", "CompensatorMountingPosition", "1", false)
+	tagDict[Tag{0x300A, 0x02E2}] = newInfo(Tag{0x300A, 0x02E2}, "DS", "SourceToCompensatorDistance", "1-n", false)
+	tagDict[Tag{0x300A, 0x02E3}] = newInfo(Tag{0x300A, 0x02E3}, "FL", "TotalCompensatorTrayWaterEquivalentThickness", "1", false)
+	tagDict[Tag{0x300A, 0x02E4}] = newInfo(Tag{0x300A, 0x02E4}, "FL", "IsocenterToCompensatorTrayDistance", "1", false)
+	tagDict[Tag{0x300A, 0x02E5}] = newInfo(Tag{0x300A, 0x02E5}, "FL", "CompensatorColumnOffset", "1", false)
+	tagDict[Tag{0x300A, 0x02E6}] = newInfo(Tag{0x300A, 0x02E6}, "FL", "IsocenterToCompensatorDistances", "1-n", false)
+	tagDict[Tag{0x300A, 0x02E7}] = newInfo(Tag{0x300A, 0x02E7}, "FL", "CompensatorRelativeStoppingPowerRatio", "1", false)
+	tagDict[Tag{0x300A, 0x02E8}] = newInfo(Tag{0x300A, 0x02E8}, "FL", "CompensatorMillingToolDiameter", "1", false)
+	tagDict[Tag{0x300A, 0x02EA}] = newInfo(Tag{0x300A, 0x02EA}, "SQ", "IonRangeCompensatorSequence", "1", false)
+	tagDict[Tag{0x300A, 0x02EB}] = newInfo(Tag{0x300A, 0x02EB}, "LT", "CompensatorDescription", "1", false)
+	tagDict[Tag{0x300A, 0x0302}] = newInfo(Tag{0x300A, 0x0302}, "IS", "RadiationMassNumber", "1", false)
+	tagDict[Tag{0x300A, 0x0304}] = newInfo(Tag{0x300A, 0x0304}, "IS", "RadiationAtomicNumber", "1", false)
+	tagDict[Tag{0x300A, 0x0306}] = newInfo(Tag{0x300A, 0x0306}, "SS", "RadiationChargeState", "1", false)
+	tagDict[Tag{0x300A, 0x0308}] = newInfo(Tag{0x300A, 0x0308}, "CS", "ScanMode", "1", false)
+	tagDict[Tag{0x300A, 0x030A}] = newInfo(Tag{0x300A, 0x030A}, "FL", "VirtualSourceAxisDistances", "2", false)
+	tagDict[Tag{0x300A, 0x030C}] = newInfo(Tag{0x300A, 0x030C}, "SQ", "SnoutSequence", "1", false)
+	tagDict[Tag{0x300A, 0x030D}] = newInfo(Tag{0x300A, 0x030D}, "FL", "SnoutPosition", "1", false)
+	tagDict[Tag{0x300A, 0x030F}] = newInfo(Tag{0x300A, 0x030F}, "SH", "SnoutID", "1", false)
+	tagDict[Tag{0x300A, 0x0312}] = newInfo(Tag{0x300A, 0x0312}, "IS", "NumberOfRangeShifters", "1", false)
+	tagDict[Tag{0x300A, 0x0314}] = newInfo(Tag{0x300A, 0x0314}, "SQ", "RangeShifterSequence", "1", false)
+	tagDict[Tag{0x300A, 0x0316}] = newInfo(Tag{0x300A, 0x0316}, "IS", "RangeShifterNumber", "1", false)
+	tagDict[Tag{0x300A, 0x0318}] = newInfo(Tag{0x300A, 0x0318}, "SH", "RangeShifterID", "1", false)
+	tagDict[Tag{0x300A, 0x0320}] = newInfo(Tag{0x300A, 0x0320}, "CS", "RangeShifterType", "1", false)
+	tagDict[Tag{0x300A, 0x0322}] = newInfo(Tag{0x300A, 0x0322}, "LO", "RangeShifterDescription", "1", false)
+	tagDict[Tag{0x300A, 0x0330}] = newInfo(Tag{0x300A, 0x0330}, "IS", "NumberOfLateralSpreadingDevices", "1", false)
+	tagDict[Tag{0x300A, 0x0332}] = newInfo(Tag{0x300A, 0x0332}, "SQ", "LateralSpreadingDeviceSequence", "1", false)
+	tagDict[Tag{0x300A, 0x0334}] = newInfo(Tag{0x300A, 0x0334}, "IS", "LateralSpreadingDeviceNumber", "1", false)
+	tagDict[Tag{0x300A, 0x0336}] = newInfo(Tag{0x300A, 0x0336}, "SH", "LateralSpreadingDeviceID", "1", false)
+	tagDict[Tag{0x300A, 0x0338}] = newInfo(Tag{0x300A, 0x0338}, "CS", "LateralSpreadingDeviceType", "1", false)
+	tagDict[Tag{0x300A, 0x033A}] = newInfo(Tag{0x300A, 0x033A}, "LO", "LateralSpreadingDeviceDescription", "1", false)
+	tagDict[Tag{0x300A, 0x033C}] = newInfo(Tag{0x300A, 0x033C}, "FL", "LateralSpreadingDeviceWaterEquivalentThickness", "1", false)
+	tagDict[Tag{0x300A, 0x0340}] = newInfo(Tag{0x300A, 0x0340}, "IS", "NumberOfRangeModulators", "1", false)
+	tagDict[Tag{0x300A, 0x0342}] = newInfo(Tag{0x300A, 0x0342}, "SQ", "RangeModulatorSequence", "1", false)
+	tagDict[Tag{0x300A, 0x0344}] = newInfo(Tag{0x300A, 0x0344}, "IS", "RangeModulatorNumber", "1", false)
+	tagDict[Tag{0x300A, 0x0346}] = newInfo(Tag{0x300A, 0x0346}, "SH", "RangeModulatorID", "1", false)
+	tagDict[Tag{0x300A, 0x0348}] = newInfo(Tag{0x300A, 0x0348}, "CS", "RangeModulatorType", "1", false)
+	tagDict[Tag{0x300A, 0x034A}] = newInfo(Tag{0x300A, 0x034A}, "LO", "RangeModulatorDescription", "1", false)
+	tagDict[Tag{0x300A, 0x034C}] = newInfo(Tag{0x300A, 0x034C}, "SH", "BeamCurrentModulationID", "1", false)
+	tagDict[Tag{0x300A, 0x0350}] = newInfo(Tag{0x300A, 0x0350}, "CS", "PatientSupportType", "1", false)
+	tagDict[Tag{0x300A, 0x0352}] = newInfo(Tag{0x300A, 0x0352}, "SH", "PatientSupportID", "1", false)
+	tagDict[Tag{0x300A, 0x0354}] = newInfo(Tag{0x300A, 0x0354}, "LO", "PatientSupportAccessoryCode", "1", false)
+	tagDict[Tag{0x300A, 0x0356}] = newInfo(Tag{0x300A, 0x0356}, "FL", "FixationLightAzimuthalAngle", "1", false)
+	tagDict[Tag{0x300A, 0x0358}] = newInfo(Tag{0x300A, 0x0358}, "FL", "FixationLightPolarAngle", "1", false)
+	tagDict[Tag{0x300A, 0x035A}] = newInfo(Tag{0x300A, 0x035A}, "FL", "MetersetRate", "1", false)
+	tagDict[Tag{0x300A, 0x0360}] = newInfo(Tag{0x300A, 0x0360}, "SQ", "RangeShifterSettingsSequence", "1", false)
+	tagDict[Tag{0x300A, 0x0362}] = newInfo(Tag{0x300A, 0x0362}, "LO", "RangeShifterSetting", "1", false)
+	tagDict[Tag{0x300A, 0x0364}] = newInfo(Tag{0x300A, 0x0364}, "FL", "IsocenterToRangeShifterDistance", "1", false)
+	tagDict[Tag{0x300A, 0x0366}] = newInfo(Tag{0x300A, 0x0366}, "FL", "RangeShifterWaterEquivalentThickness", "1", false)
+	tagDict[Tag{0x300A, 0x0370}] = newInfo(Tag{0x300A, 0x0370}, "SQ", "LateralSpreadingDeviceSettingsSequence", "1", false)
+	tagDict[Tag{0x300A, 0x0372}] = newInfo(Tag{0x300A, 0x0372}, "LO", "LateralSpreadingDeviceSetting", "1", false)
+	tagDict[Tag{0x300A, 0x0374}] = newInfo(Tag{0x300A, 0x0374}, "FL", "IsocenterToLateralSpreadingDeviceDistance", "1", false)
+	tagDict[Tag{0x300A, 0x0380}] = newInfo(Tag{0x300A, 0x0380}, "SQ", "RangeModulatorSettingsSequence", "1", false)
+	tagDict[Tag{0x300A, 0x0382}] = newInfo(Tag{0x300A, 0x0382}, "FL", "RangeModulatorGatingStartValue", "1", false)
+	tagDict[Tag{0x300A, 0x0384}] = newInfo(Tag{0x300A, 0x0384}, "FL", "RangeModulatorGatingStopValue", "1", false)
+	tagDict[Tag{0x300A, 0x0386}] = newInfo(Tag{0x300A, 0x0386}, "FL", "RangeModulatorGatingStartWaterEquivalentThickness", "1", false)
+	tagDict[Tag{0x300A, 0x0388}] = newInfo(Tag{0x300A, 0x0388}, "FL", "RangeModulatorGatingStopWaterEquivalentThickness", "1", false)
+	tagDict[Tag{0x300A, 0x038A}] = newInfo(Tag{0x300A, 0x038A}, "FL", "IsocenterToRangeModulatorDistance", "1", false)
+	tagDict[Tag{0x300A, 0x0390}] = newInfo(Tag{0x300A, 0x0390}, "SH", "ScanSpotTuneID", "1", false)
+	tagDict[Tag{0x300A, 0x0392}] = newInfo(Tag{0x300A, 0x0392}, "IS", "NumberOfScanSpotPositions", "1", false)
+	tagDict[Tag{0x300A, 0x0394}] = newInfo(Tag{0x300A, 0x0394}, "FL", "ScanSpotPositionMap", "1-n", false)
+	tagDict[Tag{0x300A, 0x0396}] = newInfo(Tag{0x300A, 0x0396}, "FL", "ScanSpotMetersetWeights", "1-n", false)
+	tagDict[Tag{0x300A, 0x0398}] = newInfo(Tag{0x300A, 0x0398}, "FL", "ScanningSpotSize", "2", false)
+	tagDict[Tag{0x300A, 0x039A}] = newInfo(Tag{0x300A, 0x039A}, "IS", "NumberOfPaintings", "1", false)
+	tagDict[Tag{0x300A, 0x03A0}] = newInfo(Tag{0x300A, 0x03A0}, "SQ", "IonToleranceTableSequence", "1", false)
+	tagDict[Tag{0x300A, 0x03A2}] = newInfo(Tag{0x300A, 0x03A2}, "SQ", "IonBeamSequence", "1", false)
+	tagDict[Tag{0x300A, 0x03A4}] = newInfo(Tag{0x300A, 0x03A4}, "SQ", "IonBeamLimitingDeviceSequence", "1", false)
+	tagDict[Tag{0x300A, 0x03A6}] = newInfo(Tag{0x300A, 0x03A6}, "SQ", "IonBlockSequence", "1", false)
+	tagDict[Tag{0x300A, 0x03A8}] = newInfo(Tag{0x300A, 0x03A8}, "SQ", "IonControlPointSequence", "1", false)
+	tagDict[Tag{0x300A, 0x03AA}] = newInfo(Tag{0x300A, 0x03AA}, "SQ", "IonWedgeSequence", "1", false)
+	tagDict[Tag{0x300A, 0x03AC}] = newInfo(Tag{0x300A, 0x03AC}, "SQ", "IonWedgePositionSequence", "1", false)
+	tagDict[Tag{0x300A, 0x0401}] = newInfo(Tag{0x300A, 0x0401}, "SQ", "ReferencedSetupImageSequence", "1", false)
+	tagDict[Tag{0x300A, 0x0402}] = newInfo(Tag{0x300A, 0x0402}, "ST", "SetupImageComment", "1", false)
+	tagDict[Tag{0x300A, 0x0410}] = newInfo(Tag{0x300A, 0x0410}, "SQ", "MotionSynchronizationSequence", "1", false)
+	tagDict[Tag{0x300A, 0x0412}] = newInfo(Tag{0x300A, 0x0412}, "FL", "ControlPointOrientation", "3", false)
+	tagDict[Tag{0x300A, 0x0420}] = newInfo(Tag{0x300A, 0x0420}, "SQ", "GeneralAccessorySequence", "1", false)
+	tagDict[Tag{0x300A, 0x0421}] = newInfo(Tag{0x300A, 0x0421}, "SH", "GeneralAccessoryID", "1", false)
+	tagDict[Tag{0x300A, 0x0422}] = newInfo(Tag{0x300A, 0x0422}, "ST", "GeneralAccessoryDescription", "1", false)
+	tagDict[Tag{0x300A, 0x0423}] = newInfo(Tag{0x300A, 0x0423}, "CS", "GeneralAccessoryType", "1", false)
+	tagDict[Tag{0x300A, 0x0424}] = newInfo(Tag{0x300A, 0x0424}, "IS", "GeneralAccessoryNumber", "1", false)
+	tagDict[Tag{0x300A, 0x0431}] = newInfo(Tag{0x300A, 0x0431}, "SQ", "ApplicatorGeometrySequence", "1", false)
+	tagDict[Tag{0x300A, 0x0432}] = newInfo(Tag{0x300A, 0x0432}, "CS", "ApplicatorApertureShape", "1", false)
+	tagDict[Tag{0x300A, 0x0433}] = newInfo(Tag{0x300A, 0x0433}, "FL", "ApplicatorOpening", "1", false)
+	tagDict[Tag{0x300A, 0x0434}] = newInfo(Tag{0x300A, 0x0434}, "FL", "ApplicatorOpeningX", "1", false)
+	tagDict[Tag{0x300A, 0x0435}] = newInfo(Tag{0x300A, 0x0435}, "FL", "ApplicatorOpeningY", "1", false)
+	tagDict[Tag{0x300A, 0x0436}] = newInfo(Tag{0x300A, 0x0436}, "FL", "SourceToApplicatorMountingPositionDistance", "1", false)
+	tagDict[Tag{0x300C, 0x0002}] = newInfo(Tag{0x300C, 0x0002}, "SQ", "ReferencedRTPlanSequence", "1", false)
+	tagDict[Tag{0x300C, 0x0004}] = newInfo(Tag{0x300C, 0x0004}, "SQ", "ReferencedBeamSequence", "1", false)
+	tagDict[Tag{0x300C, 0x0006}] = newInfo(Tag{0x300C, 0x0006}, "IS", "ReferencedBeamNumber", "1", false)
+	tagDict[Tag{0x300C, 0x0007}] = newInfo(Tag{0x300C, 0x0007}, "IS", "ReferencedReferenceImageNumber", "1", false)
+	tagDict[Tag{0x300C, 0x0008}] = newInfo(Tag{0x300C, 0x0008}, "DS", "StartCumulativeMetersetWeight", "1", false)
+	tagDict[Tag{0x300C, 0x0009}] = newInfo(Tag{0x300C, 0x0009}, "DS", "EndCumulativeMetersetWeight", "1", false)
+	tagDict[Tag{0x300C, 0x000A}] = newInfo(Tag{0x300C, 0x000A}, "SQ", "ReferencedBrachyApplicationSetupSequence", "1", false)
+	tagDict[Tag{0x300C, 0x000C}] = newInfo(Tag{0x300C, 0x000C}, "IS", "ReferencedBrachyApplicationSetupNumber", "1", false)
+	tagDict[Tag{0x300C, 0x000E}] = newInfo(Tag{0x300C, 0x000E}, "IS", "ReferencedSourceNumber", "1", false)
+	tagDict[Tag{0x300C, 0x0020}] = newInfo(Tag{0x300C, 0x0020}, "SQ", "ReferencedFractionGroupSequence", "1", false)
+	tagDict[Tag{0x300C, 0x0022}] = newInfo(Tag{0x300C, 0x0022}, "IS", "ReferencedFractionGroupNumber", "1", false)
+	tagDict[Tag{0x300C, 0x0040}] = newInfo(Tag{0x300C, 0x0040}, "SQ", "ReferencedVerificationImageSequence", "1", false)
+	tagDict[Tag{0x300C, 0x0042}] = newInfo(Tag{0x300C, 0x0042}, "SQ", "ReferencedReferenceImageSequence", "1", false)
+	tagDict[Tag{0x300C, 0x0050}] = newInfo(Tag{0x300C, 0x0050}, "SQ", "ReferencedDoseReferenceSequence", "1", false)
+	tagDict[Tag{0x300C, 0x0051}] = newInfo(Tag{0x300C, 0x0051}, "IS", "ReferencedDoseReferenceNumber", "1", false)
+	tagDict[Tag{0x300C, 0x0055}] = newInfo(Tag{0x300C, 0x0055}, "SQ", "BrachyReferencedDoseReferenceSequence", "1", false)
+	tagDict[Tag{0x300C, 0x0060}] = newInfo(Tag{0x300C, 0x0060}, "SQ", "ReferencedStructureSetSequence", "1", false)
+	tagDict[Tag{0x300C, 0x006A}] = newInfo(Tag{0x300C, 0x006A}, "IS", "ReferencedPatientSetupNumber", "1", false)
+	tagDict[Tag{0x300C, 0x0080}] = newInfo(Tag{0x300C, 0x0080}, "SQ", "ReferencedDoseSequence", "1", false)
+	tagDict[Tag{0x300C, 0x00A0}] = newInfo(Tag{0x300C, 0x00A0}, "IS", "ReferencedToleranceTableNumber", "1", false)
+	tagDict[Tag{0x300C, 0x00B0}] = newInfo(Tag{0x300C, 0x00B0}, "SQ", "ReferencedBolusSequence", "1", false)
+	tagDict[Tag{0x300C, 0x00C0}] = newInfo(Tag{0x300C, 0x00C0}, "IS", "ReferencedWedgeNumber", "1", false)
+	tagDict[Tag{0x300C, 0x00D0}] = newInfo(Tag{0x300C, 0x00D0}, "IS", "ReferencedCompensatorNumber", "1", false)
+	tagDict[Tag{0x300C, 0x00E0}] = newInfo(Tag{0x300C, 0x00E0}, "IS", "ReferencedBlockNumber", "1", false)
+	tagDict[Tag{0x300C, 0x00F0}] = newInfo(Tag{0x300C, 0x00F0}, "IS", "ReferencedControlPointIndex", "1", false)
+	tagDict[Tag{0x300C, 0x00F2}] = newInfo(Tag{0x300C, 0x00F2}, "SQ", "ReferencedControlPointSequence", "1", false)
+	tagDict[Tag{0x300C, 0x00F4}] = newInfo(Tag{0x300C, 0x00F4}, "IS", "ReferencedStartControlPointIndex", "1", false)
+	tagDict[Tag{0x300C, 0x00F6}] = newInfo(Tag{0x300C, 0x00F6}, "IS", "ReferencedStopControlPointIndex", "1", false)
+	tagDict[Tag{0x300C, 0x0100}] = newInfo(Tag{0x300C, 0x0100}, "IS", "ReferencedRangeShifterNumber", "1", false)
+	tagDict[Tag{0x300C, 0x0102}] = newInfo(Tag{0x300C, 0x0102}, "IS", "ReferencedLateralSpreadingDeviceNumber", "1", false)
+	tagDict[Tag{0x300C, 0x0104}] = newInfo(Tag{0x300C, 0x0104}, "IS", "ReferencedRangeModulatorNumber", "1", false)
+	tagDict[Tag{0x300E, 0x0002}] = newInfo(Tag{0x300E, 0x0002}, "CS", "ApprovalStatus", "1", false)
+	tagDict[Tag{0x300E, 0x0004}] = newInfo(Tag{0x300E, 0x0004}, "DA", "ReviewDate", "1", false)
+	tagDict[Tag{0x300E, 0x0005}] = newInfo(Tag{0x300E, 0x0005}, "TM", "ReviewTime", "1", false)
+	tagDict[Tag{0x300E, 0x0008}] = newInfo(Tag{0x300E, 0x0008}, "PN", "ReviewerName", "1", false)
+	tagDict[Tag{0x4010, 0x0001}] = newInfo(Tag{0x4010, 0x0001}, "CS", "LowEnergyDetectors", "1", false)
+	tagDict[Tag{0x4010, 0x0002}] = newInfo(Tag{0x4010, 0x0002}, "CS", "HighEnergyDetectors", "1", false)
+	tagDict[Tag{0x4010, 0x0004}] = newInfo(Tag{0x4010, 0x0004}, "SQ", "DetectorGeometrySequence", "1", false)
+	tagDict[Tag{0x4010, 0x1001}] = newInfo(Tag{0x4010, 0x1001}, "SQ", "ThreatROIVoxelSequence", "1", false)
+	tagDict[Tag{0x4010, 0x1004}] = newInfo(Tag{0x4010, 0x1004}, "FL", "ThreatROIBase", "3", false)
+	tagDict[Tag{0x4010, 0x1005}] = newInfo(Tag{0x4010, 0x1005}, "FL", "ThreatROIExtents", "3", false)
+	tagDict[Tag{0x4010, 0x1006}] = newInfo(Tag{0x4010, 0x1006}, "OB", "ThreatROIBitmap", "1", false)
+	tagDict[Tag{0x4010, 0x1007}] = newInfo(Tag{0x4010, 0x1007}, "SH", "RouteSegmentID", "1", false)
+	tagDict[Tag{0x4010, 0x1008}] = newInfo(Tag{0x4010, 0x1008}, "CS", "GantryType", "1", false)
+	tagDict[Tag{0x4010, 0x1009}] = newInfo(Tag{0x4010, 0x1009}, "CS", "OOIOwnerType", "1", false)
+	tagDict[Tag{0x4010, 0x100A}] = newInfo(Tag{0x4010, 0x100A}, "SQ", "RouteSegmentSequence", "1", false)
+	tagDict[Tag{0x4010, 0x1010}] = newInfo(Tag{0x4010, 0x1010}, "US", "PotentialThreatObjectID", "1", false)
+	tagDict[Tag{0x4010, 0x1011}] = newInfo(Tag{0x4010, 0x1011}, "SQ", "ThreatSequence", "1", false)
+	tagDict[Tag{0x4010, 0x1012}] = newInfo(Tag{0x4010, 0x1012}, "CS", "ThreatCategory", "1", false)
+	tagDict[Tag{0x4010, 0x1013}] = newInfo(Tag{0x4010, 0x1013}, "LT", "ThreatCategoryDescription", "1", false)
+	tagDict[Tag{0x4010, 0x1014}] = newInfo(Tag{0x4010, 0x1014}, "CS", "ATDAbilityAssessment", "1", false)
+	tagDict[Tag{0x4010, 0x1015}] = newInfo(Tag{0x4010, 0x1015}, "CS", "ATDAssessmentFlag", "1", false)
+	tagDict[Tag{0x4010, 0x1016}] = newInfo(Tag{0x4010, 0x1016}, "FL", "ATDAssessmentProbability", "1", false)
+	tagDict[Tag{0x4010, 0x1017}] = newInfo(Tag{0x4010, 0x1017}, "FL", "Mass", "1", false)
+	tagDict[Tag{0x4010, 0x1018}] = newInfo(Tag{0x4010, 0x1018}, "FL", "Density", "1", false)
+	tagDict[Tag{0x4010, 0x1019}] = newInfo(Tag{0x4010, 0x1019}, "FL", "ZEffective", "1", false)
+	tagDict[Tag{0x4010, 0x101A}] = newInfo(Tag{0x4010, 0x101A}, "SH", "BoardingPassID", "1", false)
+	tagDict[Tag{0x4010, 0x101B}] = newInfo(Tag{0x4010, 0x101B}, "FL", "CenterOfMass", "3", false)
+	tagDict[Tag{0x4010, 0x101C}] = newInfo(Tag{0x4010, 0x101C}, "FL", "CenterOfPTO", "3", false)
+	tagDict[Tag{0x4010, 0x101D}] = newInfo(Tag{0x4010, 0x101D}, "FL", "BoundingPolygon", "6-n", false)
+	tagDict[Tag{0x4010, 0x101E}] = newInfo(Tag{0x4010, 0x101E}, "SH", "RouteSegmentStartLocationID", "1", false)
+	tagDict[Tag{0x4010, 0x101F}] = newInfo(Tag{0x4010, 0x101F}, "SH", "RouteSegmentEndLocationID", "1", false)
+	tagDict[Tag{0x4010, 0x1020}] = newInfo(Tag{0x4010, 0x1020}, "CS", "RouteSegmentLocationIDType", "1", false)
+	tagDict[Tag{0x4010, 0x1021}] = newInfo(Tag{0x4010, 0x1021}, "CS", "AbortReason", "1-n", false)
+	tagDict[Tag{0x4010, 0x1023}] = newInfo(Tag{0x4010, 0x1023}, "FL", "VolumeOfPTO", "1", false)
+	tagDict[Tag{0x4010, 0x1024}] = newInfo(Tag{0x4010, 0x1024}, "CS", "AbortFlag", "1", false)
+	tagDict[Tag{0x4010, 0x1025}] = newInfo(Tag{0x4010, 0x1025}, "DT", "RouteSegmentStartTime", "1", false)
+	tagDict[Tag{0x4010, 0x1026}] = newInfo(Tag{0x4010, 0x1026}, "DT", "RouteSegmentEndTime", "1", false)
+	tagDict[Tag{0x4010, 0x1027}] = newInfo(Tag{0x4010, 0x1027}, "CS", "TDRType", "1", false)
+	tagDict[Tag{0x4010, 0x1028}] = newInfo(Tag{0x4010, 0x1028}, "CS", "InternationalRouteSegment", "1", false)
+	tagDict[Tag{0x4010, 0x1029}] = newInfo(Tag{0x4010, 0x1029}, "LO", "ThreatDetectionAlgorithmandVersion", "1-n", false)
+	tagDict[Tag{0x4010, 0x102A}] = newInfo(Tag{0x4010, 0x102A}, "SH", "AssignedLocation", "1", false)
+	tagDict[Tag{0x4010, 0x102B}] = newInfo(Tag{0x4010, 0x102B}, "DT", "AlarmDecisionTime", "1", false)
+	tagDict[Tag{0x4010, 0x1031}] = newInfo(Tag{0x4010, 0x1031}, "CS", "AlarmDecision", "1", false)
+	tagDict[Tag{0x4010, 0x1033}] = newInfo(Tag{0x4010, 0x1033}, "US", "NumberOfTotalObjects", "1", false)
+	tagDict[Tag{0x4010, 0x1034}] = newInfo(Tag{0x4010, 0x1034}, "US", "NumberOfAlarmObjects", "1", false)
+	tagDict[Tag{0x4010, 0x1037}] = newInfo(Tag{0x4010, 0x1037}, "SQ", "PTORepresentationSequence", "1", false)
+	tagDict[Tag{0x4010, 0x1038}] = newInfo(Tag{0x4010, 0x1038}, "SQ", "ATDAssessmentSequence", "1", false)
+	tagDict[Tag{0x4010, 0x1039}] = newInfo(Tag{0x4010, 0x1039}, "CS", "TIPType", "1", false)
+	tagDict[Tag{0x4010, 0x103A}] = newInfo(Tag{0x4010, 0x103A}, "CS", "DICOSVersion", "1", false)
+	tagDict[Tag{0x4010, 0x1041}] = newInfo(Tag{0x4010, 0x1041}, "DT", "OOIOwnerCreationTime", "1", false)
+	tagDict[Tag{0x4010, 0x1042}] = newInfo(Tag{0x4010, 0x1042}, "CS", "OOIType", "1", false)
+	tagDict[Tag{0x4010, 0x1043}] = newInfo(Tag{0x4010, 0x1043}, "FL", "OOISize", "3", false)
+	tagDict[Tag{0x4010, 0x1044}] = newInfo(Tag{0x4010, 0x1044}, "CS", "AcquisitionStatus", "1", false)
+	tagDict[Tag{0x4010, 0x1045}] = newInfo(Tag{0x4010, 0x1045}, "SQ", "BasisMaterialsCodeSequence", "1", false)
+	tagDict[Tag{0x4010, 0x1046}] = newInfo(Tag{0x4010, 0x1046}, "CS", "PhantomType", "1", false)
+	tagDict[Tag{0x4010, 0x1047}] = newInfo(Tag{0x4010, 0x1047}, "SQ", "OOIOwnerSequence", "1", false)
+	tagDict[Tag{0x4010, 0x1048}] = newInfo(Tag{0x4010, 0x1048}, "CS", "ScanType", "1", false)
+	tagDict[Tag{0x4010, 0x1051}] = newInfo(Tag{0x4010, 0x1051}, "LO", "ItineraryID", "1", false)
+	tagDict[Tag{0x4010, 0x1052}] = newInfo(Tag{0x4010, 0x1052}, "SH", "ItineraryIDType", "1", false)
+	tagDict[Tag{0x4010, 0x1053}] = newInfo(Tag{0x4010, 0x1053}, "LO", "ItineraryIDAssigningAuthority", "1", false)
+	tagDict[Tag{0x4010, 0x1054}] = newInfo(Tag{0x4010, 0x1054}, "SH", "RouteID", "1", false)
+	tagDict[Tag{0x4010, 0x1055}] = newInfo(Tag{0x4010, 0x1055}, "SH", "RouteIDAssigningAuthority", "1", false)
+	tagDict[Tag{0x4010, 0x1056}] = newInfo(Tag{0x4010, 0x1056}, "CS", "InboundArrivalType", "1", false)
+	tagDict[Tag{0x4010, 0x1058}] = newInfo(Tag{0x4010, 0x1058}, "SH", "CarrierID", "1", false)
+	tagDict[Tag{0x4010, 0x1059}] = newInfo(Tag{0x4010, 0x1059}, "CS", "CarrierIDAssigningAuthority", "1", false)
+	tagDict[Tag{0x4010, 0x1060}] = newInfo(Tag{0x4010, 0x1060}, "FL", "SourceOrientation", "3", false)
+	tagDict[Tag{0x4010, 0x1061}] = newInfo(Tag{0x4010, 0x1061}, "FL", "SourcePosition", "3", false)
+	tagDict[Tag{0x4010, 0x1062}] = newInfo(Tag{0x4010, 0x1062}, "FL", "BeltHeight", "1", false)
+	tagDict[Tag{0x4010, 0x1064}] = newInfo(Tag{0x4010, 0x1064}, "SQ", "AlgorithmRoutingCodeSequence", "1", false)
+	tagDict[Tag{0x4010, 0x1067}] = newInfo(Tag{0x4010, 0x1067}, "CS", "TransportClassification", "1", false)
+	tagDict[Tag{0x4010, 0x1068}] = newInfo(Tag{0x4010, 0x1068}, "LT", "OOITypeDescriptor", "1", false)
+	tagDict[Tag{0x4010, 0x1069}] = newInfo(Tag{0x4010, 0x1069}, "FL", "TotalProcessingTime", "1", false)
+	tagDict[Tag{0x4010, 0x106C}] = newInfo(Tag{0x4010, 0x106C}, "OB", "DetectorCalibrationData", "1", false)
+	tagDict[Tag{0x4FFE, 0x0001}] = newInfo(Tag{0x4FFE, 0x0001}, "SQ", "MACParametersSequence", "1", false)
+	tagDict[Tag{0x5200, 0x9229}] = newInfo(Tag{0x5200, 0x9229}, "SQ", "SharedFunctionalGroupsSequence", "1", false)
+	tagDict[Tag{0x5200, 0x9230}] = newInfo(Tag{0x5200, 0x9230}, "SQ", "PerFrameFunctionalGroupsSequence", "1", false)
+	tagDict[Tag{0x5400, 0x0100}] = newInfo(Tag{0x5400, 0x0100}, "SQ", "WaveformSequence", "1", false)
+	tagDict[Tag{0x5400, 0x0110}] = newInfo(Tag{0x5400, 0x0110}, "OW or OB", "ChannelMinimumValue", "1", false)
+	tagDict[Tag{0x5400, 0x0112}] = newInfo(Tag{0x5400, 0x0112}, "OW or OB", "ChannelMaximumValue", "1", false)
+	tagDict[Tag{0x5400, 0x1004}] = newInfo(Tag{0x5400, 0x1004}, "US", "WaveformBitsAllocated", "1", false)
+	tagDict[Tag{0x5400, 0x1006}] = newInfo(Tag{0x5400, 0x1006}, "CS", "WaveformSampleInterpretation", "1", false)
+	tagDict[Tag{0x5400, 0x100A}] = newInfo(Tag{0x5400, 0x100A}, "OW or OB", "WaveformPaddingValue", "1", false)
+	tagDict[Tag{0x5400, 0x1010}] = newInfo(Tag{0x5400, 0x1010}, "OW or OB", "WaveformData", "1", false)
+	tagDict[Tag{0x5600, 0x0010}] = newInfo(Tag{0x5600, 0x0010}, "OF", "FirstOrderPhaseCorrectionAngle", "1", false)
+	tagDict[Tag{0x5600, 0x0020}] = newInfo(Tag{0x5600, 0x0020}, "OF", "SpectroscopyData", "1", false)
+	addRepeating(Tag{0x6000, 0x0010}, Tag{0xFF01, 0xFFFF}, newInfo(Tag{0x6000, 0x0010}, "US", "OverlayRows", "1", false))
+	addRepeating(Tag{0x6000, 0x0011}, Tag{0xFF01, 0xFFFF}, newInfo(Tag{0x6000, 0x0011}, "US", "OverlayColumns", "1", false))
+	addRepeating(Tag{0x6000, 0x0015}, Tag{0xFF01, 0xFFFF}, newInfo(Tag{0x6000, 0x0015}, "IS", "NumberOfFramesInOverlay", "1", false))
+	addRepeating(Tag{0x6000, 0x0022}, Tag{0xFF01, 0xFFFF}, newInfo(Tag{0x6000, 0x0022}, "LO", "OverlayDescription", "1", false))
+	addRepeating(Tag{0x6000, 0x0040}, Tag{0xFF01, 0xFFFF}, newInfo(Tag{0x6000, 0x0040}, "CS", "OverlayType", "1", false))
+	addRepeating(Tag{0x6000, 0x0045}, Tag{0xFF01, 0xFFFF}, newInfo(Tag{0x6000, 0x0045}, "LO", "OverlaySubtype", "1", false))
+	addRepeating(Tag{0x6000, 0x0050}, Tag{0xFF01, 0xFFFF}, newInfo(Tag{0x6000, 0x0050}, "SS", "OverlayOrigin", "2", false))
+	addRepeating(Tag{0x6000, 0x0051}, Tag{0xFF01, 0xFFFF}, newInfo(Tag{0x6000, 0x0051}, "US", "ImageFrameOrigin", "1", false))
+	addRepeating(Tag{0x6000, 0x0100}, Tag{0xFF01, 0xFFFF}, newInfo(Tag{0x6000, 0x0100}, "US", "OverlayBitsAllocated", "1", false))
+	addRepeating(Tag{0x6000, 0x0102}, Tag{0xFF01, 0xFFFF}, newInfo(Tag{0x6000, 0x0102}, "US", "OverlayBitPosition", "1", false))
+	addRepeating(Tag{0x6000, 0x1001}, Tag{0xFF01, 0xFFFF}, newInfo(Tag{0x6000, 0x1001}, "CS", "OverlayActivationLayer", "1", false))
+	addRepeating(Tag{0x6000, 0x1301}, Tag{0xFF01, 0xFFFF}, newInfo(Tag{0x6000, 0x1301}, "IS", "ROIArea", "1", false))
+	addRepeating(Tag{0x6000, 0x1302}, Tag{0xFF01, 0xFFFF}, newInfo(Tag{0x6000, 0x1302}, "DS", "ROIMean", "1", false))
+	addRepeating(Tag{0x6000, 0x1303}, Tag{0xFF01, 0xFFFF}, newInfo(Tag{0x6000, 0x1303}, "DS", "ROIStandardDeviation", "1", false))
+	addRepeating(Tag{0x6000, 0x1500}, Tag{0xFF01, 0xFFFF}, newInfo(Tag{0x6000, 0x1500}, "LO", "OverlayLabel", "1", false))
+	addRepeating(Tag{0x6000, 0x3000}, Tag{0xFF01, 0xFFFF}, newInfo(Tag{0x6000, 0x3000}, "OW or OB", "OverlayData", "1", false))
+	tagDict[Tag{0x7FE0, 0x0010}] = newInfo(Tag{0x7FE0, 0x0010}, "OW or OB", "PixelData", "1", false)
+	tagDict[Tag{0xFFFA, 0xFFFA}] = newInfo(Tag{0xFFFA, 0xFFFA}, "SQ", "DigitalSignaturesSequence", "1", false)
+	tagDict[Tag{0xFFFC, 0xFFFC}] = newInfo(Tag{0xFFFC, 0xFFFC}, "OB", "DataSetTrailingPadding", "1", false)
+	tagDict[Tag{0xFFFE, 0xE000}] = newInfo(Tag{0xFFFE, 0xE000}, "NA", "Item", "1", false)
+	tagDict[Tag{0xFFFE, 0xE00D}] = newInfo(Tag{0xFFFE, 0xE00D}, "NA", "ItemDelimitationItem", "1", false)
+	tagDict[Tag{0xFFFE, 0xE0DD}] = newInfo(Tag{0xFFFE, 0xE0DD}, "NA", "SequenceDelimitationItem", "1", false)
+	tagDict[Tag{0x0022, 0x1415}] = newInfo(Tag{0x0022, 0x1415}, "CS", "OphthalmicMappingDeviceType", "1", false)
+	tagDict[Tag{0x0022, 0x1420}] = newInfo(Tag{0x0022, 0x1420}, "SQ", "AcquisitionTypeMethodSequence", "1", false)
+	tagDict[Tag{0x0022, 0x1423}] = newInfo(Tag{0x0022, 0x1423}, "SQ", "AcquisitonMethodAlgorithmSequence", "1", false)
+	tagDict[Tag{0x0022, 0x1436}] = newInfo(Tag{0x0022, 0x1436}, "SQ", "OphthalmicThicknessMapTypeCodeSequence", "1", false)
+	tagDict[Tag{0x0022, 0x1443}] = newInfo(Tag{0x0022, 0x1443}, "SQ", "OphthalmicThicknessMappingNormalsSequence", "1", false)
+	tagDict[Tag{0x0022, 0x1445}] = newInfo(Tag{0x0022, 0x1445}, "SQ", "RetinalThicknessDefinitionCodeSequence", "1", false)
+	tagDict[Tag{0x0022, 0x1450}] = newInfo(Tag{0x0022, 0x1450}, "SQ", "PixelValueMappingtoCodedConceptSequence", "1", false)
+	tagDict[Tag{0x0022, 0x1452}] = newInfo(Tag{0x0022, 0x1452}, "US or SS", "MappedPixelValue", "1", false)
+	tagDict[Tag{0x0022, 0x1454}] = newInfo(Tag{0x0022, 0x1454}, "LO", "PixelValueMappingExplanation", "1", false)
+	tagDict[Tag{0x0022, 0x1458}] = newInfo(Tag{0x0022, 0x1458}, "SQ", "OphthalmicThicknessMapQualityThresholdSequence", "1", false)
+	tagDict[Tag{0x0022, 0x1460}] = newInfo(Tag{0x0022, 0x1460}, "FL", "OphthalmicThicknessMapThresholdQualityRating", "1", false)
+	tagDict[Tag{0x0022, 0x1463}] = newInfo(Tag{0x0022, 0x1463}, "FL", "AnatomicStructureReferencePoint", "2", false)
+	tagDict[Tag{0x0022, 0x1465}] = newInfo(Tag{0x0022, 0x1465}, "SQ", "RegistrationtoLocalizerSequence", "1", false)
+	tagDict[Tag{0x0022, 0x1466}] = newInfo(Tag{0x0022, 0x1466}, "CS", "RegisteredLocalizerUnits", "1", false)
+	tagDict[Tag{0x0022, 0x1467}] = newInfo(Tag{0x0022, 0x1467}, "FL", "RegisteredTopLeftHandCorner", "2", false)
+	tagDict[Tag{0x0022, 0x1468}] = newInfo(Tag{0x0022, 0x1468}, "FL", "RegisteredBottomRightHandCorner", "2", false)
+	tagDict[Tag{0x0022, 0x1470}] = newInfo(Tag{0x0022, 0x1470}, "SQ", "OphthalmicThicknessMapQualityRatingSequence", "1", false)
+	tagDict[Tag{0x0022, 0x1472}] = newInfo(Tag{0x0022, 0x1472}, "SQ", "RelevantOPTAttributesSequence", "1", false)
+	tagDict[Tag{0x0040, 0x4001}] = newInfo(Tag{0x0040, 0x4001}, "CS", "RETIRED_GeneralPurposeScheduledProcedureStepStatus", "1", true)
+	tagDict[Tag{0x0040, 0x4002}] = newInfo(Tag{0x0040, 0x4002}, "CS", "RETIRED_GeneralPurposePerformedProcedureStepStatus", "1", true)
+	tagDict[Tag{0x0040, 0x4003}] = newInfo(Tag{0x0040, 0x4003}, "CS", "RETIRED_GeneralPurposeScheduledProcedureStepPriority", "1", true)
+	tagDict[Tag{0x0040, 0x4004}] = newInfo(Tag{0x0040, 0x4004}, "SQ", "RETIRED_ScheduledProcessingApplicationsCodeSequence", "1", true)
+	tagDict[Tag{0x0040, 0x4006}] = newInfo(Tag{0x0040, 0x4006}, "CS", "RETIRED_MultipleCopiesFlag", "1", true)
+	tagDict[Tag{0x0040, 0x4015}] = newInfo(Tag{0x0040, 0x4015}, "SQ", "RETIRED_ResultingGeneralPurposePerformedProcedureStepsSequence", "1", true)
+	tagDict[Tag{0x0040, 0x4016}] = newInfo(Tag{0x0040, 0x4016}, "SQ", "RETIRED_ReferencedGeneralPurposeScheduledProcedureStepSequence", "1", true)
+	tagDict[Tag{0x0040, 0x4022}] = newInfo(Tag{0x0040, 0x4022}, "SQ", "RETIRED_RelevantInformationSequence", "1", true)
+	tagDict[Tag{0x0040, 0x4023}] = newInfo(Tag{0x0040, 0x4023}, "UI", "RETIRED_ReferencedGeneralPurposeScheduledProcedureStepTransactionUID", "1", true)
+	tagDict[Tag{0x0040, 0x4031}] = newInfo(Tag{0x0040, 0x4031}, "SQ", "RETIRED_RequestedSubsequentWorkitemCodeSequence", "1", true)
+	tagDict[Tag{0x0040, 0x4032}] = newInfo(Tag{0x0040, 0x4032}, "SQ", "RETIRED_NonDICOMOutputCodeSequence", "1", true)
+	tagDict[Tag{0x0040, 0xA161}] = newInfo(Tag{0x0040, 0xA161}, "FD", "FloatingPointValue", "1-n", false)
+	tagDict[Tag{0x0040, 0xA162}] = newInfo(Tag{0x0040, 0xA162}, "SL", "RationalNumeratorValue", "1-n", false)
+	tagDict[Tag{0x0040, 0xA163}] = newInfo(Tag{0x0040, 0xA163}, "UL", "RationalDenominatorValue", "1-n", false)
+	tagDict[Tag{0x0010, 0x0200}] = newInfo(Tag{0x0010, 0x0200}, "CS", "QualityControlSubject", "1", false)
+	tagDict[Tag{0x3006, 0x0018}] = newInfo(Tag{0x3006, 0x0018}, "SQ", "PredecessorStructureSetSequence", "1", false)
+	tagDict[Tag{0x300A, 0x0088}] = newInfo(Tag{0x300A, 0x0088}, "FL", "RETIRED_BeamDosePointDepth", "1", true)
+	tagDict[Tag{0x300A, 0x0089}] = newInfo(Tag{0x300A, 0x0089}, "FL", "RETIRED_BeamDosePointEquivalentDepth", "1", true)
+	tagDict[Tag{0x300A, 0x008A}] = newInfo(Tag{0x300A, 0x008A}, "FL", "RETIRED_BeamDosePointSSD", "1", true)
+	tagDict[Tag{0x300A, 0x008C}] = newInfo(Tag{0x300A, 0x008C}, "SQ", "BeamDoseVerificationControlPointSequence", "1", false)
+	tagDict[Tag{0x300A, 0x008D}] = newInfo(Tag{0x300A, 0x008D}, "FL", "AverageBeamDosePointDepth", "1", false)
+	tagDict[Tag{0x300A, 0x008E}] = newInfo(Tag{0x300A, 0x008E}, "FL", "AverageBeamDosePointEquivalentDepth", "1", false)
+	tagDict[Tag{0x300A, 0x008F}] = newInfo(Tag{0x300A, 0x008F}, "FL", "AverageBeamDosePointSSD", "1", false)
+	tagDict[Tag{0x0040, 0xA171}] = newInfo(Tag{0x0040, 0xA171}, "UI", "ObservationUID", "1", false)
+	tagDict[Tag{0x300A, 0x00EF}] = newInfo(Tag{0x300A, 0x00EF}, "SH", "CompensatorTrayID", "1", false)
+	tagDict[Tag{0x0066, 0x0037}] = newInfo(Tag{0x0066, 0x0037}, "FL", "RecommendedPointRadius", "1", false)
+	tagDict[Tag{0x0066, 0x0038}] = newInfo(Tag{0x0066, 0x0038}, "FL", "RecommendedLineThickness", "1", false)
+	tagDict[Tag{0x300A, 0x021B}] = newInfo(Tag{0x300A, 0x021B}, "SH", "SourceModel", "1", false)
+	tagDict[Tag{0x300A, 0x021C}] = newInfo(Tag{0x300A, 0x021C}, "LO", "SourceDescription", "1", false)
+	tagDict[Tag{0x0008, 0x0015}] = newInfo(Tag{0x0008, 0x0015}, "DT", "InstanceCoercionDateTime", "1", false)
+	tagDict[Tag{0x0000, 0x0001}] = newInfo(Tag{0x0000, 0x0001}, "UL", "ACR_NEMA_CommandGroupLengthToEnd", "1", true)
+	tagDict[Tag{0x0000, 0x0010}] = newInfo(Tag{0x0000, 0x0010}, "CS", "ACR_NEMA_CommandRecognitionCode", "1", true)
+	tagDict[Tag{0x0000, 0x0200}] = newInfo(Tag{0x0000, 0x0200}, "LO", "ACR_NEMA_Initiator", "1", true)
+	tagDict[Tag{0x0000, 0x0300}] = newInfo(Tag{0x0000, 0x0300}, "LO", "ACR_NEMA_Receiver", "1", true)
+	tagDict[Tag{0x0000, 0x0400}] = newInfo(Tag{0x0000, 0x0400}, "LO", "ACR_NEMA_FindLocation", "1", true)
+	tagDict[Tag{0x0000, 0x0850}] = newInfo(Tag{0x0000, 0x0850}, "US", "ACR_NEMA_NumberOfMatches", "1", true)
+	tagDict[Tag{0x0000, 0x0860}] = newInfo(Tag{0x0000, 0x0860}, "US", "ACR_NEMA_ResponseSequenceNumber", "1", true)
+	tagDict[Tag{0x0000, 0x4000}] = newInfo(Tag{0x0000, 0x4000}, "LO", "ACR_NEMA_DialogReceiver", "1", true)
+	tagDict[Tag{0x0000, 0x4010}] = newInfo(Tag{0x0000, 0x4010}, "LO", "ACR_NEMA_TerminalType", "1", true)
+	tagDict[Tag{0x0000, 0x5010}] = newInfo(Tag{0x0000, 0x5010}, "LO", "ACR_NEMA_MessageSetID", "1", true)
+	tagDict[Tag{0x0000, 0x5020}] = newInfo(Tag{0x0000, 0x5020}, "LO", "ACR_NEMA_EndMessageSet", "1", true)
+	tagDict[Tag{0x0000, 0x5110}] = newInfo(Tag{0x0000, 0x5110}, "LO", "ACR_NEMA_DisplayFormat", "1", true)
+	tagDict[Tag{0x0000, 0x5120}] = newInfo(Tag{0x0000, 0x5120}, "LO", "ACR_NEMA_PagePositionID", "1", true)
+	tagDict[Tag{0x0000, 0x5130}] = newInfo(Tag{0x0000, 0x5130}, "LO", "ACR_NEMA_TextFormatID", "1", true)
+	tagDict[Tag{0x0000, 0x5140}] = newInfo(Tag{0x0000, 0x5140}, "CS", "ACR_NEMA_NormalReverse", "1", true)
+	tagDict[Tag{0x0000, 0x5150}] = newInfo(Tag{0x0000, 0x5150}, "CS", "ACR_NEMA_AddGrayScale", "1", true)
+	tagDict[Tag{0x0000, 0x5160}] = newInfo(Tag{0x0000, 0x5160}, "CS", "ACR_NEMA_Borders", "1", true)
+	tagDict[Tag{0x0000, 0x5170}] = newInfo(Tag{0x0000, 0x5170}, "IS", "ACR_NEMA_Copies", "1", true)
+	tagDict[Tag{0x0000, 0x5180}] = newInfo(Tag{0x0000, 0x5180}, "LO", "ACR_NEMA_MagnificationType", "1", true)
+	tagDict[Tag{0x0000, 0x5190}] = newInfo(Tag{0x0000, 0x5190}, "LO", "ACR_NEMA_Erase", "1-n", true)
+	tagDict[Tag{0x0000, 0x51A0}] = newInfo(Tag{0x0000, 0x51A0}, "CS", "ACR_NEMA_Print", "1", true)
+	tagDict[Tag{0x0000, 0x51B0}] = newInfo(Tag{0x0000, 0x51B0}, "US", "ACR_NEMA_Overlays", "1-n", true)
+	tagDict[Tag{0x0008, 0x0001}] = newInfo(Tag{0x0008, 0x0001}, "UL", "ACR_NEMA_IdentifyingGroupLengthToEnd", "1", true)
+	tagDict[Tag{0x0008, 0x0010}] = newInfo(Tag{0x0008, 0x0010}, "LO", "ACR_NEMA_RecognitionCode", "1", true)
+	tagDict[Tag{0x0008, 0x0040}] = newInfo(Tag{0x0008, 0x0040}, "US", "ACR_NEMA_OldDataSetType", "1", true)
+	tagDict[Tag{0x0008, 0x0041}] = newInfo(Tag{0x0008, 0x0041}, "LO", "ACR_NEMA_DataSetSubtype", "1", true)
+	tagDict[Tag{0x0008, 0x1000}] = newInfo(Tag{0x0008, 0x1000}, "LO", "ACR_NEMA_NetworkID", "1", true)
+	tagDict[Tag{0x0008, 0x4000}] = newInfo(Tag{0x0008, 0x4000}, "LT", "ACR_NEMA_IdentifyingComments", "1-n", true)
+	tagDict[Tag{0x0010, 0x1050}] = newInfo(Tag{0x0010, 0x1050}, "LT", "ACR_NEMA_InsurancePlanIdentification", "1-n", true)
+	tagDict[Tag{0x0018, 0x1240}] = newInfo(Tag{0x0018, 0x1240}, "IS", "ACR_NEMA_UpperLowerPixelValues", "1-n", true)
+	tagDict[Tag{0x0018, 0x4000}] = newInfo(Tag{0x0018, 0x4000}, "LT", "ACR_NEMA_AcquisitionComments", "1-n", true)
+	tagDict[Tag{0x0018, 0x5030}] = newInfo(Tag{0x0018, 0x5030}, "DS", "ACR_NEMA_DynamicRange", "1", true)
+	tagDict[Tag{0x0018, 0x5040}] = newInfo(Tag{0x0018, 0x5040}, "DS", "ACR_NEMA_TotalGain", "1", true)
+	tagDict[Tag{0x0020, 0x0030}] = newInfo(Tag{0x0020, 0x0030}, "DS", "ACR_NEMA_ImagePosition", "3", true)
+	tagDict[Tag{0x0020, 0x0035}] = newInfo(Tag{0x0020, 0x0035}, "DS", "ACR_NEMA_ImageOrientation", "6", true)
+	tagDict[Tag{0x0020, 0x0050}] = newInfo(Tag{0x0020, 0x0050}, "DS", "ACR_NEMA_Location", "1", true)
+	tagDict[Tag{0x0020, 0x0070}] = newInfo(Tag{0x0020, 0x0070}, "LO", "ACR_NEMA_ImageGeometryType", "1", true)
+	tagDict[Tag{0x0020, 0x0080}] = newInfo(Tag{0x0020, 0x0080}, "LO", "ACR_NEMA_MaskingImage", "1-n", true)
+	tagDict[Tag{0x0020, 0x1001}] = newInfo(Tag{0x0020, 0x1001}, "IS", "ACR_NEMA_AcquisitionsInSeries", "1", true)
+	tagDict[Tag{0x0020, 0x1003}] = newInfo(Tag{0x0020, 0x1003}, "IS", "ACR_NEMA_ImagesInSeries", "1", true)
+	tagDict[Tag{0x0020, 0x1005}] = newInfo(Tag{0x0020, 0x1005}, "IS", "ACR_NEMA_ImagesInStudy", "1", true)
+	tagDict[Tag{0x0020, 0x1020}] = newInfo(Tag{0x0020, 0x1020}, "LO", "ACR_NEMA_Reference", "1-n", true)
+	addRepeating(Tag{0x0020, 0x3100}, Tag{0xFFFF, 0xFF01}, newInfo(Tag{0x0020, 0x3100}, "LO", "ACR_NEMA_SourceImageID", "1-n", true))
+	tagDict[Tag{0x0020, 0x3401}] = newInfo(Tag{0x0020, 0x3401}, "LO", "ACR_NEMA_ModifyingDeviceID", "1", true)
+	tagDict[Tag{0x0020, 0x3402}] = newInfo(Tag{0x0020, 0x3402}, "LO", "ACR_NEMA_ModifiedImageID", "1", true)
+	tagDict[Tag{0x0020, 0x3403}] = newInfo(Tag{0x0020, 0x3403}, "DA", "ACR_NEMA_ModifiedImageDate", "1", true)
+	tagDict[Tag{0x0020, 0x3404}] = newInfo(Tag{0x0020, 0x3404}, "LO", "ACR_NEMA_ModifyingDeviceManufacturer", "1", true)
+	tagDict[Tag{0x0020, 0x3405}] = newInfo(Tag{0x0020, 0x3405}, "TM", "ACR_NEMA_ModifiedImageTime", "1", true)
+	tagDict[Tag{0x0020, 0x3406}] = newInfo(Tag{0x0020, 0x3406}, "LO", "ACR_NEMA_ModifiedImageDescription", "1", true)
+	tagDict[Tag{0x0020, 0x5000}] = newInfo(Tag{0x0020, 0x5000}, "AT", "ACR_NEMA_OriginalImageIdentification", "1-n", true)
+	tagDict[Tag{0x0020, 0x5002}] = newInfo(Tag{0x0020, 0x5002}, "LO", "ACR_NEMA_OriginalImageIdentificationNomenclature", "1-n", true)
+	tagDict[Tag{0x0028, 0x0005}] = newInfo(Tag{0x0028, 0x0005}, "US", "ACR_NEMA_ImageDimensions", "1", true)
+	tagDict[Tag{0x0028, 0x0040}] = newInfo(Tag{0x0028, 0x0040}, "CS", "ACR_NEMA_ImageFormat", "1", true)
+	tagDict[Tag{0x0028, 0x0050}] = newInfo(Tag{0x0028, 0x0050}, "LO", "ACR_NEMA_ManipulatedImage", "1-n", true)
+	tagDict[Tag{0x0028, 0x0060}] = newInfo(Tag{0x0028, 0x0060}, "CS", "ACR_NEMA_CompressionCode", "1", true)
+	tagDict[Tag{0x0028, 0x0104}] = newInfo(Tag{0x0028, 0x0104}, "US or SS", "ACR_NEMA_SmallestValidPixelValue", "1", true)
+	tagDict[Tag{0x0028, 0x0105}] = newInfo(Tag{0x0028, 0x0105}, "US or SS", "ACR_NEMA_LargestValidPixelValue", "1", true)
+	tagDict[Tag{0x0028, 0x0200}] = newInfo(Tag{0x0028, 0x0200}, "US", "ACR_NEMA_ImageLocation", "1", true)
+	tagDict[Tag{0x0028, 0x1080}] = newInfo(Tag{0x0028, 0x1080}, "CS", "ACR_NEMA_GrayScale", "1", true)
+	tagDict[Tag{0x0028, 0x1100}] = newInfo(Tag{0x0028, 0x1100}, "US or SS", "ACR_NEMA_GrayLookupTableDescriptor", "3", true)
+	tagDict[Tag{0x0028, 0x1200}] = newInfo(Tag{0x0028, 0x1200}, "US or SS", "ACR_NEMA_GrayLookupTableData", "1-n", true)
+	tagDict[Tag{0x0028, 0x4000}] = newInfo(Tag{0x0028, 0x4000}, "LT", "ACR_NEMA_ImagePresentationComments", "1-n", true)
+	tagDict[Tag{0x4000, 0x0000}] = newInfo(Tag{0x4000, 0x0000}, "UL", "ACR_NEMA_TextGroupLength", "1", true)
+	tagDict[Tag{0x4000, 0x0010}] = newInfo(Tag{0x4000, 0x0010}, "LT", "ACR_NEMA_TextArbitrary", "1-n", true)
+	tagDict[Tag{0x4000, 0x4000}] = newInfo(Tag{0x4000, 0x4000}, "LT", "ACR_NEMA_TextComments", "1-n", true)
+	addRepeating(Tag{0x6000, 0x0110}, Tag{0xFF01, 0xFFFF}, newInfo(Tag{0x6000, 0x0110}, "CS", "ACR_NEMA_OverlayFormat", "1", true))
+	addRepeating(Tag{0x6000, 0x0200}, Tag{0xFF01, 0xFFFF}, newInfo(Tag{0x6000, 0x0200}, "US", "ACR_NEMA_OverlayLocation", "1", true))
+	addRepeating(Tag{0x6000, 0x4000}, Tag{0xFF01, 0xFFFF}, newInfo(Tag{0x6000, 0x4000}, "LT", "ACR_NEMA_OverlayComments", "1-n", true))
+	tagDict[Tag{0x0028, 0x005F}] = newInfo(Tag{0x0028, 0x005F}, "CS", "ACR_NEMA_2C_CompressionRecognitionCode", "1", true)
+	tagDict[Tag{0x0028, 0x0061}] = newInfo(Tag{0x0028, 0x0061}, "SH", "ACR_NEMA_2C_CompressionOriginator", "1", true)
+	tagDict[Tag{0x0028, 0x0062}] = newInfo(Tag{0x0028, 0x0062}, "SH", "ACR_NEMA_2C_CompressionLabel", "1", true)
+	tagDict[Tag{0x0028, 0x0063}] = newInfo(Tag{0x0028, 0x0063}, "SH", "ACR_NEMA_2C_CompressionDescription", "1", true)
+	tagDict[Tag{0x0028, 0x0065}] = newInfo(Tag{0x0028, 0x0065}, "CS", "ACR_NEMA_2C_CompressionSequence", "1-n", true)
+	tagDict[Tag{0x0028, 0x0066}] = newInfo(Tag{0x0028, 0x0066}, "AT", "ACR_NEMA_2C_CompressionStepPointers", "1-n", true)
+	tagDict[Tag{0x0028, 0x0068}] = newInfo(Tag{0x0028, 0x0068}, "US", "ACR_NEMA_2C_RepeatInterval", "1", true)
+	tagDict[Tag{0x0028, 0x0069}] = newInfo(Tag{0x0028, 0x0069}, "US", "ACR_NEMA_2C_BitsGrouped", "1", true)
+	tagDict[Tag{0x0028, 0x0070}] = newInfo(Tag{0x0028, 0x0070}, "US", "ACR_NEMA_2C_PerimeterTable", "1-n", true)
+	tagDict[Tag{0x0028, 0x0071}] = newInfo(Tag{0x0028, 0x0071}, "US or SS", "ACR_NEMA_2C_PerimeterValue", "1", true)
+	tagDict[Tag{0x0028, 0x0080}] = newInfo(Tag{0x0028, 0x0080}, "US", "ACR_NEMA_2C_PredictorRows", "1", true)
+	tagDict[Tag{0x0028, 0x0081}] = newInfo(Tag{0x0028, 0x0081}, "US", "ACR_NEMA_2C_PredictorColumns", "1", true)
+	tagDict[Tag{0x0028, 0x0082}] = newInfo(Tag{0x0028, 0x0082}, "US", "ACR_NEMA_2C_PredictorConstants", "1-n", true)
+	tagDict[Tag{0x0028, 0x0090}] = newInfo(Tag{0x0028, 0x0090}, "CS", "ACR_NEMA_2C_BlockedPixels", "1", true)
+	tagDict[Tag{0x0028, 0x0091}] = newInfo(Tag{0x0028, 0x0091}, "US", "ACR_NEMA_2C_BlockRows", "1", true)
+	tagDict[Tag{0x0028, 0x0092}] = newInfo(Tag{0x0028, 0x0092}, "US", "ACR_NEMA_2C_BlockColumns", "1", true)
+	tagDict[Tag{0x0028, 0x0093}] = newInfo(Tag{0x0028, 0x0093}, "US", "ACR_NEMA_2C_RowOverlap", "1", true)
+	tagDict[Tag{0x0028, 0x0094}] = newInfo(Tag{0x0028, 0x0094}, "US", "ACR_NEMA_2C_ColumnOverlap", "1", true)
+	tagDict[Tag{0x0028, 0x0400}] = newInfo(Tag{0x0028, 0x0400}, "CS", "ACR_NEMA_2C_TransformLabel", "1", true)
+	tagDict[Tag{0x0028, 0x0401}] = newInfo(Tag{0x0028, 0x0401}, "CS", "ACR_NEMA_2C_TransformVersionNumber", "1", true)
+	tagDict[Tag{0x0028, 0x0402}] = newInfo(Tag{0x0028, 0x0402}, "US", "ACR_NEMA_2C_NumberOfTransformSteps", "1", true)
+	tagDict[Tag{0x0028, 0x0403}] = newInfo(Tag{0x0028, 0x0403}, "CS", "ACR_NEMA_2C_SequenceOfCompressedData", "1-n", true)
+	tagDict[Tag{0x0028, 0x0404}] = newInfo(Tag{0x0028, 0x0404}, "AT", "ACR_NEMA_2C_DetailsOfCoefficients", "1-n", true)
+	tagDict[Tag{0x0028, 0x0410}] = newInfo(Tag{0x0028, 0x0410}, "US", "ACR_NEMA_2C_RowsForNthOrderCoefficients", "1", true)
+	tagDict[Tag{0x0028, 0x0411}] = newInfo(Tag{0x0028, 0x0411}, "US", "ACR_NEMA_2C_ColumnsForNthOrderCoefficients", "1", true)
+	tagDict[Tag{0x0028, 0x0412}] = newInfo(Tag{0x0028, 0x0412}, "CS", "ACR_NEMA_2C_CoefficientCoding", "1-n", true)
+	tagDict[Tag{0x0028, 0x0413}] = newInfo(Tag{0x0028, 0x0413}, "AT", "ACR_NEMA_2C_CoefficientCodingPointers", "1-n", true)
+	tagDict[Tag{0x0028, 0x0700}] = newInfo(Tag{0x0028, 0x0700}, "CS", "ACR_NEMA_2C_DCTLabel", "1", true)
+	tagDict[Tag{0x0028, 0x0701}] = newInfo(Tag{0x0028, 0x0701}, "CS", "ACR_NEMA_2C_DataBlockDescription", "1-n", true)
+	tagDict[Tag{0x0028, 0x0702}] = newInfo(Tag{0x0028, 0x0702}, "AT", "ACR_NEMA_2C_DataBlock", "1-n", true)
+	tagDict[Tag{0x0028, 0x0710}] = newInfo(Tag{0x0028, 0x0710}, "US", "ACR_NEMA_2C_NormalizationFactorFormat", "1", true)
+	tagDict[Tag{0x0028, 0x0720}] = newInfo(Tag{0x0028, 0x0720}, "US", "ACR_NEMA_2C_ZonalMapNumberFormat", "1", true)
+	tagDict[Tag{0x0028, 0x0721}] = newInfo(Tag{0x0028, 0x0721}, "AT", "ACR_NEMA_2C_ZonalMapLocation", "1-n", true)
+	tagDict[Tag{0x0028, 0x0722}] = newInfo(Tag{0x0028, 0x0722}, "US", "ACR_NEMA_2C_ZonalMapFormat", "1", true)
+	tagDict[Tag{0x0028, 0x0730}] = newInfo(Tag{0x0028, 0x0730}, "US", "ACR_NEMA_2C_AdaptiveMapFormat", "1", true)
+	tagDict[Tag{0x0028, 0x0740}] = newInfo(Tag{0x0028, 0x0740}, "US", "ACR_NEMA_2C_CodeNumberFormat", "1", true)
+	tagDict[Tag{0x0028, 0x0800}] = newInfo(Tag{0x0028, 0x0800}, "CS", "ACR_NEMA_2C_CodeLabel", "1-n", true)
+	tagDict[Tag{0x0028, 0x0802}] = newInfo(Tag{0x0028, 0x0802}, "US", "ACR_NEMA_2C_NumberOfTables", "1", true)
+	tagDict[Tag{0x0028, 0x0803}] = newInfo(Tag{0x0028, 0x0803}, "AT", "ACR_NEMA_2C_CodeTableLocation", "1-n", true)
+	tagDict[Tag{0x0028, 0x0804}] = newInfo(Tag{0x0028, 0x0804}, "US", "ACR_NEMA_2C_BitsForCodeWord", "1", true)
+	tagDict[Tag{0x0028, 0x0808}] = newInfo(Tag{0x0028, 0x0808}, "AT", "ACR_NEMA_2C_ImageDataLocation", "1-n", true)
+	tagDict[Tag{0x1000, 0x0000}] = newInfo(Tag{0x1000, 0x0000}, "UL", "ACR_NEMA_2C_CodeTableGroupLength", "1", true)
+	tagDict[Tag{0x1000, 0x0010}] = newInfo(Tag{0x1000, 0x0010}, "US", "ACR_NEMA_2C_EscapeTriplet", "3", true)
+	tagDict[Tag{0x1000, 0x0011}] = newInfo(Tag{0x1000, 0x0011}, "US", "ACR_NEMA_2C_RunLengthTriplet", "3", true)
+	tagDict[Tag{0x1000, 0x0012}] = newInfo(Tag{0x1000, 0x0012}, "US", "ACR_NEMA_2C_HuffmanTableSize", "1", true)
+	tagDict[Tag{0x1000, 0x0013}] = newInfo(Tag{0x1000, 0x0013}, "US", "ACR_NEMA_2C_HuffmanTableTriplet", "3", true)
+	tagDict[Tag{0x1000, 0x0014}] = newInfo(Tag{0x1000, 0x0014}, "US", "ACR_NEMA_2C_ShiftTableSize", "1", true)
+	tagDict[Tag{0x1000, 0x0015}] = newInfo(Tag{0x1000, 0x0015}, "US", "ACR_NEMA_2C_ShiftTableTriplet", "3", true)
+	tagDict[Tag{0x1010, 0x0000}] = newInfo(Tag{0x1010, 0x0000}, "UL", "ACR_NEMA_2C_ZonalMapGroupLength", "1", true)
+	tagDict[Tag{0x1010, 0x0004}] = newInfo(Tag{0x1010, 0x0004}, "US", "ACR_NEMA_2C_ZonalMap", "1-n", true)
+	addRepeating(Tag{0x6000, 0x0060}, Tag{0xFF01, 0xFFFF}, newInfo(Tag{0x6000, 0x0060}, "CS", "ACR_NEMA_2C_OverlayCompressionCode", "1", true))
+	addRepeating(Tag{0x6000, 0x0061}, Tag{0xFF01, 0xFFFF}, newInfo(Tag{0x6000, 0x0061}, "SH", "ACR_NEMA_2C_OverlayCompressionOriginator", "1", true))
+	addRepeating(Tag{0x6000, 0x0062}, Tag{0xFF01, 0xFFFF}, newInfo(Tag{0x6000, 0x0062}, "SH", "ACR_NEMA_2C_OverlayCompressionLabel", "1", true))
+	addRepeating(Tag{0x6000, 0x0063}, Tag{0xFF01, 0xFFFF}, newInfo(Tag{0x6000, 0x0063}, "SH", "ACR_NEMA_2C_OverlayCompressionDescription", "1", true))
+	addRepeating(Tag{0x6000, 0x0066}, Tag{0xFF01, 0xFFFF}, newInfo(Tag{0x6000, 0x0066}, "AT", "ACR_NEMA_2C_OverlayCompressionStepPointers", "1-n", true))
+	addRepeating(Tag{0x6000, 0x0068}, Tag{0xFF01, 0xFFFF}, newInfo(Tag{0x6000, 0x0068}, "US", "ACR_NEMA_2C_OverlayRepeatInterval", "1", true))
+	addRepeating(Tag{0x6000, 0x0069}, Tag{0xFF01, 0xFFFF}, newInfo(Tag{0x6000, 0x0069}, "US", "ACR_NEMA_2C_OverlayBitsGrouped", "1", true))
+	addRepeating(Tag{0x6000, 0x0800}, Tag{0xFF01, 0xFFFF}, newInfo(Tag{0x6000, 0x0800}, "CS", "ACR_NEMA_2C_OverlayCodeLabel", "1-n", true))
+	addRepeating(Tag{0x6000, 0x0802}, Tag{0xFF01, 0xFFFF}, newInfo(Tag{0x6000, 0x0802}, "US", "ACR_NEMA_2C_OverlayNumberOfTables", "1", true))
+	addRepeating(Tag{0x6000, 0x0803}, Tag{0xFF01, 0xFFFF}, newInfo(Tag{0x6000, 0x0803}, "AT", "ACR_NEMA_2C_OverlayCodeTableLocation", "1-n", true))
+	addRepeating(Tag{0x6000, 0x0804}, Tag{0xFF01, 0xFFFF}, newInfo(Tag{0x6000, 0x0804}, "US", "ACR_NEMA_2C_OverlayBitsForCodeWord", "1", true))
+	addRepeating(Tag{0x7F00, 0x0000}, Tag{0xFF01, 0xFFFF}, newInfo(Tag{0x7F00, 0x0000}, "UL", "ACR_NEMA_2C_VariablePixelDataGroupLength", "1", true))
+	addRepeating(Tag{0x7F00, 0x0010}, Tag{0xFF01, 0xFFFF}, newInfo(Tag{0x7F00, 0x0010}, "OW or OB", "ACR_NEMA_2C_VariablePixelData", "1", true))
+	addRepeating(Tag{0x7F00, 0x0011}, Tag{0xFF01, 0xFFFF}, newInfo(Tag{0x7F00, 0x0011}, "AT", "ACR_NEMA_2C_VariableNextDataGroup", "1", true))
+	addRepeating(Tag{0x7F00, 0x0020}, Tag{0xFF01, 0xFFFF}, newInfo(Tag{0x7F00, 0x0020}, "OW", "ACR_NEMA_2C_VariableCoefficientsSDVN", "1-n", true))
+	addRepeating(Tag{0x7F00, 0x0030}, Tag{0xFF01, 0xFFFF}, newInfo(Tag{0x7F00, 0x0030}, "OW", "ACR_NEMA_2C_VariableCoefficientsSDHN", "1-n", true))
+	addRepeating(Tag{0x7F00, 0x0040}, Tag{0xFF01, 0xFFFF}, newInfo(Tag{0x7F00, 0x0040}, "OW", "ACR_NEMA_2C_VariableCoefficientsSDDN", "1-n", true))
+	tagDict[Tag{0x7FE0, 0x0020}] = newInfo(Tag{0x7FE0, 0x0020}, "OW", "ACR_NEMA_2C_CoefficientsSDVN", "1-n", true)
+	tagDict[Tag{0x7FE0, 0x0030}] = newInfo(Tag{0x7FE0, 0x0030}, "OW", "ACR_NEMA_2C_CoefficientsSDHN", "1-n", true)
+	tagDict[Tag{0x7FE0, 0x0040}] = newInfo(Tag{0x7FE0, 0x0040}, "OW", "ACR_NEMA_2C_CoefficientsSDDN", "1-n", true)
+	tagDict[Tag{0x0000, 0x0001}] = newInfo(Tag{0x0000, 0x0001}, "UL", "RETIRED_CommandLengthToEnd", "1", true)
+	tagDict[Tag{0x0000, 0x0010}] = newInfo(Tag{0x0000, 0x0010}, "SH", "RETIRED_CommandRecognitionCode", "1", true)
+	tagDict[Tag{0x0000, 0x0200}] = newInfo(Tag{0x0000, 0x0200}, "AE", "RETIRED_Initiator", "1", true)
+	tagDict[Tag{0x0000, 0x0300}] = newInfo(Tag{0x0000, 0x0300}, "AE", "RETIRED_Receiver", "1", true)
+	tagDict[Tag{0x0000, 0x0400}] = newInfo(Tag{0x0000, 0x0400}, "AE", "RETIRED_FindLocation", "1", true)
+	tagDict[Tag{0x0000, 0x0850}] = newInfo(Tag{0x0000, 0x0850}, "US", "RETIRED_NumberOfMatches", "1", true)
+	tagDict[Tag{0x0000, 0x0860}] = newInfo(Tag{0x0000, 0x0860}, "US", "RETIRED_ResponseSequenceNumber", "1", true)
+	tagDict[Tag{0x0000, 0x4000}] = newInfo(Tag{0x0000, 0x4000}, "AT", "RETIRED_DialogReceiver", "1", true)
+	tagDict[Tag{0x0000, 0x4010}] = newInfo(Tag{0x0000, 0x4010}, "AT", "RETIRED_TerminalType", "1", true)
+	tagDict[Tag{0x0000, 0x5010}] = newInfo(Tag{0x0000, 0x5010}, "SH", "RETIRED_MessageSetID", "1", true)
+	tagDict[Tag{0x0000, 0x5020}] = newInfo(Tag{0x0000, 0x5020}, "SH", "RETIRED_EndMessageID", "1", true)
+	tagDict[Tag{0x0000, 0x5110}] = newInfo(Tag{0x0000, 0x5110}, "AT", "RETIRED_DisplayFormat", "1", true)
+	tagDict[Tag{0x0000, 0x5120}] = newInfo(Tag{0x0000, 0x5120}, "AT", "RETIRED_PagePositionID", "1", true)
+	tagDict[Tag{0x0000, 0x5130}] = newInfo(Tag{0x0000, 0x5130}, "CS", "RETIRED_TextFormatID", "1", true)
+	tagDict[Tag{0x0000, 0x5140}] = newInfo(Tag{0x0000, 0x5140}, "CS", "RETIRED_NormalReverse", "1", true)
+	tagDict[Tag{0x0000, 0x5150}] = newInfo(Tag{0x0000, 0x5150}, "CS", "RETIRED_AddGrayScale", "1", true)
+	tagDict[Tag{0x0000, 0x5160}] = newInfo(Tag{0x0000, 0x5160}, "CS", "RETIRED_Borders", "1", true)
+	tagDict[Tag{0x0000, 0x5170}] = newInfo(Tag{0x0000, 0x5170}, "IS", "RETIRED_Copies", "1", true)
+	tagDict[Tag{0x0000, 0x5180}] = newInfo(Tag{0x0000, 0x5180}, "CS", "RETIRED_CommandMagnificationType", "1", true)
+	tagDict[Tag{0x0000, 0x5190}] = newInfo(Tag{0x0000, 0x5190}, "CS", "RETIRED_Erase", "1", true)
+	tagDict[Tag{0x0000, 0x51A0}] = newInfo(Tag{0x0000, 0x51A0}, "CS", "RETIRED_Print", "1", true)
+	tagDict[Tag{0x0000, 0x51B0}] = newInfo(Tag{0x0000, 0x51B0}, "US", "RETIRED_Overlays", "1-n", true)
+	tagDict[Tag{0x0004, 0x1504}] = newInfo(Tag{0x0004, 0x1504}, "UL", "RETIRED_MRDRDirectoryRecordOffset", "1", true)
+	tagDict[Tag{0x0004, 0x1600}] = newInfo(Tag{0x0004, 0x1600}, "UL", "RETIRED_NumberOfReferences", "1", true)
+	tagDict[Tag{0x0008, 0x0001}] = newInfo(Tag{0x0008, 0x0001}, "UL", "RETIRED_LengthToEnd", "1", true)
+	tagDict[Tag{0x0008, 0x0010}] = newInfo(Tag{0x0008, 0x0010}, "SH", "RETIRED_RecognitionCode", "1", true)
+	tagDict[Tag{0x0008, 0x0024}] = newInfo(Tag{0x0008, 0x0024}, "DA", "RETIRED_OverlayDate", "1", true)
+	tagDict[Tag{0x0008, 0x0025}] = newInfo(Tag{0x0008, 0x0025}, "DA", "RETIRED_CurveDate", "1", true)
+	tagDict[Tag{0x0008, 0x0034}] = newInfo(Tag{0x0008, 0x0034}, "TM", "RETIRED_OverlayTime", "1", true)
+	tagDict[Tag{0x0008, 0x0035}] = newInfo(Tag{0x0008, 0x0035}, "TM", "RETIRED_CurveTime", "1", true)
+	tagDict[Tag{0x0008, 0x0040}] = newInfo(Tag{0x0008, 0x0040}, "US", "RETIRED_DataSetType", "1", true)
+	tagDict[Tag{0x0008, 0x0041}] = newInfo(Tag{0x0008, 0x0041}, "LO", "RETIRED_DataSetSubtype", "1", true)
+	tagDict[Tag{0x0008, 0x0042}] = newInfo(Tag{0x0008, 0x0042}, "CS", "RETIRED_NuclearMedicineSeriesType", "1", true)
+	tagDict[Tag{0x0008, 0x1000}] = newInfo(Tag{0x0008, 0x1000}, "AE", "RETIRED_NetworkID", "1", true)
+	tagDict[Tag{0x0008, 0x1100}] = newInfo(Tag{0x0008, 0x1100}, "SQ", "RETIRED_ReferencedResultsSequence", "1", true)
+	tagDict[Tag{0x0008, 0x1130}] = newInfo(Tag{0x0008, 0x1130}, "SQ", "RETIRED_ReferencedOverlaySequence", "1", true)
+	tagDict[Tag{0x0008, 0x1145}] = newInfo(Tag{0x0008, 0x1145}, "SQ", "RETIRED_ReferencedCurveSequence", "1", true)
+	tagDict[Tag{0x0008, 0x2110}] = newInfo(Tag{0x0008, 0x2110}, "CS", "RETIRED_LossyImageCompressionRetired", "1", true)
+	tagDict[Tag{0x0008, 0x2200}] = newInfo(Tag{0x0008, 0x2200}, "CS", "RETIRED_TransducerPosition", "1", true)
+	tagDict[Tag{0x0008, 0x2204}] = newInfo(Tag{0x0008, 0x2204}, "CS", "RETIRED_TransducerOrientation", "1", true)
+	tagDict[Tag{0x0008, 0x2208}] = newInfo(Tag{0x0008, 0x2208}, "CS", "RETIRED_AnatomicStructure", "1", true)
+	tagDict[Tag{0x0008, 0x2240}] = newInfo(Tag{0x0008, 0x2240}, "SQ", "RETIRED_TransducerPositionSequence", "1", true)
+	tagDict[Tag{0x0008, 0x2242}] = newInfo(Tag{0x0008, 0x2242}, "SQ", "RETIRED_TransducerPositionModifierSequence", "1", true)
+	tagDict[Tag{0x0008, 0x2244}] = newInfo(Tag{0x0008, 0x2244}, "SQ", "RETIRED_TransducerOrientationSequence", "1", true)
+	tagDict[Tag{0x0008, 0x2246}] = newInfo(Tag{0x0008, 0x2246}, "SQ", "RETIRED_TransducerOrientationModifierSequence", "1", true)
+	tagDict[Tag{0x0008, 0x2251}] = newInfo(Tag{0x0008, 0x2251}, "SQ", "RETIRED_AnatomicStructureSpaceOrRegionCodeSequenceTrial", "1", true)
+	tagDict[Tag{0x0008, 0x2253}] = newInfo(Tag{0x0008, 0x2253}, "SQ", "RETIRED_AnatomicPortalOfEntranceCodeSequenceTrial", "1", true)
+	tagDict[Tag{0x0008, 0x2255}] = newInfo(Tag{0x0008, 0x2255}, "SQ", "RETIRED_AnatomicApproachDirectionCodeSequenceTrial", "1", true)
+	tagDict[Tag{0x0008, 0x2256}] = newInfo(Tag{0x0008, 0x2256}, "ST", "RETIRED_AnatomicPerspectiveDescriptionTrial", "1", true)
+	tagDict[Tag{0x0008, 0x2257}] = newInfo(Tag{0x0008, 0x2257}, "SQ", "RETIRED_AnatomicPerspectiveCodeSequenceTrial", "1", true)
+	tagDict[Tag{0x0008, 0x2258}] = newInfo(Tag{0x0008, 0x2258}, "ST", "RETIRED_AnatomicLocationOfExaminingInstrumentDescriptionTrial", "1", true)
+	tagDict[Tag{0x0008, 0x2259}] = newInfo(Tag{0x0008, 0x2259}, "SQ", "RETIRED_AnatomicLocationOfExaminingInstrumentCodeSequenceTrial", "1", true)
+	tagDict[Tag{0x0008, 0x225A}] = newInfo(Tag{0x0008, 0x225A}, "SQ", "RETIRED_AnatomicStructureSpaceOrRegionModifierCodeSequenceTrial", "1", true)
+	tagDict[Tag{0x0008, 0x225C}] = newInfo(Tag{0x0008, 0x225C}, "SQ", "RETIRED_OnAxisBackgroundAnatomicStructureCodeSequenceTrial", "1", true)
+	tagDict[Tag{0x0008, 0x4000}] = newInfo(Tag{0x0008, 0x4000}, "LT", "RETIRED_IdentifyingComments", "1", true)
+	tagDict[Tag{0x0010, 0x1050}] = newInfo(Tag{0x0010, 0x1050}, "LO", "RETIRED_InsurancePlanIdentification", "1-n", true)
+	tagDict[Tag{0x0018, 0x0030}] = newInfo(Tag{0x0018, 0x0030}, "LO", "RETIRED_Radionuclide", "1-n", true)
+	tagDict[Tag{0x0018, 0x0032}] = newInfo(Tag{0x0018, 0x0032}, "DS", "RETIRED_EnergyWindowCenterline", "1", true)
+	tagDict[Tag{0x0018, 0x0033}] = newInfo(Tag{0x0018, 0x0033}, "DS", "RETIRED_EnergyWindowTotalWidth", "1-n", true)
+	tagDict[Tag{0x0018, 0x0037}] = newInfo(Tag{0x0018, 0x0037}, "CS", "RETIRED_TherapyType", "1", true)
+	tagDict[Tag{0x0018, 0x0039}] = newInfo(Tag{0x0018, 0x0039}, "CS", "RETIRED_TherapyDescription", "1", true)
+	tagDict[Tag{0x0018, 0x1011}] = newInfo(Tag{0x0018, 0x1011}, "LO", "RETIRED_HardcopyCreationDeviceID", "1", true)
+	tagDict[Tag{0x0018, 0x1017}] = newInfo(Tag{0x0018, 0x1017}, "LO", "RETIRED_HardcopyDeviceManufacturer", "1", true)
+	tagDict[Tag{0x0018, 0x101A}] = newInfo(Tag{0x0018, 0x101A}, "LO", "RETIRED_HardcopyDeviceSoftwareVersion", "1-n", true)
+	tagDict[Tag{0x0018, 0x101B}] = newInfo(Tag{0x0018, 0x101B}, "LO", "RETIRED_HardcopyDeviceManufacturerModelName", "1", true)
+	tagDict[Tag{0x0018, 0x1141}] = newInfo(Tag{0x0018, 0x1141}, "DS", "RETIRED_AngularPosition", "1", true)
+	tagDict[Tag{0x0018, 0x1146}] = newInfo(Tag{0x0018, 0x1146}, "DS", "RETIRED_RotationOffset", "1-n", true)
+	tagDict[Tag{0x0018, 0x1240}] = newInfo(Tag{0x0018, 0x1240}, "IS", "RETIRED_UpperLowerPixelValues", "1-n", true)
+	tagDict[Tag{0x0018, 0x4000}] = newInfo(Tag{0x0018, 0x4000}, "LT", "RETIRED_AcquisitionComments", "1", true)
+	tagDict[Tag{0x0018, 0x5021}] = newInfo(Tag{0x0018, 0x5021}, "LO", "RETIRED_PostprocessingFunction", "1", true)
+	tagDict[Tag{0x0018, 0x5030}] = newInfo(Tag{0x0018, 0x5030}, "DS", "RETIRED_DynamicRange", "1", true)
+	tagDict[Tag{0x0018, 0x5040}] = newInfo(Tag{0x0018, 0x5040}, "DS", "RETIRED_TotalGain", "1", true)
+	tagDict[Tag{0x0018, 0x5210}] = newInfo(Tag{0x0018, 0x5210}, "DS", "RETIRED_ImageTransformationMatrix", "6", true)
+	tagDict[Tag{0x0018, 0x5212}] = newInfo(Tag{0x0018, 0x5212}, "DS", "RETIRED_ImageTranslationVector", "3", true)
+	tagDict[Tag{0x0018, 0x6038}] = newInfo(Tag{0x0018, 0x6038}, "UL", "RETIRED_DopplerSampleVolumeXPositionRetired", "1", true)
+	tagDict[Tag{0x0018, 0x603A}] = newInfo(Tag{0x0018, 0x603A}, "UL", "RETIRED_DopplerSampleVolumeYPositionRetired", "1", true)
+	tagDict[Tag{0x0018, 0x603C}] = newInfo(Tag{0x0018, 0x603C}, "UL", "RETIRED_TMLinePositionX0Retired", "1", true)
+	tagDict[Tag{0x0018, 0x603E}] = newInfo(Tag{0x0018, 0x603E}, "UL", "RETIRED_TMLinePositionY0Retired", "1", true)
+	tagDict[Tag{0x0018, 0x6040}] = newInfo(Tag{0x0018, 0x6040}, "UL", "RETIRED_TMLinePositionX1Retired", "1", true)
+	tagDict[Tag{0x0018, 0x6042}] = newInfo(Tag{0x0018, 0x6042}, "UL", "RETIRED_TMLinePositionY1Retired", "1", true)
+	tagDict[Tag{0x0018, 0x9096}] = newInfo(Tag{0x0018, 0x9096}, "FD", "RETIRED_ParallelReductionFactorInPlaneRetired", "1", true)
+	tagDict[Tag{0x0018, 0x9166}] = newInfo(Tag{0x0018, 0x9166}, "CS", "RETIRED_BulkMotionStatus", "1", true)
+	tagDict[Tag{0x0018, 0x9195}] = newInfo(Tag{0x0018, 0x9195}, "FD", "RETIRED_ChemicalShiftMinimumIntegrationLimitInHz", "1", true)
+	tagDict[Tag{0x0018, 0x9196}] = newInfo(Tag{0x0018, 0x9196}, "FD", "RETIRED_ChemicalShiftMaximumIntegrationLimitInHz", "1", true)
+	tagDict[Tag{0x0020, 0x0014}] = newInfo(Tag{0x0020, 0x0014}, "IS", "RETIRED_IsotopeNumber", "1", true)
+	tagDict[Tag{0x0020, 0x0015}] = newInfo(Tag{0x0020, 0x0015}, "IS", "RETIRED_PhaseNumber", "1", true)
+	tagDict[Tag{0x0020, 0x0016}] = newInfo(Tag{0x0020, 0x0016}, "IS", "RETIRED_IntervalNumber", "1", true)
+	tagDict[Tag{0x0020, 0x0017}] = newInfo(Tag{0x0020, 0x0017}, "IS", "RETIRED_TimeSlotNumber", "1", true)
+	tagDict[Tag{0x0020, 0x0018}] = newInfo(Tag{0x0020, 0x0018}, "IS", "RETIRED_AngleNumber", "1", true)
+	tagDict[Tag{0x0020, 0x0022}] = newInfo(Tag{0x0020, 0x0022}, "IS", "RETIRED_OverlayNumber", "1", true)
+	tagDict[Tag{0x0020, 0x0024}] = newInfo(Tag{0x0020, 0x0024}, "IS", "RETIRED_CurveNumber", "1", true)
+	tagDict[Tag{0x0020, 0x0026}] = newInfo(Tag{0x0020, 0x0026}, "IS", "RETIRED_LUTNumber", "1", true)
+	tagDict[Tag{0x0020, 0x0030}] = newInfo(Tag{0x0020, 0x0030}, "DS", "RETIRED_ImagePosition", "3", true)
+	tagDict[Tag{0x0020, 0x0035}] = newInfo(Tag{0x0020, 0x0035}, "DS", "RETIRED_ImageOrientation", "6", true)
+	tagDict[Tag{0x0020, 0x0050}] = newInfo(Tag{0x0020, 0x0050}, "DS", "RETIRED_Location", "1", true)
+	tagDict[Tag{0x0020, 0x0070}] = newInfo(Tag{0x0020, 0x0070}, "LO", "RETIRED_ImageGeometryType", "1", true)
+	tagDict[Tag{0x0020, 0x0080}] = newInfo(Tag{0x0020, 0x0080}, "CS", "RETIRED_MaskingImage", "1-n", true)
+	tagDict[Tag{0x0020, 0x00AA}] = newInfo(Tag{0x0020, 0x00AA}, "IS", "RETIRED_ReportNumber", "1", true)
+	tagDict[Tag{0x0020, 0x1000}] = newInfo(Tag{0x0020, 0x1000}, "IS", "RETIRED_SeriesInStudy", "1", true)
+	tagDict[Tag{0x0020, 0x1001}] = newInfo(Tag{0x0020, 0x1001}, "IS", "RETIRED_AcquisitionsInSeries", "1", true)
+	tagDict[Tag{0x0020, 0x1003}] = newInfo(Tag{0x0020, 0x1003}, "IS", "RETIRED_ImagesInSeries", "1", true)
+	tagDict[Tag{0x0020, 0x1004}] = newInfo(Tag{0x0020, 0x1004}, "IS", "RETIRED_AcquisitionsInStudy", "1", true)
+	tagDict[Tag{0x0020, 0x1005}] = newInfo(Tag{0x0020, 0x1005}, "IS", "RETIRED_ImagesInStudy", "1", true)
+	tagDict[Tag{0x0020, 0x1020}] = newInfo(Tag{0x0020, 0x1020}, "LO", "RETIRED_Reference", "1-n", true)
+	tagDict[Tag{0x0020, 0x1070}] = newInfo(Tag{0x0020, 0x1070}, "IS", "RETIRED_OtherStudyNumbers", "1-n", true)
+	addRepeating(Tag{0x0020, 0x3100}, Tag{0xFFFF, 0xFF01}, newInfo(Tag{0x0020, 0x3100}, "CS", "RETIRED_SourceImageIDs", "1-n", true))
+	tagDict[Tag{0x0020, 0x3401}] = newInfo(Tag{0x0020, 0x3401}, "CS", "RETIRED_ModifyingDeviceID", "1", true)
+	tagDict[Tag{0x0020, 0x3402}] = newInfo(Tag{0x0020, 0x3402}, "CS", "RETIRED_ModifiedImageID", "1", true)
+	tagDict[Tag{0x0020, 0x3403}] = newInfo(Tag{0x0020, 0x3403}, "DA", "RETIRED_ModifiedImageDate", "1", true)
+	tagDict[Tag{0x0020, 0x3404}] = newInfo(Tag{0x0020, 0x3404}, "LO", "RETIRED_ModifyingDeviceManufacturer", "1", true)
+	tagDict[Tag{0x0020, 0x3405}] = newInfo(Tag{0x0020, 0x3405}, "TM", "RETIRED_ModifiedImageTime", "1", true)
+	tagDict[Tag{0x0020, 0x3406}] = newInfo(Tag{0x0020, 0x3406}, "LO", "RETIRED_ModifiedImageDescription", "1", true)
+	tagDict[Tag{0x0020, 0x5000}] = newInfo(Tag{0x0020, 0x5000}, "AT", "RETIRED_OriginalImageIdentification", "1-n", true)
+	tagDict[Tag{0x0020, 0x5002}] = newInfo(Tag{0x0020, 0x5002}, "LO", "RETIRED_OriginalImageIdentificationNomenclature", "1-n", true)
+	tagDict[Tag{0x0028, 0x0005}] = newInfo(Tag{0x0028, 0x0005}, "US", "RETIRED_ImageDimensions", "1", true)
+	tagDict[Tag{0x0028, 0x0012}] = newInfo(Tag{0x0028, 0x0012}, "US", "RETIRED_Planes", "1", true)
+	tagDict[Tag{0x0028, 0x0040}] = newInfo(Tag{0x0028, 0x0040}, "CS", "RETIRED_ImageFormat", "1", true)
+	tagDict[Tag{0x0028, 0x0050}] = newInfo(Tag{0x0028, 0x0050}, "LO", "RETIRED_ManipulatedImage", "1-n", true)
+	tagDict[Tag{0x0028, 0x005F}] = newInfo(Tag{0x0028, 0x005F}, "LO", "RETIRED_CompressionRecognitionCode", "1", true)
+	tagDict[Tag{0x0028, 0x0060}] = newInfo(Tag{0x0028, 0x0060}, "CS", "RETIRED_CompressionCode", "1", true)
+	tagDict[Tag{0x0028, 0x0061}] = newInfo(Tag{0x0028, 0x0061}, "SH", "RETIRED_CompressionOriginator", "1", true)
+	tagDict[Tag{0x0028, 0x0062}] = newInfo(Tag{0x0028, 0x0062}, "LO", "RETIRED_CompressionLabel", "1", true)
+	tagDict[Tag{0x0028, 0x0063}] = newInfo(Tag{0x0028, 0x0063}, "SH", "RETIRED_CompressionDescription", "1", true)
+	tagDict[Tag{0x0028, 0x0065}] = newInfo(Tag{0x0028, 0x0065}, "CS", "RETIRED_CompressionSequence", "1-n", true)
+	tagDict[Tag{0x0028, 0x0066}] = newInfo(Tag{0x0028, 0x0066}, "AT", "RETIRED_CompressionStepPointers", "1-n", true)
+	tagDict[Tag{0x0028, 0x0068}] = newInfo(Tag{0x0028, 0x0068}, "US", "RETIRED_RepeatInterval", "1", true)
+	tagDict[Tag{0x0028, 0x0069}] = newInfo(Tag{0x0028, 0x0069}, "US", "RETIRED_BitsGrouped", "1", true)
+	tagDict[Tag{0x0028, 0x0070}] = newInfo(Tag{0x0028, 0x0070}, "US", "RETIRED_PerimeterTable", "1-n", true)
+	tagDict[Tag{0x0028, 0x0071}] = newInfo(Tag{0x0028, 0x0071}, "US or SS", "RETIRED_PerimeterValue", "1", true)
+	tagDict[Tag{0x0028, 0x0080}] = newInfo(Tag{0x0028, 0x0080}, "US", "RETIRED_PredictorRows", "1", true)
+	tagDict[Tag{0x0028, 0x0081}] = newInfo(Tag{0x0028, 0x0081}, "US", "RETIRED_PredictorColumns", "1", true)
+	tagDict[Tag{0x0028, 0x0082}] = newInfo(Tag{0x0028, 0x0082}, "US", "RETIRED_PredictorConstants", "1-n", true)
+	tagDict[Tag{0x0028, 0x0090}] = newInfo(Tag{0x0028, 0x0090}, "CS", "RETIRED_BlockedPixels", "1", true)
+	tagDict[Tag{0x0028, 0x0091}] = newInfo(Tag{0x0028, 0x0091}, "US", "RETIRED_BlockRows", "1", true)
+	tagDict[Tag{0x0028, 0x0092}] = newInfo(Tag{0x0028, 0x0092}, "US", "RETIRED_BlockColumns", "1", true)
+	tagDict[Tag{0x0028, 0x0093}] = newInfo(Tag{0x0028, 0x0093}, "US", "RETIRED_RowOverlap", "1", true)
+	tagDict[Tag{0x0028, 0x0094}] = newInfo(Tag{0x0028, 0x0094}, "US", "RETIRED_ColumnOverlap", "1", true)
+	tagDict[Tag{0x0028, 0x0104}] = newInfo(Tag{0x0028, 0x0104}, "US or SS", "RETIRED_SmallestValidPixelValue", "1", true)
+	tagDict[Tag{0x0028, 0x0105}] = newInfo(Tag{0x0028, 0x0105}, "US or SS", "RETIRED_LargestValidPixelValue", "1", true)
+	tagDict[Tag{0x0028, 0x0110}] = newInfo(Tag{0x0028, 0x0110}, "US or SS", "RETIRED_SmallestImagePixelValueInPlane", "1", true)
+	tagDict[Tag{0x0028, 0x0111}] = newInfo(Tag{0x0028, 0x0111}, "US or SS", "RETIRED_LargestImagePixelValueInPlane", "1", true)
+	tagDict[Tag{0x0028, 0x0200}] = newInfo(Tag{0x0028, 0x0200}, "US", "RETIRED_ImageLocation", "1", true)
+	tagDict[Tag{0x0028, 0x0400}] = newInfo(Tag{0x0028, 0x0400}, "LO", "RETIRED_TransformLabel", "1", true)
+	tagDict[Tag{0x0028, 0x0401}] = newInfo(Tag{0x0028, 0x0401}, "LO", "RETIRED_TransformVersionNumber", "1", true)
+	tagDict[Tag{0x0028, 0x0402}] = newInfo(Tag{0x0028, 0x0402}, "US", "RETIRED_NumberOfTransformSteps", "1", true)
+	tagDict[Tag{0x0028, 0x0403}] = newInfo(Tag{0x0028, 0x0403}, "LO", "RETIRED_SequenceOfCompressedData", "1-n", true)
+	tagDict[Tag{0x0028, 0x0404}] = newInfo(Tag{0x0028, 0x0404}, "AT", "RETIRED_DetailsOfCoefficients", "1-n", true)
+	tagDict[Tag{0x0028, 0x0700}] = newInfo(Tag{0x0028, 0x0700}, "LO", "RETIRED_DCTLabel", "1", true)
+	tagDict[Tag{0x0028, 0x0701}] = newInfo(Tag{0x0028, 0x0701}, "CS", "RETIRED_DataBlockDescription", "1-n", true)
+	tagDict[Tag{0x0028, 0x0702}] = newInfo(Tag{0x0028, 0x0702}, "AT", "RETIRED_DataBlock", "1-n", true)
+	tagDict[Tag{0x0028, 0x0710}] = newInfo(Tag{0x0028, 0x0710}, "US", "RETIRED_NormalizationFactorFormat", "1", true)
+	tagDict[Tag{0x0028, 0x0720}] = newInfo(Tag{0x0028, 0x0720}, "US", "RETIRED_ZonalMapNumberFormat", "1", true)
+	tagDict[Tag{0x0028, 0x0721}] = newInfo(Tag{0x0028, 0x0721}, "AT", "RETIRED_ZonalMapLocation", "1-n", true)
+	tagDict[Tag{0x0028, 0x0722}] = newInfo(Tag{0x0028, 0x0722}, "US", "RETIRED_ZonalMapFormat", "1", true)
+	tagDict[Tag{0x0028, 0x0730}] = newInfo(Tag{0x0028, 0x0730}, "US", "RETIRED_AdaptiveMapFormat", "1", true)
+	tagDict[Tag{0x0028, 0x0740}] = newInfo(Tag{0x0028, 0x0740}, "US", "RETIRED_CodeNumberFormat", "1", true)
+	tagDict[Tag{0x0028, 0x1080}] = newInfo(Tag{0x0028, 0x1080}, "CS", "RETIRED_GrayScale", "1", true)
+	tagDict[Tag{0x0028, 0x1100}] = newInfo(Tag{0x0028, 0x1100}, "US or SS", "RETIRED_GrayLookupTableDescriptor", "3", true)
+	tagDict[Tag{0x0028, 0x1111}] = newInfo(Tag{0x0028, 0x1111}, "US or SS", "RETIRED_LargeRedPaletteColorLookupTableDescriptor", "4", true)
+	tagDict[Tag{0x0028, 0x1112}] = newInfo(Tag{0x0028, 0x1112}, "US or SS", "RETIRED_LargeGreenPaletteColorLookupTableDescriptor", "4", true)
+	tagDict[Tag{0x0028, 0x1113}] = newInfo(Tag{0x0028, 0x1113}, "US or SS", "RETIRED_LargeBluePaletteColorLookupTableDescriptor", "4", true)
+	tagDict[Tag{0x0028, 0x1200}] = newInfo(Tag{0x0028, 0x1200}, "OW or US or SS", "RETIRED_GrayLookupTableData", "1-n", true)
+	tagDict[Tag{0x0028, 0x1211}] = newInfo(Tag{0x0028, 0x1211}, "OW", "RETIRED_LargeRedPaletteColorLookupTableData", "1", true)
+	tagDict[Tag{0x0028, 0x1212}] = newInfo(Tag{0x0028, 0x1212}, "OW", "RETIRED_LargeGreenPaletteColorLookupTableData", "1", true)
+	tagDict[Tag{0x0028, 0x1213}] = newInfo(Tag{0x0028, 0x1213}, "OW", "RETIRED_LargeBluePaletteColorLookupTableData", "1", true)
+	tagDict[Tag{0x0028, 0x1214}] = newInfo(Tag{0x0028, 0x1214}, "UI", "RETIRED_LargePaletteColorLookupTableUID", "1", true)
+	tagDict[Tag{0x0028, 0x4000}] = newInfo(Tag{0x0028, 0x4000}, "LT", "RETIRED_ImagePresentationComments", "1", true)
+	tagDict[Tag{0x0028, 0x5000}] = newInfo(Tag{0x0028, 0x5000}, "SQ", "RETIRED_BiPlaneAcquisitionSequence", "1", true)
+	tagDict[Tag{0x0028, 0x6030}] = newInfo(Tag{0x0028, 0x6030}, "US", "RETIRED_MaskPointers", "1-n", true)
+	tagDict[Tag{0x0028, 0x9099}] = newInfo(Tag{0x0028, 0x9099}, "US", "RETIRED_LargestMonochromePixelValue", "1", true)
+	tagDict[Tag{0x0032, 0x000A}] = newInfo(Tag{0x0032, 0x000A}, "CS", "RETIRED_StudyStatusID", "1", true)
+	tagDict[Tag{0x0032, 0x000C}] = newInfo(Tag{0x0032, 0x000C}, "CS", "RETIRED_StudyPriorityID", "1", true)
+	tagDict[Tag{0x0032, 0x0012}] = newInfo(Tag{0x0032, 0x0012}, "LO", "RETIRED_StudyIDIssuer", "1", true)
+	tagDict[Tag{0x0032, 0x0032}] = newInfo(Tag{0x0032, 0x0032}, "DA", "RETIRED_StudyVerifiedDate", "1", true)
+	tagDict[Tag{0x0032, 0x0033}] = newInfo(Tag{0x0032, 0x0033}, "TM", "RETIRED_StudyVerifiedTime", "1", true)
+	tagDict[Tag{0x0032, 0x0034}] = newInfo(Tag{0x0032, 0x0034}, "DA", "RETIRED_StudyReadDate", "1", true)
+	tagDict[Tag{0x0032, 0x0035}] = newInfo(Tag{0x0032, 0x0035}, "TM", "RETIRED_StudyReadTime", "1", true)
+	tagDict[Tag{0x0032, 0x1000}] = newInfo(Tag{0x0032, 0x1000}, "DA", "RETIRED_ScheduledStudyStartDate", "1", true)
+	tagDict[Tag{0x0032, 0x1001}] = newInfo(Tag{0x0032, 0x1001}, "TM", "RETIRED_ScheduledStudyStartTime", "1", true)
+	tagDict[Tag{0x0032, 0x1010}] = newInfo(Tag{0x0032, 0x1010}, "DA", "RETIRED_ScheduledStudyStopDate", "1", true)
+	tagDict[Tag{0x0032, 0x1011}] = newInfo(Tag{0x0032, 0x1011}, "TM", "RETIRED_ScheduledStudyStopTime", "1", true)
+	tagDict[Tag{0x0032, 0x1020}] = newInfo(Tag{0x0032, 0x1020}, "LO", "RETIRED_ScheduledStudyLocation", "1", true)
+	tagDict[Tag{0x0032, 0x1021}] = newInfo(Tag{0x0032, 0x1021}, "AE", "RETIRED_ScheduledStudyLocationAETitle", "1-n", true)
+	tagDict[Tag{0x0032, 0x1030}] = newInfo(Tag{0x0032, 0x1030}, "LO", "RETIRED_ReasonForStudy", "1", true)
+	tagDict[Tag{0x0032, 0x1040}] = newInfo(Tag{0x0032, 0x1040}, "DA", "RETIRED_StudyArrivalDate", "1", true)
+	tagDict[Tag{0x0032, 0x1041}] = newInfo(Tag{0x0032, 0x1041}, "TM", "RETIRED_StudyArrivalTime", "1", true)
+	tagDict[Tag{0x0032, 0x1050}] = newInfo(Tag{0x0032, 0x1050}, "DA", "RETIRED_StudyCompletionDate", "1", true)
+	tagDict[Tag{0x0032, 0x1051}] = newInfo(Tag{0x0032, 0x1051}, "TM", "RETIRED_StudyCompletionTime", "1", true)
+	tagDict[Tag{0x0032, 0x1055}] = newInfo(Tag{0x0032, 0x1055}, "CS", "RETIRED_StudyComponentStatusID", "1", true)
+	tagDict[Tag{0x0032, 0x4000}] = newInfo(Tag{0x0032, 0x4000}, "LT", "RETIRED_StudyComments", "1", true)
+	tagDict[Tag{0x0038, 0x0011}] = newInfo(Tag{0x0038, 0x0011}, "LO", "RETIRED_IssuerOfAdmissionID", "1", true)
+	tagDict[Tag{0x0038, 0x001A}] = newInfo(Tag{0x0038, 0x001A}, "DA", "RETIRED_ScheduledAdmissionDate", "1", true)
+	tagDict[Tag{0x0038, 0x001B}] = newInfo(Tag{0x0038, 0x001B}, "TM", "RETIRED_ScheduledAdmissionTime", "1", true)
+	tagDict[Tag{0x0038, 0x001C}] = newInfo(Tag{0x0038, 0x001C}, "DA", "RETIRED_ScheduledDischargeDate", "1", true)
+	tagDict[Tag{0x0038, 0x001D}] = newInfo(Tag{0x0038, 0x001D}, "TM", "RETIRED_ScheduledDischargeTime", "1", true)
+	tagDict[Tag{0x0038, 0x001E}] = newInfo(Tag{0x0038, 0x001E}, "LO", "RETIRED_ScheduledPatientInstitutionResidence", "1", true)
+	tagDict[Tag{0x0038, 0x0030}] = newInfo(Tag{0x0038, 0x0030}, "DA", "RETIRED_DischargeDate", "1", true)
+	tagDict[Tag{0x0038, 0x0032}] = newInfo(Tag{0x0038, 0x0032}, "TM", "RETIRED_DischargeTime", "1", true)
+	tagDict[Tag{0x0038, 0x0040}] = newInfo(Tag{0x0038, 0x0040}, "LO", "RETIRED_DischargeDiagnosisDescription", "1", true)
+	tagDict[Tag{0x0038, 0x0044}] = newInfo(Tag{0x0038, 0x0044}, "SQ", "RETIRED_DischargeDiagnosisCodeSequence", "1", true)
+	tagDict[Tag{0x0038, 0x0061}] = newInfo(Tag{0x0038, 0x0061}, "LO", "RETIRED_IssuerOfServiceEpisodeID", "1", true)
+	tagDict[Tag{0x0040, 0x0307}] = newInfo(Tag{0x0040, 0x0307}, "DS", "RETIRED_DistanceSourceToSupport", "1", true)
+	tagDict[Tag{0x0040, 0x0330}] = newInfo(Tag{0x0040, 0x0330}, "SQ", "RETIRED_ReferencedProcedureStepSequence", "1", true)
+	tagDict[Tag{0x0040, 0x050A}] = newInfo(Tag{0x0040, 0x050A}, "LO", "RETIRED_SpecimenAccessionNumber", "1", true)
+	tagDict[Tag{0x0040, 0x0550}] = newInfo(Tag{0x0040, 0x0550}, "SQ", "RETIRED_SpecimenSequence", "1", true)
+	tagDict[Tag{0x0040, 0x0552}] = newInfo(Tag{0x0040, 0x0552}, "SQ", "RETIRED_SpecimenDescriptionSequenceTrial", "1", true)
+	tagDict[Tag{0x0040, 0x0553}] = newInfo(Tag{0x0040, 0x0553}, "ST", "RETIRED_SpecimenDescriptionTrial", "1", true)
+	tagDict[Tag{0x0040, 0x06FA}] = newInfo(Tag{0x0040, 0x06FA}, "LO", "RETIRED_SlideIdentifier", "1", true)
+	tagDict[Tag{0x0040, 0x08D8}] = newInfo(Tag{0x0040, 0x08D8}, "SQ", "RETIRED_PixelSpacingSequence", "1", true)
+	tagDict[Tag{0x0040, 0x08DA}] = newInfo(Tag{0x0040, 0x08DA}, "SQ", "RETIRED_CoordinateSystemAxisCodeSequence", "1", true)
+	tagDict[Tag{0x0040, 0x09F8}] = newInfo(Tag{0x0040, 0x09F8}, "SQ", "RETIRED_VitalStainCodeSequenceTrial", "1", true)
+	tagDict[Tag{0x0040, 0x1006}] = newInfo(Tag{0x0040, 0x1006}, "SH", "RETIRED_PlacerOrderNumberProcedure", "1", true)
+	tagDict[Tag{0x0040, 0x1007}] = newInfo(Tag{0x0040, 0x1007}, "SH", "RETIRED_FillerOrderNumberProcedure", "1", true)
+	tagDict[Tag{0x0040, 0x1060}] = newInfo(Tag{0x0040, 0x1060}, "LO", "RETIRED_RequestedProcedureDescriptionTrial", "1", true)
+	tagDict[Tag{0x0040, 0x2001}] = newInfo(Tag{0x0040, 0x2001}, "LO", "RETIRED_ReasonForTheImagingServiceRequest", "1", true)
+	tagDict[Tag{0x0040, 0x2006}] = newInfo(Tag{0x0040, 0x2006}, "SH", "RETIRED_PlacerOrderNumberImagingServiceRequestRetired", "1", true)
+	tagDict[Tag{0x0040, 0x2007}] = newInfo(Tag{0x0040, 0x2007}, "SH", "RETIRED_FillerOrderNumberImagingServiceRequestRetired", "1", true)
+	tagDict[Tag{0x0040, 0xA007}] = newInfo(Tag{0x0040, 0xA007}, "CS", "RETIRED_FindingsFlagTrial", "1", true)
+	tagDict[Tag{0x0040, 0xA020}] = newInfo(Tag{0x0040, 0xA020}, "SQ", "RETIRED_FindingsSequenceTrial", "1", true)
+	tagDict[Tag{0x0040, 0xA021}] = newInfo(Tag{0x0040, 0xA021}, "UI", "RETIRED_FindingsGroupUIDTrial", "1", true)
+	tagDict[Tag{0x0040, 0xA022}] = newInfo(Tag{0x0040, 0xA022}, "UI", "RETIRED_ReferencedFindingsGroupUIDTrial", "1", true)
+	tagDict[Tag{0x0040, 0xA023}] = newInfo(Tag{0x0040, 0xA023}, "DA", "RETIRED_FindingsGroupRecordingDateTrial", "1", true)
+	tagDict[Tag{0x0040, 0xA024}] = newInfo(Tag{0x0040, 0xA024}, "TM", "RETIRED_FindingsGroupRecordingTimeTrial", "1", true)
+	tagDict[Tag{0x0040, 0xA026}] = newInfo(Tag{0x0040, 0xA026}, "SQ", "RETIRED_FindingsSourceCategoryCodeSequenceTrial", "1", true)
+	tagDict[Tag{0x0040, 0xA028}] = newInfo(Tag{0x0040, 0xA028}, "SQ", "RETIRED_DocumentingOrganizationIdentifierCodeSequenceTrial", "1", true)
+	tagDict[Tag{0x0040, 0xA047}] = newInfo(Tag{0x0040, 0xA047}, "LO", "RETIRED_MeasurementPrecisionDescriptionTrial", "1", true)
+	tagDict[Tag{0x0040, 0xA057}] = newInfo(Tag{0x0040, 0xA057}, "CS", "RETIRED_UrgencyOrPriorityAlertsTrial", "1-n", true)
+	tagDict[Tag{0x0040, 0xA060}] = newInfo(Tag{0x0040, 0xA060}, "LO", "RETIRED_SequencingIndicatorTrial", "1", true)
+	tagDict[Tag{0x0040, 0xA066}] = newInfo(Tag{0x0040, 0xA066}, "SQ", "RETIRED_DocumentIdentifierCodeSequenceTrial", "1", true)
+	tagDict[Tag{0x0040, 0xA067}] = newInfo(Tag{0x0040, 0xA067}, "PN", "RETIRED_DocumentAuthorTrial", "1", true)
+	tagDict[Tag{0x0040, 0xA068}] = newInfo(Tag{0x0040, 0xA068}, "SQ", "RETIRED_DocumentAuthorIdentifierCodeSequenceTrial", "1", true)
+	tagDict[Tag{0x0040, 0xA070}] = newInfo(Tag{0x0040, 0xA070}, "SQ", "RETIRED_IdentifierCodeSequenceTrial", "1", true)
+	tagDict[Tag{0x0040, 0xA074}] = newInfo(Tag{0x0040, 0xA074}, "OB", "RETIRED_ObjectBinaryIdentifierTrial", "1", true)
+	tagDict[Tag{0x0040, 0xA076}] = newInfo(Tag{0x0040, 0xA076}, "SQ", "RETIRED_DocumentingObserverIdentifierCodeSequenceTrial", "1", true)
+	tagDict[Tag{0x0040, 0xA085}] = newInfo(Tag{0x0040, 0xA085}, "SQ", "RETIRED_ProcedureIdentifierCodeSequenceTrial", "1", true)
+	tagDict[Tag{0x0040, 0xA089}] = newInfo(Tag{0x0040, 0xA089}, "OB", "RETIRED_ObjectDirectoryBinaryIdentifierTrial", "1", true)
+	tagDict[Tag{0x0040, 0xA090}] = newInfo(Tag{0x0040, 0xA090}, "SQ", "RETIRED_EquivalentCDADocumentSequence", "1", true)
+	tagDict[Tag{0x0040, 0xA110}] = newInfo(Tag{0x0040, 0xA110}, "DA", "RETIRED_DateOfDocumentOrVerbalTransactionTrial", "1", true)
+	tagDict[Tag{0x0040, 0xA112}] = newInfo(Tag{0x0040, 0xA112}, "TM", "RETIRED_TimeOfDocumentCreationOrVerbalTransactionTrial", "1", true)
+	tagDict[Tag{0x0040, 0xA125}] = newInfo(Tag{0x0040, 0xA125}, "CS", "RETIRED_ReportStatusIDTrial", "2", true)
+	tagDict[Tag{0x0040, 0xA167}] = newInfo(Tag{0x0040, 0xA167}, "SQ", "RETIRED_ObservationCategoryCodeSequenceTrial", "1", true)
+	tagDict[Tag{0x0040, 0xA16A}] = newInfo(Tag{0x0040, 0xA16A}, "ST", "RETIRED_BibliographicCitationTrial", "1", true)
+	tagDict[Tag{0x0040, 0xA172}] = newInfo(Tag{0x0040, 0xA172}, "UI", "RETIRED_ReferencedObservationUIDTrial", "1", true)
+	tagDict[Tag{0x0040, 0xA173}] = newInfo(Tag{0x0040, 0xA173}, "CS", "RETIRED_ReferencedObservationClassTrial", "1", true)
+	tagDict[Tag{0x0040, 0xA174}] = newInfo(Tag{0x0040, 0xA174}, "CS", "RETIRED_ReferencedObjectObservationClassTrial", "1", true)
+	tagDict[Tag{0x0040, 0xA192}] = newInfo(Tag{0x0040, 0xA192}, "DA", "RETIRED_ObservationDateTrial", "1", true)
+	tagDict[Tag{0x0040, 0xA193}] = newInfo(Tag{0x0040, 0xA193}, "TM", "RETIRED_ObservationTimeTrial", "1", true)
+	tagDict[Tag{0x0040, 0xA194}] = newInfo(Tag{0x0040, 0xA194}, "CS", "RETIRED_MeasurementAutomationTrial", "1", true)
+	tagDict[Tag{0x0040, 0xA224}] = newInfo(Tag{0x0040, 0xA224}, "ST", "RETIRED_IdentificationDescriptionTrial", "1", true)
+	tagDict[Tag{0x0040, 0xA290}] = newInfo(Tag{0x0040, 0xA290}, "CS", "RETIRED_CoordinatesSetGeometricTypeTrial", "1", true)
+	tagDict[Tag{0x0040, 0xA296}] = newInfo(Tag{0x0040, 0xA296}, "SQ", "RETIRED_AlgorithmCodeSequenceTrial", "1", true)
+	tagDict[Tag{0x0040, 0xA297}] = newInfo(Tag{0x0040, 0xA297}, "ST", "RETIRED_AlgorithmDescriptionTrial", "1", true)
+	tagDict[Tag{0x0040, 0xA29A}] = newInfo(Tag{0x0040, 0xA29A}, "SL", "RETIRED_PixelCoordinatesSetTrial", "2-2n", true)
+	tagDict[Tag{0x0040, 0xA307}] = newInfo(Tag{0x0040, 0xA307}, "PN", "RETIRED_CurrentObserverTrial", "1", true)
+	tagDict[Tag{0x0040, 0xA313}] = newInfo(Tag{0x0040, 0xA313}, "SQ", "RETIRED_ReferencedAccessionSequenceTrial", "1", true)
+	tagDict[Tag{0x0040, 0xA33A}] = newInfo(Tag{0x0040, 0xA33A}, "ST", "RETIRED_ReportStatusCommentTrial", "1", true)
+	tagDict[Tag{0x0040, 0xA340}] = newInfo(Tag{0x0040, 0xA340}, "SQ", "RETIRED_ProcedureContextSequenceTrial", "1", true)
+	tagDict[Tag{0x0040, 0xA352}] = newInfo(Tag{0x0040, 0xA352}, "PN", "RETIRED_VerbalSourceTrial", "1", true)
+	tagDict[Tag{0x0040, 0xA353}] = newInfo(Tag{0x0040, 0xA353}, "ST", "RETIRED_AddressTrial", "1", true)
+	tagDict[Tag{0x0040, 0xA354}] = newInfo(Tag{0x0040, 0xA354}, "LO", "RETIRED_TelephoneNumberTrial", "1", true)
+	tagDict[Tag{0x0040, 0xA358}] = newInfo(Tag{0x0040, 0xA358}, "SQ", "RETIRED_VerbalSourceIdentifierCodeSequenceTrial", "1", true)
+	tagDict[Tag{0x0040, 0xA380}] = newInfo(Tag{0x0040, 0xA380}, "SQ", "RETIRED_ReportDetailSequenceTrial", "1", true)
+	tagDict[Tag{0x0040, 0xA402}] = newInfo(Tag{0x0040, 0xA402}, "UI", "RETIRED_ObservationSubjectUIDTrial", "1", true)
+	tagDict[Tag{0x0040, 0xA403}] = newInfo(Tag{0x0040, 0xA403}, "CS", "RETIRED_ObservationSubjectClassTrial", "1", true)
+	tagDict[Tag{0x0040, 0xA404}] = newInfo(Tag{0x0040, 0xA404}, "SQ", "RETIRED_ObservationSubjectTypeCodeSequenceTrial", "1", true)
+	tagDict[Tag{0x0040, 0xA600}] = newInfo(Tag{0x0040, 0xA600}, "CS", "RETIRED_ObservationSubjectContextFlagTrial", "1", true)
+	tagDict[Tag{0x0040, 0xA601}] = newInfo(Tag{0x0040, 0xA601}, "CS", "RETIRED_ObserverContextFlagTrial", "1", true)
+	tagDict[Tag{0x0040, 0xA603}] = newInfo(Tag{0x0040, 0xA603}, "CS", "RETIRED_ProcedureContextFlagTrial", "1", true)
+	tagDict[Tag{0x0040, 0xA731}] = newInfo(Tag{0x0040, 0xA731}, "SQ", "RETIRED_RelationshipSequenceTrial", "1", true)
+	tagDict[Tag{0x0040, 0xA732}] = newInfo(Tag{0x0040, 0xA732}, "SQ", "RETIRED_RelationshipTypeCodeSequenceTrial", "1", true)
+	tagDict[Tag{0x0040, 0xA744}] = newInfo(Tag{0x0040, 0xA744}, "SQ", "RETIRED_LanguageCodeSequenceTrial", "1", true)
+	tagDict[Tag{0x0040, 0xA992}] = newInfo(Tag{0x0040, 0xA992}, "ST", "RETIRED_UniformResourceLocatorTrial", "1", true)
+	tagDict[Tag{0x0040, 0xDB06}] = newInfo(Tag{0x0040, 0xDB06}, "DT", "RETIRED_TemplateVersion", "1", true)
+	tagDict[Tag{0x0040, 0xDB07}] = newInfo(Tag{0x0040, 0xDB07}, "DT", "RETIRED_TemplateLocalVersion", "1", true)
+	tagDict[Tag{0x0040, 0xDB0B}] = newInfo(Tag{0x0040, 0xDB0B}, "CS", "RETIRED_TemplateExtensionFlag", "1", true)
+	tagDict[Tag{0x0040, 0xDB0C}] = newInfo(Tag{0x0040, 0xDB0C}, "UI", "RETIRED_TemplateExtensionOrganizationUID", "1", true)
+	tagDict[Tag{0x0040, 0xDB0D}] = newInfo(Tag{0x0040, 0xDB0D}, "UI", "RETIRED_TemplateExtensionCreatorUID", "1", true)
+	tagDict[Tag{0x0054, 0x1400}] = newInfo(Tag{0x0054, 0x1400}, "CS", "RETIRED_CountsIncluded", "1-n", true)
+	tagDict[Tag{0x0054, 0x1401}] = newInfo(Tag{0x0054, 0x1401}, "CS", "RETIRED_DeadTimeCorrectionFlag", "1", true)
+	tagDict[Tag{0x0070, 0x0040}] = newInfo(Tag{0x0070, 0x0040}, "IS", "RETIRED_ImageRotationRetired", "1", true)
+	tagDict[Tag{0x0070, 0x0050}] = newInfo(Tag{0x0070, 0x0050}, "US", "RETIRED_DisplayedAreaTopLeftHandCornerTrial", "2", true)
+	tagDict[Tag{0x0070, 0x0051}] = newInfo(Tag{0x0070, 0x0051}, "US", "RETIRED_DisplayedAreaBottomRightHandCornerTrial", "2", true)
+	tagDict[Tag{0x0070, 0x0067}] = newInfo(Tag{0x0070, 0x0067}, "US", "RETIRED_GraphicLayerRecommendedDisplayRGBValue", "3", true)
+	tagDict[Tag{0x0074, 0x1024}] = newInfo(Tag{0x0074, 0x1024}, "IS", "RETIRED_BeamOrderIndexTrial", "1", true)
+	tagDict[Tag{0x0074, 0x1038}] = newInfo(Tag{0x0074, 0x1038}, "DS", "RETIRED_DoubleExposureMetersetTrial", "1", true)
+	tagDict[Tag{0x0074, 0x103A}] = newInfo(Tag{0x0074, 0x103A}, "DS", "RETIRED_DoubleExposureFieldDeltaTrial", "4", true)
+	tagDict[Tag{0x0074, 0x1220}] = newInfo(Tag{0x0074, 0x1220}, "SQ", "RETIRED_RelatedProcedureStepSequence", "1", true)
+	tagDict[Tag{0x0074, 0x1222}] = newInfo(Tag{0x0074, 0x1222}, "LO", "RETIRED_ProcedureStepRelationshipType", "1", true)
+	tagDict[Tag{0x0088, 0x0904}] = newInfo(Tag{0x0088, 0x0904}, "LO", "RETIRED_TopicTitle", "1", true)
+	tagDict[Tag{0x0088, 0x0906}] = newInfo(Tag{0x0088, 0x0906}, "ST", "RETIRED_TopicSubject", "1", true)
+	tagDict[Tag{0x0088, 0x0910}] = newInfo(Tag{0x0088, 0x0910}, "LO", "RETIRED_TopicAuthor", "1", true)
+	tagDict[Tag{0x0088, 0x0912}] = newInfo(Tag{0x0088, 0x0912}, "LO", "RETIRED_TopicKeywords", "1-32", true)
+	tagDict[Tag{0x2000, 0x0062}] = newInfo(Tag{0x2000, 0x0062}, "CS", "RETIRED_ColorImagePrintingFlag", "1", true)
+	tagDict[Tag{0x2000, 0x0063}] = newInfo(Tag{0x2000, 0x0063}, "CS", "RETIRED_CollationFlag", "1", true)
+	tagDict[Tag{0x2000, 0x0065}] = newInfo(Tag{0x2000, 0x0065}, "CS", "RETIRED_AnnotationFlag", "1", true)
+	tagDict[Tag{0x2000, 0x0067}] = newInfo(Tag{0x2000, 0x0067}, "CS", "RETIRED_ImageOverlayFlag", "1", true)
+	tagDict[Tag{0x2000, 0x0069}] = newInfo(Tag{0x2000, 0x0069}, "CS", "RETIRED_PresentationLUTFlag", "1", true)
+	tagDict[Tag{0x2000, 0x006A}] = newInfo(Tag{0x2000, 0x006A}, "CS", "RETIRED_ImageBoxPresentationLUTFlag", "1", true)
+	tagDict[Tag{0x2000, 0x0510}] = newInfo(Tag{0x2000, 0x0510}, "SQ", "RETIRED_ReferencedStoredPrintSequence", "1", true)
+	tagDict[Tag{0x2020, 0x0130}] = newInfo(Tag{0x2020, 0x0130}, "SQ", "RETIRED_ReferencedImageOverlayBoxSequence", "1", true)
+	tagDict[Tag{0x2020, 0x0140}] = newInfo(Tag{0x2020, 0x0140}, "SQ", "RETIRED_ReferencedVOILUTBoxSequence", "1", true)
+	tagDict[Tag{0x2040, 0x0010}] = newInfo(Tag{0x2040, 0x0010}, "SQ", "RETIRED_ReferencedOverlayPlaneSequence", "1", true)
+	tagDict[Tag{0x2040, 0x0011}] = newInfo(Tag{0x2040, 0x0011}, "US", "RETIRED_ReferencedOverlayPlaneGroups", "1-99", true)
+	tagDict[Tag{0x2040, 0x0020}] = newInfo(Tag{0x2040, 0x0020}, "SQ", "RETIRED_OverlayPixelDataSequence", "1", true)
+	tagDict[Tag{0x2040, 0x0060}] = newInfo(Tag{0x2040, 0x0060}, "CS", "RETIRED_OverlayMagnificationType", "1", true)
+	tagDict[Tag{0x2040, 0x0070}] = newInfo(Tag{0x2040, 0x0070}, "CS", "RETIRED_OverlaySmoothingType", "1", true)
+	tagDict[Tag{0x2040, 0x0072}] = newInfo(Tag{0x2040, 0x0072}, "CS", "RETIRED_OverlayOrImageMagnification", "1", true)
+	tagDict[Tag{0x2040, 0x0074}] = newInfo(Tag{0x2040, 0x0074}, "US", "RETIRED_MagnifyToNumberOfColumns", "1", true)
+	tagDict[Tag{0x2040, 0x0080}] = newInfo(Tag{0x2040, 0x0080}, "CS", "RETIRED_OverlayForegroundDensity", "1", true)
+	tagDict[Tag{0x2040, 0x0082}] = newInfo(Tag{0x2040, 0x0082}, "CS", "RETIRED_OverlayBackgroundDensity", "1", true)
+	tagDict[Tag{0x2040, 0x0090}] = newInfo(Tag{0x2040, 0x0090}, "CS", "RETIRED_OverlayMode", "1", true)
+	tagDict[Tag{0x2040, 0x0100}] = newInfo(Tag{0x2040, 0x0100}, "CS", "RETIRED_ThresholdDensity", "1", true)
+	tagDict[Tag{0x2040, 0x0500}] = newInfo(Tag{0x2040, 0x0500}, "SQ", "RETIRED_ReferencedImageBoxSequenceRetired", "1", true)
+	tagDict[Tag{0x2100, 0x0010}] = newInfo(Tag{0x2100, 0x0010}, "SH", "RETIRED_PrintJobID", "1", true)
+	tagDict[Tag{0x2100, 0x0140}] = newInfo(Tag{0x2100, 0x0140}, "AE", "RETIRED_DestinationAE", "1", true)
+	tagDict[Tag{0x2100, 0x0500}] = newInfo(Tag{0x2100, 0x0500}, "SQ", "RETIRED_ReferencedPrintJobSequencePullStoredPrint", "1", true)
+	tagDict[Tag{0x2110, 0x0099}] = newInfo(Tag{0x2110, 0x0099}, "SH", "RETIRED_PrintQueueID", "1", true)
+	tagDict[Tag{0x2120, 0x0010}] = newInfo(Tag{0x2120, 0x0010}, "CS", "RETIRED_QueueStatus", "1", true)
+	tagDict[Tag{0x2120, 0x0050}] = newInfo(Tag{0x2120, 0x0050}, "SQ", "RETIRED_PrintJobDescriptionSequence", "1", true)
+	tagDict[Tag{0x2120, 0x0070}] = newInfo(Tag{0x2120, 0x0070}, "SQ", "RETIRED_ReferencedPrintJobSequence", "1", true)
+	tagDict[Tag{0x2130, 0x0010}] = newInfo(Tag{0x2130, 0x0010}, "SQ", "RETIRED_PrintManagementCapabilitiesSequence", "1", true)
+	tagDict[Tag{0x2130, 0x0015}] = newInfo(Tag{0x2130, 0x0015}, "SQ", "RETIRED_PrinterCharacteristicsSequence", "1", true)
+	tagDict[Tag{0x2130, 0x0030}] = newInfo(Tag{0x2130, 0x0030}, "SQ", "RETIRED_FilmBoxContentSequence", "1", true)
+	tagDict[Tag{0x2130, 0x0040}] = newInfo(Tag{0x2130, 0x0040}, "SQ", "RETIRED_ImageBoxContentSequence", "1", true)
+	tagDict[Tag{0x2130, 0x0050}] = newInfo(Tag{0x2130, 0x0050}, "SQ", "RETIRED_AnnotationContentSequence", "1", true)
+	tagDict[Tag{0x2130, 0x0060}] = newInfo(Tag{0x2130, 0x0060}, "SQ", "RETIRED_ImageOverlayBoxContentSequence", "1", true)
+	tagDict[Tag{0x2130, 0x0080}] = newInfo(Tag{0x2130, 0x0080}, "SQ", "RETIRED_PresentationLUTContentSequence", "1", true)
+	tagDict[Tag{0x2130, 0x00A0}] = newInfo(Tag{0x2130, 0x00A0}, "SQ", "RETIRED_ProposedStudySequence", "1", true)
+	tagDict[Tag{0x2130, 0x00C0}] = newInfo(Tag{0x2130, 0x00C0}, "SQ", "RETIRED_OriginalImageSequence", "1", true)
+	tagDict[Tag{0x4000, 0x0010}] = newInfo(Tag{0x4000, 0x0010}, "LT", "RETIRED_Arbitrary", "1", true)
+	tagDict[Tag{0x4000, 0x4000}] = newInfo(Tag{0x4000, 0x4000}, "LT", "RETIRED_TextComments", "1", true)
+	tagDict[Tag{0x4008, 0x0040}] = newInfo(Tag{0x4008, 0x0040}, "SH", "RETIRED_ResultsID", "1", true)
+	tagDict[Tag{0x4008, 0x0042}] = newInfo(Tag{0x4008, 0x0042}, "LO", "RETIRED_ResultsIDIssuer", "1", true)
+	tagDict[Tag{0x4008, 0x0050}] = newInfo(Tag{0x4008, 0x0050}, "SQ", "RETIRED_ReferencedInterpretationSequence", "1", true)
+	tagDict[Tag{0x4008, 0x00FF}] = newInfo(Tag{0x4008, 0x00FF}, "CS", "RETIRED_ReportProductionStatusTrial", "1", true)
+	tagDict[Tag{0x4008, 0x0100}] = newInfo(Tag{0x4008, 0x0100}, "DA", "RETIRED_InterpretationRecordedDate", "1", true)
+	tagDict[Tag{0x4008, 0x0101}] = newInfo(Tag{0x4008, 0x0101}, "TM", "RETIRED_InterpretationRecordedTime", "1", true)
+	tagDict[Tag{0x4008, 0x0102}] = newInfo(Tag{0x4008, 0x0102}, "PN", "RETIRED_InterpretationRecorder", "1", true)
+	tagDict[Tag{0x4008, 0x0103}] = newInfo(Tag{0x4008, 0x0103}, "LO", "RETIRED_ReferenceToRecordedSound", "1", true)
+	tagDict[Tag{0x4008, 0x0108}] = newInfo(Tag{0x4008, 0x0108}, "DA", "RETIRED_InterpretationTranscriptionDate", "1", true)
+	tagDict[Tag{0x4008, 0x0109}] = newInfo(Tag{0x4008, 0x0109}, "TM", "RETIRED_InterpretationTranscriptionTime", "1", true)
+	tagDict[Tag{0x4008, 0x010A}] = newInfo(Tag{0x4008, 0x010A}, "PN", "RETIRED_InterpretationTranscriber", "1", true)
+	tagDict[Tag{0x4008, 0x010B}] = newInfo(Tag{0x4008, 0x010B}, "ST", "RETIRED_InterpretationText", "1", true)
+	tagDict[Tag{0x4008, 0x010C}] = newInfo(Tag{0x4008, 0x010C}, "PN", "RETIRED_InterpretationAuthor", "1", true)
+	tagDict[Tag{0x4008, 0x0111}] = newInfo(Tag{0x4008, 0x0111}, "SQ", "RETIRED_InterpretationApproverSequence", "1", true)
+	tagDict[Tag{0x4008, 0x0112}] = newInfo(Tag{0x4008, 0x0112}, "DA", "RETIRED_InterpretationApprovalDate", "1", true)
+	tagDict[Tag{0x4008, 0x0113}] = newInfo(Tag{0x4008, 0x0113}, "TM", "RETIRED_InterpretationApprovalTime", "1", true)
+	tagDict[Tag{0x4008, 0x0114}] = newInfo(Tag{0x4008, 0x0114}, "PN", "RETIRED_PhysicianApprovingInterpretation", "1", true)
+	tagDict[Tag{0x4008, 0x0115}] = newInfo(Tag{0x4008, 0x0115}, "LT", "RETIRED_InterpretationDiagnosisDescription", "1", true)
+	tagDict[Tag{0x4008, 0x0117}] = newInfo(Tag{0x4008, 0x0117}, "SQ", "RETIRED_InterpretationDiagnosisCodeSequence", "1", true)
+	tagDict[Tag{0x4008, 0x0118}] = newInfo(Tag{0x4008, 0x0118}, "SQ", "RETIRED_ResultsDistributionListSequence", "1", true)
+	tagDict[Tag{0x4008, 0x0119}] = newInfo(Tag{0x4008, 0x0119}, "PN", "RETIRED_DistributionName", "1", true)
+	tagDict[Tag{0x4008, 0x011A}] = newInfo(Tag{0x4008, 0x011A}, "LO", "RETIRED_DistributionAddress", "1", true)
+	tagDict[Tag{0x4008, 0x0200}] = newInfo(Tag{0x4008, 0x0200}, "SH", "RETIRED_InterpretationID", "1", true)
+	tagDict[Tag{0x4008, 0x0202}] = newInfo(Tag{0x4008, 0x0202}, "LO", "RETIRED_InterpretationIDIssuer", "1", true)
+	tagDict[Tag{0x4008, 0x0210}] = newInfo(Tag{0x4008, 0x0210}, "CS", "RETIRED_InterpretationTypeID", "1", true)
+	tagDict[Tag{0x4008, 0x0212}] = newInfo(Tag{0x4008, 0x0212}, "CS", "RETIRED_InterpretationStatusID", "1", true)
+	tagDict[Tag{0x4008, 0x0300}] = newInfo(Tag{0x4008, 0x0300}, "ST", "RETIRED_Impressions", "1", true)
+	tagDict[Tag{0x4008, 0x4000}] = newInfo(Tag{0x4008, 0x4000}, "ST", "RETIRED_ResultsComments", "1", true)
+	addRepeating(Tag{0x5000, 0x0005}, Tag{0xFF01, 0xFFFF}, newInfo(Tag{0x5000, 0x0005}, "US", "RETIRED_CurveDimensions", "1", true))
+	addRepeating(Tag{0x5000, 0x0010}, Tag{0xFF01, 0xFFFF}, newInfo(Tag{0x5000, 0x0010}, "US", "RETIRED_NumberOfPoints", "1", true))
+	addRepeating(Tag{0x5000, 0x0020}, Tag{0xFF01, 0xFFFF}, newInfo(Tag{0x5000, 0x0020}, "CS", "RETIRED_TypeOfData", "1", true))
+	addRepeating(Tag{0x5000, 0x0022}, Tag{0xFF01, 0xFFFF}, newInfo(Tag{0x5000, 0x0022}, "LO", "RETIRED_CurveDescription", "1", true))
+	addRepeating(Tag{0x5000, 0x0030}, Tag{0xFF01, 0xFFFF}, newInfo(Tag{0x5000, 0x0030}, "SH", "RETIRED_AxisUnits", "1-n", true))
+	addRepeating(Tag{0x5000, 0x0040}, Tag{0xFF01, 0xFFFF}, newInfo(Tag{0x5000, 0x0040}, "SH", "RETIRED_AxisLabels", "1-n", true))
+	addRepeating(Tag{0x5000, 0x0103}, Tag{0xFF01, 0xFFFF}, newInfo(Tag{0x5000, 0x0103}, "US", "RETIRED_DataValueRepresentation", "1", true))
+	addRepeating(Tag{0x5000, 0x0104}, Tag{0xFF01, 0xFFFF}, newInfo(Tag{0x5000, 0x0104}, "US", "RETIRED_MinimumCoordinateValue", "1-n", true))
+	addRepeating(Tag{0x5000, 0x0105}, Tag{0xFF01, 0xFFFF}, newInfo(Tag{0x5000, 0x0105}, "US", "RETIRED_MaximumCoordinateValue", "1-n", true))
+	addRepeating(Tag{0x5000, 0x0106}, Tag{0xFF01, 0xFFFF}, newInfo(Tag{0x5000, 0x0106}, "SH", "RETIRED_CurveRange", "1-n", true))
+	addRepeating(Tag{0x5000, 0x0110}, Tag{0xFF01, 0xFFFF}, newInfo(Tag{0x5000, 0x0110}, "US", "RETIRED_CurveDataDescriptor", "1-n", true))
+	addRepeating(Tag{0x5000, 0x0112}, Tag{0xFF01, 0xFFFF}, newInfo(Tag{0x5000, 0x0112}, "US", "RETIRED_CoordinateStartValue", "1-n", true))
+	addRepeating(Tag{0x5000, 0x0114}, Tag{0xFF01, 0xFFFF}, newInfo(Tag{0x5000, 0x0114}, "US", "RETIRED_CoordinateStepValue", "1-n", true))
+	addRepeating(Tag{0x5000, 0x1001}, Tag{0xFF01, 0xFFFF}, newInfo(Tag{0x5000, 0x1001}, "CS", "RETIRED_CurveActivationLayer", "1", true))
+	addRepeating(Tag{0x5000, 0x2000}, Tag{0xFF01, 0xFFFF}, newInfo(Tag{0x5000, 0x2000}, "US", "RETIRED_AudioType", "1", true))
+	addRepeating(Tag{0x5000, 0x2002}, Tag{0xFF01, 0xFFFF}, newInfo(Tag{0x5000, 0x2002}, "US", "RETIRED_AudioSampleFormat", "1", true))
+	addRepeating(Tag{0x5000, 0x2004}, Tag{0xFF01, 0xFFFF}, newInfo(Tag{0x5000, 0x2004}, "US", "RETIRED_NumberOfChannels", "1", true))
+	addRepeating(Tag{0x5000, 0x2006}, Tag{0xFF01, 0xFFFF}, newInfo(Tag{0x5000, 0x2006}, "UL", "RETIRED_NumberOfSamples", "1", true))
+	addRepeating(Tag{0x5000, 0x2008}, Tag{0xFF01, 0xFFFF}, newInfo(Tag{0x5000, 0x2008}, "UL", "RETIRED_SampleRate", "1", true))
+	addRepeating(Tag{0x5000, 0x200A}, Tag{0xFF01, 0xFFFF}, newInfo(Tag{0x5000, 0x200A}, "UL", "RETIRED_TotalTime", "1", true))
+	addRepeating(Tag{0x5000, 0x200C}, Tag{0xFF01, 0xFFFF}, newInfo(Tag{0x5000, 0x200C}, "OW or OB", "RETIRED_AudioSampleData", "1", true))
+	addRepeating(Tag{0x5000, 0x200E}, Tag{0xFF01, 0xFFFF}, newInfo(Tag{0x5000, 0x200E}, "LT", "RETIRED_AudioComments", "1", true))
+	addRepeating(Tag{0x5000, 0x2500}, Tag{0xFF01, 0xFFFF}, newInfo(Tag{0x5000, 0x2500}, "LO", "RETIRED_CurveLabel", "1", true))
+	addRepeating(Tag{0x5000, 0x2600}, Tag{0xFF01, 0xFFFF}, newInfo(Tag{0x5000, 0x2600}, "SQ", "RETIRED_CurveReferencedOverlaySequence", "1", true))
+	addRepeating(Tag{0x5000, 0x2610}, Tag{0xFF01, 0xFFFF}, newInfo(Tag{0x5000, 0x2610}, "US", "RETIRED_CurveReferencedOverlayGroup", "1", true))
+	addRepeating(Tag{0x5000, 0x3000}, Tag{0xFF01, 0xFFFF}, newInfo(Tag{0x5000, 0x3000}, "OW or OB", "RETIRED_CurveData", "1", true))
+	addRepeating(Tag{0x6000, 0x0012}, Tag{0xFF01, 0xFFFF}, newInfo(Tag{0x6000, 0x0012}, "US", "RETIRED_OverlayPlanes", "1", true))
+	addRepeating(Tag{0x6000, 0x0052}, Tag{0xFF01, 0xFFFF}, newInfo(Tag{0x6000, 0x0052}, "US", "RETIRED_OverlayPlaneOrigin", "1", true))
+	addRepeating(Tag{0x6000, 0x0060}, Tag{0xFF01, 0xFFFF}, newInfo(Tag{0x6000, 0x0060}, "CS", "RETIRED_OverlayCompressionCode", "1", true))
+	addRepeating(Tag{0x6000, 0x0061}, Tag{0xFF01, 0xFFFF}, newInfo(Tag{0x6000, 0x0061}, "SH", "RETIRED_OverlayCompressionOriginator", "1", true))
+	addRepeating(Tag{0x6000, 0x0062}, Tag{0xFF01, 0xFFFF}, newInfo(Tag{0x6000, 0x0062}, "SH", "RETIRED_OverlayCompressionLabel", "1", true))
+	addRepeating(Tag{0x6000, 0x0063}, Tag{0xFF01, 0xFFFF}, newInfo(Tag{0x6000, 0x0063}, "CS", "RETIRED_OverlayCompressionDescription", "1", true))
+	addRepeating(Tag{0x6000, 0x0066}, Tag{0xFF01, 0xFFFF}, newInfo(Tag{0x6000, 0x0066}, "AT", "RETIRED_OverlayCompressionStepPointers", "1-n", true))
+	addRepeating(Tag{0x6000, 0x0068}, Tag{0xFF01, 0xFFFF}, newInfo(Tag{0x6000, 0x0068}, "US", "RETIRED_OverlayRepeatInterval", "1", true))
+	addRepeating(Tag{0x6000, 0x0069}, Tag{0xFF01, 0xFFFF}, newInfo(Tag{0x6000, 0x0069}, "US", "RETIRED_OverlayBitsGrouped", "1", true))
+	addRepeating(Tag{0x6000, 0x0110}, Tag{0xFF01, 0xFFFF}, newInfo(Tag{0x6000, 0x0110}, "CS", "RETIRED_OverlayFormat", "1", true))
+	addRepeating(Tag{0x6000, 0x0200}, Tag{0xFF01, 0xFFFF}, newInfo(Tag{0x6000, 0x0200}, "US", "RETIRED_OverlayLocation", "1", true))
+	addRepeating(Tag{0x6000, 0x0800}, Tag{0xFF01, 0xFFFF}, newInfo(Tag{0x6000, 0x0800}, "CS", "RETIRED_OverlayCodeLabel", "1-n", true))
+	addRepeating(Tag{0x6000, 0x0802}, Tag{0xFF01, 0xFFFF}, newInfo(Tag{0x6000, 0x0802}, "US", "RETIRED_OverlayNumberOfTables", "1", true))
+	addRepeating(Tag{0x6000, 0x0803}, Tag{0xFF01, 0xFFFF}, newInfo(Tag{0x6000, 0x0803}, "AT", "RETIRED_OverlayCodeTableLocation", "1-n", true))
+	addRepeating(Tag{0x6000, 0x0804}, Tag{0xFF01, 0xFFFF}, newInfo(Tag{0x6000, 0x0804}, "US", "RETIRED_OverlayBitsForCodeWord", "1", true))
+	addRepeating(Tag{0x6000, 0x1100}, Tag{0xFF01, 0xFFFF}, newInfo(Tag{0x6000, 0x1100}, "US", "RETIRED_OverlayDescriptorGray", "1", true))
+	addRepeating(Tag{0x6000, 0x1101}, Tag{0xFF01, 0xFFFF}, newInfo(Tag{0x6000, 0x1101}, "US", "RETIRED_OverlayDescriptorRed", "1", true))
+	addRepeating(Tag{0x6000, 0x1102}, Tag{0xFF01, 0xFFFF}, newInfo(Tag{0x6000, 0x1102}, "US", "RETIRED_OverlayDescriptorGreen", "1", true))
+	addRepeating(Tag{0x6000, 0x1103}, Tag{0xFF01, 0xFFFF}, newInfo(Tag{0x6000, 0x1103}, "US", "RETIRED_OverlayDescriptorBlue", "1", true))
+	addRepeating(Tag{0x6000, 0x1200}, Tag{0xFF01, 0xFFFF}, newInfo(Tag{0x6000, 0x1200}, "US", "RETIRED_OverlaysGray", "1-n", true))
+	addRepeating(Tag{0x6000, 0x1201}, Tag{0xFF01, 0xFFFF}, newInfo(Tag{0x6000, 0x1201}, "US", "RETIRED_OverlaysRed", "1-n", true))
+	addRepeating(Tag{0x6000, 0x1202}, Tag{0xFF01, 0xFFFF}, newInfo(Tag{0x6000, 0x1202}, "US", "RETIRED_OverlaysGreen", "1-n", true))
+	addRepeating(Tag{0x6000, 0x1203}, Tag{0xFF01, 0xFFFF}, newInfo(Tag{0x6000, 0x1203}, "US", "RETIRED_OverlaysBlue", "1-n", true))
+	addRepeating(Tag{0x6000, 0x4000}, Tag{0xFF01, 0xFFFF}, newInfo(Tag{0x6000, 0x4000}, "LT", "RETIRED_OverlayComments", "1", true))
+	tagDict[Tag{0x7FE0, 0x0020}] = newInfo(Tag{0x7FE0, 0x0020}, "OW", "RETIRED_CoefficientsSDVN", "1", true)
+	tagDict[Tag{0x7FE0, 0x0030}] = newInfo(Tag{0x7FE0, 0x0030}, "OW", "RETIRED_CoefficientsSDHN", "1", true)
+	tagDict[Tag{0x7FE0, 0x0040}] = newInfo(Tag{0x7FE0, 0x0040}, "OW", "RETIRED_CoefficientsSDDN", "1", true)
+	addRepeating(Tag{0x7F00, 0x0010}, Tag{0xFF01, 0xFFFF}, newInfo(Tag{0x7F00, 0x0010}, "OW or OB", "RETIRED_VariablePixelData", "1", true))
+	addRepeating(Tag{0x7F00, 0x0011}, Tag{0xFF01, 0xFFFF}, newInfo(Tag{0x7F00, 0x0011}, "US", "RETIRED_VariableNextDataGroup", "1", true))
+	addRepeating(Tag{0x7F00, 0x0020}, Tag{0xFF01, 0xFFFF}, newInfo(Tag{0x7F00, 0x0020}, "OW", "RETIRED_VariableCoefficientsSDVN", "1", true))
+	addRepeating(Tag{0x7F00, 0x0030}, Tag{0xFF01, 0xFFFF}, newInfo(Tag{0x7F00, 0x0030}, "OW", "RETIRED_VariableCoefficientsSDHN", "1", true))
+	addRepeating(Tag{0x7F00, 0x0040}, Tag{0xFF01, 0xFFFF}, newInfo(Tag{0x7F00, 0x0040}, "OW", "RETIRED_VariableCoefficientsSDDN", "1", true))
+	addRepeating(Tag{0x0028, 0x0400}, Tag{0xFFFF, 0xFF0F}, newInfo(Tag{0x0028, 0x0400}, "US", "RETIRED_RowsForNthOrderCoefficients", "1", true))
+	addRepeating(Tag{0x0028, 0x0401}, Tag{0xFFFF, 0xFF0F}, newInfo(Tag{0x0028, 0x0401}, "US", "RETIRED_ColumnsForNthOrderCoefficients", "1", true))
+	addRepeating(Tag{0x0028, 0x0402}, Tag{0xFFFF, 0xFF0F}, newInfo(Tag{0x0028, 0x0402}, "LO", "RETIRED_CoefficientCoding", "1-n", true))
+	addRepeating(Tag{0x0028, 0x0403}, Tag{0xFFFF, 0xFF0F}, newInfo(Tag{0x0028, 0x0403}, "AT", "RETIRED_CoefficientCodingPointers", "1-n", true))
+	addRepeating(Tag{0x0028, 0x0800}, Tag{0xFFFF, 0xFF0F}, newInfo(Tag{0x0028, 0x0800}, "LO", "RETIRED_CodeLabel", "1-n", true))
+	addRepeating(Tag{0x0028, 0x0802}, Tag{0xFFFF, 0xFF0F}, newInfo(Tag{0x0028, 0x0802}, "US", "RETIRED_NumberOfTables", "1", true))
+	addRepeating(Tag{0x0028, 0x0803}, Tag{0xFFFF, 0xFF0F}, newInfo(Tag{0x0028, 0x0803}, "AT", "RETIRED_CodeTableLocation", "1-n", true))
+	addRepeating(Tag{0x0028, 0x0804}, Tag{0xFFFF, 0xFF0F}, newInfo(Tag{0x0028, 0x0804}, "US", "RETIRED_BitsForCodeWord", "1", true))
+	addRepeating(Tag{0x0028, 0x0808}, Tag{0xFFFF, 0xFF0F}, newInfo(Tag{0x0028, 0x0808}, "AT", "RETIRED_ImageDataLocation", "1-n", true))
+	addRepeating(Tag{0x1000, 0x0000}, Tag{0xFFFF, 0x000F}, newInfo(Tag{0x1000, 0x0000}, "US", "RETIRED_EscapeTriplet", "3", true))
+	addRepeating(Tag{0x1000, 0x0001}, Tag{0xFFFF, 0x000F}, newInfo(Tag{0x1000, 0x0001}, "US", "RETIRED_RunLengthTriplet", "3", true))
+	addRepeating(Tag{0x1000, 0x0002}, Tag{0xFFFF, 0x000F}, newInfo(Tag{0x1000, 0x0002}, "US", "RETIRED_HuffmanTableSize", "1", true))
+	addRepeating(Tag{0x1000, 0x0003}, Tag{0xFFFF, 0x000F}, newInfo(Tag{0x1000, 0x0003}, "US", "RETIRED_HuffmanTableTriplet", "3", true))
+	addRepeating(Tag{0x1000, 0x0004}, Tag{0xFFFF, 0x000F}, newInfo(Tag{0x1000, 0x0004}, "US", "RETIRED_ShiftTableSize", "1", true))
+	addRepeating(Tag{0x1000, 0x0005}, Tag{0xFFFF, 0x000F}, newInfo(Tag{0x1000, 0x0005}, "US", "RETIRED_ShiftTableTriplet", "3", true))
+	addRepeating(Tag{0x1010, 0x0000}, Tag{0xFFFF, 0x0000}, newInfo(Tag{0x1010, 0x0000}, "US", "RETIRED_ZonalMap", "1-n", true))
 }
@@ -28,6 +28,14 @@ func TestFind(t *testing.T) {
 	if (elem.Tag != Tag{2, 0x10}) {
 		t.Errorf("Wrong element: %v", elem)
 	}
+
+	// Retired tags are found by keyword as well as by name.
+	for _, name := range []string{"RETIRED_GeneralPurposeScheduledProcedureStepStatus", "GeneralPurposeScheduledProcedureStepStatus"} {
+		elem, err = FindByName(name)
+		if err != nil || elem.Tag != GeneralPurposeScheduledProcedureStepStatus {
+			t.Errorf("FindByName(%q) got: %v, %v, want: %v", name, elem.Tag, err, GeneralPurposeScheduledProcedureStepStatus)
+		}
+	}
 }
 
 // TODO: add a test for correctly splitting ranges
@@ -0,0 +1,105 @@
+package uid
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"math/big"
+)
+
+// ErrorRootTooLong indicates that an organization root leaves too little room
+// within the 64 characters of a UID for a unique suffix.
+var ErrorRootTooLong = errors.New("UID root too long")
+
+const (
+	// uuidRoot is the root of UIDs derived from a UUID, see PS3.5 B.2.
+	uuidRoot = "2.25"
+	// minSuffixDigits is the minimum number of digits of the suffixes
+	// appended to a root by NewWithRoot and FromHash, which keeps the chance
+	// of collisions negligible (about 53 bits).
+	minSuffixDigits = 16
+	// maxSuffixDigits is the number of digits of the suffixes appended to a
+	// root when there is room for them (about 126 bits).
+	maxSuffixDigits = 38
+)
+
+// New returns a new, globally unique UID derived from a random (version 4)
+// UUID, in the 2.25 root reserved for this purpose (PS3.5 B.2), e.g.
+// "2.25.329800735698586629295641978511506172918".
+func New() (string, error) {
+	var u [16]byte
+	if _, err := rand.Read(u[:]); err != nil {
+		return "", err
+	}
+	u[6] = u[6]&0x0F | 0x40 // version 4
+	u[8] = u[8]&0x3F | 0x80 // RFC 4122 variant
+	return uuidRoot + "." + new(big.Int).SetBytes(u[:]).String(), nil
+}
+
+// NewWithRoot returns a new UID made of orgRoot, the UID root registered by
+// your organization, and a random suffix. The suffix is as long as fits within
+// the 64 characters of a UID, up to 38 digits, which makes collisions
+// negligible. ErrorRootTooLong is returned if orgRoot leaves room for less
+// than 16 digits.
+//
+//	Example: uid, err := uid.NewWithRoot("1.2.826.0.1.3680043.9.7133")
+func NewWithRoot(orgRoot string) (string, error) {
+	n, err := suffixDigits(orgRoot)
+	if err != nil {
+		return "", err
+	}
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return joinSuffix(orgRoot, b, n), nil
+}
+
+// FromHash returns a UID made of root and a suffix derived from a hash of
+// inputs. The same root and inputs always give the same UID, which makes it
+// suitable for reproducible remapping of UIDs, e.g. when de-identifying the
+// same study several times:
+//
+//	newUID, err := uid.FromHash(orgRoot, projectSalt, originalUID)
+//
+// Like NewWithRoot, ErrorRootTooLong is returned if root leaves room for less
+// than 16 digits.
+func FromHash(root string, inputs ...string) (string, error) {
+	n, err := suffixDigits(root)
+	if err != nil {
+		return "", err
+	}
+	h := sha256.New()
+	h.Write([]byte(root))
+	for _, in := range inputs {
+		// Prefix each input with its length so that e.g. ("ab", "c") and
+		// ("a", "bc") give different UIDs.
+		fmt.Fprintf(h, "\x00%d:%s", len(in), in)
+	}
+	return joinSuffix(root, h.Sum(nil), n), nil
+}
+
+// suffixDigits validates root and returns the number of digits of the suffix
+// to append to it.
+func suffixDigits(root string) (int, error) {
+	if err := Validate(root); err != nil {
+		return 0, err
+	}
+	n := maxUIDLength - len(root) - 1
+	if n < minSuffixDigits {
+		return 0, fmt.Errorf("%w: %q leaves room for %d digits, need at least %d", ErrorRootTooLong, root, n, minSuffixDigits)
+	}
+	if n > maxSuffixDigits {
+		n = maxSuffixDigits
+	}
+	return n, nil
+}
+
+// joinSuffix appends to root the first n digits of the decimal representation
+// of b, which must have enough bits for n digits. The top bit of b is set so
+// that the suffix never starts with a zero.
+func joinSuffix(root string, b []byte, n int) string {
+	b[0] |= 0x80
+	return root + "." + new(big.Int).SetBytes(b).String()[:n]
+}
@@ -0,0 +1,90 @@
+package uid
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+)
+
+func TestNew(t *testing.T) {
+	seen := make(map[string]bool)
+	for i := 0; i < 100; i++ {
+		u, err := New()
+		if err != nil {
+			t.Fatalf("New() unexpected error: %v", err)
+		}
+		if err := Validate(u); err != nil {
+			t.Errorf("New() returned invalid UID: %v", err)
+		}
+		if !strings.HasPrefix(u, "2.25.") {
+			t.Errorf("New() got: %s, want a 2.25 UID", u)
+		}
+		n, ok := new(big.Int).SetString(strings.TrimPrefix(u, "2.25."), 10)
+		if !ok || n.BitLen() > 128 {
+			t.Errorf("New() got: %s, want a 128 bit UUID suffix", u)
+		}
+		if seen[u] {
+			t.Errorf("New() returned %s twice", u)
+		}
+		seen[u] = true
+	}
+}
+
+func TestNewWithRoot(t *testing.T) {
+	cases := []struct {
+		root       string
+		wantLength int
+		wantErr    error
+	}{
+		{root: "1.2.3", wantLength: len("1.2.3.") + maxSuffixDigits},
+		{root: "1.2.826.0.1.3680043.9.7133" + strings.Repeat(".1", 10), wantLength: maxUIDLength},
+		{root: "1.2.826.0.1.3680043.9.7133" + strings.Repeat(".1", 11), wantErr: ErrorRootTooLong},
+		{root: "1.02.3", wantErr: ErrorInvalidUID},
+		{root: "", wantErr: ErrorInvalidUID},
+	}
+	for _, tc := range cases {
+		t.Run(tc.root, func(t *testing.T) {
+			u, err := NewWithRoot(tc.root)
+			if !errors.Is(err, tc.wantErr) {
+				t.Fatalf("NewWithRoot(%q) unexpected error. got: %v, want: %v", tc.root, err, tc.wantErr)
+			}
+			if tc.wantErr != nil {
+				return
+			}
+			if err := Validate(u); err != nil {
+				t.Errorf("NewWithRoot(%q) returned invalid UID: %v", tc.root, err)
+			}
+			if !strings.HasPrefix(u, tc.root+".") || len(u) != tc.wantLength {
+				t.Errorf("NewWithRoot(%q) got: %s, want %d characters under the root", tc.root, u, tc.wantLength)
+			}
+			if other, _ := NewWithRoot(tc.root); other == u {
+				t.Errorf("NewWithRoot(%q) returned %s twice", tc.root, u)
+			}
+		})
+	}
+}
+
+func TestFromHash(t *testing.T) {
+	a, err := FromHash("1.2.3", "salt", "1.2.840.1")
+	if err != nil {
+		t.Fatalf("FromHash() unexpected error: %v", err)
+	}
+	if err := Validate(a); err != nil {
+		t.Errorf("FromHash() returned invalid UID: %v", err)
+	}
+	if again, _ := FromHash("1.2.3", "salt", "1.2.840.1"); again != a {
+		t.Errorf("FromHash() is not deterministic. got: %s and %s", a, again)
+	}
+	for _, inputs := range [][]string{{"salt", "1.2.840.2"}, {"salt1", ".2.840.1"}, {"salt", "1.2.840.1", ""}} {
+		if b, _ := FromHash("1.2.3", inputs...); b == a {
+			t.Errorf("FromHash(%q) got the same UID as for other inputs: %s", inputs, b)
+		}
+	}
+	if b, _ := FromHash("1.2.4", "salt", "1.2.840.1"); strings.TrimPrefix(b, "1.2.4.") == strings.TrimPrefix(a, "1.2.3.") {
+		t.Errorf("FromHash() suffix does not depend on the root: %s", b)
+	}
+	if _, err := FromHash("1.2.3.a", "x"); !errors.Is(err, ErrorInvalidUID) {
+		t.Errorf("FromHash(invalid root) unexpected error. got: %v, want: %v", err, ErrorInvalidUID)
+	}
+}
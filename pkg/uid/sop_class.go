@@ -0,0 +1,193 @@
+package uid
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrorNotSOPClass indicates that a UID is not a registered SOP Class.
+var ErrorNotSOPClass = errors.New("UID is not a SOP Class")
+
+// storageSOPClassRoot is the root of the Storage SOP Classes of the Storage
+// Service Class (PS3.4 B.5).
+const storageSOPClassRoot = "1.2.840.10008.5.1.4.1.1."
+
+// SOPClass holds metadata about a SOP Class.
+type SOPClass struct {
+	UID     string
+	Name    string // e.g. "CT Image Storage".
+	Keyword string // The PS3.6 keyword, if known.
+	// Storage indicates if this is a Storage SOP Class, whose instances are
+	// exchanged with the Storage Service Class and stored in DICOM files.
+	Storage bool
+	// Modality is the Modality (0008,0060) typical of instances of this Storage
+	// SOP Class, e.g. "CT", or "" if there is no typical modality.
+	Modality string
+	// IOD is the name of the Information Object Definition (PS3.3) of instances
+	// of this Storage SOP Class, e.g. "CT Image".
+	IOD string
+	// Image indicates if instances of this Storage SOP Class are images, i.e.
+	// have Pixel Data, as opposed to e.g. structured reports or waveforms.
+	Image   bool
+	Retired bool
+}
+
+// storageMetadata is the metadata of Storage SOP Classes that can not be
+// derived from their name.
+type storageMetadata struct {
+	modality string
+	image    bool
+}
+
+// storageSOPClasses holds the typical modality of common Storage SOP Classes,
+// and whether they are images. Storage SOP Classes missing here are images if
+// the name of their IOD ends with "Image", and have no typical modality.
+var storageSOPClasses = map[string]storageMetadata{
+	"1.2.840.10008.1.3.10":             {"", false},
+	"1.2.840.10008.5.1.4.1.1.1":        {"CR", true},
+	"1.2.840.10008.5.1.4.1.1.1.1":      {"DX", true},
+	"1.2.840.10008.5.1.4.1.1.1.1.1":    {"DX", true},
+	"1.2.840.10008.5.1.4.1.1.1.2":      {"MG", true},
+	"1.2.840.10008.5.1.4.1.1.1.2.1":    {"MG", true},
+	"1.2.840.10008.5.1.4.1.1.1.3":      {"IO", true},
+	"1.2.840.10008.5.1.4.1.1.1.3.1":    {"IO", true},
+	"1.2.840.10008.5.1.4.1.1.2":        {"CT", true},
+	"1.2.840.10008.5.1.4.1.1.2.1":      {"CT", true},
+	"1.2.840.10008.5.1.4.1.1.2.2":      {"CT", true},
+	"1.2.840.10008.5.1.4.1.1.3.1":      {"US", true},
+	"1.2.840.10008.5.1.4.1.1.4":        {"MR", true},
+	"1.2.840.10008.5.1.4.1.1.4.1":      {"MR", true},
+	"1.2.840.10008.5.1.4.1.1.4.2":      {"MR", false},
+	"1.2.840.10008.5.1.4.1.1.4.3":      {"MR", true},
+	"1.2.840.10008.5.1.4.1.1.4.4":      {"MR", true},
+	"1.2.840.10008.5.1.4.1.1.6.1":      {"US", true},
+	"1.2.840.10008.5.1.4.1.1.6.2":      {"US", true},
+	"1.2.840.10008.5.1.4.1.1.7":        {"OT", true},
+	"1.2.840.10008.5.1.4.1.1.7.1":      {"OT", true},
+	"1.2.840.10008.5.1.4.1.1.7.2":      {"OT", true},
+	"1.2.840.10008.5.1.4.1.1.7.3":      {"OT", true},
+	"1.2.840.10008.5.1.4.1.1.7.4":      {"OT", true},
+	"1.2.840.10008.5.1.4.1.1.9.1.1":    {"ECG", false},
+	"1.2.840.10008.5.1.4.1.1.9.1.2":    {"ECG", false},
+	"1.2.840.10008.5.1.4.1.1.9.1.3":    {"ECG", false},
+	"1.2.840.10008.5.1.4.1.1.9.2.1":    {"HD", false},
+	"1.2.840.10008.5.1.4.1.1.9.3.1":    {"EPS", false},
+	"1.2.840.10008.5.1.4.1.1.9.4.1":    {"AU", false},
+	"1.2.840.10008.5.1.4.1.1.9.4.2":    {"AU", false},
+	"1.2.840.10008.5.1.4.1.1.9.5.1":    {"HD", false},
+	"1.2.840.10008.5.1.4.1.1.9.6.1":    {"RESP", false},
+	"1.2.840.10008.5.1.4.1.1.11.1":     {"PR", false},
+	"1.2.840.10008.5.1.4.1.1.11.2":     {"PR", false},
+	"1.2.840.10008.5.1.4.1.1.11.3":     {"PR", false},
+	"1.2.840.10008.5.1.4.1.1.11.4":     {"PR", false},
+	"1.2.840.10008.5.1.4.1.1.11.5":     {"PR", false},
+	"1.2.840.10008.5.1.4.1.1.12.1":     {"XA", true},
+	"1.2.840.10008.5.1.4.1.1.12.1.1":   {"XA", true},
+	"1.2.840.10008.5.1.4.1.1.12.2":     {"RF", true},
+	"1.2.840.10008.5.1.4.1.1.12.2.1":   {"RF", true},
+	"1.2.840.10008.5.1.4.1.1.13.1.1":   {"XA", true},
+	"1.2.840.10008.5.1.4.1.1.13.1.2":   {"DX", true},
+	"1.2.840.10008.5.1.4.1.1.13.1.3":   {"MG", true},
+	"1.2.840.10008.5.1.4.1.1.13.1.4":   {"MG", true},
+	"1.2.840.10008.5.1.4.1.1.13.1.5":   {"MG", true},
+	"1.2.840.10008.5.1.4.1.1.14.1":     {"IVOCT", true},
+	"1.2.840.10008.5.1.4.1.1.14.2":     {"IVOCT", true},
+	"1.2.840.10008.5.1.4.1.1.20":       {"NM", true},
+	"1.2.840.10008.5.1.4.1.1.30":       {"", true},
+	"1.2.840.10008.5.1.4.1.1.66":       {"", false},
+	"1.2.840.10008.5.1.4.1.1.66.1":     {"REG", false},
+	"1.2.840.10008.5.1.4.1.1.66.2":     {"FID", false},
+	"1.2.840.10008.5.1.4.1.1.66.3":     {"REG", false},
+	"1.2.840.10008.5.1.4.1.1.66.4":     {"SEG", true},
+	"1.2.840.10008.5.1.4.1.1.66.5":     {"SEG", false},
+	"1.2.840.10008.5.1.4.1.1.77.1.1":   {"ES", true},
+	"1.2.840.10008.5.1.4.1.1.77.1.1.1": {"ES", true},
+	"1.2.840.10008.5.1.4.1.1.77.1.2":   {"GM", true},
+	"1.2.840.10008.5.1.4.1.1.77.1.2.1": {"GM", true},
+	"1.2.840.10008.5.1.4.1.1.77.1.3":   {"SM", true},
+	"1.2.840.10008.5.1.4.1.1.77.1.4":   {"XC", true},
+	"1.2.840.10008.5.1.4.1.1.77.1.4.1": {"XC", true},
+	"1.2.840.10008.5.1.4.1.1.77.1.5.1": {"OP", true},
+	"1.2.840.10008.5.1.4.1.1.77.1.5.2": {"OP", true},
+	"1.2.840.10008.5.1.4.1.1.77.1.5.3": {"OP", false},
+	"1.2.840.10008.5.1.4.1.1.77.1.5.4": {"OPT", true},
+	"1.2.840.10008.5.1.4.1.1.77.1.5.5": {"OP", true},
+	"1.2.840.10008.5.1.4.1.1.77.1.5.6": {"OP", true},
+	"1.2.840.10008.5.1.4.1.1.77.1.5.7": {"OP", true},
+	"1.2.840.10008.5.1.4.1.1.77.1.5.8": {"OPT", false},
+	"1.2.840.10008.5.1.4.1.1.77.1.6":   {"SM", true},
+	"1.2.840.10008.5.1.4.1.1.88.11":    {"SR", false},
+	"1.2.840.10008.5.1.4.1.1.88.22":    {"SR", false},
+	"1.2.840.10008.5.1.4.1.1.88.33":    {"SR", false},
+	"1.2.840.10008.5.1.4.1.1.88.34":    {"SR", false},
+	"1.2.840.10008.5.1.4.1.1.88.35":    {"SR", false},
+	"1.2.840.10008.5.1.4.1.1.88.40":    {"SR", false},
+	"1.2.840.10008.5.1.4.1.1.88.50":    {"SR", false},
+	"1.2.840.10008.5.1.4.1.1.88.59":    {"KO", false},
+	"1.2.840.10008.5.1.4.1.1.88.65":    {"SR", false},
+	"1.2.840.10008.5.1.4.1.1.88.67":    {"SR", false},
+	"1.2.840.10008.5.1.4.1.1.88.68":    {"SR", false},
+	"1.2.840.10008.5.1.4.1.1.88.69":    {"SR", false},
+	"1.2.840.10008.5.1.4.1.1.104.1":    {"DOC", false},
+	"1.2.840.10008.5.1.4.1.1.104.2":    {"DOC", false},
+	"1.2.840.10008.5.1.4.1.1.128":      {"PT", true},
+	"1.2.840.10008.5.1.4.1.1.128.1":    {"PT", true},
+	"1.2.840.10008.5.1.4.1.1.130":      {"PT", true},
+	"1.2.840.10008.5.1.4.1.1.481.1":    {"RTIMAGE", true},
+	"1.2.840.10008.5.1.4.1.1.481.2":    {"RTDOSE", true},
+	"1.2.840.10008.5.1.4.1.1.481.3":    {"RTSTRUCT", false},
+	"1.2.840.10008.5.1.4.1.1.481.4":    {"RTRECORD", false},
+	"1.2.840.10008.5.1.4.1.1.481.5":    {"RTPLAN", false},
+	"1.2.840.10008.5.1.4.1.1.481.6":    {"RTRECORD", false},
+	"1.2.840.10008.5.1.4.1.1.481.7":    {"RTRECORD", false},
+	"1.2.840.10008.5.1.4.1.1.481.8":    {"RTPLAN", false},
+	"1.2.840.10008.5.1.4.1.1.481.9":    {"RTRECORD", false},
+}
+
+// LookupSOPClass returns metadata about the SOP Class uid. An error wrapping
+// ErrorNotSOPClass is returned if uid is not a SOP Class registered in PS3.6.
+func LookupSOPClass(uid string) (SOPClass, error) {
+	info, ok := uidMap[uid]
+	if !ok || info.Type != TypeSOPClass {
+		return SOPClass{}, fmt.Errorf("%w: %s", ErrorNotSOPClass, uid)
+	}
+	c := SOPClass{
+		UID:     uid,
+		Name:    info.Name,
+		Keyword: info.Keyword,
+		Retired: info.Status == "Retired",
+	}
+	meta, known := storageSOPClasses[uid]
+	if !known && !strings.HasPrefix(uid, storageSOPClassRoot) {
+		return c, nil
+	}
+	c.Storage = true
+	c.IOD = iodName(info.Name)
+	if known {
+		c.Modality = meta.modality
+		c.Image = meta.image
+	} else {
+		c.Image = strings.HasSuffix(c.IOD, "Image")
+	}
+	return c, nil
+}
+
+// IsStorageSOPClass indicates if uid is a Storage SOP Class, see
+// SOPClass.Storage.
+func IsStorageSOPClass(uid string) bool {
+	c, err := LookupSOPClass(uid)
+	return err == nil && c.Storage
+}
+
+// iodName derives the name of the IOD of a Storage SOP Class from the name of
+// the SOP Class, e.g. "Digital X-Ray Image" from "Digital X-Ray Image Storage
+// - For Presentation".
+func iodName(sopClassName string) string {
+	name := sopClassName
+	if i := strings.Index(name, " - "); i >= 0 {
+		name = name[:i]
+	}
+	name = strings.TrimSuffix(strings.TrimSuffix(name, " SOP Class"), " Storage")
+	return name
+}
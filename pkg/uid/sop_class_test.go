@@ -0,0 +1,69 @@
+package uid
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestLookupSOPClass(t *testing.T) {
+	cases := []struct {
+		uid  string
+		want SOPClass
+	}{
+		{
+			uid:  "1.2.840.10008.5.1.4.1.1.2",
+			want: SOPClass{UID: "1.2.840.10008.5.1.4.1.1.2", Name: "CT Image Storage", Storage: true, Modality: "CT", IOD: "CT Image", Image: true},
+		},
+		{
+			uid:  "1.2.840.10008.5.1.4.1.1.1.1",
+			want: SOPClass{UID: "1.2.840.10008.5.1.4.1.1.1.1", Name: "Digital X-Ray Image Storage - For Presentation", Storage: true, Modality: "DX", IOD: "Digital X-Ray Image", Image: true},
+		},
+		{
+			uid:  "1.2.840.10008.5.1.4.1.1.88.33",
+			want: SOPClass{UID: "1.2.840.10008.5.1.4.1.1.88.33", Name: "Comprehensive SR Storage", Storage: true, Modality: "SR", IOD: "Comprehensive SR"},
+		},
+		{
+			// Not in storageSOPClasses.
+			uid:  "1.2.840.10008.5.1.4.1.1.131",
+			want: SOPClass{UID: "1.2.840.10008.5.1.4.1.1.131", Name: "Basic Structured Display Storage", Storage: true, IOD: "Basic Structured Display"},
+		},
+		{
+			uid:  "1.2.840.10008.5.1.4.1.1.5",
+			want: SOPClass{UID: "1.2.840.10008.5.1.4.1.1.5", Name: "Nuclear Medicine Image Storage", Storage: true, IOD: "Nuclear Medicine Image", Image: true, Retired: true},
+		},
+		{
+			uid:  "1.2.840.10008.1.1",
+			want: SOPClass{UID: "1.2.840.10008.1.1", Name: "Verification SOP Class"},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.uid, func(t *testing.T) {
+			got, err := LookupSOPClass(tc.uid)
+			if err != nil {
+				t.Fatalf("LookupSOPClass(%s) unexpected error: %v", tc.uid, err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("LookupSOPClass(%s) unexpected result. diff: %v", tc.uid, diff)
+			}
+			if IsStorageSOPClass(tc.uid) != tc.want.Storage {
+				t.Errorf("IsStorageSOPClass(%s) got: %v, want: %v", tc.uid, !tc.want.Storage, tc.want.Storage)
+			}
+		})
+	}
+
+	for _, u := range []string{ExplicitVRLittleEndian, "1.2.3.4"} {
+		if _, err := LookupSOPClass(u); !errors.Is(err, ErrorNotSOPClass) {
+			t.Errorf("LookupSOPClass(%s) unexpected error. got: %v, want: %v", u, err, ErrorNotSOPClass)
+		}
+	}
+}
+
+func TestStorageSOPClasses(t *testing.T) {
+	for u := range storageSOPClasses {
+		if info, ok := uidMap[u]; !ok || info.Type != TypeSOPClass {
+			t.Errorf("storageSOPClasses entry %s is not a SOP Class of the dictionary", u)
+		}
+	}
+}
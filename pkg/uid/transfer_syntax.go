@@ -0,0 +1,174 @@
+package uid
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+)
+
+// ErrorUnknownTransferSyntax indicates that a UID is not a transfer syntax
+// whose encoding is known.
+var ErrorUnknownTransferSyntax = errors.New("unknown transfer syntax")
+
+// Codec is the compression scheme of the pixel data of a transfer syntax.
+type Codec string
+
+// Codecs of the transfer syntaxes defined in PS3.5. Uncompressed transfer
+// syntaxes have no Codec ("").
+const (
+	CodecJPEG         Codec = "JPEG"          // ISO/IEC 10918-1 DCT processes.
+	CodecJPEGLossless Codec = "JPEG Lossless" // ISO/IEC 10918-1 lossless processes.
+	CodecJPEGLS       Codec = "JPEG-LS"
+	CodecJPEG2000     Codec = "JPEG 2000"
+	CodecRLE          Codec = "RLE"
+	CodecMPEG2        Codec = "MPEG-2"
+	CodecH264         Codec = "H.264"
+	CodecHEVC         Codec = "HEVC"
+)
+
+// TransferSyntax holds the properties of a transfer syntax, i.e. how it
+// encodes data sets and their pixel data (PS3.5 Section 10 and Annex A).
+type TransferSyntax struct {
+	UID  string
+	Name string // e.g. "JPEG Baseline (Process 1)".
+	// ByteOrder and Implicit are the encoding of the data set.
+	ByteOrder binary.ByteOrder
+	Implicit  bool
+	// Deflated indicates if the data set is compressed with the deflate
+	// algorithm (RFC 1951), after the File Meta Information.
+	Deflated bool
+	// Encapsulated indicates if the PixelData of the data set is
+	// encapsulated, i.e. a sequence of fragments with an undefined length
+	// (PS3.5 A.4), as opposed to native pixel data.
+	Encapsulated bool
+	// Lossy indicates if the Codec may compress pixel data irreversibly.
+	Lossy bool
+	// Retired indicates if the transfer syntax is retired from the standard.
+	Retired bool
+	// Codec is the compression scheme of pixel data, or "" if it is not
+	// compressed.
+	Codec Codec
+	// Fragmentable indicates if an encapsulated frame may span several
+	// fragments. RLE Lossless requires exactly one fragment per frame.
+	Fragmentable bool
+	// SingleStream indicates if all the frames of an encapsulated multi-frame
+	// image form a single compressed stream, as with video codecs, rather
+	// than one compressed stream per frame.
+	SingleStream bool
+	// Referenced indicates if pixel data is not in the data set but
+	// referenced by the Pixel Data Provider URL (0028,7FE0), as with JPIP.
+	Referenced bool
+}
+
+// canonical returns the uncompressed transfer syntax that encodes data sets
+// like ts.
+func (ts TransferSyntax) canonical() string {
+	switch {
+	case ts.Implicit:
+		return ImplicitVRLittleEndian
+	case ts.ByteOrder == binary.BigEndian:
+		return ExplicitVRBigEndian
+	case ts.Deflated:
+		return DeflatedExplicitVRLittleEndian
+	default:
+		return ExplicitVRLittleEndian
+	}
+}
+
+var (
+	explicitLittle = TransferSyntax{ByteOrder: binary.LittleEndian}
+	implicitLittle = TransferSyntax{ByteOrder: binary.LittleEndian, Implicit: true}
+	explicitBig    = TransferSyntax{ByteOrder: binary.BigEndian}
+	deflatedLittle = TransferSyntax{ByteOrder: binary.LittleEndian, Deflated: true}
+)
+
+// compressed returns the properties of an explicit VR little endian transfer
+// syntax that encapsulates pixel data compressed with codec.
+func compressed(codec Codec, lossy bool) TransferSyntax {
+	return TransferSyntax{
+		ByteOrder:    binary.LittleEndian,
+		Encapsulated: true,
+		Lossy:        lossy,
+		Codec:        codec,
+		Fragmentable: codec != CodecRLE,
+		SingleStream: codec == CodecMPEG2 || codec == CodecH264 || codec == CodecHEVC,
+	}
+}
+
+// jpip returns the properties of a JPIP Referenced transfer syntax with the
+// data set encoding ts.
+func jpip(ts TransferSyntax) TransferSyntax {
+	ts.Codec = CodecJPEG2000
+	ts.Referenced = true
+	return ts
+}
+
+// transferSyntaxes holds the properties of every transfer syntax defined in
+// PS3.5 that encodes data sets, except for those taken from the UID
+// dictionary (UID, Name and Retired). Transfer syntaxes that do not encode data
+// sets, such as RFC 2557 MIME encapsulation, are not included.
+var transferSyntaxes = map[string]TransferSyntax{
+	"1.2.840.10008.1.2":       implicitLittle,
+	"1.2.840.10008.1.2.1":     explicitLittle,
+	"1.2.840.10008.1.2.1.99":  deflatedLittle,
+	"1.2.840.10008.1.2.2":     explicitBig,
+	"1.2.840.10008.1.2.4.50":  compressed(CodecJPEG, true),
+	"1.2.840.10008.1.2.4.51":  compressed(CodecJPEG, true),
+	"1.2.840.10008.1.2.4.52":  compressed(CodecJPEG, true),
+	"1.2.840.10008.1.2.4.53":  compressed(CodecJPEG, true),
+	"1.2.840.10008.1.2.4.54":  compressed(CodecJPEG, true),
+	"1.2.840.10008.1.2.4.55":  compressed(CodecJPEG, true),
+	"1.2.840.10008.1.2.4.56":  compressed(CodecJPEG, true),
+	"1.2.840.10008.1.2.4.57":  compressed(CodecJPEGLossless, false),
+	"1.2.840.10008.1.2.4.58":  compressed(CodecJPEGLossless, false),
+	"1.2.840.10008.1.2.4.59":  compressed(CodecJPEG, true),
+	"1.2.840.10008.1.2.4.60":  compressed(CodecJPEG, true),
+	"1.2.840.10008.1.2.4.61":  compressed(CodecJPEG, true),
+	"1.2.840.10008.1.2.4.62":  compressed(CodecJPEG, true),
+	"1.2.840.10008.1.2.4.63":  compressed(CodecJPEG, true),
+	"1.2.840.10008.1.2.4.64":  compressed(CodecJPEG, true),
+	"1.2.840.10008.1.2.4.65":  compressed(CodecJPEGLossless, false),
+	"1.2.840.10008.1.2.4.66":  compressed(CodecJPEGLossless, false),
+	"1.2.840.10008.1.2.4.70":  compressed(CodecJPEGLossless, false),
+	"1.2.840.10008.1.2.4.80":  compressed(CodecJPEGLS, false),
+	"1.2.840.10008.1.2.4.81":  compressed(CodecJPEGLS, true),
+	"1.2.840.10008.1.2.4.90":  compressed(CodecJPEG2000, false),
+	"1.2.840.10008.1.2.4.91":  compressed(CodecJPEG2000, true),
+	"1.2.840.10008.1.2.4.92":  compressed(CodecJPEG2000, false),
+	"1.2.840.10008.1.2.4.93":  compressed(CodecJPEG2000, true),
+	"1.2.840.10008.1.2.4.94":  jpip(explicitLittle),
+	"1.2.840.10008.1.2.4.95":  jpip(deflatedLittle),
+	"1.2.840.10008.1.2.4.100": compressed(CodecMPEG2, true),
+	"1.2.840.10008.1.2.4.101": compressed(CodecMPEG2, true),
+	"1.2.840.10008.1.2.4.102": compressed(CodecH264, true),
+	"1.2.840.10008.1.2.4.103": compressed(CodecH264, true),
+	"1.2.840.10008.1.2.4.104": compressed(CodecH264, true),
+	"1.2.840.10008.1.2.4.105": compressed(CodecH264, true),
+	"1.2.840.10008.1.2.4.106": compressed(CodecH264, true),
+	"1.2.840.10008.1.2.4.107": compressed(CodecHEVC, true),
+	"1.2.840.10008.1.2.4.108": compressed(CodecHEVC, true),
+	"1.2.840.10008.1.2.5":     compressed(CodecRLE, false),
+	// Papyrus 3 files use the encoding of Implicit VR Little Endian.
+	"1.2.840.10008.1.20": implicitLittle,
+}
+
+// LookupTransferSyntax returns the properties of the transfer syntax uid.
+//
+// An error wrapping ErrorUnknownTransferSyntax is returned if uid is not a
+// transfer syntax defined in the DICOM standard, or if it does not encode data
+// sets (e.g. XML Encoding). Private transfer syntaxes are not assumed to be
+// explicit little endian, as their encoding can not be known.
+func LookupTransferSyntax(uid string) (TransferSyntax, error) {
+	ts, ok := transferSyntaxes[uid]
+	if !ok {
+		if e, err := Lookup(uid); err == nil && e.Type != TypeTransferSyntax {
+			return TransferSyntax{}, fmt.Errorf("%w: '%s' is not a transfer syntax (is %s)", ErrorUnknownTransferSyntax, uid, e.Type)
+		}
+		return TransferSyntax{}, fmt.Errorf("%w: %s", ErrorUnknownTransferSyntax, UIDString(uid))
+	}
+	info := uidMap[uid]
+	ts.UID = uid
+	ts.Name = info.Name
+	ts.Retired = info.Status == "Retired"
+	return ts, nil
+}
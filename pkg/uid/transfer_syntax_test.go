@@ -0,0 +1,98 @@
+package uid
+
+import (
+	"encoding/binary"
+	"errors"
+	"testing"
+)
+
+func TestCanonicalTransferSyntaxUID(t *testing.T) {
+	cases := []struct {
+		uid     string
+		want    string
+		wantErr error
+	}{
+		{uid: ImplicitVRLittleEndian, want: ImplicitVRLittleEndian},
+		{uid: ExplicitVRBigEndian, want: ExplicitVRBigEndian},
+		{uid: DeflatedExplicitVRLittleEndian, want: DeflatedExplicitVRLittleEndian},
+		{uid: "1.2.840.10008.1.2.4.50", want: ExplicitVRLittleEndian},
+		{uid: "1.2.840.10008.1.2.4.95", want: DeflatedExplicitVRLittleEndian},
+		{uid: "1.2.840.10008.1.20", want: ImplicitVRLittleEndian},
+		{uid: "1.2.840.10008.1.2.6.2", wantErr: ErrorUnknownTransferSyntax},
+		{uid: VerificationSOPClass, wantErr: ErrorUnknownTransferSyntax},
+		{uid: "1.2.3.4.5", wantErr: ErrorUnknownTransferSyntax},
+	}
+	for _, tc := range cases {
+		t.Run(tc.uid, func(t *testing.T) {
+			got, err := CanonicalTransferSyntaxUID(tc.uid)
+			if !errors.Is(err, tc.wantErr) {
+				t.Fatalf("CanonicalTransferSyntaxUID(%s) unexpected error. got: %v, want: %v", tc.uid, err, tc.wantErr)
+			}
+			if got != tc.want {
+				t.Errorf("CanonicalTransferSyntaxUID(%s) got: %s, want: %s", tc.uid, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestLookupTransferSyntax(t *testing.T) {
+	cases := []struct {
+		uid     string
+		want    TransferSyntax
+		wantErr error
+	}{
+		{
+			uid:  ImplicitVRLittleEndian,
+			want: TransferSyntax{UID: ImplicitVRLittleEndian, Name: "Implicit VR Little Endian", ByteOrder: binary.LittleEndian, Implicit: true},
+		},
+		{
+			uid:  ExplicitVRBigEndian,
+			want: TransferSyntax{UID: ExplicitVRBigEndian, Name: "Explicit VR Big Endian", ByteOrder: binary.BigEndian, Retired: true},
+		},
+		{
+			uid: "1.2.840.10008.1.2.4.50",
+			want: TransferSyntax{UID: "1.2.840.10008.1.2.4.50", Name: "JPEG Baseline (Process 1)", ByteOrder: binary.LittleEndian,
+				Encapsulated: true, Lossy: true, Codec: CodecJPEG, Fragmentable: true},
+		},
+		{
+			uid: "1.2.840.10008.1.2.5",
+			want: TransferSyntax{UID: "1.2.840.10008.1.2.5", Name: "RLE Lossless", ByteOrder: binary.LittleEndian,
+				Encapsulated: true, Codec: CodecRLE},
+		},
+		{
+			uid: "1.2.840.10008.1.2.4.102",
+			want: TransferSyntax{UID: "1.2.840.10008.1.2.4.102", Name: "MPEG-4 AVC/H.264 High Profile / Level 4.1", ByteOrder: binary.LittleEndian,
+				Encapsulated: true, Lossy: true, Codec: CodecH264, Fragmentable: true, SingleStream: true},
+		},
+		{
+			uid: "1.2.840.10008.1.2.4.95",
+			want: TransferSyntax{UID: "1.2.840.10008.1.2.4.95", Name: "JPIP Referenced Deflate", ByteOrder: binary.LittleEndian,
+				Deflated: true, Codec: CodecJPEG2000, Referenced: true},
+		},
+		{uid: "1.2.840.10008.1.2.6.1", wantErr: ErrorUnknownTransferSyntax},
+		{uid: VerificationSOPClass, wantErr: ErrorUnknownTransferSyntax},
+		{uid: "1.2.3.4.5", wantErr: ErrorUnknownTransferSyntax},
+	}
+	for _, tc := range cases {
+		t.Run(tc.uid, func(t *testing.T) {
+			got, err := LookupTransferSyntax(tc.uid)
+			if !errors.Is(err, tc.wantErr) {
+				t.Fatalf("LookupTransferSyntax(%s) unexpected error. got: %v, want: %v", tc.uid, err, tc.wantErr)
+			}
+			if got != tc.want {
+				t.Errorf("LookupTransferSyntax(%s) got: %+v, want: %+v", tc.uid, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestTransferSyntaxes(t *testing.T) {
+	for u, ts := range transferSyntaxes {
+		if info, ok := uidMap[u]; !ok || info.Type != TypeTransferSyntax {
+			t.Errorf("transferSyntaxes entry %s is not a transfer syntax of the dictionary", u)
+		}
+		if ts.Encapsulated != (ts.Codec != "" && !ts.Referenced) {
+			t.Errorf("transferSyntaxes entry %s: Encapsulated is %v for codec %q", u, ts.Encapsulated, ts.Codec)
+		}
+	}
+}
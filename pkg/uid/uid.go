@@ -5,6 +5,57 @@ import (
 	"fmt"
 )
 
+type Type string
+
+const (
+	TypeSOPClass                  Type = "SOP Class"
+	TypeTransferSyntax            Type = "Transfer Syntax"
+	TypeWellKnownFrameOfReference Type = "Well-known frame of reference"
+	TypeWellKnownSOPInstance      Type = "Well-known SOP instance"
+	TypeCodingScheme              Type = "Coding Scheme"
+)
+
+// Commonly used UID constants.
+var (
+	PatientRootQRFind = standardUID("1.2.840.10008.5.1.4.1.2.1.1")
+	StudyRootQRFind   = standardUID("1.2.840.10008.5.1.4.1.2.2.1")
+	PatientRootQRGet  = standardUID("1.2.840.10008.5.1.4.1.2.1.3")
+	StudyRootQRGet    = standardUID("1.2.840.10008.5.1.4.1.2.2.3")
+	PatientRootQRMove = standardUID("1.2.840.10008.5.1.4.1.2.1.2")
+	StudyRootQRMove   = standardUID("1.2.840.10008.5.1.4.1.2.2.2")
+
+	ModalityWorklistInformationFind = standardUID("1.2.840.10008.5.1.4.31")
+	VerificationSOPClass            = standardUID("1.2.840.10008.1.1")
+
+	ComputedRadiographyImageStorage = standardUID("1.2.840.10008.5.1.4.1.1.1")
+	CTImageStorage                  = standardUID("1.2.840.10008.5.1.4.1.1.2")
+	EnhancedCTImageStorage          = standardUID("1.2.840.10008.5.1.4.1.1.2.1")
+	MRImageStorage                  = standardUID("1.2.840.10008.5.1.4.1.1.4")
+	UltrasoundImageStorage          = standardUID("1.2.840.10008.5.1.4.1.1.6.1")
+	SecondaryCaptureImageStorage    = standardUID("1.2.840.10008.5.1.4.1.1.7")
+	NuclearMedicineImageStorage     = standardUID("1.2.840.10008.5.1.4.1.1.20")
+	SegmentationStorage             = standardUID("1.2.840.10008.5.1.4.1.1.66.4")
+	BasicTextSRStorage              = standardUID("1.2.840.10008.5.1.4.1.1.88.11")
+	EnhancedSRStorage               = standardUID("1.2.840.10008.5.1.4.1.1.88.22")
+	ComprehensiveSRStorage          = standardUID("1.2.840.10008.5.1.4.1.1.88.33")
+
+	// https://www.dicomlibrary.com/dicom/transfer-syntax/
+	ImplicitVRLittleEndian         = standardUID("1.2.840.10008.1.2")
+	ExplicitVRLittleEndian         = standardUID("1.2.840.10008.1.2.1")
+	ExplicitVRBigEndian            = standardUID("1.2.840.10008.1.2.2")
+	DeflatedExplicitVRLittleEndian = standardUID("1.2.840.10008.1.2.1.99")
+)
+
+// Info holds detailed information about a DICOM UID
+type Info struct {
+	UID     string // "1.2.840.10008.x.y.z"
+	Name    string // The UID string, e.g.,"1.2.840.10008.1.2.1".
+	Keyword string // The PS3.6 keyword, e.g., "ExplicitVRLittleEndian".
+	Type    Type   // "SOP Class", "Transfer Syntax", etc.
+	Part    string // The part of the standard defining the UID, e.g., "PS3.5".
+	Status  string // "" if active. "Retired", if retired.
+}
+
 // Standard list of transfer syntaxes.
 var StandardTransferSyntaxes = []string{
 	ImplicitVRLittleEndian,
@@ -15,54 +66,63 @@ var StandardTransferSyntaxes = []string{
 
 // CanonicalTransferSyntaxUID return the canonical transfer syntax UID (e.g.,
 // dicomuid.ExplicitVRLittleEndian or dicomuid.ImplicitVRLittleEndian), given an
-// UID that represents any transfer syntax.  Returns an error if the uid is not
-// defined in DICOM standard, or if the uid does not represent a transfer
-// syntax.
+// UID that represents any transfer syntax, i.e. the uncompressed transfer
+// syntax that encodes the data set like uid does. For example, it is
+// ExplicitVRLittleEndian for all the transfer syntaxes that encapsulate
+// compressed pixel data (PS3.5 A.4).
 //
-// TODO(saito) Check the standard to see if we need to accept unknown UIDS as
-// explicit little endian.
+// Like LookupTransferSyntax, an error wrapping ErrorUnknownTransferSyntax is
+// returned if uid is not a known transfer syntax that encodes data sets.
 func CanonicalTransferSyntaxUID(uid string) (string, error) {
-	// defaults are explicit VR, little endian
-	switch uid {
-	case ImplicitVRLittleEndian,
-		ExplicitVRLittleEndian,
-		ExplicitVRBigEndian,
-		DeflatedExplicitVRLittleEndian:
-		return uid, nil
-	default:
-		e, err := Lookup(uid)
-		if err != nil {
-			return "", err
-		}
-		if e.Type != TypeTransferSyntax {
-			return "", fmt.Errorf("dicom.CanonicalTransferSyntaxUID: '%s' is not a transfer syntax (is %s)", uid, e.Type)
-		}
-		// The default is ExplicitVRLittleEndian
-		return ExplicitVRLittleEndian, nil
+	ts, err := LookupTransferSyntax(uid)
+	if err != nil {
+		return "", err
 	}
+	return ts.canonical(), nil
 }
 
 // ParseTransferSyntaxUID parses a transfer syntax uid and returns its byteorder
 // and implicitVR/explicitVR type.  TrasnferSyntaxUID can be any UID that refers to
 // a transfer syntax. It can be, e.g., 1.2.840.10008.1.2 (it will return
 // LittleEndian, ImplicitVR) or 1.2.840.10008.1.2.4.54 (it will return
-// (LittleEndian, ExplicitVR).
+// (LittleEndian, ExplicitVR). See LookupTransferSyntax for the other
+// properties of a transfer syntax.
 func ParseTransferSyntaxUID(uid string) (bo binary.ByteOrder, implicit bool, err error) {
-	canonical, err := CanonicalTransferSyntaxUID(uid)
+	ts, err := LookupTransferSyntax(uid)
 	if err != nil {
 		return nil, false, err
 	}
-	switch canonical {
-	case ImplicitVRLittleEndian:
-		return binary.LittleEndian, true, nil
-	case DeflatedExplicitVRLittleEndian:
-		fallthrough
-	case ExplicitVRLittleEndian:
-		return binary.LittleEndian, false, nil
-	case ExplicitVRBigEndian:
-		return binary.BigEndian, false, nil
-	default:
-		return binary.BigEndian, false, fmt.Errorf("invalid or unknown transfer syntax: %v,  %v",
-			canonical, uid)
+	return ts.ByteOrder, ts.Implicit, nil
+}
+
+func standardUID(uid string) string {
+	return MustLookup(uid).UID
+}
+
+// Lookup finds information about the given uid (string starting with 1.2.840).  Returns
+// an error unless uid is the one defined in the DICOM standard, P3.6.
+func Lookup(uid string) (Info, error) {
+	e, ok := uidMap[uid]
+	if !ok {
+		return Info{}, fmt.Errorf("UID '%s' not found in dictionary", uid)
+	}
+	return e, nil
+}
+
+// MustLookup is similar to LookupUID, but crashes the process on error.
+func MustLookup(uid string) Info {
+	e, err := Lookup(uid)
+	if err != nil {
+		panic(err)
+	}
+	return e
+}
+
+// UIDString returns a human-readable diagnostic string for a DICOM UID.
+func UIDString(uid string) string {
+	e, ok := uidMap[uid]
+	if !ok {
+		return fmt.Sprintf("%s[??]", uid)
 	}
+	return fmt.Sprintf("%s[%s]", uid, e.Name)
 }
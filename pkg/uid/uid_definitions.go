@@ -2,478 +2,404 @@ package uid
 
 // Translated from pynetdict, _uid_dict.py
 
-import (
-	"fmt"
-)
-
-type Type string
-
-const (
-	TypeSOPClass                  Type = "SOP Class"
-	TypeTransferSyntax            Type = "Transfer Syntax"
-	TypeWellKnownFrameOfReference Type = "Well-known frame of reference"
-	TypeWellKnownSOPInstance      Type = "Well-known SOP instance"
-	TypeCodingScheme              Type = "Coding Scheme"
-)
-
-// Commonly used UID constants.
-var (
-	PatientRootQRFind = standardUID("1.2.840.10008.5.1.4.1.2.1.1")
-	StudyRootQRFind   = standardUID("1.2.840.10008.5.1.4.1.2.2.1")
-	PatientRootQRGet  = standardUID("1.2.840.10008.5.1.4.1.2.1.3")
-	StudyRootQRGet    = standardUID("1.2.840.10008.5.1.4.1.2.2.3")
-	PatientRootQRMove = standardUID("1.2.840.10008.5.1.4.1.2.1.2")
-	StudyRootQRMove   = standardUID("1.2.840.10008.5.1.4.1.2.2.2")
-
-	ModalityWorklistInformationFind = standardUID("1.2.840.10008.5.1.4.31")
-	VerificationSOPClass            = standardUID("1.2.840.10008.1.1")
-
-	// https://www.dicomlibrary.com/dicom/transfer-syntax/
-	ImplicitVRLittleEndian         = standardUID("1.2.840.10008.1.2")
-	ExplicitVRLittleEndian         = standardUID("1.2.840.10008.1.2.1")
-	ExplicitVRBigEndian            = standardUID("1.2.840.10008.1.2.2")
-	DeflatedExplicitVRLittleEndian = standardUID("1.2.840.10008.1.2.1.99")
-)
-
-// Info holds detailed information about a DICOM UID
-type Info struct {
-	UID    string // "1.2.840.10008.x.y.z"
-	Name   string // The UID string, e.g.,"1.2.840.10008.1.2.1".
-	Type   Type   // "SOP Class", "Transfer Syntax", etc.
-	Part   string // Not used.
-	Status string // "" if active. "Retired", if retired.
-}
-
 var uidMap = map[string]Info{
-	"1.2.840.10008.1.1":                {"1.2.840.10008.1.1", "Verification SOP Class", TypeSOPClass, "", ""},
-	"1.2.840.10008.1.2":                {"1.2.840.10008.1.2", "Implicit VR Little Endian", TypeTransferSyntax, "Default Transfer Syntax for DICOM", ""},
-	"1.2.840.10008.1.2.1":              {"1.2.840.10008.1.2.1", "Explicit VR Little Endian", TypeTransferSyntax, "", ""},
-	"1.2.840.10008.1.2.1.99":           {"1.2.840.10008.1.2.1.99", "Deflated Explicit VR Little Endian", TypeTransferSyntax, "", ""},
-	"1.2.840.10008.1.2.2":              {"1.2.840.10008.1.2.2", "Explicit VR Big Endian", TypeTransferSyntax, "", "Retired"},
-	"1.2.840.10008.1.2.4.50":           {"1.2.840.10008.1.2.4.50", "JPEG Baseline (Process 1)", TypeTransferSyntax, "Default Transfer Syntax for Lossy JPEG 8 Bit Image Compression", ""},
-	"1.2.840.10008.1.2.4.51":           {"1.2.840.10008.1.2.4.51", "JPEG Extended (Process 2 and 4)", TypeTransferSyntax, "Default Transfer Syntax for Lossy JPEG 12 Bit Image Compression (Process 4 only)", ""},
-	"1.2.840.10008.1.2.4.52":           {"1.2.840.10008.1.2.4.52", "JPEG Extended (Process 3 and 5)", TypeTransferSyntax, "", "Retired"},
-	"1.2.840.10008.1.2.4.53":           {"1.2.840.10008.1.2.4.53", "JPEG Spectral Selection, Non-Hierarchical (Process 6 and 8)", TypeTransferSyntax, "", "Retired"},
-	"1.2.840.10008.1.2.4.54":           {"1.2.840.10008.1.2.4.54", "JPEG Spectral Selection, Non-Hierarchical (Process 7 and 9)", TypeTransferSyntax, "", "Retired"},
-	"1.2.840.10008.1.2.4.55":           {"1.2.840.10008.1.2.4.55", "JPEG Full Progression, Non-Hierarchical (Process 10 and 12)", TypeTransferSyntax, "", "Retired"},
-	"1.2.840.10008.1.2.4.56":           {"1.2.840.10008.1.2.4.56", "JPEG Full Progression, Non-Hierarchical (Process 11 and 13)", TypeTransferSyntax, "", "Retired"},
-	"1.2.840.10008.1.2.4.57":           {"1.2.840.10008.1.2.4.57", "JPEG Lossless, Non-Hierarchical (Process 14)", TypeTransferSyntax, "", ""},
-	"1.2.840.10008.1.2.4.58":           {"1.2.840.10008.1.2.4.58", "JPEG Lossless, Non-Hierarchical (Process 15)", TypeTransferSyntax, "", "Retired"},
-	"1.2.840.10008.1.2.4.59":           {"1.2.840.10008.1.2.4.59", "JPEG Extended, Hierarchical (Process 16 and 18)", TypeTransferSyntax, "", "Retired"},
-	"1.2.840.10008.1.2.4.60":           {"1.2.840.10008.1.2.4.60", "JPEG Extended, Hierarchical (Process 17 and 19)", TypeTransferSyntax, "", "Retired"},
-	"1.2.840.10008.1.2.4.61":           {"1.2.840.10008.1.2.4.61", "JPEG Spectral Selection, Hierarchical (Process 20 and 22)", TypeTransferSyntax, "", "Retired"},
-	"1.2.840.10008.1.2.4.62":           {"1.2.840.10008.1.2.4.62", "JPEG Spectral Selection, Hierarchical (Process 21 and 23)", TypeTransferSyntax, "", "Retired"},
-	"1.2.840.10008.1.2.4.63":           {"1.2.840.10008.1.2.4.63", "JPEG Full Progression, Hierarchical (Process 24 and 26)", TypeTransferSyntax, "", "Retired"},
-	"1.2.840.10008.1.2.4.64":           {"1.2.840.10008.1.2.4.64", "JPEG Full Progression, Hierarchical (Process 25 and 27)", TypeTransferSyntax, "", "Retired"},
-	"1.2.840.10008.1.2.4.65":           {"1.2.840.10008.1.2.4.65", "JPEG Lossless, Hierarchical (Process 28)", TypeTransferSyntax, "", "Retired"},
-	"1.2.840.10008.1.2.4.66":           {"1.2.840.10008.1.2.4.66", "JPEG Lossless, Hierarchical (Process 29)", TypeTransferSyntax, "", "Retired"},
-	"1.2.840.10008.1.2.4.70":           {"1.2.840.10008.1.2.4.70", "JPEG Lossless, Non-Hierarchical, First-Order Prediction (Process 14 [Selection Value 1])", TypeTransferSyntax, "Default Transfer Syntax for Lossless JPEG Image Compression", ""},
-	"1.2.840.10008.1.2.4.80":           {"1.2.840.10008.1.2.4.80", "JPEG-LS Lossless Image Compression", TypeTransferSyntax, "", ""},
-	"1.2.840.10008.1.2.4.81":           {"1.2.840.10008.1.2.4.81", "JPEG-LS Lossy (Near-Lossless) Image Compression", TypeTransferSyntax, "", ""},
-	"1.2.840.10008.1.2.4.90":           {"1.2.840.10008.1.2.4.90", "JPEG 2000 Image Compression (Lossless Only)", TypeTransferSyntax, "", ""},
-	"1.2.840.10008.1.2.4.91":           {"1.2.840.10008.1.2.4.91", "JPEG 2000 Image Compression", TypeTransferSyntax, "", ""},
-	"1.2.840.10008.1.2.4.92":           {"1.2.840.10008.1.2.4.92", "JPEG 2000 Part 2 Multi-component Image Compression (Lossless Only)", TypeTransferSyntax, "", ""},
-	"1.2.840.10008.1.2.4.93":           {"1.2.840.10008.1.2.4.93", "JPEG 2000 Part 2 Multi-component Image Compression", TypeTransferSyntax, "", ""},
-	"1.2.840.10008.1.2.4.94":           {"1.2.840.10008.1.2.4.94", "JPIP Referenced", TypeTransferSyntax, "", ""},
-	"1.2.840.10008.1.2.4.95":           {"1.2.840.10008.1.2.4.95", "JPIP Referenced Deflate", TypeTransferSyntax, "", ""},
-	"1.2.840.10008.1.2.4.100":          {"1.2.840.10008.1.2.4.100", "MPEG2 Main Profile / Main Level", TypeTransferSyntax, "", ""},
-	"1.2.840.10008.1.2.4.101":          {"1.2.840.10008.1.2.4.101", "MPEG2 Main Profile / High Level", TypeTransferSyntax, "", ""},
-	"1.2.840.10008.1.2.4.102":          {"1.2.840.10008.1.2.4.102", "MPEG-4 AVC/H.264 High Profile / Level 4.1", TypeTransferSyntax, "", ""},
-	"1.2.840.10008.1.2.4.103":          {"1.2.840.10008.1.2.4.103", "MPEG-4 AVC/H.264 BD-compatible High Profile / Level 4.1", TypeTransferSyntax, "", ""},
-	"1.2.840.10008.1.2.4.104":          {"1.2.840.10008.1.2.4.104", "MPEG-4 AVC/H.264 High Profile / Level 4.2 For 2D Video", TypeTransferSyntax, "", ""},
-	"1.2.840.10008.1.2.4.105":          {"1.2.840.10008.1.2.4.105", "MPEG-4 AVC/H.264 High Profile / Level 4.2 For 3D Video", TypeTransferSyntax, "", ""},
-	"1.2.840.10008.1.2.4.106":          {"1.2.840.10008.1.2.4.106", "MPEG-4 AVC/H.264 Stereo High Profile / Level 4.2", TypeTransferSyntax, "", ""},
-	"1.2.840.10008.1.2.4.107":          {"1.2.840.10008.1.2.4.107", "HEVC/H.265 Main Profile / Level 5.1", TypeTransferSyntax, "", ""},
-	"1.2.840.10008.1.2.4.108":          {"1.2.840.10008.1.2.4.108", "HEVC/H.265 Main 10 Profile / Level 5.1", TypeTransferSyntax, "", ""},
-	"1.2.840.10008.1.2.5":              {"1.2.840.10008.1.2.5", "RLE Lossless", TypeTransferSyntax, "", ""},
-	"1.2.840.10008.1.2.6.1":            {"1.2.840.10008.1.2.6.1", "RFC 2557 MIME encapsulation", TypeTransferSyntax, "", ""},
-	"1.2.840.10008.1.2.6.2":            {"1.2.840.10008.1.2.6.2", "XML Encoding", TypeTransferSyntax, "", ""},
-	"1.2.840.10008.1.3.10":             {"1.2.840.10008.1.3.10", "Media Storage Directory Storage", TypeSOPClass, "", ""},
-	"1.2.840.10008.1.4.1.1":            {"1.2.840.10008.1.4.1.1", "Talairach Brain Atlas Frame of Reference", TypeWellKnownFrameOfReference, "", ""},
-	"1.2.840.10008.1.4.1.2":            {"1.2.840.10008.1.4.1.2", "SPM2 T1 Frame of Reference", TypeWellKnownFrameOfReference, "", ""},
-	"1.2.840.10008.1.4.1.3":            {"1.2.840.10008.1.4.1.3", "SPM2 T2 Frame of Reference", TypeWellKnownFrameOfReference, "", ""},
-	"1.2.840.10008.1.4.1.4":            {"1.2.840.10008.1.4.1.4", "SPM2 PD Frame of Reference", TypeWellKnownFrameOfReference, "", ""},
-	"1.2.840.10008.1.4.1.5":            {"1.2.840.10008.1.4.1.5", "SPM2 EPI Frame of Reference", TypeWellKnownFrameOfReference, "", ""},
-	"1.2.840.10008.1.4.1.6":            {"1.2.840.10008.1.4.1.6", "SPM2 FIL T1 Frame of Reference", TypeWellKnownFrameOfReference, "", ""},
-	"1.2.840.10008.1.4.1.7":            {"1.2.840.10008.1.4.1.7", "SPM2 PET Frame of Reference", TypeWellKnownFrameOfReference, "", ""},
-	"1.2.840.10008.1.4.1.8":            {"1.2.840.10008.1.4.1.8", "SPM2 TRANSM Frame of Reference", TypeWellKnownFrameOfReference, "", ""},
-	"1.2.840.10008.1.4.1.9":            {"1.2.840.10008.1.4.1.9", "SPM2 SPECT Frame of Reference", TypeWellKnownFrameOfReference, "", ""},
-	"1.2.840.10008.1.4.1.10":           {"1.2.840.10008.1.4.1.10", "SPM2 GRAY Frame of Reference", TypeWellKnownFrameOfReference, "", ""},
-	"1.2.840.10008.1.4.1.11":           {"1.2.840.10008.1.4.1.11", "SPM2 WHITE Frame of Reference", TypeWellKnownFrameOfReference, "", ""},
-	"1.2.840.10008.1.4.1.12":           {"1.2.840.10008.1.4.1.12", "SPM2 CSF Frame of Reference", TypeWellKnownFrameOfReference, "", ""},
-	"1.2.840.10008.1.4.1.13":           {"1.2.840.10008.1.4.1.13", "SPM2 BRAINMASK Frame of Reference", TypeWellKnownFrameOfReference, "", ""},
-	"1.2.840.10008.1.4.1.14":           {"1.2.840.10008.1.4.1.14", "SPM2 AVG305T1 Frame of Reference", TypeWellKnownFrameOfReference, "", ""},
-	"1.2.840.10008.1.4.1.15":           {"1.2.840.10008.1.4.1.15", "SPM2 AVG152T1 Frame of Reference", TypeWellKnownFrameOfReference, "", ""},
-	"1.2.840.10008.1.4.1.16":           {"1.2.840.10008.1.4.1.16", "SPM2 AVG152T2 Frame of Reference", TypeWellKnownFrameOfReference, "", ""},
-	"1.2.840.10008.1.4.1.17":           {"1.2.840.10008.1.4.1.17", "SPM2 AVG152PD Frame of Reference", TypeWellKnownFrameOfReference, "", ""},
-	"1.2.840.10008.1.4.1.18":           {"1.2.840.10008.1.4.1.18", "SPM2 SINGLESUBJT1 Frame of Reference", TypeWellKnownFrameOfReference, "", ""},
-	"1.2.840.10008.1.4.2.1":            {"1.2.840.10008.1.4.2.1", "ICBM 452 T1 Frame of Reference", TypeWellKnownFrameOfReference, "", ""},
-	"1.2.840.10008.1.4.2.2":            {"1.2.840.10008.1.4.2.2", "ICBM Single Subject MRI Frame of Reference", TypeWellKnownFrameOfReference, "", ""},
-	"1.2.840.10008.1.5.1":              {"1.2.840.10008.1.5.1", "Hot Iron Color Palette SOP Instance", TypeWellKnownSOPInstance, "", ""},
-	"1.2.840.10008.1.5.2":              {"1.2.840.10008.1.5.2", "PET Color Palette SOP Instance", TypeWellKnownSOPInstance, "", ""},
-	"1.2.840.10008.1.5.3":              {"1.2.840.10008.1.5.3", "Hot Metal Blue Color Palette SOP Instance", TypeWellKnownSOPInstance, "", ""},
-	"1.2.840.10008.1.5.4":              {"1.2.840.10008.1.5.4", "PET 20 Step Color Palette SOP Instance", TypeWellKnownSOPInstance, "", ""},
-	"1.2.840.10008.1.5.5":              {"1.2.840.10008.1.5.5", "Spring Color Palette SOP Instance", TypeWellKnownSOPInstance, "", ""},
-	"1.2.840.10008.1.5.6":              {"1.2.840.10008.1.5.6", "Summer Color Palette SOP Instance", TypeWellKnownSOPInstance, "", ""},
-	"1.2.840.10008.1.5.7":              {"1.2.840.10008.1.5.7", "Fall Color Palette SOP Instance", TypeWellKnownSOPInstance, "", ""},
-	"1.2.840.10008.1.5.8":              {"1.2.840.10008.1.5.8", "Winter Color Palette SOP Instance", TypeWellKnownSOPInstance, "", ""},
-	"1.2.840.10008.1.9":                {"1.2.840.10008.1.9", "Basic Study Content Notification SOP Class", TypeSOPClass, "", "Retired"},
-	"1.2.840.10008.1.20":               {"1.2.840.10008.1.20", "Papyrus 3 Implicit VR Little Endian", TypeTransferSyntax, "", "Retired"},
-	"1.2.840.10008.1.20.1":             {"1.2.840.10008.1.20.1", "Storage Commitment Push Model SOP Class", TypeSOPClass, "", ""},
-	"1.2.840.10008.1.20.1.1":           {"1.2.840.10008.1.20.1.1", "Storage Commitment Push Model SOP Instance", TypeWellKnownSOPInstance, "", ""},
-	"1.2.840.10008.1.20.2":             {"1.2.840.10008.1.20.2", "Storage Commitment Pull Model SOP Class", TypeSOPClass, "", "Retired"},
-	"1.2.840.10008.1.20.2.1":           {"1.2.840.10008.1.20.2.1", "Storage Commitment Pull Model SOP Instance", TypeWellKnownSOPInstance, "", "Retired"},
-	"1.2.840.10008.1.40":               {"1.2.840.10008.1.40", "Procedural Event Logging SOP Class", TypeSOPClass, "", ""},
-	"1.2.840.10008.1.40.1":             {"1.2.840.10008.1.40.1", "Procedural Event Logging SOP Instance", TypeWellKnownSOPInstance, "", ""},
-	"1.2.840.10008.1.42":               {"1.2.840.10008.1.42", "Substance Administration Logging SOP Class", TypeSOPClass, "", ""},
-	"1.2.840.10008.1.42.1":             {"1.2.840.10008.1.42.1", "Substance Administration Logging SOP Instance", TypeWellKnownSOPInstance, "", ""},
-	"1.2.840.10008.2.6.1":              {"1.2.840.10008.2.6.1", "DICOM UID Registry", "DICOM UIDs as Coding Scheme", "", ""},
-	"1.2.840.10008.2.16.4":             {"1.2.840.10008.2.16.4", "DICOM Controlled Terminology", TypeCodingScheme, "", ""},
-	"1.2.840.10008.2.16.5":             {"1.2.840.10008.2.16.5", "Adult Mouse Anatomy Ontology", TypeCodingScheme, "", ""},
-	"1.2.840.10008.2.16.6":             {"1.2.840.10008.2.16.6", "Uberon Ontology", TypeCodingScheme, "", ""},
-	"1.2.840.10008.2.16.7":             {"1.2.840.10008.2.16.7", "Integrated Taxonomic Information System (ITIS) Taxonomic Serial Number (TSN)", TypeCodingScheme, "", ""},
-	"1.2.840.10008.2.16.8":             {"1.2.840.10008.2.16.8", "Mouse Genome Initiative (MGI)", TypeCodingScheme, "", ""},
-	"1.2.840.10008.2.16.9":             {"1.2.840.10008.2.16.9", "PubChem Compound CID", TypeCodingScheme, "", ""},
-	"1.2.840.10008.3.1.1.1":            {"1.2.840.10008.3.1.1.1", "DICOM Application Context Name", "Application Context Name", "", ""},
-	"1.2.840.10008.3.1.2.1.1":          {"1.2.840.10008.3.1.2.1.1", "Detached Patient Management SOP Class", TypeSOPClass, "", "Retired"},
-	"1.2.840.10008.3.1.2.1.4":          {"1.2.840.10008.3.1.2.1.4", "Detached Patient Management Meta SOP Class", "Meta SOP Class", "", "Retired"},
-	"1.2.840.10008.3.1.2.2.1":          {"1.2.840.10008.3.1.2.2.1", "Detached Visit Management SOP Class", TypeSOPClass, "", "Retired"},
-	"1.2.840.10008.3.1.2.3.1":          {"1.2.840.10008.3.1.2.3.1", "Detached Study Management SOP Class", TypeSOPClass, "", "Retired"},
-	"1.2.840.10008.3.1.2.3.2":          {"1.2.840.10008.3.1.2.3.2", "Study Component Management SOP Class", TypeSOPClass, "", "Retired"},
-	"1.2.840.10008.3.1.2.3.3":          {"1.2.840.10008.3.1.2.3.3", "Modality Performed Procedure Step SOP Class", TypeSOPClass, "", ""},
-	"1.2.840.10008.3.1.2.3.4":          {"1.2.840.10008.3.1.2.3.4", "Modality Performed Procedure Step Retrieve SOP Class", TypeSOPClass, "", ""},
-	"1.2.840.10008.3.1.2.3.5":          {"1.2.840.10008.3.1.2.3.5", "Modality Performed Procedure Step Notification SOP Class", TypeSOPClass, "", ""},
-	"1.2.840.10008.3.1.2.5.1":          {"1.2.840.10008.3.1.2.5.1", "Detached Results Management SOP Class", TypeSOPClass, "", "Retired"},
-	"1.2.840.10008.3.1.2.5.4":          {"1.2.840.10008.3.1.2.5.4", "Detached Results Management Meta SOP Class", "Meta SOP Class", "", "Retired"},
-	"1.2.840.10008.3.1.2.5.5":          {"1.2.840.10008.3.1.2.5.5", "Detached Study Management Meta SOP Class", "Meta SOP Class", "", "Retired"},
-	"1.2.840.10008.3.1.2.6.1":          {"1.2.840.10008.3.1.2.6.1", "Detached Interpretation Management SOP Class", TypeSOPClass, "", "Retired"},
-	"1.2.840.10008.4.2":                {"1.2.840.10008.4.2", "Storage Service Class", "Service Class", "", ""},
-	"1.2.840.10008.5.1.1.1":            {"1.2.840.10008.5.1.1.1", "Basic Film Session SOP Class", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.1.2":            {"1.2.840.10008.5.1.1.2", "Basic Film Box SOP Class", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.1.4":            {"1.2.840.10008.5.1.1.4", "Basic Grayscale Image Box SOP Class", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.1.4.1":          {"1.2.840.10008.5.1.1.4.1", "Basic Color Image Box SOP Class", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.1.4.2":          {"1.2.840.10008.5.1.1.4.2", "Referenced Image Box SOP Class", TypeSOPClass, "", "Retired"},
-	"1.2.840.10008.5.1.1.9":            {"1.2.840.10008.5.1.1.9", "Basic Grayscale Print Management Meta SOP Class", "Meta SOP Class", "", ""},
-	"1.2.840.10008.5.1.1.9.1":          {"1.2.840.10008.5.1.1.9.1", "Referenced Grayscale Print Management Meta SOP Class", "Meta SOP Class", "", "Retired"},
-	"1.2.840.10008.5.1.1.14":           {"1.2.840.10008.5.1.1.14", "Print Job SOP Class", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.1.15":           {"1.2.840.10008.5.1.1.15", "Basic Annotation Box SOP Class", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.1.16":           {"1.2.840.10008.5.1.1.16", "Printer SOP Class", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.1.16.376":       {"1.2.840.10008.5.1.1.16.376", "Printer Configuration Retrieval SOP Class", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.1.17":           {"1.2.840.10008.5.1.1.17", "Printer SOP Instance", "Well-known Printer SOP Instance", "", ""},
-	"1.2.840.10008.5.1.1.17.376":       {"1.2.840.10008.5.1.1.17.376", "Printer Configuration Retrieval SOP Instance", "Well-known Printer SOP Instance", "", ""},
-	"1.2.840.10008.5.1.1.18":           {"1.2.840.10008.5.1.1.18", "Basic Color Print Management Meta SOP Class", "Meta SOP Class", "", ""},
-	"1.2.840.10008.5.1.1.18.1":         {"1.2.840.10008.5.1.1.18.1", "Referenced Color Print Management Meta SOP Class", "Meta SOP Class", "", "Retired"},
-	"1.2.840.10008.5.1.1.22":           {"1.2.840.10008.5.1.1.22", "VOI LUT Box SOP Class", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.1.23":           {"1.2.840.10008.5.1.1.23", "Presentation LUT SOP Class", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.1.24":           {"1.2.840.10008.5.1.1.24", "Image Overlay Box SOP Class", TypeSOPClass, "", "Retired"},
-	"1.2.840.10008.5.1.1.24.1":         {"1.2.840.10008.5.1.1.24.1", "Basic Print Image Overlay Box SOP Class", TypeSOPClass, "", "Retired"},
-	"1.2.840.10008.5.1.1.25":           {"1.2.840.10008.5.1.1.25", "Print Queue SOP Instance", "Well-known Print Queue SOP Instance", "", "Retired"},
-	"1.2.840.10008.5.1.1.26":           {"1.2.840.10008.5.1.1.26", "Print Queue Management SOP Class", TypeSOPClass, "", "Retired"},
-	"1.2.840.10008.5.1.1.27":           {"1.2.840.10008.5.1.1.27", "Stored Print Storage SOP Class", TypeSOPClass, "", "Retired"},
-	"1.2.840.10008.5.1.1.29":           {"1.2.840.10008.5.1.1.29", "Hardcopy Grayscale Image Storage SOP Class", TypeSOPClass, "", "Retired"},
-	"1.2.840.10008.5.1.1.30":           {"1.2.840.10008.5.1.1.30", "Hardcopy Color Image Storage SOP Class", TypeSOPClass, "", "Retired"},
-	"1.2.840.10008.5.1.1.31":           {"1.2.840.10008.5.1.1.31", "Pull Print Request SOP Class", TypeSOPClass, "", "Retired"},
-	"1.2.840.10008.5.1.1.32":           {"1.2.840.10008.5.1.1.32", "Pull Stored Print Management Meta SOP Class", "Meta SOP Class", "", "Retired"},
-	"1.2.840.10008.5.1.1.33":           {"1.2.840.10008.5.1.1.33", "Media Creation Management SOP Class UID", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.1.40":           {"1.2.840.10008.5.1.1.40", "Display System SOP Class", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.1.40.1":         {"1.2.840.10008.5.1.1.40.1", "Display System SOP Instance", TypeWellKnownSOPInstance, "", ""},
-	"1.2.840.10008.5.1.4.1.1.1":        {"1.2.840.10008.5.1.4.1.1.1", "Computed Radiography Image Storage", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.1.1.1.1":      {"1.2.840.10008.5.1.4.1.1.1.1", "Digital X-Ray Image Storage - For Presentation", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.1.1.1.1.1":    {"1.2.840.10008.5.1.4.1.1.1.1.1", "Digital X-Ray Image Storage - For Processing", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.1.1.1.2":      {"1.2.840.10008.5.1.4.1.1.1.2", "Digital Mammography X-Ray Image Storage - For Presentation", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.1.1.1.2.1":    {"1.2.840.10008.5.1.4.1.1.1.2.1", "Digital Mammography X-Ray Image Storage - For Processing", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.1.1.1.3":      {"1.2.840.10008.5.1.4.1.1.1.3", "Digital Intra-Oral X-Ray Image Storage - For Presentation", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.1.1.1.3.1":    {"1.2.840.10008.5.1.4.1.1.1.3.1", "Digital Intra-Oral X-Ray Image Storage - For Processing", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.1.1.2":        {"1.2.840.10008.5.1.4.1.1.2", "CT Image Storage", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.1.1.2.1":      {"1.2.840.10008.5.1.4.1.1.2.1", "Enhanced CT Image Storage", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.1.1.2.2":      {"1.2.840.10008.5.1.4.1.1.2.2", "Legacy Converted Enhanced CT Image Storage", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.1.1.3":        {"1.2.840.10008.5.1.4.1.1.3", "Ultrasound Multi-frame Image Storage", TypeSOPClass, "", "Retired"},
-	"1.2.840.10008.5.1.4.1.1.3.1":      {"1.2.840.10008.5.1.4.1.1.3.1", "Ultrasound Multi-frame Image Storage", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.1.1.4":        {"1.2.840.10008.5.1.4.1.1.4", "MR Image Storage", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.1.1.4.1":      {"1.2.840.10008.5.1.4.1.1.4.1", "Enhanced MR Image Storage", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.1.1.4.2":      {"1.2.840.10008.5.1.4.1.1.4.2", "MR Spectroscopy Storage", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.1.1.4.3":      {"1.2.840.10008.5.1.4.1.1.4.3", "Enhanced MR Color Image Storage", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.1.1.4.4":      {"1.2.840.10008.5.1.4.1.1.4.4", "Legacy Converted Enhanced MR Image Storage", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.1.1.5":        {"1.2.840.10008.5.1.4.1.1.5", "Nuclear Medicine Image Storage", TypeSOPClass, "", "Retired"},
-	"1.2.840.10008.5.1.4.1.1.6":        {"1.2.840.10008.5.1.4.1.1.6", "Ultrasound Image Storage", TypeSOPClass, "", "Retired"},
-	"1.2.840.10008.5.1.4.1.1.6.1":      {"1.2.840.10008.5.1.4.1.1.6.1", "Ultrasound Image Storage", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.1.1.6.2":      {"1.2.840.10008.5.1.4.1.1.6.2", "Enhanced US Volume Storage", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.1.1.7":        {"1.2.840.10008.5.1.4.1.1.7", "Secondary Capture Image Storage", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.1.1.7.1":      {"1.2.840.10008.5.1.4.1.1.7.1", "Multi-frame Single Bit Secondary Capture Image Storage", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.1.1.7.2":      {"1.2.840.10008.5.1.4.1.1.7.2", "Multi-frame Grayscale Byte Secondary Capture Image Storage", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.1.1.7.3":      {"1.2.840.10008.5.1.4.1.1.7.3", "Multi-frame Grayscale Word Secondary Capture Image Storage", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.1.1.7.4":      {"1.2.840.10008.5.1.4.1.1.7.4", "Multi-frame True Color Secondary Capture Image Storage", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.1.1.8":        {"1.2.840.10008.5.1.4.1.1.8", "Standalone Overlay Storage", TypeSOPClass, "", "Retired"},
-	"1.2.840.10008.5.1.4.1.1.9":        {"1.2.840.10008.5.1.4.1.1.9", "Standalone Curve Storage", TypeSOPClass, "", "Retired"},
-	"1.2.840.10008.5.1.4.1.1.9.1":      {"1.2.840.10008.5.1.4.1.1.9.1", "Waveform Storage - Trial", TypeSOPClass, "", "Retired"},
-	"1.2.840.10008.5.1.4.1.1.9.1.1":    {"1.2.840.10008.5.1.4.1.1.9.1.1", "12-lead ECG Waveform Storage", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.1.1.9.1.2":    {"1.2.840.10008.5.1.4.1.1.9.1.2", "General ECG Waveform Storage", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.1.1.9.1.3":    {"1.2.840.10008.5.1.4.1.1.9.1.3", "Ambulatory ECG Waveform Storage", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.1.1.9.2.1":    {"1.2.840.10008.5.1.4.1.1.9.2.1", "Hemodynamic Waveform Storage", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.1.1.9.3.1":    {"1.2.840.10008.5.1.4.1.1.9.3.1", "Cardiac Electrophysiology Waveform Storage", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.1.1.9.4.1":    {"1.2.840.10008.5.1.4.1.1.9.4.1", "Basic Voice Audio Waveform Storage", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.1.1.9.4.2":    {"1.2.840.10008.5.1.4.1.1.9.4.2", "General Audio Waveform Storage", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.1.1.9.5.1":    {"1.2.840.10008.5.1.4.1.1.9.5.1", "Arterial Pulse Waveform Storage", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.1.1.9.6.1":    {"1.2.840.10008.5.1.4.1.1.9.6.1", "Respiratory Waveform Storage", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.1.1.10":       {"1.2.840.10008.5.1.4.1.1.10", "Standalone Modality LUT Storage", TypeSOPClass, "", "Retired"},
-	"1.2.840.10008.5.1.4.1.1.11":       {"1.2.840.10008.5.1.4.1.1.11", "Standalone VOI LUT Storage", TypeSOPClass, "", "Retired"},
-	"1.2.840.10008.5.1.4.1.1.11.1":     {"1.2.840.10008.5.1.4.1.1.11.1", "Grayscale Softcopy Presentation State Storage", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.1.1.11.2":     {"1.2.840.10008.5.1.4.1.1.11.2", "Color Softcopy Presentation State Storage", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.1.1.11.3":     {"1.2.840.10008.5.1.4.1.1.11.3", "Pseudo-Color Softcopy Presentation State Storage", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.1.1.11.4":     {"1.2.840.10008.5.1.4.1.1.11.4", "Blending Softcopy Presentation State Storage", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.1.1.11.5":     {"1.2.840.10008.5.1.4.1.1.11.5", "XA/XRF Grayscale Softcopy Presentation State Storage", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.1.1.11.6":     {"1.2.840.10008.5.1.4.1.1.11.6", "Grayscale Planar MPR Volumetric Presentation State Storage", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.1.1.11.7":     {"1.2.840.10008.5.1.4.1.1.11.7", "Compositing Planar MPR Volumetric Presentation State Storage", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.1.1.11.8":     {"1.2.840.10008.5.1.4.1.1.11.8", "Advanced Blending Presentation State Storage", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.1.1.11.9":     {"1.2.840.10008.5.1.4.1.1.11.9", "Volume Rendering Volumetric Presentation State Storage", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.1.1.11.10":    {"1.2.840.10008.5.1.4.1.1.11.10", "Segmented Volume Rendering Volumetric Presentation State Storage", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.1.1.11.11":    {"1.2.840.10008.5.1.4.1.1.11.11", "Multiple Volume Rendering Volumetric Presentation State Storage", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.1.1.12.1":     {"1.2.840.10008.5.1.4.1.1.12.1", "X-Ray Angiographic Image Storage", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.1.1.12.1.1":   {"1.2.840.10008.5.1.4.1.1.12.1.1", "Enhanced XA Image Storage", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.1.1.12.2":     {"1.2.840.10008.5.1.4.1.1.12.2", "X-Ray Radiofluoroscopic Image Storage", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.1.1.12.2.1":   {"1.2.840.10008.5.1.4.1.1.12.2.1", "Enhanced XRF Image Storage", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.1.1.12.3":     {"1.2.840.10008.5.1.4.1.1.12.3", "X-Ray Angiographic Bi-Plane Image Storage", TypeSOPClass, "", "Retired"},
-	"1.2.840.10008.5.1.4.1.1.12.77":    {"1.2.840.10008.5.1.4.1.1.12.77", "", TypeSOPClass, "", "Retired"},
-	"1.2.840.10008.5.1.4.1.1.13.1.1":   {"1.2.840.10008.5.1.4.1.1.13.1.1", "X-Ray 3D Angiographic Image Storage", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.1.1.13.1.2":   {"1.2.840.10008.5.1.4.1.1.13.1.2", "X-Ray 3D Craniofacial Image Storage", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.1.1.13.1.3":   {"1.2.840.10008.5.1.4.1.1.13.1.3", "Breast Tomosynthesis Image Storage", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.1.1.13.1.4":   {"1.2.840.10008.5.1.4.1.1.13.1.4", "Breast Projection X-Ray Image Storage - For Presentation", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.1.1.13.1.5":   {"1.2.840.10008.5.1.4.1.1.13.1.5", "Breast Projection X-Ray Image Storage - For Processing", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.1.1.14.1":     {"1.2.840.10008.5.1.4.1.1.14.1", "Intravascular Optical Coherence Tomography Image Storage - For Presentation", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.1.1.14.2":     {"1.2.840.10008.5.1.4.1.1.14.2", "Intravascular Optical Coherence Tomography Image Storage - For Processing", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.1.1.20":       {"1.2.840.10008.5.1.4.1.1.20", "Nuclear Medicine Image Storage", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.1.1.30":       {"1.2.840.10008.5.1.4.1.1.30", "Parametric Map Storage", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.1.1.40":       {"1.2.840.10008.5.1.4.1.1.40", "", TypeSOPClass, "", "Retired"},
-	"1.2.840.10008.5.1.4.1.1.66":       {"1.2.840.10008.5.1.4.1.1.66", "Raw Data Storage", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.1.1.66.1":     {"1.2.840.10008.5.1.4.1.1.66.1", "Spatial Registration Storage", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.1.1.66.2":     {"1.2.840.10008.5.1.4.1.1.66.2", "Spatial Fiducials Storage", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.1.1.66.3":     {"1.2.840.10008.5.1.4.1.1.66.3", "Deformable Spatial Registration Storage", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.1.1.66.4":     {"1.2.840.10008.5.1.4.1.1.66.4", "Segmentation Storage", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.1.1.66.5":     {"1.2.840.10008.5.1.4.1.1.66.5", "Surface Segmentation Storage", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.1.1.66.6":     {"1.2.840.10008.5.1.4.1.1.66.6", "Tractography Results Storage", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.1.1.67":       {"1.2.840.10008.5.1.4.1.1.67", "Real World Value Mapping Storage", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.1.1.68.1":     {"1.2.840.10008.5.1.4.1.1.68.1", "Surface Scan Mesh Storage", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.1.1.68.2":     {"1.2.840.10008.5.1.4.1.1.68.2", "Surface Scan Point Cloud Storage", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.1.1.77.1":     {"1.2.840.10008.5.1.4.1.1.77.1", "VL Image Storage - Trial", TypeSOPClass, "", "Retired"},
-	"1.2.840.10008.5.1.4.1.1.77.2":     {"1.2.840.10008.5.1.4.1.1.77.2", "VL Multi-frame Image Storage - Trial", TypeSOPClass, "", "Retired"},
-	"1.2.840.10008.5.1.4.1.1.77.1.1":   {"1.2.840.10008.5.1.4.1.1.77.1.1", "VL Endoscopic Image Storage", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.1.1.77.1.1.1": {"1.2.840.10008.5.1.4.1.1.77.1.1.1", "Video Endoscopic Image Storage", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.1.1.77.1.2":   {"1.2.840.10008.5.1.4.1.1.77.1.2", "VL Microscopic Image Storage", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.1.1.77.1.2.1": {"1.2.840.10008.5.1.4.1.1.77.1.2.1", "Video Microscopic Image Storage", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.1.1.77.1.3":   {"1.2.840.10008.5.1.4.1.1.77.1.3", "VL Slide-Coordinates Microscopic Image Storage", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.1.1.77.1.4":   {"1.2.840.10008.5.1.4.1.1.77.1.4", "VL Photographic Image Storage", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.1.1.77.1.4.1": {"1.2.840.10008.5.1.4.1.1.77.1.4.1", "Video Photographic Image Storage", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.1.1.77.1.5.1": {"1.2.840.10008.5.1.4.1.1.77.1.5.1", "Ophthalmic Photography 8 Bit Image Storage", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.1.1.77.1.5.2": {"1.2.840.10008.5.1.4.1.1.77.1.5.2", "Ophthalmic Photography 16 Bit Image Storage", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.1.1.77.1.5.3": {"1.2.840.10008.5.1.4.1.1.77.1.5.3", "Stereometric Relationship Storage", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.1.1.77.1.5.4": {"1.2.840.10008.5.1.4.1.1.77.1.5.4", "Ophthalmic Tomography Image Storage", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.1.1.77.1.5.5": {"1.2.840.10008.5.1.4.1.1.77.1.5.5", "Wide Field Ophthalmic Photography Stereographic Projection Image Storage", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.1.1.77.1.5.6": {"1.2.840.10008.5.1.4.1.1.77.1.5.6", "Wide Field Ophthalmic Photography 3D Coordinates Image Storage", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.1.1.77.1.5.7": {"1.2.840.10008.5.1.4.1.1.77.1.5.7", "Ophthalmic Optical Coherence Tomography En Face Image Storage", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.1.1.77.1.5.8": {"1.2.840.10008.5.1.4.1.1.77.1.5.8", "Ophthalmic Optical Coherence Tomography B-scan Volume Analysis Storage", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.1.1.77.1.6":   {"1.2.840.10008.5.1.4.1.1.77.1.6", "VL Whole Slide Microscopy Image Storage", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.1.1.78.1":     {"1.2.840.10008.5.1.4.1.1.78.1", "Lensometry Measurements Storage", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.1.1.78.2":     {"1.2.840.10008.5.1.4.1.1.78.2", "Autorefraction Measurements Storage", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.1.1.78.3":     {"1.2.840.10008.5.1.4.1.1.78.3", "Keratometry Measurements Storage", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.1.1.78.4":     {"1.2.840.10008.5.1.4.1.1.78.4", "Subjective Refraction Measurements Storage", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.1.1.78.5":     {"1.2.840.10008.5.1.4.1.1.78.5", "Visual Acuity Measurements Storage", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.1.1.78.6":     {"1.2.840.10008.5.1.4.1.1.78.6", "Spectacle Prescription Report Storage", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.1.1.78.7":     {"1.2.840.10008.5.1.4.1.1.78.7", "Ophthalmic Axial Measurements Storage", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.1.1.78.8":     {"1.2.840.10008.5.1.4.1.1.78.8", "Intraocular Lens Calculations Storage", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.1.1.79.1":     {"1.2.840.10008.5.1.4.1.1.79.1", "Macular Grid Thickness and Volume Report Storage", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.1.1.80.1":     {"1.2.840.10008.5.1.4.1.1.80.1", "Ophthalmic Visual Field Static Perimetry Measurements Storage", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.1.1.81.1":     {"1.2.840.10008.5.1.4.1.1.81.1", "Ophthalmic Thickness Map Storage", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.1.1.82.1":     {"1.2.840.10008.5.1.4.1.1.82.1", "Corneal Topography Map Storage", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.1.1.88.1":     {"1.2.840.10008.5.1.4.1.1.88.1", "Text SR Storage - Trial", TypeSOPClass, "", "Retired"},
-	"1.2.840.10008.5.1.4.1.1.88.2":     {"1.2.840.10008.5.1.4.1.1.88.2", "Audio SR Storage - Trial", TypeSOPClass, "", "Retired"},
-	"1.2.840.10008.5.1.4.1.1.88.3":     {"1.2.840.10008.5.1.4.1.1.88.3", "Detail SR Storage - Trial", TypeSOPClass, "", "Retired"},
-	"1.2.840.10008.5.1.4.1.1.88.4":     {"1.2.840.10008.5.1.4.1.1.88.4", "Comprehensive SR Storage - Trial", TypeSOPClass, "", "Retired"},
-	"1.2.840.10008.5.1.4.1.1.88.11":    {"1.2.840.10008.5.1.4.1.1.88.11", "Basic Text SR Storage", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.1.1.88.22":    {"1.2.840.10008.5.1.4.1.1.88.22", "Enhanced SR Storage", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.1.1.88.33":    {"1.2.840.10008.5.1.4.1.1.88.33", "Comprehensive SR Storage", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.1.1.88.34":    {"1.2.840.10008.5.1.4.1.1.88.34", "Comprehensive 3D SR Storage", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.1.1.88.35":    {"1.2.840.10008.5.1.4.1.1.88.35", "Extensible SR Storage", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.1.1.88.40":    {"1.2.840.10008.5.1.4.1.1.88.40", "Procedure Log Storage", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.1.1.88.50":    {"1.2.840.10008.5.1.4.1.1.88.50", "Mammography CAD SR Storage", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.1.1.88.59":    {"1.2.840.10008.5.1.4.1.1.88.59", "Key Object Selection Document Storage", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.1.1.88.65":    {"1.2.840.10008.5.1.4.1.1.88.65", "Chest CAD SR Storage", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.1.1.88.67":    {"1.2.840.10008.5.1.4.1.1.88.67", "X-Ray Radiation Dose SR Storage", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.1.1.88.68":    {"1.2.840.10008.5.1.4.1.1.88.68", "Radiopharmaceutical Radiation Dose SR Storage", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.1.1.88.69":    {"1.2.840.10008.5.1.4.1.1.88.69", "Colon CAD SR Storage", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.1.1.88.70":    {"1.2.840.10008.5.1.4.1.1.88.70", "Implantation Plan SR Storage", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.1.1.88.71":    {"1.2.840.10008.5.1.4.1.1.88.71", "Acquisition Context SR Storage", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.1.1.88.72":    {"1.2.840.10008.5.1.4.1.1.88.72", "Simplified Adult Echo SR Storage", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.1.1.88.73":    {"1.2.840.10008.5.1.4.1.1.88.73", "Patient Radiation Dose SR Storage", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.1.1.90.1":     {"1.2.840.10008.5.1.4.1.1.90.1", "Content Assessment Results Storage", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.1.1.104.1":    {"1.2.840.10008.5.1.4.1.1.104.1", "Encapsulated PDF Storage", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.1.1.104.2":    {"1.2.840.10008.5.1.4.1.1.104.2", "Encapsulated CDA Storage", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.1.1.128":      {"1.2.840.10008.5.1.4.1.1.128", "Positron Emission Tomography Image Storage", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.1.1.128.1":    {"1.2.840.10008.5.1.4.1.1.128.1", "Legacy Converted Enhanced PET Image Storage", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.1.1.129":      {"1.2.840.10008.5.1.4.1.1.129", "Standalone PET Curve Storage", TypeSOPClass, "", "Retired"},
-	"1.2.840.10008.5.1.4.1.1.130":      {"1.2.840.10008.5.1.4.1.1.130", "Enhanced PET Image Storage", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.1.1.131":      {"1.2.840.10008.5.1.4.1.1.131", "Basic Structured Display Storage", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.1.1.200.1":    {"1.2.840.10008.5.1.4.1.1.200.1", "CT Defined Procedure Protocol Storage", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.1.1.200.2":    {"1.2.840.10008.5.1.4.1.1.200.2", "CT Performed Procedure Protocol Storage", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.1.1.200.3":    {"1.2.840.10008.5.1.4.1.1.200.3", "Protocol Approval Storage", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.1.1.200.4":    {"1.2.840.10008.5.1.4.1.1.200.4", "Protocol Approval Information Model - FIND", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.1.1.200.5":    {"1.2.840.10008.5.1.4.1.1.200.5", "Protocol Approval Information Model - MOVE", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.1.1.200.6":    {"1.2.840.10008.5.1.4.1.1.200.6", "Protocol Approval Information Model - GET", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.1.1.481.1":    {"1.2.840.10008.5.1.4.1.1.481.1", "RT Image Storage", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.1.1.481.2":    {"1.2.840.10008.5.1.4.1.1.481.2", "RT Dose Storage", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.1.1.481.3":    {"1.2.840.10008.5.1.4.1.1.481.3", "RT Structure Set Storage", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.1.1.481.4":    {"1.2.840.10008.5.1.4.1.1.481.4", "RT Beams Treatment Record Storage", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.1.1.481.5":    {"1.2.840.10008.5.1.4.1.1.481.5", "RT Plan Storage", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.1.1.481.6":    {"1.2.840.10008.5.1.4.1.1.481.6", "RT Brachy Treatment Record Storage", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.1.1.481.7":    {"1.2.840.10008.5.1.4.1.1.481.7", "RT Treatment Summary Record Storage", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.1.1.481.8":    {"1.2.840.10008.5.1.4.1.1.481.8", "RT Ion Plan Storage", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.1.1.481.9":    {"1.2.840.10008.5.1.4.1.1.481.9", "RT Ion Beams Treatment Record Storage", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.1.1.501.1":    {"1.2.840.10008.5.1.4.1.1.501.1", "DICOS CT Image Storage", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.1.1.501.2.1":  {"1.2.840.10008.5.1.4.1.1.501.2.1", "DICOS Digital X-Ray Image Storage - For Presentation", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.1.1.501.2.2":  {"1.2.840.10008.5.1.4.1.1.501.2.2", "DICOS Digital X-Ray Image Storage - For Processing", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.1.1.501.3":    {"1.2.840.10008.5.1.4.1.1.501.3", "DICOS Threat Detection Report Storage", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.1.1.501.4":    {"1.2.840.10008.5.1.4.1.1.501.4", "DICOS 2D AIT Storage", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.1.1.501.5":    {"1.2.840.10008.5.1.4.1.1.501.5", "DICOS 3D AIT Storage", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.1.1.501.6":    {"1.2.840.10008.5.1.4.1.1.501.6", "DICOS Quadrupole Resonance (QR) Storage", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.1.1.601.1":    {"1.2.840.10008.5.1.4.1.1.601.1", "Eddy Current Image Storage", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.1.1.601.2":    {"1.2.840.10008.5.1.4.1.1.601.2", "Eddy Current Multi-frame Image Storage", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.1.2.1.1":      {"1.2.840.10008.5.1.4.1.2.1.1", "Patient Root Query/Retrieve Information Model - FIND", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.1.2.1.2":      {"1.2.840.10008.5.1.4.1.2.1.2", "Patient Root Query/Retrieve Information Model - MOVE", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.1.2.1.3":      {"1.2.840.10008.5.1.4.1.2.1.3", "Patient Root Query/Retrieve Information Model - GET", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.1.2.2.1":      {"1.2.840.10008.5.1.4.1.2.2.1", "Study Root Query/Retrieve Information Model - FIND", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.1.2.2.2":      {"1.2.840.10008.5.1.4.1.2.2.2", "Study Root Query/Retrieve Information Model - MOVE", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.1.2.2.3":      {"1.2.840.10008.5.1.4.1.2.2.3", "Study Root Query/Retrieve Information Model - GET", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.1.2.3.1":      {"1.2.840.10008.5.1.4.1.2.3.1", "Patient/Study Only Query/Retrieve Information Model - FIND", TypeSOPClass, "", "Retired"},
-	"1.2.840.10008.5.1.4.1.2.3.2":      {"1.2.840.10008.5.1.4.1.2.3.2", "Patient/Study Only Query/Retrieve Information Model - MOVE", TypeSOPClass, "", "Retired"},
-	"1.2.840.10008.5.1.4.1.2.3.3":      {"1.2.840.10008.5.1.4.1.2.3.3", "Patient/Study Only Query/Retrieve Information Model - GET", TypeSOPClass, "", "Retired"},
-	"1.2.840.10008.5.1.4.1.2.4.2":      {"1.2.840.10008.5.1.4.1.2.4.2", "Composite Instance Root Retrieve - MOVE", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.1.2.4.3":      {"1.2.840.10008.5.1.4.1.2.4.3", "Composite Instance Root Retrieve - GET", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.1.2.5.3":      {"1.2.840.10008.5.1.4.1.2.5.3", "Composite Instance Retrieve Without Bulk Data - GET", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.20.1":         {"1.2.840.10008.5.1.4.20.1", "Defined Procedure Protocol Information Model - FIND", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.20.2":         {"1.2.840.10008.5.1.4.20.2", "Defined Procedure Protocol Information Model - MOVE", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.20.3":         {"1.2.840.10008.5.1.4.20.3", "Defined Procedure Protocol Information Model - GET", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.31":           {"1.2.840.10008.5.1.4.31", "Modality Worklist Information Model - FIND", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.32":           {"1.2.840.10008.5.1.4.32", "General Purpose Worklist Management Meta SOP Class", "Meta SOP Class", "", "Retired"},
-	"1.2.840.10008.5.1.4.32.1":         {"1.2.840.10008.5.1.4.32.1", "General Purpose Worklist Information Model - FIND", TypeSOPClass, "", "Retired"},
-	"1.2.840.10008.5.1.4.32.2":         {"1.2.840.10008.5.1.4.32.2", "General Purpose Scheduled Procedure Step SOP Class", TypeSOPClass, "", "Retired"},
-	"1.2.840.10008.5.1.4.32.3":         {"1.2.840.10008.5.1.4.32.3", "General Purpose Performed Procedure Step SOP Class", TypeSOPClass, "", "Retired"},
-	"1.2.840.10008.5.1.4.33":           {"1.2.840.10008.5.1.4.33", "Instance Availability Notification SOP Class", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.34.1":         {"1.2.840.10008.5.1.4.34.1", "RT Beams Delivery Instruction Storage - Trial", TypeSOPClass, "", "Retired"},
-	"1.2.840.10008.5.1.4.34.2":         {"1.2.840.10008.5.1.4.34.2", "RT Conventional Machine Verification - Trial", TypeSOPClass, "", "Retired"},
-	"1.2.840.10008.5.1.4.34.3":         {"1.2.840.10008.5.1.4.34.3", "RT Ion Machine Verification - Trial", TypeSOPClass, "", "Retired"},
-	"1.2.840.10008.5.1.4.34.4":         {"1.2.840.10008.5.1.4.34.4", "Unified Worklist and Procedure Step Service Class - Trial", "Service Class", "", "Retired"},
-	"1.2.840.10008.5.1.4.34.4.1":       {"1.2.840.10008.5.1.4.34.4.1", "Unified Procedure Step - Push SOP Class - Trial", TypeSOPClass, "", "Retired"},
-	"1.2.840.10008.5.1.4.34.4.2":       {"1.2.840.10008.5.1.4.34.4.2", "Unified Procedure Step - Watch SOP Class - Trial", TypeSOPClass, "", "Retired"},
-	"1.2.840.10008.5.1.4.34.4.3":       {"1.2.840.10008.5.1.4.34.4.3", "Unified Procedure Step - Pull SOP Class - Trial", TypeSOPClass, "", "Retired"},
-	"1.2.840.10008.5.1.4.34.4.4":       {"1.2.840.10008.5.1.4.34.4.4", "Unified Procedure Step - Event SOP Class - Trial", TypeSOPClass, "", "Retired"},
-	"1.2.840.10008.5.1.4.34.5":         {"1.2.840.10008.5.1.4.34.5", "UPS Global Subscription SOP Instance", TypeWellKnownSOPInstance, "", ""},
-	"1.2.840.10008.5.1.4.34.5.1":       {"1.2.840.10008.5.1.4.34.5.1", "UPS Filtered Global Subscription SOP Instance", TypeWellKnownSOPInstance, "", ""},
-	"1.2.840.10008.5.1.4.34.6":         {"1.2.840.10008.5.1.4.34.6", "Unified Worklist and Procedure Step Service Class", "Service Class", "", ""},
-	"1.2.840.10008.5.1.4.34.6.1":       {"1.2.840.10008.5.1.4.34.6.1", "Unified Procedure Step - Push SOP Class", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.34.6.2":       {"1.2.840.10008.5.1.4.34.6.2", "Unified Procedure Step - Watch SOP Class", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.34.6.3":       {"1.2.840.10008.5.1.4.34.6.3", "Unified Procedure Step - Pull SOP Class", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.34.6.4":       {"1.2.840.10008.5.1.4.34.6.4", "Unified Procedure Step - Event SOP Class", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.34.7":         {"1.2.840.10008.5.1.4.34.7", "RT Beams Delivery Instruction Storage", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.34.8":         {"1.2.840.10008.5.1.4.34.8", "RT Conventional Machine Verification", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.34.9":         {"1.2.840.10008.5.1.4.34.9", "RT Ion Machine Verification", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.34.10":        {"1.2.840.10008.5.1.4.34.10", "RT Brachy Application Setup Delivery Instruction Storage", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.37.1":         {"1.2.840.10008.5.1.4.37.1", "General Relevant Patient Information Query", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.37.2":         {"1.2.840.10008.5.1.4.37.2", "Breast Imaging Relevant Patient Information Query", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.37.3":         {"1.2.840.10008.5.1.4.37.3", "Cardiac Relevant Patient Information Query", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.38.1":         {"1.2.840.10008.5.1.4.38.1", "Hanging Protocol Storage", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.38.2":         {"1.2.840.10008.5.1.4.38.2", "Hanging Protocol Information Model - FIND", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.38.3":         {"1.2.840.10008.5.1.4.38.3", "Hanging Protocol Information Model - MOVE", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.38.4":         {"1.2.840.10008.5.1.4.38.4", "Hanging Protocol Information Model - GET", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.39.1":         {"1.2.840.10008.5.1.4.39.1", "Color Palette Storage", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.39.2":         {"1.2.840.10008.5.1.4.39.2", "Color Palette Query/Retrieve Information Model - FIND", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.39.3":         {"1.2.840.10008.5.1.4.39.3", "Color Palette Query/Retrieve Information Model - MOVE", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.39.4":         {"1.2.840.10008.5.1.4.39.4", "Color Palette Query/Retrieve Information Model - GET", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.41":           {"1.2.840.10008.5.1.4.41", "Product Characteristics Query SOP Class", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.42":           {"1.2.840.10008.5.1.4.42", "Substance Approval Query SOP Class", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.43.1":         {"1.2.840.10008.5.1.4.43.1", "Generic Implant Template Storage", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.43.2":         {"1.2.840.10008.5.1.4.43.2", "Generic Implant Template Information Model - FIND", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.43.3":         {"1.2.840.10008.5.1.4.43.3", "Generic Implant Template Information Model - MOVE", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.43.4":         {"1.2.840.10008.5.1.4.43.4", "Generic Implant Template Information Model - GET", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.44.1":         {"1.2.840.10008.5.1.4.44.1", "Implant Assembly Template Storage", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.44.2":         {"1.2.840.10008.5.1.4.44.2", "Implant Assembly Template Information Model - FIND", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.44.3":         {"1.2.840.10008.5.1.4.44.3", "Implant Assembly Template Information Model - MOVE", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.44.4":         {"1.2.840.10008.5.1.4.44.4", "Implant Assembly Template Information Model - GET", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.45.1":         {"1.2.840.10008.5.1.4.45.1", "Implant Template Group Storage", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.45.2":         {"1.2.840.10008.5.1.4.45.2", "Implant Template Group Information Model - FIND", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.45.3":         {"1.2.840.10008.5.1.4.45.3", "Implant Template Group Information Model - MOVE", TypeSOPClass, "", ""},
-	"1.2.840.10008.5.1.4.45.4":         {"1.2.840.10008.5.1.4.45.4", "Implant Template Group Information Model - GET", TypeSOPClass, "", ""},
-	"1.2.840.10008.7.1.1":              {"1.2.840.10008.7.1.1", "NativeData DICOM Model", "Application Hosting Model", "", ""},
-	"1.2.840.10008.7.1.2":              {"1.2.840.10008.7.1.2", "Abstract Multi-Dimensional Image Model", "Application Hosting Model", "", ""},
-	"1.2.840.10008.8.1.1":              {"1.2.840.10008.8.1.1", "DICOM Content Mapping Resource", "Mapping Resource", "", ""},
-	"1.2.840.10008.15.0.3.1":           {"1.2.840.10008.15.0.3.1", "dicomDeviceName", "LDAP OID", "", ""},
-	"1.2.840.10008.15.0.3.2":           {"1.2.840.10008.15.0.3.2", "dicomDescription", "LDAP OID", "", ""},
-	"1.2.840.10008.15.0.3.3":           {"1.2.840.10008.15.0.3.3", "dicomManufacturer", "LDAP OID", "", ""},
-	"1.2.840.10008.15.0.3.4":           {"1.2.840.10008.15.0.3.4", "dicomManufacturerModelName", "LDAP OID", "", ""},
-	"1.2.840.10008.15.0.3.5":           {"1.2.840.10008.15.0.3.5", "dicomSoftwareVersion", "LDAP OID", "", ""},
-	"1.2.840.10008.15.0.3.6":           {"1.2.840.10008.15.0.3.6", "dicomVendorData", "LDAP OID", "", ""},
-	"1.2.840.10008.15.0.3.7":           {"1.2.840.10008.15.0.3.7", "dicomAETitle", "LDAP OID", "", ""},
-	"1.2.840.10008.15.0.3.8":           {"1.2.840.10008.15.0.3.8", "dicomNetworkConnectionReference", "LDAP OID", "", ""},
-	"1.2.840.10008.15.0.3.9":           {"1.2.840.10008.15.0.3.9", "dicomApplicationCluster", "LDAP OID", "", ""},
-	"1.2.840.10008.15.0.3.10":          {"1.2.840.10008.15.0.3.10", "dicomAssociationInitiator", "LDAP OID", "", ""},
-	"1.2.840.10008.15.0.3.11":          {"1.2.840.10008.15.0.3.11", "dicomAssociationAcceptor", "LDAP OID", "", ""},
-	"1.2.840.10008.15.0.3.12":          {"1.2.840.10008.15.0.3.12", "dicomHostname", "LDAP OID", "", ""},
-	"1.2.840.10008.15.0.3.13":          {"1.2.840.10008.15.0.3.13", "dicomPort", "LDAP OID", "", ""},
-	"1.2.840.10008.15.0.3.14":          {"1.2.840.10008.15.0.3.14", "dicomSOPClass", "LDAP OID", "", ""},
-	"1.2.840.10008.15.0.3.15":          {"1.2.840.10008.15.0.3.15", "dicomTransferRole", "LDAP OID", "", ""},
-	"1.2.840.10008.15.0.3.16":          {"1.2.840.10008.15.0.3.16", "dicomTransferSyntax", "LDAP OID", "", ""},
-	"1.2.840.10008.15.0.3.17":          {"1.2.840.10008.15.0.3.17", "dicomPrimaryDeviceType", "LDAP OID", "", ""},
-	"1.2.840.10008.15.0.3.18":          {"1.2.840.10008.15.0.3.18", "dicomRelatedDeviceReference", "LDAP OID", "", ""},
-	"1.2.840.10008.15.0.3.19":          {"1.2.840.10008.15.0.3.19", "dicomPreferredCalledAETitle", "LDAP OID", "", ""},
-	"1.2.840.10008.15.0.3.20":          {"1.2.840.10008.15.0.3.20", "dicomTLSCyphersuite", "LDAP OID", "", ""},
-	"1.2.840.10008.15.0.3.21":          {"1.2.840.10008.15.0.3.21", "dicomAuthorizedNodeCertificateReference", "LDAP OID", "", ""},
-	"1.2.840.10008.15.0.3.22":          {"1.2.840.10008.15.0.3.22", "dicomThisNodeCertificateReference", "LDAP OID", "", ""},
-	"1.2.840.10008.15.0.3.23":          {"1.2.840.10008.15.0.3.23", "dicomInstalled", "LDAP OID", "", ""},
-	"1.2.840.10008.15.0.3.24":          {"1.2.840.10008.15.0.3.24", "dicomStationName", "LDAP OID", "", ""},
-	"1.2.840.10008.15.0.3.25":          {"1.2.840.10008.15.0.3.25", "dicomDeviceSerialNumber", "LDAP OID", "", ""},
-	"1.2.840.10008.15.0.3.26":          {"1.2.840.10008.15.0.3.26", "dicomInstitutionName", "LDAP OID", "", ""},
-	"1.2.840.10008.15.0.3.27":          {"1.2.840.10008.15.0.3.27", "dicomInstitutionAddress", "LDAP OID", "", ""},
-	"1.2.840.10008.15.0.3.28":          {"1.2.840.10008.15.0.3.28", "dicomInstitutionDepartmentName", "LDAP OID", "", ""},
-	"1.2.840.10008.15.0.3.29":          {"1.2.840.10008.15.0.3.29", "dicomIssuerOfPatientID", "LDAP OID", "", ""},
-	"1.2.840.10008.15.0.3.30":          {"1.2.840.10008.15.0.3.30", "dicomPreferredCallingAETitle", "LDAP OID", "", ""},
-	"1.2.840.10008.15.0.3.31":          {"1.2.840.10008.15.0.3.31", "dicomSupportedCharacterSet", "LDAP OID", "", ""},
-	"1.2.840.10008.15.0.4.1":           {"1.2.840.10008.15.0.4.1", "dicomConfigurationRoot", "LDAP OID", "", ""},
-	"1.2.840.10008.15.0.4.2":           {"1.2.840.10008.15.0.4.2", "dicomDevicesRoot", "LDAP OID", "", ""},
-	"1.2.840.10008.15.0.4.3":           {"1.2.840.10008.15.0.4.3", "dicomUniqueAETitlesRegistryRoot", "LDAP OID", "", ""},
-	"1.2.840.10008.15.0.4.4":           {"1.2.840.10008.15.0.4.4", "dicomDevice", "LDAP OID", "", ""},
-	"1.2.840.10008.15.0.4.5":           {"1.2.840.10008.15.0.4.5", "dicomNetworkAE", "LDAP OID", "", ""},
-	"1.2.840.10008.15.0.4.6":           {"1.2.840.10008.15.0.4.6", "dicomNetworkConnection", "LDAP OID", "", ""},
-	"1.2.840.10008.15.0.4.7":           {"1.2.840.10008.15.0.4.7", "dicomUniqueAETitle", "LDAP OID", "", ""},
-	"1.2.840.10008.15.0.4.8":           {"1.2.840.10008.15.0.4.8", "dicomTransferCapability", "LDAP OID", "", ""},
-	"1.2.840.10008.15.1.1":             {"1.2.840.10008.15.1.1", "Universal Coordinated Time", "Synchronization Frame of Reference", "", ""},
-}
-
-func standardUID(uid string) string {
-	return MustLookup(uid).UID
-}
-
-// Lookup finds information about the given uid (string starting with 1.2.840).  Returns
-// an error unless uid is the one defined in the DICOM standard, P3.6.
-func Lookup(uid string) (Info, error) {
-	e, ok := uidMap[uid]
-	if !ok {
-		return Info{}, fmt.Errorf("UID '%s' not found in dictionary", uid)
-	}
-	return e, nil
-}
-
-// MustLookup is similar to LookupUID, but crashes the process on error.
-func MustLookup(uid string) Info {
-	e, err := Lookup(uid)
-	if err != nil {
-		panic(err)
-	}
-	return e
-}
-
-// UIDString returns a human-readable diagnostic string for a DICOM UID.
-func UIDString(uid string) string {
-	e, ok := uidMap[uid]
-	if !ok {
-		return fmt.Sprintf("%s[??]", uid)
-	}
-	return fmt.Sprintf("%s[%s]", uid, e.Name)
+	"1.2.840.10008.1.1":                {UID: "1.2.840.10008.1.1", Name: "Verification SOP Class", Type: TypeSOPClass},
+	"1.2.840.10008.1.2":                {UID: "1.2.840.10008.1.2", Name: "Implicit VR Little Endian", Type: TypeTransferSyntax, Part: "Default Transfer Syntax for DICOM"},
+	"1.2.840.10008.1.2.1":              {UID: "1.2.840.10008.1.2.1", Name: "Explicit VR Little Endian", Type: TypeTransferSyntax},
+	"1.2.840.10008.1.2.1.99":           {UID: "1.2.840.10008.1.2.1.99", Name: "Deflated Explicit VR Little Endian", Type: TypeTransferSyntax},
+	"1.2.840.10008.1.2.2":              {UID: "1.2.840.10008.1.2.2", Name: "Explicit VR Big Endian", Type: TypeTransferSyntax, Status: "Retired"},
+	"1.2.840.10008.1.2.4.50":           {UID: "1.2.840.10008.1.2.4.50", Name: "JPEG Baseline (Process 1)", Type: TypeTransferSyntax, Part: "Default Transfer Syntax for Lossy JPEG 8 Bit Image Compression"},
+	"1.2.840.10008.1.2.4.51":           {UID: "1.2.840.10008.1.2.4.51", Name: "JPEG Extended (Process 2 and 4)", Type: TypeTransferSyntax, Part: "Default Transfer Syntax for Lossy JPEG 12 Bit Image Compression (Process 4 only)"},
+	"1.2.840.10008.1.2.4.52":           {UID: "1.2.840.10008.1.2.4.52", Name: "JPEG Extended (Process 3 and 5)", Type: TypeTransferSyntax, Status: "Retired"},
+	"1.2.840.10008.1.2.4.53":           {UID: "1.2.840.10008.1.2.4.53", Name: "JPEG Spectral Selection, Non-Hierarchical (Process 6 and 8)", Type: TypeTransferSyntax, Status: "Retired"},
+	"1.2.840.10008.1.2.4.54":           {UID: "1.2.840.10008.1.2.4.54", Name: "JPEG Spectral Selection, Non-Hierarchical (Process 7 and 9)", Type: TypeTransferSyntax, Status: "Retired"},
+	"1.2.840.10008.1.2.4.55":           {UID: "1.2.840.10008.1.2.4.55", Name: "JPEG Full Progression, Non-Hierarchical (Process 10 and 12)", Type: TypeTransferSyntax, Status: "Retired"},
+	"1.2.840.10008.1.2.4.56":           {UID: "1.2.840.10008.1.2.4.56", Name: "JPEG Full Progression, Non-Hierarchical (Process 11 and 13)", Type: TypeTransferSyntax, Status: "Retired"},
+	"1.2.840.10008.1.2.4.57":           {UID: "1.2.840.10008.1.2.4.57", Name: "JPEG Lossless, Non-Hierarchical (Process 14)", Type: TypeTransferSyntax},
+	"1.2.840.10008.1.2.4.58":           {UID: "1.2.840.10008.1.2.4.58", Name: "JPEG Lossless, Non-Hierarchical (Process 15)", Type: TypeTransferSyntax, Status: "Retired"},
+	"1.2.840.10008.1.2.4.59":           {UID: "1.2.840.10008.1.2.4.59", Name: "JPEG Extended, Hierarchical (Process 16 and 18)", Type: TypeTransferSyntax, Status: "Retired"},
+	"1.2.840.10008.1.2.4.60":           {UID: "1.2.840.10008.1.2.4.60", Name: "JPEG Extended, Hierarchical (Process 17 and 19)", Type: TypeTransferSyntax, Status: "Retired"},
+	"1.2.840.10008.1.2.4.61":           {UID: "1.2.840.10008.1.2.4.61", Name: "JPEG Spectral Selection, Hierarchical (Process 20 and 22)", Type: TypeTransferSyntax, Status: "Retired"},
+	"1.2.840.10008.1.2.4.62":           {UID: "1.2.840.10008.1.2.4.62", Name: "JPEG Spectral Selection, Hierarchical (Process 21 and 23)", Type: TypeTransferSyntax, Status: "Retired"},
+	"1.2.840.10008.1.2.4.63":           {UID: "1.2.840.10008.1.2.4.63", Name: "JPEG Full Progression, Hierarchical (Process 24 and 26)", Type: TypeTransferSyntax, Status: "Retired"},
+	"1.2.840.10008.1.2.4.64":           {UID: "1.2.840.10008.1.2.4.64", Name: "JPEG Full Progression, Hierarchical (Process 25 and 27)", Type: TypeTransferSyntax, Status: "Retired"},
+	"1.2.840.10008.1.2.4.65":           {UID: "1.2.840.10008.1.2.4.65", Name: "JPEG Lossless, Hierarchical (Process 28)", Type: TypeTransferSyntax, Status: "Retired"},
+	"1.2.840.10008.1.2.4.66":           {UID: "1.2.840.10008.1.2.4.66", Name: "JPEG Lossless, Hierarchical (Process 29)", Type: TypeTransferSyntax, Status: "Retired"},
+	"1.2.840.10008.1.2.4.70":           {UID: "1.2.840.10008.1.2.4.70", Name: "JPEG Lossless, Non-Hierarchical, First-Order Prediction (Process 14 [Selection Value 1])", Type: TypeTransferSyntax, Part: "Default Transfer Syntax for Lossless JPEG Image Compression"},
+	"1.2.840.10008.1.2.4.80":           {UID: "1.2.840.10008.1.2.4.80", Name: "JPEG-LS Lossless Image Compression", Type: TypeTransferSyntax},
+	"1.2.840.10008.1.2.4.81":           {UID: "1.2.840.10008.1.2.4.81", Name: "JPEG-LS Lossy (Near-Lossless) Image Compression", Type: TypeTransferSyntax},
+	"1.2.840.10008.1.2.4.90":           {UID: "1.2.840.10008.1.2.4.90", Name: "JPEG 2000 Image Compression (Lossless Only)", Type: TypeTransferSyntax},
+	"1.2.840.10008.1.2.4.91":           {UID: "1.2.840.10008.1.2.4.91", Name: "JPEG 2000 Image Compression", Type: TypeTransferSyntax},
+	"1.2.840.10008.1.2.4.92":           {UID: "1.2.840.10008.1.2.4.92", Name: "JPEG 2000 Part 2 Multi-component Image Compression (Lossless Only)", Type: TypeTransferSyntax},
+	"1.2.840.10008.1.2.4.93":           {UID: "1.2.840.10008.1.2.4.93", Name: "JPEG 2000 Part 2 Multi-component Image Compression", Type: TypeTransferSyntax},
+	"1.2.840.10008.1.2.4.94":           {UID: "1.2.840.10008.1.2.4.94", Name: "JPIP Referenced", Type: TypeTransferSyntax},
+	"1.2.840.10008.1.2.4.95":           {UID: "1.2.840.10008.1.2.4.95", Name: "JPIP Referenced Deflate", Type: TypeTransferSyntax},
+	"1.2.840.10008.1.2.4.100":          {UID: "1.2.840.10008.1.2.4.100", Name: "MPEG2 Main Profile / Main Level", Type: TypeTransferSyntax},
+	"1.2.840.10008.1.2.4.101":          {UID: "1.2.840.10008.1.2.4.101", Name: "MPEG2 Main Profile / High Level", Type: TypeTransferSyntax},
+	"1.2.840.10008.1.2.4.102":          {UID: "1.2.840.10008.1.2.4.102", Name: "MPEG-4 AVC/H.264 High Profile / Level 4.1", Type: TypeTransferSyntax},
+	"1.2.840.10008.1.2.4.103":          {UID: "1.2.840.10008.1.2.4.103", Name: "MPEG-4 AVC/H.264 BD-compatible High Profile / Level 4.1", Type: TypeTransferSyntax},
+	"1.2.840.10008.1.2.4.104":          {UID: "1.2.840.10008.1.2.4.104", Name: "MPEG-4 AVC/H.264 High Profile / Level 4.2 For 2D Video", Type: TypeTransferSyntax},
+	"1.2.840.10008.1.2.4.105":          {UID: "1.2.840.10008.1.2.4.105", Name: "MPEG-4 AVC/H.264 High Profile / Level 4.2 For 3D Video", Type: TypeTransferSyntax},
+	"1.2.840.10008.1.2.4.106":          {UID: "1.2.840.10008.1.2.4.106", Name: "MPEG-4 AVC/H.264 Stereo High Profile / Level 4.2", Type: TypeTransferSyntax},
+	"1.2.840.10008.1.2.4.107":          {UID: "1.2.840.10008.1.2.4.107", Name: "HEVC/H.265 Main Profile / Level 5.1", Type: TypeTransferSyntax},
+	"1.2.840.10008.1.2.4.108":          {UID: "1.2.840.10008.1.2.4.108", Name: "HEVC/H.265 Main 10 Profile / Level 5.1", Type: TypeTransferSyntax},
+	"1.2.840.10008.1.2.5":              {UID: "1.2.840.10008.1.2.5", Name: "RLE Lossless", Type: TypeTransferSyntax},
+	"1.2.840.10008.1.2.6.1":            {UID: "1.2.840.10008.1.2.6.1", Name: "RFC 2557 MIME encapsulation", Type: TypeTransferSyntax},
+	"1.2.840.10008.1.2.6.2":            {UID: "1.2.840.10008.1.2.6.2", Name: "XML Encoding", Type: TypeTransferSyntax},
+	"1.2.840.10008.1.3.10":             {UID: "1.2.840.10008.1.3.10", Name: "Media Storage Directory Storage", Type: TypeSOPClass},
+	"1.2.840.10008.1.4.1.1":            {UID: "1.2.840.10008.1.4.1.1", Name: "Talairach Brain Atlas Frame of Reference", Type: TypeWellKnownFrameOfReference},
+	"1.2.840.10008.1.4.1.2":            {UID: "1.2.840.10008.1.4.1.2", Name: "SPM2 T1 Frame of Reference", Type: TypeWellKnownFrameOfReference},
+	"1.2.840.10008.1.4.1.3":            {UID: "1.2.840.10008.1.4.1.3", Name: "SPM2 T2 Frame of Reference", Type: TypeWellKnownFrameOfReference},
+	"1.2.840.10008.1.4.1.4":            {UID: "1.2.840.10008.1.4.1.4", Name: "SPM2 PD Frame of Reference", Type: TypeWellKnownFrameOfReference},
+	"1.2.840.10008.1.4.1.5":            {UID: "1.2.840.10008.1.4.1.5", Name: "SPM2 EPI Frame of Reference", Type: TypeWellKnownFrameOfReference},
+	"1.2.840.10008.1.4.1.6":            {UID: "1.2.840.10008.1.4.1.6", Name: "SPM2 FIL T1 Frame of Reference", Type: TypeWellKnownFrameOfReference},
+	"1.2.840.10008.1.4.1.7":            {UID: "1.2.840.10008.1.4.1.7", Name: "SPM2 PET Frame of Reference", Type: TypeWellKnownFrameOfReference},
+	"1.2.840.10008.1.4.1.8":            {UID: "1.2.840.10008.1.4.1.8", Name: "SPM2 TRANSM Frame of Reference", Type: TypeWellKnownFrameOfReference},
+	"1.2.840.10008.1.4.1.9":            {UID: "1.2.840.10008.1.4.1.9", Name: "SPM2 SPECT Frame of Reference", Type: TypeWellKnownFrameOfReference},
+	"1.2.840.10008.1.4.1.10":           {UID: "1.2.840.10008.1.4.1.10", Name: "SPM2 GRAY Frame of Reference", Type: TypeWellKnownFrameOfReference},
+	"1.2.840.10008.1.4.1.11":           {UID: "1.2.840.10008.1.4.1.11", Name: "SPM2 WHITE Frame of Reference", Type: TypeWellKnownFrameOfReference},
+	"1.2.840.10008.1.4.1.12":           {UID: "1.2.840.10008.1.4.1.12", Name: "SPM2 CSF Frame of Reference", Type: TypeWellKnownFrameOfReference},
+	"1.2.840.10008.1.4.1.13":           {UID: "1.2.840.10008.1.4.1.13", Name: "SPM2 BRAINMASK Frame of Reference", Type: TypeWellKnownFrameOfReference},
+	"1.2.840.10008.1.4.1.14":           {UID: "1.2.840.10008.1.4.1.14", Name: "SPM2 AVG305T1 Frame of Reference", Type: TypeWellKnownFrameOfReference},
+	"1.2.840.10008.1.4.1.15":           {UID: "1.2.840.10008.1.4.1.15", Name: "SPM2 AVG152T1 Frame of Reference", Type: TypeWellKnownFrameOfReference},
+	"1.2.840.10008.1.4.1.16":           {UID: "1.2.840.10008.1.4.1.16", Name: "SPM2 AVG152T2 Frame of Reference", Type: TypeWellKnownFrameOfReference},
+	"1.2.840.10008.1.4.1.17":           {UID: "1.2.840.10008.1.4.1.17", Name: "SPM2 AVG152PD Frame of Reference", Type: TypeWellKnownFrameOfReference},
+	"1.2.840.10008.1.4.1.18":           {UID: "1.2.840.10008.1.4.1.18", Name: "SPM2 SINGLESUBJT1 Frame of Reference", Type: TypeWellKnownFrameOfReference},
+	"1.2.840.10008.1.4.2.1":            {UID: "1.2.840.10008.1.4.2.1", Name: "ICBM 452 T1 Frame of Reference", Type: TypeWellKnownFrameOfReference},
+	"1.2.840.10008.1.4.2.2":            {UID: "1.2.840.10008.1.4.2.2", Name: "ICBM Single Subject MRI Frame of Reference", Type: TypeWellKnownFrameOfReference},
+	"1.2.840.10008.1.5.1":              {UID: "1.2.840.10008.1.5.1", Name: "Hot Iron Color Palette SOP Instance", Type: TypeWellKnownSOPInstance},
+	"1.2.840.10008.1.5.2":              {UID: "1.2.840.10008.1.5.2", Name: "PET Color Palette SOP Instance", Type: TypeWellKnownSOPInstance},
+	"1.2.840.10008.1.5.3":              {UID: "1.2.840.10008.1.5.3", Name: "Hot Metal Blue Color Palette SOP Instance", Type: TypeWellKnownSOPInstance},
+	"1.2.840.10008.1.5.4":              {UID: "1.2.840.10008.1.5.4", Name: "PET 20 Step Color Palette SOP Instance", Type: TypeWellKnownSOPInstance},
+	"1.2.840.10008.1.5.5":              {UID: "1.2.840.10008.1.5.5", Name: "Spring Color Palette SOP Instance", Type: TypeWellKnownSOPInstance},
+	"1.2.840.10008.1.5.6":              {UID: "1.2.840.10008.1.5.6", Name: "Summer Color Palette SOP Instance", Type: TypeWellKnownSOPInstance},
+	"1.2.840.10008.1.5.7":              {UID: "1.2.840.10008.1.5.7", Name: "Fall Color Palette SOP Instance", Type: TypeWellKnownSOPInstance},
+	"1.2.840.10008.1.5.8":              {UID: "1.2.840.10008.1.5.8", Name: "Winter Color Palette SOP Instance", Type: TypeWellKnownSOPInstance},
+	"1.2.840.10008.1.9":                {UID: "1.2.840.10008.1.9", Name: "Basic Study Content Notification SOP Class", Type: TypeSOPClass, Status: "Retired"},
+	"1.2.840.10008.1.20":               {UID: "1.2.840.10008.1.20", Name: "Papyrus 3 Implicit VR Little Endian", Type: TypeTransferSyntax, Status: "Retired"},
+	"1.2.840.10008.1.20.1":             {UID: "1.2.840.10008.1.20.1", Name: "Storage Commitment Push Model SOP Class", Type: TypeSOPClass},
+	"1.2.840.10008.1.20.1.1":           {UID: "1.2.840.10008.1.20.1.1", Name: "Storage Commitment Push Model SOP Instance", Type: TypeWellKnownSOPInstance},
+	"1.2.840.10008.1.20.2":             {UID: "1.2.840.10008.1.20.2", Name: "Storage Commitment Pull Model SOP Class", Type: TypeSOPClass, Status: "Retired"},
+	"1.2.840.10008.1.20.2.1":           {UID: "1.2.840.10008.1.20.2.1", Name: "Storage Commitment Pull Model SOP Instance", Type: TypeWellKnownSOPInstance, Status: "Retired"},
+	"1.2.840.10008.1.40":               {UID: "1.2.840.10008.1.40", Name: "Procedural Event Logging SOP Class", Type: TypeSOPClass},
+	"1.2.840.10008.1.40.1":             {UID: "1.2.840.10008.1.40.1", Name: "Procedural Event Logging SOP Instance", Type: TypeWellKnownSOPInstance},
+	"1.2.840.10008.1.42":               {UID: "1.2.840.10008.1.42", Name: "Substance Administration Logging SOP Class", Type: TypeSOPClass},
+	"1.2.840.10008.1.42.1":             {UID: "1.2.840.10008.1.42.1", Name: "Substance Administration Logging SOP Instance", Type: TypeWellKnownSOPInstance},
+	"1.2.840.10008.2.6.1":              {UID: "1.2.840.10008.2.6.1", Name: "DICOM UID Registry", Type: "DICOM UIDs as Coding Scheme"},
+	"1.2.840.10008.2.16.4":             {UID: "1.2.840.10008.2.16.4", Name: "DICOM Controlled Terminology", Type: TypeCodingScheme},
+	"1.2.840.10008.2.16.5":             {UID: "1.2.840.10008.2.16.5", Name: "Adult Mouse Anatomy Ontology", Type: TypeCodingScheme},
+	"1.2.840.10008.2.16.6":             {UID: "1.2.840.10008.2.16.6", Name: "Uberon Ontology", Type: TypeCodingScheme},
+	"1.2.840.10008.2.16.7":             {UID: "1.2.840.10008.2.16.7", Name: "Integrated Taxonomic Information System (ITIS) Taxonomic Serial Number (TSN)", Type: TypeCodingScheme},
+	"1.2.840.10008.2.16.8":             {UID: "1.2.840.10008.2.16.8", Name: "Mouse Genome Initiative (MGI)", Type: TypeCodingScheme},
+	"1.2.840.10008.2.16.9":             {UID: "1.2.840.10008.2.16.9", Name: "PubChem Compound CID", Type: TypeCodingScheme},
+	"1.2.840.10008.3.1.1.1":            {UID: "1.2.840.10008.3.1.1.1", Name: "DICOM Application Context Name", Type: "Application Context Name"},
+	"1.2.840.10008.3.1.2.1.1":          {UID: "1.2.840.10008.3.1.2.1.1", Name: "Detached Patient Management SOP Class", Type: TypeSOPClass, Status: "Retired"},
+	"1.2.840.10008.3.1.2.1.4":          {UID: "1.2.840.10008.3.1.2.1.4", Name: "Detached Patient Management Meta SOP Class", Type: "Meta SOP Class", Status: "Retired"},
+	"1.2.840.10008.3.1.2.2.1":          {UID: "1.2.840.10008.3.1.2.2.1", Name: "Detached Visit Management SOP Class", Type: TypeSOPClass, Status: "Retired"},
+	"1.2.840.10008.3.1.2.3.1":          {UID: "1.2.840.10008.3.1.2.3.1", Name: "Detached Study Management SOP Class", Type: TypeSOPClass, Status: "Retired"},
+	"1.2.840.10008.3.1.2.3.2":          {UID: "1.2.840.10008.3.1.2.3.2", Name: "Study Component Management SOP Class", Type: TypeSOPClass, Status: "Retired"},
+	"1.2.840.10008.3.1.2.3.3":          {UID: "1.2.840.10008.3.1.2.3.3", Name: "Modality Performed Procedure Step SOP Class", Type: TypeSOPClass},
+	"1.2.840.10008.3.1.2.3.4":          {UID: "1.2.840.10008.3.1.2.3.4", Name: "Modality Performed Procedure Step Retrieve SOP Class", Type: TypeSOPClass},
+	"1.2.840.10008.3.1.2.3.5":          {UID: "1.2.840.10008.3.1.2.3.5", Name: "Modality Performed Procedure Step Notification SOP Class", Type: TypeSOPClass},
+	"1.2.840.10008.3.1.2.5.1":          {UID: "1.2.840.10008.3.1.2.5.1", Name: "Detached Results Management SOP Class", Type: TypeSOPClass, Status: "Retired"},
+	"1.2.840.10008.3.1.2.5.4":          {UID: "1.2.840.10008.3.1.2.5.4", Name: "Detached Results Management Meta SOP Class", Type: "Meta SOP Class", Status: "Retired"},
+	"1.2.840.10008.3.1.2.5.5":          {UID: "1.2.840.10008.3.1.2.5.5", Name: "Detached Study Management Meta SOP Class", Type: "Meta SOP Class", Status: "Retired"},
+	"1.2.840.10008.3.1.2.6.1":          {UID: "1.2.840.10008.3.1.2.6.1", Name: "Detached Interpretation Management SOP Class", Type: TypeSOPClass, Status: "Retired"},
+	"1.2.840.10008.4.2":                {UID: "1.2.840.10008.4.2", Name: "Storage Service Class", Type: "Service Class"},
+	"1.2.840.10008.5.1.1.1":            {UID: "1.2.840.10008.5.1.1.1", Name: "Basic Film Session SOP Class", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.1.2":            {UID: "1.2.840.10008.5.1.1.2", Name: "Basic Film Box SOP Class", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.1.4":            {UID: "1.2.840.10008.5.1.1.4", Name: "Basic Grayscale Image Box SOP Class", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.1.4.1":          {UID: "1.2.840.10008.5.1.1.4.1", Name: "Basic Color Image Box SOP Class", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.1.4.2":          {UID: "1.2.840.10008.5.1.1.4.2", Name: "Referenced Image Box SOP Class", Type: TypeSOPClass, Status: "Retired"},
+	"1.2.840.10008.5.1.1.9":            {UID: "1.2.840.10008.5.1.1.9", Name: "Basic Grayscale Print Management Meta SOP Class", Type: "Meta SOP Class"},
+	"1.2.840.10008.5.1.1.9.1":          {UID: "1.2.840.10008.5.1.1.9.1", Name: "Referenced Grayscale Print Management Meta SOP Class", Type: "Meta SOP Class", Status: "Retired"},
+	"1.2.840.10008.5.1.1.14":           {UID: "1.2.840.10008.5.1.1.14", Name: "Print Job SOP Class", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.1.15":           {UID: "1.2.840.10008.5.1.1.15", Name: "Basic Annotation Box SOP Class", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.1.16":           {UID: "1.2.840.10008.5.1.1.16", Name: "Printer SOP Class", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.1.16.376":       {UID: "1.2.840.10008.5.1.1.16.376", Name: "Printer Configuration Retrieval SOP Class", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.1.17":           {UID: "1.2.840.10008.5.1.1.17", Name: "Printer SOP Instance", Type: "Well-known Printer SOP Instance"},
+	"1.2.840.10008.5.1.1.17.376":       {UID: "1.2.840.10008.5.1.1.17.376", Name: "Printer Configuration Retrieval SOP Instance", Type: "Well-known Printer SOP Instance"},
+	"1.2.840.10008.5.1.1.18":           {UID: "1.2.840.10008.5.1.1.18", Name: "Basic Color Print Management Meta SOP Class", Type: "Meta SOP Class"},
+	"1.2.840.10008.5.1.1.18.1":         {UID: "1.2.840.10008.5.1.1.18.1", Name: "Referenced Color Print Management Meta SOP Class", Type: "Meta SOP Class", Status: "Retired"},
+	"1.2.840.10008.5.1.1.22":           {UID: "1.2.840.10008.5.1.1.22", Name: "VOI LUT Box SOP Class", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.1.23":           {UID: "1.2.840.10008.5.1.1.23", Name: "Presentation LUT SOP Class", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.1.24":           {UID: "1.2.840.10008.5.1.1.24", Name: "Image Overlay Box SOP Class", Type: TypeSOPClass, Status: "Retired"},
+	"1.2.840.10008.5.1.1.24.1":         {UID: "1.2.840.10008.5.1.1.24.1", Name: "Basic Print Image Overlay Box SOP Class", Type: TypeSOPClass, Status: "Retired"},
+	"1.2.840.10008.5.1.1.25":           {UID: "1.2.840.10008.5.1.1.25", Name: "Print Queue SOP Instance", Type: "Well-known Print Queue SOP Instance", Status: "Retired"},
+	"1.2.840.10008.5.1.1.26":           {UID: "1.2.840.10008.5.1.1.26", Name: "Print Queue Management SOP Class", Type: TypeSOPClass, Status: "Retired"},
+	"1.2.840.10008.5.1.1.27":           {UID: "1.2.840.10008.5.1.1.27", Name: "Stored Print Storage SOP Class", Type: TypeSOPClass, Status: "Retired"},
+	"1.2.840.10008.5.1.1.29":           {UID: "1.2.840.10008.5.1.1.29", Name: "Hardcopy Grayscale Image Storage SOP Class", Type: TypeSOPClass, Status: "Retired"},
+	"1.2.840.10008.5.1.1.30":           {UID: "1.2.840.10008.5.1.1.30", Name: "Hardcopy Color Image Storage SOP Class", Type: TypeSOPClass, Status: "Retired"},
+	"1.2.840.10008.5.1.1.31":           {UID: "1.2.840.10008.5.1.1.31", Name: "Pull Print Request SOP Class", Type: TypeSOPClass, Status: "Retired"},
+	"1.2.840.10008.5.1.1.32":           {UID: "1.2.840.10008.5.1.1.32", Name: "Pull Stored Print Management Meta SOP Class", Type: "Meta SOP Class", Status: "Retired"},
+	"1.2.840.10008.5.1.1.33":           {UID: "1.2.840.10008.5.1.1.33", Name: "Media Creation Management SOP Class UID", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.1.40":           {UID: "1.2.840.10008.5.1.1.40", Name: "Display System SOP Class", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.1.40.1":         {UID: "1.2.840.10008.5.1.1.40.1", Name: "Display System SOP Instance", Type: TypeWellKnownSOPInstance},
+	"1.2.840.10008.5.1.4.1.1.1":        {UID: "1.2.840.10008.5.1.4.1.1.1", Name: "Computed Radiography Image Storage", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.1.1.1.1":      {UID: "1.2.840.10008.5.1.4.1.1.1.1", Name: "Digital X-Ray Image Storage - For Presentation", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.1.1.1.1.1":    {UID: "1.2.840.10008.5.1.4.1.1.1.1.1", Name: "Digital X-Ray Image Storage - For Processing", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.1.1.1.2":      {UID: "1.2.840.10008.5.1.4.1.1.1.2", Name: "Digital Mammography X-Ray Image Storage - For Presentation", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.1.1.1.2.1":    {UID: "1.2.840.10008.5.1.4.1.1.1.2.1", Name: "Digital Mammography X-Ray Image Storage - For Processing", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.1.1.1.3":      {UID: "1.2.840.10008.5.1.4.1.1.1.3", Name: "Digital Intra-Oral X-Ray Image Storage - For Presentation", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.1.1.1.3.1":    {UID: "1.2.840.10008.5.1.4.1.1.1.3.1", Name: "Digital Intra-Oral X-Ray Image Storage - For Processing", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.1.1.2":        {UID: "1.2.840.10008.5.1.4.1.1.2", Name: "CT Image Storage", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.1.1.2.1":      {UID: "1.2.840.10008.5.1.4.1.1.2.1", Name: "Enhanced CT Image Storage", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.1.1.2.2":      {UID: "1.2.840.10008.5.1.4.1.1.2.2", Name: "Legacy Converted Enhanced CT Image Storage", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.1.1.3":        {UID: "1.2.840.10008.5.1.4.1.1.3", Name: "Ultrasound Multi-frame Image Storage", Type: TypeSOPClass, Status: "Retired"},
+	"1.2.840.10008.5.1.4.1.1.3.1":      {UID: "1.2.840.10008.5.1.4.1.1.3.1", Name: "Ultrasound Multi-frame Image Storage", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.1.1.4":        {UID: "1.2.840.10008.5.1.4.1.1.4", Name: "MR Image Storage", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.1.1.4.1":      {UID: "1.2.840.10008.5.1.4.1.1.4.1", Name: "Enhanced MR Image Storage", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.1.1.4.2":      {UID: "1.2.840.10008.5.1.4.1.1.4.2", Name: "MR Spectroscopy Storage", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.1.1.4.3":      {UID: "1.2.840.10008.5.1.4.1.1.4.3", Name: "Enhanced MR Color Image Storage", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.1.1.4.4":      {UID: "1.2.840.10008.5.1.4.1.1.4.4", Name: "Legacy Converted Enhanced MR Image Storage", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.1.1.5":        {UID: "1.2.840.10008.5.1.4.1.1.5", Name: "Nuclear Medicine Image Storage", Type: TypeSOPClass, Status: "Retired"},
+	"1.2.840.10008.5.1.4.1.1.6":        {UID: "1.2.840.10008.5.1.4.1.1.6", Name: "Ultrasound Image Storage", Type: TypeSOPClass, Status: "Retired"},
+	"1.2.840.10008.5.1.4.1.1.6.1":      {UID: "1.2.840.10008.5.1.4.1.1.6.1", Name: "Ultrasound Image Storage", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.1.1.6.2":      {UID: "1.2.840.10008.5.1.4.1.1.6.2", Name: "Enhanced US Volume Storage", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.1.1.7":        {UID: "1.2.840.10008.5.1.4.1.1.7", Name: "Secondary Capture Image Storage", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.1.1.7.1":      {UID: "1.2.840.10008.5.1.4.1.1.7.1", Name: "Multi-frame Single Bit Secondary Capture Image Storage", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.1.1.7.2":      {UID: "1.2.840.10008.5.1.4.1.1.7.2", Name: "Multi-frame Grayscale Byte Secondary Capture Image Storage", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.1.1.7.3":      {UID: "1.2.840.10008.5.1.4.1.1.7.3", Name: "Multi-frame Grayscale Word Secondary Capture Image Storage", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.1.1.7.4":      {UID: "1.2.840.10008.5.1.4.1.1.7.4", Name: "Multi-frame True Color Secondary Capture Image Storage", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.1.1.8":        {UID: "1.2.840.10008.5.1.4.1.1.8", Name: "Standalone Overlay Storage", Type: TypeSOPClass, Status: "Retired"},
+	"1.2.840.10008.5.1.4.1.1.9":        {UID: "1.2.840.10008.5.1.4.1.1.9", Name: "Standalone Curve Storage", Type: TypeSOPClass, Status: "Retired"},
+	"1.2.840.10008.5.1.4.1.1.9.1":      {UID: "1.2.840.10008.5.1.4.1.1.9.1", Name: "Waveform Storage - Trial", Type: TypeSOPClass, Status: "Retired"},
+	"1.2.840.10008.5.1.4.1.1.9.1.1":    {UID: "1.2.840.10008.5.1.4.1.1.9.1.1", Name: "12-lead ECG Waveform Storage", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.1.1.9.1.2":    {UID: "1.2.840.10008.5.1.4.1.1.9.1.2", Name: "General ECG Waveform Storage", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.1.1.9.1.3":    {UID: "1.2.840.10008.5.1.4.1.1.9.1.3", Name: "Ambulatory ECG Waveform Storage", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.1.1.9.2.1":    {UID: "1.2.840.10008.5.1.4.1.1.9.2.1", Name: "Hemodynamic Waveform Storage", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.1.1.9.3.1":    {UID: "1.2.840.10008.5.1.4.1.1.9.3.1", Name: "Cardiac Electrophysiology Waveform Storage", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.1.1.9.4.1":    {UID: "1.2.840.10008.5.1.4.1.1.9.4.1", Name: "Basic Voice Audio Waveform Storage", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.1.1.9.4.2":    {UID: "1.2.840.10008.5.1.4.1.1.9.4.2", Name: "General Audio Waveform Storage", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.1.1.9.5.1":    {UID: "1.2.840.10008.5.1.4.1.1.9.5.1", Name: "Arterial Pulse Waveform Storage", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.1.1.9.6.1":    {UID: "1.2.840.10008.5.1.4.1.1.9.6.1", Name: "Respiratory Waveform Storage", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.1.1.10":       {UID: "1.2.840.10008.5.1.4.1.1.10", Name: "Standalone Modality LUT Storage", Type: TypeSOPClass, Status: "Retired"},
+	"1.2.840.10008.5.1.4.1.1.11":       {UID: "1.2.840.10008.5.1.4.1.1.11", Name: "Standalone VOI LUT Storage", Type: TypeSOPClass, Status: "Retired"},
+	"1.2.840.10008.5.1.4.1.1.11.1":     {UID: "1.2.840.10008.5.1.4.1.1.11.1", Name: "Grayscale Softcopy Presentation State Storage", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.1.1.11.2":     {UID: "1.2.840.10008.5.1.4.1.1.11.2", Name: "Color Softcopy Presentation State Storage", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.1.1.11.3":     {UID: "1.2.840.10008.5.1.4.1.1.11.3", Name: "Pseudo-Color Softcopy Presentation State Storage", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.1.1.11.4":     {UID: "1.2.840.10008.5.1.4.1.1.11.4", Name: "Blending Softcopy Presentation State Storage", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.1.1.11.5":     {UID: "1.2.840.10008.5.1.4.1.1.11.5", Name: "XA/XRF Grayscale Softcopy Presentation State Storage", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.1.1.11.6":     {UID: "1.2.840.10008.5.1.4.1.1.11.6", Name: "Grayscale Planar MPR Volumetric Presentation State Storage", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.1.1.11.7":     {UID: "1.2.840.10008.5.1.4.1.1.11.7", Name: "Compositing Planar MPR Volumetric Presentation State Storage", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.1.1.11.8":     {UID: "1.2.840.10008.5.1.4.1.1.11.8", Name: "Advanced Blending Presentation State Storage", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.1.1.11.9":     {UID: "1.2.840.10008.5.1.4.1.1.11.9", Name: "Volume Rendering Volumetric Presentation State Storage", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.1.1.11.10":    {UID: "1.2.840.10008.5.1.4.1.1.11.10", Name: "Segmented Volume Rendering Volumetric Presentation State Storage", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.1.1.11.11":    {UID: "1.2.840.10008.5.1.4.1.1.11.11", Name: "Multiple Volume Rendering Volumetric Presentation State Storage", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.1.1.12.1":     {UID: "1.2.840.10008.5.1.4.1.1.12.1", Name: "X-Ray Angiographic Image Storage", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.1.1.12.1.1":   {UID: "1.2.840.10008.5.1.4.1.1.12.1.1", Name: "Enhanced XA Image Storage", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.1.1.12.2":     {UID: "1.2.840.10008.5.1.4.1.1.12.2", Name: "X-Ray Radiofluoroscopic Image Storage", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.1.1.12.2.1":   {UID: "1.2.840.10008.5.1.4.1.1.12.2.1", Name: "Enhanced XRF Image Storage", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.1.1.12.3":     {UID: "1.2.840.10008.5.1.4.1.1.12.3", Name: "X-Ray Angiographic Bi-Plane Image Storage", Type: TypeSOPClass, Status: "Retired"},
+	"1.2.840.10008.5.1.4.1.1.12.77":    {UID: "1.2.840.10008.5.1.4.1.1.12.77", Name: "", Type: TypeSOPClass, Status: "Retired"},
+	"1.2.840.10008.5.1.4.1.1.13.1.1":   {UID: "1.2.840.10008.5.1.4.1.1.13.1.1", Name: "X-Ray 3D Angiographic Image Storage", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.1.1.13.1.2":   {UID: "1.2.840.10008.5.1.4.1.1.13.1.2", Name: "X-Ray 3D Craniofacial Image Storage", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.1.1.13.1.3":   {UID: "1.2.840.10008.5.1.4.1.1.13.1.3", Name: "Breast Tomosynthesis Image Storage", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.1.1.13.1.4":   {UID: "1.2.840.10008.5.1.4.1.1.13.1.4", Name: "Breast Projection X-Ray Image Storage - For Presentation", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.1.1.13.1.5":   {UID: "1.2.840.10008.5.1.4.1.1.13.1.5", Name: "Breast Projection X-Ray Image Storage - For Processing", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.1.1.14.1":     {UID: "1.2.840.10008.5.1.4.1.1.14.1", Name: "Intravascular Optical Coherence Tomography Image Storage - For Presentation", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.1.1.14.2":     {UID: "1.2.840.10008.5.1.4.1.1.14.2", Name: "Intravascular Optical Coherence Tomography Image Storage - For Processing", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.1.1.20":       {UID: "1.2.840.10008.5.1.4.1.1.20", Name: "Nuclear Medicine Image Storage", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.1.1.30":       {UID: "1.2.840.10008.5.1.4.1.1.30", Name: "Parametric Map Storage", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.1.1.40":       {UID: "1.2.840.10008.5.1.4.1.1.40", Name: "", Type: TypeSOPClass, Status: "Retired"},
+	"1.2.840.10008.5.1.4.1.1.66":       {UID: "1.2.840.10008.5.1.4.1.1.66", Name: "Raw Data Storage", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.1.1.66.1":     {UID: "1.2.840.10008.5.1.4.1.1.66.1", Name: "Spatial Registration Storage", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.1.1.66.2":     {UID: "1.2.840.10008.5.1.4.1.1.66.2", Name: "Spatial Fiducials Storage", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.1.1.66.3":     {UID: "1.2.840.10008.5.1.4.1.1.66.3", Name: "Deformable Spatial Registration Storage", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.1.1.66.4":     {UID: "1.2.840.10008.5.1.4.1.1.66.4", Name: "Segmentation Storage", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.1.1.66.5":     {UID: "1.2.840.10008.5.1.4.1.1.66.5", Name: "Surface Segmentation Storage", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.1.1.66.6":     {UID: "1.2.840.10008.5.1.4.1.1.66.6", Name: "Tractography Results Storage", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.1.1.67":       {UID: "1.2.840.10008.5.1.4.1.1.67", Name: "Real World Value Mapping Storage", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.1.1.68.1":     {UID: "1.2.840.10008.5.1.4.1.1.68.1", Name: "Surface Scan Mesh Storage", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.1.1.68.2":     {UID: "1.2.840.10008.5.1.4.1.1.68.2", Name: "Surface Scan Point Cloud Storage", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.1.1.77.1":     {UID: "1.2.840.10008.5.1.4.1.1.77.1", Name: "VL Image Storage - Trial", Type: TypeSOPClass, Status: "Retired"},
+	"1.2.840.10008.5.1.4.1.1.77.2":     {UID: "1.2.840.10008.5.1.4.1.1.77.2", Name: "VL Multi-frame Image Storage - Trial", Type: TypeSOPClass, Status: "Retired"},
+	"1.2.840.10008.5.1.4.1.1.77.1.1":   {UID: "1.2.840.10008.5.1.4.1.1.77.1.1", Name: "VL Endoscopic Image Storage", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.1.1.77.1.1.1": {UID: "1.2.840.10008.5.1.4.1.1.77.1.1.1", Name: "Video Endoscopic Image Storage", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.1.1.77.1.2":   {UID: "1.2.840.10008.5.1.4.1.1.77.1.2", Name: "VL Microscopic Image Storage", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.1.1.77.1.2.1": {UID: "1.2.840.10008.5.1.4.1.1.77.1.2.1", Name: "Video Microscopic Image Storage", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.1.1.77.1.3":   {UID: "1.2.840.10008.5.1.4.1.1.77.1.3", Name: "VL Slide-Coordinates Microscopic Image Storage", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.1.1.77.1.4":   {UID: "1.2.840.10008.5.1.4.1.1.77.1.4", Name: "VL Photographic Image Storage", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.1.1.77.1.4.1": {UID: "1.2.840.10008.5.1.4.1.1.77.1.4.1", Name: "Video Photographic Image Storage", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.1.1.77.1.5.1": {UID: "1.2.840.10008.5.1.4.1.1.77.1.5.1", Name: "Ophthalmic Photography 8 Bit Image Storage", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.1.1.77.1.5.2": {UID: "1.2.840.10008.5.1.4.1.1.77.1.5.2", Name: "Ophthalmic Photography 16 Bit Image Storage", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.1.1.77.1.5.3": {UID: "1.2.840.10008.5.1.4.1.1.77.1.5.3", Name: "Stereometric Relationship Storage", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.1.1.77.1.5.4": {UID: "1.2.840.10008.5.1.4.1.1.77.1.5.4", Name: "Ophthalmic Tomography Image Storage", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.1.1.77.1.5.5": {UID: "1.2.840.10008.5.1.4.1.1.77.1.5.5", Name: "Wide Field Ophthalmic Photography Stereographic Projection Image Storage", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.1.1.77.1.5.6": {UID: "1.2.840.10008.5.1.4.1.1.77.1.5.6", Name: "Wide Field Ophthalmic Photography 3D Coordinates Image Storage", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.1.1.77.1.5.7": {UID: "1.2.840.10008.5.1.4.1.1.77.1.5.7", Name: "Ophthalmic Optical Coherence Tomography En Face Image Storage", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.1.1.77.1.5.8": {UID: "1.2.840.10008.5.1.4.1.1.77.1.5.8", Name: "Ophthalmic Optical Coherence Tomography B-scan Volume Analysis Storage", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.1.1.77.1.6":   {UID: "1.2.840.10008.5.1.4.1.1.77.1.6", Name: "VL Whole Slide Microscopy Image Storage", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.1.1.78.1":     {UID: "1.2.840.10008.5.1.4.1.1.78.1", Name: "Lensometry Measurements Storage", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.1.1.78.2":     {UID: "1.2.840.10008.5.1.4.1.1.78.2", Name: "Autorefraction Measurements Storage", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.1.1.78.3":     {UID: "1.2.840.10008.5.1.4.1.1.78.3", Name: "Keratometry Measurements Storage", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.1.1.78.4":     {UID: "1.2.840.10008.5.1.4.1.1.78.4", Name: "Subjective Refraction Measurements Storage", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.1.1.78.5":     {UID: "1.2.840.10008.5.1.4.1.1.78.5", Name: "Visual Acuity Measurements Storage", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.1.1.78.6":     {UID: "1.2.840.10008.5.1.4.1.1.78.6", Name: "Spectacle Prescription Report Storage", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.1.1.78.7":     {UID: "1.2.840.10008.5.1.4.1.1.78.7", Name: "Ophthalmic Axial Measurements Storage", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.1.1.78.8":     {UID: "1.2.840.10008.5.1.4.1.1.78.8", Name: "Intraocular Lens Calculations Storage", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.1.1.79.1":     {UID: "1.2.840.10008.5.1.4.1.1.79.1", Name: "Macular Grid Thickness and Volume Report Storage", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.1.1.80.1":     {UID: "1.2.840.10008.5.1.4.1.1.80.1", Name: "Ophthalmic Visual Field Static Perimetry Measurements Storage", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.1.1.81.1":     {UID: "1.2.840.10008.5.1.4.1.1.81.1", Name: "Ophthalmic Thickness Map Storage", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.1.1.82.1":     {UID: "1.2.840.10008.5.1.4.1.1.82.1", Name: "Corneal Topography Map Storage", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.1.1.88.1":     {UID: "1.2.840.10008.5.1.4.1.1.88.1", Name: "Text SR Storage - Trial", Type: TypeSOPClass, Status: "Retired"},
+	"1.2.840.10008.5.1.4.1.1.88.2":     {UID: "1.2.840.10008.5.1.4.1.1.88.2", Name: "Audio SR Storage - Trial", Type: TypeSOPClass, Status: "Retired"},
+	"1.2.840.10008.5.1.4.1.1.88.3":     {UID: "1.2.840.10008.5.1.4.1.1.88.3", Name: "Detail SR Storage - Trial", Type: TypeSOPClass, Status: "Retired"},
+	"1.2.840.10008.5.1.4.1.1.88.4":     {UID: "1.2.840.10008.5.1.4.1.1.88.4", Name: "Comprehensive SR Storage - Trial", Type: TypeSOPClass, Status: "Retired"},
+	"1.2.840.10008.5.1.4.1.1.88.11":    {UID: "1.2.840.10008.5.1.4.1.1.88.11", Name: "Basic Text SR Storage", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.1.1.88.22":    {UID: "1.2.840.10008.5.1.4.1.1.88.22", Name: "Enhanced SR Storage", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.1.1.88.33":    {UID: "1.2.840.10008.5.1.4.1.1.88.33", Name: "Comprehensive SR Storage", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.1.1.88.34":    {UID: "1.2.840.10008.5.1.4.1.1.88.34", Name: "Comprehensive 3D SR Storage", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.1.1.88.35":    {UID: "1.2.840.10008.5.1.4.1.1.88.35", Name: "Extensible SR Storage", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.1.1.88.40":    {UID: "1.2.840.10008.5.1.4.1.1.88.40", Name: "Procedure Log Storage", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.1.1.88.50":    {UID: "1.2.840.10008.5.1.4.1.1.88.50", Name: "Mammography CAD SR Storage", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.1.1.88.59":    {UID: "1.2.840.10008.5.1.4.1.1.88.59", Name: "Key Object Selection Document Storage", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.1.1.88.65":    {UID: "1.2.840.10008.5.1.4.1.1.88.65", Name: "Chest CAD SR Storage", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.1.1.88.67":    {UID: "1.2.840.10008.5.1.4.1.1.88.67", Name: "X-Ray Radiation Dose SR Storage", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.1.1.88.68":    {UID: "1.2.840.10008.5.1.4.1.1.88.68", Name: "Radiopharmaceutical Radiation Dose SR Storage", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.1.1.88.69":    {UID: "1.2.840.10008.5.1.4.1.1.88.69", Name: "Colon CAD SR Storage", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.1.1.88.70":    {UID: "1.2.840.10008.5.1.4.1.1.88.70", Name: "Implantation Plan SR Storage", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.1.1.88.71":    {UID: "1.2.840.10008.5.1.4.1.1.88.71", Name: "Acquisition Context SR Storage", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.1.1.88.72":    {UID: "1.2.840.10008.5.1.4.1.1.88.72", Name: "Simplified Adult Echo SR Storage", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.1.1.88.73":    {UID: "1.2.840.10008.5.1.4.1.1.88.73", Name: "Patient Radiation Dose SR Storage", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.1.1.90.1":     {UID: "1.2.840.10008.5.1.4.1.1.90.1", Name: "Content Assessment Results Storage", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.1.1.104.1":    {UID: "1.2.840.10008.5.1.4.1.1.104.1", Name: "Encapsulated PDF Storage", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.1.1.104.2":    {UID: "1.2.840.10008.5.1.4.1.1.104.2", Name: "Encapsulated CDA Storage", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.1.1.128":      {UID: "1.2.840.10008.5.1.4.1.1.128", Name: "Positron Emission Tomography Image Storage", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.1.1.128.1":    {UID: "1.2.840.10008.5.1.4.1.1.128.1", Name: "Legacy Converted Enhanced PET Image Storage", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.1.1.129":      {UID: "1.2.840.10008.5.1.4.1.1.129", Name: "Standalone PET Curve Storage", Type: TypeSOPClass, Status: "Retired"},
+	"1.2.840.10008.5.1.4.1.1.130":      {UID: "1.2.840.10008.5.1.4.1.1.130", Name: "Enhanced PET Image Storage", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.1.1.131":      {UID: "1.2.840.10008.5.1.4.1.1.131", Name: "Basic Structured Display Storage", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.1.1.200.1":    {UID: "1.2.840.10008.5.1.4.1.1.200.1", Name: "CT Defined Procedure Protocol Storage", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.1.1.200.2":    {UID: "1.2.840.10008.5.1.4.1.1.200.2", Name: "CT Performed Procedure Protocol Storage", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.1.1.200.3":    {UID: "1.2.840.10008.5.1.4.1.1.200.3", Name: "Protocol Approval Storage", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.1.1.200.4":    {UID: "1.2.840.10008.5.1.4.1.1.200.4", Name: "Protocol Approval Information Model - FIND", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.1.1.200.5":    {UID: "1.2.840.10008.5.1.4.1.1.200.5", Name: "Protocol Approval Information Model - MOVE", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.1.1.200.6":    {UID: "1.2.840.10008.5.1.4.1.1.200.6", Name: "Protocol Approval Information Model - GET", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.1.1.481.1":    {UID: "1.2.840.10008.5.1.4.1.1.481.1", Name: "RT Image Storage", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.1.1.481.2":    {UID: "1.2.840.10008.5.1.4.1.1.481.2", Name: "RT Dose Storage", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.1.1.481.3":    {UID: "1.2.840.10008.5.1.4.1.1.481.3", Name: "RT Structure Set Storage", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.1.1.481.4":    {UID: "1.2.840.10008.5.1.4.1.1.481.4", Name: "RT Beams Treatment Record Storage", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.1.1.481.5":    {UID: "1.2.840.10008.5.1.4.1.1.481.5", Name: "RT Plan Storage", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.1.1.481.6":    {UID: "1.2.840.10008.5.1.4.1.1.481.6", Name: "RT Brachy Treatment Record Storage", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.1.1.481.7":    {UID: "1.2.840.10008.5.1.4.1.1.481.7", Name: "RT Treatment Summary Record Storage", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.1.1.481.8":    {UID: "1.2.840.10008.5.1.4.1.1.481.8", Name: "RT Ion Plan Storage", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.1.1.481.9":    {UID: "1.2.840.10008.5.1.4.1.1.481.9", Name: "RT Ion Beams Treatment Record Storage", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.1.1.501.1":    {UID: "1.2.840.10008.5.1.4.1.1.501.1", Name: "DICOS CT Image Storage", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.1.1.501.2.1":  {UID: "1.2.840.10008.5.1.4.1.1.501.2.1", Name: "DICOS Digital X-Ray Image Storage - For Presentation", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.1.1.501.2.2":  {UID: "1.2.840.10008.5.1.4.1.1.501.2.2", Name: "DICOS Digital X-Ray Image Storage - For Processing", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.1.1.501.3":    {UID: "1.2.840.10008.5.1.4.1.1.501.3", Name: "DICOS Threat Detection Report Storage", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.1.1.501.4":    {UID: "1.2.840.10008.5.1.4.1.1.501.4", Name: "DICOS 2D AIT Storage", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.1.1.501.5":    {UID: "1.2.840.10008.5.1.4.1.1.501.5", Name: "DICOS 3D AIT Storage", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.1.1.501.6":    {UID: "1.2.840.10008.5.1.4.1.1.501.6", Name: "DICOS Quadrupole Resonance (QR) Storage", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.1.1.601.1":    {UID: "1.2.840.10008.5.1.4.1.1.601.1", Name: "Eddy Current Image Storage", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.1.1.601.2":    {UID: "1.2.840.10008.5.1.4.1.1.601.2", Name: "Eddy Current Multi-frame Image Storage", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.1.2.1.1":      {UID: "1.2.840.10008.5.1.4.1.2.1.1", Name: "Patient Root Query/Retrieve Information Model - FIND", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.1.2.1.2":      {UID: "1.2.840.10008.5.1.4.1.2.1.2", Name: "Patient Root Query/Retrieve Information Model - MOVE", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.1.2.1.3":      {UID: "1.2.840.10008.5.1.4.1.2.1.3", Name: "Patient Root Query/Retrieve Information Model - GET", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.1.2.2.1":      {UID: "1.2.840.10008.5.1.4.1.2.2.1", Name: "Study Root Query/Retrieve Information Model - FIND", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.1.2.2.2":      {UID: "1.2.840.10008.5.1.4.1.2.2.2", Name: "Study Root Query/Retrieve Information Model - MOVE", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.1.2.2.3":      {UID: "1.2.840.10008.5.1.4.1.2.2.3", Name: "Study Root Query/Retrieve Information Model - GET", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.1.2.3.1":      {UID: "1.2.840.10008.5.1.4.1.2.3.1", Name: "Patient/Study Only Query/Retrieve Information Model - FIND", Type: TypeSOPClass, Status: "Retired"},
+	"1.2.840.10008.5.1.4.1.2.3.2":      {UID: "1.2.840.10008.5.1.4.1.2.3.2", Name: "Patient/Study Only Query/Retrieve Information Model - MOVE", Type: TypeSOPClass, Status: "Retired"},
+	"1.2.840.10008.5.1.4.1.2.3.3":      {UID: "1.2.840.10008.5.1.4.1.2.3.3", Name: "Patient/Study Only Query/Retrieve Information Model - GET", Type: TypeSOPClass, Status: "Retired"},
+	"1.2.840.10008.5.1.4.1.2.4.2":      {UID: "1.2.840.10008.5.1.4.1.2.4.2", Name: "Composite Instance Root Retrieve - MOVE", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.1.2.4.3":      {UID: "1.2.840.10008.5.1.4.1.2.4.3", Name: "Composite Instance Root Retrieve - GET", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.1.2.5.3":      {UID: "1.2.840.10008.5.1.4.1.2.5.3", Name: "Composite Instance Retrieve Without Bulk Data - GET", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.20.1":         {UID: "1.2.840.10008.5.1.4.20.1", Name: "Defined Procedure Protocol Information Model - FIND", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.20.2":         {UID: "1.2.840.10008.5.1.4.20.2", Name: "Defined Procedure Protocol Information Model - MOVE", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.20.3":         {UID: "1.2.840.10008.5.1.4.20.3", Name: "Defined Procedure Protocol Information Model - GET", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.31":           {UID: "1.2.840.10008.5.1.4.31", Name: "Modality Worklist Information Model - FIND", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.32":           {UID: "1.2.840.10008.5.1.4.32", Name: "General Purpose Worklist Management Meta SOP Class", Type: "Meta SOP Class", Status: "Retired"},
+	"1.2.840.10008.5.1.4.32.1":         {UID: "1.2.840.10008.5.1.4.32.1", Name: "General Purpose Worklist Information Model - FIND", Type: TypeSOPClass, Status: "Retired"},
+	"1.2.840.10008.5.1.4.32.2":         {UID: "1.2.840.10008.5.1.4.32.2", Name: "General Purpose Scheduled Procedure Step SOP Class", Type: TypeSOPClass, Status: "Retired"},
+	"1.2.840.10008.5.1.4.32.3":         {UID: "1.2.840.10008.5.1.4.32.3", Name: "General Purpose Performed Procedure Step SOP Class", Type: TypeSOPClass, Status: "Retired"},
+	"1.2.840.10008.5.1.4.33":           {UID: "1.2.840.10008.5.1.4.33", Name: "Instance Availability Notification SOP Class", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.34.1":         {UID: "1.2.840.10008.5.1.4.34.1", Name: "RT Beams Delivery Instruction Storage - Trial", Type: TypeSOPClass, Status: "Retired"},
+	"1.2.840.10008.5.1.4.34.2":         {UID: "1.2.840.10008.5.1.4.34.2", Name: "RT Conventional Machine Verification - Trial", Type: TypeSOPClass, Status: "Retired"},
+	"1.2.840.10008.5.1.4.34.3":         {UID: "1.2.840.10008.5.1.4.34.3", Name: "RT Ion Machine Verification - Trial", Type: TypeSOPClass, Status: "Retired"},
+	"1.2.840.10008.5.1.4.34.4":         {UID: "1.2.840.10008.5.1.4.34.4", Name: "Unified Worklist and Procedure Step Service Class - Trial", Type: "Service Class", Status: "Retired"},
+	"1.2.840.10008.5.1.4.34.4.1":       {UID: "1.2.840.10008.5.1.4.34.4.1", Name: "Unified Procedure Step - Push SOP Class - Trial", Type: TypeSOPClass, Status: "Retired"},
+	"1.2.840.10008.5.1.4.34.4.2":       {UID: "1.2.840.10008.5.1.4.34.4.2", Name: "Unified Procedure Step - Watch SOP Class - Trial", Type: TypeSOPClass, Status: "Retired"},
+	"1.2.840.10008.5.1.4.34.4.3":       {UID: "1.2.840.10008.5.1.4.34.4.3", Name: "Unified Procedure Step - Pull SOP Class - Trial", Type: TypeSOPClass, Status: "Retired"},
+	"1.2.840.10008.5.1.4.34.4.4":       {UID: "1.2.840.10008.5.1.4.34.4.4", Name: "Unified Procedure Step - Event SOP Class - Trial", Type: TypeSOPClass, Status: "Retired"},
+	"1.2.840.10008.5.1.4.34.5":         {UID: "1.2.840.10008.5.1.4.34.5", Name: "UPS Global Subscription SOP Instance", Type: TypeWellKnownSOPInstance},
+	"1.2.840.10008.5.1.4.34.5.1":       {UID: "1.2.840.10008.5.1.4.34.5.1", Name: "UPS Filtered Global Subscription SOP Instance", Type: TypeWellKnownSOPInstance},
+	"1.2.840.10008.5.1.4.34.6":         {UID: "1.2.840.10008.5.1.4.34.6", Name: "Unified Worklist and Procedure Step Service Class", Type: "Service Class"},
+	"1.2.840.10008.5.1.4.34.6.1":       {UID: "1.2.840.10008.5.1.4.34.6.1", Name: "Unified Procedure Step - Push SOP Class", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.34.6.2":       {UID: "1.2.840.10008.5.1.4.34.6.2", Name: "Unified Procedure Step - Watch SOP Class", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.34.6.3":       {UID: "1.2.840.10008.5.1.4.34.6.3", Name: "Unified Procedure Step - Pull SOP Class", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.34.6.4":       {UID: "1.2.840.10008.5.1.4.34.6.4", Name: "Unified Procedure Step - Event SOP Class", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.34.7":         {UID: "1.2.840.10008.5.1.4.34.7", Name: "RT Beams Delivery Instruction Storage", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.34.8":         {UID: "1.2.840.10008.5.1.4.34.8", Name: "RT Conventional Machine Verification", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.34.9":         {UID: "1.2.840.10008.5.1.4.34.9", Name: "RT Ion Machine Verification", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.34.10":        {UID: "1.2.840.10008.5.1.4.34.10", Name: "RT Brachy Application Setup Delivery Instruction Storage", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.37.1":         {UID: "1.2.840.10008.5.1.4.37.1", Name: "General Relevant Patient Information Query", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.37.2":         {UID: "1.2.840.10008.5.1.4.37.2", Name: "Breast Imaging Relevant Patient Information Query", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.37.3":         {UID: "1.2.840.10008.5.1.4.37.3", Name: "Cardiac Relevant Patient Information Query", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.38.1":         {UID: "1.2.840.10008.5.1.4.38.1", Name: "Hanging Protocol Storage", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.38.2":         {UID: "1.2.840.10008.5.1.4.38.2", Name: "Hanging Protocol Information Model - FIND", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.38.3":         {UID: "1.2.840.10008.5.1.4.38.3", Name: "Hanging Protocol Information Model - MOVE", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.38.4":         {UID: "1.2.840.10008.5.1.4.38.4", Name: "Hanging Protocol Information Model - GET", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.39.1":         {UID: "1.2.840.10008.5.1.4.39.1", Name: "Color Palette Storage", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.39.2":         {UID: "1.2.840.10008.5.1.4.39.2", Name: "Color Palette Query/Retrieve Information Model - FIND", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.39.3":         {UID: "1.2.840.10008.5.1.4.39.3", Name: "Color Palette Query/Retrieve Information Model - MOVE", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.39.4":         {UID: "1.2.840.10008.5.1.4.39.4", Name: "Color Palette Query/Retrieve Information Model - GET", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.41":           {UID: "1.2.840.10008.5.1.4.41", Name: "Product Characteristics Query SOP Class", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.42":           {UID: "1.2.840.10008.5.1.4.42", Name: "Substance Approval Query SOP Class", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.43.1":         {UID: "1.2.840.10008.5.1.4.43.1", Name: "Generic Implant Template Storage", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.43.2":         {UID: "1.2.840.10008.5.1.4.43.2", Name: "Generic Implant Template Information Model - FIND", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.43.3":         {UID: "1.2.840.10008.5.1.4.43.3", Name: "Generic Implant Template Information Model - MOVE", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.43.4":         {UID: "1.2.840.10008.5.1.4.43.4", Name: "Generic Implant Template Information Model - GET", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.44.1":         {UID: "1.2.840.10008.5.1.4.44.1", Name: "Implant Assembly Template Storage", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.44.2":         {UID: "1.2.840.10008.5.1.4.44.2", Name: "Implant Assembly Template Information Model - FIND", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.44.3":         {UID: "1.2.840.10008.5.1.4.44.3", Name: "Implant Assembly Template Information Model - MOVE", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.44.4":         {UID: "1.2.840.10008.5.1.4.44.4", Name: "Implant Assembly Template Information Model - GET", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.45.1":         {UID: "1.2.840.10008.5.1.4.45.1", Name: "Implant Template Group Storage", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.45.2":         {UID: "1.2.840.10008.5.1.4.45.2", Name: "Implant Template Group Information Model - FIND", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.45.3":         {UID: "1.2.840.10008.5.1.4.45.3", Name: "Implant Template Group Information Model - MOVE", Type: TypeSOPClass},
+	"1.2.840.10008.5.1.4.45.4":         {UID: "1.2.840.10008.5.1.4.45.4", Name: "Implant Template Group Information Model - GET", Type: TypeSOPClass},
+	"1.2.840.10008.7.1.1":              {UID: "1.2.840.10008.7.1.1", Name: "NativeData DICOM Model", Type: "Application Hosting Model"},
+	"1.2.840.10008.7.1.2":              {UID: "1.2.840.10008.7.1.2", Name: "Abstract Multi-Dimensional Image Model", Type: "Application Hosting Model"},
+	"1.2.840.10008.8.1.1":              {UID: "1.2.840.10008.8.1.1", Name: "DICOM Content Mapping Resource", Type: "Mapping Resource"},
+	"1.2.840.10008.15.0.3.1":           {UID: "1.2.840.10008.15.0.3.1", Name: "dicomDeviceName", Type: "LDAP OID"},
+	"1.2.840.10008.15.0.3.2":           {UID: "1.2.840.10008.15.0.3.2", Name: "dicomDescription", Type: "LDAP OID"},
+	"1.2.840.10008.15.0.3.3":           {UID: "1.2.840.10008.15.0.3.3", Name: "dicomManufacturer", Type: "LDAP OID"},
+	"1.2.840.10008.15.0.3.4":           {UID: "1.2.840.10008.15.0.3.4", Name: "dicomManufacturerModelName", Type: "LDAP OID"},
+	"1.2.840.10008.15.0.3.5":           {UID: "1.2.840.10008.15.0.3.5", Name: "dicomSoftwareVersion", Type: "LDAP OID"},
+	"1.2.840.10008.15.0.3.6":           {UID: "1.2.840.10008.15.0.3.6", Name: "dicomVendorData", Type: "LDAP OID"},
+	"1.2.840.10008.15.0.3.7":           {UID: "1.2.840.10008.15.0.3.7", Name: "dicomAETitle", Type: "LDAP OID"},
+	"1.2.840.10008.15.0.3.8":           {UID: "1.2.840.10008.15.0.3.8", Name: "dicomNetworkConnectionReference", Type: "LDAP OID"},
+	"1.2.840.10008.15.0.3.9":           {UID: "1.2.840.10008.15.0.3.9", Name: "dicomApplicationCluster", Type: "LDAP OID"},
+	"1.2.840.10008.15.0.3.10":          {UID: "1.2.840.10008.15.0.3.10", Name: "dicomAssociationInitiator", Type: "LDAP OID"},
+	"1.2.840.10008.15.0.3.11":          {UID: "1.2.840.10008.15.0.3.11", Name: "dicomAssociationAcceptor", Type: "LDAP OID"},
+	"1.2.840.10008.15.0.3.12":          {UID: "1.2.840.10008.15.0.3.12", Name: "dicomHostname", Type: "LDAP OID"},
+	"1.2.840.10008.15.0.3.13":          {UID: "1.2.840.10008.15.0.3.13", Name: "dicomPort", Type: "LDAP OID"},
+	"1.2.840.10008.15.0.3.14":          {UID: "1.2.840.10008.15.0.3.14", Name: "dicomSOPClass", Type: "LDAP OID"},
+	"1.2.840.10008.15.0.3.15":          {UID: "1.2.840.10008.15.0.3.15", Name: "dicomTransferRole", Type: "LDAP OID"},
+	"1.2.840.10008.15.0.3.16":          {UID: "1.2.840.10008.15.0.3.16", Name: "dicomTransferSyntax", Type: "LDAP OID"},
+	"1.2.840.10008.15.0.3.17":          {UID: "1.2.840.10008.15.0.3.17", Name: "dicomPrimaryDeviceType", Type: "LDAP OID"},
+	"1.2.840.10008.15.0.3.18":          {UID: "1.2.840.10008.15.0.3.18", Name: "dicomRelatedDeviceReference", Type: "LDAP OID"},
+	"1.2.840.10008.15.0.3.19":          {UID: "1.2.840.10008.15.0.3.19", Name: "dicomPreferredCalledAETitle", Type: "LDAP OID"},
+	"1.2.840.10008.15.0.3.20":          {UID: "1.2.840.10008.15.0.3.20", Name: "dicomTLSCyphersuite", Type: "LDAP OID"},
+	"1.2.840.10008.15.0.3.21":          {UID: "1.2.840.10008.15.0.3.21", Name: "dicomAuthorizedNodeCertificateReference", Type: "LDAP OID"},
+	"1.2.840.10008.15.0.3.22":          {UID: "1.2.840.10008.15.0.3.22", Name: "dicomThisNodeCertificateReference", Type: "LDAP OID"},
+	"1.2.840.10008.15.0.3.23":          {UID: "1.2.840.10008.15.0.3.23", Name: "dicomInstalled", Type: "LDAP OID"},
+	"1.2.840.10008.15.0.3.24":          {UID: "1.2.840.10008.15.0.3.24", Name: "dicomStationName", Type: "LDAP OID"},
+	"1.2.840.10008.15.0.3.25":          {UID: "1.2.840.10008.15.0.3.25", Name: "dicomDeviceSerialNumber", Type: "LDAP OID"},
+	"1.2.840.10008.15.0.3.26":          {UID: "1.2.840.10008.15.0.3.26", Name: "dicomInstitutionName", Type: "LDAP OID"},
+	"1.2.840.10008.15.0.3.27":          {UID: "1.2.840.10008.15.0.3.27", Name: "dicomInstitutionAddress", Type: "LDAP OID"},
+	"1.2.840.10008.15.0.3.28":          {UID: "1.2.840.10008.15.0.3.28", Name: "dicomInstitutionDepartmentName", Type: "LDAP OID"},
+	"1.2.840.10008.15.0.3.29":          {UID: "1.2.840.10008.15.0.3.29", Name: "dicomIssuerOfPatientID", Type: "LDAP OID"},
+	"1.2.840.10008.15.0.3.30":          {UID: "1.2.840.10008.15.0.3.30", Name: "dicomPreferredCallingAETitle", Type: "LDAP OID"},
+	"1.2.840.10008.15.0.3.31":          {UID: "1.2.840.10008.15.0.3.31", Name: "dicomSupportedCharacterSet", Type: "LDAP OID"},
+	"1.2.840.10008.15.0.4.1":           {UID: "1.2.840.10008.15.0.4.1", Name: "dicomConfigurationRoot", Type: "LDAP OID"},
+	"1.2.840.10008.15.0.4.2":           {UID: "1.2.840.10008.15.0.4.2", Name: "dicomDevicesRoot", Type: "LDAP OID"},
+	"1.2.840.10008.15.0.4.3":           {UID: "1.2.840.10008.15.0.4.3", Name: "dicomUniqueAETitlesRegistryRoot", Type: "LDAP OID"},
+	"1.2.840.10008.15.0.4.4":           {UID: "1.2.840.10008.15.0.4.4", Name: "dicomDevice", Type: "LDAP OID"},
+	"1.2.840.10008.15.0.4.5":           {UID: "1.2.840.10008.15.0.4.5", Name: "dicomNetworkAE", Type: "LDAP OID"},
+	"1.2.840.10008.15.0.4.6":           {UID: "1.2.840.10008.15.0.4.6", Name: "dicomNetworkConnection", Type: "LDAP OID"},
+	"1.2.840.10008.15.0.4.7":           {UID: "1.2.840.10008.15.0.4.7", Name: "dicomUniqueAETitle", Type: "LDAP OID"},
+	"1.2.840.10008.15.0.4.8":           {UID: "1.2.840.10008.15.0.4.8", Name: "dicomTransferCapability", Type: "LDAP OID"},
+	"1.2.840.10008.15.1.1":             {UID: "1.2.840.10008.15.1.1", Name: "Universal Coordinated Time", Type: "Synchronization Frame of Reference"},
 }
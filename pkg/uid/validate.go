@@ -0,0 +1,41 @@
+package uid
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrorInvalidUID indicates that a UID does not follow the encoding rules of
+// PS3.5 9.1.
+var ErrorInvalidUID = errors.New("invalid UID")
+
+// maxUIDLength is the maximum length of a UID, see PS3.5 9.1.
+const maxUIDLength = 64
+
+// Validate checks that uid follows the encoding rules of PS3.5 9.1: it is at
+// most 64 characters long, and made of components of digits separated by '.',
+// none of which is empty or has a leading zero. The returned error wraps
+// ErrorInvalidUID.
+//
+// Validate does not check that uid is registered, see Lookup.
+func Validate(uid string) error {
+	if uid == "" {
+		return fmt.Errorf("%w: empty", ErrorInvalidUID)
+	}
+	if len(uid) > maxUIDLength {
+		return fmt.Errorf("%w: %q is longer than %d characters", ErrorInvalidUID, uid, maxUIDLength)
+	}
+	for _, c := range strings.Split(uid, ".") {
+		if c == "" {
+			return fmt.Errorf("%w: %q has an empty component", ErrorInvalidUID, uid)
+		}
+		if strings.Trim(c, "0123456789") != "" {
+			return fmt.Errorf("%w: %q has a component that is not made of digits", ErrorInvalidUID, uid)
+		}
+		if len(c) > 1 && c[0] == '0' {
+			return fmt.Errorf("%w: %q has a component with a leading zero", ErrorInvalidUID, uid)
+		}
+	}
+	return nil
+}
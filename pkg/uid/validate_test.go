@@ -0,0 +1,20 @@
+package uid
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestValidate(t *testing.T) {
+	for _, u := range []string{"1.2.840.10008.1.2", "0.1", "2.25.0"} {
+		if err := Validate(u); err != nil {
+			t.Errorf("Validate(%q) unexpected error: %v", u, err)
+		}
+	}
+	for _, u := range []string{"", "1..2", "1.2.", "1.02", "1.2a", "1.2 ", strings.Repeat("1.", 32) + "1"} {
+		if err := Validate(u); !errors.Is(err, ErrorInvalidUID) {
+			t.Errorf("Validate(%q) unexpected error. got: %v, want: %v", u, err, ErrorInvalidUID)
+		}
+	}
+}
@@ -0,0 +1,253 @@
+package dicom
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/suyashkumar/dicom/pkg/tag"
+	"github.com/suyashkumar/dicom/pkg/vrraw"
+)
+
+var (
+	// ErrorPrivateCreatorNotFound indicates that the block of a private data
+	// element is not reserved by a Private Creator Data Element in the Dataset.
+	ErrorPrivateCreatorNotFound = errors.New("private creator not found")
+	// ErrorNoFreePrivateBlock indicates that all the private blocks
+	// (gggg,0010-00FF) of a group are already reserved by other Private
+	// Creators.
+	ErrorNoFreePrivateBlock = errors.New("no free private block in group")
+)
+
+// PrivateCreator returns the Private Creator that reserved the block containing
+// the private data element t in this Dataset, i.e. the value of the
+// (gggg,00xx) element for t=(gggg,xxee). Like FindElementByTag, it does not
+// search within Sequences: private blocks are scoped to the Dataset or Item
+// that contains them.
+func (d *Dataset) PrivateCreator(t tag.Tag) (string, error) {
+	creatorTag, ok := tag.PrivateCreatorFor(t)
+	if !ok {
+		return "", fmt.Errorf("%w: %s", tag.ErrorInvalidPrivateTag, t)
+	}
+	elem, err := d.FindElementByTag(creatorTag)
+	if err != nil {
+		return "", fmt.Errorf("%w: %s", ErrorPrivateCreatorNotFound, tag.DebugString(t))
+	}
+	strs, ok := elem.Value.GetValue().([]string)
+	if !ok || len(strs) == 0 {
+		return "", fmt.Errorf("%w: %s has no string value", ErrorPrivateCreatorNotFound, creatorTag)
+	}
+	return strings.Trim(strs[0], " \x00"), nil
+}
+
+// FindTagInfo returns information about t from the tag dictionary. Unlike
+// tag.Find, private data elements are resolved using the Private Creator
+// elements in this Dataset and the private dictionary (see tag.FindPrivate).
+func (d *Dataset) FindTagInfo(t tag.Tag) (tag.Info, error) {
+	return findTagInfo(d, t)
+}
+
+// findTagInfo is like Dataset.FindTagInfo, but accepts a nil Dataset, in which
+// case private data elements cannot be resolved.
+func findTagInfo(d *Dataset, t tag.Tag) (tag.Info, error) {
+	if tag.IsPrivateCreator(t) {
+		return tag.PrivateCreatorInfo(t), nil
+	}
+	if _, ok := tag.PrivateCreatorFor(t); ok && d != nil {
+		creator, err := d.PrivateCreator(t)
+		if err != nil {
+			return tag.Info{}, err
+		}
+		return tag.FindPrivate(creator, t)
+	}
+	return tag.Find(t)
+}
+
+// SetPrivate sets the value of the private attribute at offset (0x00-0xFF)
+// within the block of group reserved by creator, and returns the Element that
+// was set. If no block of group is reserved by creator yet, the first free
+// Private Creator slot (gggg,0010-00FF) is reserved by adding a Private
+// Creator element. Any existing element at the resulting tag is replaced, and
+// new elements are inserted in tag order.
+//
+// The data can be one of the types that is acceptable to NewValue. The VR is
+// taken from the private dictionary (see tag.RegisterPrivate) if the attribute
+// is registered there, otherwise it is derived from data: LO for []string, SL
+// for []int, FD for []float64, OB for []byte and SQ for [][]*Element.
+//
+//	Example: elem, err := ds.SetPrivate("ACME_AI 1.0", 0x0029, 0x01, []string{"v2"})
+func (d *Dataset) SetPrivate(creator string, group uint16, offset uint8, data interface{}) (*Element, error) {
+	value, err := NewValue(data)
+	if err != nil {
+		return nil, err
+	}
+	block, err := d.reservePrivateBlock(creator, group)
+	if err != nil {
+		return nil, err
+	}
+	t := tag.Tag{Group: group, Element: uint16(block)<<8 | uint16(offset)}
+
+	var vr string
+	if info, err := tag.FindPrivate(creator, t); err == nil {
+		vr = info.VR
+	} else if vr, err = defaultPrivateVR(value); err != nil {
+		return nil, err
+	}
+	elem := &Element{
+		Tag:                    t,
+		ValueRepresentation:    tag.GetVRKind(t, vr),
+		RawValueRepresentation: vr,
+		Value:                  value,
+	}
+	d.setElement(elem)
+	return elem, nil
+}
+
+// MergePrivate copies the private blocks of src into this Dataset. Each block
+// is moved to the block of the same group that this Dataset reserves for the
+// same Private Creator (reserving a free one if needed), so that blocks of
+// different creators never collide, even if they were at the same position in
+// both Datasets. Copied elements replace any existing element with the same
+// resulting tag. Only top-level elements are merged, as private blocks are
+// scoped to the Dataset or Item that contains them. An error leaves this
+// Dataset unchanged.
+func (d *Dataset) MergePrivate(src Dataset) error {
+	// Reserve the destination blocks in a copy of the elements, which only
+	// replaces those of this Dataset once everything is resolved.
+	merged := Dataset{Elements: append([]*Element(nil), d.Elements...)}
+	blocks := make(map[tag.Tag]uint8)
+	for _, elem := range src.Elements {
+		if !tag.IsPrivateCreator(elem.Tag) {
+			continue
+		}
+		creator, err := src.PrivateCreator(tag.Tag{Group: elem.Tag.Group, Element: elem.Tag.Element << 8})
+		if err != nil {
+			return err
+		}
+		block, err := merged.reservePrivateBlock(creator, elem.Tag.Group)
+		if err != nil {
+			return err
+		}
+		blocks[elem.Tag] = block
+	}
+
+	var moved []*Element
+	for _, elem := range src.Elements {
+		creatorTag, ok := tag.PrivateCreatorFor(elem.Tag)
+		if !ok {
+			continue
+		}
+		block, ok := blocks[creatorTag]
+		if !ok {
+			return fmt.Errorf("%w: %s", ErrorPrivateCreatorNotFound, tag.DebugString(elem.Tag))
+		}
+		c := elem.Clone()
+		c.Tag.Element = uint16(block)<<8 | elem.Tag.Element&0x00FF
+		moved = append(moved, c)
+	}
+	for _, elem := range moved {
+		merged.setElement(elem)
+	}
+	d.Elements = merged.Elements
+	return nil
+}
+
+// reservePrivateBlock returns the block (0x10-0xFF) of group reserved by
+// creator, adding a Private Creator element for the first free block if there
+// is none.
+func (d *Dataset) reservePrivateBlock(creator string, group uint16) (uint8, error) {
+	creator = strings.Trim(creator, " \x00")
+	if !tag.IsPrivateCreator(tag.Tag{Group: group, Element: 0x0010}) {
+		return 0, fmt.Errorf("%w: group %04x", tag.ErrorInvalidPrivateTag, group)
+	}
+	if creator == "" {
+		return 0, fmt.Errorf("%w: empty Private Creator", tag.ErrorInvalidPrivateTag)
+	}
+	used := make(map[uint16]bool)
+	for _, elem := range d.Elements {
+		if elem.Tag.Group != group || !tag.IsPrivateCreator(elem.Tag) {
+			continue
+		}
+		used[elem.Tag.Element] = true
+		if elem.Value == nil {
+			continue
+		}
+		if strs, ok := elem.Value.GetValue().([]string); ok && len(strs) > 0 && strings.Trim(strs[0], " \x00") == creator {
+			return uint8(elem.Tag.Element), nil
+		}
+	}
+	for block := uint16(0x0010); block <= 0x00FF; block++ {
+		if used[block] {
+			continue
+		}
+		t := tag.Tag{Group: group, Element: block}
+		d.setElement(&Element{
+			Tag:                    t,
+			ValueRepresentation:    tag.GetVRKind(t, vrraw.LongString),
+			RawValueRepresentation: vrraw.LongString,
+			Value:                  &stringsValue{value: []string{creator}},
+		})
+		return uint8(block), nil
+	}
+	return 0, fmt.Errorf("%w: %04x", ErrorNoFreePrivateBlock, group)
+}
+
+// setElement replaces the element of this Dataset with the same tag as elem,
+// or inserts elem before the first element with a greater tag.
+func (d *Dataset) setElement(elem *Element) {
+	for i, e := range d.Elements {
+		if e.Tag == elem.Tag {
+			d.Elements[i] = elem
+			return
+		}
+		if e.Tag.Compare(elem.Tag) > 0 {
+			d.Elements = append(d.Elements, nil)
+			copy(d.Elements[i+1:], d.Elements[i:])
+			d.Elements[i] = elem
+			return
+		}
+	}
+	d.Elements = append(d.Elements, elem)
+}
+
+func defaultPrivateVR(v Value) (string, error) {
+	switch v.ValueType() {
+	case Strings:
+		return vrraw.LongString, nil
+	case Ints:
+		return vrraw.SignedLong, nil
+	case Floats:
+		return vrraw.FloatingPointDouble, nil
+	case Bytes:
+		return vrraw.OtherByte, nil
+	case Sequences:
+		return vrraw.Sequence, nil
+	default:
+		return "", fmt.Errorf("%w: %v can not be stored in a private attribute", ErrorUnexpectedValueType, v.ValueType())
+	}
+}
+
+// verifyPrivateBlocks checks that every private data element in elems, and
+// within any nested sequence items, has its block reserved by a Private
+// Creator element at the same level.
+func verifyPrivateBlocks(elems []*Element) error {
+	reserved := make(map[tag.Tag]bool)
+	for _, elem := range elems {
+		if tag.IsPrivateCreator(elem.Tag) {
+			reserved[elem.Tag] = true
+		}
+	}
+	for _, elem := range elems {
+		if creatorTag, ok := tag.PrivateCreatorFor(elem.Tag); ok && !reserved[creatorTag] {
+			return fmt.Errorf("%w: %s", ErrorPrivateCreatorNotFound, tag.DebugString(elem.Tag))
+		}
+		if elem.Value != nil && elem.Value.ValueType() == Sequences {
+			for _, item := range elem.Value.GetValue().([]*SequenceItemValue) {
+				if err := verifyPrivateBlocks(item.elements); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
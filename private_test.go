@@ -0,0 +1,215 @@
+package dicom
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/suyashkumar/dicom/pkg/dicomio"
+	"github.com/suyashkumar/dicom/pkg/tag"
+	"github.com/suyashkumar/dicom/pkg/uid"
+)
+
+func TestReadElement_ImplicitPrivate(t *testing.T) {
+	var data bytes.Buffer
+	writeImplicit := func(t tag.Tag, value string) {
+		binary.Write(&data, binary.LittleEndian, []uint16{t.Group, t.Element})
+		binary.Write(&data, binary.LittleEndian, uint32(len(value)))
+		data.WriteString(value)
+	}
+	writeImplicit(tag.Tag{Group: 0x0019, Element: 0x0010}, "SIEMENS MR HEADER ")
+	writeImplicit(tag.Tag{Group: 0x0019, Element: 0x100C}, "1000")
+	// Same offset, but in a block with no Private Creator.
+	writeImplicit(tag.Tag{Group: 0x0019, Element: 0x110C}, "1000")
+
+	r, err := dicomio.NewReader(bufio.NewReader(&data), binary.LittleEndian, int64(data.Len()))
+	if err != nil {
+		t.Fatalf("unable to create dicomio.Reader: %v", err)
+	}
+	r.SetTransferSyntax(binary.LittleEndian, true)
+
+	var ds Dataset
+	for _, wantVR := range []string{"LO", "IS", "UN"} {
+		elem, err := readElement(r, &ds, nil)
+		if err != nil {
+			t.Fatalf("readElement() unexpected error: %v", err)
+		}
+		if elem.RawValueRepresentation != wantVR {
+			t.Errorf("readElement(%s) unexpected VR. got: %v, want: %v", elem.Tag, elem.RawValueRepresentation, wantVR)
+		}
+		ds.Elements = append(ds.Elements, elem)
+	}
+
+	info, err := ds.FindTagInfo(tag.Tag{Group: 0x0019, Element: 0x100C})
+	if err != nil {
+		t.Fatalf("FindTagInfo() unexpected error: %v", err)
+	}
+	if info.Tag != (tag.Tag{Group: 0x0019, Element: 0x100C}) || info.VR != "IS" || info.Keyword != "BValue" {
+		t.Errorf("FindTagInfo() unexpected info: %v", info)
+	}
+	if _, err := ds.PrivateCreator(tag.Tag{Group: 0x0019, Element: 0x110C}); !errors.Is(err, ErrorPrivateCreatorNotFound) {
+		t.Errorf("PrivateCreator() unexpected error. got: %v, want: %v", err, ErrorPrivateCreatorNotFound)
+	}
+}
+
+func TestDataset_SetPrivate(t *testing.T) {
+	ds := Dataset{Elements: []*Element{
+		mustNewElement(tag.PatientName, []string{"Bob"}),
+		mustNewElement(tag.Rows, []int{128}),
+		{Tag: tag.Tag{Group: 0x0029, Element: 0x0010}, RawValueRepresentation: "LO", Value: &stringsValue{value: []string{"SIEMENS CSA HEADER"}}},
+		{Tag: tag.Tag{Group: 0x0029, Element: 0x1008}, RawValueRepresentation: "CS", Value: &stringsValue{value: []string{"IMAGE NUM 4"}}},
+	}}
+
+	if _, err := ds.SetPrivate("ACME_AI 1.0", 0x0029, 0x01, []string{"v1"}); err != nil {
+		t.Fatalf("SetPrivate() unexpected error: %v", err)
+	}
+	if _, err := ds.SetPrivate("ACME_AI 1.0", 0x0029, 0x02, []int{7}); err != nil {
+		t.Fatalf("SetPrivate() unexpected error: %v", err)
+	}
+	// Reuses the existing block, and takes the VR from the private dictionary.
+	if _, err := ds.SetPrivate("SIEMENS CSA HEADER", 0x0029, 0x09, []string{"20100114"}); err != nil {
+		t.Fatalf("SetPrivate() unexpected error: %v", err)
+	}
+	// Replaces the value set above.
+	if _, err := ds.SetPrivate("ACME_AI 1.0", 0x0029, 0x01, []string{"v2"}); err != nil {
+		t.Fatalf("SetPrivate() unexpected error: %v", err)
+	}
+
+	want := []struct {
+		tag   tag.Tag
+		vr    string
+		value interface{}
+	}{
+		{tag.PatientName, "PN", []string{"Bob"}},
+		{tag.Rows, "US", []int{128}},
+		{tag.Tag{Group: 0x0029, Element: 0x0010}, "LO", []string{"SIEMENS CSA HEADER"}},
+		{tag.Tag{Group: 0x0029, Element: 0x0011}, "LO", []string{"ACME_AI 1.0"}},
+		{tag.Tag{Group: 0x0029, Element: 0x1008}, "CS", []string{"IMAGE NUM 4"}},
+		{tag.Tag{Group: 0x0029, Element: 0x1009}, "LO", []string{"20100114"}},
+		{tag.Tag{Group: 0x0029, Element: 0x1101}, "LO", []string{"v2"}},
+		{tag.Tag{Group: 0x0029, Element: 0x1102}, "SL", []int{7}},
+	}
+	if len(ds.Elements) != len(want) {
+		t.Fatalf("SetPrivate() unexpected number of elements. got: %v, want: %v", len(ds.Elements), len(want))
+	}
+	for i, w := range want {
+		got := ds.Elements[i]
+		if got.Tag != w.tag || got.RawValueRepresentation != w.vr || !cmp.Equal(got.Value.GetValue(), w.value) {
+			t.Errorf("SetPrivate() unexpected element %d. got: %v %v %v, want: %v %v %v", i, got.Tag, got.RawValueRepresentation, got.Value.GetValue(), w.tag, w.vr, w.value)
+		}
+	}
+
+	// The private block survives a round trip through Write.
+	ds.Elements = append([]*Element{mustNewElement(tag.TransferSyntaxUID, []string{uid.ExplicitVRLittleEndian})}, ds.Elements...)
+	var out bytes.Buffer
+	if err := Write(&out, ds); err != nil {
+		t.Fatalf("Write() unexpected error: %v", err)
+	}
+	parsed, err := Parse(&out, int64(out.Len()), nil)
+	if err != nil {
+		t.Fatalf("Parse() unexpected error: %v", err)
+	}
+	elem, err := parsed.FindElementByTag(tag.Tag{Group: 0x0029, Element: 0x1102})
+	if err != nil {
+		t.Fatalf("FindElementByTag() unexpected error: %v", err)
+	}
+	if diff := cmp.Diff([]int{7}, elem.Value.GetValue()); diff != "" {
+		t.Errorf("SetPrivate() value not preserved by Write. diff: %v", diff)
+	}
+}
+
+func TestDataset_SetPrivate_NoFreeBlock(t *testing.T) {
+	var ds Dataset
+	for block := 0x10; block <= 0xFF; block++ {
+		if _, err := ds.SetPrivate(strconv.Itoa(block), 0x0041, 0x00, []string{"x"}); err != nil {
+			t.Fatalf("SetPrivate() unexpected error: %v", err)
+		}
+	}
+	if _, err := ds.SetPrivate("ONE MORE", 0x0041, 0x00, []string{"x"}); !errors.Is(err, ErrorNoFreePrivateBlock) {
+		t.Errorf("SetPrivate() unexpected error. got: %v, want: %v", err, ErrorNoFreePrivateBlock)
+	}
+	if _, err := ds.SetPrivate("ACME", 0x0040, 0x00, []string{"x"}); !errors.Is(err, tag.ErrorInvalidPrivateTag) {
+		t.Errorf("SetPrivate(even group) unexpected error. got: %v, want: %v", err, tag.ErrorInvalidPrivateTag)
+	}
+}
+
+func TestDataset_MergePrivate(t *testing.T) {
+	var dst, src Dataset
+	mustSetPrivate := func(ds *Dataset, creator string, offset uint8, value string) {
+		if _, err := ds.SetPrivate(creator, 0x0041, offset, []string{value}); err != nil {
+			t.Fatalf("SetPrivate() unexpected error: %v", err)
+		}
+	}
+	mustSetPrivate(&dst, "DST", 0x01, "dst")
+	mustSetPrivate(&src, "SRC", 0x01, "src")
+	mustSetPrivate(&src, "DST", 0x02, "merged")
+
+	if err := dst.MergePrivate(src); err != nil {
+		t.Fatalf("MergePrivate() unexpected error: %v", err)
+	}
+	for _, tc := range []struct {
+		creator string
+		offset  uint8
+		want    string
+	}{
+		{"DST", 0x01, "dst"},
+		{"DST", 0x02, "merged"},
+		{"SRC", 0x01, "src"},
+	} {
+		block, err := dst.reservePrivateBlock(tc.creator, 0x0041)
+		if err != nil {
+			t.Fatalf("reservePrivateBlock() unexpected error: %v", err)
+		}
+		elem, err := dst.FindElementByTag(tag.Tag{Group: 0x0041, Element: uint16(block)<<8 | uint16(tc.offset)})
+		if err != nil {
+			t.Fatalf("MergePrivate() missing %s element %02x: %v", tc.creator, tc.offset, err)
+		}
+		if got := MustGetStrings(elem.Value)[0]; got != tc.want {
+			t.Errorf("MergePrivate() unexpected %s element %02x. got: %v, want: %v", tc.creator, tc.offset, got, tc.want)
+		}
+	}
+	if len(dst.Elements) != 5 {
+		t.Errorf("MergePrivate() unexpected number of elements: %v", dst.Elements)
+	}
+}
+
+func TestDataset_MergePrivate_Error(t *testing.T) {
+	var dst Dataset
+	if _, err := dst.SetPrivate("DST", 0x0041, 0x01, []string{"dst"}); err != nil {
+		t.Fatalf("SetPrivate() unexpected error: %v", err)
+	}
+	want := append([]*Element(nil), dst.Elements...)
+	// The second Private Creator of src has no value, after the first one was
+	// resolved.
+	src := Dataset{Elements: []*Element{
+		{Tag: tag.Tag{Group: 0x0041, Element: 0x0010}, RawValueRepresentation: "LO", Value: &stringsValue{value: []string{"SRC"}}},
+		{Tag: tag.Tag{Group: 0x0041, Element: 0x0011}, RawValueRepresentation: "LO", Value: &stringsValue{}},
+		{Tag: tag.Tag{Group: 0x0041, Element: 0x1001}, RawValueRepresentation: "LO", Value: &stringsValue{value: []string{"src"}}},
+	}}
+	if err := dst.MergePrivate(src); !errors.Is(err, ErrorPrivateCreatorNotFound) {
+		t.Fatalf("MergePrivate() unexpected error. got: %v, want: %v", err, ErrorPrivateCreatorNotFound)
+	}
+	if diff := cmp.Diff(want, dst.Elements, cmp.AllowUnexported(stringsValue{})); diff != "" {
+		t.Errorf("MergePrivate() error changed the Dataset. diff: %v", diff)
+	}
+}
+
+func TestWrite_UnreservedPrivateBlock(t *testing.T) {
+	ds := Dataset{Elements: []*Element{
+		mustNewElement(tag.TransferSyntaxUID, []string{uid.ImplicitVRLittleEndian}),
+		{Tag: tag.Tag{Group: 0x0029, Element: 0x1001}, RawValueRepresentation: "LO", Value: &stringsValue{value: []string{"orphan"}}},
+	}}
+	var out bytes.Buffer
+	if err := Write(&out, ds); !errors.Is(err, ErrorPrivateCreatorNotFound) {
+		t.Errorf("Write() unexpected error. got: %v, want: %v", err, ErrorPrivateCreatorNotFound)
+	}
+	out.Reset()
+	if err := Write(&out, ds, SkipPrivateCreatorVerification()); err != nil {
+		t.Errorf("Write(SkipPrivateCreatorVerification) unexpected error: %v", err)
+	}
+}
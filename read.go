@@ -1,6 +1,7 @@
 package dicom
 
 import (
+	"bufio"
 	"bytes"
 	"encoding/binary"
 	"errors"
@@ -16,6 +17,7 @@ import (
 	"github.com/suyashkumar/dicom/pkg/dicomio"
 	"github.com/suyashkumar/dicom/pkg/frame"
 	"github.com/suyashkumar/dicom/pkg/tag"
+	"github.com/suyashkumar/dicom/pkg/uid"
 )
 
 var (
@@ -23,8 +25,11 @@ var (
 	// value length which is not allowed.
 	ErrorOWRequiresEvenVL = errors.New("vr of OW requires even value length")
 	// ErrorUnsupportedVR indicates that this VR is not supported.
-	ErrorUnsupportedVR      = errors.New("unsupported VR")
-	errorUnableToParseFloat = errors.New("unable to parse float type")
+	ErrorUnsupportedVR = errors.New("unsupported VR")
+	// ErrorPixelDataEncapsulation indicates that PixelData is encapsulated
+	// while the transfer syntax requires native pixel data, or the converse.
+	ErrorPixelDataEncapsulation = errors.New("PixelData encapsulation does not match the transfer syntax")
+	errorUnableToParseFloat     = errors.New("unable to parse float type")
 )
 
 func readTag(r dicomio.Reader) (*tag.Tag, error) {
@@ -38,10 +43,12 @@ func readTag(r dicomio.Reader) (*tag.Tag, error) {
 }
 
 // TODO: Parsed VR should be an enum. Will require refactors of tag pkg.
-func readVR(r dicomio.Reader, isImplicit bool, t tag.Tag) (string, error) {
+// For implicit VR, private data elements are resolved using the Private
+// Creator elements read so far into d, which may be nil.
+func readVR(r dicomio.Reader, isImplicit bool, t tag.Tag, d *Dataset) (string, error) {
 	if isImplicit {
-		if entry, err := tag.Find(t); err == nil {
-			return entry.VR, nil
+		if entry, err := findTagInfo(d, t); err == nil {
+			return implicitVR(entry, d), nil
 		}
 		return tag.UnknownVR, nil
 	}
@@ -51,6 +58,22 @@ func readVR(r dicomio.Reader, isImplicit bool, t tag.Tag) (string, error) {
 
 }
 
+// implicitVR picks the VR of an implicit VR element among the VRs its tag
+// allows, based on the elements read so far into d (which may be nil).
+func implicitVR(info tag.Info, d *Dataset) string {
+	// Tags defined as "US or SS" depend on the signedness of the pixel data.
+	// Other multi-VR tags (e.g. PixelData, LUTData) are OW in implicit VR
+	// transfer syntaxes, which is their default VR. See PS3.5 Annex A.1.
+	if info.VR == vrraw.UnsignedShort && info.AllowsVR(vrraw.SignedShort) && d != nil {
+		if elem, err := d.FindElementByTag(tag.PixelRepresentation); err == nil {
+			if v, ok := elem.Value.GetValue().([]int); ok && len(v) > 0 && v[0] == 1 {
+				return vrraw.SignedShort
+			}
+		}
+	}
+	return info.VR
+}
+
 func readVL(r dicomio.Reader, isImplicit bool, t tag.Tag, vr string) (uint32, error) {
 	if isImplicit {
 		return r.ReadUInt32()
@@ -61,9 +84,9 @@ func readVL(r dicomio.Reader, isImplicit bool, t tag.Tag, vr string) (uint32, er
 	switch vr {
 	// TODO: Parsed VR should be an enum. Will require refactors of tag pkg.
 	case "NA", vrraw.OtherByte, vrraw.OtherDouble, vrraw.OtherFloat,
-		vrraw.OtherLong, vrraw.OtherWord, vrraw.Sequence, vrraw.Unknown,
-		vrraw.UnlimitedCharacters, vrraw.UniversalResourceIdentifier,
-		vrraw.UnlimitedText:
+		vrraw.OtherLong, vrraw.OtherVeryLong, vrraw.OtherWord, vrraw.Sequence,
+		vrraw.SignedVeryLong, vrraw.Unknown, vrraw.UnlimitedCharacters,
+		vrraw.UniversalResourceIdentifier, vrraw.UnlimitedText, vrraw.UnsignedVeryLong:
 		_ = r.Skip(2) // ignore two reserved bytes (0000H)
 		vl, err := r.ReadUInt32()
 		if err != nil {
@@ -117,9 +140,34 @@ func readValue(r dicomio.Reader, t tag.Tag, vr string, vl uint32, isImplicit boo
 
 }
 
+// pixelDataEncapsulation indicates if the PixelData of d, with value length
+// vl, is encapsulated, and returns the UID of the transfer syntax of d. The
+// transfer syntax of d decides if it is known. Otherwise, e.g. in sequence
+// items, encapsulated PixelData is recognized by its undefined length, as it
+// is in files whose transfer syntax does not match their PixelData.
+func pixelDataEncapsulation(d *Dataset, vl uint32) (encapsulated bool, tsUID string) {
+	undefinedLength := vl == tag.VLUndefinedLength
+	if d == nil {
+		return undefinedLength, ""
+	}
+	ts, err := d.transferSyntax()
+	if err != nil {
+		return undefinedLength, ""
+	}
+	// Encapsulated PixelData always has an undefined length, and native
+	// PixelData a defined one (PS3.5 A.4).
+	if ts.Encapsulated != undefinedLength {
+		log.Printf("WARN: PixelData value length %d does not match transfer syntax %s, reading it as encapsulated=%v",
+			vl, uid.UIDString(ts.UID), undefinedLength)
+		return undefinedLength, ""
+	}
+	return ts.Encapsulated, ts.UID
+}
+
 func readPixelData(r dicomio.Reader, t tag.Tag, vr string, vl uint32, d *Dataset, fc chan<- *frame.Frame) (Value,
 	error) {
-	if vl == tag.VLUndefinedLength {
+	encapsulated, tsUID := pixelDataEncapsulation(d, vl)
+	if encapsulated {
 		var image PixelDataInfo
 		image.IsEncapsulated = true
 		// The first Item in PixelData is the basic offset table. Skip this for now.
@@ -142,7 +190,8 @@ func readPixelData(r dicomio.Reader, t tag.Tag, vr string, vl uint32, d *Dataset
 			f := frame.Frame{
 				Encapsulated: true,
 				EncapsulatedData: frame.EncapsulatedFrame{
-					Data: data,
+					Data:              data,
+					TransferSyntaxUID: tsUID,
 				},
 			}
 
@@ -210,11 +259,11 @@ func readNativeFrames(d dicomio.Reader, parsedData *Dataset, fc chan<- *frame.Fr
 	}
 	bitsAllocated := MustGetInts(b.Value)[0]
 
-	s, err := parsedData.FindElementByTag(tag.SamplesPerPixel)
-	if err != nil {
-		return nil, 0, err
+	// ACR-NEMA images have no SamplesPerPixel, and a single sample per pixel.
+	samplesPerPixel := 1
+	if s, err := parsedData.FindElementByTag(tag.SamplesPerPixel); err == nil {
+		samplesPerPixel = MustGetInts(s.Value)[0]
 	}
-	samplesPerPixel := MustGetInts(s.Value)[0]
 
 	pixelsPerFrame := MustGetInts(rows.Value)[0] * MustGetInts(cols.Value)[0]
 
@@ -359,7 +408,7 @@ func readSequenceItem(r dicomio.Reader, t tag.Tag, vr string, vl uint32) (Value,
 
 func readBytes(r dicomio.Reader, t tag.Tag, vr string, vl uint32) (Value, error) {
 	// TODO: add special handling of PixelData
-	if vr == vrraw.OtherByte {
+	if vr == vrraw.OtherByte || vr == vrraw.OtherVeryLong {
 		data := make([]byte, vl)
 		_, err := io.ReadFull(r, data)
 		return &bytesValue{value: data}, err
@@ -517,7 +566,7 @@ func readElement(r dicomio.Reader, d *Dataset, fc chan<- *frame.Frame) (*Element
 		readImplicit = true
 	}
 
-	vr, err := readVR(r, readImplicit, *t)
+	vr, err := readVR(r, readImplicit, *t, d)
 	if err != nil {
 		return nil, err
 	}
@@ -529,7 +578,12 @@ func readElement(r dicomio.Reader, d *Dataset, fc chan<- *frame.Frame) (*Element
 
 	// log.Println("readElement: vr, vl", vr, vl)
 
-	val, err := readValue(r, *t, vr, vl, readImplicit, d, fc)
+	var val Value
+	if vr == vrraw.Unknown && !readImplicit {
+		val, vr, err = readUnknown(r, *t, vl, d)
+	} else {
+		val, err = readValue(r, *t, vr, vl, readImplicit, d, fc)
+	}
 	if err != nil {
 		log.Println("error reading value ", err)
 		return nil, err
@@ -539,6 +593,55 @@ func readElement(r dicomio.Reader, d *Dataset, fc chan<- *frame.Frame) (*Element
 
 }
 
+// readUnknown reads the value of an element with VR UN in an explicit VR transfer syntax, and returns it along with
+// the VR it was read with. Values of UN elements are encoded in Implicit VR Little Endian (PS3.5 6.2.2): those with an
+// undefined length are sequences (CP-246), and those of tags known from the dictionary are read with their dictionary
+// VR. Other values are read as strings, like those of other unknown VRs.
+func readUnknown(r dicomio.Reader, t tag.Tag, vl uint32, d *Dataset) (Value, string, error) {
+	if vl == tag.VLUndefinedLength {
+		bo, implicit := r.ByteOrder(), r.IsImplicit()
+		r.SetTransferSyntax(binary.LittleEndian, true)
+		defer r.SetTransferSyntax(bo, implicit)
+		val, err := readSequence(r, t, vrraw.Sequence, vl)
+		return val, vrraw.Sequence, err
+	}
+
+	info, err := findTagInfo(d, t)
+	if err != nil || info.VR == vrraw.Unknown {
+		val, err := readString(r, t, vrraw.Unknown, vl)
+		return val, vrraw.Unknown, err
+	}
+	data := make([]byte, vl)
+	if _, err := io.ReadFull(r, data); err != nil {
+		return nil, "", err
+	}
+	vr := implicitVR(info, d)
+	if val, err := decodeUnknown(t, data, vr, d); err == nil {
+		return val, vr, nil
+	}
+	// The value does not match its dictionary VR, keep it as it was received.
+	val, err := decodeUnknown(t, data, vrraw.Unknown, d)
+	return val, vrraw.Unknown, err
+}
+
+// decodeUnknown decodes data, the value of an element with VR UN, as a value of VR vr encoded in Implicit VR Little
+// Endian.
+func decodeUnknown(t tag.Tag, data []byte, vr string, d *Dataset) (Value, error) {
+	r, err := dicomio.NewReader(bufio.NewReader(bytes.NewReader(data)), binary.LittleEndian, int64(len(data)))
+	if err != nil {
+		return nil, err
+	}
+	r.SetTransferSyntax(binary.LittleEndian, true)
+	val, err := readValue(r, t, vr, uint32(len(data)), true, d, nil)
+	if err != nil {
+		return nil, err
+	}
+	if !r.IsLimitExhausted() {
+		return nil, fmt.Errorf("%d bytes left after reading a value of VR %s", r.BytesLeftUntilLimit(), vr)
+	}
+	return val, nil
+}
+
 // Read an Item object as raw bytes, useful when parsing encapsulated PixelData.
 // This returns the read raw item, an indication if this is the end of the set
 // of items, and a possible error.
@@ -548,7 +651,7 @@ func readRawItem(r dicomio.Reader) ([]byte, bool, error) {
 		return nil, true, err
 	}
 	// Item is always encoded implicit. PS3.6 7.5
-	vr, err := readVR(r, true, *t)
+	vr, err := readVR(r, true, *t, nil)
 	if err != nil {
 		return nil, true, err
 	}
@@ -17,6 +17,7 @@ import (
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/suyashkumar/dicom/pkg/tag"
+	"github.com/suyashkumar/dicom/pkg/uid"
 )
 
 func TestReadTag(t *testing.T) {
@@ -416,3 +417,199 @@ func buildTagData(t *testing.T, tg tag.Tag) []byte {
 
 	return data.Bytes()
 }
+
+func TestReadPixelData_TransferSyntax(t *testing.T) {
+	// Encapsulated PixelData: an empty Basic Offset Table, one fragment and
+	// the Sequence Delimitation Item.
+	encapsulated := []byte{
+		0xFE, 0xFF, 0x00, 0xE0, 0x00, 0x00, 0x00, 0x00,
+		0xFE, 0xFF, 0x00, 0xE0, 0x02, 0x00, 0x00, 0x00, 0x01, 0x02,
+		0xFE, 0xFF, 0xDD, 0xE0, 0x00, 0x00, 0x00, 0x00,
+	}
+	native := []byte{0x01, 0x02}
+	imageElems := []*Element{
+		mustNewElement(tag.Rows, []int{1}),
+		mustNewElement(tag.Columns, []int{2}),
+		mustNewElement(tag.BitsAllocated, []int{8}),
+		mustNewElement(tag.SamplesPerPixel, []int{1}),
+	}
+	withTransferSyntax := func(ts string) *Dataset {
+		return &Dataset{Elements: append([]*Element{mustNewElement(tag.TransferSyntaxUID, []string{ts})}, imageElems...)}
+	}
+
+	cases := []struct {
+		name string
+		d    *Dataset
+		data []byte
+		vl   uint32
+		want PixelDataInfo
+	}{
+		{
+			name: "encapsulated transfer syntax",
+			d:    withTransferSyntax("1.2.840.10008.1.2.4.50"),
+			data: encapsulated,
+			vl:   tag.VLUndefinedLength,
+			want: PixelDataInfo{IsEncapsulated: true, Frames: []frame.Frame{
+				{Encapsulated: true, EncapsulatedData: frame.EncapsulatedFrame{Data: []byte{1, 2}, TransferSyntaxUID: "1.2.840.10008.1.2.4.50"}},
+			}},
+		},
+		{
+			name: "native transfer syntax",
+			d:    withTransferSyntax(uid.ExplicitVRLittleEndian),
+			data: native,
+			vl:   uint32(len(native)),
+			want: PixelDataInfo{Frames: []frame.Frame{
+				{NativeData: frame.NativeFrame{BitsPerSample: 8, Rows: 1, Cols: 2, Data: [][]int{{1}, {2}}}},
+			}},
+		},
+		{
+			name: "no transfer syntax, undefined length",
+			d:    &Dataset{Elements: imageElems},
+			data: encapsulated,
+			vl:   tag.VLUndefinedLength,
+			want: PixelDataInfo{IsEncapsulated: true, Frames: []frame.Frame{
+				{Encapsulated: true, EncapsulatedData: frame.EncapsulatedFrame{Data: []byte{1, 2}}},
+			}},
+		},
+		{
+			name: "undefined length in native transfer syntax",
+			d:    withTransferSyntax(uid.ExplicitVRLittleEndian),
+			data: encapsulated,
+			vl:   tag.VLUndefinedLength,
+			want: PixelDataInfo{IsEncapsulated: true, Frames: []frame.Frame{
+				{Encapsulated: true, EncapsulatedData: frame.EncapsulatedFrame{Data: []byte{1, 2}}},
+			}},
+		},
+		{
+			name: "defined length in encapsulated transfer syntax",
+			d:    withTransferSyntax("1.2.840.10008.1.2.5"),
+			data: native,
+			vl:   uint32(len(native)),
+			want: PixelDataInfo{Frames: []frame.Frame{
+				{NativeData: frame.NativeFrame{BitsPerSample: 8, Rows: 1, Cols: 2, Data: [][]int{{1}, {2}}}},
+			}},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			r, err := dicomio.NewReader(bufio.NewReader(bytes.NewReader(tc.data)), binary.LittleEndian, int64(len(tc.data)))
+			if err != nil {
+				t.Fatalf("unable to create new dicomio.Reader: %v", err)
+			}
+			r.SetTransferSyntax(binary.LittleEndian, false)
+			got, err := readPixelData(r, tag.PixelData, vrraw.OtherByte, tc.vl, tc.d, nil)
+			if err != nil {
+				t.Fatalf("readPixelData() unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.want, MustGetPixelDataInfo(got)); diff != "" {
+				t.Errorf("readPixelData() unexpected diff: %s", diff)
+			}
+		})
+	}
+}
+
+func TestImplicitVR(t *testing.T) {
+	signed := Dataset{Elements: []*Element{mustNewElement(tag.PixelRepresentation, []int{1})}}
+	unsigned := Dataset{Elements: []*Element{mustNewElement(tag.PixelRepresentation, []int{0})}}
+	cases := []struct {
+		name string
+		tag  tag.Tag
+		ds   *Dataset
+		want string
+	}{
+		{name: "signed pixel data", tag: tag.SmallestImagePixelValue, ds: &signed, want: vrraw.SignedShort},
+		{name: "unsigned pixel data", tag: tag.SmallestImagePixelValue, ds: &unsigned, want: vrraw.UnsignedShort},
+		{name: "no PixelRepresentation", tag: tag.SmallestImagePixelValue, ds: &Dataset{}, want: vrraw.UnsignedShort},
+		{name: "nil Dataset", tag: tag.PixelPaddingValue, want: vrraw.UnsignedShort},
+		{name: "PixelData", tag: tag.PixelData, ds: &signed, want: vrraw.OtherWord},
+		{name: "single VR", tag: tag.Rows, ds: &signed, want: vrraw.UnsignedShort},
+		{name: "repeating group", tag: tag.Tag{Group: 0x6002, Element: 0x3000}, ds: &signed, want: vrraw.OtherWord},
+		{name: "repeating group US", tag: tag.Tag{Group: 0x6004, Element: 0x0010}, ds: &signed, want: vrraw.UnsignedShort},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := implicitVR(tag.MustFind(tc.tag), tc.ds); got != tc.want {
+				t.Errorf("implicitVR(%s) got: %v, want: %v", tag.DebugString(tc.tag), got, tc.want)
+			}
+		})
+	}
+}
+
+func TestReadElement_Unknown(t *testing.T) {
+	// explicitUN is the header of an element with VR UN in Explicit VR Little Endian.
+	explicitUN := func(t tag.Tag, vl uint32) []byte {
+		b := []byte{byte(t.Group), byte(t.Group >> 8), byte(t.Element), byte(t.Element >> 8), 'U', 'N', 0, 0, 0, 0, 0, 0}
+		binary.LittleEndian.PutUint32(b[8:], vl)
+		return b
+	}
+	join := func(parts ...[]byte) []byte { return bytes.Join(parts, nil) }
+	// The PatientName element following each UN element checks that the
+	// transfer syntax of the reader is left as it was.
+	patientName := []byte{0x10, 0x00, 0x10, 0x00, 'P', 'N', 0x04, 0x00, 'B', 'o', 'b', ' '}
+
+	cases := []struct {
+		name   string
+		data   []byte
+		want   *Element
+		wantVR string
+	}{
+		{
+			name: "undefined length sequence",
+			data: join(
+				explicitUN(tag.ReferencedSeriesSequence, tag.VLUndefinedLength),
+				[]byte{0xFE, 0xFF, 0x00, 0xE0, 0xFF, 0xFF, 0xFF, 0xFF}, // Item, undefined length
+				[]byte{0x20, 0x00, 0x0E, 0x00, 0x04, 0x00, 0x00, 0x00, '1', '.', '2', 0},
+				[]byte{0xFE, 0xFF, 0x0D, 0xE0, 0x00, 0x00, 0x00, 0x00}, // Item Delimitation Item
+				[]byte{0xFE, 0xFF, 0xDD, 0xE0, 0x00, 0x00, 0x00, 0x00}, // Sequence Delimitation Item
+				patientName,
+			),
+			want: makeSequenceElement(tag.ReferencedSeriesSequence, [][]*Element{
+				{{Tag: tag.SeriesInstanceUID, ValueRepresentation: tag.VRStringList, RawValueRepresentation: vrraw.UniqueIdentifier,
+					ValueLength: 4, Value: &stringsValue{value: []string{"1.2"}}}},
+			}),
+			wantVR: vrraw.Sequence,
+		},
+		{
+			name:   "dictionary VR",
+			data:   join(explicitUN(tag.Rows, 4), []byte{0x80, 0x00, 0x00, 0x01}, patientName),
+			want:   mustNewElement(tag.Rows, []int{128, 256}),
+			wantVR: vrraw.UnsignedShort,
+		},
+		{
+			name:   "value not matching the dictionary VR",
+			data:   join(explicitUN(tag.Rows, 3), []byte{'a', 'b', 'c'}, patientName),
+			want:   &Element{Tag: tag.Rows, Value: &stringsValue{value: []string{"abc"}}},
+			wantVR: vrraw.Unknown,
+		},
+		{
+			name:   "tag missing from the dictionary",
+			data:   join(explicitUN(tag.Tag{Group: 0x0009, Element: 0x1001}, 2), []byte{'a', 'b'}, patientName),
+			want:   &Element{Tag: tag.Tag{Group: 0x0009, Element: 0x1001}, Value: &stringsValue{value: []string{"ab"}}},
+			wantVR: vrraw.Unknown,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			r, err := dicomio.NewReader(bufio.NewReader(bytes.NewReader(tc.data)), binary.LittleEndian, int64(len(tc.data)))
+			if err != nil {
+				t.Fatalf("NewReader() unexpected error: %v", err)
+			}
+			r.SetTransferSyntax(binary.LittleEndian, false)
+			got, err := readElement(r, &Dataset{}, nil)
+			if err != nil {
+				t.Fatalf("readElement() unexpected error: %v", err)
+			}
+			if got.RawValueRepresentation != tc.wantVR {
+				t.Errorf("readElement() unexpected VR. got: %v, want: %v", got.RawValueRepresentation, tc.wantVR)
+			}
+			if diff := cmp.Diff(tc.want.Value, got.Value, cmp.AllowUnexported(allValues...)); diff != "" {
+				t.Errorf("readElement() unexpected value. diff: %v", diff)
+			}
+
+			next, err := readElement(r, &Dataset{}, nil)
+			if err != nil || next.Tag != tag.PatientName || next.RawValueRepresentation != vrraw.PersonName {
+				t.Errorf("readElement() after the UN element got: %v, %v, want PatientName with VR PN", next, err)
+			}
+		})
+	}
+}
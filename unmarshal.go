@@ -0,0 +1,630 @@
+package dicom
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/suyashkumar/dicom/pkg/personname"
+	"github.com/suyashkumar/dicom/pkg/tag"
+	"github.com/suyashkumar/dicom/pkg/vrraw"
+)
+
+var (
+	// ErrorInvalidUnmarshalTarget indicates that the value passed to Unmarshal
+	// was not a non-nil pointer to a struct.
+	ErrorInvalidUnmarshalTarget = errors.New("dicom: Unmarshal target must be a non-nil pointer to a struct")
+	// ErrorInvalidStructTag indicates that a `dicom:"..."` struct tag could not
+	// be resolved to a DICOM tag.
+	ErrorInvalidStructTag = errors.New("dicom: invalid struct tag")
+	// ErrorUnsupportedFieldType indicates that a struct field has a Go type that
+	// cannot hold a DICOM Element value.
+	ErrorUnsupportedFieldType = errors.New("unsupported Go field type")
+	// ErrorValueMultiplicity indicates that an Element has more values than the
+	// target Go field can hold (for example, several values into an int).
+	ErrorValueMultiplicity = errors.New("element has more values than the field can hold")
+)
+
+var (
+	timeType       = reflect.TypeOf(time.Time{})
+	personNameType = reflect.TypeOf(personname.Info{})
+	pixelDataType  = reflect.TypeOf(PixelDataInfo{})
+)
+
+// UnmarshalError describes a failure to unmarshal a DICOM Element into a Go
+// struct field.
+type UnmarshalError struct {
+	// Tag is the tag of the Element that failed to unmarshal.
+	Tag tag.Tag
+	// Path is the location of the Element within the Dataset, including the
+	// enclosing Sequences and Item indices, e.g.
+	// "ReferencedSeriesSequence[0].SeriesInstanceUID".
+	Path string
+	// Field is the name of the Go struct field being populated.
+	Field string
+	// Type is the Go type of the struct field being populated.
+	Type reflect.Type
+	// Err is the underlying reason for the failure.
+	Err error
+}
+
+func (e *UnmarshalError) Error() string {
+	return fmt.Sprintf("dicom: cannot unmarshal %s %s into Go struct field %s of type %v: %v",
+		e.Path, e.Tag, e.Field, e.Type, e.Err)
+}
+
+// Unwrap returns the underlying error.
+func (e *UnmarshalError) Unwrap() error { return e.Err }
+
+// Unmarshal populates the struct pointed to by v with values from the Elements
+// in the provided Dataset. Struct fields are matched to Elements using struct
+// tags holding either the DICOM keyword or the "gggg,eeee" tag:
+//
+//	type Series struct {
+//		PatientName  personname.Info `dicom:"PatientName"`
+//		StudyDate    time.Time       `dicom:"StudyDate"`
+//		Position     []float64       `dicom:"0020,0032"`
+//		Rows         int             `dicom:"Rows"`
+//		Referenced   []Reference     `dicom:"ReferencedImageSequence"`
+//	}
+//
+// Supported field types are string, []string, all integer and float kinds (and
+// slices of them), []byte, time.Time (from DA, TM and DT values),
+// personname.Info (from PN values) and PixelDataInfo. Numbers are parsed out of
+// DS and IS strings as needed. Sequences are unmarshaled into nested structs
+// (exactly zero or one Item), slices of structs, or pointers to either.
+//
+// Fields without a dicom struct tag, fields tagged with "-" and Elements that
+// are not present in the Dataset are left untouched. Nil pointer fields are
+// only allocated for Elements that have a value, so that an empty (e.g. Type 2)
+// Element leaves them nil. Anonymous struct fields without a dicom tag are
+// treated as if their fields were part of the outer struct. The ",omitempty"
+// option only applies to Marshal, and is ignored by Unmarshal, so that the same
+// struct can be used for both.
+//
+// If an Element cannot be converted to its field, Unmarshal returns an
+// *UnmarshalError describing which Element failed and why.
+func Unmarshal(ds Dataset, v interface{}) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		return ErrorInvalidUnmarshalTarget
+	}
+	return unmarshalElements(ds.Elements, rv.Elem(), "")
+}
+
+// structField represents a single tagged field of a Go struct.
+type structField struct {
+	// index is the index sequence of this field for reflect.Value.FieldByIndex.
+	index []int
+	name  string
+	tag   tag.Tag
+	// keyword is the dictionary keyword of tag, if it has one.
+	keyword   string
+	omitEmpty bool
+}
+
+// structFields returns all the dicom-tagged fields of the provided struct type.
+func structFields(t reflect.Type) ([]structField, error) {
+	var fields []structField
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		structTag, hasTag := f.Tag.Lookup("dicom")
+		if structTag == "-" {
+			continue
+		}
+		if f.Anonymous && !hasTag {
+			ft := f.Type
+			if ft.Kind() == reflect.Ptr {
+				ft = ft.Elem()
+			}
+			if ft.Kind() != reflect.Struct {
+				continue
+			}
+			embedded, err := structFields(ft)
+			if err != nil {
+				return nil, err
+			}
+			for _, ef := range embedded {
+				ef.index = append([]int{i}, ef.index...)
+				fields = append(fields, ef)
+			}
+			continue
+		}
+		if !hasTag || f.PkgPath != "" {
+			// Untagged or unexported.
+			continue
+		}
+		t, omitEmpty, err := parseStructTag(structTag)
+		if err != nil {
+			return nil, fmt.Errorf("%w on field %s: %v", ErrorInvalidStructTag, f.Name, err)
+		}
+		var keyword string
+		if info, err := tag.Find(t); err == nil {
+			keyword = info.Keyword
+		}
+		fields = append(fields, structField{
+			index:     []int{i},
+			name:      f.Name,
+			tag:       t,
+			keyword:   keyword,
+			omitEmpty: omitEmpty,
+		})
+	}
+	return fields, nil
+}
+
+// parseStructTag parses a `dicom:"..."` struct tag. The tag name is either a
+// DICOM keyword (e.g. "PatientName") or a hex tag in "gggg,eeee", "(gggg,eeee)"
+// or "ggggeeee" form, optionally followed by ",omitempty".
+func parseStructTag(s string) (t tag.Tag, omitEmpty bool, err error) {
+	parts := strings.Split(s, ",")
+	name := parts[0]
+	opts := parts[1:]
+	if len(parts) >= 2 && isHexTagPart(parts[0]) && isHexTagPart(parts[1]) {
+		name = parts[0] + "," + parts[1]
+		opts = parts[2:]
+	}
+	for _, opt := range opts {
+		switch opt {
+		case "omitempty":
+			omitEmpty = true
+		default:
+			return tag.Tag{}, false, fmt.Errorf("unknown option %q", opt)
+		}
+	}
+	t, err = parseTagName(name)
+	return t, omitEmpty, err
+}
+
+func isHexTagPart(s string) bool {
+	s = strings.Trim(s, "() ")
+	if len(s) != 4 {
+		return false
+	}
+	_, err := strconv.ParseUint(s, 16, 16)
+	return err == nil
+}
+
+// parseTagName resolves a keyword or hex tag string to a tag.Tag.
+func parseTagName(name string) (tag.Tag, error) {
+	hex := strings.Trim(strings.Replace(name, " ", "", -1), "()")
+	hex = strings.Replace(hex, ",", "", 1)
+	if len(hex) == 8 {
+		if v, err := strconv.ParseUint(hex, 16, 32); err == nil {
+			return tag.Tag{Group: uint16(v >> 16), Element: uint16(v)}, nil
+		}
+	}
+	info, err := tag.FindByName(name)
+	if err != nil {
+		return tag.Tag{}, err
+	}
+	return info.Tag, nil
+}
+
+// elementPath returns the path used in errors for an Element nested at prefix.
+func elementPath(prefix string, f structField) string {
+	name := f.keyword
+	if name == "" {
+		name = f.tag.String()
+	}
+	if prefix == "" {
+		return name
+	}
+	return prefix + "." + name
+}
+
+func unmarshalElements(elems []*Element, rv reflect.Value, path string) error {
+	fields, err := structFields(rv.Type())
+	if err != nil {
+		return err
+	}
+	byTag := make(map[tag.Tag]*Element, len(elems))
+	for _, e := range elems {
+		byTag[e.Tag] = e
+	}
+	for _, f := range fields {
+		elem, ok := byTag[f.tag]
+		if !ok || elem.Value == nil {
+			continue
+		}
+		fv, err := fieldByIndexAlloc(rv, f.index)
+		if err != nil {
+			return err
+		}
+		fieldPath := elementPath(path, f)
+		if err := unmarshalValue(elem, fv, fieldPath); err != nil {
+			var uErr *UnmarshalError
+			if errors.As(err, &uErr) {
+				return err
+			}
+			return &UnmarshalError{Tag: elem.Tag, Path: fieldPath, Field: f.name, Type: fv.Type(), Err: err}
+		}
+	}
+	return nil
+}
+
+// fieldByIndexAlloc is like reflect.Value.FieldByIndex, but allocates nil
+// embedded struct pointers along the way.
+func fieldByIndexAlloc(v reflect.Value, index []int) (reflect.Value, error) {
+	for i, x := range index {
+		if i > 0 && v.Kind() == reflect.Ptr {
+			if v.IsNil() {
+				if !v.CanSet() {
+					return reflect.Value{}, fmt.Errorf("dicom: cannot set embedded pointer to unexported struct %v", v.Type().Elem())
+				}
+				v.Set(reflect.New(v.Type().Elem()))
+			}
+			v = v.Elem()
+		}
+		v = v.Field(x)
+	}
+	return v, nil
+}
+
+// unmarshalValue sets fv from the Value of elem.
+func unmarshalValue(elem *Element, fv reflect.Value, path string) error {
+	if fv.Kind() == reflect.Ptr {
+		if fv.IsNil() {
+			if isEmptyValue(elem.Value) {
+				return nil
+			}
+			fv.Set(reflect.New(fv.Type().Elem()))
+		}
+		return unmarshalValue(elem, fv.Elem(), path)
+	}
+
+	switch elem.Value.ValueType() {
+	case Sequences:
+		return unmarshalSequence(elem, fv, path)
+	case PixelData:
+		if fv.Type() != pixelDataType {
+			return fmt.Errorf("%w: cannot hold PixelData", ErrorUnsupportedFieldType)
+		}
+		fv.Set(reflect.ValueOf(MustGetPixelDataInfo(elem.Value)))
+		return nil
+	case Bytes:
+		if fv.Kind() != reflect.Slice || fv.Type().Elem().Kind() != reflect.Uint8 {
+			return fmt.Errorf("%w: cannot hold Bytes", ErrorUnsupportedFieldType)
+		}
+		fv.SetBytes(MustGetBytes(elem.Value))
+		return nil
+	}
+
+	if fv.Kind() == reflect.Slice && fv.Type().Elem().Kind() != reflect.Uint8 {
+		n := valueLen(elem.Value)
+		slice := reflect.MakeSlice(fv.Type(), n, n)
+		for i := 0; i < n; i++ {
+			if err := unmarshalScalar(elem, i, slice.Index(i)); err != nil {
+				return fmt.Errorf("value %d: %w", i, err)
+			}
+		}
+		fv.Set(slice)
+		return nil
+	}
+
+	n := valueLen(elem.Value)
+	if n == 0 {
+		return nil
+	}
+	if fv.Kind() == reflect.String && elem.Value.ValueType() == Strings {
+		// Multi-valued strings are kept in their backslash separated form.
+		fv.SetString(strings.Join(MustGetStrings(elem.Value), "\\"))
+		return nil
+	}
+	if n > 1 {
+		return fmt.Errorf("%w: got %d values", ErrorValueMultiplicity, n)
+	}
+	return unmarshalScalar(elem, 0, fv)
+}
+
+func unmarshalSequence(elem *Element, fv reflect.Value, path string) error {
+	items := elem.Value.GetValue().([]*SequenceItemValue)
+	switch {
+	case fv.Kind() == reflect.Struct && fv.Type() != timeType && fv.Type() != personNameType:
+		if len(items) == 0 {
+			return nil
+		}
+		if len(items) > 1 {
+			return fmt.Errorf("%w: sequence has %d items, use a slice field", ErrorValueMultiplicity, len(items))
+		}
+		return unmarshalElements(items[0].elements, fv, path+"[0]")
+	case fv.Kind() == reflect.Slice:
+		structType := fv.Type().Elem()
+		isPtr := structType.Kind() == reflect.Ptr
+		if isPtr {
+			structType = structType.Elem()
+		}
+		if structType.Kind() != reflect.Struct {
+			break
+		}
+		slice := reflect.MakeSlice(fv.Type(), len(items), len(items))
+		for i, item := range items {
+			target := slice.Index(i)
+			if isPtr {
+				target.Set(reflect.New(structType))
+				target = target.Elem()
+			}
+			if err := unmarshalElements(item.elements, target, fmt.Sprintf("%s[%d]", path, i)); err != nil {
+				return err
+			}
+		}
+		fv.Set(slice)
+		return nil
+	}
+	return fmt.Errorf("%w: sequences require a struct or slice of structs", ErrorUnsupportedFieldType)
+}
+
+// valueLen returns the number of values held in v.
+func valueLen(v Value) int {
+	return reflect.ValueOf(v.GetValue()).Len()
+}
+
+// isEmptyValue reports whether v holds no value, e.g. the value of a Type 2
+// Element present with a zero length, which is read as a single empty string.
+func isEmptyValue(v Value) bool {
+	switch v.ValueType() {
+	case PixelData:
+		return false
+	case Strings:
+		for _, s := range MustGetStrings(v) {
+			if strings.TrimSpace(s) != "" {
+				return false
+			}
+		}
+		return true
+	}
+	return valueLen(v) == 0
+}
+
+// unmarshalScalar sets fv to the i-th value held by elem.
+func unmarshalScalar(elem *Element, i int, fv reflect.Value) error {
+	switch fv.Type() {
+	case timeType:
+		s, err := stringValueAt(elem, i)
+		if err != nil {
+			return err
+		}
+		t, err := parseDICOMTime(elementVR(elem), s)
+		if err != nil {
+			return err
+		}
+		fv.Set(reflect.ValueOf(t))
+		return nil
+	case personNameType:
+		s, err := stringValueAt(elem, i)
+		if err != nil {
+			return err
+		}
+		pn, err := personname.Parse(s)
+		if err != nil {
+			return err
+		}
+		fv.Set(reflect.ValueOf(pn))
+		return nil
+	}
+
+	switch fv.Kind() {
+	case reflect.String:
+		s, err := stringValueAt(elem, i)
+		if err != nil {
+			return err
+		}
+		fv.SetString(s)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := intValueAt(elem, i)
+		if err != nil {
+			return err
+		}
+		if fv.OverflowInt(n) {
+			return fmt.Errorf("value %d overflows %v", n, fv.Type())
+		}
+		fv.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := intValueAt(elem, i)
+		if err != nil {
+			return err
+		}
+		if n < 0 || fv.OverflowUint(uint64(n)) {
+			return fmt.Errorf("value %d overflows %v", n, fv.Type())
+		}
+		fv.SetUint(uint64(n))
+	case reflect.Float32, reflect.Float64:
+		f, err := floatValueAt(elem, i)
+		if err != nil {
+			return err
+		}
+		fv.SetFloat(f)
+	default:
+		return fmt.Errorf("%w: %v", ErrorUnsupportedFieldType, fv.Type())
+	}
+	return nil
+}
+
+func stringValueAt(elem *Element, i int) (string, error) {
+	if elem.Value.ValueType() != Strings {
+		return "", fmt.Errorf("cannot convert ValueType %v to string", elem.Value.ValueType())
+	}
+	return MustGetStrings(elem.Value)[i], nil
+}
+
+func intValueAt(elem *Element, i int) (int64, error) {
+	switch elem.Value.ValueType() {
+	case Ints:
+		return int64(MustGetInts(elem.Value)[i]), nil
+	case Strings:
+		s := strings.TrimSpace(MustGetStrings(elem.Value)[i])
+		n, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid integer string %q", s)
+		}
+		return n, nil
+	default:
+		return 0, fmt.Errorf("cannot convert ValueType %v to integer", elem.Value.ValueType())
+	}
+}
+
+func floatValueAt(elem *Element, i int) (float64, error) {
+	switch elem.Value.ValueType() {
+	case Floats:
+		return MustGetFloats(elem.Value)[i], nil
+	case Ints:
+		return float64(MustGetInts(elem.Value)[i]), nil
+	case Strings:
+		s := strings.TrimSpace(MustGetStrings(elem.Value)[i])
+		f, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid decimal string %q", s)
+		}
+		return f, nil
+	default:
+		return 0, fmt.Errorf("cannot convert ValueType %v to float", elem.Value.ValueType())
+	}
+}
+
+// elementVR returns the raw VR of elem, falling back to the dictionary VR.
+func elementVR(elem *Element) string {
+	if elem.RawValueRepresentation != "" {
+		return elem.RawValueRepresentation
+	}
+	if info, err := tag.Find(elem.Tag); err == nil {
+		return info.VR
+	}
+	return ""
+}
+
+// parseDICOMTime parses a DA, TM or DT value into a time.Time. Values of TM are
+// returned on the zero date (0000-01-01), and values without an explicit UTC
+// offset are returned in UTC.
+func parseDICOMTime(vr, s string) (time.Time, error) {
+	s = strings.TrimSpace(s)
+	switch vr {
+	case vrraw.Date:
+		return parseDate(s)
+	case vrraw.Time:
+		return parseTime(s)
+	case vrraw.DateTime:
+		return parseDateTime(s)
+	}
+	// Unknown VR (for example UN); guess from the shape of the value.
+	switch {
+	case len(s) == 8 && !strings.ContainsAny(s, ".:+-&"):
+		return parseDate(s)
+	case len(s) <= 6 || strings.Contains(s, ":"):
+		return parseTime(s)
+	default:
+		return parseDateTime(s)
+	}
+}
+
+// parseDate parses a DA value (YYYYMMDD). The ACR-NEMA YYYY.MM.DD form is also
+// accepted.
+func parseDate(s string) (time.Time, error) {
+	s = strings.Replace(s, ".", "", -1)
+	t, err := time.Parse("20060102", s)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid DA value %q", s)
+	}
+	return t, nil
+}
+
+// parseTime parses a TM value (HH[MM[SS[.F{1-6}]]]). The ACR-NEMA
+// HH:MM:SS.frac form is also accepted.
+func parseTime(s string) (time.Time, error) {
+	raw := s
+	s = strings.Replace(s, ":", "", -1)
+	h, m, sec, nsec, err := parseTimeComponents(s)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid TM value %q", raw)
+	}
+	return time.Date(0, time.January, 1, h, m, sec, nsec, time.UTC), nil
+}
+
+// parseDateTime parses a DT value (YYYY[MM[DD[HH[MM[SS[.F{1-6}]]]]]][&ZZXX]).
+func parseDateTime(s string) (time.Time, error) {
+	invalid := fmt.Errorf("invalid DT value %q", s)
+	loc := time.UTC
+	if idx := strings.IndexAny(s, "+-"); idx >= 0 {
+		offset := s[idx:]
+		s = s[:idx]
+		if len(offset) != 5 {
+			return time.Time{}, invalid
+		}
+		hh, err1 := strconv.Atoi(offset[1:3])
+		mm, err2 := strconv.Atoi(offset[3:5])
+		if err1 != nil || err2 != nil {
+			return time.Time{}, invalid
+		}
+		secs := hh*3600 + mm*60
+		if offset[0] == '-' {
+			secs = -secs
+		}
+		loc = time.FixedZone(offset, secs)
+	}
+	if len(s) < 4 {
+		return time.Time{}, invalid
+	}
+	datePart := s
+	timePart := ""
+	if len(s) > 8 {
+		datePart, timePart = s[:8], s[8:]
+	}
+	year, month, day := 0, 1, 1
+	var err error
+	if year, err = strconv.Atoi(datePart[:4]); err != nil {
+		return time.Time{}, invalid
+	}
+	if len(datePart) >= 6 {
+		if month, err = strconv.Atoi(datePart[4:6]); err != nil || month < 1 || month > 12 {
+			return time.Time{}, invalid
+		}
+	}
+	if len(datePart) == 8 {
+		if day, err = strconv.Atoi(datePart[6:8]); err != nil || day < 1 || day > 31 {
+			return time.Time{}, invalid
+		}
+	}
+	if len(datePart) != 4 && len(datePart) != 6 && len(datePart) != 8 {
+		return time.Time{}, invalid
+	}
+	h, m, sec, nsec := 0, 0, 0, 0
+	if timePart != "" {
+		if h, m, sec, nsec, err = parseTimeComponents(timePart); err != nil {
+			return time.Time{}, invalid
+		}
+	}
+	return time.Date(year, time.Month(month), day, h, m, sec, nsec, loc), nil
+}
+
+// parseTimeComponents parses HH[MM[SS[.F{1-6}]]].
+func parseTimeComponents(s string) (h, m, sec, nsec int, err error) {
+	frac := ""
+	if idx := strings.Index(s, "."); idx >= 0 {
+		s, frac = s[:idx], s[idx+1:]
+		if len(s) != 6 || len(frac) == 0 || len(frac) > 6 {
+			return 0, 0, 0, 0, errors.New("invalid fractional seconds")
+		}
+	}
+	if len(s) != 2 && len(s) != 4 && len(s) != 6 {
+		return 0, 0, 0, 0, errors.New("invalid time length")
+	}
+	parts := []*int{&h, &m, &sec}
+	limits := []int{23, 59, 60} // 60 allows for leap seconds.
+	for i := 0; i*2 < len(s); i++ {
+		v, err := strconv.Atoi(s[i*2 : i*2+2])
+		if err != nil || v < 0 || v > limits[i] {
+			return 0, 0, 0, 0, errors.New("invalid time component")
+		}
+		*parts[i] = v
+	}
+	if frac != "" {
+		f, err := strconv.Atoi(frac + strings.Repeat("0", 9-len(frac)))
+		if err != nil {
+			return 0, 0, 0, 0, errors.New("invalid fractional seconds")
+		}
+		nsec = f
+	}
+	return h, m, sec, nsec, nil
+}
@@ -0,0 +1,264 @@
+package dicom
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/suyashkumar/dicom/pkg/personname"
+	"github.com/suyashkumar/dicom/pkg/tag"
+)
+
+type unmarshalTestReference struct {
+	SOPClassUID    string `dicom:"ReferencedSOPClassUID"`
+	SOPInstanceUID string `dicom:"ReferencedSOPInstanceUID"`
+}
+
+type unmarshalTestPatient struct {
+	Name      personname.Info `dicom:"PatientName"`
+	ID        string          `dicom:"PatientID"`
+	BirthDate time.Time       `dicom:"PatientBirthDate"`
+}
+
+type unmarshalTestImage struct {
+	unmarshalTestPatient
+	ImageType       []string                 `dicom:"ImageType"`
+	StudyTime       time.Time                `dicom:"StudyTime"`
+	AcquisitionTime time.Time                `dicom:"AcquisitionDateTime"`
+	Position        []float64                `dicom:"0020,0032"`
+	Rows            int                      `dicom:"(0028,0010)"`
+	Columns         uint16                   `dicom:"Columns"`
+	NumberOfFrames  int                      `dicom:"NumberOfFrames"`
+	SliceThickness  float64                  `dicom:"SliceThickness"`
+	InstanceNumber  *int                     `dicom:"InstanceNumber"`
+	References      []unmarshalTestReference `dicom:"ReferencedImageSequence"`
+	Source          *unmarshalTestReference  `dicom:"SourceImageSequence"`
+	Missing         string                   `dicom:"StudyDescription"`
+	Ignored         string
+	Skipped         string `dicom:"-"`
+}
+
+func TestUnmarshal(t *testing.T) {
+	ds := Dataset{Elements: []*Element{
+		mustNewElement(tag.PatientName, []string{"Potter^Harry"}),
+		mustNewElement(tag.PatientID, []string{"1234"}),
+		mustNewElement(tag.PatientBirthDate, []string{"19800731"}),
+		mustNewElement(tag.ImageType, []string{"ORIGINAL", "PRIMARY"}),
+		mustNewElement(tag.StudyTime, []string{"101530.25"}),
+		mustNewElement(tag.AcquisitionDateTime, []string{"20200102030405.5+0100"}),
+		mustNewElement(tag.ImagePositionPatient, []string{"-1.5", "2", "3e1"}),
+		mustNewElement(tag.Rows, []int{128}),
+		mustNewElement(tag.Columns, []int{256}),
+		mustNewElement(tag.NumberOfFrames, []string{" 3"}),
+		mustNewElement(tag.SliceThickness, []string{"0.625"}),
+		mustNewElement(tag.InstanceNumber, []string{"7"}),
+		makeSequenceElement(tag.ReferencedImageSequence, [][]*Element{
+			{
+				mustNewElement(tag.ReferencedSOPClassUID, []string{"1.2.3"}),
+				mustNewElement(tag.ReferencedSOPInstanceUID, []string{"1.2.3.4"}),
+			},
+			{
+				mustNewElement(tag.ReferencedSOPClassUID, []string{"1.2.3"}),
+				mustNewElement(tag.ReferencedSOPInstanceUID, []string{"1.2.3.5"}),
+			},
+		}),
+		makeSequenceElement(tag.SourceImageSequence, [][]*Element{
+			{mustNewElement(tag.ReferencedSOPInstanceUID, []string{"9.8.7"})},
+		}),
+		mustNewElement(tag.StudyID, []string{"unused"}),
+	}}
+
+	instanceNumber := 7
+	want := unmarshalTestImage{
+		unmarshalTestPatient: unmarshalTestPatient{
+			Name: personname.Info{
+				Alphabetic: personname.GroupInfo{FamilyName: "Potter", GivenName: "Harry"},
+			},
+			ID:        "1234",
+			BirthDate: time.Date(1980, time.July, 31, 0, 0, 0, 0, time.UTC),
+		},
+		ImageType:       []string{"ORIGINAL", "PRIMARY"},
+		StudyTime:       time.Date(0, time.January, 1, 10, 15, 30, 250000000, time.UTC),
+		AcquisitionTime: time.Date(2020, time.January, 2, 3, 4, 5, 500000000, time.FixedZone("+0100", 3600)),
+		Position:        []float64{-1.5, 2, 30},
+		Rows:            128,
+		Columns:         256,
+		NumberOfFrames:  3,
+		SliceThickness:  0.625,
+		InstanceNumber:  &instanceNumber,
+		References: []unmarshalTestReference{
+			{SOPClassUID: "1.2.3", SOPInstanceUID: "1.2.3.4"},
+			{SOPClassUID: "1.2.3", SOPInstanceUID: "1.2.3.5"},
+		},
+		Source:  &unmarshalTestReference{SOPInstanceUID: "9.8.7"},
+		Ignored: "keep",
+		Skipped: "keep",
+	}
+
+	got := unmarshalTestImage{Ignored: "keep", Skipped: "keep"}
+	if err := Unmarshal(ds, &got); err != nil {
+		t.Fatalf("Unmarshal() unexpected error: %v", err)
+	}
+	if diff := cmp.Diff(want, got, cmp.AllowUnexported(unmarshalTestImage{}), cmp.Comparer(func(a, b time.Time) bool { return a.Equal(b) })); diff != "" {
+		t.Errorf("Unmarshal() unexpected result. diff: %v", diff)
+	}
+}
+
+func TestUnmarshal_EmptyPointers(t *testing.T) {
+	ds := Dataset{Elements: []*Element{
+		mustNewElement(tag.InstanceNumber, []string{""}),
+		mustNewElement(tag.Rows, []int{}),
+		makeSequenceElement(tag.SourceImageSequence, nil),
+		mustNewElement(tag.PatientID, []string{"123"}),
+	}}
+	var got struct {
+		InstanceNumber *int                    `dicom:"InstanceNumber"`
+		Rows           *uint16                 `dicom:"Rows"`
+		Source         *unmarshalTestReference `dicom:"SourceImageSequence"`
+		PatientID      *string                 `dicom:"PatientID,omitempty"`
+	}
+	if err := Unmarshal(ds, &got); err != nil {
+		t.Fatalf("Unmarshal() unexpected error: %v", err)
+	}
+	if got.InstanceNumber != nil || got.Rows != nil || got.Source != nil {
+		t.Errorf("Unmarshal() allocated pointers for empty elements: InstanceNumber=%v, Rows=%v, Source=%v", got.InstanceNumber, got.Rows, got.Source)
+	}
+	if got.PatientID == nil || *got.PatientID != "123" {
+		t.Errorf("Unmarshal() unexpected PatientID: %v, want 123", got.PatientID)
+	}
+}
+
+func TestUnmarshal_Errors(t *testing.T) {
+	cases := []struct {
+		name      string
+		ds        Dataset
+		target    interface{}
+		wantPath  string
+		wantTag   tag.Tag
+		wantError error
+	}{
+		{
+			name: "bad integer string",
+			ds:   Dataset{Elements: []*Element{mustNewElement(tag.NumberOfFrames, []string{"three"})}},
+			target: &struct {
+				N int `dicom:"NumberOfFrames"`
+			}{},
+			wantPath: "NumberOfFrames",
+			wantTag:  tag.NumberOfFrames,
+		},
+		{
+			name: "too many values",
+			ds:   Dataset{Elements: []*Element{mustNewElement(tag.ImagePositionPatient, []string{"1", "2", "3"})}},
+			target: &struct {
+				P float64 `dicom:"ImagePositionPatient"`
+			}{},
+			wantPath:  "ImagePositionPatient",
+			wantTag:   tag.ImagePositionPatient,
+			wantError: ErrorValueMultiplicity,
+		},
+		{
+			name: "overflow",
+			ds:   Dataset{Elements: []*Element{mustNewElement(tag.Rows, []int{300})}},
+			target: &struct {
+				R uint8 `dicom:"Rows"`
+			}{},
+			wantPath: "Rows",
+			wantTag:  tag.Rows,
+		},
+		{
+			name: "nested bad date",
+			ds: Dataset{Elements: []*Element{
+				makeSequenceElement(tag.ReferencedStudySequence, [][]*Element{
+					{mustNewElement(tag.StudyDate, []string{"20201340"})},
+				}),
+			}},
+			target: &struct {
+				Studies []struct {
+					Date time.Time `dicom:"StudyDate"`
+				} `dicom:"ReferencedStudySequence"`
+			}{},
+			wantPath: "ReferencedStudySequence[0].StudyDate",
+			wantTag:  tag.StudyDate,
+		},
+		{
+			name: "unsupported field type",
+			ds:   Dataset{Elements: []*Element{mustNewElement(tag.PatientID, []string{"1"})}},
+			target: &struct {
+				ID map[string]string `dicom:"PatientID"`
+			}{},
+			wantPath:  "PatientID",
+			wantTag:   tag.PatientID,
+			wantError: ErrorUnsupportedFieldType,
+		},
+		{
+			name: "retired attribute",
+			ds:   Dataset{Elements: []*Element{mustNewElement(tag.GeneralPurposeScheduledProcedureStepStatus, []string{"SCHEDULED"})}},
+			target: &struct {
+				Status map[string]string `dicom:"GeneralPurposeScheduledProcedureStepStatus"`
+			}{},
+			wantPath:  "GeneralPurposeScheduledProcedureStepStatus",
+			wantTag:   tag.GeneralPurposeScheduledProcedureStepStatus,
+			wantError: ErrorUnsupportedFieldType,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := Unmarshal(tc.ds, tc.target)
+			var uErr *UnmarshalError
+			if !errors.As(err, &uErr) {
+				t.Fatalf("Unmarshal() expected an *UnmarshalError, got: %v", err)
+			}
+			if uErr.Path != tc.wantPath || uErr.Tag != tc.wantTag {
+				t.Errorf("Unmarshal() error reported wrong element. got: %s %v, want: %s %v", uErr.Path, uErr.Tag, tc.wantPath, tc.wantTag)
+			}
+			if tc.wantError != nil && !errors.Is(err, tc.wantError) {
+				t.Errorf("Unmarshal() unexpected underlying error. got: %v, want: %v", err, tc.wantError)
+			}
+		})
+	}
+}
+
+func TestUnmarshal_InvalidTarget(t *testing.T) {
+	var s struct{}
+	if err := Unmarshal(Dataset{}, s); err != ErrorInvalidUnmarshalTarget {
+		t.Errorf("Unmarshal(non-pointer) unexpected error. got: %v, want: %v", err, ErrorInvalidUnmarshalTarget)
+	}
+	bad := struct {
+		A string `dicom:"NotARealKeyword"`
+	}{}
+	if err := Unmarshal(Dataset{}, &bad); !errors.Is(err, ErrorInvalidStructTag) {
+		t.Errorf("Unmarshal(bad struct tag) unexpected error. got: %v, want: %v", err, ErrorInvalidStructTag)
+	}
+}
+
+func TestParseDICOMTime(t *testing.T) {
+	cases := []struct {
+		vr      string
+		value   string
+		want    time.Time
+		wantErr bool
+	}{
+		{vr: "DA", value: "20200229", want: time.Date(2020, 2, 29, 0, 0, 0, 0, time.UTC)},
+		{vr: "DA", value: "2020.02.29", want: time.Date(2020, 2, 29, 0, 0, 0, 0, time.UTC)},
+		{vr: "DA", value: "20210229", wantErr: true},
+		{vr: "TM", value: "07", want: time.Date(0, 1, 1, 7, 0, 0, 0, time.UTC)},
+		{vr: "TM", value: "0730", want: time.Date(0, 1, 1, 7, 30, 0, 0, time.UTC)},
+		{vr: "TM", value: "07:30:15.000001", want: time.Date(0, 1, 1, 7, 30, 15, 1000, time.UTC)},
+		{vr: "TM", value: "2460", wantErr: true},
+		{vr: "DT", value: "2020", want: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{vr: "DT", value: "202003041200-0530", want: time.Date(2020, 3, 4, 12, 0, 0, 0, time.FixedZone("", -(5*3600+30*60)))},
+		{vr: "DT", value: "20200", wantErr: true},
+	}
+	for _, tc := range cases {
+		got, err := parseDICOMTime(tc.vr, tc.value)
+		if (err != nil) != tc.wantErr {
+			t.Errorf("parseDICOMTime(%s, %q) unexpected error: %v", tc.vr, tc.value, err)
+			continue
+		}
+		if !got.Equal(tc.want) {
+			t.Errorf("parseDICOMTime(%s, %q) got: %v, want: %v", tc.vr, tc.value, got, tc.want)
+		}
+	}
+}
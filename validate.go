@@ -0,0 +1,314 @@
+package dicom
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/suyashkumar/dicom/pkg/tag"
+	"github.com/suyashkumar/dicom/pkg/uid"
+	"github.com/suyashkumar/dicom/pkg/vrraw"
+)
+
+// ErrorInvalidValue is wrapped by the ValidationError returned by Write under
+// StrictValidation.
+var ErrorInvalidValue = errors.New("invalid value")
+
+// ValueError describes an Element value that does not conform to the rules of
+// its VR, or an Element whose number of values does not conform to its VM.
+type ValueError struct {
+	// Path locates the Element within nested sequences, e.g.
+	// "ReferencedSeriesSequence[0].SeriesInstanceUID".
+	Path string
+	Tag  tag.Tag
+	VR   string
+	// Index is the index of the invalid value, or -1 if the problem is with
+	// the Element as a whole, e.g. its number of values.
+	Index  int
+	Value  string
+	Reason string
+}
+
+func (e ValueError) Error() string {
+	if e.Index < 0 {
+		return fmt.Sprintf("%s (%s): %s", e.Path, e.VR, e.Reason)
+	}
+	return fmt.Sprintf("%s (%s) value %d %q: %s", e.Path, e.VR, e.Index, e.Value, e.Reason)
+}
+
+// ValidationError is the report of StrictValidation: it lists every invalid
+// value of a Dataset. It wraps ErrorInvalidValue, so that
+// errors.Is(err, ErrorInvalidValue) is true, and errors.As gives access to the
+// individual ValueErrors.
+type ValidationError []ValueError
+
+func (e ValidationError) Error() string {
+	msgs := make([]string, len(e))
+	for i, v := range e {
+		msgs[i] = v.Error()
+	}
+	return fmt.Sprintf("%d invalid values: %s", len(e), strings.Join(msgs, "; "))
+}
+
+func (e ValidationError) Unwrap() error { return ErrorInvalidValue }
+
+// maxValueLengths is the maximum length, in characters, of a value of each
+// string VR (PS3.5 Table 6.2-1). PN is limited per component group, and UC
+// and UT are practically unlimited.
+var maxValueLengths = map[string]int{
+	vrraw.ApplicationEntity: 16,
+	vrraw.AgeString:         4,
+	vrraw.CodeString:        16,
+	vrraw.Date:              8,
+	vrraw.DecimalString:     16,
+	vrraw.DateTime:          26,
+	vrraw.IntegerString:     12,
+	vrraw.LongString:        64,
+	vrraw.LongText:          10240,
+	vrraw.ShortString:       16,
+	vrraw.ShortText:         1024,
+	vrraw.Time:              14,
+	vrraw.UniqueIdentifier:  64,
+}
+
+// intRanges is the range of values of each binary integer VR.
+var intRanges = map[string][2]int64{
+	vrraw.UnsignedShort: {0, 1<<16 - 1},
+	vrraw.SignedShort:   {-1 << 15, 1<<15 - 1},
+	vrraw.UnsignedLong:  {0, 1<<32 - 1},
+	vrraw.SignedLong:    {-1 << 31, 1<<31 - 1},
+}
+
+var (
+	codeStringRegexp    = regexp.MustCompile(`^[A-Z0-9 _]*$`)
+	ageStringRegexp     = regexp.MustCompile(`^[0-9]{3}[DWMY]$`)
+	decimalStringRegexp = regexp.MustCompile(`^[+-]?([0-9]+\.?[0-9]*|\.[0-9]+)([eE][+-]?[0-9]+)?$`)
+	integerStringRegexp = regexp.MustCompile(`^[+-]?[0-9]+$`)
+	timeRegexp          = regexp.MustCompile(`^([0-9]{2})(([0-9]{2})(([0-9]{2})(\.[0-9]{1,6})?)?)?$`)
+	dateTimeRegexp      = regexp.MustCompile(`^([0-9]{4})(([0-9]{2})(([0-9]{2})(([0-9]{2})(([0-9]{2})(([0-9]{2})(\.[0-9]{1,6})?)?)?)?)?)?([+-][0-9]{4})?$`)
+)
+
+// validateValues checks every value of the Elements of d, including within
+// sequences, against the rules of its VR (PS3.5 6.2) and the VM of its tag. It
+// returns a ValidationError listing all the problems found, or nil.
+func validateValues(d *Dataset) error {
+	if errs := validateElements(d, ""); len(errs) > 0 {
+		return ValidationError(errs)
+	}
+	return nil
+}
+
+func validateElements(d *Dataset, parent string) []ValueError {
+	var errs []ValueError
+	for _, elem := range d.Elements {
+		if elem.Value == nil || elem.Tag.Group == tag.GroupSeqItem {
+			continue
+		}
+		info, infoErr := findTagInfo(d, elem.Tag)
+		path := valuePath(parent, elem.Tag, info, infoErr)
+		vr := elem.RawValueRepresentation
+		if vr == "" && infoErr == nil {
+			vr = info.VR
+		}
+		newError := func(index int, value, reason string) ValueError {
+			return ValueError{Path: path, Tag: elem.Tag, VR: vr, Index: index, Value: value, Reason: reason}
+		}
+
+		var n int
+		switch v := elem.Value.GetValue().(type) {
+		case []*SequenceItemValue:
+			for i, item := range v {
+				itemPath := fmt.Sprintf("%s[%d]", path, i)
+				errs = append(errs, validateElements(&Dataset{Elements: item.elements}, itemPath)...)
+			}
+			continue
+		case []string:
+			if len(v) == 1 && strings.Trim(v[0], " \x00") == "" {
+				// Empty values are allowed for Type 2 attributes.
+				continue
+			}
+			n = len(v)
+			for i, s := range v {
+				if reason := validateString(vr, s); reason != "" {
+					errs = append(errs, newError(i, s, reason))
+				}
+			}
+		case []int:
+			n = len(v)
+			if r, ok := intRanges[vr]; ok {
+				for i, value := range v {
+					if int64(value) < r[0] || int64(value) > r[1] {
+						errs = append(errs, newError(i, strconv.Itoa(value), fmt.Sprintf("out of range [%d, %d]", r[0], r[1])))
+					}
+				}
+			}
+		case []float64:
+			n = len(v)
+		default:
+			continue
+		}
+
+		if n == 0 || infoErr != nil {
+			continue
+		}
+		if vm, err := tag.ParseVM(info.VM); err == nil && !vm.Allows(n) && !isSingleTextVR(vr) {
+			errs = append(errs, newError(-1, "", fmt.Sprintf("%d values, VM is %s", n, info.VM)))
+		}
+	}
+	return errs
+}
+
+// valuePath returns the path of the Element with tag t under parent.
+func valuePath(parent string, t tag.Tag, info tag.Info, infoErr error) string {
+	name := t.String()
+	if infoErr == nil && info.Keyword != "" {
+		name = info.Keyword
+	}
+	if parent == "" {
+		return name
+	}
+	return parent + "." + name
+}
+
+// isSingleTextVR indicates if vr holds a single value in which backslashes are
+// not value delimiters (PS3.5 6.4).
+func isSingleTextVR(vr string) bool {
+	return vr == vrraw.LongText || vr == vrraw.ShortText || vr == vrraw.UnlimitedText ||
+		vr == vrraw.UniversalResourceIdentifier
+}
+
+// validateString checks a single value s of a string VR, and returns the
+// reason it is invalid or "".
+func validateString(vr, s string) string {
+	s = strings.TrimRight(s, " \x00")
+	switch vr {
+	case vrraw.ApplicationEntity, vrraw.CodeString, vrraw.DecimalString, vrraw.IntegerString:
+		// Leading spaces are not significant either.
+		s = strings.TrimLeft(s, " ")
+	}
+	if s == "" {
+		return ""
+	}
+	if max, ok := maxValueLengths[vr]; ok && utf8.RuneCountInString(s) > max {
+		return fmt.Sprintf("longer than %d characters", max)
+	}
+
+	switch vr {
+	case vrraw.ApplicationEntity, vrraw.LongString, vrraw.ShortString, vrraw.UnlimitedCharacters:
+		return checkCharacters(s, false)
+	case vrraw.LongText, vrraw.ShortText, vrraw.UnlimitedText:
+		return checkCharacters(s, true)
+	case vrraw.CodeString:
+		if !codeStringRegexp.MatchString(s) {
+			return "only uppercase letters, digits, space and underscore are allowed"
+		}
+	case vrraw.AgeString:
+		if !ageStringRegexp.MatchString(s) {
+			return "not of the form nnnD, nnnW, nnnM or nnnY"
+		}
+	case vrraw.DecimalString:
+		if !decimalStringRegexp.MatchString(s) {
+			return "not a decimal number"
+		}
+	case vrraw.IntegerString:
+		if !integerStringRegexp.MatchString(s) {
+			return "not an integer"
+		}
+		if _, err := strconv.ParseInt(s, 10, 32); err != nil {
+			return "out of the range of IS"
+		}
+	case vrraw.Date:
+		if _, err := time.Parse("20060102", s); err != nil || len(s) != 8 {
+			return "not a valid YYYYMMDD date"
+		}
+	case vrraw.Time:
+		if !validTime(s) {
+			return "not a valid HHMMSS.FFFFFF time"
+		}
+	case vrraw.DateTime:
+		if !validDateTime(s) {
+			return "not a valid YYYYMMDDHHMMSS.FFFFFF&ZZXX date time"
+		}
+	case vrraw.UniqueIdentifier:
+		if err := uid.Validate(s); err != nil {
+			return strings.TrimPrefix(err.Error(), uid.ErrorInvalidUID.Error()+": ")
+		}
+	case vrraw.PersonName:
+		return validatePersonName(s)
+	case vrraw.UniversalResourceIdentifier:
+		if strings.HasPrefix(s, " ") {
+			return "leading spaces are not allowed"
+		}
+		return checkCharacters(s, false)
+	}
+	return ""
+}
+
+// checkCharacters checks that s has no backslash, which separates values, and
+// no control characters other than ESC, used by character set extensions, and,
+// if text is true, the format effectors of text VRs.
+func checkCharacters(s string, text bool) string {
+	for _, r := range s {
+		switch {
+		case r == '\\' && !text:
+			return "backslash is not allowed"
+		case r == 0x1B:
+		case text && (r == '\r' || r == '\n' || r == '\f' || r == '\t'):
+		case unicode.IsControl(r):
+			return fmt.Sprintf("control character %U is not allowed", r)
+		}
+	}
+	return ""
+}
+
+// validatePersonName checks a PN value: at most three component groups of at
+// most five components each, of at most 64 characters.
+func validatePersonName(s string) string {
+	groups := strings.Split(s, "=")
+	if len(groups) > 3 {
+		return "more than 3 component groups"
+	}
+	for _, g := range groups {
+		if utf8.RuneCountInString(g) > 64 {
+			return "component group longer than 64 characters"
+		}
+		if strings.Count(g, "^") > 4 {
+			return "more than 5 components"
+		}
+	}
+	return checkCharacters(s, false)
+}
+
+// validTime checks a TM value, HH[MM[SS[.F{1,6}]]].
+func validTime(s string) bool {
+	m := timeRegexp.FindStringSubmatch(s)
+	if m == nil {
+		return false
+	}
+	return inRange(m[1], 0, 23) && inRange(m[3], 0, 59) && inRange(m[5], 0, 60)
+}
+
+// validDateTime checks a DT value, YYYY[MM[DD[HH[MM[SS[.F{1,6}]]]]]][&ZZXX].
+func validDateTime(s string) bool {
+	m := dateTimeRegexp.FindStringSubmatch(s)
+	if m == nil {
+		return false
+	}
+	return inRange(m[3], 1, 12) && inRange(m[5], 1, 31) && inRange(m[7], 0, 23) &&
+		inRange(m[9], 0, 59) && inRange(m[11], 0, 60)
+}
+
+// inRange indicates if the optional number s, if present, is within
+// [min, max].
+func inRange(s string, min, max int) bool {
+	if s == "" {
+		return true
+	}
+	n, err := strconv.Atoi(s)
+	return err == nil && n >= min && n <= max
+}
@@ -0,0 +1,129 @@
+package dicom
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/suyashkumar/dicom/pkg/tag"
+	"github.com/suyashkumar/dicom/pkg/uid"
+)
+
+func TestValidateString(t *testing.T) {
+	cases := []struct {
+		vr        string
+		value     string
+		wantValid bool
+	}{
+		{vr: "AE", value: "STORESCP", wantValid: true},
+		{vr: "AE", value: "A_VERY_LONG_AE_TITLE", wantValid: false},
+		{vr: "AS", value: "018M", wantValid: true},
+		{vr: "AS", value: "18M", wantValid: false},
+		{vr: "CS", value: "ORIGINAL", wantValid: true},
+		{vr: "CS", value: " MONOCHROME2 ", wantValid: true},
+		{vr: "CS", value: "original", wantValid: false},
+		{vr: "CS", value: "ABC-DEF", wantValid: false},
+		{vr: "DA", value: "20200229", wantValid: true},
+		{vr: "DA", value: "20190229", wantValid: false},
+		{vr: "DA", value: "2020-01-01", wantValid: false},
+		{vr: "DS", value: "-1.5e3", wantValid: true},
+		{vr: "DS", value: " 42 ", wantValid: true},
+		{vr: "DS", value: "1,5", wantValid: false},
+		{vr: "DS", value: "0.12345678901234567", wantValid: false},
+		{vr: "DT", value: "20200101120000.123456+0100", wantValid: true},
+		{vr: "DT", value: "2020", wantValid: true},
+		{vr: "DT", value: "20201301", wantValid: false},
+		{vr: "IS", value: "+12", wantValid: true},
+		{vr: "IS", value: "1.0", wantValid: false},
+		{vr: "IS", value: "4294967296", wantValid: false},
+		{vr: "LO", value: strings.Repeat("a", 64), wantValid: true},
+		{vr: "LO", value: strings.Repeat("a", 80), wantValid: false},
+		{vr: "LO", value: "a\\b", wantValid: false},
+		{vr: "LO", value: "a\nb", wantValid: false},
+		{vr: "LT", value: "line 1\r\nline 2 \\ more", wantValid: true},
+		{vr: "PN", value: "Doe^John^^Dr=ドウ^ジョン", wantValid: true},
+		{vr: "PN", value: "a^b^c^d^e^f", wantValid: false},
+		{vr: "PN", value: "a=b=c=d", wantValid: false},
+		{vr: "SH", value: "12345678901234567", wantValid: false},
+		{vr: "TM", value: "235959.999999", wantValid: true},
+		{vr: "TM", value: "12", wantValid: true},
+		{vr: "TM", value: "246000", wantValid: false},
+		{vr: "TM", value: "12:00:00", wantValid: false},
+		{vr: "UI", value: "1.2.840.10008.1.2\x00", wantValid: true},
+		{vr: "UI", value: "1.2.840.010008", wantValid: false},
+		{vr: "UI", value: "1.2.abc", wantValid: false},
+		{vr: "UR", value: "http://example.com/a b", wantValid: true},
+		{vr: "UR", value: " http://example.com", wantValid: false},
+	}
+	for _, tc := range cases {
+		t.Run(tc.vr+" "+tc.value, func(t *testing.T) {
+			reason := validateString(tc.vr, tc.value)
+			if (reason == "") != tc.wantValid {
+				t.Errorf("validateString(%s, %q) got reason: %q, want valid: %v", tc.vr, tc.value, reason, tc.wantValid)
+			}
+		})
+	}
+}
+
+func TestValidateValues(t *testing.T) {
+	ds := Dataset{Elements: []*Element{
+		mustNewElement(tag.PatientName, []string{"Doe^John"}),
+		mustNewElement(tag.PatientID, []string{strings.Repeat("1", 80)}),
+		mustNewElement(tag.Modality, []string{"ct"}),
+		mustNewElement(tag.StudyDate, []string{""}),
+		mustNewElement(tag.ImageType, []string{"ORIGINAL", "PRIMARY"}),
+		mustNewElement(tag.PixelSpacing, []string{"0.5"}),
+		mustNewElement(tag.Rows, []int{70000}),
+		makeSequenceElement(tag.ReferencedSeriesSequence, [][]*Element{
+			{mustNewElement(tag.SeriesInstanceUID, []string{"1.2.3"})},
+			{mustNewElement(tag.SeriesInstanceUID, []string{"1.2.03"})},
+		}),
+	}}
+
+	err := validateValues(&ds)
+	if !errors.Is(err, ErrorInvalidValue) {
+		t.Fatalf("validateValues() unexpected error. got: %v, want: %v", err, ErrorInvalidValue)
+	}
+	var got ValidationError
+	if !errors.As(err, &got) {
+		t.Fatalf("validateValues() error is not a ValidationError: %v", err)
+	}
+	want := ValidationError{
+		{Path: "PatientID", Tag: tag.PatientID, VR: "LO", Index: 0, Value: strings.Repeat("1", 80), Reason: "longer than 64 characters"},
+		{Path: "Modality", Tag: tag.Modality, VR: "CS", Index: 0, Value: "ct", Reason: "only uppercase letters, digits, space and underscore are allowed"},
+		{Path: "PixelSpacing", Tag: tag.PixelSpacing, VR: "DS", Index: -1, Reason: "1 values, VM is 2"},
+		{Path: "Rows", Tag: tag.Rows, VR: "US", Index: 0, Value: "70000", Reason: "out of range [0, 65535]"},
+		{
+			Path:   "ReferencedSeriesSequence[1].SeriesInstanceUID",
+			Tag:    tag.SeriesInstanceUID,
+			VR:     "UI",
+			Index:  0,
+			Value:  "1.2.03",
+			Reason: `"1.2.03" has a component with a leading zero`,
+		},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("validateValues() unexpected report. diff: %v", diff)
+	}
+}
+
+func TestWrite_StrictValidation(t *testing.T) {
+	ds := Dataset{Elements: []*Element{
+		mustNewElement(tag.MediaStorageSOPClassUID, []string{"1.2.840.10008.5.1.4.1.1.1.2"}),
+		mustNewElement(tag.MediaStorageSOPInstanceUID, []string{"1.2.3.4.5.6.7"}),
+		mustNewElement(tag.TransferSyntaxUID, []string{uid.ImplicitVRLittleEndian}),
+		mustNewElement(tag.StudyDate, []string{"2020-01-01"}),
+	}}
+	if err := Write(&bytes.Buffer{}, ds); err != nil {
+		t.Errorf("Write() without StrictValidation unexpected error: %v", err)
+	}
+	var out bytes.Buffer
+	if err := Write(&out, ds, StrictValidation()); !errors.Is(err, ErrorInvalidValue) {
+		t.Errorf("Write(StrictValidation()) unexpected error. got: %v, want: %v", err, ErrorInvalidValue)
+	}
+	if out.Len() != 0 {
+		t.Errorf("Write(StrictValidation()) wrote %d bytes of an invalid Dataset", out.Len())
+	}
+}
@@ -1,18 +1,20 @@
 package dicom
 
 import (
-	"bufio"
 	"bytes"
 	"encoding/binary"
 	"errors"
 	"fmt"
 	"io"
+	"log"
+	"strings"
 
 	"github.com/suyashkumar/dicom/pkg/vrraw"
 
 	"github.com/suyashkumar/dicom/pkg/uid"
 
 	"github.com/suyashkumar/dicom/pkg/dicomio"
+	"github.com/suyashkumar/dicom/pkg/frame"
 	"github.com/suyashkumar/dicom/pkg/tag"
 )
 
@@ -26,12 +28,17 @@ var (
 	// ErrorUnsupportedBitsPerSample indicates that the BitsPerSample in this
 	// Dataset is not supported when unpacking native PixelData.
 	ErrorUnsupportedBitsPerSample = errors.New("unsupported BitsPerSample value")
+	// ErrorPreambleTooLong indicates that the preamble given to the Preamble
+	// WriteOption is longer than the 128 bytes of a DICOM file preamble.
+	ErrorPreambleTooLong = errors.New("preamble longer than 128 bytes")
 )
 
-// TODO(suyashkumar): consider adding an element-by-element write API.
+// preambleLength is the length of the preamble of a DICOM file (PS3.10 7.1).
+const preambleLength = 128
 
 // Write will write the input DICOM dataset to the provided io.Writer as a complete DICOM (including any header
-// information if available).
+// information if available). See Writer to write a DICOM element-by-element, and WriteDataset to write the dataset
+// alone.
 func Write(out io.Writer, ds Dataset, opts ...WriteOption) error {
 	optSet := toOptSet(opts...)
 	w := dicomio.NewWriter(out, nil, false)
@@ -41,32 +48,93 @@ func Write(out io.Writer, ds Dataset, opts ...WriteOption) error {
 			metaElems = append(metaElems, elem)
 		}
 	}
+	if optSet.generateFileMeta {
+		var err error
+		if metaElems, err = generateFileMeta(&ds, metaElems); err != nil {
+			return err
+		}
+		elems := append([]*Element(nil), metaElems...)
+		for _, elem := range ds.Elements {
+			if elem.Tag.Group != tag.MetadataGroup {
+				elems = append(elems, elem)
+			}
+		}
+		ds.Elements = elems
+	}
 
-	err := writeFileHeader(w, &ds, metaElems, *optSet)
+	ts, err := ds.transferSyntax()
+	if err == ErrorElementNotFound && optSet.defaultMissingTransferSyntax {
+		ts, err = uid.LookupTransferSyntax(uid.ImplicitVRLittleEndian)
+	}
 	if err != nil {
 		return err
 	}
+	if err := verifyDataset(&ds, ts, *optSet); err != nil {
+		return err
+	}
 
-	endian, implicit, err := ds.transferSyntax()
-	if (err != nil && err != ErrorElementNotFound) || (err == ErrorElementNotFound && !optSet.defaultMissingTransferSyntax) {
+	if err := writeFileHeader(w, &ds, metaElems, *optSet); err != nil {
 		return err
 	}
+	return writeDatasetElements(out, ds, ts, *optSet)
+}
 
-	if err == ErrorElementNotFound && optSet.defaultMissingTransferSyntax {
-		w.SetTransferSyntax(binary.LittleEndian, true)
-	} else {
-		w.SetTransferSyntax(endian, implicit)
+// WriteDataset writes the input DICOM dataset to the provided io.Writer in the transfer syntax transferSyntaxUID,
+// without the preamble, magic word and File Meta Information that Write adds, as in DIMSE messages or in some
+// legacy files. Elements of the File Meta Information group (0002) in ds are not written. The Preamble, GenerateFileMeta
+// and DefaultMissingTransferSyntax WriteOptions do not apply. Deflated transfer syntaxes are not supported yet, and
+// fail with ErrorUnimplemented, as they do in Write.
+func WriteDataset(out io.Writer, ds Dataset, transferSyntaxUID string, opts ...WriteOption) error {
+	optSet := toOptSet(opts...)
+	ts, err := uid.LookupTransferSyntax(transferSyntaxUID)
+	if err != nil {
+		return err
+	}
+	if err := verifyDataset(&ds, ts, *optSet); err != nil {
+		return err
+	}
+	return writeDatasetElements(out, ds, ts, *optSet)
+}
+
+// verifyDataset makes the checks on ds that precede writing it in ts.
+func verifyDataset(ds *Dataset, ts uid.TransferSyntax, opts writeOptSet) error {
+	if ts.Deflated {
+		return fmt.Errorf("%w: writing deflated transfer syntax %s", ErrorUnimplemented, uid.UIDString(ts.UID))
+	}
+	if opts.strictValidation {
+		if err := validateValues(ds); err != nil {
+			return err
+		}
+	}
+	if !opts.skipPrivateCreatorVerification {
+		if err := verifyPrivateBlocks(ds.Elements); err != nil {
+			return err
+		}
 	}
+	if err := verifyPixelDataEncapsulation(*ds, ts); err != nil {
+		if opts.strictValidation {
+			return err
+		}
+		log.Printf("WARN: %v", err)
+	}
+	return nil
+}
 
+// writeDatasetElements writes the elements of ds, except those of the File
+// Meta Information, to out in ts.
+func writeDatasetElements(out io.Writer, ds Dataset, ts uid.TransferSyntax, opts writeOptSet) error {
+	w := dicomio.NewWriter(out, ts.ByteOrder, ts.Implicit)
 	for _, elem := range ds.Elements {
 		if elem.Tag.Group != tag.MetadataGroup {
-			err = writeElement(w, elem, *optSet)
+			elem, err := withDictionaryVR(elem, &ds, opts)
 			if err != nil {
 				return err
 			}
+			if err := writeElement(w, elem, opts); err != nil {
+				return err
+			}
 		}
 	}
-
 	return nil
 }
 
@@ -81,6 +149,15 @@ func SkipVRVerification() WriteOption {
 	}
 }
 
+// SkipPrivateCreatorVerification returns a WriteOption that skips checking
+// that every private data element has its block reserved by a Private Creator
+// element, e.g. to write back a parsed Dataset that lacks some of them.
+func SkipPrivateCreatorVerification() WriteOption {
+	return func(set *writeOptSet) {
+		set.skipPrivateCreatorVerification = true
+	}
+}
+
 // SkipValueTypeVerification returns WriteOption function that skips checking ValueType
 // for concurrency with VR and casting
 func SkipValueTypeVerification() WriteOption {
@@ -99,11 +176,89 @@ func DefaultMissingTransferSyntax() WriteOption {
 	}
 }
 
+// StrictValidation returns a WriteOption that validates every value of the
+// Dataset before writing anything: the length, character repertoire and format
+// of string values according to their VR (PS3.5 6.2), the range of binary
+// integers, and the number of values according to the VM of their tag. All
+// the problems found are reported together in a ValidationError. PixelData
+// whose encapsulation does not match the transfer syntax, which is otherwise
+// logged and written as is, is also rejected with ErrorPixelDataEncapsulation.
+func StrictValidation() WriteOption {
+	return func(set *writeOptSet) {
+		set.strictValidation = true
+	}
+}
+
+// GenerateFileMeta returns a WriteOption that completes the File Meta
+// Information of the written Dataset: a missing FileMetaInformationVersion,
+// ImplementationClassUID or ImplementationVersionName is set to this library's,
+// and a missing MediaStorageSOPClassUID or MediaStorageSOPInstanceUID is
+// derived from SOPClassUID or SOPInstanceUID. Elements already present are
+// kept, but Write fails with ErrorInconsistentFileMeta if they contradict the
+// Dataset. The TransferSyntaxUID is not generated, see
+// DefaultMissingTransferSyntax.
+func GenerateFileMeta() WriteOption {
+	return func(set *writeOptSet) {
+		set.generateFileMeta = true
+	}
+}
+
+// DefinedLengthSequences returns a WriteOption that writes every sequence and
+// sequence item with a defined length, computed from its content, and without
+// delimitation items. By default, they are written with an undefined length
+// and delimitation items (PS3.5 7.5). Writer.BeginSequence, which cannot know
+// the length of what follows, fails under this option.
+func DefinedLengthSequences() WriteOption {
+	return func(set *writeOptSet) {
+		set.definedLengthSequences = true
+	}
+}
+
+// UndefinedLengthSequences returns a WriteOption that writes every sequence
+// and sequence item with an undefined length and delimitation items, which is
+// the default. It overrides a previous DefinedLengthSequences.
+func UndefinedLengthSequences() WriteOption {
+	return func(set *writeOptSet) {
+		set.definedLengthSequences = false
+	}
+}
+
+// Preamble returns a WriteOption that writes preamble, padded with zeros to
+// 128 bytes, instead of the 128 zero bytes that start a DICOM file (PS3.10
+// 7.1), e.g. to make a file that is both a TIFF and a DICOM. Write and
+// Writer.WriteHeader fail with ErrorPreambleTooLong if preamble is longer.
+func Preamble(preamble []byte) WriteOption {
+	return func(set *writeOptSet) {
+		set.preamble = preamble
+	}
+}
+
+// DictionaryVRForUnknown returns a WriteOption that writes Elements with VR UN
+// whose tag is in the dictionary with their dictionary VR instead, e.g. when
+// they were received from an application that did not know their VR. Private
+// tags are resolved through their Private Creator, see Dataset.FindTagInfo.
+// Their raw bytes (or string) values are decoded as Implicit VR Little Endian
+// values of the dictionary VR (PS3.5 6.2.2), and Write fails if they do not
+// match it. Parse already does this for the UN Elements it reads, so this only
+// changes Elements built by hand, or parsed while their tag was missing from
+// the dictionary, e.g. before a tag.RegisterPrivate call.
+func DictionaryVRForUnknown() WriteOption {
+	return func(set *writeOptSet) {
+		set.dictionaryVRForUnknown = true
+	}
+}
+
 // writeOptSet represents the flattened option set after all WriteOptions have been applied.
 type writeOptSet struct {
-	skipVRVerification           bool
-	skipValueTypeVerification    bool
-	defaultMissingTransferSyntax bool
+	skipVRVerification             bool
+	skipValueTypeVerification      bool
+	skipPrivateCreatorVerification bool
+	defaultMissingTransferSyntax   bool
+	strictValidation               bool
+	generateFileMeta               bool
+	definedLengthSequences         bool
+	dictionaryVRForUnknown         bool
+	preamble                       []byte
 }
 
 func toOptSet(opts ...WriteOption) *writeOptSet {
@@ -114,6 +269,25 @@ func toOptSet(opts ...WriteOption) *writeOptSet {
 	return optSet
 }
 
+// verifyPixelDataEncapsulation checks that the PixelData of ds, if any, is
+// encapsulated if and only if the transfer syntax ts requires it.
+func verifyPixelDataEncapsulation(ds Dataset, ts uid.TransferSyntax) error {
+	elem, err := ds.FindElementByTag(tag.PixelData)
+	if err != nil || elem.Value == nil {
+		return nil
+	}
+	info, ok := elem.Value.GetValue().(PixelDataInfo)
+	if !ok {
+		// Value type verification reports PixelData of the wrong type.
+		return nil
+	}
+	if info.IsEncapsulated != ts.Encapsulated {
+		return fmt.Errorf("%w: IsEncapsulated is %v, but transfer syntax %s requires %v",
+			ErrorPixelDataEncapsulation, info.IsEncapsulated, uid.UIDString(ts.UID), ts.Encapsulated)
+	}
+	return nil
+}
+
 func writeFileHeader(w dicomio.Writer, ds *Dataset, metaElems []*Element, opts writeOptSet) error {
 	// File headers are always written in littleEndian explicit
 	w.SetTransferSyntax(binary.LittleEndian, false)
@@ -157,7 +331,13 @@ func writeFileHeader(w dicomio.Writer, ds *Dataset, metaElems []*Element, opts w
 		}
 	}
 
-	if err := w.WriteZeros(128); err != nil {
+	if len(opts.preamble) > preambleLength {
+		return fmt.Errorf("%w: got %d bytes", ErrorPreambleTooLong, len(opts.preamble))
+	}
+	if err := w.WriteBytes(opts.preamble); err != nil {
+		return err
+	}
+	if err := w.WriteZeros(preambleLength - len(opts.preamble)); err != nil {
 		return err
 	}
 	if err := w.WriteString(magicWord); err != nil {
@@ -181,50 +361,238 @@ func writeFileHeader(w dicomio.Writer, ds *Dataset, metaElems []*Element, opts w
 }
 
 func writeElement(w dicomio.Writer, elem *Element, opts writeOptSet) error {
+	vr, err := writeVR(elem, opts)
+	if err != nil {
+		return err
+	}
+
+	length := elem.ValueLength
+	if elem.Value != nil {
+		_, implicit := w.GetTransferSyntax()
+		if length, err = valueLength(elem.Tag, elem.Value, vr, elem.ValueLength, implicit, opts); err != nil {
+			return err
+		}
+	}
+
+	err = encodeElementHeader(w, elem.Tag, vr, length)
+	if err != nil {
+		return err
+	}
+
+	if elem.Value != nil {
+		// The value is written straight to w, and must be exactly length
+		// bytes long unless that is undefined.
+		err = writeValue(w, elem.Tag, elem.Value, elem.Value.ValueType(), vr, length, opts)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// writeVR returns the VR that elem is written with, after checking it and
+// the type of its value unless opts skip these checks.
+func writeVR(elem *Element, opts writeOptSet) (string, error) {
 	vr := elem.RawValueRepresentation
 	if !opts.skipVRVerification {
 		var err error
 		vr, err = verifyVROrDefault(elem.Tag, elem.RawValueRepresentation)
 		if err != nil {
-			return err
+			return "", err
 		}
 	}
 	if !opts.skipValueTypeVerification && elem.Value != nil {
-		err := verifyValueType(elem.Tag, elem.Value, vr)
-		if err != nil {
-			return err
+		if err := verifyValueType(elem.Tag, elem.Value, vr); err != nil {
+			return "", err
 		}
 	}
+	return vr, nil
+}
 
-	length := elem.ValueLength
-	var valueData = &bytes.Buffer{}
-	if elem.Value != nil {
-		bo, implicit := w.GetTransferSyntax()
-		subWriter := dicomio.NewWriter(valueData, bo, implicit)
-		err := writeValue(subWriter, elem.Tag, elem.Value, elem.Value.ValueType(), vr, elem.ValueLength, opts)
+// withDictionaryVR returns elem, an element of d, with its dictionary VR if it
+// has VR UN and opts has dictionaryVRForUnknown, see DictionaryVRForUnknown.
+// Like in readUnknown, private tags are resolved through the Private Creator
+// elements of d. Otherwise, elem is returned as is.
+func withDictionaryVR(elem *Element, d *Dataset, opts writeOptSet) (*Element, error) {
+	if !opts.dictionaryVRForUnknown || elem.RawValueRepresentation != vrraw.Unknown || elem.Value == nil {
+		return elem, nil
+	}
+	info, err := findTagInfo(d, elem.Tag)
+	if err != nil || info.VR == vrraw.Unknown {
+		return elem, nil
+	}
+	vr := implicitVR(info, d)
+	converted := &Element{
+		Tag:                    elem.Tag,
+		ValueRepresentation:    tag.GetVRKind(elem.Tag, vr),
+		RawValueRepresentation: vr,
+		ValueLength:            elem.ValueLength,
+		Value:                  elem.Value,
+	}
+	var data []byte
+	switch v := elem.Value.GetValue().(type) {
+	case []byte:
+		data = v
+	case []string:
+		data = []byte(strings.Join(v, "\\"))
+	default:
+		// The value was already decoded, e.g. it is a sequence.
+		return converted, nil
+	}
+	if converted.Value, err = decodeUnknown(elem.Tag, data, vr, d); err != nil {
+		return nil, fmt.Errorf("%v has VR UN, and cannot be decoded with VR %s: %w", tag.DebugString(elem.Tag), vr, err)
+	}
+	converted.ValueLength = uint32(len(data))
+	return converted, nil
+}
+
+// valueLength returns the length in bytes of value as written by writeValue
+// with vr, or tag.VLUndefinedLength if it is written with an undefined length:
+// encapsulated PixelData (PS3.5 A.4), and sequences and items unless opts has
+// definedLengthSequences. vl is the ValueLength of the Element, which may
+// require an undefined length, and implicit indicates if the transfer syntax
+// has implicit VRs, which changes the length of nested element headers.
+func valueLength(t tag.Tag, value Value, vr string, vl uint32, implicit bool, opts writeOptSet) (uint32, error) {
+	valueType := value.ValueType()
+	if vl == tag.VLUndefinedLength && valueType <= 2 { // strings, bytes or ints
+		return 0, fmt.Errorf("encoding undefined-length element not yet supported: %v", t)
+	}
+
+	var length int
+	switch v := value.GetValue().(type) {
+	case []string:
+		for i, s := range v {
+			if i > 0 {
+				length++ // The backslash delimiter.
+			}
+			length += len(s)
+		}
+	case []byte:
+		length = len(v)
+		switch vr {
+		case vrraw.OtherWord:
+			if length%2 != 0 {
+				return 0, ErrorOWRequiresEvenVL
+			}
+		case vrraw.OtherByte:
+		case vrraw.OtherVeryLong:
+			if length%8 != 0 {
+				return 0, fmt.Errorf("vr of OV requires a multiple of 8 bytes, got: %d", length)
+			}
+		default:
+			return 0, ErrorMismatchValueTypeAndVR
+		}
+	case []int:
+		switch vr {
+		case vrraw.UnsignedShort, vrraw.SignedShort, vrraw.AttributeTag:
+			length = 2 * len(v)
+		case vrraw.UnsignedLong, vrraw.SignedLong:
+			length = 4 * len(v)
+		default:
+			return 0, ErrorMismatchValueTypeAndVR
+		}
+	case []float64:
+		switch vr {
+		case vrraw.FloatingPointSingle:
+			length = 4 * len(v)
+		case vrraw.FloatingPointDouble:
+			length = 8 * len(v)
+		}
+	case PixelDataInfo:
+		if v.IsEncapsulated {
+			return tag.VLUndefinedLength, nil
+		}
+		for i := range v.Frames {
+			n, err := nativeFrameLength(&v.Frames[i].NativeData)
+			if err != nil {
+				return 0, err
+			}
+			length += n
+		}
+	case []*SequenceItemValue:
+		if !opts.definedLengthSequences {
+			return tag.VLUndefinedLength, nil
+		}
+		for _, item := range v {
+			n, err := itemLength(item.elements, implicit, opts)
+			if err != nil {
+				return 0, err
+			}
+			length += itemHeaderLength + n
+		}
+	case []*Element:
+		if !opts.definedLengthSequences {
+			return tag.VLUndefinedLength, nil
+		}
+		n, err := itemLength(v, implicit, opts)
 		if err != nil {
-			return err
+			return 0, err
 		}
+		length = n
+	default:
+		return 0, ErrorUnexpectedValueType
+	}
+	// Values are padded to an even length.
+	length += length % 2
+	if uint64(length) >= uint64(tag.VLUndefinedLength) {
+		return 0, fmt.Errorf("value of %v is too long: %d bytes", tag.DebugString(t), length)
+	}
+	return uint32(length), nil
+}
+
+// itemHeaderLength is the length of the tag and length of a sequence item,
+// which have no VR (PS3.5 7.5).
+const itemHeaderLength = 8
 
-		length = uint32(len(valueData.Bytes()))
-		if elem.ValueLength == tag.VLUndefinedLength {
-			length = tag.VLUndefinedLength
+// itemLength returns the length in bytes of the elements of a sequence item,
+// as written with a defined length.
+func itemLength(elems []*Element, implicit bool, opts writeOptSet) (int, error) {
+	length := 0
+	item := &Dataset{Elements: elems}
+	for _, elem := range elems {
+		elem, err := withDictionaryVR(elem, item, opts)
+		if err != nil {
+			return 0, err
 		}
+		vr, err := writeVR(elem, opts)
+		if err != nil {
+			return 0, err
+		}
+		vl := elem.ValueLength
+		if elem.Value != nil {
+			if vl, err = valueLength(elem.Tag, elem.Value, vr, elem.ValueLength, implicit, opts); err != nil {
+				return 0, err
+			}
+		}
+		if vl == tag.VLUndefinedLength {
+			return 0, fmt.Errorf("%v has an undefined length, so its sequence item cannot have a defined length",
+				tag.DebugString(elem.Tag))
+		}
+		length += elementHeaderLength(vr, implicit) + int(vl)
 	}
+	return length, nil
+}
 
-	err := encodeElementHeader(w, elem.Tag, vr, length)
-	if err != nil {
-		return err
+// elementHeaderLength returns the length of the tag, VR and length of an
+// element, as written by encodeElementHeader (PS3.5 7.1).
+func elementHeaderLength(vr string, implicit bool) int {
+	if implicit || !hasLongVL(vr) {
+		return 8
 	}
+	return 12
+}
 
-	if elem.Value != nil {
-		// Write the bytes to the original writer
-		err = w.WriteBytes(valueData.Bytes())
-		if err != nil {
-			return err
-		}
+// hasLongVL indicates if elements of vr have a 32-bit length, preceded by 2
+// reserved bytes, in explicit VR transfer syntaxes (PS3.5 7.1.2).
+func hasLongVL(vr string) bool {
+	switch vr {
+	case "NA", vrraw.OtherByte, vrraw.OtherDouble, vrraw.OtherFloat,
+		vrraw.OtherLong, vrraw.OtherVeryLong, vrraw.OtherWord, vrraw.Sequence,
+		vrraw.SignedVeryLong, vrraw.Unknown, vrraw.UnlimitedCharacters,
+		vrraw.UniversalResourceIdentifier, vrraw.UnlimitedText, vrraw.UnsignedVeryLong:
+		return true
 	}
-	return nil
+	return false
 }
 
 func writeMetaElem(w dicomio.Writer, t tag.Tag, ds *Dataset, tagsUsed *map[tag.Tag]bool, optSet writeOptSet) error {
@@ -243,14 +611,19 @@ func writeMetaElem(w dicomio.Writer, t tag.Tag, ds *Dataset, tagsUsed *map[tag.T
 func verifyVROrDefault(t tag.Tag, vr string) (string, error) {
 	tagInfo, err := tag.Find(t)
 	if err != nil {
+		// Tags missing from the dictionary (e.g. private tags) keep the
+		// provided VR, if any.
+		if vr != "" {
+			return vr, nil
+		}
 		return vrraw.Unknown, nil
 	}
 	if vr == "" {
 		return tagInfo.VR, nil
 	}
-	if tagInfo.VR != vr {
+	if !tagInfo.AllowsVR(vr) {
 		return "", fmt.Errorf("ERROR dicomio.veryifyElement: VR mismatch for tag %v. Element.VR=%v, but DICOM standard defines VR to be %v",
-			tag.DebugString(t), vr, tagInfo.VR)
+			tag.DebugString(t), vr, strings.Join(tagInfo.VRs, " or "))
 	}
 
 	return vr, nil
@@ -266,7 +639,7 @@ func verifyValueType(t tag.Tag, value Value, vr string) error {
 		ok = valueType == Sequences
 	case "NA":
 		ok = valueType == SequenceItem
-	case vrraw.OtherWord, vrraw.OtherByte:
+	case vrraw.OtherWord, vrraw.OtherByte, vrraw.OtherVeryLong:
 		if t == tag.PixelData {
 			ok = valueType == PixelData
 		} else {
@@ -301,12 +674,8 @@ func writeVRVL(w dicomio.Writer, t tag.Tag, vr string, vl uint32) error {
 		vl = tag.VLUndefinedLength
 	}
 
-	if vr == "SQ" || t == tag.Item {
-		// We are going to write these out with undefined length always.
-		vl = tag.VLUndefinedLength
-	}
-
-	if len(vr) != 2 && vl != tag.VLUndefinedLength && t != tag.SequenceDelimitationItem {
+	// Items and delimitation items have no VR, see below.
+	if len(vr) != 2 && vl != tag.VLUndefinedLength && t.Group != tag.GroupSeqItem {
 		return fmt.Errorf("ERROR dicomio.writeVRVL: Value Representation must be of length 2, e.g. 'UN'. For tag=%v, it was RawValueRepresentation=%v",
 			tag.DebugString(t), vr)
 	}
@@ -320,18 +689,14 @@ func writeVRVL(w dicomio.Writer, t tag.Tag, vr string, vl uint32) error {
 		if err := w.WriteString(vr); err != nil {
 			return err
 		}
-		switch vr {
-		case "NA", vrraw.OtherByte, vrraw.OtherDouble, vrraw.OtherFloat,
-			vrraw.OtherLong, vrraw.OtherWord, vrraw.Sequence, vrraw.Unknown,
-			vrraw.UnlimitedCharacters, vrraw.UniversalResourceIdentifier,
-			vrraw.UnlimitedText:
+		if hasLongVL(vr) {
 			if err := w.WriteZeros(2); err != nil {
 				return err
 			}
 			if err := w.WriteUInt32(vl); err != nil {
 				return err
 			}
-		default:
+		} else {
 			if err := w.WriteUInt16(uint16(vl)); err != nil {
 				return err
 			}
@@ -345,17 +710,17 @@ func writeVRVL(w dicomio.Writer, t tag.Tag, vr string, vl uint32) error {
 }
 
 func writeRawItem(w dicomio.Writer, data []byte) error {
-	length := uint32(len(data))
+	// Fragments are padded to an even length, like OB values.
+	length := uint32(len(data) + len(data)%2)
 	if err := writeTag(w, tag.Item, length); err != nil {
 		return err
 	}
-	if err := writeVRVL(w, tag.Item, "NA", length); err != nil {
+	// Items of encapsulated PixelData always have a defined length, unlike
+	// the sequence items written by writeVRVL (PS3.5 A.4).
+	if err := w.WriteUInt32(length); err != nil {
 		return err
 	}
-	if err := w.WriteBytes(data); err != nil {
-		return err
-	}
-	return nil
+	return writeOtherByteString(w, data)
 }
 
 func writeBasicOffsetTable(w dicomio.Writer, offsets []uint32) error {
@@ -383,10 +748,6 @@ func encodeElementHeader(w dicomio.Writer, t tag.Tag, vr string, vl uint32) erro
 }
 
 func writeValue(w dicomio.Writer, t tag.Tag, value Value, valueType ValueType, vr string, vl uint32, opts writeOptSet) error {
-	if vl == tag.VLUndefinedLength && valueType <= 2 { // strings, bytes or ints
-		return fmt.Errorf("encoding undefined-length element not yet supported: %v", t)
-	}
-
 	v := value.GetValue()
 	switch valueType {
 	case Strings:
@@ -409,17 +770,20 @@ func writeValue(w dicomio.Writer, t tag.Tag, value Value, valueType ValueType, v
 }
 
 func writeStrings(w dicomio.Writer, values []string, vr string) error {
-	s := ""
+	length := 0
 	for i, substr := range values {
 		if i > 0 {
-			s += "\\"
+			if err := w.WriteString("\\"); err != nil {
+				return err
+			}
+			length++
 		}
-		s += substr
-	}
-	if err := w.WriteString(s); err != nil {
-		return err
+		if err := w.WriteString(substr); err != nil {
+			return err
+		}
+		length += len(substr)
 	}
-	if len(s)%2 == 1 {
+	if length%2 == 1 {
 		switch vr {
 		case vrraw.DateTime, vrraw.LongString, vrraw.LongText, vrraw.PersonName,
 			vrraw.ShortString, vrraw.ShortText, vrraw.UnlimitedText,
@@ -444,6 +808,11 @@ func writeBytes(w dicomio.Writer, values []byte, vr string) error {
 		err = writeOtherWordString(w, values)
 	case vrraw.OtherByte:
 		err = writeOtherByteString(w, values)
+	case vrraw.OtherVeryLong:
+		if len(values)%8 != 0 {
+			return fmt.Errorf("vr of OV requires a multiple of 8 bytes, got: %d", len(values))
+		}
+		err = w.WriteBytes(values)
 	default:
 		return ErrorMismatchValueTypeAndVR
 	}
@@ -500,7 +869,7 @@ func writeFloats(w dicomio.Writer, v Value, vr string) error {
 
 func writePixelData(w dicomio.Writer, t tag.Tag, value Value, vr string, vl uint32) error {
 	image := MustGetPixelDataInfo(value)
-	if vl == tag.VLUndefinedLength {
+	if image.IsEncapsulated {
 		if err := writeBasicOffsetTable(w, image.Offsets); err != nil {
 			return err
 		}
@@ -514,53 +883,86 @@ func writePixelData(w dicomio.Writer, t tag.Tag, value Value, vr string, vl uint
 			return err
 		}
 	} else {
-		numFrames := len(image.Frames)
-		numPixels := len(image.Frames[0].NativeData.Data)
-		numValues := len(image.Frames[0].NativeData.Data[0])
-		// Total required buffer length in bytes:
-		length := numFrames * numPixels * numValues * image.Frames[0].NativeData.BitsPerSample / 8
-
-		buf := &bytes.Buffer{}
-		buf.Grow(length)
-		for frame := 0; frame < numFrames; frame++ {
-			for pixel := 0; pixel < numPixels; pixel++ {
-				for value := 0; value < numValues; value++ {
-					pixelValue := image.Frames[frame].NativeData.Data[pixel][value]
-					switch image.Frames[frame].NativeData.BitsPerSample {
-					case 8:
-						if err := binary.Write(buf, binary.LittleEndian, uint8(pixelValue)); err != nil {
-							return err
-						}
-					case 16:
-						if err := binary.Write(buf, binary.LittleEndian, uint16(pixelValue)); err != nil {
-							return err
-						}
-					case 32:
-						if err := binary.Write(buf, binary.LittleEndian, uint32(pixelValue)); err != nil {
-							return err
-						}
-					default:
-						return ErrorUnsupportedBitsPerSample
-					}
-				}
+		// Frames are encoded one at a time in buf, to avoid holding a copy of
+		// the whole PixelData.
+		var buf []byte
+		length := 0
+		bo, _ := w.GetTransferSyntax()
+		for i := range image.Frames {
+			var err error
+			if buf, err = appendNativeFrame(buf[:0], &image.Frames[i].NativeData, bo); err != nil {
+				return err
 			}
+			if err := w.WriteBytes(buf); err != nil {
+				return err
+			}
+			length += len(buf)
 		}
-		if err := w.WriteBytes(buf.Bytes()); err != nil {
-			return err
+		if length%2 == 1 {
+			return w.WriteByte(0)
 		}
 	}
 	return nil
 }
 
+// nativeFrameLength returns the length in bytes of f as written by
+// appendNativeFrame.
+func nativeFrameLength(f *frame.NativeFrame) (int, error) {
+	switch f.BitsPerSample {
+	case 8, 16, 32:
+	default:
+		return 0, ErrorUnsupportedBitsPerSample
+	}
+	length := 0
+	for _, pixel := range f.Data {
+		length += len(pixel)
+	}
+	return length * f.BitsPerSample / 8, nil
+}
+
+// appendNativeFrame appends the pixel values of f to b, in byte order bo.
+func appendNativeFrame(b []byte, f *frame.NativeFrame, bo binary.ByteOrder) ([]byte, error) {
+	n, err := nativeFrameLength(f)
+	if err != nil {
+		return nil, err
+	}
+	if cap(b)-len(b) < n {
+		grown := make([]byte, len(b), len(b)+n)
+		copy(grown, b)
+		b = grown
+	}
+	for _, pixel := range f.Data {
+		for _, value := range pixel {
+			switch f.BitsPerSample {
+			case 8:
+				b = append(b, uint8(value))
+			case 16:
+				if bo == binary.BigEndian {
+					b = append(b, uint8(value>>8), uint8(value))
+				} else {
+					b = append(b, uint8(value), uint8(value>>8))
+				}
+			case 32:
+				if bo == binary.BigEndian {
+					b = append(b, uint8(value>>24), uint8(value>>16), uint8(value>>8), uint8(value))
+				} else {
+					b = append(b, uint8(value), uint8(value>>8), uint8(value>>16), uint8(value>>24))
+				}
+			}
+		}
+	}
+	return b, nil
+}
+
 var sequenceDelimitationItem = &Element{
 	Tag:         tag.SequenceDelimitationItem,
 	ValueLength: 0, // This should be 00000000H in base32
 }
 
 func writeSequence(w dicomio.Writer, t tag.Tag, values []*SequenceItemValue, vr string, vl uint32, opts writeOptSet) error {
-	// We always write out sequences using the undefined length encoding.
-	// Note: we currently don't validate that the length of the sequence matches
-	// the VL if it's not undefined VL.
+	// Sequences are written with an undefined length and a Sequence
+	// Delimitation Item, unless opts has definedLengthSequences, in which case
+	// vl is their computed length.
 	// More details about the sequence structure can be found at:
 	// http://dicom.nema.org/medical/dicom/current/output/chtml/part05/sect_7.5.html
 
@@ -570,6 +972,9 @@ func writeSequence(w dicomio.Writer, t tag.Tag, values []*SequenceItemValue, vr
 			return err
 		}
 	}
+	if vl != tag.VLUndefinedLength {
+		return nil
+	}
 
 	// Write Sequence Delimitation Item as implicit VR
 	oldBO, oldImplicit := w.GetTransferSyntax()
@@ -594,16 +999,33 @@ var item = &Element{
 
 func writeSequenceItem(w dicomio.Writer, t tag.Tag, values []*Element, vr string, vl uint32, opts writeOptSet) error {
 	// Write out item header.
-	if err := writeElement(w, item, opts); err != nil {
+	length := tag.VLUndefinedLength
+	if opts.definedLengthSequences {
+		_, implicit := w.GetTransferSyntax()
+		n, err := itemLength(values, implicit, opts)
+		if err != nil {
+			return err
+		}
+		length = uint32(n)
+	}
+	if err := encodeElementHeader(w, tag.Item, "", length); err != nil {
 		return err
 	}
 
 	// Write out nested Dataset elements.
+	item := &Dataset{Elements: values}
 	for _, elem := range values {
+		elem, err := withDictionaryVR(elem, item, opts)
+		if err != nil {
+			return err
+		}
 		if err := writeElement(w, elem, opts); err != nil {
 			return err
 		}
 	}
+	if length != tag.VLUndefinedLength {
+		return nil
+	}
 
 	// Write ItemDelimitationItem.
 	return writeElement(w, sequenceItemDelimitationItem, opts)
@@ -613,21 +1035,9 @@ func writeOtherWordString(w dicomio.Writer, data []byte) error {
 	if len(data)%2 != 0 {
 		return ErrorOWRequiresEvenVL
 	}
-	bo, _ := w.GetTransferSyntax()
-	r, err := dicomio.NewReader(bufio.NewReader(bytes.NewBuffer(data)), bo, int64(len(data)))
-	if err != nil {
-		return err
-	}
-	for i := 0; i < int(len(data)/2); i++ {
-		v, err := r.ReadUInt16()
-		if err != nil {
-			return err
-		}
-		if err := w.WriteUInt16(v); err != nil {
-			return err
-		}
-	}
-	return nil
+	// The words are written as they are held, see the byte order TODO in
+	// readBytes.
+	return w.WriteBytes(data)
 }
 
 func writeOtherByteString(w dicomio.Writer, data []byte) error {
@@ -1,10 +1,13 @@
 package dicom
 
 import (
+	"bufio"
 	"bytes"
 	"encoding/binary"
+	"errors"
 	"io/ioutil"
 	"os"
+	"strings"
 	"testing"
 
 	"github.com/suyashkumar/dicom/pkg/frame"
@@ -16,6 +19,7 @@ import (
 	"github.com/suyashkumar/dicom/pkg/dicomio"
 	"github.com/suyashkumar/dicom/pkg/tag"
 	"github.com/suyashkumar/dicom/pkg/uid"
+	"github.com/suyashkumar/dicom/pkg/vrraw"
 )
 
 // TestWrite tests the write package by ensuring that it is consistent with the
@@ -281,6 +285,36 @@ func TestWrite(t *testing.T) {
 			}},
 			expectedError: nil,
 		},
+		{
+			name: "encapsulated PixelData",
+			dataset: Dataset{Elements: []*Element{
+				mustNewElement(tag.MediaStorageSOPClassUID, []string{"1.2.840.10008.5.1.4.1.1.1.2"}),
+				mustNewElement(tag.MediaStorageSOPInstanceUID, []string{"1.2.3.4.5.6.7"}),
+				mustNewElement(tag.TransferSyntaxUID, []string{"1.2.840.10008.1.2.4.50"}),
+				mustNewElement(tag.Rows, []int{2}),
+				mustNewElement(tag.Columns, []int{2}),
+				mustNewElement(tag.PixelData, PixelDataInfo{
+					IsEncapsulated: true,
+					Frames: []frame.Frame{
+						{
+							Encapsulated: true,
+							EncapsulatedData: frame.EncapsulatedFrame{
+								Data:              []byte{1, 2, 3, 4},
+								TransferSyntaxUID: "1.2.840.10008.1.2.4.50",
+							},
+						},
+						{
+							Encapsulated: true,
+							EncapsulatedData: frame.EncapsulatedFrame{
+								Data:              []byte{5, 6},
+								TransferSyntaxUID: "1.2.840.10008.1.2.4.50",
+							},
+						},
+					},
+				}),
+			}},
+			expectedError: nil,
+		},
 	}
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
@@ -331,6 +365,186 @@ func TestWrite(t *testing.T) {
 	}
 }
 
+func TestWrite_SequenceLengths(t *testing.T) {
+	itemDelimiter := []byte{0xFE, 0xFF, 0x0D, 0xE0}
+	sequenceDelimiter := []byte{0xFE, 0xFF, 0xDD, 0xE0}
+	for _, ts := range []string{uid.ImplicitVRLittleEndian, uid.ExplicitVRLittleEndian} {
+		t.Run(ts, func(t *testing.T) {
+			ds := Dataset{Elements: []*Element{
+				mustNewElement(tag.MediaStorageSOPClassUID, []string{"1.2.840.10008.5.1.4.1.1.1.2"}),
+				mustNewElement(tag.MediaStorageSOPInstanceUID, []string{"1.2.3.4.5.6.7"}),
+				mustNewElement(tag.TransferSyntaxUID, []string{ts}),
+				makeSequenceElement(tag.ReferencedSeriesSequence, [][]*Element{
+					{
+						mustNewElement(tag.SeriesInstanceUID, []string{"1.2.3"}),
+						makeSequenceElement(tag.ReferencedImageSequence, [][]*Element{
+							{mustNewElement(tag.ReferencedSOPInstanceUID, []string{"1.2.3.4"})},
+							{},
+						}),
+					},
+					{mustNewElement(tag.Rows, []int{128})},
+				}),
+				mustNewElement(tag.PatientName, []string{"Bob", "Jones"}),
+			}}
+			undefined := &bytes.Buffer{}
+			if err := Write(undefined, ds, DefinedLengthSequences(), UndefinedLengthSequences()); err != nil {
+				t.Fatalf("Write(UndefinedLengthSequences()) unexpected error: %v", err)
+			}
+			if !bytes.Contains(undefined.Bytes(), itemDelimiter) || !bytes.Contains(undefined.Bytes(), sequenceDelimiter) {
+				t.Errorf("Write(UndefinedLengthSequences()) wrote no delimitation items")
+			}
+
+			defined := &bytes.Buffer{}
+			if err := Write(defined, ds, DefinedLengthSequences()); err != nil {
+				t.Fatalf("Write(DefinedLengthSequences()) unexpected error: %v", err)
+			}
+			if bytes.Contains(defined.Bytes(), itemDelimiter) || bytes.Contains(defined.Bytes(), sequenceDelimiter) {
+				t.Errorf("Write(DefinedLengthSequences()) wrote delimitation items")
+			}
+
+			// Read back, the defined length Dataset is written like the
+			// original one.
+			readDS, err := Parse(defined, int64(defined.Len()), nil)
+			if err != nil {
+				t.Fatalf("Parse() unexpected error: %v", err)
+			}
+			rewritten := &bytes.Buffer{}
+			if err := Write(rewritten, readDS); err != nil {
+				t.Fatalf("Write() of the parsed Dataset unexpected error: %v", err)
+			}
+			if !bytes.Equal(rewritten.Bytes(), undefined.Bytes()) {
+				t.Errorf("Parse() of the defined length Dataset differs from the original Dataset")
+			}
+		})
+	}
+}
+
+func TestWriteDataset(t *testing.T) {
+	ds := Dataset{Elements: []*Element{
+		mustNewElement(tag.MediaStorageSOPClassUID, []string{"1.2.840.10008.5.1.4.1.1.1.2"}),
+		mustNewElement(tag.MediaStorageSOPInstanceUID, []string{"1.2.3.4.5.6.7"}),
+		mustNewElement(tag.TransferSyntaxUID, []string{uid.ExplicitVRLittleEndian}),
+		mustNewElement(tag.PatientName, []string{"Bob", "Jones"}),
+		makeSequenceElement(tag.ReferencedSeriesSequence, [][]*Element{
+			{mustNewElement(tag.SeriesInstanceUID, []string{"1.2.3"})},
+		}),
+		mustNewElement(tag.Rows, []int{128}),
+	}}
+	file := &bytes.Buffer{}
+	if err := Write(file, ds); err != nil {
+		t.Fatalf("Write() unexpected error: %v", err)
+	}
+
+	t.Run("bare dataset", func(t *testing.T) {
+		got := &bytes.Buffer{}
+		if err := WriteDataset(got, ds, uid.ExplicitVRLittleEndian); err != nil {
+			t.Fatalf("WriteDataset() unexpected error: %v", err)
+		}
+		// The dataset follows the File Meta Information in a file.
+		if !bytes.HasSuffix(file.Bytes(), got.Bytes()) || bytes.Contains(got.Bytes(), []byte(magicWord)) {
+			t.Errorf("WriteDataset() got: %v, want the end of: %v", got.Bytes(), file.Bytes())
+		}
+	})
+	t.Run("other transfer syntax", func(t *testing.T) {
+		got := &bytes.Buffer{}
+		if err := WriteDataset(got, ds, uid.ImplicitVRLittleEndian); err != nil {
+			t.Fatalf("WriteDataset() unexpected error: %v", err)
+		}
+		r, err := dicomio.NewReader(bufio.NewReader(got), binary.LittleEndian, int64(got.Len()))
+		if err != nil {
+			t.Fatalf("NewReader() unexpected error: %v", err)
+		}
+		r.SetTransferSyntax(binary.LittleEndian, true)
+		elem, err := readElement(r, nil, nil)
+		if err != nil {
+			t.Fatalf("readElement() unexpected error: %v", err)
+		}
+		if diff := cmp.Diff([]string{"Bob", "Jones"}, elem.Value.GetValue()); elem.Tag != tag.PatientName || diff != "" {
+			t.Errorf("WriteDataset() wrote unexpected first element %v, diff: %v", elem, diff)
+		}
+	})
+	t.Run("deflated transfer syntax", func(t *testing.T) {
+		if err := WriteDataset(&bytes.Buffer{}, ds, uid.DeflatedExplicitVRLittleEndian); !errors.Is(err, ErrorUnimplemented) {
+			t.Errorf("WriteDataset(deflated) unexpected error. got: %v, want: %v", err, ErrorUnimplemented)
+		}
+		withMeta := Dataset{Elements: append([]*Element{
+			mustNewElement(tag.MediaStorageSOPClassUID, []string{"1.2.840.10008.5.1.4.1.1.1.2"}),
+			mustNewElement(tag.MediaStorageSOPInstanceUID, []string{"1.2.3.4.5.6.7"}),
+			mustNewElement(tag.TransferSyntaxUID, []string{uid.DeflatedExplicitVRLittleEndian}),
+		}, ds.Elements...)}
+		if err := Write(&bytes.Buffer{}, withMeta); !errors.Is(err, ErrorUnimplemented) {
+			t.Errorf("Write(deflated) unexpected error. got: %v, want: %v", err, ErrorUnimplemented)
+		}
+	})
+	t.Run("unknown transfer syntax", func(t *testing.T) {
+		if err := WriteDataset(&bytes.Buffer{}, ds, "1.2.3"); !errors.Is(err, uid.ErrorUnknownTransferSyntax) {
+			t.Errorf("WriteDataset(1.2.3) unexpected error. got: %v, want: %v", err, uid.ErrorUnknownTransferSyntax)
+		}
+	})
+}
+
+func TestWrite_Preamble(t *testing.T) {
+	ds := Dataset{Elements: []*Element{
+		mustNewElement(tag.TransferSyntaxUID, []string{uid.ExplicitVRLittleEndian}),
+		mustNewElement(tag.PatientName, []string{"Bob", "Jones"}),
+	}}
+	tiffHeader := []byte{'I', 'I', 42, 0, 8, 0, 0, 0}
+	got := &bytes.Buffer{}
+	if err := Write(got, ds, Preamble(tiffHeader)); err != nil {
+		t.Fatalf("Write(Preamble()) unexpected error: %v", err)
+	}
+	want := append(append(append([]byte(nil), tiffHeader...), make([]byte, 128-len(tiffHeader))...), magicWord...)
+	if !bytes.HasPrefix(got.Bytes(), want) {
+		t.Errorf("Write(Preamble()) got: %v, want prefix: %v", got.Bytes()[:132], want)
+	}
+	if _, err := Parse(got, int64(got.Len()), nil); err != nil {
+		t.Errorf("Parse() of a file with a custom preamble unexpected error: %v", err)
+	}
+
+	if err := Write(&bytes.Buffer{}, ds, Preamble(make([]byte, 129))); !errors.Is(err, ErrorPreambleTooLong) {
+		t.Errorf("Write(Preamble(129 bytes)) unexpected error. got: %v, want: %v", err, ErrorPreambleTooLong)
+	}
+}
+
+func TestWrite_PixelDataEncapsulation(t *testing.T) {
+	cases := []struct {
+		name           string
+		transferSyntax string
+		pixelData      PixelDataInfo
+	}{
+		{
+			name:           "native PixelData in encapsulated transfer syntax",
+			transferSyntax: "1.2.840.10008.1.2.4.50",
+			pixelData: PixelDataInfo{Frames: []frame.Frame{
+				{NativeData: frame.NativeFrame{BitsPerSample: 8, Rows: 1, Cols: 2, Data: [][]int{{1}, {2}}}},
+			}},
+		},
+		{
+			name:           "encapsulated PixelData in native transfer syntax",
+			transferSyntax: uid.ExplicitVRLittleEndian,
+			pixelData: PixelDataInfo{IsEncapsulated: true, Frames: []frame.Frame{
+				{Encapsulated: true, EncapsulatedData: frame.EncapsulatedFrame{Data: []byte{1, 2}}},
+			}},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			ds := Dataset{Elements: []*Element{
+				mustNewElement(tag.MediaStorageSOPClassUID, []string{"1.2.840.10008.5.1.4.1.1.1.2"}),
+				mustNewElement(tag.MediaStorageSOPInstanceUID, []string{"1.2.3.4.5.6.7"}),
+				mustNewElement(tag.TransferSyntaxUID, []string{tc.transferSyntax}),
+				mustNewElement(tag.PixelData, tc.pixelData),
+			}}
+			if err := Write(&bytes.Buffer{}, ds); err != nil {
+				t.Errorf("Write() unexpected error: %v", err)
+			}
+			if err := Write(&bytes.Buffer{}, ds, StrictValidation()); !errors.Is(err, ErrorPixelDataEncapsulation) {
+				t.Errorf("Write(StrictValidation()) unexpected error. got: %v, want: %v", err, ErrorPixelDataEncapsulation)
+			}
+		})
+	}
+}
+
 func TestVerifyVR(t *testing.T) {
 	cases := []struct {
 		name    string
@@ -363,6 +577,16 @@ func TestVerifyVR(t *testing.T) {
 			wantVR:  "UN",
 			wantErr: false,
 		},
+		{
+			name: "private tag with vr",
+			tg: tag.Tag{
+				Group:   0x0029,
+				Element: 0x1001,
+			},
+			inVR:    "SL",
+			wantVR:  "SL",
+			wantErr: false,
+		},
 	}
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
@@ -452,3 +676,200 @@ func TestWriteFloats(t *testing.T) {
 	}
 
 }
+
+// BenchmarkWrite runs sanity benchmarks writing the sample files in testdata.
+func BenchmarkWrite(b *testing.B) {
+	files, err := ioutil.ReadDir("./testdata")
+	if err != nil {
+		b.Fatalf("unable to read testdata/: %v", err)
+	}
+	for _, f := range files {
+		if !f.IsDir() && strings.HasSuffix(f.Name(), ".dcm") {
+			b.Run(f.Name(), func(b *testing.B) {
+				ds, err := ParseFile("./testdata/"+f.Name(), nil)
+				if err != nil {
+					b.Fatalf("Unable to parse %s. Error: %v", f.Name(), err)
+				}
+
+				b.ReportAllocs()
+				b.ResetTimer()
+				for i := 0; i < b.N; i++ {
+					// Some sample files have elements with VRs that differ
+					// from the dictionary's.
+					if err := Write(ioutil.Discard, ds, SkipVRVerification()); err != nil {
+						b.Fatalf("Write(%s) unexpected error: %v", f.Name(), err)
+					}
+				}
+			})
+		}
+	}
+}
+
+// BenchmarkWrite_MultiFrame benchmarks writing a large native multi-frame
+// PixelData, to keep track of the memory used on top of the Dataset.
+func BenchmarkWrite_MultiFrame(b *testing.B) {
+	const numFrames, rows, cols = 64, 512, 512
+	frames := make([]frame.Frame, numFrames)
+	for i := range frames {
+		data := make([][]int, rows*cols)
+		for j := range data {
+			data[j] = []int{j % 4096}
+		}
+		frames[i] = frame.Frame{NativeData: frame.NativeFrame{BitsPerSample: 16, Rows: rows, Cols: cols, Data: data}}
+	}
+	ds := Dataset{Elements: []*Element{
+		mustNewElement(tag.TransferSyntaxUID, []string{uid.ExplicitVRLittleEndian}),
+		mustNewElement(tag.NumberOfFrames, []string{"64"}),
+		mustNewElement(tag.Rows, []int{rows}),
+		mustNewElement(tag.Columns, []int{cols}),
+		mustNewElement(tag.BitsAllocated, []int{16}),
+		mustNewElement(tag.PixelData, PixelDataInfo{Frames: frames}),
+	}}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if err := Write(ioutil.Discard, ds); err != nil {
+			b.Fatalf("Write() unexpected error: %v", err)
+		}
+	}
+}
+
+func TestValueLength(t *testing.T) {
+	cases := []struct {
+		name  string
+		value Value
+		vr    string
+		opts  writeOptSet
+	}{
+		{name: "strings", value: &stringsValue{value: []string{"Bob", "Jones"}}, vr: vrraw.PersonName},
+		{name: "odd string", value: &stringsValue{value: []string{"1.2.3"}}, vr: vrraw.UniqueIdentifier},
+		{name: "no strings", value: &stringsValue{value: []string{}}, vr: vrraw.LongString},
+		{name: "OB", value: &bytesValue{value: []byte{1, 2, 3}}, vr: vrraw.OtherByte},
+		{name: "OW", value: &bytesValue{value: []byte{1, 2, 3, 4}}, vr: vrraw.OtherWord},
+		{name: "US", value: &intsValue{value: []int{1, 2, 3}}, vr: vrraw.UnsignedShort},
+		{name: "SL", value: &intsValue{value: []int{-1}}, vr: vrraw.SignedLong},
+		{name: "FL", value: &floatsValue{value: []float64{1.5, 2}}, vr: vrraw.FloatingPointSingle},
+		{name: "FD", value: &floatsValue{value: []float64{1.5}}, vr: vrraw.FloatingPointDouble},
+		{
+			name: "native PixelData",
+			value: &pixelDataValue{PixelDataInfo{Frames: []frame.Frame{
+				{NativeData: frame.NativeFrame{BitsPerSample: 8, Rows: 1, Cols: 3, Data: [][]int{{1}, {2}, {3}}}},
+			}}},
+			vr: vrraw.OtherByte,
+		},
+		{
+			name: "defined length sequence",
+			value: makeSequenceElement(tag.ReferencedSeriesSequence, [][]*Element{
+				{mustNewElement(tag.SeriesInstanceUID, []string{"1.2.3"}), mustNewElement(tag.Rows, []int{1})},
+				{makeSequenceElement(tag.ReferencedImageSequence, [][]*Element{
+					{mustNewElement(tag.ReferencedSOPInstanceUID, []string{"1.2.3.4"})},
+				})},
+			}).Value,
+			vr:   vrraw.Sequence,
+			opts: writeOptSet{definedLengthSequences: true},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := valueLength(tag.PixelData, tc.value, tc.vr, 0, false, tc.opts)
+			if err != nil {
+				t.Fatalf("valueLength() unexpected error: %v", err)
+			}
+			buf := bytes.Buffer{}
+			w := dicomio.NewWriter(&buf, binary.LittleEndian, false)
+			if err := writeValue(w, tag.PixelData, tc.value, tc.value.ValueType(), tc.vr, got, tc.opts); err != nil {
+				t.Fatalf("writeValue() unexpected error: %v", err)
+			}
+			if int(got) != buf.Len() {
+				t.Errorf("valueLength() got: %d, but writeValue wrote %d bytes", got, buf.Len())
+			}
+		})
+	}
+}
+
+func TestWrite_DictionaryVRForUnknown(t *testing.T) {
+	unknown := func(t tag.Tag, v Value) *Element {
+		return &Element{Tag: t, ValueRepresentation: tag.VRStringList, RawValueRepresentation: vrraw.Unknown, Value: v}
+	}
+	ds := Dataset{Elements: []*Element{
+		mustNewElement(tag.MediaStorageSOPClassUID, []string{"1.2.840.10008.5.1.4.1.1.1.2"}),
+		mustNewElement(tag.MediaStorageSOPInstanceUID, []string{"1.2.3.4.5.6.7"}),
+		mustNewElement(tag.TransferSyntaxUID, []string{uid.ExplicitVRLittleEndian}),
+		unknown(tag.PatientName, &stringsValue{value: []string{"Bob", "Jones"}}),
+		unknown(tag.Rows, &bytesValue{value: []byte{0x80, 0x00}}),
+		unknown(tag.Tag{Group: 0x0009, Element: 0x0010}, &stringsValue{value: []string{"CREATOR"}}),
+	}}
+
+	if err := Write(&bytes.Buffer{}, ds); err == nil {
+		t.Errorf("Write() of UN elements of known tags got no error, want a VR mismatch")
+	}
+
+	out := &bytes.Buffer{}
+	if err := Write(out, ds, DictionaryVRForUnknown()); err != nil {
+		t.Fatalf("Write(DictionaryVRForUnknown()) unexpected error: %v", err)
+	}
+	got, err := Parse(out, int64(out.Len()), nil)
+	if err != nil {
+		t.Fatalf("Parse() unexpected error: %v", err)
+	}
+	for _, want := range []*Element{
+		mustNewElement(tag.PatientName, []string{"Bob", "Jones"}),
+		mustNewElement(tag.Rows, []int{128}),
+		// Private Creator elements are LO.
+		{Tag: tag.Tag{Group: 0x0009, Element: 0x0010}, RawValueRepresentation: vrraw.LongString, Value: &stringsValue{value: []string{"CREATOR"}}},
+	} {
+		elem, err := got.FindElementByTag(want.Tag)
+		if err != nil {
+			t.Fatalf("Parse() did not read %v: %v", want.Tag, err)
+		}
+		if elem.RawValueRepresentation != want.RawValueRepresentation {
+			t.Errorf("Write(DictionaryVRForUnknown()) unexpected VR of %v. got: %v, want: %v", want.Tag, elem.RawValueRepresentation, want.RawValueRepresentation)
+		}
+		if diff := cmp.Diff(want.Value, elem.Value, cmp.AllowUnexported(allValues...)); diff != "" {
+			t.Errorf("Write(DictionaryVRForUnknown()) unexpected value of %v. diff: %v", want.Tag, diff)
+		}
+	}
+
+	ds.Elements = append(ds.Elements, unknown(tag.Columns, &bytesValue{value: []byte{1, 2, 3}}))
+	if err := Write(&bytes.Buffer{}, ds, DictionaryVRForUnknown()); err == nil {
+		t.Errorf("Write(DictionaryVRForUnknown()) of a value not matching its dictionary VR got no error")
+	}
+}
+
+func TestWrite_DictionaryVRForUnknown_ParsedPrivate(t *testing.T) {
+	const creator = "DICTIONARY VR TEST"
+	privateTag := tag.Tag{Group: 0x0009, Element: 0x1001}
+	in := &bytes.Buffer{}
+	if err := Write(in, Dataset{Elements: []*Element{
+		mustNewElement(tag.MediaStorageSOPClassUID, []string{"1.2.840.10008.5.1.4.1.1.1.2"}),
+		mustNewElement(tag.MediaStorageSOPInstanceUID, []string{"1.2.3.4.5.6.7"}),
+		mustNewElement(tag.TransferSyntaxUID, []string{uid.ExplicitVRLittleEndian}),
+		{Tag: tag.Tag{Group: 0x0009, Element: 0x0010}, RawValueRepresentation: vrraw.LongString, Value: &stringsValue{value: []string{creator}}},
+		{Tag: privateTag, RawValueRepresentation: vrraw.Unknown, Value: &stringsValue{value: []string{"\x05\x01"}}},
+	}}); err != nil {
+		t.Fatalf("Write() unexpected error: %v", err)
+	}
+	// The private tag is unknown when the file is parsed, and stays UN.
+	parsed, err := Parse(in, int64(in.Len()), nil)
+	if err != nil {
+		t.Fatalf("Parse() unexpected error: %v", err)
+	}
+	if elem, err := parsed.FindElementByTag(privateTag); err != nil || elem.RawValueRepresentation != vrraw.Unknown {
+		t.Fatalf("Parse() of an unregistered private tag got: %v, %v, want VR UN", elem, err)
+	}
+
+	if err := tag.RegisterPrivate(creator, tag.Info{Tag: tag.Tag{Group: 0x0009, Element: 0x0001}, VR: "US", Name: "DictionaryVRTest", VM: "1"}); err != nil {
+		t.Fatalf("RegisterPrivate() unexpected error: %v", err)
+	}
+	out := &bytes.Buffer{}
+	if err := Write(out, parsed, DictionaryVRForUnknown()); err != nil {
+		t.Fatalf("Write(DictionaryVRForUnknown()) unexpected error: %v", err)
+	}
+	// Parse would read the UN element with its registered VR too, so check
+	// the written element itself: (0009,1001) US, length 2, value 0x0105.
+	want := []byte{0x09, 0x00, 0x01, 0x10, 'U', 'S', 0x02, 0x00, 0x05, 0x01}
+	if !bytes.Contains(out.Bytes(), want) {
+		t.Errorf("Write(DictionaryVRForUnknown()) did not write %v with its private dictionary VR, want bytes: %v", privateTag, want)
+	}
+}
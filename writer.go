@@ -0,0 +1,576 @@
+package dicom
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/suyashkumar/dicom/pkg/dicomio"
+	"github.com/suyashkumar/dicom/pkg/frame"
+	"github.com/suyashkumar/dicom/pkg/tag"
+	"github.com/suyashkumar/dicom/pkg/uid"
+	"github.com/suyashkumar/dicom/pkg/vrraw"
+)
+
+var (
+	// ErrorWriterState indicates that a Writer method was called out of order, e.g. WriteElement before WriteHeader,
+	// or BeginItem outside of a sequence.
+	ErrorWriterState = errors.New("writer method called out of order")
+	// ErrorPixelDataFrames indicates that the frames given to Writer.WritePixelData do not match the image pixel
+	// elements written before them, e.g. their Rows and Columns, or their NumberOfFrames.
+	ErrorPixelDataFrames = errors.New("frames do not match the image pixel elements")
+	// ErrorNotSeekable indicates that Writer.WritePixelData was asked for an offset table, which it fills in once all
+	// frames are written, while the Writer does not write to an io.WriteSeeker.
+	ErrorNotSeekable = errors.New("offset tables require an io.WriteSeeker")
+)
+
+// OffsetTable selects the offset table that Writer.WritePixelData writes with encapsulated PixelData (PS3.5 A.4).
+type OffsetTable int
+
+const (
+	// NoOffsetTable writes an empty Basic Offset Table.
+	NoOffsetTable OffsetTable = iota
+	// BasicOffsetTable writes the offset of each frame in the Basic Offset Table. The offsets must fit in 32 bits.
+	BasicOffsetTable
+	// ExtendedOffsetTable writes the offset and length of each frame in the ExtendedOffsetTable and
+	// ExtendedOffsetTableLengths elements before the PixelData, and an empty Basic Offset Table (PS3.3 C.7.6.3).
+	ExtendedOffsetTable
+)
+
+// Tags of the Extended Offset Table (PS3.3 C.7.6.3), which precede PixelData.
+var (
+	extendedOffsetTableTag        = tag.Tag{Group: 0x7FE0, Element: 0x0001}
+	extendedOffsetTableLengthsTag = tag.Tag{Group: 0x7FE0, Element: 0x0002}
+)
+
+// imagePixelTags are the elements that Writer.WritePixelData relies on to write native PixelData.
+var imagePixelTags = map[tag.Tag]bool{
+	tag.Rows:            true,
+	tag.Columns:         true,
+	tag.SamplesPerPixel: true,
+	tag.BitsAllocated:   true,
+	tag.NumberOfFrames:  true,
+}
+
+// NextFrame returns the next frame to be written by Writer.WritePixelData, or io.EOF once there are no more.
+type NextFrame func() (*frame.Frame, error)
+
+// FramesFromChannel returns a NextFrame that receives frames from fc until it is closed. It mirrors the frameChan of
+// Parse.
+func FramesFromChannel(fc <-chan *frame.Frame) NextFrame {
+	return func() (*frame.Frame, error) {
+		f, ok := <-fc
+		if !ok {
+			return nil, io.EOF
+		}
+		return f, nil
+	}
+}
+
+// Writer is a struct that allows a user to write a DICOM element-by-element, which may be useful to emit large
+// Datasets without first building them in memory. If you instead already have the whole Dataset, just use the
+// dicom.Write(...) method.
+//
+// A DICOM is written by a call to WriteHeader, followed by calls to WriteElement for the top-level elements in order.
+// Sequences can be written whole by WriteElement, or piece by piece with undefined lengths: BeginSequence starts a
+// sequence, BeginItem starts an item of the innermost open sequence, whose elements are then written by WriteElement,
+// and End closes the innermost open item or sequence. Close checks that everything was closed.
+//
+// The checks that Write makes on the whole Dataset are made per element: private data elements must follow their
+// Private Creator at the same level, and StrictValidation reports the problems of one element at a time.
+// GenerateFileMeta is not supported, as the SOP Instance is not known when the header is written.
+type Writer struct {
+	out    io.Writer
+	w      dicomio.Writer
+	opts   writeOptSet
+	header bool
+	// ts is the transfer syntax of the Dataset, set by WriteHeader.
+	ts uid.TransferSyntax
+	// open is the stack of open sequences and items, innermost last. The
+	// top-level Dataset is its first entry.
+	open []*openScope
+}
+
+// openScope is the top-level Dataset or an open sequence or item of a Writer.
+type openScope struct {
+	isItem bool
+	// path locates the scope for validation reports, e.g. "Seq[0]".
+	path string
+	// items is the number of items begun in a sequence.
+	items int
+	// reserved are the Private Creators seen in an item or the Dataset.
+	reserved map[tag.Tag]bool
+	// pixelModule holds the elements written in an item or the Dataset that
+	// describe its PixelData, see imagePixelTags.
+	pixelModule Dataset
+	// vrContext holds the Private Creator and PixelRepresentation elements
+	// written in an item or the Dataset, which decide the VR of the elements
+	// converted by DictionaryVRForUnknown.
+	vrContext Dataset
+}
+
+// NewWriter returns a new Writer that writes to out. The WriteOptions apply to every element written.
+func NewWriter(out io.Writer, opts ...WriteOption) *Writer {
+	return &Writer{
+		out:  out,
+		w:    dicomio.NewWriter(out, nil, false),
+		opts: *toOptSet(opts...),
+		open: []*openScope{{isItem: true, reserved: make(map[tag.Tag]bool)}},
+	}
+}
+
+// WriteHeader writes the preamble, magic word and File Meta Information made of metaElems, which must all be in group
+// 0x0002. The FileMetaInformationGroupLength is computed, and the TransferSyntaxUID element selects the encoding of
+// the elements written next (or the default LittleEndian Implicit under DefaultMissingTransferSyntax).
+func (w *Writer) WriteHeader(metaElems []*Element) error {
+	if w.header {
+		return fmt.Errorf("%w: header already written", ErrorWriterState)
+	}
+	for _, elem := range metaElems {
+		if elem.Tag.Group != tag.MetadataGroup {
+			return fmt.Errorf("%w: %s is not a File Meta Information element", ErrorWriterState, tag.DebugString(elem.Tag))
+		}
+	}
+	if w.opts.generateFileMeta {
+		return fmt.Errorf("%w: GenerateFileMeta is not supported by Writer", ErrorUnimplemented)
+	}
+	meta := Dataset{Elements: metaElems}
+	if w.opts.strictValidation {
+		if err := validateValues(&meta); err != nil {
+			return err
+		}
+	}
+	ts, err := meta.transferSyntax()
+	if err == ErrorElementNotFound && w.opts.defaultMissingTransferSyntax {
+		ts, err = uid.LookupTransferSyntax(uid.ImplicitVRLittleEndian)
+	}
+	if err != nil {
+		return err
+	}
+	if ts.Deflated {
+		return fmt.Errorf("%w: Writer does not support deflated transfer syntaxes", ErrorUnimplemented)
+	}
+	if err := writeFileHeader(w.w, &meta, metaElems, w.opts); err != nil {
+		return err
+	}
+	w.w.SetTransferSyntax(ts.ByteOrder, ts.Implicit)
+	w.ts = ts
+	w.header = true
+	return nil
+}
+
+// WriteElement writes elem in the innermost open item, or at the top level of the Dataset if none is open.
+func (w *Writer) WriteElement(elem *Element) error {
+	scope, err := w.itemScope()
+	if err != nil {
+		return err
+	}
+	if elem.Tag.Group == tag.MetadataGroup {
+		return fmt.Errorf("%w: %s must be written by WriteHeader", ErrorWriterState, tag.DebugString(elem.Tag))
+	}
+	if err := w.verifyElement(scope, elem); err != nil {
+		return err
+	}
+	elem, err = withDictionaryVR(elem, &scope.vrContext, w.opts)
+	if err != nil {
+		return err
+	}
+	if err := writeElement(w.w, elem, w.opts); err != nil {
+		return err
+	}
+	if imagePixelTags[elem.Tag] {
+		scope.pixelModule.Elements = append(scope.pixelModule.Elements, elem)
+	}
+	if tag.IsPrivateCreator(elem.Tag) || elem.Tag == tag.PixelRepresentation {
+		scope.vrContext.Elements = append(scope.vrContext.Elements, elem)
+	}
+	return nil
+}
+
+// BeginSequence starts a sequence with tag t and an undefined length in the innermost open item, or at the top level
+// of the Dataset if none is open. Its items are started by BeginItem, and it is closed by End. It fails under
+// DefinedLengthSequences, as the length of the sequence is not known yet.
+func (w *Writer) BeginSequence(t tag.Tag) error {
+	scope, err := w.itemScope()
+	if err != nil {
+		return err
+	}
+	if w.opts.definedLengthSequences {
+		return fmt.Errorf("%w: BeginSequence under DefinedLengthSequences, use WriteElement", ErrorWriterState)
+	}
+	if !w.opts.skipVRVerification {
+		if _, err := verifyVROrDefault(t, vrraw.Sequence); err != nil {
+			return err
+		}
+	}
+	if err := w.verifyElement(scope, &Element{Tag: t, RawValueRepresentation: vrraw.Sequence}); err != nil {
+		return err
+	}
+	if err := encodeElementHeader(w.w, t, vrraw.Sequence, tag.VLUndefinedLength); err != nil {
+		return err
+	}
+	info, infoErr := tag.Find(t)
+	w.open = append(w.open, &openScope{path: valuePath(scope.path, t, info, infoErr)})
+	return nil
+}
+
+// BeginItem starts an item with an undefined length in the innermost open sequence. Its elements are written by
+// WriteElement and BeginSequence, and it is closed by End.
+func (w *Writer) BeginItem() error {
+	scope := w.open[len(w.open)-1]
+	if scope.isItem {
+		return fmt.Errorf("%w: BeginItem outside of a sequence", ErrorWriterState)
+	}
+	if err := writeElement(w.w, item, w.opts); err != nil {
+		return err
+	}
+	w.open = append(w.open, &openScope{
+		isItem:   true,
+		path:     fmt.Sprintf("%s[%d]", scope.path, scope.items),
+		reserved: make(map[tag.Tag]bool),
+	})
+	scope.items++
+	return nil
+}
+
+// End closes the innermost open item or sequence, writing its delimitation item.
+func (w *Writer) End() error {
+	if len(w.open) == 1 {
+		return fmt.Errorf("%w: End without an open sequence or item", ErrorWriterState)
+	}
+	scope := w.open[len(w.open)-1]
+	if scope.isItem {
+		if err := writeElement(w.w, sequenceItemDelimitationItem, w.opts); err != nil {
+			return err
+		}
+	} else {
+		// Like writeSequence, write the Sequence Delimitation Item as implicit VR.
+		bo, implicit := w.w.GetTransferSyntax()
+		w.w.SetTransferSyntax(bo, true)
+		err := writeElement(w.w, sequenceDelimitationItem, w.opts)
+		w.w.SetTransferSyntax(bo, implicit)
+		if err != nil {
+			return err
+		}
+	}
+	w.open = w.open[:len(w.open)-1]
+	return nil
+}
+
+// Close checks that the header was written and that every sequence and item begun was closed by End. It does not
+// close the underlying io.Writer.
+func (w *Writer) Close() error {
+	if !w.header {
+		return fmt.Errorf("%w: header not written", ErrorWriterState)
+	}
+	if n := len(w.open) - 1; n > 0 {
+		return fmt.Errorf("%w: %d sequences or items not closed", ErrorWriterState, n)
+	}
+	return nil
+}
+
+// itemScope returns the innermost open item, or the top-level Dataset, in which elements can be written.
+func (w *Writer) itemScope() (*openScope, error) {
+	if !w.header {
+		return nil, fmt.Errorf("%w: header not written", ErrorWriterState)
+	}
+	scope := w.open[len(w.open)-1]
+	if !scope.isItem {
+		return nil, fmt.Errorf("%w: elements of a sequence must be in an item", ErrorWriterState)
+	}
+	return scope, nil
+}
+
+// verifyElement makes the checks of Write on elem, about to be written in scope.
+func (w *Writer) verifyElement(scope *openScope, elem *Element) error {
+	if !w.opts.skipPrivateCreatorVerification {
+		if tag.IsPrivateCreator(elem.Tag) {
+			scope.reserved[elem.Tag] = true
+		}
+		if creatorTag, ok := tag.PrivateCreatorFor(elem.Tag); ok && !scope.reserved[creatorTag] {
+			return fmt.Errorf("%w: %s", ErrorPrivateCreatorNotFound, tag.DebugString(elem.Tag))
+		}
+		if elem.Value != nil && elem.Value.ValueType() == Sequences {
+			for _, item := range elem.Value.GetValue().([]*SequenceItemValue) {
+				if err := verifyPrivateBlocks(item.elements); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	if w.opts.strictValidation {
+		if errs := validateElements(&Dataset{Elements: []*Element{elem}}, scope.path); len(errs) > 0 {
+			return ValidationError(errs)
+		}
+	}
+	if elem.Tag == tag.PixelData {
+		return verifyPixelDataEncapsulation(Dataset{Elements: []*Element{elem}}, w.ts)
+	}
+	return nil
+}
+
+// WritePixelData writes a PixelData element, in the innermost open item or at the top level of the Dataset, made of
+// the frames returned by next until io.EOF. Frames are written as they come and are not kept in memory.
+//
+// Native frames are written with a defined length, computed up front from the Rows, Columns, BitsAllocated,
+// SamplesPerPixel (default 1) and NumberOfFrames (default 1) elements, which must have been written before in the
+// same item or Dataset. Exactly NumberOfFrames frames of that size must then follow.
+//
+// Encapsulated frames, required by encapsulated transfer syntaxes, are written one fragment each, and table selects
+// the offset table. BasicOffsetTable and ExtendedOffsetTable are filled in once all the frames are written, which
+// requires the Writer to write to an io.WriteSeeker and exactly NumberOfFrames frames.
+func (w *Writer) WritePixelData(next NextFrame, table OffsetTable) error {
+	scope, err := w.itemScope()
+	if err != nil {
+		return err
+	}
+	numFrames, err := scope.pixelInt(tag.NumberOfFrames, 1)
+	if err != nil {
+		return err
+	}
+	if !w.ts.Encapsulated {
+		if table != NoOffsetTable {
+			return fmt.Errorf("%w: offset tables are only written with encapsulated PixelData", ErrorPixelDataEncapsulation)
+		}
+		return w.writeNativeFrames(scope, next, numFrames)
+	}
+	return w.writeEncapsulatedFrames(next, table, numFrames)
+}
+
+func (w *Writer) writeNativeFrames(scope *openScope, next NextFrame, numFrames int) error {
+	var dims [4]int
+	for i, t := range []tag.Tag{tag.Rows, tag.Columns, tag.BitsAllocated, tag.SamplesPerPixel} {
+		def := -1
+		if t == tag.SamplesPerPixel {
+			def = 1
+		}
+		n, err := scope.pixelInt(t, def)
+		if err != nil {
+			return err
+		}
+		dims[i] = n
+	}
+	rows, cols, bitsAllocated, samples := dims[0], dims[1], dims[2], dims[3]
+	if bitsAllocated != 8 && bitsAllocated != 16 && bitsAllocated != 32 {
+		return ErrorUnsupportedBitsPerSample
+	}
+	frameLength := rows * cols * samples * bitsAllocated / 8
+	length := uint64(frameLength) * uint64(numFrames)
+	if length+length%2 >= uint64(tag.VLUndefinedLength) {
+		return fmt.Errorf("%w: %d bytes of native PixelData do not fit in a defined length", ErrorPixelDataFrames, length)
+	}
+
+	vr := vrraw.OtherWord
+	if bitsAllocated == 8 {
+		vr = vrraw.OtherByte
+	}
+	if err := encodeElementHeader(w.w, tag.PixelData, vr, uint32(length+length%2)); err != nil {
+		return err
+	}
+	var buf []byte
+	for i := 0; ; i++ {
+		f, err := next()
+		if err == io.EOF {
+			if i != numFrames {
+				return fmt.Errorf("%w: got %d frames, NumberOfFrames is %d", ErrorPixelDataFrames, i, numFrames)
+			}
+			break
+		}
+		if err != nil {
+			return err
+		}
+		if f.Encapsulated {
+			return fmt.Errorf("%w: encapsulated frame in a native transfer syntax", ErrorPixelDataEncapsulation)
+		}
+		if i >= numFrames {
+			return fmt.Errorf("%w: more than NumberOfFrames %d frames", ErrorPixelDataFrames, numFrames)
+		}
+		if f.NativeData.BitsPerSample != bitsAllocated {
+			return fmt.Errorf("%w: frame %d has %d bits per sample, BitsAllocated is %d", ErrorPixelDataFrames, i,
+				f.NativeData.BitsPerSample, bitsAllocated)
+		}
+		if buf, err = appendNativeFrame(buf[:0], &f.NativeData, w.ts.ByteOrder); err != nil {
+			return err
+		}
+		if len(buf) != frameLength {
+			return fmt.Errorf("%w: frame %d is %d bytes, want %d", ErrorPixelDataFrames, i, len(buf), frameLength)
+		}
+		if err := w.w.WriteBytes(buf); err != nil {
+			return err
+		}
+	}
+	if length%2 == 1 {
+		return w.w.WriteByte(0)
+	}
+	return nil
+}
+
+func (w *Writer) writeEncapsulatedFrames(next NextFrame, table OffsetTable, numFrames int) error {
+	var ws io.WriteSeeker
+	if table != NoOffsetTable {
+		var ok bool
+		if ws, ok = w.out.(io.WriteSeeker); !ok {
+			return ErrorNotSeekable
+		}
+	}
+	position := func() (int64, error) { return ws.Seek(0, io.SeekCurrent) }
+
+	// The offset tables are written with zeros and filled in at the end, at
+	// these positions.
+	var eotPosition, eotLengthsPosition, botPosition int64
+	if table == ExtendedOffsetTable {
+		for _, t := range []tag.Tag{extendedOffsetTableTag, extendedOffsetTableLengthsTag} {
+			if err := encodeElementHeader(w.w, t, vrraw.OtherVeryLong, uint32(8*numFrames)); err != nil {
+				return err
+			}
+			pos, err := position()
+			if err != nil {
+				return err
+			}
+			if t == extendedOffsetTableTag {
+				eotPosition = pos
+			} else {
+				eotLengthsPosition = pos
+			}
+			if err := w.w.WriteZeros(8 * numFrames); err != nil {
+				return err
+			}
+		}
+	}
+	if err := encodeElementHeader(w.w, tag.PixelData, vrraw.OtherByte, tag.VLUndefinedLength); err != nil {
+		return err
+	}
+	if table == BasicOffsetTable {
+		pos, err := position()
+		if err != nil {
+			return err
+		}
+		// The values of the Basic Offset Table item follow its tag and length.
+		botPosition = pos + 8
+		if err := writeRawItem(w.w, make([]byte, 4*numFrames)); err != nil {
+			return err
+		}
+	} else if err := writeRawItem(w.w, nil); err != nil {
+		return err
+	}
+
+	// Offsets are from the first byte of the first fragment item.
+	var offsets, lengths []uint64
+	var offset uint64
+	for i := 0; ; i++ {
+		f, err := next()
+		if err == io.EOF {
+			if table != NoOffsetTable && i != numFrames {
+				return fmt.Errorf("%w: got %d frames, NumberOfFrames is %d", ErrorPixelDataFrames, i, numFrames)
+			}
+			break
+		}
+		if err != nil {
+			return err
+		}
+		if !f.Encapsulated {
+			return fmt.Errorf("%w: native frame in an encapsulated transfer syntax", ErrorPixelDataEncapsulation)
+		}
+		if table != NoOffsetTable && i >= numFrames {
+			return fmt.Errorf("%w: more than NumberOfFrames %d frames", ErrorPixelDataFrames, numFrames)
+		}
+		data := f.EncapsulatedData.Data
+		if err := writeRawItem(w.w, data); err != nil {
+			return err
+		}
+		length := uint64(len(data) + len(data)%2)
+		offsets = append(offsets, offset)
+		lengths = append(lengths, length)
+		offset += 8 + length
+	}
+	if err := encodeElementHeader(w.w, tag.SequenceDelimitationItem, "", 0); err != nil {
+		return err
+	}
+
+	switch table {
+	case BasicOffsetTable:
+		values := make([]byte, 0, 4*numFrames)
+		for _, o := range offsets {
+			if o > math.MaxUint32 {
+				return fmt.Errorf("%w: frame offset %d does not fit in a Basic Offset Table, use ExtendedOffsetTable",
+					ErrorPixelDataFrames, o)
+			}
+			values = appendUint32(values, uint32(o))
+		}
+		return w.fillIn(ws, botPosition, values)
+	case ExtendedOffsetTable:
+		values := make([]byte, 0, 8*numFrames)
+		for _, o := range offsets {
+			values = appendUint64(values, o)
+		}
+		if err := w.fillIn(ws, eotPosition, values); err != nil {
+			return err
+		}
+		values = values[:0]
+		for _, l := range lengths {
+			values = appendUint64(values, l)
+		}
+		return w.fillIn(ws, eotLengthsPosition, values)
+	}
+	return nil
+}
+
+// fillIn overwrites the bytes of ws at pos with data, and seeks back to where it was.
+func (w *Writer) fillIn(ws io.WriteSeeker, pos int64, data []byte) error {
+	end, err := ws.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return err
+	}
+	if _, err := ws.Seek(pos, io.SeekStart); err != nil {
+		return err
+	}
+	if err := w.w.WriteBytes(data); err != nil {
+		return err
+	}
+	_, err = ws.Seek(end, io.SeekStart)
+	return err
+}
+
+// pixelInt returns the integer value of the element with tag t written in the scope, or def if it is absent and def
+// is not negative.
+func (s *openScope) pixelInt(t tag.Tag, def int) (int, error) {
+	elem, err := s.pixelModule.FindElementByTag(t)
+	if err != nil {
+		if def >= 0 {
+			return def, nil
+		}
+		return 0, fmt.Errorf("%w: %s must be written before PixelData", ErrorElementNotFound, tag.DebugString(t))
+	}
+	switch v := elem.Value.GetValue().(type) {
+	case []int:
+		if len(v) > 0 {
+			return v[0], nil
+		}
+	case []string:
+		// NumberOfFrames is an IS.
+		if len(v) > 0 {
+			if n, err := strconv.Atoi(strings.TrimSpace(v[0])); err == nil {
+				return n, nil
+			}
+		}
+	}
+	return 0, fmt.Errorf("%w: %s is not a number: %v", ErrorPixelDataFrames, tag.DebugString(t), elem.Value)
+}
+
+// appendUint32 appends v to b in little endian, the byte order of encapsulated transfer syntaxes.
+func appendUint32(b []byte, v uint32) []byte {
+	var buf [4]byte
+	binary.LittleEndian.PutUint32(buf[:], v)
+	return append(b, buf[:]...)
+}
+
+// appendUint64 appends v to b in little endian.
+func appendUint64(b []byte, v uint64) []byte {
+	var buf [8]byte
+	binary.LittleEndian.PutUint64(buf[:], v)
+	return append(b, buf[:]...)
+}
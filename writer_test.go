@@ -0,0 +1,432 @@
+package dicom
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/suyashkumar/dicom/pkg/frame"
+	"github.com/suyashkumar/dicom/pkg/tag"
+	"github.com/suyashkumar/dicom/pkg/uid"
+)
+
+func TestWriter(t *testing.T) {
+	for _, ts := range []string{uid.ImplicitVRLittleEndian, uid.ExplicitVRLittleEndian, uid.ExplicitVRBigEndian} {
+		t.Run(ts, func(t *testing.T) {
+			meta := []*Element{
+				mustNewElement(tag.MediaStorageSOPClassUID, []string{"1.2.840.10008.5.1.4.1.1.1.2"}),
+				mustNewElement(tag.MediaStorageSOPInstanceUID, []string{"1.2.3.4.5.6.7"}),
+				mustNewElement(tag.TransferSyntaxUID, []string{ts}),
+			}
+			patientName := mustNewElement(tag.PatientName, []string{"Bob", "Jones"})
+			seriesUID := func(u string) *Element { return mustNewElement(tag.SeriesInstanceUID, []string{u}) }
+			nested := makeSequenceElement(tag.ReferencedImageSequence, [][]*Element{
+				{mustNewElement(tag.ReferencedSOPInstanceUID, []string{"1.2.3.4"})},
+			})
+			rows := mustNewElement(tag.Rows, []int{128})
+
+			// The same Dataset, written whole by Write.
+			want := &bytes.Buffer{}
+			ds := Dataset{Elements: append(append([]*Element(nil), meta...),
+				patientName,
+				makeSequenceElement(tag.ReferencedSeriesSequence, [][]*Element{
+					{seriesUID("1.2.3"), nested},
+					{seriesUID("1.2.4")},
+				}),
+				rows,
+			)}
+			if err := Write(want, ds); err != nil {
+				t.Fatalf("Write() unexpected error: %v", err)
+			}
+
+			got := &bytes.Buffer{}
+			w := NewWriter(got)
+			for _, step := range []func() error{
+				func() error { return w.WriteHeader(meta) },
+				func() error { return w.WriteElement(patientName) },
+				func() error { return w.BeginSequence(tag.ReferencedSeriesSequence) },
+				w.BeginItem,
+				func() error { return w.WriteElement(seriesUID("1.2.3")) },
+				func() error { return w.WriteElement(nested) },
+				w.End,
+				w.BeginItem,
+				func() error { return w.WriteElement(seriesUID("1.2.4")) },
+				w.End,
+				w.End,
+				func() error { return w.WriteElement(rows) },
+				w.Close,
+			} {
+				if err := step(); err != nil {
+					t.Fatalf("Writer unexpected error: %v", err)
+				}
+			}
+			if !bytes.Equal(got.Bytes(), want.Bytes()) {
+				t.Errorf("Writer output differs from Write. got: %v, want: %v", got.Bytes(), want.Bytes())
+			}
+		})
+	}
+}
+
+func TestWriter_Errors(t *testing.T) {
+	meta := []*Element{mustNewElement(tag.TransferSyntaxUID, []string{uid.ImplicitVRLittleEndian})}
+	cases := []struct {
+		name     string
+		noHeader bool
+		steps    func(w *Writer) error
+		wantErr  error
+	}{
+		{
+			name:     "element before header",
+			noHeader: true,
+			steps:    func(w *Writer) error { return w.WriteElement(mustNewElement(tag.Rows, []int{1})) },
+			wantErr:  ErrorWriterState,
+		},
+		{
+			name: "element in a sequence outside of an item",
+			steps: func(w *Writer) error {
+				if err := w.BeginSequence(tag.ReferencedSeriesSequence); err != nil {
+					return err
+				}
+				return w.WriteElement(mustNewElement(tag.Rows, []int{1}))
+			},
+			wantErr: ErrorWriterState,
+		},
+		{
+			name:     "deflated transfer syntax",
+			noHeader: true,
+			steps: func(w *Writer) error {
+				return w.WriteHeader([]*Element{mustNewElement(tag.TransferSyntaxUID, []string{uid.DeflatedExplicitVRLittleEndian})})
+			},
+			wantErr: ErrorUnimplemented,
+		},
+		{
+			name:    "item outside of a sequence",
+			steps:   func(w *Writer) error { return w.BeginItem() },
+			wantErr: ErrorWriterState,
+		},
+		{
+			name:    "End without an open sequence",
+			steps:   func(w *Writer) error { return w.End() },
+			wantErr: ErrorWriterState,
+		},
+		{
+			name: "unclosed sequence",
+			steps: func(w *Writer) error {
+				if err := w.BeginSequence(tag.ReferencedSeriesSequence); err != nil {
+					return err
+				}
+				return w.Close()
+			},
+			wantErr: ErrorWriterState,
+		},
+		{
+			name:    "meta element after header",
+			steps:   func(w *Writer) error { return w.WriteElement(meta[0]) },
+			wantErr: ErrorWriterState,
+		},
+		{
+			name: "private element without its Private Creator",
+			steps: func(w *Writer) error {
+				return w.WriteElement(&Element{
+					Tag:                    tag.Tag{Group: 0x0009, Element: 0x1001},
+					RawValueRepresentation: "LO",
+					Value:                  &stringsValue{value: []string{"x"}},
+				})
+			},
+			wantErr: ErrorPrivateCreatorNotFound,
+		},
+		{
+			name: "BeginSequence under DefinedLengthSequences",
+			steps: func(w *Writer) error {
+				w.opts.definedLengthSequences = true
+				return w.BeginSequence(tag.ReferencedSeriesSequence)
+			},
+			wantErr: ErrorWriterState,
+		},
+		{
+			name: "invalid value under StrictValidation",
+			steps: func(w *Writer) error {
+				w.opts.strictValidation = true
+				return w.WriteElement(mustNewElement(tag.Modality, []string{"ct"}))
+			},
+			wantErr: ErrorInvalidValue,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			w := NewWriter(&bytes.Buffer{})
+			if !tc.noHeader {
+				if err := w.WriteHeader(meta); err != nil {
+					t.Fatalf("WriteHeader() unexpected error: %v", err)
+				}
+			}
+			if err := tc.steps(w); !errors.Is(err, tc.wantErr) {
+				t.Errorf("Writer unexpected error. got: %v, want: %v", err, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestWriter_BeginSequenceVR(t *testing.T) {
+	w := NewWriter(&bytes.Buffer{})
+	if err := w.WriteHeader([]*Element{mustNewElement(tag.TransferSyntaxUID, []string{uid.ExplicitVRLittleEndian})}); err != nil {
+		t.Fatalf("WriteHeader() unexpected error: %v", err)
+	}
+	if err := w.BeginSequence(tag.Rows); err == nil {
+		t.Errorf("BeginSequence(Rows) expected a VR mismatch error, got nil")
+	}
+}
+
+func TestWriter_WritePixelData_Native(t *testing.T) {
+	meta := []*Element{mustNewElement(tag.TransferSyntaxUID, []string{uid.ExplicitVRLittleEndian})}
+	frames := []frame.Frame{
+		{NativeData: frame.NativeFrame{BitsPerSample: 16, Rows: 2, Cols: 2, Data: [][]int{{1}, {2}, {3}, {4}}}},
+		{NativeData: frame.NativeFrame{BitsPerSample: 16, Rows: 2, Cols: 2, Data: [][]int{{5}, {6}, {7}, {8}}}},
+	}
+	pixelElems := []*Element{
+		mustNewElement(tag.NumberOfFrames, []string{"2"}),
+		mustNewElement(tag.Rows, []int{2}),
+		mustNewElement(tag.Columns, []int{2}),
+		mustNewElement(tag.BitsAllocated, []int{16}),
+	}
+
+	want := &bytes.Buffer{}
+	ds := Dataset{Elements: append(append(append([]*Element(nil), meta...), pixelElems...),
+		mustNewElement(tag.PixelData, PixelDataInfo{Frames: frames}))}
+	if err := Write(want, ds); err != nil {
+		t.Fatalf("Write() unexpected error: %v", err)
+	}
+
+	got := &bytes.Buffer{}
+	w := NewWriter(got)
+	if err := w.WriteHeader(meta); err != nil {
+		t.Fatalf("WriteHeader() unexpected error: %v", err)
+	}
+	for _, elem := range pixelElems {
+		if err := w.WriteElement(elem); err != nil {
+			t.Fatalf("WriteElement() unexpected error: %v", err)
+		}
+	}
+	fc := make(chan *frame.Frame, len(frames))
+	for i := range frames {
+		fc <- &frames[i]
+	}
+	close(fc)
+	if err := w.WritePixelData(FramesFromChannel(fc), NoOffsetTable); err != nil {
+		t.Fatalf("WritePixelData() unexpected error: %v", err)
+	}
+	if !bytes.Equal(got.Bytes(), want.Bytes()) {
+		t.Errorf("WritePixelData() output differs from Write. got: %v, want: %v", got.Bytes(), want.Bytes())
+	}
+}
+
+func TestWriter_WritePixelData_Encapsulated(t *testing.T) {
+	frameData := [][]byte{{1, 2, 3, 4}, {5, 6, 7}, {8, 9}}
+	for _, tc := range []struct {
+		name  string
+		table OffsetTable
+	}{
+		{name: "no offset table", table: NoOffsetTable},
+		{name: "basic offset table", table: BasicOffsetTable},
+		{name: "extended offset table", table: ExtendedOffsetTable},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			file, err := ioutil.TempFile("", "writer_test.dcm")
+			if err != nil {
+				t.Fatalf("TempFile() unexpected error: %v", err)
+			}
+			defer os.Remove(file.Name())
+			defer file.Close()
+
+			w := NewWriter(file)
+			if err := w.WriteHeader([]*Element{mustNewElement(tag.TransferSyntaxUID, []string{"1.2.840.10008.1.2.4.50"})}); err != nil {
+				t.Fatalf("WriteHeader() unexpected error: %v", err)
+			}
+			if err := w.WriteElement(mustNewElement(tag.NumberOfFrames, []string{"3"})); err != nil {
+				t.Fatalf("WriteElement() unexpected error: %v", err)
+			}
+			i := 0
+			next := func() (*frame.Frame, error) {
+				if i == len(frameData) {
+					return nil, io.EOF
+				}
+				i++
+				return &frame.Frame{Encapsulated: true, EncapsulatedData: frame.EncapsulatedFrame{Data: frameData[i-1]}}, nil
+			}
+			if err := w.WritePixelData(next, tc.table); err != nil {
+				t.Fatalf("WritePixelData() unexpected error: %v", err)
+			}
+
+			info, err := file.Stat()
+			if err != nil {
+				t.Fatalf("Stat() unexpected error: %v", err)
+			}
+			if _, err := file.Seek(0, io.SeekStart); err != nil {
+				t.Fatalf("Seek() unexpected error: %v", err)
+			}
+			ds, err := Parse(file, info.Size(), nil)
+			if err != nil {
+				t.Fatalf("Parse() unexpected error: %v", err)
+			}
+			pixelData, err := ds.FindElementByTag(tag.PixelData)
+			if err != nil {
+				t.Fatalf("FindElementByTag(PixelData) unexpected error: %v", err)
+			}
+			image := MustGetPixelDataInfo(pixelData.Value)
+			// Fragments are padded to an even length.
+			wantData := [][]byte{{1, 2, 3, 4}, {5, 6, 7, 0}, {8, 9}}
+			if len(image.Frames) != len(wantData) {
+				t.Fatalf("Parse() got %d frames, want %d", len(image.Frames), len(wantData))
+			}
+			for i, f := range image.Frames {
+				if !bytes.Equal(f.EncapsulatedData.Data, wantData[i]) {
+					t.Errorf("frame %d got: %v, want: %v", i, f.EncapsulatedData.Data, wantData[i])
+				}
+			}
+
+			// The reader skips the Basic Offset Table, so look for it after the
+			// PixelData header.
+			raw, err := ioutil.ReadFile(file.Name())
+			if err != nil {
+				t.Fatalf("ReadFile() unexpected error: %v", err)
+			}
+			header := []byte{0xE0, 0x7F, 0x10, 0x00, 'O', 'B', 0, 0, 0xFF, 0xFF, 0xFF, 0xFF, 0xFE, 0xFF, 0x00, 0xE0}
+			pos := bytes.Index(raw, header)
+			if pos < 0 {
+				t.Fatalf("PixelData header not found")
+			}
+			bot := raw[pos+len(header):]
+			var gotOffsets []uint32
+			for j := uint32(0); j < binary.LittleEndian.Uint32(bot); j += 4 {
+				gotOffsets = append(gotOffsets, binary.LittleEndian.Uint32(bot[4+j:]))
+			}
+			var wantOffsets []uint32
+			if tc.table == BasicOffsetTable {
+				wantOffsets = []uint32{0, 12, 24}
+			}
+			if diff := cmp.Diff(wantOffsets, gotOffsets); diff != "" {
+				t.Errorf("unexpected Basic Offset Table. diff: %v", diff)
+			}
+
+			for eotTag, want := range map[tag.Tag][]uint64{
+				extendedOffsetTableTag:        {0, 12, 24},
+				extendedOffsetTableLengthsTag: {4, 4, 2},
+			} {
+				elem, err := ds.FindElementByTag(eotTag)
+				if tc.table != ExtendedOffsetTable {
+					if err == nil {
+						t.Errorf("unexpected %v element", eotTag)
+					}
+					continue
+				}
+				if err != nil {
+					t.Fatalf("FindElementByTag(%v) unexpected error: %v", eotTag, err)
+				}
+				b := elem.Value.GetValue().([]byte)
+				var got []uint64
+				for j := 0; j+8 <= len(b); j += 8 {
+					got = append(got, binary.LittleEndian.Uint64(b[j:]))
+				}
+				if diff := cmp.Diff(want, got); diff != "" {
+					t.Errorf("unexpected %v. diff: %v", eotTag, diff)
+				}
+			}
+		})
+	}
+}
+
+func TestWriter_WritePixelData_Errors(t *testing.T) {
+	nativeFrame := &frame.Frame{NativeData: frame.NativeFrame{BitsPerSample: 8, Rows: 1, Cols: 2, Data: [][]int{{1}, {2}}}}
+	encapsulatedFrame := &frame.Frame{Encapsulated: true, EncapsulatedData: frame.EncapsulatedFrame{Data: []byte{1, 2}}}
+	frames := func(fs ...*frame.Frame) NextFrame {
+		fc := make(chan *frame.Frame, len(fs))
+		for _, f := range fs {
+			fc <- f
+		}
+		close(fc)
+		return FramesFromChannel(fc)
+	}
+	native := []*Element{
+		mustNewElement(tag.Rows, []int{1}),
+		mustNewElement(tag.Columns, []int{2}),
+		mustNewElement(tag.BitsAllocated, []int{8}),
+	}
+	cases := []struct {
+		name           string
+		transferSyntax string
+		elems          []*Element
+		next           NextFrame
+		table          OffsetTable
+		wantErr        error
+	}{
+		{
+			name:           "missing Rows",
+			transferSyntax: uid.ExplicitVRLittleEndian,
+			elems:          native[1:],
+			next:           frames(nativeFrame),
+			wantErr:        ErrorElementNotFound,
+		},
+		{
+			name:           "too few frames",
+			transferSyntax: uid.ExplicitVRLittleEndian,
+			elems:          append([]*Element{mustNewElement(tag.NumberOfFrames, []string{"2"})}, native...),
+			next:           frames(nativeFrame),
+			wantErr:        ErrorPixelDataFrames,
+		},
+		{
+			name:           "too many frames",
+			transferSyntax: uid.ExplicitVRLittleEndian,
+			elems:          native,
+			next:           frames(nativeFrame, nativeFrame),
+			wantErr:        ErrorPixelDataFrames,
+		},
+		{
+			name:           "frame of the wrong size",
+			transferSyntax: uid.ExplicitVRLittleEndian,
+			elems:          native,
+			next:           frames(&frame.Frame{NativeData: frame.NativeFrame{BitsPerSample: 8, Data: [][]int{{1}}}}),
+			wantErr:        ErrorPixelDataFrames,
+		},
+		{
+			name:           "encapsulated frame in a native transfer syntax",
+			transferSyntax: uid.ExplicitVRLittleEndian,
+			elems:          native,
+			next:           frames(encapsulatedFrame),
+			wantErr:        ErrorPixelDataEncapsulation,
+		},
+		{
+			name:           "native frame in an encapsulated transfer syntax",
+			transferSyntax: "1.2.840.10008.1.2.4.50",
+			next:           frames(nativeFrame),
+			wantErr:        ErrorPixelDataEncapsulation,
+		},
+		{
+			name:           "offset table without an io.WriteSeeker",
+			transferSyntax: "1.2.840.10008.1.2.4.50",
+			next:           frames(encapsulatedFrame),
+			table:          BasicOffsetTable,
+			wantErr:        ErrorNotSeekable,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			w := NewWriter(&bytes.Buffer{})
+			if err := w.WriteHeader([]*Element{mustNewElement(tag.TransferSyntaxUID, []string{tc.transferSyntax})}); err != nil {
+				t.Fatalf("WriteHeader() unexpected error: %v", err)
+			}
+			for _, elem := range tc.elems {
+				if err := w.WriteElement(elem); err != nil {
+					t.Fatalf("WriteElement() unexpected error: %v", err)
+				}
+			}
+			if err := w.WritePixelData(tc.next, tc.table); !errors.Is(err, tc.wantErr) {
+				t.Errorf("WritePixelData() unexpected error. got: %v, want: %v", err, tc.wantErr)
+			}
+		})
+	}
+}